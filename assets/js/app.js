@@ -131,8 +131,10 @@ function processMessage(data) {
 function connectSocket() {
 	var ws, loc = window.location;
 	var protocol = loc.protocol == "https:" ? "wss:" : "ws:"
+	// keep base path when served behind reverse proxy
+	var path = loc.pathname.replace(/[^\/]*$/, "");
 
-	ws = new WebSocket(protocol + "//" + loc.hostname + (loc.port ? ":" + loc.port : "") + "/ws");
+	ws = new WebSocket(protocol + "//" + loc.hostname + (loc.port ? ":" + loc.port : "") + path + "ws");
 
 	ws.onerror = function(evt) {
 		ws.close();
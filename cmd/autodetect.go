@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// autoAdapter is the adapter name for serial ports detected at startup
+const autoAdapter = "auto"
+
+// autoDetection holds the communication parameters of the adapter to detect
+type autoDetection struct {
+	baudrate int
+	comsets  []string
+	pause    *time.Duration
+}
+
+// serialPorts returns the candidate serial ports
+func serialPorts() ([]string, error) {
+	if runtime.GOOS == "windows" {
+		var ports []string
+		for i := 1; i <= 32; i++ {
+			ports = append(ports, fmt.Sprintf("COM%d", i))
+		}
+		return ports, nil
+	}
+
+	return filepath.Glob("/dev/serial/by-id/*")
+}
+
+// probeAdapter returns the number of the manager's devices responding on the connection
+func probeAdapter(conn meters.Connection, m *meters.Manager) int {
+	client := conn.ModbusClient()
+
+	var responding int
+	m.All(func(id uint8, dev meters.Device) {
+		conn.Slave(id)
+
+		if err := dev.Initialize(client); err != nil && !errors.Is(err, meters.ErrPartiallyOpened) {
+			return
+		}
+
+		// exception responses are sent by present devices, too
+		_, err := dev.Probe(client)
+		var mbErr *modbus.Error
+		if err == nil || errors.As(err, &mbErr) {
+			responding++
+		}
+	})
+
+	return responding
+}
+
+// DetectAdapter probes the serial ports for the devices configured on the auto adapter
+// and attaches the devices to the port and comset most devices respond on.
+func (conf *DeviceConfigHandler) DetectAdapter() error {
+	m, ok := conf.Managers[autoAdapter]
+	if !ok || m.Conn != nil {
+		return nil
+	}
+
+	if m.Count() == 0 {
+		return errors.New("config: detecting the auto adapter requires configured devices")
+	}
+
+	ports, err := serialPorts()
+	if err != nil {
+		return err
+	}
+
+	var port, comset string
+	var best int
+
+PORTS:
+	for _, p := range ports {
+		for _, c := range conf.auto.comsets {
+			conn, err := meters.NewSerial(p, conf.auto.baudrate, c)
+			if err != nil {
+				return err
+			}
+			responding := probeAdapter(conn, m)
+			conn.Close()
+
+			log.Printf("config: probing %s (%s): %d of %d devices responding", p, c, responding, m.Count())
+
+			if responding > best {
+				port, comset, best = p, c, responding
+			}
+			if best == m.Count() {
+				break PORTS
+			}
+		}
+	}
+
+	if best == 0 {
+		return fmt.Errorf("config: none of %d serial ports has responding devices", len(ports))
+	}
+
+	log.Printf("config: detected adapter %s (%s)", port, comset)
+	if m.Conn, err = meters.NewSerial(port, conf.auto.baudrate, comset); err != nil {
+		return err
+	}
+	if conf.auto.pause != nil {
+		setPause(m.Conn, *conf.auto.pause)
+	}
+
+	return nil
+}
+
+// autoComsets parses a comma-separated list of comsets to probe
+func autoComsets(comset string) []string {
+	var res []string
+	for _, c := range strings.Split(comset, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// ScanAdapter scans the adapter's device id range MIN-MAX for the given device types and adds
+// all detected devices of known type that are not configured yet. Scanned devices are tracked
+// separately from configured devices so that reloading the configuration doesn't remove them.
+func (conf *DeviceConfigHandler) ScanAdapter(adapter string, ids string, types []string) error {
+	m, ok := conf.Managers[adapter]
+	if !ok || m.Conn == nil {
+		return errors.New("config: auto-scan requires a default adapter")
+	}
+
+	sc, err := newScanConfig(ids, types)
+	if err != nil {
+		return fmt.Errorf("config: %v", err)
+	}
+
+	sc.skip = make(map[uint8]bool)
+	m.All(func(id uint8, dev meters.Device) {
+		sc.skip[id] = true
+	})
+
+	log.Printf("config: scanning %s for devices", adapter)
+
+	var added int
+	for _, res := range scanBus(m.Conn, sc) {
+		if res.Type == "" {
+			log.Printf("config: skipping unknown device %d", res.ID)
+			continue
+		}
+
+		key, dev := conf.addDevice(DeviceConfig{
+			Type:    res.Type,
+			ID:      res.ID,
+			Adapter: adapter,
+		})
+		conf.Scanned[key] = dev
+		added++
+	}
+
+	log.Printf("config: added %d scanned devices", added)
+
+	return nil
+}
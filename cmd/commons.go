@@ -57,6 +57,14 @@ func meterHelp() string {
 	s += fmt.Sprintf("\n  %s", "TCP")
 	s += fmt.Sprintf("\n    %-10s%s", "SUNS", "Sunspec-compatible MODBUS TCP device (SMA, SolarEdge, KOSTAL, etc)")
 
+	s += fmt.Sprintf("\n  %s", "Other")
+	s += fmt.Sprintf("\n    %-10s%s", "HOST", "Gateway host metrics, use with adapter host (HOST:1@host)")
+	s += fmt.Sprintf("\n    %-10s%s", "SIM", "Simulated three-phase meter, use with adapter sim (SIM:1@sim)")
+	s += fmt.Sprintf("\n    %-10s%s", "SML", "Smart meter via optical reader, use with adapter sml (SML:1@sml:/dev/ttyUSB0)")
+	s += fmt.Sprintf("\n    %-10s%s", "D0", "Smart meter via optical reader, use with adapter d0 (D0:1@d0:/dev/ttyUSB0)")
+	s += fmt.Sprintf("\n    %-10s%s", "P1", "DSMR smart meter via P1 port, use with adapter p1 (P1:1@p1:/dev/ttyUSB0)")
+	s += fmt.Sprintf("\n    %-10s%s", "MBUS", "M-Bus heat, water or electricity meter, use with adapter mbus (MBUS:1@mbus:/dev/ttyUSB0)")
+
 	return s
 }
 
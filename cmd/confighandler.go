@@ -1,38 +1,95 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"reflect"
 	"regexp"
-	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
 	"github.com/volkszaehler/mbmd/meters"
+	"github.com/volkszaehler/mbmd/meters/host"
+	"github.com/volkszaehler/mbmd/meters/mbus"
+	"github.com/volkszaehler/mbmd/meters/optical"
 	"github.com/volkszaehler/mbmd/meters/rs485"
-	"github.com/volkszaehler/mbmd/meters/sunspec"
+	"github.com/volkszaehler/mbmd/meters/sim"
+	"github.com/volkszaehler/mbmd/server"
 )
 
 // Config describes the entire configuration
 type Config struct {
-	API      string
-	Rate     time.Duration
-	Mqtt     MqttConfig
-	Influx   InfluxConfig
-	Adapters []AdapterConfig
-	Devices  []DeviceConfig
-	Other    map[string]interface{} `mapstructure:",remain"`
+	API          string
+	TLS          TLSConfig
+	Rate         time.Duration
+	Mqtt         MqttConfig
+	Influx       InfluxConfig
+	UDP          UDPConfig
+	StatsD       StatsDConfig
+	Volkszaehler VolkszaehlerConfig
+	PVOutput     PVOutputConfig
+	EmonCMS      EmonCMSConfig
+	Webhook      WebhookConfig
+	Adaptive     AdaptiveConfig
+	Rules        []RuleConfig
+	Hooks        []server.HookConfig
+	Modbus       ModbusConfig
+	Adapters     []AdapterConfig
+	Devices      []DeviceConfig
+	Groups       []GroupConfig
+	Other        map[string]interface{} `mapstructure:",remain"`
+}
+
+// deviceSpecHook decodes device specification strings like SDM:1@/dev/ttyUSB0#Heatpump in the devices list
+func deviceSpecHook(from, to reflect.Type, data interface{}) (interface{}, error) {
+	if from.Kind() != reflect.String || to != reflect.TypeOf(DeviceConfig{}) {
+		return data, nil
+	}
+	return parseDeviceSpec(data.(string))
+}
+
+// unmarshalConfig decodes the config file read by v
+func unmarshalConfig(v *viper.Viper, conf *Config) error {
+	return v.UnmarshalExact(conf, viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+		deviceSpecHook,
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToSliceHookFunc(","),
+	)))
+}
+
+// TLSConfig describes the http server certificate configuration
+type TLSConfig struct {
+	Cert       string
+	Key        string
+	SelfSigned bool
+	ClientCA   string
 }
 
 // MqttConfig describes the mqtt broker configuration
 type MqttConfig struct {
-	Broker   string
-	Topic    string
-	User     string
-	Password string
-	ClientID string
-	Qos      int
-	Homie    string
+	Broker    string
+	Topic     string
+	User      string
+	Password  string
+	ClientID  string
+	CACert    string
+	Cert      string
+	Key       string
+	Insecure  bool
+	Qos       int
+	Homie     string
+	Devices   string
+	Units     string
+	Deadband  string
+	Interval  time.Duration
+	Snapshots bool
+	Names     bool
+	JSON      bool
+	OBIS      bool
 }
 
 // InfluxConfig describes the InfluxDB configuration
@@ -44,43 +101,275 @@ type InfluxConfig struct {
 	Token        string
 	User         string
 	Password     string
+	Devices      string
+	Units        string
+	Policy       string
+	Queue        int
+}
+
+// UDPConfig describes the line protocol UDP sink configuration
+type UDPConfig struct {
+	Address     string
+	Measurement string
+	Devices     string
+	Units       string
+}
+
+// StatsDConfig describes the StatsD sink configuration
+type StatsDConfig struct {
+	Address string
+	Prefix  string
+	Tags    bool
+	Devices string
+	Units   string
+}
+
+// VolkszaehlerConfig describes the volkszaehler.org middleware configuration
+type VolkszaehlerConfig struct {
+	URL      string
+	Channels []VolkszaehlerChannelConfig
+}
+
+// VolkszaehlerChannelConfig maps a device's measurement to a middleware channel
+type VolkszaehlerChannelConfig struct {
+	Device      string
+	Measurement string
+	UUID        string
+}
+
+// volkszaehlerChannels converts the channel map configuration
+func volkszaehlerChannels(conf []VolkszaehlerChannelConfig) ([]server.VolkszaehlerChannel, error) {
+	res := make([]server.VolkszaehlerChannel, 0, len(conf))
+	for _, c := range conf {
+		m, err := meters.MeasurementString(c.Measurement)
+		if err != nil {
+			return nil, fmt.Errorf("invalid measurement %s for channel %s", c.Measurement, c.UUID)
+		}
+
+		res = append(res, server.VolkszaehlerChannel{
+			Device:      c.Device,
+			Measurement: m,
+			UUID:        c.UUID,
+		})
+	}
+	return res, nil
+}
+
+// PVOutputConfig describes the PVOutput.org uploader configuration
+type PVOutputConfig struct {
+	APIKey      string
+	SystemID    string
+	Generation  string
+	Consumption string
+	Interval    time.Duration
+}
+
+// EmonCMSConfig describes the EmonCMS sink configuration
+type EmonCMSConfig struct {
+	URL     string
+	APIKey  string
+	Nodes   string
+	Devices string
+	Units   string
+}
+
+// WebhookConfig describes the webhook alert configuration
+type WebhookConfig struct {
+	URL       []string
+	ErrorRate float64
+	Window    time.Duration
+	Devices   string
+}
+
+// AdaptiveConfig describes the adaptive polling configuration
+type AdaptiveConfig struct {
+	Min       time.Duration
+	Max       time.Duration
+	Threshold float64
+}
+
+// RuleConfig describes a limit rule and its alert actions
+type RuleConfig struct {
+	Name      string
+	Device    string
+	Condition string
+	MQTT      string
+	Webhook   string
+	Exec      string
+}
+
+// limitRules converts the rules configuration. Rules publishing via MQTT require a broker.
+func limitRules(conf []RuleConfig, broker bool) ([]server.Rule, error) {
+	res := make([]server.Rule, 0, len(conf))
+	for _, c := range conf {
+		rule := server.Rule{
+			Name:    c.Name,
+			Device:  c.Device,
+			Topic:   c.MQTT,
+			Webhook: c.Webhook,
+			Exec:    c.Exec,
+		}
+		if rule.Name == "" {
+			rule.Name = c.Condition
+		}
+
+		if err := server.ParseRuleCondition(c.Condition, &rule); err != nil {
+			return nil, fmt.Errorf("rule %s: %v", rule.Name, err)
+		}
+		if rule.Topic != "" && !broker {
+			return nil, fmt.Errorf("rule %s: mqtt topic requires mqtt broker", rule.Name)
+		}
+
+		res = append(res, rule)
+	}
+	return res, nil
+}
+
+// rulesPublish returns true if any rule publishes alerts via MQTT
+func rulesPublish(rules []server.Rule) bool {
+	for _, r := range rules {
+		if r.Topic != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// ModbusConfig describes the Modbus TCP server configuration
+type ModbusConfig struct {
+	Listen    string
+	Gateway   string // adapter receiving requests for unmapped units
+	Registers []ModbusRegisterConfig
+}
+
+// ModbusRegisterConfig maps a device's measurement to Modbus server registers
+type ModbusRegisterConfig struct {
+	Unit        uint8
+	Address     uint16
+	Device      string
+	Measurement string
+	Type        string
+	Scale       float64
+}
+
+// modbusRegisters converts the register map configuration
+func modbusRegisters(conf []ModbusRegisterConfig) ([]server.ModbusRegister, error) {
+	res := make([]server.ModbusRegister, 0, len(conf))
+	for _, r := range conf {
+		m, err := meters.MeasurementString(r.Measurement)
+		if err != nil {
+			return nil, fmt.Errorf("invalid measurement %s for register %d", r.Measurement, r.Address)
+		}
+
+		res = append(res, server.ModbusRegister{
+			Unit:        r.Unit,
+			Address:     r.Address,
+			Device:      r.Device,
+			Measurement: m,
+			Type:        r.Type,
+			Scale:       r.Scale,
+		})
+	}
+	return res, nil
 }
 
 // AdapterConfig describes device communication parameters
 type AdapterConfig struct {
-	Device   string
-	RTU      bool
-	Baudrate int
-	Comset   string
+	Device    string
+	RTU       bool
+	Baudrate  int
+	Comset    string
+	Pause     *time.Duration   // pause between different device ids, defaults to 100ms for RTU
+	Direction *DirectionConfig // RS485 driver-enable control, optional
+	Limit     float64          // maximum transactions per second, defaults to --bus-limit
+}
+
+// DirectionConfig describes RS485 driver-enable control for serial adapters without automatic direction switching
+type DirectionConfig struct {
+	Mode        string // rts or gpio
+	GPIO        int
+	DelayBefore time.Duration `mapstructure:"delay-before"`
+	DelayAfter  time.Duration `mapstructure:"delay-after"`
 }
 
 // DeviceConfig describes a single device's configuration
 type DeviceConfig struct {
-	Type      string
-	ID        uint8
-	SubDevice int
-	Name      string
-	Adapter   string
+	Type       string
+	ID         uint8
+	SubDevice  int
+	Name       string
+	Tags       []string
+	Adapter    string
+	Retries    int
+	Timeout    time.Duration
+	RetryDelay time.Duration `mapstructure:"retry-delay"`
+	Demand     time.Duration
+	Priority   int                      // devices with higher priority are queried first
+	Registers  []rs485.RegisterMapEntry // replace or add registers of RS485 devices
+	Include    []string                 // measurements queried from RS485 devices, defaults to all
+	Exclude    []string                 // measurements not queried from RS485 devices
+}
+
+// GroupConfig describes a consistency group of devices that are queried back-to-back
+type GroupConfig struct {
+	Name    string
+	Devices []string
 }
 
 // DeviceConfigHandler creates map of meter managers from given configuration
 type DeviceConfigHandler struct {
 	DefaultDevice string
 	Managers      map[string]*meters.Manager
+	Labels        map[meters.Device]server.Labels
+	Options       map[meters.Device]server.QueryOptions
+	Devices       map[string]meters.Device // devices created from configuration by key
+	Scanned       map[string]meters.Device // devices added by auto-scan by key, not reconciled on reload
+	auto          *autoDetection           // parameters of the auto adapter until detected
 }
 
 // NewDeviceConfigHandler creates a configuration handler
 func NewDeviceConfigHandler() *DeviceConfigHandler {
 	conf := &DeviceConfigHandler{
 		Managers: make(map[string]*meters.Manager),
+		Labels:   make(map[meters.Device]server.Labels),
+		Options:  make(map[meters.Device]server.QueryOptions),
+		Devices:  make(map[string]meters.Device),
+		Scanned:  make(map[string]meters.Device),
 	}
 	return conf
 }
 
-// createConnection parses adapter string to create TCP or RTU connection
-func createConnection(device string, rtu bool, baudrate int, comset string) (res meters.Connection) {
-	if device == "mock" {
+// newConnection parses adapter string to create TCP or RTU connection
+func newConnection(device string, rtu bool, baudrate int, comset string) (res meters.Connection, err error) {
+	if device == autoAdapter {
+		return nil, errors.New("adapter auto-detection is only supported when starting the run command")
+	} else if device == "mock" {
 		res = meters.NewMock(device) // mocked connection
+	} else if device == "host" {
+		res = host.NewConnection() // gateway host metrics
+	} else if device == "sim" {
+		res = sim.NewConnection() // simulated devices
+	} else if protocol, port, ok := opticalAdapter(device); ok {
+		log.Printf("config: creating %s optical reader connection for %s", strings.ToUpper(string(protocol)), port)
+		if res, err = optical.NewConnection(protocol, port, baudrate, comset); err != nil {
+			return nil, err
+		}
+	} else if strings.HasPrefix(device, mbusPrefix) {
+		port := strings.TrimPrefix(device, mbusPrefix)
+		log.Printf("config: creating M-Bus connection for %s", port)
+		if res, err = mbus.NewConnection(port, baudrate, comset); err != nil {
+			return nil, err
+		}
+	} else if strings.HasPrefix(device, "replay:") {
+		// replay:<file>[?bus=<bus>]
+		file, bus := strings.TrimPrefix(device, "replay:"), ""
+		if i := strings.Index(file, "?bus="); i >= 0 {
+			file, bus = file[:i], file[i+len("?bus="):]
+		}
+		log.Printf("config: replaying %s", device)
+		if res, err = meters.NewReplay(file, bus, viper.GetFloat64("replay-speed")); err != nil {
+			return nil, err
+		}
 	} else if tcp, _ := regexp.MatchString(":[0-9]+$", device); tcp {
 		if rtu {
 			// special case: RTU over TCP
@@ -91,22 +380,127 @@ func createConnection(device string, rtu bool, baudrate int, comset string) (res
 			res = meters.NewTCP(device) // tcp connection
 		}
 	} else {
-		log.Printf("config: creating RTU connection for %s (%dbaud, %s)", device, baudrate, comset)
-		if baudrate == 0 || comset == "" {
-			log.Fatal("Missing comset configuration. See -h for help.")
+		if comset == "" {
+			return nil, errors.New("Missing comset configuration. See -h for help.")
 		}
+		cs, err := meters.ParseComset(comset, baudrate)
+		if err != nil {
+			return nil, fmt.Errorf("%v. See -h for help.", err)
+		}
+		mode := "RTU"
+		if cs.DataBits == 7 {
+			mode = "ASCII"
+		}
+		log.Printf("config: creating %s connection for %s (%dbaud, %d%s%d)", mode, device, cs.Baudrate, cs.DataBits, cs.Parity, cs.StopBits)
 		if _, err := os.Stat(device); err != nil {
-			log.Fatal(err)
+			return nil, err
+		}
+		// serial connection
+		if res, err = meters.NewSerial(device, baudrate, comset); err != nil {
+			return nil, err
 		}
-		res = meters.NewRTU(device, baudrate, comset) // serial connection
+	}
+	return res, nil
+}
+
+// opticalAdapter parses optical reader and P1 port adapters like sml:/dev/ttyUSB0, d0:/dev/ttyUSB0 or p1:/dev/ttyUSB0
+func opticalAdapter(device string) (optical.Protocol, string, bool) {
+	for _, protocol := range []optical.Protocol{optical.SML, optical.D0, optical.P1} {
+		if prefix := string(protocol) + ":"; strings.HasPrefix(device, prefix) {
+			return protocol, strings.TrimPrefix(device, prefix), true
+		}
+	}
+	return "", "", false
+}
+
+// mbusPrefix is the prefix of M-Bus adapters like mbus:/dev/ttyUSB0
+const mbusPrefix = "mbus:"
+
+// modbusAdapter returns false for adapters like optical readers or M-Bus that don't send modbus transactions
+func modbusAdapter(adapter string) bool {
+	_, _, ok := opticalAdapter(adapter)
+	return !ok && !strings.HasPrefix(adapter, mbusPrefix)
+}
+
+// createConnection parses adapter string to create TCP or RTU connection
+func createConnection(device string, rtu bool, baudrate int, comset string) meters.Connection {
+	res, err := newConnection(device, rtu, baudrate, comset)
+	if err != nil {
+		log.Fatal(err)
 	}
 	return res
 }
 
-// ConnectionManager returns connection manager from cache or creates new connection wrapped by manager
+// setPause sets the pause between operations on different device ids. TCP connections don't pause.
+func setPause(conn meters.Connection, pause time.Duration) {
+	if p, ok := conn.(meters.Pausable); ok {
+		p.Pause(pause)
+	}
+}
+
+// setDirection enables RS485 driver-enable control of the connection
+func setDirection(conn meters.Connection, conf *DirectionConfig) error {
+	if conf == nil {
+		return nil
+	}
+	if conn == nil {
+		return errors.New("config: direction control not supported for auto-detected adapters")
+	}
+
+	d, ok := conn.(meters.DirectionControllable)
+	if !ok {
+		return fmt.Errorf("config: direction control not supported for adapter %v", conn)
+	}
+
+	log.Printf("config: using %s direction control for %s", conf.Mode, conn)
+	return d.Direction(meters.Direction{
+		Mode:        conf.Mode,
+		GPIO:        conf.GPIO,
+		DelayBefore: conf.DelayBefore,
+		DelayAfter:  conf.DelayAfter,
+	})
+}
+
+// busLimit returns the adapter's transaction limit or the default limit. Optical readers and M-Bus are not limited
+// since they don't send modbus transactions.
+func busLimit(limits map[string]float64, adapter string) float64 {
+	if !modbusAdapter(adapter) {
+		return 0
+	}
+	if l, ok := limits[adapter]; ok {
+		return l
+	}
+	return viper.GetFloat64("bus-limit")
+}
+
+// setAdapterPause sets the pause of the manager's connection. The auto adapter's pause is applied once detected.
+func (conf *DeviceConfigHandler) setAdapterPause(manager *meters.Manager, pause time.Duration) {
+	if manager.Conn == nil && conf.auto != nil {
+		conf.auto.pause = &pause
+		return
+	}
+	setPause(manager.Conn, pause)
+}
+
+// ConnectionManager returns connection manager from cache or creates new connection wrapped by manager.
+// The connection of the auto adapter is created by DetectAdapter once the devices have been added.
 func (conf *DeviceConfigHandler) ConnectionManager(connSpec string, rtu bool, baudrate int, comset string) *meters.Manager {
 	manager, ok := conf.Managers[connSpec]
-	if !ok {
+	if !ok && connSpec == autoAdapter {
+		comsets := autoComsets(comset)
+		if rtu || len(comsets) == 0 {
+			log.Fatal("config: adapter auto-detection requires serial comset configuration")
+		}
+		for _, c := range comsets {
+			if _, err := meters.ParseComset(c, baudrate); err != nil {
+				log.Fatalf("config: %v", err)
+			}
+		}
+
+		conf.auto = &autoDetection{baudrate: baudrate, comsets: comsets}
+		manager = meters.NewManager(nil)
+		conf.Managers[connSpec] = manager
+	} else if !ok {
 		conn := createConnection(connSpec, rtu, baudrate, comset)
 		manager = meters.NewManager(conn)
 		conf.Managers[connSpec] = manager
@@ -120,112 +514,261 @@ func (conf *DeviceConfigHandler) createDeviceForManager(
 	meterType string,
 	subdevice int,
 ) meters.Device {
-	var meter meters.Device
-	meterType = strings.ToUpper(meterType)
+	meter, err := server.NewDevice(meterType, subdevice)
+	if err != nil {
+		log.Fatalf("Error creating device %s: %v.", meterType, err)
+	}
+
+	return meter
+}
+
+// Key identifies the device configuration by adapter, type and id
+func (devConf DeviceConfig) Key() string {
+	return fmt.Sprintf("%s:%d.%d@%s", strings.ToUpper(devConf.Type), devConf.ID, devConf.SubDevice, devConf.Adapter)
+}
+
+// Labels returns the configured device labels
+func (devConf DeviceConfig) Labels() server.Labels {
+	return server.Labels{
+		Name: devConf.Name,
+		Tags: devConf.Tags,
+	}
+}
+
+// QueryOptions returns the configured device query retries, timeouts and priority
+func (devConf DeviceConfig) QueryOptions() server.QueryOptions {
+	return server.QueryOptions{
+		Retries:    devConf.Retries,
+		Timeout:    devConf.Timeout,
+		RetryDelay: devConf.RetryDelay,
+		Demand:     devConf.Demand,
+		Priority:   devConf.Priority,
+	}
+}
 
-	var isSunspec bool
-	sunspecTypes := []string{"FRONIUS", "KOSTAL", "KACO", "SE", "SMA", "SOLAREDGE", "STECA", "SUNS", "SUNSPEC"}
-	for _, t := range sunspecTypes {
-		if t == meterType {
-			isSunspec = true
-			break
+// NewDevice creates a device from configuration. The returned configuration has the default adapter applied.
+func (conf *DeviceConfigHandler) NewDevice(devConf DeviceConfig) (DeviceConfig, meters.Device, error) {
+	if devConf.Adapter == "" {
+		// find default adapter
+		if len(conf.Managers) != 1 {
+			return devConf, nil, fmt.Errorf("Missing adapter configuration for device %v", devConf)
+		}
+		for a := range conf.Managers {
+			log.Printf("config: using default adapter %s for device %v", a, devConf)
+			devConf.Adapter = a
 		}
 	}
 
-	sort.SearchStrings(sunspecTypes, meterType)
-	if isSunspec {
-		meter = sunspec.NewDevice(meterType, subdevice)
-	} else {
-		if subdevice > 0 {
-			log.Fatalf("Invalid subdevice number for device %s: %d", meterType, subdevice)
+	if _, ok := conf.Managers[devConf.Adapter]; !ok {
+		return devConf, nil, fmt.Errorf("Missing adapter configuration for device %v", devConf)
+	}
+
+	meter, err := server.NewDevice(devConf.Type, devConf.SubDevice)
+	if err != nil {
+		return devConf, nil, fmt.Errorf("Error creating device %s: %v.", devConf.Type, err)
+	}
+
+	if len(devConf.Registers) > 0 {
+		if err := overrideRegisters(meter, devConf.Registers); err != nil {
+			return devConf, nil, fmt.Errorf("Error creating device %s: %v.", devConf.Key(), err)
 		}
+	}
 
-		var err error
-		meter, err = rs485.NewDevice(meterType)
-		if err != nil {
-			log.Fatalf("Error creating device %s: %v.", meterType, err)
+	if len(devConf.Include) > 0 || len(devConf.Exclude) > 0 {
+		if err := filterMeasurements(meter, devConf.Include, devConf.Exclude); err != nil {
+			return devConf, nil, fmt.Errorf("Error creating device %s: %v.", devConf.Key(), err)
 		}
 	}
 
-	return meter
+	return devConf, meter, nil
 }
 
-// CreateDevice creates new device and adds it to the connection manager
-func (conf *DeviceConfigHandler) CreateDevice(devConf DeviceConfig) {
-	if devConf.Adapter == "" {
-		// find default adapter
-		if len(conf.Managers) == 1 {
-			for a := range conf.Managers {
-				log.Printf("config: using default adapter %s for device %v", a, devConf)
-				devConf.Adapter = a
+// overrideRegisters replaces or adds the registers of an RS485 device
+func overrideRegisters(meter meters.Device, entries []rs485.RegisterMapEntry) error {
+	dev, ok := meter.(*rs485.RS485)
+	if !ok {
+		return errors.New("registers can only be configured for RS485 devices")
+	}
+
+	registers, _, err := rs485.NewRegisters(entries, rs485.HighWordFirst)
+	if err != nil {
+		return fmt.Errorf("invalid registers: %v", err)
+	}
+
+	return dev.Override(registers)
+}
+
+// filterMeasurements restricts the measurements queried from an RS485 device
+func filterMeasurements(meter meters.Device, include, exclude []string) error {
+	dev, ok := meter.(*rs485.RS485)
+	if !ok {
+		return errors.New("measurement filters can only be configured for RS485 devices")
+	}
+
+	return dev.Filter(include, exclude)
+}
+
+// counterInterval sets the number of query cycles between reads of low priority measurements of all RS485 devices
+func counterInterval(managers map[string]*meters.Manager, interval int) error {
+	log.Printf("config: reading energy counters every %d queries", interval)
+
+	var err error
+	for _, m := range managers {
+		m.All(func(id uint8, dev meters.Device) {
+			if d, ok := dev.(*rs485.RS485); ok && err == nil {
+				err = d.Prioritize(interval)
 			}
-		} else {
-			log.Fatalf("Missing adapter configuration for device %v", devConf)
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf("config: %v", err)
+	}
+
+	return nil
+}
+
+// adaptivePolling enables adaptive polling of all RS485 devices. Intervals are converted to query cycles at the given rate.
+func adaptivePolling(managers map[string]*meters.Manager, conf AdaptiveConfig, rate time.Duration) error {
+	cycles := func(d time.Duration) int {
+		if rate <= 0 || d <= rate {
+			return 1
 		}
+		return int((d + rate - 1) / rate)
 	}
 
-	manager, ok := conf.Managers[devConf.Adapter]
-	if !ok {
-		log.Fatalf("Missing adapter configuration for device %v", devConf)
+	a := rs485.Adaptive{
+		MinInterval: cycles(conf.Min),
+		MaxInterval: cycles(conf.Max),
+		Threshold:   conf.Threshold,
 	}
-	meter := conf.createDeviceForManager(manager, devConf.Type, devConf.SubDevice)
+	if err := a.Validate(); err != nil {
+		return fmt.Errorf("config: %v", err)
+	}
+
+	log.Printf("config: adaptive polling every %d-%d cycles", a.MinInterval, a.MaxInterval)
+
+	var err error
+	for _, m := range managers {
+		m.All(func(id uint8, dev meters.Device) {
+			if d, ok := dev.(*rs485.RS485); ok && err == nil {
+				err = d.Adaptive(a)
+			}
+		})
+	}
+
+	return err
+}
+
+// CreateDevice creates new device and adds it to the connection manager
+func (conf *DeviceConfigHandler) CreateDevice(devConf DeviceConfig) {
+	key, meter := conf.addDevice(devConf)
+	conf.Devices[key] = meter
+}
 
+// addDevice creates the device and adds it to the adapter's manager. It returns the device's key.
+func (conf *DeviceConfigHandler) addDevice(devConf DeviceConfig) (string, meters.Device) {
+	devConf, meter, err := conf.NewDevice(devConf)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	manager := conf.Managers[devConf.Adapter]
 	if err := manager.Add(devConf.ID, meter); err != nil {
 		log.Fatalf("Error adding device %v: %v.", devConf, err)
 	}
+
+	if devConf.Name != "" || len(devConf.Tags) > 0 {
+		conf.Labels[meter] = devConf.Labels()
+	}
+
+	if opts := devConf.QueryOptions(); opts != (server.QueryOptions{}) {
+		conf.Options[meter] = opts
+	}
+
+	return devConf.Key(), meter
 }
 
-// CreateDeviceFromSpec creates new device from specification string and adds
-// it to the connection manager
-func (conf *DeviceConfigHandler) CreateDeviceFromSpec(deviceDef string) {
+// parseDeviceSpec parses a device specification TYPE:ID[.SUBDEVICE][@ADAPTER][#NAME]
+func parseDeviceSpec(deviceDef string) (DeviceConfig, error) {
+	var devConf DeviceConfig
+
+	if i := strings.Index(deviceDef, "#"); i >= 0 {
+		devConf.Name = strings.TrimSpace(deviceDef[i+1:])
+		if devConf.Name == "" {
+			return devConf, fmt.Errorf("device name empty: %s", deviceDef)
+		}
+		deviceDef = deviceDef[:i]
+	}
+
 	deviceSplit := strings.Split(deviceDef, "@")
 	if len(deviceSplit) == 0 || len(deviceSplit) > 2 {
-		log.Fatalf("Cannot parse connect string %s. See -h for help.", deviceDef)
+		return devConf, fmt.Errorf("cannot parse connect string %s", deviceDef)
 	}
 
 	meterDef := deviceSplit[0]
-	connSpec := conf.DefaultDevice
 	if len(deviceSplit) == 2 {
-		connSpec = deviceSplit[1]
-	}
-
-	if connSpec == "" {
-		log.Fatalf("Cannot parse connect string- missing physical device or connection for %s. See -h for help.", deviceDef)
+		if devConf.Adapter = deviceSplit[1]; devConf.Adapter == "" {
+			return devConf, fmt.Errorf("missing physical device or connection for %s", deviceDef)
+		}
 	}
 
 	meterSplit := strings.Split(meterDef, ":")
 	if len(meterSplit) != 2 {
-		log.Fatalf("Cannot parse device definition: %s. See -h for help.", meterDef)
+		return devConf, fmt.Errorf("cannot parse device definition: %s", meterDef)
 	}
 
 	meterType, devID := meterSplit[0], meterSplit[1]
 	if len(strings.TrimSpace(meterType)) == 0 {
-		log.Fatalf("Cannot parse device definition- meter type empty: %s. See -h for help.", meterDef)
+		return devConf, fmt.Errorf("meter type empty: %s", meterDef)
 	}
+	devConf.Type = meterType
 
-	var subdevice int
 	devIDSplit := strings.SplitN(devID, ".", 2)
 	if len(devIDSplit) == 2 {
-		var err error
-		subdevice, err = strconv.Atoi(devIDSplit[1])
+		subdevice, err := strconv.Atoi(devIDSplit[1])
 		if err != nil {
-			log.Fatalf("Error parsing device id %s: %v. See -h for help.", devID, err)
+			return devConf, fmt.Errorf("error parsing device id %s: %v", devID, err)
 		}
-	} else if len(devIDSplit) > 2 {
-		log.Fatalf("Error parsing device id %s. See -h for help.", devID)
+		devConf.SubDevice = subdevice
 	}
 
-	id, err := strconv.Atoi(devIDSplit[0])
+	id, err := strconv.ParseUint(devIDSplit[0], 10, 8)
+	if err != nil {
+		return devConf, fmt.Errorf("error parsing device id %s: %v", devID, err)
+	}
+	devConf.ID = uint8(id)
+
+	return devConf, nil
+}
+
+// CreateDeviceFromSpec creates new device from specification string and adds
+// it to the connection manager
+func (conf *DeviceConfigHandler) CreateDeviceFromSpec(deviceDef string) {
+	devConf, err := parseDeviceSpec(deviceDef)
 	if err != nil {
-		log.Fatalf("Error parsing device id %s: %v. See -h for help.", devID, err)
+		log.Fatalf("%v. See -h for help.", err)
+	}
+
+	connSpec := devConf.Adapter
+	if connSpec == "" {
+		connSpec = conf.DefaultDevice
+	}
+
+	if connSpec == "" {
+		log.Fatalf("Cannot parse connect string- missing physical device or connection for %s. See -h for help.", deviceDef)
 	}
 
 	// If this is an RTU over TCP device, a default RTU over TCP should already
 	// have been created of the --rtu flag was specified. We'll not re-check this here.
 	manager := conf.ConnectionManager(connSpec, false, 0, "")
 
-	meter := conf.createDeviceForManager(manager, meterType, subdevice)
-	if err := manager.Add(uint8(id), meter); err != nil {
-		log.Fatalf("Error adding device %s: %v. See -h for help.", meterDef, err)
+	meter := conf.createDeviceForManager(manager, devConf.Type, devConf.SubDevice)
+	if err := manager.Add(devConf.ID, meter); err != nil {
+		log.Fatalf("Error adding device %s: %v. See -h for help.", deviceDef, err)
+	}
+
+	if devConf.Name != "" {
+		conf.Labels[meter] = devConf.Labels()
 	}
 }
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// diagCmd represents the diag command
+var diagCmd = &cobra.Command{
+	Use:   "diag",
+	Short: "Download diagnostics bundle from running daemon",
+	Long: `Diag downloads a diagnostics bundle from a running mbmd daemon. The bundle contains
+version, configuration (secrets redacted), device inventory and status including error and
+latency statistics, recent log output and recent bus traffic. Attach it to bug reports.`,
+	Args: cobra.NoArgs,
+	Run:  diag,
+}
+
+func init() {
+	rootCmd.AddCommand(diagCmd)
+
+	diagCmd.PersistentFlags().StringP(
+		"url", "u",
+		"http://localhost:8080",
+		"Daemon REST API url including base path if any",
+	)
+	diagCmd.PersistentFlags().StringP(
+		"output", "o",
+		"",
+		"Output file (default is the file name provided by the daemon)",
+	)
+}
+
+func diag(cmd *cobra.Command, args []string) {
+	url, _ := cmd.PersistentFlags().GetString("url")
+	output, _ := cmd.PersistentFlags().GetString("output")
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(strings.TrimRight(url, "/") + "/api/diag")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("Failed to download diagnostics: %s", resp.Status)
+	}
+
+	if output == "" {
+		output = "mbmd-diag.tar.gz"
+		if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil && params["filename"] != "" {
+			output = params["filename"]
+		}
+	}
+
+	f, err := os.Create(output)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		f.Close()
+		log.Fatal(err)
+	}
+
+	if err := f.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(output)
+}
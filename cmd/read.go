@@ -21,10 +21,42 @@ var readCmd = &cobra.Command{
 	Use:   "read [flags] " + strings.Join([]string{"register", "length"}, " "),
 	Short: "Read register (EXPERIMENTAL)",
 	Long: `Read reads a single register (input, holding, coil, discrete input)
-and will return it according to defined format. Read will ignore the config file
+and will return it according to defined format. Alternatively, a single measurement
+is read using the device type's register definition. Read will ignore the config file
 and requires adapter configuration using command line.`,
-	Args: cobra.ExactArgs(2),
-	Run:  read,
+	Example: `  mbmd read -a /dev/ttyUSB0 -d 1 -t input -e float 12 2
+  mbmd read -a /dev/ttyUSB0 -d 1 --register 0x0C --fc 4 -e float32
+  mbmd read -a /dev/ttyUSB0 -d SDM:1 --measurement PowerL1`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 && len(args) != 2 {
+			return fmt.Errorf("expected register and length, got %d arguments", len(args))
+		}
+		return nil
+	},
+	Run: read,
+}
+
+// funcCodes maps modbus function codes to register types
+var funcCodes = map[int]string{
+	1: "coil",
+	2: "discrete",
+	3: "holding",
+	4: "input",
+}
+
+// sizedEncodings maps encodings with explicit size to encoding and register length
+var sizedEncodings = map[string]struct {
+	encoding string
+	length   int
+}{
+	"int16":   {"int", 1},
+	"uint16":  {"uint", 1},
+	"int32":   {"int", 2},
+	"uint32":  {"uint", 2},
+	"int64":   {"int", 4},
+	"uint64":  {"uint", 4},
+	"float32": {"float", 2},
+	"float64": {"float", 4},
 }
 
 func init() {
@@ -43,7 +75,27 @@ func init() {
 	readCmd.PersistentFlags().StringP(
 		"encoding", "e",
 		"int",
-		"Data encoding: bit|int|uint|int32s|uint32s|hex|float|floats|string",
+		"Data encoding: bit|int|uint|int32s|uint32s|hex|float|floats|string.\nSized encodings int16|uint16|int32|uint32|int64|uint64|float32|float64 imply the register length.",
+	)
+	readCmd.PersistentFlags().String(
+		"register",
+		"",
+		"Register to read, decimal or hex (0x). Alternative to register argument.",
+	)
+	readCmd.PersistentFlags().Int(
+		"length",
+		0,
+		"Number of registers to read. Alternative to length argument.",
+	)
+	readCmd.PersistentFlags().Int(
+		"fc",
+		0,
+		"Modbus function code: 1 (coil), 2 (discrete), 3 (holding) or 4 (input). Overrides --type.",
+	)
+	readCmd.PersistentFlags().StringP(
+		"measurement", "m",
+		"",
+		"Measurement to read using the device type's register definition, e.g. PowerL1. Requires device type (e.g. -d SDM:1).",
 	)
 }
 
@@ -88,6 +140,14 @@ func deviceIDFromSpec(meterDef string) uint8 {
 	return uint8(devID)
 }
 
+// deviceTypeFromSpec parses a device specification and extracts the device type if given
+func deviceTypeFromSpec(meterDef string) string {
+	if meterSplit := strings.Split(meterDef, ":"); len(meterSplit) == 2 {
+		return meterSplit[0]
+	}
+	return ""
+}
+
 func bytes2uint(b []byte, length int) uint64 {
 	switch length {
 	case 1:
@@ -102,6 +162,18 @@ func bytes2uint(b []byte, length int) uint64 {
 	return 0
 }
 
+// bytes2int decodes a signed integer, sign-extended according to the register length
+func bytes2int(b []byte, length int) int64 {
+	u := bytes2uint(b, length)
+	switch length {
+	case 1:
+		return int64(int16(u))
+	case 2:
+		return int64(int32(u))
+	}
+	return int64(u)
+}
+
 func bytes2float(b []byte, length int) float64 {
 	switch length {
 	case 2:
@@ -139,8 +211,7 @@ func decode(b []byte, length int, encoding string) string {
 	case "bit":
 		return decodeCoils(b, length)
 	case "int":
-		u := bytes2uint(b, length)
-		return strconv.FormatInt(int64(u), 10)
+		return strconv.FormatInt(bytes2int(b, length), 10)
 	case "int32swapped", "int32s":
 		if length != 2 {
 			log.Fatal("Invalid length for int32(swapped) encoding")
@@ -212,17 +283,109 @@ func validateFlags(typ, encoding string) {
 	}
 }
 
+// parseRegisterFlags returns register and length from flags. Length defaults to the sized encoding's length.
+func parseRegisterFlags(cmd *cobra.Command, encoding string) (int, int) {
+	reg, _ := cmd.PersistentFlags().GetString("register")
+	length, _ := cmd.PersistentFlags().GetInt("length")
+
+	register, err := strconv.ParseInt(reg, 0, 32)
+	if err != nil {
+		log.Fatal("Invalid register number")
+	}
+
+	if length == 0 {
+		if sized, ok := sizedEncodings[strings.ToLower(encoding)]; ok {
+			length = sized.length
+		} else {
+			length = 1
+		}
+	}
+
+	return int(register), length
+}
+
+// readMeasurement reads a single measurement using the device type's register definition
+func readMeasurement(meterDef, measurement string) {
+	meterType := strings.ToUpper(deviceTypeFromSpec(meterDef))
+	if meterType == "" {
+		log.Fatal("Missing device type. See -h for help.")
+	}
+
+	factory, ok := rs485.Producers[meterType]
+	if !ok {
+		log.Fatalf("Measurement reads are not supported for device type %s", meterType)
+	}
+
+	var op rs485.Operation
+	for _, o := range factory().Produce() {
+		if strings.EqualFold(o.IEC61850.String(), measurement) {
+			op = o
+			break
+		}
+	}
+
+	if op.FuncCode == 0 {
+		log.Fatalf("Measurement %s is not supported by device type %s", measurement, meterType)
+	}
+
+	// parse modbus settings
+	conn, client := modbusClient()
+	conn.Slave(deviceIDFromSpec(meterDef))
+
+	device, err := rs485.NewDevice(meterType)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	res, err := device.QueryOp(client, op)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(res.String())
+}
+
 func read(cmd *cobra.Command, args []string) {
 	// log only fatal messages
 	configureLogger(viper.GetBool("verbose"), 0)
 
-	// arguments
-	register, length := parseArgs(args)
-
 	// flags
 	dev, _ := cmd.PersistentFlags().GetString("device")
 	typ, _ := cmd.PersistentFlags().GetString("type")
 	encoding, _ := cmd.PersistentFlags().GetString("encoding")
+
+	if measurement, _ := cmd.PersistentFlags().GetString("measurement"); measurement != "" {
+		if len(args) > 0 {
+			log.Fatal("Cannot combine measurement and register arguments")
+		}
+		readMeasurement(dev, measurement)
+		return
+	}
+
+	if fc, _ := cmd.PersistentFlags().GetInt("fc"); fc != 0 {
+		var ok bool
+		if typ, ok = funcCodes[fc]; !ok {
+			log.Fatalf("Invalid function code %d", fc)
+		}
+	}
+
+	// arguments
+	var register, length int
+	if len(args) == 2 {
+		register, length = parseArgs(args)
+	} else if cmd.PersistentFlags().Changed("register") {
+		register, length = parseRegisterFlags(cmd, encoding)
+	} else {
+		log.Fatal("Missing register. See -h for help.")
+	}
+
+	if sized, ok := sizedEncodings[strings.ToLower(encoding)]; ok {
+		if length != sized.length {
+			log.Fatalf("Invalid length %d for %s encoding", length, encoding)
+		}
+		encoding = sized.encoding
+	}
+
 	validateFlags(typ, encoding)
 
 	// parse modbus settings
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	tc := []struct {
+		encoding, hex, want string
+	}{
+		{"int16", "0001", "1"},
+		{"int16", "ffff", "-1"},
+		{"int16", "8000", "-32768"},
+		{"int32", "ffffffff", "-1"},
+		{"int32", "fffffffe", "-2"},
+		{"int32", "7fffffff", "2147483647"},
+		{"int64", "ffffffffffffffff", "-1"},
+		{"uint16", "ffff", "65535"},
+		{"uint32", "ffffffff", "4294967295"},
+		{"uint64", "ffffffffffffffff", "18446744073709551615"},
+		{"float32", "43660000", "230.000000"},
+		{"float32", "c3660000", "-230.000000"},
+		{"float64", "406cc00000000000", "230.000000"},
+		{"int32s", "ffffffff", "-1"},
+		{"uint32s", "00010000", "1"},
+		{"floats", "00004366", "230.000000"},
+		{"hex", "00ff", "00ff"},
+	}
+
+	for _, tc := range tc {
+		b, err := hex.DecodeString(tc.hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		encoding, length := tc.encoding, len(b)/2
+		if sized, ok := sizedEncodings[encoding]; ok {
+			encoding, length = sized.encoding, sized.length
+		}
+
+		if s := decode(b, length, encoding); s != tc.want {
+			t.Errorf("%s %s: expected %s, got %s", tc.encoding, tc.hex, tc.want, s)
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package cmd
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	mblog "github.com/volkszaehler/mbmd/log"
+	"github.com/volkszaehler/mbmd/meters"
+	"github.com/volkszaehler/mbmd/server"
+)
+
+// reloader applies configuration file changes to the running daemon
+type reloader struct {
+	mux         sync.Mutex
+	cmd         *cobra.Command
+	confHandler *DeviceConfigHandler
+	engine      *server.Engine
+	status      *server.Status
+	journal     *server.Journal
+	sinks       *sinks
+	logger      meters.Logger            // bus logger for added connections
+	tracer      *meters.TraceWriter      // traces added connections if not nil
+	recorder    *meters.RecordWriter     // records added connections if not nil
+	adapters    map[string]AdapterConfig // applied config file adapters by device
+	devices     bool                     // devices are created from the config file
+}
+
+// Reload re-reads the config file, adds and removes devices and restarts the sinks
+func (r *reloader) Reload() error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	var conf Config
+	if cfgFile != "" {
+		if err := viper.ReadInConfig(); err != nil {
+			return fmt.Errorf("failed reading config file %s: %v", cfgFile, err)
+		}
+
+		if err := unmarshalConfig(viper.GetViper(), &conf); err != nil {
+			return fmt.Errorf("failed parsing config file %s: %v", cfgFile, err)
+		}
+
+		if err := remainingKeys(r.cmd, conf.Other); err != nil {
+			return err
+		}
+	}
+
+	// validate sink configuration before applying any changes
+	start, err := r.sinks.prepare()
+	if err != nil {
+		return err
+	}
+
+	if r.devices {
+		if err := r.reloadDevices(conf); err != nil {
+			return err
+		}
+	}
+
+	r.engine.SetDefaultQueryOptions(queryOptions())
+
+	r.sinks.Stop()
+	start()
+
+	r.journal.Add(server.Event{Type: server.EventControl, Text: "configuration reloaded"})
+	log.Println("config: reloaded")
+
+	return nil
+}
+
+// reloadDevices adds new adapters, removes adapters no longer configured and applies
+// device changes. Connections of existing adapters are kept open and devices keep their
+// runtime status. Changed adapter parameters are rejected since they require re-opening
+// the connection. All adapters and devices are validated before any change is applied.
+func (r *reloader) reloadDevices(conf Config) (err error) {
+	h := r.confHandler
+
+	// create new adapters, devices are validated against them
+	added := make(map[string]meters.Connection)
+	limiters := make(map[string]*meters.Limiter)
+	var applied bool
+	defer func() {
+		if err != nil && !applied {
+			for name, conn := range added {
+				conn.Close()
+				delete(h.Managers, name)
+			}
+		}
+	}()
+
+	removed := make(map[string]bool)
+	for name := range r.adapters {
+		removed[name] = true
+	}
+
+	for _, a := range conf.Adapters {
+		delete(removed, a.Device)
+
+		if applied, ok := r.adapters[a.Device]; ok {
+			if !reflect.DeepEqual(applied, a) {
+				return fmt.Errorf("changing parameters of adapter %s requires a restart", a.Device)
+			}
+			continue
+		}
+		if _, ok := h.Managers[a.Device]; ok {
+			continue
+		}
+
+		conn, err := newConnection(a.Device, a.RTU, a.Baudrate, a.Comset)
+		if err != nil {
+			return err
+		}
+		conn.Logger(r.logger)
+		if a.Pause != nil {
+			setPause(conn, *a.Pause)
+		}
+		if err := setDirection(conn, a.Direction); err != nil {
+			return err
+		}
+		if r.tracer != nil {
+			if t, ok := conn.(meters.Traceable); ok {
+				t.Trace(r.tracer)
+			} else {
+				log.Printf("config: tracing not supported for %s", conn)
+			}
+		}
+		if r.recorder != nil && modbusAdapter(a.Device) {
+			conn = meters.NewRecorder(conn, r.recorder)
+		}
+		if limit := busLimit(map[string]float64{a.Device: a.Limit}, a.Device); limit > 0 {
+			limiter := meters.NewLimiter(conn, limit)
+			limiters[a.Device] = limiter
+			conn = limiter
+		}
+
+		added[a.Device] = conn
+		h.Managers[a.Device] = meters.NewManager(conn)
+	}
+
+	configured := make(map[string]AdapterConfig, len(conf.Adapters))
+	for _, a := range conf.Adapters {
+		configured[a.Device] = a
+	}
+
+	// validate all devices before applying changes
+	wanted := make(map[string]DeviceConfig)
+	devices := make(map[string]meters.Device)
+	for _, devConf := range conf.Devices {
+		devConf, dev, err := h.NewDevice(devConf)
+		if err != nil {
+			return err
+		}
+		if removed[devConf.Adapter] {
+			return fmt.Errorf("Missing adapter configuration for device %v", devConf)
+		}
+
+		key := devConf.Key()
+		wanted[key] = devConf
+		devices[key] = dev
+	}
+
+	// apply changes, new adapters use the engine's device managers
+	applied = true
+	for _, name := range sortedConnections(added) {
+		if err := r.engine.AddConnection(name, added[name]); err != nil {
+			mblog.Errorf("config: cannot add adapter %s: %v", name, err)
+			delete(h.Managers, name)
+			continue
+		}
+		h.Managers[name] = r.engine.Manager(name)
+		if limiter, ok := limiters[name]; ok {
+			r.status.AddLimiter(name, limiter)
+		}
+		r.adapters[name] = configured[name]
+	}
+
+	qe := r.engine.QueryEngine()
+
+	// forget devices removed at runtime, e.g. using the REST api, such that they are
+	// added again if still configured
+	for _, key := range sortedKeys(h.Devices) {
+		if qe.DeviceID(h.Devices[key]) == "" {
+			delete(h.Devices, key)
+		}
+	}
+
+	newDevices := make(map[string]meters.Device)
+	for key, dev := range devices {
+		if _, ok := h.Devices[key]; !ok {
+			newDevices[key] = dev
+		}
+	}
+
+	for _, key := range sortedKeys(h.Devices) {
+		dev := h.Devices[key]
+
+		if devConf, ok := wanted[key]; ok {
+			qe.SetLabels(dev, devConf.Labels())
+			qe.SetQueryOptions(dev, devConf.QueryOptions())
+			continue
+		}
+
+		id := qe.DeviceID(dev)
+		if err := r.engine.RemoveDevice(dev); err != nil {
+			mblog.Errorf("config: cannot remove device %s: %v", key, err)
+			continue
+		}
+
+		delete(h.Devices, key)
+		r.status.Remove(id)
+		log.Printf("config: removed device %s", id)
+	}
+
+	// close adapters removed from the config file once their devices have been removed
+	for _, name := range sortedAdapters(removed) {
+		if err := r.engine.RemoveConnection(name); err != nil {
+			mblog.Errorf("config: cannot remove adapter %s: %v", name, err)
+			continue
+		}
+
+		delete(h.Managers, name)
+		delete(r.adapters, name)
+		r.status.RemoveLimiter(name)
+		log.Printf("config: removed adapter %s", name)
+	}
+
+	for _, key := range sortedKeys(newDevices) {
+		devConf, dev := wanted[key], newDevices[key]
+
+		if err := r.engine.AddDevice(devConf.Adapter, devConf.ID, dev, devConf.Labels()); err != nil {
+			mblog.Errorf("config: cannot add device %s: %v", key, err)
+			continue
+		}
+
+		r.engine.SetQueryOptions(dev, devConf.QueryOptions())
+
+		h.Devices[key] = dev
+		log.Printf("config: added device %s", qe.DeviceID(dev))
+	}
+
+	return nil
+}
+
+// sortedConnections returns the sorted names of a connection map
+func sortedConnections(conns map[string]meters.Connection) []string {
+	names := make([]string, 0, len(conns))
+	for name := range conns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sortedAdapters returns the sorted names of an adapter set
+func sortedAdapters(adapters map[string]bool) []string {
+	names := make([]string, 0, len(adapters))
+	for name := range adapters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sortedKeys returns the sorted keys of a device map
+func sortedKeys(devices map[string]meters.Device) []string {
+	keys := make([]string, 0, len(devices))
+	for key := range devices {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -4,9 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/volkszaehler/mbmd/meters/rs485"
 )
 
 var cfgFile string
@@ -41,6 +43,8 @@ func init() {
 		"",
 		`Default MODBUS adapter. This option can be used if all devices are attached to a single adapter.
 Can be either an RTU device (/dev/ttyUSB0) or TCP socket (localhost:502).
+Use auto to detect the serial port the devices respond on, probing all comsets
+given as comma-separated list (8N1,8E1).
 The default adapter can be overridden per device`,
 	)
 	rootCmd.PersistentFlags().IntP(
@@ -51,7 +55,8 @@ The default adapter can be overridden per device`,
 	rootCmd.PersistentFlags().String(
 		"comset",
 		"8N1",
-		`Communication parameters for default adapter, either 8N1 or 8E1.
+		`Communication parameters for default adapter as <databits><parity><stopbits>, e.g. 8N1, 8E1 or 7O2.
+Parity is N(one), E(ven) or O(dd). The baud rate can be prefixed, e.g. 38400:8N2.
 Only applicable if the default adapter is an RTU device`,
 	)
 	rootCmd.PersistentFlags().Bool(
@@ -60,6 +65,12 @@ Only applicable if the default adapter is an RTU device`,
 		`Use RTU over TCP for default adapter.
 Typically used with RS485 to Ethernet adapters that don't perform protocol conversion (e.g. USR-TCP232).
 Only applicable if the default adapter is a TCP connection`,
+	)
+	rootCmd.PersistentFlags().StringSlice(
+		"register-maps",
+		[]string{},
+		`YAML register map files describing additional device types, glob patterns are allowed.
+  Example: --register-maps /etc/mbmd/meters/*.yaml`,
 	)
 	rootCmd.PersistentFlags().BoolP(
 		"help", "h",
@@ -117,4 +128,32 @@ func initConfig() {
 			os.Exit(1)
 		}
 	}
+
+	if err := loadRegisterMaps(viper.GetStringSlice("register-maps")); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+// loadRegisterMaps registers the device types of the register map files matching the patterns
+func loadRegisterMaps(patterns []string) error {
+	for _, pattern := range patterns {
+		files, err := filepath.Glob(pattern)
+		if err != nil {
+			return fmt.Errorf("config: invalid register map pattern %s: %v", pattern, err)
+		}
+		if len(files) == 0 {
+			return fmt.Errorf("config: register map %s not found", pattern)
+		}
+
+		for _, file := range files {
+			typ, err := rs485.LoadRegisterMap(file)
+			if err != nil {
+				return fmt.Errorf("config: invalid register map %v", err)
+			}
+			log.Printf("config: registered device type %s from %s", typ, file)
+		}
+	}
+
+	return nil
 }
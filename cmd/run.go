@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"context"
+	"fmt"
+	"io"
 	golog "log"
 	"os"
 	"os/signal"
@@ -13,11 +15,21 @@ import (
 	"github.com/spf13/viper"
 	latest "github.com/tcnksm/go-latest"
 
+	mblog "github.com/volkszaehler/mbmd/log"
+	"github.com/volkszaehler/mbmd/meters"
+	"github.com/volkszaehler/mbmd/meters/rs485"
 	"github.com/volkszaehler/mbmd/server"
 )
 
 const (
 	cacheDuration = 1 * time.Minute
+
+	// maximum time for draining queries and flushing sinks on shutdown
+	shutdownTimeout = 30 * time.Second
+
+	// log and bus traffic lines retained for diagnostics
+	diagLogLines = 500
+	diagBusLines = 200
 )
 
 // runCmd represents the run command
@@ -68,22 +80,360 @@ Valid types are:`+meterHelp()+`
 To use an adapter different from default, append RTU device or TCP address separated by @.
 If the adapter is a TCP connection (identified by :port), the device type (SUNS) is ignored and
 any type is considered valid.
-  Example: -d SDM:1@/dev/USB11 -d SMA:126@localhost:502`,
+  Example: -d SDM:1@/dev/USB11 -d SMA:126@localhost:502
+Optionally append a device name separated by #, used in the APIs and metrics.
+  Example: -d SDM:1@/dev/ttyUSB0#Heatpump`,
 	)
 	runCmd.PersistentFlags().DurationP(
 		"rate", "r",
 		time.Second,
 		"Rate limit. Devices will not be queried more often than rate limit.",
 	)
+	runCmd.PersistentFlags().Bool(
+		"serial-ids",
+		false,
+		"Identify devices by type and serial number where available, ex: SDM.123456, instead of adapter and slave id",
+	)
+	runCmd.PersistentFlags().Bool(
+		"auto-scan",
+		false,
+		"Scan the default adapter for devices on startup and query all detected devices in addition to the configured ones",
+	)
+	runCmd.PersistentFlags().String(
+		"auto-scan-ids",
+		"1-247",
+		"Device id range to scan with auto-scan as MIN-MAX or single id",
+	)
+	runCmd.PersistentFlags().StringSlice(
+		"auto-scan-types",
+		nil,
+		"Device types to probe with auto-scan, ex: SDM,DZG. Defaults to all RS485 types or SUNS for TCP adapters.",
+	)
+	runCmd.PersistentFlags().Duration(
+		"pause",
+		meters.DefaultPause,
+		"Pause between querying different device ids on the default RTU adapter. Use 0 for buses that don't need a pause.",
+	)
+	runCmd.PersistentFlags().Float64(
+		"bus-limit",
+		0,
+		"Maximum Modbus transactions per second per adapter. Transactions exceeding the limit are delayed. Use 0 for no limit.",
+	)
+	runCmd.PersistentFlags().Int(
+		"counter-interval",
+		rs485.LowPriorityInterval,
+		"Number of queries between reads of RS485 energy counters and operating hours. Use 1 to read them with every query.",
+	)
+	runCmd.PersistentFlags().Duration(
+		"adaptive-max",
+		0,
+		"Enable adaptive polling of RS485 devices. Near-constant measurements are read at most this often. Use 0 to disable.",
+	)
+	runCmd.PersistentFlags().Duration(
+		"adaptive-min",
+		0,
+		"Interval for reading changing measurements with adaptive polling. Defaults to rate.",
+	)
+	runCmd.PersistentFlags().Float64(
+		"adaptive-threshold",
+		0.01,
+		"Relative change below which measurements are considered constant with adaptive polling",
+	)
+	runCmd.PersistentFlags().Int(
+		"retries",
+		3,
+		"Query attempts before a device is considered offline",
+	)
+	runCmd.PersistentFlags().Duration(
+		"timeout",
+		0,
+		"Device response timeout. Defaults to 300ms for RTU and 1s for ASCII and TCP adapters.",
+	)
+	runCmd.PersistentFlags().Duration(
+		"retry-delay",
+		100*time.Millisecond,
+		"Delay before retrying a failed query, doubled with every retry",
+	)
+	runCmd.PersistentFlags().Duration(
+		"demand",
+		0,
+		"Interval for computing import and export power demand from energy counters, e.g. 15m. Disabled if 0.",
+	)
+	runCmd.PersistentFlags().String(
+		"log-level",
+		"info",
+		"Log level: debug, info, warn or error. Verbose mode implies debug.",
+	)
+	runCmd.PersistentFlags().String(
+		"log-format",
+		string(mblog.FormatText),
+		"Log format: text or json",
+	)
+	runCmd.PersistentFlags().String(
+		"log-file",
+		"",
+		"Log to file instead of stderr",
+	)
+	runCmd.PersistentFlags().Int(
+		"log-max-size",
+		10,
+		"Maximum log file size in MB before it is rotated. Use 0 for no size limit.",
+	)
+	runCmd.PersistentFlags().Duration(
+		"log-max-age",
+		0,
+		"Maximum log file age before it is rotated, e.g. 24h. Use 0 for no age limit.",
+	)
+	runCmd.PersistentFlags().Int(
+		"log-keep",
+		5,
+		"Number of rotated log files to keep",
+	)
 	runCmd.PersistentFlags().String(
 		"api",
 		"0.0.0.0:8080",
 		"REST API url. Use 127.0.0.1:8080 to limit to localhost.",
 	)
+	runCmd.PersistentFlags().String(
+		"api-base",
+		"",
+		"REST API and web UI base path when served under a sub-path by a reverse proxy. ex: /mbmd",
+	)
+	runCmd.PersistentFlags().Bool(
+		"api-proxy",
+		false,
+		"Trust X-Forwarded-* headers set by a reverse proxy",
+	)
+	runCmd.PersistentFlags().Bool(
+		"api-debug",
+		false,
+		"Expose pprof profiles at /debug/pprof and runtime variables at /debug/vars for diagnosing memory growth and goroutine leaks",
+	)
+	runCmd.PersistentFlags().String(
+		"api-timezone",
+		"UTC",
+		"Time zone of RFC3339 timestamps in REST and websocket API payloads: UTC, Local or a location, ex: Europe/Berlin",
+	)
+	runCmd.PersistentFlags().Duration(
+		"api-stale-after",
+		0,
+		"Flag current values older than this as outdated in REST and gRPC API responses (optional)",
+	)
+	runCmd.PersistentFlags().Bool(
+		"api-stale-omit",
+		false,
+		"Omit outdated values from REST and gRPC API responses instead of flagging them",
+	)
+	runCmd.PersistentFlags().String(
+		"trace",
+		"",
+		"Trace all modbus request and response frames with timestamps to file",
+	)
+	runCmd.PersistentFlags().String(
+		"trace-format",
+		"json",
+		"Trace format: json lines or annotated hex dumps decoding slave, function code, registers, checksum and values",
+	)
+	runCmd.PersistentFlags().String(
+		"record",
+		"",
+		"Record raw modbus read requests and responses of all adapters to file for replaying using the replay:<file>[?bus=<adapter>] adapter",
+	)
+	runCmd.PersistentFlags().Float64(
+		"replay-speed",
+		1,
+		"Replay speed relative to the recording when using the replay:<file> adapter. Use 0 for replaying without delay.",
+	)
+	runCmd.PersistentFlags().String(
+		"api-annotations",
+		"",
+		"File for persisting annotations created via REST API. Annotations are kept in memory only if empty.",
+	)
+	runCmd.PersistentFlags().String(
+		"api-events",
+		"",
+		"File for persisting the event journal (device availability, settings changes and alerts). Events are kept in memory only if empty.",
+	)
+	runCmd.PersistentFlags().Int(
+		"api-events-size",
+		10000,
+		"Maximum number of events kept in the event journal",
+	)
+	runCmd.PersistentFlags().String(
+		"state",
+		"",
+		"File for persisting the last energy readings across restarts. Restored readings are flagged as stale until the device has been queried.",
+	)
+	runCmd.PersistentFlags().Bool(
+		"state-all",
+		false,
+		"Persist all last values instead of energy readings only",
+	)
+	runCmd.PersistentFlags().String(
+		"modbus-listen",
+		"",
+		`Serve last readings via Modbus TCP at the given address (optional), ex: :502.
+Registers are mapped in the modbus section of the config file.`,
+	)
+	runCmd.PersistentFlags().String(
+		"modbus-gateway",
+		"",
+		`Forward Modbus TCP requests for units without mapped registers to the adapter (optional), ex: /dev/ttyUSB0.
+Requires --modbus-listen.`,
+	)
+	runCmd.PersistentFlags().Bool(
+		"gateway-writes",
+		false,
+		"Forward write requests received by the Modbus TCP gateway. Writes to protected registers of known device types are refused.",
+	)
+	runCmd.PersistentFlags().String(
+		"volkszaehler-url",
+		"",
+		`Push readings to a volkszaehler.org middleware (optional), ex: http://localhost/middleware.php.
+Channels are mapped in the volkszaehler section of the config file.`,
+	)
+	runCmd.PersistentFlags().String(
+		"pvoutput-apikey",
+		"",
+		"Upload generation and consumption status to PVOutput.org using the API key (optional)",
+	)
+	runCmd.PersistentFlags().String(
+		"pvoutput-systemid",
+		"",
+		"PVOutput.org system id",
+	)
+	runCmd.PersistentFlags().String(
+		"pvoutput-generation",
+		"",
+		"Device id or name of the generation meter or inverter uploaded to PVOutput.org, ex: SUNS1.1",
+	)
+	runCmd.PersistentFlags().String(
+		"pvoutput-consumption",
+		"",
+		"Device id or name of the consumption meter uploaded to PVOutput.org, ex: SDM1.1",
+	)
+	runCmd.PersistentFlags().Duration(
+		"pvoutput-interval",
+		5*time.Minute,
+		"PVOutput.org status interval of the system, 5m, 10m or 15m",
+	)
+	runCmd.PersistentFlags().String(
+		"emoncms-url",
+		"",
+		"Post readings to the input API of an EmonCMS server (optional), ex: http://localhost/emoncms",
+	)
+	runCmd.PersistentFlags().String(
+		"emoncms-apikey",
+		"",
+		"EmonCMS read & write API key",
+	)
+	runCmd.PersistentFlags().String(
+		"emoncms-nodes",
+		"",
+		`EmonCMS node names of devices (optional), ex: SDM1.1=house,garage=heatpump.
+Devices can be referenced by id or name. Other devices use their id as node name.`,
+	)
+	runCmd.PersistentFlags().String(
+		"emoncms-devices",
+		"",
+		"Devices to post to EmonCMS (optional). Same syntax as --mqtt-devices.",
+	)
+	runCmd.PersistentFlags().String(
+		"emoncms-units",
+		"",
+		"Unit conversions applied before posting to EmonCMS (optional). Same syntax as --mqtt-units.",
+	)
+	runCmd.PersistentFlags().StringSlice(
+		"webhook-url",
+		[]string{},
+		"Post device failure, recovery and error rate alerts as JSON to webhook URLs (optional), multiple URLs separated by comma",
+	)
+	runCmd.PersistentFlags().Float64(
+		"webhook-errorrate",
+		0,
+		"Alert when more than this percentage of a device's requests fail within the window (optional), 0 disables error rate alerts",
+	)
+	runCmd.PersistentFlags().Duration(
+		"webhook-window",
+		5*time.Minute,
+		"Window for calculating the error rate",
+	)
+	runCmd.PersistentFlags().String(
+		"webhook-devices",
+		"",
+		"Devices to send alerts for (optional). Same syntax as --mqtt-devices.",
+	)
+	runCmd.PersistentFlags().StringSlice(
+		"aggregate",
+		[]string{},
+		`Windows for aggregating minimum, maximum and mean of all measurements (optional).
+Aggregates are available via REST API at /api/aggregate/{window}.
+  Example: --aggregate 1m,15m,1h`,
+	)
+	runCmd.PersistentFlags().Bool(
+		"aggregate-publish",
+		false,
+		"Publish aggregates to MQTT and InfluxDB when a window closes",
+	)
+	runCmd.PersistentFlags().Duration(
+		"history",
+		0,
+		`Keep an in-memory history of all measurements for the given duration (optional).
+The history is available via REST API at /api/device/{id}/history.
+  Example: --history 24h`,
+	)
+	runCmd.PersistentFlags().Duration(
+		"history-resolution",
+		time.Minute,
+		"Resolution of the in-memory history, values are downsampled to minimum, maximum and mean per interval",
+	)
+	runCmd.PersistentFlags().Bool(
+		"api-write",
+		false,
+		`Allow writing device settings (POST /api/settings/{device}/{setting}), adding and
+removing devices (POST /api/devices, DELETE /api/devices/{device}) and reloading the configuration (POST /api/reload) via REST API`,
+	)
+	runCmd.PersistentFlags().String(
+		"tls-cert",
+		"",
+		"TLS certificate file for serving the REST API via https",
+	)
+	runCmd.PersistentFlags().String(
+		"tls-key",
+		"",
+		"TLS private key file for serving the REST API via https",
+	)
+	runCmd.PersistentFlags().Bool(
+		"tls-selfsigned",
+		false,
+		`Serve the REST API via https using a self-signed certificate.
+If certificate and key files are given and don't exist, the generated certificate is saved to these files.`,
+	)
+	runCmd.PersistentFlags().String(
+		"tls-clientca",
+		"",
+		"CA certificate file for verifying TLS client certificates. Enables authentication by client certificate common name.",
+	)
+	runCmd.PersistentFlags().String(
+		"api-auth-header",
+		"",
+		`Authenticate REST API requests by header set by an authenticating reverse proxy, e.g. X-Remote-User.
+The proxy must prevent clients from setting this header.`,
+	)
+	runCmd.PersistentFlags().StringSlice(
+		"api-auth-users",
+		[]string{},
+		"Restrict authenticated access to the given users or client certificate common names",
+	)
+	runCmd.PersistentFlags().String(
+		"grpc",
+		"",
+		"gRPC API address (optional), ex: 0.0.0.0:8081. Uses the REST API's TLS and authentication configuration.",
+	)
 	runCmd.PersistentFlags().StringP(
 		"mqtt-broker", "m",
 		"",
-		"MQTT broker URI. ex: tcp://10.10.1.1:1883",
+		"MQTT broker URI. ex: tcp://10.10.1.1:1883 or ssl://10.10.1.1:8883 for TLS",
 	)
 	runCmd.PersistentFlags().String(
 		"mqtt-topic",
@@ -105,6 +455,26 @@ any type is considered valid.
 		"mbmd",
 		"MQTT client id",
 	)
+	runCmd.PersistentFlags().String(
+		"mqtt-cacert",
+		"",
+		"MQTT CA certificate file for verifying the broker certificate (optional). Defaults to system roots.",
+	)
+	runCmd.PersistentFlags().String(
+		"mqtt-cert",
+		"",
+		"MQTT client certificate file for TLS client authentication (optional)",
+	)
+	runCmd.PersistentFlags().String(
+		"mqtt-key",
+		"",
+		"MQTT client certificate key file (optional)",
+	)
+	runCmd.PersistentFlags().Bool(
+		"mqtt-insecure",
+		false,
+		"Skip verifying the MQTT broker certificate",
+	)
 	runCmd.PersistentFlags().Int(
 		"mqtt-qos",
 		0,
@@ -115,6 +485,52 @@ any type is considered valid.
 		"homie",
 		"MQTT Homie IoT discovery base topic (homieiot.github.io). Set empty to disable.",
 	)
+	runCmd.PersistentFlags().String(
+		"mqtt-devices",
+		"",
+		`Devices to publish via MQTT (optional). Comma-separated list of device id or name patterns
+or tag patterns prefixed with tag:.
+  Example: --mqtt-devices garage*,tag:billing`,
+	)
+	runCmd.PersistentFlags().String(
+		"mqtt-units",
+		"",
+		`Unit conversions applied before publishing via MQTT (optional). Comma-separated list of
+source=target units. Supports metric prefixes (m, k, M) and temperatures (°C, K, °F).
+  Example: --mqtt-units W=kW,Wh=kWh`,
+	)
+	runCmd.PersistentFlags().String(
+		"mqtt-deadband",
+		"",
+		`Publish values via MQTT only if changed by more than the deadband (optional). Absolute change
+in the published unit or relative change in percent.
+  Example: --mqtt-deadband 1%`,
+	)
+	runCmd.PersistentFlags().Duration(
+		"mqtt-interval",
+		0,
+		"Maximum interval for publishing unchanged values via MQTT (optional). Without deadband unchanged values are suppressed.",
+	)
+	runCmd.PersistentFlags().Bool(
+		"mqtt-snapshots",
+		false,
+		"Publish the readings of each device polling cycle as single json message at <topic>/<device>/snapshot",
+	)
+	runCmd.PersistentFlags().Bool(
+		"mqtt-names",
+		false,
+		"Publish devices with configured name at <topic>/<name> instead of <topic>/<device>",
+	)
+	runCmd.PersistentFlags().Bool(
+		"mqtt-json",
+		false,
+		"Publish values as json including unit, description and timestamp instead of plain numbers",
+	)
+	runCmd.PersistentFlags().Bool(
+		"mqtt-obis",
+		false,
+		"Publish readings with OBIS code at <topic>/<device>/<obis code> instead of <topic>/<device>/<measurement>",
+	)
 	runCmd.PersistentFlags().StringP(
 		"influx-url", "i",
 		"",
@@ -150,17 +566,131 @@ any type is considered valid.
 		"",
 		"InfluxDB password (optional)",
 	)
+	runCmd.PersistentFlags().String(
+		"influx-devices",
+		"",
+		"Devices to write to InfluxDB (optional). Same syntax as --mqtt-devices.",
+	)
+	runCmd.PersistentFlags().String(
+		"influx-units",
+		"",
+		"Unit conversions applied before writing to InfluxDB (optional). Same syntax as --mqtt-units.",
+	)
+	runCmd.PersistentFlags().String(
+		"influx-policy",
+		string(server.InfluxQueue),
+		"Handling of readings while InfluxDB is unavailable: queue (up to --influx-queue readings) or drop",
+	)
+	runCmd.PersistentFlags().Int(
+		"influx-queue",
+		5000,
+		"Maximum number of readings queued while InfluxDB is unavailable. Oldest readings are dropped if exceeded.",
+	)
+
+	runCmd.PersistentFlags().String(
+		"udp-address",
+		"",
+		"Send readings as InfluxDB line protocol via UDP, e.g. to Telegraf's socket_listener. ex: 127.0.0.1:8094",
+	)
+	runCmd.PersistentFlags().String(
+		"udp-measurement",
+		"data",
+		"Line protocol measurement",
+	)
+	runCmd.PersistentFlags().String(
+		"udp-devices",
+		"",
+		"Devices to send via UDP (optional). Same syntax as --mqtt-devices.",
+	)
+	runCmd.PersistentFlags().String(
+		"udp-units",
+		"",
+		"Unit conversions applied before sending via UDP (optional). Same syntax as --mqtt-units.",
+	)
+
+	runCmd.PersistentFlags().String(
+		"statsd-address",
+		"",
+		"Send readings as StatsD gauges and device errors as counters via UDP. ex: 127.0.0.1:8125",
+	)
+	runCmd.PersistentFlags().String(
+		"statsd-prefix",
+		"mbmd",
+		"StatsD metric name prefix",
+	)
+	runCmd.PersistentFlags().Bool(
+		"statsd-tags",
+		false,
+		"Use DogStatsD device tags instead of device names in StatsD metric names",
+	)
+	runCmd.PersistentFlags().String(
+		"statsd-devices",
+		"",
+		"Devices to send via StatsD (optional). Same syntax as --mqtt-devices.",
+	)
+	runCmd.PersistentFlags().String(
+		"statsd-units",
+		"",
+		"Unit conversions applied before sending via StatsD (optional). Same syntax as --mqtt-units.",
+	)
 
 	pflags := runCmd.PersistentFlags()
 
 	// bind command line options to viper with exceptions
 	bindPflagsWithExceptions(pflags, "devices")
 
+	// tls
+	bindPFlagsWithPrefix(pflags, "tls", "cert", "key", "selfsigned", "clientca")
+
 	// mqtt
-	bindPFlagsWithPrefix(pflags, "mqtt", "broker", "topic", "user", "password", "clientid", "qos", "homie")
+	bindPFlagsWithPrefix(pflags, "mqtt", "broker", "topic", "user", "password", "clientid", "cacert", "cert", "key", "insecure", "qos", "homie", "devices", "units", "deadband", "interval", "snapshots", "names", "json", "obis")
+
+	// modbus
+	bindPFlagsWithPrefix(pflags, "modbus", "listen", "gateway")
+	bindPFlagsWithPrefix(pflags, "volkszaehler", "url")
+	bindPFlagsWithPrefix(pflags, "pvoutput", "apikey", "systemid", "generation", "consumption", "interval")
+	bindPFlagsWithPrefix(pflags, "emoncms", "url", "apikey", "nodes", "devices", "units")
+	bindPFlagsWithPrefix(pflags, "webhook", "url", "errorrate", "window", "devices")
+	bindPFlagsWithPrefix(pflags, "adaptive", "min", "max", "threshold")
 
 	// influx
-	bindPFlagsWithPrefix(pflags, "influx", "url", "database", "measurement", "organization", "token", "user", "password")
+	bindPFlagsWithPrefix(pflags, "influx", "url", "database", "measurement", "organization", "token", "user", "password", "devices", "units", "policy", "queue")
+
+	// udp
+	bindPFlagsWithPrefix(pflags, "udp", "address", "measurement", "devices", "units")
+
+	// statsd
+	bindPFlagsWithPrefix(pflags, "statsd", "address", "prefix", "tags", "devices", "units")
+}
+
+// apiTLSConfig returns the api's certificate configuration
+func apiTLSConfig() server.TLSConfig {
+	return server.TLSConfig{
+		CertFile:     viper.GetString("tls.cert"),
+		KeyFile:      viper.GetString("tls.key"),
+		SelfSigned:   viper.GetBool("tls.selfsigned"),
+		ClientCAFile: viper.GetString("tls.clientca"),
+	}
+}
+
+// openHABExport returns the MQTT sink's configuration for the openHAB export if MQTT is enabled
+func openHABExport() (*server.OpenHABExport, error) {
+	topic := viper.GetString("mqtt.topic")
+	if viper.GetString("mqtt.broker") == "" || topic == "" {
+		return nil, nil
+	}
+
+	units, err := server.NewUnitConverter(viper.GetString("mqtt.units"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid mqtt units: %v", err)
+	}
+
+	return &server.OpenHABExport{
+		Topic:    topic,
+		Selector: server.NewSelector(viper.GetString("mqtt.devices")),
+		Units:    units,
+		Names:    viper.GetBool("mqtt.names"),
+	}, nil
 }
 
 // checkVersion validates if updates are available
@@ -177,8 +707,39 @@ func checkVersion() {
 	}
 }
 
-// validate surplus config
-func validateRemainingKeys(cmd *cobra.Command, other map[string]interface{}) {
+// authenticator creates the REST API authenticator from configuration or nil if authentication is disabled
+func authenticator() server.Authenticator {
+	var auth server.Authenticators
+	if header := viper.GetString("api-auth-header"); header != "" {
+		auth = append(auth, server.HeaderAuthenticator{Header: header})
+	}
+	if viper.GetString("tls.clientca") != "" {
+		auth = append(auth, server.ClientCertAuthenticator{})
+	}
+
+	users := viper.GetStringSlice("api-auth-users")
+	if len(auth) == 0 {
+		if len(users) > 0 {
+			log.Fatal("config: api-auth-users requires api-auth-header or tls-clientca")
+		}
+		return nil
+	}
+
+	return server.Restrict(auth, users...)
+}
+
+// queryOptions returns the default query retries and timeouts from configuration
+func queryOptions() server.QueryOptions {
+	return server.QueryOptions{
+		Retries:    viper.GetInt("retries"),
+		Timeout:    viper.GetDuration("timeout"),
+		RetryDelay: viper.GetDuration("retry-delay"),
+		Demand:     viper.GetDuration("demand"),
+	}
+}
+
+// remainingKeys validates surplus config
+func remainingKeys(cmd *cobra.Command, other map[string]interface{}) error {
 	flags := cmd.PersistentFlags()
 
 	invalid := make([]string, 0)
@@ -189,11 +750,56 @@ func validateRemainingKeys(cmd *cobra.Command, other map[string]interface{}) {
 	}
 
 	if len(invalid) > 0 {
-		log.Fatalf("config: failed parsing config file %s - excess keys: %v", cfgFile, invalid)
+		return fmt.Errorf("failed parsing config file %s - excess keys: %v", cfgFile, invalid)
+	}
+
+	return nil
+}
+
+// validate surplus config
+func validateRemainingKeys(cmd *cobra.Command, other map[string]interface{}) {
+	if err := remainingKeys(cmd, other); err != nil {
+		log.Fatalf("config: %v", err)
 	}
 }
 
+// configureLeveledLogger configures the default leveled logger from the log flags and uses it for all output
+func configureLeveledLogger(out io.Writer) {
+	level, err := mblog.ParseLevel(viper.GetString("log-level"))
+	if err != nil {
+		log.Fatalf("config: %v", err)
+	}
+	if viper.GetBool("verbose") {
+		level = mblog.LevelDebug
+	}
+
+	format, err := mblog.ParseFormat(viper.GetString("log-format"))
+	if err != nil {
+		log.Fatalf("config: %v", err)
+	}
+
+	mblog.SetOutput(out)
+	mblog.SetLevel(level)
+	mblog.SetFormat(format)
+
+	log = mblog.Default()
+}
+
 func run(cmd *cobra.Command, args []string) {
+	// retain recent log output for diagnostics
+	logBuffer := server.NewRingLog(diagLogLines)
+	out := io.MultiWriter(os.Stderr, logBuffer)
+	if file := viper.GetString("log-file"); file != "" {
+		f, err := mblog.NewFile(file, int64(viper.GetInt("log-max-size"))<<20, viper.GetDuration("log-max-age"), viper.GetInt("log-keep"))
+		if err != nil {
+			log.Fatalf("config: failed opening log file: %v", err)
+		}
+		defer f.Close()
+		out = io.MultiWriter(f, logBuffer)
+	}
+	golog.SetOutput(out) // third party libraries
+	configureLeveledLogger(out)
+
 	log.Printf("mbmd %s (%s)", server.Version, server.Commit)
 	if len(args) > 0 {
 		log.Fatalf("excess arguments, aborting: %v", args)
@@ -206,7 +812,8 @@ func run(cmd *cobra.Command, args []string) {
 	defaultDevice := viper.GetString("adapter")
 	if defaultDevice != "" {
 		confHandler.DefaultDevice = defaultDevice
-		confHandler.ConnectionManager(defaultDevice, viper.GetBool("rtu"), viper.GetInt("baudrate"), viper.GetString("comset"))
+		m := confHandler.ConnectionManager(defaultDevice, viper.GetBool("rtu"), viper.GetInt("baudrate"), viper.GetString("comset"))
+		confHandler.setAdapterPause(m, viper.GetDuration("pause"))
 	}
 
 	// create devices from command line
@@ -217,12 +824,17 @@ func run(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	limits := make(map[string]float64) // transaction limits of config file adapters
+	var adapters []AdapterConfig       // config file adapters
+	var groups []GroupConfig
+	var hooks []server.HookConfig
+	var registers []ModbusRegisterConfig
 	if cfgFile != "" {
 		// config file found
 		log.Printf("config: using %s", viper.ConfigFileUsed())
 
 		var conf Config
-		if err := viper.UnmarshalExact(&conf); err != nil {
+		if err := unmarshalConfig(viper.GetViper(), &conf); err != nil {
 			log.Fatalf("config: failed parsing config file %s: %v", cfgFile, err)
 		}
 
@@ -233,106 +845,374 @@ func run(cmd *cobra.Command, args []string) {
 		if len(devices) == 0 {
 			// add adapters from configuration
 			for _, a := range conf.Adapters {
-				confHandler.ConnectionManager(a.Device, a.RTU, a.Baudrate, a.Comset)
+				m := confHandler.ConnectionManager(a.Device, a.RTU, a.Baudrate, a.Comset)
+				if a.Pause != nil {
+					confHandler.setAdapterPause(m, *a.Pause)
+				}
+				if err := setDirection(m.Conn, a.Direction); err != nil {
+					log.Fatal(err)
+				}
+				if a.Limit > 0 {
+					limits[a.Device] = a.Limit
+				}
 			}
+			adapters = conf.Adapters
 
 			// add devices from configuration
 			for _, dev := range conf.Devices {
 				confHandler.CreateDevice(dev)
 			}
 		}
+
+		groups = conf.Groups
+		hooks = conf.Hooks
+		registers = conf.Modbus.Registers
+	}
+
+	// detect serial port of the auto adapter, before scanning it
+	if err := confHandler.DetectAdapter(); err != nil {
+		log.Fatal(err)
+	}
+
+	// add devices detected on the default adapter
+	if viper.GetBool("auto-scan") {
+		if err := confHandler.ScanAdapter(defaultDevice, viper.GetString("auto-scan-ids"), viper.GetStringSlice("auto-scan-types")); err != nil {
+			log.Fatal(err)
+		}
 	}
 
 	if countDevices(confHandler.Managers) == 0 {
 		log.Fatal("config: no devices found - terminating")
 	}
 
-	// raw log
+	// low priority polling
+	if interval := viper.GetInt("counter-interval"); interval != rs485.LowPriorityInterval {
+		if err := counterInterval(confHandler.Managers, interval); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// adaptive polling
+	if interval := viper.GetDuration("adaptive.max"); interval > 0 {
+		conf := AdaptiveConfig{
+			Min:       viper.GetDuration("adaptive.min"),
+			Max:       interval,
+			Threshold: viper.GetFloat64("adaptive.threshold"),
+		}
+		if err := adaptivePolling(confHandler.Managers, conf, viper.GetDuration("rate")); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// trace bus frames
+	var tracer *meters.TraceWriter
+	if file := viper.GetString("trace"); file != "" {
+		newTraceWriter := meters.NewTraceWriter
+		switch format := viper.GetString("trace-format"); format {
+		case "json":
+		case "hex":
+			newTraceWriter = meters.NewHexTraceWriter
+		default:
+			log.Fatalf("config: invalid trace format %s", format)
+		}
+
+		f, err := os.Create(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+
+		tracer = newTraceWriter(f)
+
+		log.Printf("config: tracing to %s", file)
+		for _, m := range confHandler.Managers {
+			if t, ok := m.Conn.(meters.Traceable); ok {
+				t.Trace(tracer)
+			} else {
+				log.Printf("config: tracing not supported for %s", m.Conn)
+			}
+		}
+	}
+
+	// record bus traffic
+	var recorder *meters.RecordWriter
+	if file := viper.GetString("record"); file != "" {
+		f, err := os.Create(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+
+		log.Printf("config: recording to %s", file)
+		recorder = meters.NewRecordWriter(f)
+		for conn, m := range confHandler.Managers {
+			if !modbusAdapter(conn) {
+				continue
+			}
+			m.Conn = meters.NewRecorder(m.Conn, recorder)
+		}
+	}
+
+	// limit bus transactions
+	limiters := make(map[string]*meters.Limiter)
+	for conn, m := range confHandler.Managers {
+		if l := busLimit(limits, conn); l > 0 {
+			limiter := meters.NewLimiter(m.Conn, l)
+			m.Conn = limiter
+			limiters[conn] = limiter
+		}
+	}
+
+	// retain recent bus traffic for diagnostics, raw log
+	busBuffer := server.NewRingLog(diagBusLines)
+	var busLogger meters.Logger = busBuffer
 	if viper.GetBool("raw") {
-		setLogger(confHandler.Managers, golog.New(os.Stderr, "", golog.LstdFlags))
+		busLogger = golog.New(io.MultiWriter(os.Stderr, busBuffer), "", golog.LstdFlags)
 	}
+	setLogger(confHandler.Managers, busLogger)
 
-	// query engine
-	qe := server.NewQueryEngine(confHandler.Managers)
+	// engine
+	engine := server.NewEngine(server.EngineOptions{
+		Rate:      viper.GetDuration("rate"),
+		Query:     queryOptions(),
+		SerialIDs: viper.GetBool("serial-ids"),
+	})
+	for conn, m := range confHandler.Managers {
+		if err := engine.AddConnection(conn, m.Conn); err != nil {
+			log.Fatal(err)
+		}
+		m.All(func(id uint8, dev meters.Device) {
+			if err := engine.AddDevice(conn, id, dev, confHandler.Labels[dev]); err != nil {
+				log.Fatal(err)
+			}
+			if opts, ok := confHandler.Options[dev]; ok {
+				engine.SetQueryOptions(dev, opts)
+			}
+		})
+	}
 
-	// results- and control channels
-	rc := make(chan server.QuerySnip)
-	cc := make(chan server.ControlSnip)
+	// consistency groups
+	for _, g := range groups {
+		if err := engine.AddGroup(server.Group{Name: g.Name, Devices: g.Devices}); err != nil {
+			log.Fatalf("config: invalid group: %v", err)
+		}
+	}
 
-	// tee that broadcasts meter messages to multiple recipients
-	tee := server.NewBroadcaster(server.FromSnipChannel(rc))
-	go tee.Run()
+	qe := engine.QueryEngine()
 
-	// tee that broadcasts control messages to multiple recipients
-	teeC := server.NewBroadcaster(server.FromControlChannel(cc))
-	go teeC.Run()
+	// reading hooks
+	if len(hooks) > 0 {
+		h, err := server.NewHooks(hooks, qe)
+		if err != nil {
+			log.Fatalf("config: %v", err)
+		}
+		engine.SetHooks(h)
+	}
+
+	// context for graceful shutdown
+	ctx, cancel := context.WithCancel(context.Background())
 
 	// status cache (always needed to consume control messages)
-	status := server.NewStatus(qe, server.ToControlChannel(teeC.Attach()))
+	cc, _ := engine.ControlChannel()
+	status := server.NewStatus(qe, cc)
+	for conn, l := range limiters {
+		status.AddLimiter(conn, l)
+	}
+
+	// annotations
+	annotations, err := server.NewAnnotationStore(viper.GetString("api-annotations"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// event journal
+	journal, err := server.NewJournal(viper.GetString("api-events"), viper.GetInt("api-events-size"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	engine.SubscribeControl(journal.Run)
+	status.SubscribeSinks(journal.SinkChanged)
+
+	// aggregation windows
+	var aggregator *server.Aggregator
+	if specs := viper.GetStringSlice("aggregate"); len(specs) > 0 {
+		windows := make([]time.Duration, 0, len(specs))
+		for _, spec := range specs {
+			window, err := time.ParseDuration(spec)
+			if err != nil {
+				log.Fatalf("config: invalid aggregation window %s: %v", spec, err)
+			}
+			windows = append(windows, window)
+		}
+
+		if aggregator, err = server.NewAggregator(windows); err != nil {
+			log.Fatalf("config: %v", err)
+		}
+		engine.Subscribe(aggregator.Run)
+	}
+
+	// in-memory history for the REST api
+	var history *server.History
+	if retention := viper.GetDuration("history"); retention > 0 && viper.GetString("api") != "" {
+		if history, err = server.NewHistory(retention, viper.GetDuration("history-resolution")); err != nil {
+			log.Fatalf("config: %v", err)
+		}
+		engine.Subscribe(history.Run)
+	}
+
+	// modbus server
+	if addr := viper.GetString("modbus.listen"); addr != "" {
+		regs, err := modbusRegisters(registers)
+		if err != nil {
+			log.Fatalf("config: %v", err)
+		}
+		gateway := viper.GetString("modbus.gateway")
+		if len(regs) == 0 && gateway == "" {
+			log.Fatal("config: modbus server requires registers or gateway")
+		}
+
+		modbusServer, err := server.NewModbusServer(qe, regs)
+		if err != nil {
+			log.Fatalf("config: invalid modbus registers: %v", err)
+		}
+
+		if gateway != "" {
+			if _, ok := confHandler.Managers[gateway]; !ok || !modbusAdapter(gateway) {
+				log.Fatalf("config: invalid modbus gateway adapter %s", gateway)
+			}
+			writes := viper.GetBool("gateway-writes")
+			log.Printf("config: forwarding modbus requests for unmapped units to %s (writes: %v)", gateway, writes)
+			modbusServer.Gateway(qe, gateway, writes)
+		}
+		engine.Subscribe(modbusServer.Run)
+
+		go func() {
+			if err := modbusServer.ListenAndServe(ctx, addr); err != nil {
+				log.Fatal(err)
+			}
+		}()
+	}
+
+	// configuration reload
+	sinks := newSinks(engine, status, annotations, journal, aggregator)
+	reloader := &reloader{
+		cmd:         cmd,
+		confHandler: confHandler,
+		engine:      engine,
+		status:      status,
+		journal:     journal,
+		sinks:       sinks,
+		logger:      busLogger,
+		tracer:      tracer,
+		recorder:    recorder,
+		adapters:    make(map[string]AdapterConfig),
+		devices:     cfgFile != "" && len(devices) == 0,
+	}
+	for _, a := range adapters {
+		reloader.adapters[a.Device] = a
+	}
+
+	// measurement cache for REST and gRPC api
+	var cache *server.Cache
+	if viper.GetString("api") != "" || viper.GetString("grpc") != "" {
+		cache = server.NewCache(cacheDuration, status, viper.GetBool("verbose"))
+		cache.Staleness(viper.GetDuration("api-stale-after"), viper.GetBool("api-stale-omit"))
+		engine.Subscribe(cache.Run)
+	}
 
 	// web server
 	if viper.GetString("api") != "" {
-		// measurement cache for REST api
-		cache := server.NewCache(cacheDuration, status, viper.GetBool("verbose"))
-		tee.AttachRunner(server.NewSnipRunner(cache.Run))
+		loc, err := time.LoadLocation(viper.GetString("api-timezone"))
+		if err != nil {
+			log.Fatalf("config: invalid api timezone: %v", err)
+		}
+		server.SetTimezone(loc)
 
 		// websocket hub
 		hub := server.NewSocketHub(status)
-		tee.AttachRunner(server.NewSnipRunner(hub.Run))
+		engine.Subscribe(hub.Run)
 
 		// http daemon
-		httpd := server.NewHttpd(qe, cache)
-		go httpd.Run(hub, status, viper.GetString("api"))
-	}
-
-	// MQTT client
-	if viper.GetString("mqtt.broker") != "" {
-		qos := byte(viper.GetInt("mqtt.qos"))
-		verbose := viper.GetBool("verbose")
-
-		// default mqtt runner
-		if topic := viper.GetString("mqtt.topic"); topic != "" {
-			options := server.NewMqttOptions(
-				viper.GetString("mqtt.broker"),
-				viper.GetString("mqtt.user"),
-				viper.GetString("mqtt.password"),
-				viper.GetString("mqtt.clientid"),
-			)
-			mqttRunner := server.NewMqttRunner(options, qos, topic, verbose)
-			tee.AttachRunner(server.NewSnipRunner(mqttRunner.Run))
-		}
-
-		// homie runner
-		if topic := viper.GetString("mqtt.homie"); topic != "" {
-			options := server.NewMqttOptions(
-				viper.GetString("mqtt.broker"),
-				viper.GetString("mqtt.user"),
-				viper.GetString("mqtt.password"),
-				viper.GetString("mqtt.clientid"),
-			)
-			cc := server.ToControlChannel(teeC.Attach())
-			homieRunner := server.NewHomieRunner(qe, cc, options, qos, topic, verbose)
-			tee.AttachRunner(server.NewSnipRunner(homieRunner.Run))
-		}
-	}
-
-	// InfluxDB client
-	if viper.GetString("influx.url") != "" {
-		influx := server.NewInfluxClient(
-			viper.GetString("influx.url"),
-			viper.GetString("influx.database"),
-			viper.GetString("influx.measurement"),
-			viper.GetString("influx.organization"),
-			viper.GetString("influx.token"),
-			viper.GetString("influx.user"),
-			viper.GetString("influx.password"),
-		)
-
-		tee.AttachRunner(server.NewSnipRunner(influx.Run))
+		httpd := server.NewHttpd(qe, cache, qe.Snapshots(), annotations)
+		openHAB, err := openHABExport()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		conf := server.HttpdConfig{
+			URL:        viper.GetString("api"),
+			BasePath:   viper.GetString("api-base"),
+			TrustProxy: viper.GetBool("api-proxy"),
+			Debug:      viper.GetBool("api-debug"),
+			TLS:        apiTLSConfig(),
+			Auth:       authenticator(),
+			Events:     journal,
+			Aggregates: aggregator,
+			History:    history,
+			OpenHAB:    openHAB,
+			Metadata:   qe,
+			Supervisor: engine.Supervisor(),
+		}
+		if viper.GetBool("api-write") {
+			conf.Settings = qe
+			conf.Reload = reloader.Reload
+			conf.Devices = engine
+		}
+		conf.Diag = &server.Diagnostics{
+			Config: viper.AllSettings(),
+			Status: status,
+			Info:   qe,
+			Log:    logBuffer,
+			Bus:    busBuffer,
+		}
+		go func() {
+			if err := httpd.Run(ctx, hub, status, conf); err != nil {
+				log.Fatal(err)
+			}
+		}()
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
-	go qe.Run(ctx, viper.GetDuration("rate"), cc, rc)
+	// gRPC server
+	if addr := viper.GetString("grpc"); addr != "" {
+		grpcServer := server.NewGrpcServer(qe, cache)
+		engine.Subscribe(grpcServer.Run)
+
+		go func() {
+			if err := grpcServer.ListenAndServe(ctx, addr, apiTLSConfig(), authenticator()); err != nil {
+				log.Fatal(err)
+			}
+		}()
+	}
+
+	// MQTT and InfluxDB clients
+	if err := sinks.Start(); err != nil {
+		log.Fatalf("config: %v", err)
+	}
+
+	// last known values, restored after all runners have been subscribed
+	if file := viper.GetString("state"); file != "" {
+		state, err := server.NewStateStore(file, viper.GetBool("state-all"))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		engine.Restore(state.Snips())
+		engine.Subscribe(state.Run)
+	}
+
+	go engine.Run(ctx)
+
+	// reload configuration on SIGHUP
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			log.Println("received SIGHUP - reloading configuration")
+			if err := reloader.Reload(); err != nil {
+				mblog.Errorf("config: reload failed: %v", err)
+			}
+		}
+	}()
 
 	// wait for signal on exit channel and cancel context
 	exit := make(chan os.Signal, 1)
@@ -341,7 +1221,14 @@ func run(cmd *cobra.Command, args []string) {
 	log.Println("received signal - stopping")
 	cancel()
 
-	// wait for Run methods attached to tee to finish
-	<-tee.Done()
+	// wait for in-flight queries to complete and subscribed runners to finish
+	select {
+	case <-engine.Done():
+	case <-exit:
+		log.Fatal("received second signal - aborting")
+	case <-time.After(shutdownTimeout):
+		log.Fatal("shutdown timeout - aborting")
+	}
+
 	log.Println("stopped")
 }
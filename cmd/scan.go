@@ -1,14 +1,23 @@
 package cmd
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	golog "log"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/grid-x/modbus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
 
 	"github.com/volkszaehler/mbmd/meters"
 	"github.com/volkszaehler/mbmd/meters/rs485"
@@ -19,18 +28,236 @@ import (
 var scanCmd = &cobra.Command{
 	Use:   "scan",
 	Short: "Scan for attached devices",
-	Long: `Scan loops over all device ids from 1 to 254 and tries to
-read a common value depending on device type.
+	Long: `Scan loops over all device ids from 1 to 247, or the range given by --ids,
+and tries to read a common value depending on device type.
 For RTU devices the common value is most likely the L1 voltage,
 for TCP devices it tries to read the SunSpec common block.
 If successful the detected device type and device id are displayed.
+Where supported by device and connection, vendor, product code and
+revision are read using the Read Device Identification function.
+Devices responding without a known probe value are listed as unknown
+devices if they can be identified.
 
-Scan will ignore the config file and requires adapter configuration using command line.`,
+Scan will ignore the config file and requires adapter configuration using command line.
+
+Using --format yaml the detected devices are written as adapters and devices sections
+that can be pasted into the config file. Using --format json the results are written
+including model, serial number and probe value for further processing.
+
+Using --comsets the bus is scanned with each of the given communication parameters
+and the comset each device answered on is reported.`,
 	Run: scan,
 }
 
 func init() {
 	rootCmd.AddCommand(scanCmd)
+
+	scanCmd.PersistentFlags().StringP(
+		"format", "f",
+		"text",
+		"Output format: text, json or yaml. Structured output is written to stdout unless --output is given.",
+	)
+	scanCmd.PersistentFlags().StringP(
+		"output", "o",
+		"",
+		"Output file for json or yaml results",
+	)
+	scanCmd.PersistentFlags().String(
+		"ids",
+		"1-247",
+		"Device id range to scan as MIN-MAX or single id",
+	)
+	scanCmd.PersistentFlags().StringSlice(
+		"types",
+		nil,
+		"Device types to probe, ex: SDM,DZG. Defaults to all RS485 types or SUNS for TCP adapters.",
+	)
+	scanCmd.PersistentFlags().StringSlice(
+		"comsets",
+		nil,
+		`Detect the communication parameters of serial devices by scanning with each of the comsets,
+ex: 9600:8N1,9600:8E1,19200:8N1. Comsets without baud rate use --baudrate. Use auto for common
+baud rates from 2400 to 115200 with 8N1, 8E1 and 8O1.`,
+	)
+	scanCmd.PersistentFlags().Int(
+		"parallel",
+		scanParallel,
+		"Concurrent connections for scanning TCP adapters. Serial and RTU over TCP adapters are scanned sequentially.",
+	)
+}
+
+// scanProbe is the value read for detecting a device
+type scanProbe struct {
+	Measurement string  `json:"measurement"`
+	Value       float64 `json:"value"`
+}
+
+// scanResult describes a detected device. Unknown devices have no type and probe value.
+type scanResult struct {
+	ID             uint8                        `json:"id"`
+	Type           string                       `json:"type,omitempty"`
+	Manufacturer   string                       `json:"manufacturer,omitempty"`
+	Model          string                       `json:"model,omitempty"`
+	Version        string                       `json:"version,omitempty"`
+	Serial         string                       `json:"serial,omitempty"`
+	Probe          *scanProbe                   `json:"probe,omitempty"`
+	Identification *meters.DeviceIdentification `json:"identification,omitempty"`
+	Comset         string                       `json:"comset,omitempty"` // baudrate and comset the device answered on with comset detection
+}
+
+// scanAdapter is the adapter configuration used for scanning
+type scanAdapter struct {
+	Device   string `json:"device" yaml:"device"`
+	RTU      bool   `json:"rtu,omitempty" yaml:"rtu,omitempty"`
+	Baudrate int    `json:"baudrate,omitempty" yaml:"baudrate,omitempty"`
+	Comset   string `json:"comset,omitempty" yaml:"comset,omitempty"`
+}
+
+// scanDevice is a detected device's configuration
+type scanDevice struct {
+	Type    string `yaml:"type"`
+	ID      uint8  `yaml:"id"`
+	Adapter string `yaml:"adapter"`
+}
+
+// scanReport is the result of a bus scan
+type scanReport struct {
+	Adapter scanAdapter  `json:"adapter"`
+	Devices []scanResult `json:"devices"`
+}
+
+// newScanAdapter returns the adapter configuration from the command line. With comset
+// detection, the comset most devices answered on is used.
+func newScanAdapter(adapter string, results []scanResult) scanAdapter {
+	res := scanAdapter{
+		Device: adapter,
+		RTU:    viper.GetBool("rtu"),
+	}
+
+	// serial parameters only apply to serial connections
+	if tcp, _ := regexp.MatchString(":[0-9]+$", adapter); !tcp {
+		res.Baudrate = viper.GetInt("baudrate")
+		res.Comset = viper.GetString("comset")
+		res.RTU = false
+	}
+
+	counts := make(map[string]int)
+	var comset string
+	for _, r := range results {
+		if r.Comset == "" {
+			continue
+		}
+		if counts[r.Comset]++; counts[r.Comset] > counts[comset] {
+			comset = r.Comset
+		}
+	}
+
+	if cs, err := meters.ParseComset(comset, 0); err == nil {
+		res.Baudrate = cs.Baudrate
+		res.Comset = fmt.Sprintf("%d%s%d", cs.DataBits, cs.Parity, cs.StopBits)
+	}
+
+	return res
+}
+
+// writeJSON writes the scan report as JSON
+func (r scanReport) writeJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(r)
+}
+
+// writeYAML writes the scan report as config file adapters and devices sections.
+// Device details are written as comments since the config file does not accept them.
+func (r scanReport) writeYAML(w io.Writer) error {
+	b, err := yaml.Marshal(map[string][]scanAdapter{
+		"adapters": {r.Adapter},
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(r.Devices) == 0 {
+		b = append(b, "devices: []\n"...)
+	} else {
+		b = append(b, "devices:\n"...)
+	}
+
+	for _, res := range r.Devices {
+		// unknown devices can't be configured
+		if res.Type == "" {
+			details := []string{fmt.Sprintf("unknown device %d", res.ID)}
+			details = append(details, identificationDetails(res.Identification)...)
+			b = append(b, fmt.Sprintf("  # %s\n", strings.Join(details, ", "))...)
+			continue
+		}
+
+		dev, err := yaml.Marshal([]scanDevice{{
+			Type:    res.Type,
+			ID:      res.ID,
+			Adapter: r.Adapter.Device,
+		}})
+		if err != nil {
+			return err
+		}
+		b = append(b, dev...)
+
+		var details []string
+		addDetail := func(key, val string) {
+			if val != "" {
+				details = append(details, fmt.Sprintf("%s: %s", key, val))
+			}
+		}
+		addDetail("manufacturer", res.Manufacturer)
+		addDetail("model", res.Model)
+		addDetail("version", res.Version)
+		addDetail("serial", res.Serial)
+		addDetail("comset", res.Comset)
+		details = append(details, identificationDetails(res.Identification)...)
+		addDetail(res.Probe.Measurement, fmt.Sprintf("%.2f", res.Probe.Value))
+
+		b = append(b, fmt.Sprintf("  # %s\n", strings.Join(details, ", "))...)
+	}
+
+	_, err = w.Write(b)
+	return err
+}
+
+// identificationDetails returns the identification's non-empty fields as key: value pairs
+func identificationDetails(id *meters.DeviceIdentification) []string {
+	if id == nil {
+		return nil
+	}
+
+	var details []string
+	for _, kv := range [][2]string{
+		{"vendor", id.Vendor},
+		{"product code", id.ProductCode},
+		{"revision", id.Revision},
+	} {
+		if kv[1] != "" {
+			details = append(details, fmt.Sprintf("%s: %s", kv[0], kv[1]))
+		}
+	}
+
+	return details
+}
+
+// identify reads the device identification if supported by the connection
+func identify(conn meters.Connection, deviceID int) *meters.DeviceIdentification {
+	identifier, ok := conn.(meters.DeviceIdentifier)
+	if !ok {
+		return nil
+	}
+
+	id, err := identifier.ReadDeviceIdentification()
+	if err != nil || id == (meters.DeviceIdentification{}) {
+		return nil
+	}
+
+	log.Printf("device %d: identified as %s", deviceID, strings.Join(identificationDetails(&id), ", "))
+	return &id
 }
 
 func addDesc(s *string, key string, val string) {
@@ -62,95 +289,407 @@ func scan(cmd *cobra.Command, args []string) {
 		log.Fatalf("excess arguments, aborting: %v", args)
 	}
 
-	// create connection
+	format, _ := cmd.PersistentFlags().GetString("format")
+	if format = strings.ToLower(format); format != "text" && format != "json" && format != "yaml" {
+		log.Fatalf("invalid format %s", format)
+	}
+	output, _ := cmd.PersistentFlags().GetString("output")
+
 	adapter := viper.GetString("adapter")
 	if adapter == "" {
 		log.Fatal("missing adapter configuration")
 	}
 
-	conn := createConnection(adapter, viper.GetBool("rtu"), viper.GetInt("baudrate"), viper.GetString("comset"))
-	client := conn.ModbusClient()
+	ids, _ := cmd.PersistentFlags().GetString("ids")
+	types, _ := cmd.PersistentFlags().GetStringSlice("types")
+	sc, err := newScanConfig(ids, types)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if sc.parallel, _ = cmd.PersistentFlags().GetInt("parallel"); sc.parallel < 1 {
+		log.Fatalf("invalid parallel scans %d", sc.parallel)
+	}
+
+	flags, _ := cmd.PersistentFlags().GetStringSlice("comsets")
+	comsets, err := scanComsets(flags, viper.GetInt("baudrate"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var results []scanResult
+	if len(comsets) > 0 {
+		results = scanSerial(adapter, comsets, sc)
+	} else {
+		conn := createConnection(adapter, viper.GetBool("rtu"), viper.GetInt("baudrate"), viper.GetString("comset"))
+		prepareScan(conn)
+
+		log.Printf("starting bus scan on %s", adapter)
+		results = scanBus(conn, sc)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].ID < results[j].ID
+	})
+
+	log.Printf("found %d active devices:\r\n", len(results))
+	for _, res := range results {
+		s := ""
+		addDesc(&s, "Model", res.Model)
+		addDesc(&s, "Version", res.Version)
+		addDesc(&s, "Serial", res.Serial)
+		addDesc(&s, "Comset", res.Comset)
+		if id := res.Identification; id != nil {
+			addDesc(&s, "Vendor", id.Vendor)
+			addDesc(&s, "Product", id.ProductCode)
+			addDesc(&s, "Revision", id.Revision)
+		}
+
+		if s != "" {
+			s = fmt.Sprintf("(%s)", s)
+		}
+
+		manufacturer := res.Manufacturer
+		if res.Type == "" {
+			manufacturer = "unknown"
+		}
+
+		log.Printf(
+			"* #%d type %s %s",
+			res.ID,
+			manufacturer,
+			s,
+		)
+	}
+
+	log.Println("WARNING: This lists only the devices that responded to " +
+		"a known probe request. Devices with different " +
+		"function code definitions might not be detected.")
+
+	if format != "text" {
+		writeScanReport(scanReport{
+			Adapter: newScanAdapter(adapter, results),
+			Devices: results,
+		}, format, output)
+	}
+}
 
-	// raw log
+// scanParallel is the default number of concurrent connections for scanning TCP adapters
+const scanParallel = 4
+
+// scanConfig restricts the device ids and types probed by a bus scan
+type scanConfig struct {
+	min, max uint8
+	types    []string       // all types if empty
+	skip     map[uint8]bool // ids not to be scanned, e.g. configured devices
+	parallel int            // concurrent connections for TCP adapters
+}
+
+// newScanConfig parses the device id range MIN-MAX or a single id and validates the device types
+func newScanConfig(ids string, types []string) (scanConfig, error) {
+	res := scanConfig{min: 1, max: 247, parallel: scanParallel}
+
+	for _, t := range types {
+		if t = strings.ToUpper(strings.TrimSpace(t)); t == "" {
+			continue
+		}
+		if _, ok := rs485.Producers[t]; !ok && t != "SUNS" {
+			return res, fmt.Errorf("invalid scan type %s", t)
+		}
+		res.types = append(res.types, t)
+	}
+
+	if ids = strings.TrimSpace(ids); ids == "" {
+		return res, nil
+	}
+
+	bounds := strings.SplitN(ids, "-", 2)
+	if len(bounds) == 1 {
+		bounds = append(bounds, bounds[0])
+	}
+
+	for i, b := range bounds {
+		id, err := strconv.ParseUint(strings.TrimSpace(b), 10, 8)
+		if err != nil || id < 1 || id > 247 {
+			return res, fmt.Errorf("invalid scan id range %s", ids)
+		}
+		if i == 0 {
+			res.min = uint8(id)
+		} else {
+			res.max = uint8(id)
+		}
+	}
+
+	if res.min > res.max {
+		return res, fmt.Errorf("invalid scan id range %s", ids)
+	}
+
+	return res, nil
+}
+
+// devices returns the devices probed on the connection. Without configured types these are
+// SunSpec for TCP connections and all RS485 devices sorted by type otherwise.
+func (sc scanConfig) devices(conn meters.Connection) []meters.Device {
+	types := sc.types
+	if len(types) == 0 {
+		if _, ok := conn.(*meters.TCP); ok {
+			types = []string{"SUNS"}
+		} else {
+			for t := range rs485.Producers {
+				types = append(types, t)
+			}
+			sort.Strings(types)
+		}
+	}
+
+	devices := make([]meters.Device, 0, len(types))
+	for _, t := range types {
+		if t == "SUNS" {
+			devices = append(devices, sunspec.NewDevice(t))
+			continue
+		}
+
+		dev, err := rs485.NewDevice(t)
+		if err != nil {
+			log.Fatal(err)
+		}
+		devices = append(devices, dev)
+	}
+
+	return devices
+}
+
+// prepareScan enables raw logging and disables the connection's pause since the scan pauses between device ids itself
+func prepareScan(conn meters.Connection) {
 	if viper.GetBool("raw") {
 		conn.Logger(golog.New(os.Stderr, "", golog.LstdFlags))
 	}
 
-	// create devices
-	devices := make([]meters.Device, 0)
-	if _, ok := conn.(*meters.TCP); ok {
-		suns := sunspec.NewDevice("SUNS")
-		devices = append(devices, suns)
-	} else {
-		for t := range rs485.Producers {
-			dev, err := rs485.NewDevice(t)
-			if err != nil {
-				log.Fatal(err)
+	if p, ok := conn.(meters.Pausable); ok {
+		p.Pause(0)
+	}
+}
+
+// scanComsets parses the comsets for comset detection using the default baudrate, auto selects
+// common baudrates with 8N1, 8E1 and 8O1. The comsets are returned including their baudrate, ex: 9600:8N1.
+func scanComsets(flags []string, baudrate int) ([]string, error) {
+	var res []string
+	for _, c := range flags {
+		if c = strings.TrimSpace(c); strings.EqualFold(c, "auto") {
+			for _, b := range []int{9600, 19200, 2400, 4800, 38400, 57600, 115200} {
+				for _, comset := range []string{"8N1", "8E1", "8O1"} {
+					res = append(res, fmt.Sprintf("%d:%s", b, comset))
+				}
 			}
-			devices = append(devices, dev)
+			continue
 		}
+
+		cs, err := meters.ParseComset(c, baudrate)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, fmt.Sprintf("%d:%d%s%d", cs.Baudrate, cs.DataBits, cs.Parity, cs.StopBits))
 	}
 
-	deviceList := make(map[int]meters.Device)
-	log.Printf("starting bus scan on %s", adapter)
+	return res, nil
+}
 
-	// validate against 110V and 230V to make detection reliable
-	v := validator{[]float64{110, 230}}
+// scanSerial scans the serial adapter once per comset. Devices found are not scanned again with
+// the remaining comsets and report the comset they answered on.
+func scanSerial(adapter string, comsets []string, sc scanConfig) []scanResult {
+	switch tcp, _ := regexp.MatchString(":[0-9]+$", adapter); {
+	case tcp, !modbusAdapter(adapter), adapter == "mock", adapter == "sim", adapter == "host", strings.HasPrefix(adapter, "replay:"):
+		log.Fatal("comset detection requires a serial adapter")
+	}
+
+	skip := make(map[uint8]bool)
+	for id := range sc.skip {
+		skip[id] = true
+	}
+	sc.skip = skip
+
+	results := make([]scanResult, 0)
+	for _, comset := range comsets {
+		conn, err := meters.NewSerial(adapter, 0, comset)
+		if err != nil {
+			log.Fatal(err)
+		}
+		prepareScan(conn)
+
+		log.Printf("starting bus scan on %s (%s)", adapter, comset)
+		for _, res := range scanBus(conn, sc) {
+			res.Comset = comset
+			results = append(results, res)
+			sc.skip[res.ID] = true
+		}
+
+		conn.Close()
+	}
+
+	return results
+}
+
+// scanBus loops over the configured slave addresses except the skipped ones and returns the detected devices
+func scanBus(conn meters.Connection, sc scanConfig) []scanResult {
+	if tcp, ok := conn.(*meters.TCP); ok && sc.parallel > 1 {
+		return scanTCP(tcp, sc)
+	}
+
+	devices := sc.devices(conn)
+	results := make([]scanResult, 0)
+
+	for deviceID := int(sc.min); deviceID <= int(sc.max); deviceID++ {
+		if sc.skip[uint8(deviceID)] {
+			continue
+		}
 
-SCAN:
-	// loop over all valid slave addresses
-	for deviceID := 1; deviceID <= 247; deviceID++ {
 		// give the bus some time to recover before querying the next device
 		time.Sleep(40 * time.Millisecond)
-		conn.Slave(uint8(deviceID))
 
-		for _, dev := range devices {
-			if err := dev.Initialize(client); err != nil {
-				if !errors.Is(err, meters.ErrPartiallyOpened) {
-					continue // devices
+		if res, ok := probeID(conn, devices, uint8(deviceID)); ok {
+			results = append(results, res)
+		}
+	}
+
+	return results
+}
+
+// scanTCP probes the configured slave addresses concurrently using separate connections.
+// Unlike serial buses, TCP gateways don't suffer from collisions.
+func scanTCP(conn *meters.TCP, sc scanConfig) []scanResult {
+	ids := make(chan uint8)
+	go func() {
+		for deviceID := int(sc.min); deviceID <= int(sc.max); deviceID++ {
+			if !sc.skip[uint8(deviceID)] {
+				ids <- uint8(deviceID)
+			}
+		}
+		close(ids)
+	}()
+
+	var mux sync.Mutex
+	var wg sync.WaitGroup
+	results := make([]scanResult, 0)
+
+	for i := 0; i < sc.parallel; i++ {
+		// the first worker uses the existing connection
+		var c meters.Connection = conn
+		if i > 0 {
+			c = meters.NewTCP(conn.String())
+			c.Timeout(conn.Handler.Timeout)
+			if conn.Handler.Logger != nil {
+				c.Logger(conn.Handler.Logger)
+			}
+		}
+
+		wg.Add(1)
+		go func(c meters.Connection, owned bool) {
+			defer wg.Done()
+			if owned {
+				defer c.Close()
+			}
+
+			devices := sc.devices(c)
+			for id := range ids {
+				if res, ok := probeID(c, devices, id); ok {
+					mux.Lock()
+					results = append(results, res)
+					mux.Unlock()
 				}
-				log.Println(err) // log error but continue
 			}
+		}(c, i > 0)
+	}
+
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].ID < results[j].ID
+	})
+
+	return results
+}
+
+// probeID probes the device id with all devices and returns the detected device. Devices
+// returning modbus exceptions or values not matching the validator are present but unknown.
+func probeID(conn meters.Connection, devices []meters.Device, deviceID uint8) (scanResult, bool) {
+	conn.Slave(deviceID)
+	client := conn.ModbusClient()
 
-			mr, err := dev.Probe(client)
-			if err == nil && v.check(mr.Value) {
-				log.Printf("device %d: %s type device found, %s: %.2f\r\n",
-					deviceID,
-					dev.Descriptor().Manufacturer,
-					mr.Measurement,
-					mr.Value,
-				)
-
-				deviceList[deviceID] = dev
-				continue SCAN
+	// validate against 110V and 230V to make detection reliable
+	v := validator{[]float64{110, 230}}
+
+	var responded bool
+	for _, dev := range devices {
+		if err := dev.Initialize(client); err != nil {
+			if !errors.Is(err, meters.ErrPartiallyOpened) {
+				continue // devices
 			}
+			log.Println(err) // log error but continue
 		}
 
-		log.Printf("device %d: n/a\r\n", deviceID)
+		mr, err := dev.Probe(client)
+		var mbErr *modbus.Error
+		if err == nil || errors.As(err, &mbErr) {
+			responded = true
+		}
+
+		if err == nil && v.check(mr.Value) {
+			log.Printf("device %d: %s type device found, %s: %.2f\r\n",
+				deviceID,
+				dev.Descriptor().Manufacturer,
+				mr.Measurement,
+				mr.Value,
+			)
+
+			desc := dev.Descriptor()
+			return scanResult{
+				ID:           deviceID,
+				Type:         desc.Type,
+				Manufacturer: desc.Manufacturer,
+				Model:        desc.Model,
+				Version:      desc.Version,
+				Serial:       desc.Serial,
+				Probe: &scanProbe{
+					Measurement: mr.Measurement.String(),
+					Value:       mr.Value,
+				},
+				Identification: identify(conn, int(deviceID)),
+			}, true
+		}
 	}
 
-	log.Printf("found %d active devices:\r\n", len(deviceList))
-	for deviceID, dev := range deviceList {
-		desc := dev.Descriptor()
+	if responded {
+		if id := identify(conn, int(deviceID)); id != nil {
+			return scanResult{
+				ID:             deviceID,
+				Identification: id,
+			}, true
+		}
+	}
 
-		s := ""
-		addDesc(&s, "Model", desc.Model)
-		addDesc(&s, "Version", desc.Version)
-		addDesc(&s, "Serial", desc.Serial)
+	log.Printf("device %d: n/a\r\n", deviceID)
+	return scanResult{}, false
+}
 
-		if s != "" {
-			s = fmt.Sprintf("(%s)", s)
+// writeScanReport writes the scan report in the given format to stdout or file
+func writeScanReport(report scanReport, format, output string) {
+	var w io.Writer = os.Stdout
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			log.Fatal(err)
 		}
+		defer f.Close()
+		w = f
+	}
 
-		log.Printf(
-			"* #%d type %s %s",
-			deviceID,
-			desc.Manufacturer,
-			s,
-		)
+	var err error
+	if format == "json" {
+		err = report.writeJSON(w)
+	} else {
+		err = report.writeYAML(w)
 	}
 
-	log.Println("WARNING: This lists only the devices that responded to " +
-		"a known probe request. Devices with different " +
-		"function code definitions might not be detected.")
+	if err != nil {
+		log.Fatal(err)
+	}
 }
@@ -0,0 +1,415 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/volkszaehler/mbmd/meters"
+	"github.com/volkszaehler/mbmd/server"
+)
+
+func TestNewScanConfig(t *testing.T) {
+	tc := []struct {
+		ids      string
+		types    []string
+		min, max uint8
+		resTypes []string
+		err      bool
+	}{
+		{"", nil, 1, 247, nil, false},
+		{"1-247", nil, 1, 247, nil, false},
+		{"10-20", nil, 10, 20, nil, false},
+		{" 10 - 20 ", nil, 10, 20, nil, false},
+		{"5", nil, 5, 5, nil, false},
+		{"5-5", nil, 5, 5, nil, false},
+		{"1-10", []string{"sdm", " suns ", ""}, 1, 10, []string{"SDM", "SUNS"}, false},
+		{"0-10", nil, 0, 0, nil, true},
+		{"1-248", nil, 0, 0, nil, true},
+		{"1-256", nil, 0, 0, nil, true},
+		{"20-10", nil, 0, 0, nil, true},
+		{"1-", nil, 0, 0, nil, true},
+		{"-10", nil, 0, 0, nil, true},
+		{"1-2-3", nil, 0, 0, nil, true},
+		{"a", nil, 0, 0, nil, true},
+		{"1-10", []string{"foo"}, 0, 0, nil, true},
+	}
+
+	for _, tc := range tc {
+		sc, err := newScanConfig(tc.ids, tc.types)
+		if tc.err {
+			if err == nil {
+				t.Errorf("%q: expected error, got %d-%d", tc.ids, sc.min, sc.max)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q: %v", tc.ids, err)
+			continue
+		}
+
+		if sc.min != tc.min || sc.max != tc.max {
+			t.Errorf("%q: expected %d-%d, got %d-%d", tc.ids, tc.min, tc.max, sc.min, sc.max)
+		}
+		if !reflect.DeepEqual(sc.types, tc.resTypes) {
+			t.Errorf("%q: expected types %v, got %v", tc.ids, tc.resTypes, sc.types)
+		}
+		if sc.parallel != scanParallel {
+			t.Errorf("%q: expected %d parallel scans, got %d", tc.ids, scanParallel, sc.parallel)
+		}
+	}
+}
+
+func TestScanComsets(t *testing.T) {
+	auto, err := scanComsets([]string{"auto"}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(auto) != 21 || auto[0] != "9600:8N1" || auto[20] != "115200:8O1" {
+		t.Errorf("unexpected auto comsets %v", auto)
+	}
+
+	tc := []struct {
+		flags    []string
+		baudrate int
+		res      []string
+	}{
+		{nil, 9600, nil},
+		{[]string{"8N1", "19200:8e1", " 2400:8O1 "}, 9600, []string{"9600:8N1", "19200:8E1", "2400:8O1"}},
+		{[]string{"9600:8N1", "Auto"}, 0, append([]string{"9600:8N1"}, auto...)},
+		{[]string{"8N1"}, 0, nil},
+		{[]string{"9600:9N1"}, 9600, nil},
+		{[]string{"9600"}, 9600, nil},
+	}
+
+	for _, tc := range tc {
+		res, err := scanComsets(tc.flags, tc.baudrate)
+		if tc.res == nil && len(tc.flags) > 0 {
+			if err == nil {
+				t.Errorf("%v: expected error, got %v", tc.flags, res)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%v: %v", tc.flags, err)
+		} else if !reflect.DeepEqual(res, tc.res) {
+			t.Errorf("%v: expected %v, got %v", tc.flags, tc.res, res)
+		}
+	}
+}
+
+// scanClient responds with 230V to all register reads of the present slaves
+type scanClient struct {
+	*meters.MockClient
+	slave   *uint8
+	present map[uint8]bool
+}
+
+func (c *scanClient) read(quantity uint16) ([]byte, error) {
+	if !c.present[*c.slave] {
+		return nil, errors.New("timeout")
+	}
+
+	b := make([]byte, 2*quantity)
+	for i := 0; i+4 <= len(b); i += 4 {
+		binary.BigEndian.PutUint32(b[i:], math.Float32bits(230))
+	}
+	return b, nil
+}
+
+func (c *scanClient) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
+	return c.read(quantity)
+}
+
+func (c *scanClient) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	return c.read(quantity)
+}
+
+// scanConn is a mock connection passing the slave id to its client
+type scanConn struct {
+	*meters.Mock
+	slave uint8
+}
+
+func (c *scanConn) Slave(deviceID uint8) {
+	c.slave = deviceID
+}
+
+func newScanConn(present ...uint8) *scanConn {
+	conn := &scanConn{}
+	client := &scanClient{
+		MockClient: meters.NewMockClient(0),
+		slave:      &conn.slave,
+		present:    make(map[uint8]bool),
+	}
+	for _, id := range present {
+		client.present[id] = true
+	}
+	conn.Mock = &meters.Mock{Client: client}
+	return conn
+}
+
+func TestScanBus(t *testing.T) {
+	sc, err := newScanConfig("1-6", []string{"SDM"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn := newScanConn(2, 3, 5)
+	results := scanBus(conn, sc)
+
+	if len(results) != 3 || results[0].ID != 2 || results[1].ID != 3 || results[2].ID != 5 {
+		t.Fatalf("unexpected results %+v", results)
+	}
+	if r := results[0]; r.Type != "SDM" || r.Probe == nil || r.Probe.Value != 230 {
+		t.Errorf("unexpected result %+v", r)
+	}
+
+	// devices found with a previous comset are skipped
+	sc.skip = map[uint8]bool{2: true, 5: true}
+	if results := scanBus(conn, sc); len(results) != 1 || results[0].ID != 3 {
+		t.Errorf("unexpected results %+v", results)
+	}
+}
+
+// scanDevices provides device info for the scanned modbus server
+type scanDevices struct{}
+
+func (scanDevices) DeviceDescriptorByID(id string) meters.DeviceDescriptor {
+	return meters.DeviceDescriptor{}
+}
+
+func (scanDevices) DeviceLabelsByID(id string) server.Labels {
+	return server.Labels{}
+}
+
+func TestScanTCP(t *testing.T) {
+	present := []uint8{2, 3, 7}
+
+	var registers []server.ModbusRegister
+	for _, id := range present {
+		registers = append(registers, server.ModbusRegister{Unit: id, Address: 0, Device: fmt.Sprintf("SDM1.%d", id), Measurement: meters.VoltageL1})
+	}
+
+	s, err := server.NewModbusServer(scanDevices{}, registers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in := make(chan server.QuerySnip, len(present))
+	for _, id := range present {
+		in <- server.QuerySnip{
+			Device:            fmt.Sprintf("SDM1.%d", id),
+			MeasurementResult: meters.MeasurementResult{Measurement: meters.VoltageL1, Value: 230},
+		}
+	}
+	close(in)
+	s.Run(in)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = s.Serve(ctx, l) }()
+
+	sc, err := newScanConfig("1-10", []string{"SDM"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sc.parallel = 3
+	sc.skip = map[uint8]bool{3: true}
+
+	conn := meters.NewTCP(l.Addr().String())
+	conn.Timeout(time.Second)
+	defer conn.Close()
+
+	// skipped ids are not probed, results are sorted by id
+	results := scanBus(conn, sc)
+	if len(results) != 2 || results[0].ID != 2 || results[1].ID != 7 {
+		t.Fatalf("unexpected results %+v", results)
+	}
+	if r := results[1]; r.Type != "SDM" || r.Probe == nil || r.Probe.Value != 230 {
+		t.Errorf("unexpected result %+v", r)
+	}
+}
+
+func TestNewScanAdapter(t *testing.T) {
+	for key, val := range map[string]interface{}{"rtu": true, "baudrate": 9600, "comset": "8N1"} {
+		defer viper.Set(key, viper.Get(key))
+		viper.Set(key, val)
+	}
+
+	tc := []struct {
+		adapter string
+		results []scanResult
+		res     scanAdapter
+	}{
+		{"localhost:502", nil, scanAdapter{Device: "localhost:502", RTU: true}},
+		{"/dev/ttyUSB0", nil, scanAdapter{Device: "/dev/ttyUSB0", Baudrate: 9600, Comset: "8N1"}},
+		// the comset most devices answered on is used
+		{"/dev/ttyUSB0", []scanResult{
+			{ID: 1, Comset: "19200:8E1"},
+			{ID: 2, Comset: "2400:8N1"},
+			{ID: 3, Comset: "19200:8E1"},
+			{ID: 4},
+		}, scanAdapter{Device: "/dev/ttyUSB0", Baudrate: 19200, Comset: "8E1"}},
+		{"/dev/ttyUSB0", []scanResult{{ID: 1}}, scanAdapter{Device: "/dev/ttyUSB0", Baudrate: 9600, Comset: "8N1"}},
+	}
+
+	for i, tc := range tc {
+		if res := newScanAdapter(tc.adapter, tc.results); res != tc.res {
+			t.Errorf("%d: expected %+v, got %+v", i, tc.res, res)
+		}
+	}
+}
+
+const scanYAMLGolden = `adapters:
+- device: /dev/ttyUSB0
+  baudrate: 9600
+  comset: 8N1
+devices:
+- type: SDM
+  id: 1
+  adapter: /dev/ttyUSB0
+  # manufacturer: SDM, model: Eastron SDM630, serial: 123456, comset: 9600:8N1, VoltageL1: 231.50
+  # unknown device 2, vendor: ACME, product code: X1, revision: 1.0
+- type: DZG
+  id: 3
+  adapter: /dev/ttyUSB0
+  # manufacturer: DZG, vendor: DZG, VoltageL1: 229.00
+`
+
+const scanJSONGolden = `{
+  "adapter": {
+    "device": "/dev/ttyUSB0",
+    "baudrate": 9600,
+    "comset": "8N1"
+  },
+  "devices": [
+    {
+      "id": 1,
+      "type": "SDM",
+      "manufacturer": "SDM",
+      "model": "Eastron SDM630",
+      "serial": "123456",
+      "probe": {
+        "measurement": "VoltageL1",
+        "value": 231.5
+      },
+      "comset": "9600:8N1"
+    },
+    {
+      "id": 2,
+      "identification": {
+        "vendor": "ACME",
+        "productCode": "X1",
+        "revision": "1.0"
+      }
+    },
+    {
+      "id": 3,
+      "type": "DZG",
+      "manufacturer": "DZG",
+      "probe": {
+        "measurement": "VoltageL1",
+        "value": 229
+      },
+      "identification": {
+        "vendor": "DZG"
+      }
+    }
+  ]
+}
+`
+
+func TestScanReport(t *testing.T) {
+	report := scanReport{
+		Adapter: scanAdapter{Device: "/dev/ttyUSB0", Baudrate: 9600, Comset: "8N1"},
+		Devices: []scanResult{
+			{ID: 1, Type: "SDM", Manufacturer: "SDM", Model: "Eastron SDM630", Serial: "123456", Comset: "9600:8N1", Probe: &scanProbe{Measurement: "VoltageL1", Value: 231.5}},
+			{ID: 2, Identification: &meters.DeviceIdentification{Vendor: "ACME", ProductCode: "X1", Revision: "1.0"}},
+			{ID: 3, Type: "DZG", Manufacturer: "DZG", Probe: &scanProbe{Measurement: "VoltageL1", Value: 229}, Identification: &meters.DeviceIdentification{Vendor: "DZG"}},
+		},
+	}
+
+	var b bytes.Buffer
+	if err := report.writeYAML(&b); err != nil {
+		t.Fatal(err)
+	}
+	if s := b.String(); s != scanYAMLGolden {
+		t.Errorf("unexpected yaml output:\n%s", s)
+	}
+
+	// yaml output is accepted by the config loader
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	var conf Config
+	if err := unmarshalConfig(v, &conf); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(conf.Other) > 0 {
+		t.Errorf("unexpected config keys %v", conf.Other)
+	}
+	if expected := []AdapterConfig{{Device: "/dev/ttyUSB0", Baudrate: 9600, Comset: "8N1"}}; !reflect.DeepEqual(conf.Adapters, expected) {
+		t.Errorf("expected adapters %+v, got %+v", expected, conf.Adapters)
+	}
+	if expected := []DeviceConfig{
+		{Type: "SDM", ID: 1, Adapter: "/dev/ttyUSB0"},
+		{Type: "DZG", ID: 3, Adapter: "/dev/ttyUSB0"},
+	}; !reflect.DeepEqual(conf.Devices, expected) {
+		t.Errorf("expected devices %+v, got %+v", expected, conf.Devices)
+	}
+
+	b.Reset()
+	if err := report.writeJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+	if s := b.String(); s != scanJSONGolden {
+		t.Errorf("unexpected json output:\n%s", s)
+	}
+
+	var res scanReport
+	if err := json.Unmarshal(b.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(res, report) {
+		t.Errorf("expected %+v, got %+v", report, res)
+	}
+
+	// reports without devices are accepted as well
+	b.Reset()
+	if err := (scanReport{Adapter: report.Adapter}).writeYAML(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	v = viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	conf = Config{}
+	if err := unmarshalConfig(v, &conf); err != nil || len(conf.Adapters) != 1 || len(conf.Devices) != 0 {
+		t.Errorf("unexpected config %+v: %v", conf, err)
+	}
+}
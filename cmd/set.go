@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/volkszaehler/mbmd/meters/rs485"
+)
+
+// setCmd represents the set command
+var setCmd = &cobra.Command{
+	Use:   "set [flags] " + strings.Join([]string{"setting", "value"}, " "),
+	Short: "Write device setting (EXPERIMENTAL)",
+	Long: `Set writes a device configuration setting like baud rate, parity or slave address
+using the device type's register definitions. Without arguments the device type's
+supported settings are listed. Set will ignore the config file and requires adapter
+configuration using command line.`,
+	Example: `  mbmd set -a /dev/ttyUSB0 -d SDM:1
+  mbmd set -a /dev/ttyUSB0 -d SDM:1 baudrate 19200`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 && len(args) != 2 {
+			return fmt.Errorf("expected setting and value, got %d arguments", len(args))
+		}
+		return nil
+	},
+	Run: set,
+}
+
+func init() {
+	rootCmd.AddCommand(setCmd)
+
+	setCmd.PersistentFlags().StringP(
+		"device", "d",
+		"",
+		"MODBUS device type and ID to write, e.g. SDM:1. Only single device allowed.",
+	)
+}
+
+func set(cmd *cobra.Command, args []string) {
+	// log only fatal messages
+	configureLogger(viper.GetBool("verbose"), 0)
+
+	dev, _ := cmd.PersistentFlags().GetString("device")
+	meterType := deviceTypeFromSpec(dev)
+	if meterType == "" {
+		log.Fatal("Missing device type. See -h for help.")
+	}
+
+	factory, ok := rs485.Producers[strings.ToUpper(meterType)]
+	if !ok {
+		log.Fatalf("Device type %s does not support settings", meterType)
+	}
+
+	// list settings
+	if len(args) == 0 {
+		c, ok := factory().(rs485.Configurator)
+		if !ok || len(c.Settings()) == 0 {
+			log.Fatalf("Device type %s does not support settings", meterType)
+		}
+
+		for _, s := range c.Settings() {
+			fmt.Printf("%-10s %s\n", s.Name, s.Description)
+		}
+		return
+	}
+
+	value, err := strconv.ParseFloat(args[1], 64)
+	if err != nil {
+		log.Fatalf("Invalid value %s: %v", args[1], err)
+	}
+
+	// parse modbus settings
+	conn, client := modbusClient()
+	conn.Slave(deviceIDFromSpec(dev))
+
+	device, err := rs485.NewDevice(strings.ToUpper(meterType))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := device.WriteSetting(client, args[0], value); err != nil {
+		log.Fatal(err)
+	}
+}
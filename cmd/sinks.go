@@ -0,0 +1,383 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+	"github.com/volkszaehler/mbmd/server"
+)
+
+// sinks are the configured MQTT, Homie, InfluxDB, UDP, StatsD, volkszaehler, PVOutput, EmonCMS, webhook and rule sinks. They can be
+// restarted to apply configuration changes.
+type sinks struct {
+	engine      *server.Engine
+	status      *server.Status
+	annotations *server.AnnotationStore
+	journal     *server.Journal
+	aggregator  *server.Aggregator // optional
+	stop        []func()
+}
+
+func newSinks(engine *server.Engine, status *server.Status, annotations *server.AnnotationStore, journal *server.Journal, aggregator *server.Aggregator) *sinks {
+	return &sinks{
+		engine:      engine,
+		status:      status,
+		annotations: annotations,
+		journal:     journal,
+		aggregator:  aggregator,
+	}
+}
+
+// subscribeAggregates subscribes the sink to closed aggregation windows if publishing is enabled.
+// The returned function removes the subscription.
+func (s *sinks) subscribeAggregates(selector server.Selector, units server.UnitConverter, f func(server.AggregateWindow)) func() {
+	if s.aggregator == nil || !viper.GetBool("aggregate-publish") {
+		return func() {}
+	}
+
+	return s.aggregator.Subscribe(server.NewAggregateSubscriber(selector, s.engine.QueryEngine(), units, f))
+}
+
+// filterControl wraps the control runner for receiving the selected devices' status only
+func filterControl(selector server.Selector, qe server.DeviceInfo, run func(<-chan server.ControlSnip)) func(<-chan server.ControlSnip) {
+	return func(in <-chan server.ControlSnip) {
+		filtered := make(chan server.ControlSnip)
+		go func() {
+			for snip := range in {
+				if selector.Match(snip.Device, qe.DeviceLabelsByID(snip.Device)) {
+					filtered <- snip
+				}
+			}
+			close(filtered)
+		}()
+		run(filtered)
+	}
+}
+
+// prepare validates the sink configuration and returns a function for starting the sinks
+func (s *sinks) prepare() (func(), error) {
+	qe := s.engine.QueryEngine()
+	verbose := viper.GetBool("verbose")
+
+	var starters []func()
+
+	// MQTT client
+	if viper.GetString("mqtt.broker") != "" {
+		qos := byte(viper.GetInt("mqtt.qos"))
+		selector := server.NewSelector(viper.GetString("mqtt.devices"))
+		units, err := server.NewUnitConverter(viper.GetString("mqtt.units"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid mqtt units: %v", err)
+		}
+		deadband, err := server.NewDeadband(viper.GetString("mqtt.deadband"), viper.GetDuration("mqtt.interval"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid mqtt deadband: %v", err)
+		}
+		tlsConfig, err := server.MqttTLSConfig{
+			CAFile:   viper.GetString("mqtt.cacert"),
+			CertFile: viper.GetString("mqtt.cert"),
+			KeyFile:  viper.GetString("mqtt.key"),
+			Insecure: viper.GetBool("mqtt.insecure"),
+		}.Config()
+		if err != nil {
+			return nil, fmt.Errorf("invalid mqtt tls config: %v", err)
+		}
+
+		// default mqtt runner
+		if topic := viper.GetString("mqtt.topic"); topic != "" {
+			starters = append(starters, func() {
+				options := server.NewMqttOptions(
+					viper.GetString("mqtt.broker"),
+					viper.GetString("mqtt.user"),
+					viper.GetString("mqtt.password"),
+					viper.GetString("mqtt.clientid"),
+					tlsConfig,
+				)
+				mqttRunner := server.NewMqttRunner(options, qos, topic, verbose)
+				if viper.GetBool("mqtt.names") {
+					mqttRunner.Names(qe)
+				}
+				mqttRunner.Units(units)
+				if viper.GetBool("mqtt.json") {
+					mqttRunner.JSON()
+				}
+				if viper.GetBool("mqtt.obis") {
+					mqttRunner.OBIS()
+				}
+				unaggregate := s.subscribeAggregates(selector, units, mqttRunner.Aggregate)
+				unsnapshot := func() {}
+				if viper.GetBool("mqtt.snapshots") {
+					unsnapshot = s.engine.SubscribeNamed("mqtt snapshots", server.NewSelectorRunner(selector, qe, server.NewUnitRunner(units, mqttRunner.Snapshots)))
+				}
+				unsubscribe := s.engine.SubscribeNamed("mqtt", server.NewSelectorRunner(selector, qe, server.NewUnitRunner(units, server.NewDeadbandRunner(deadband, mqttRunner.Run))))
+
+				s.stop = append(s.stop, func() {
+					unaggregate()
+					unsnapshot()
+					unsubscribe()
+				})
+			})
+		}
+
+		// homie runner
+		if topic := viper.GetString("mqtt.homie"); topic != "" {
+			starters = append(starters, func() {
+				options := server.NewMqttOptions(
+					viper.GetString("mqtt.broker"),
+					viper.GetString("mqtt.user"),
+					viper.GetString("mqtt.password"),
+					viper.GetString("mqtt.clientid"),
+					tlsConfig,
+				)
+				cc, detach := s.engine.ControlChannel()
+				homieRunner := server.NewHomieRunner(qe, cc, options, qos, topic, units, verbose)
+				unsubscribe := s.engine.SubscribeNamed("homie", server.NewSelectorRunner(selector, qe, server.NewUnitRunner(units, server.NewDeadbandRunner(deadband, homieRunner.Run))))
+
+				// detach control channel first to not block status updates while the runner stops
+				s.stop = append(s.stop, func() {
+					detach()
+					unsubscribe()
+				})
+			})
+		}
+	}
+
+	// InfluxDB client
+	if viper.GetString("influx.url") != "" {
+		influx, err := server.NewInfluxClient(
+			viper.GetString("influx.url"),
+			viper.GetString("influx.database"),
+			viper.GetString("influx.measurement"),
+			viper.GetString("influx.organization"),
+			viper.GetString("influx.token"),
+			viper.GetString("influx.user"),
+			viper.GetString("influx.password"),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		policy := server.InfluxPolicy(viper.GetString("influx.policy"))
+		if err := influx.Degradation(policy, viper.GetInt("influx.queue"), s.status); err != nil {
+			return nil, err
+		}
+
+		selector := server.NewSelector(viper.GetString("influx.devices"))
+		units, err := server.NewUnitConverter(viper.GetString("influx.units"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid influx units: %v", err)
+		}
+
+		starters = append(starters, func() {
+			unsubscribe := s.engine.SubscribeNamed("influx", server.NewSelectorRunner(selector, qe, server.NewUnitRunner(units, influx.Run)))
+
+			// store annotations and aggregates alongside measurements
+			unannotate := s.annotations.Subscribe(influx.Annotate)
+			unaggregate := s.subscribeAggregates(selector, units, influx.Aggregate)
+
+			s.stop = append(s.stop, func() {
+				unaggregate()
+				unannotate()
+				unsubscribe()
+				s.status.RemoveSink("influx")
+			})
+		})
+	}
+
+	// line protocol via UDP
+	if addr := viper.GetString("udp.address"); addr != "" {
+		selector := server.NewSelector(viper.GetString("udp.devices"))
+		units, err := server.NewUnitConverter(viper.GetString("udp.units"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid udp units: %v", err)
+		}
+
+		udp, err := server.NewLineProtocolUDP(addr, viper.GetString("udp.measurement"))
+		if err != nil {
+			return nil, err
+		}
+
+		starters = append(starters, func() {
+			unsubscribe := s.engine.SubscribeNamed("udp", server.NewSelectorRunner(selector, qe, server.NewUnitRunner(units, udp.Run)))
+			s.stop = append(s.stop, unsubscribe)
+		})
+	}
+
+	// statsd
+	if addr := viper.GetString("statsd.address"); addr != "" {
+		selector := server.NewSelector(viper.GetString("statsd.devices"))
+		units, err := server.NewUnitConverter(viper.GetString("statsd.units"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid statsd units: %v", err)
+		}
+
+		statsd, err := server.NewStatsD(addr, viper.GetString("statsd.prefix"), viper.GetBool("statsd.tags"))
+		if err != nil {
+			return nil, err
+		}
+
+		starters = append(starters, func() {
+			unsubscribe := s.engine.SubscribeNamed("statsd", server.NewSelectorRunner(selector, qe, server.NewUnitRunner(units, statsd.Run)))
+			uncontrol := s.engine.SubscribeControlNamed("statsd control", filterControl(selector, qe, statsd.Control))
+
+			s.stop = append(s.stop, func() {
+				uncontrol()
+				unsubscribe()
+				_ = statsd.Close()
+			})
+		})
+	}
+
+	// volkszaehler middleware
+	if url := viper.GetString("volkszaehler.url"); url != "" {
+		var conf []VolkszaehlerChannelConfig
+		if err := viper.UnmarshalKey("volkszaehler.channels", &conf); err != nil {
+			return nil, fmt.Errorf("invalid volkszaehler channels: %v", err)
+		}
+
+		channels, err := volkszaehlerChannels(conf)
+		if err != nil {
+			return nil, err
+		}
+
+		vz, err := server.NewVolkszaehler(url, qe, channels)
+		if err != nil {
+			return nil, err
+		}
+
+		starters = append(starters, func() {
+			unsubscribe := s.engine.Subscribe(vz.Run)
+			s.stop = append(s.stop, unsubscribe)
+		})
+	}
+
+	// pvoutput
+	if apiKey := viper.GetString("pvoutput.apikey"); apiKey != "" {
+		pvoutput, err := server.NewPVOutput(server.PVOutputConfig{
+			APIKey:      apiKey,
+			SystemID:    viper.GetString("pvoutput.systemid"),
+			Generation:  viper.GetString("pvoutput.generation"),
+			Consumption: viper.GetString("pvoutput.consumption"),
+			Interval:    viper.GetDuration("pvoutput.interval"),
+		}, qe)
+		if err != nil {
+			return nil, err
+		}
+
+		starters = append(starters, func() {
+			unsubscribe := s.engine.Subscribe(pvoutput.Run)
+			s.stop = append(s.stop, unsubscribe)
+		})
+	}
+
+	// emoncms
+	if url := viper.GetString("emoncms.url"); url != "" {
+		selector := server.NewSelector(viper.GetString("emoncms.devices"))
+		units, err := server.NewUnitConverter(viper.GetString("emoncms.units"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid emoncms units: %v", err)
+		}
+
+		emoncms, err := server.NewEmonCMS(url, viper.GetString("emoncms.apikey"), viper.GetString("emoncms.nodes"), qe)
+		if err != nil {
+			return nil, err
+		}
+
+		starters = append(starters, func() {
+			unsubscribe := s.engine.SubscribeNamed("emoncms", server.NewSelectorRunner(selector, qe, server.NewUnitRunner(units, emoncms.Run)))
+			s.stop = append(s.stop, unsubscribe)
+		})
+	}
+
+	// webhooks
+	if urls := viper.GetStringSlice("webhook.url"); len(urls) > 0 {
+		selector := server.NewSelector(viper.GetString("webhook.devices"))
+
+		webhook, err := server.NewWebhook(urls, viper.GetFloat64("webhook.errorrate"), viper.GetDuration("webhook.window"), qe)
+		if err != nil {
+			return nil, err
+		}
+
+		starters = append(starters, func() {
+			unsubscribe := s.engine.SubscribeControlNamed("webhook", filterControl(selector, qe, webhook.Run))
+			s.stop = append(s.stop, unsubscribe)
+		})
+	}
+
+	// limit rules
+	var ruleConf []RuleConfig
+	if err := viper.UnmarshalKey("rules", &ruleConf); err != nil {
+		return nil, fmt.Errorf("invalid rules: %v", err)
+	}
+	if len(ruleConf) > 0 {
+		rules, err := limitRules(ruleConf, viper.GetString("mqtt.broker") != "")
+		if err != nil {
+			return nil, err
+		}
+
+		supervisor, err := server.NewRules(rules, qe, s.journal)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig, err := server.MqttTLSConfig{
+			CAFile:   viper.GetString("mqtt.cacert"),
+			CertFile: viper.GetString("mqtt.cert"),
+			KeyFile:  viper.GetString("mqtt.key"),
+			Insecure: viper.GetBool("mqtt.insecure"),
+		}.Config()
+		if err != nil {
+			return nil, fmt.Errorf("invalid mqtt tls config: %v", err)
+		}
+
+		starters = append(starters, func() {
+			var client *server.MqttClient
+			if rulesPublish(rules) {
+				options := server.NewMqttOptions(
+					viper.GetString("mqtt.broker"),
+					viper.GetString("mqtt.user"),
+					viper.GetString("mqtt.password"),
+					viper.GetString("mqtt.clientid")+"-rules",
+					tlsConfig,
+				)
+				client = server.NewMqttClient(options, byte(viper.GetInt("mqtt.qos")), verbose)
+				supervisor.MQTT(client)
+			}
+
+			unsubscribe := s.engine.Subscribe(supervisor.Run)
+
+			s.stop = append(s.stop, func() {
+				unsubscribe()
+				if client != nil {
+					client.Disconnect()
+				}
+			})
+		})
+	}
+
+	return func() {
+		for _, start := range starters {
+			start()
+		}
+	}, nil
+}
+
+// Start starts the configured sinks
+func (s *sinks) Start() error {
+	start, err := s.prepare()
+	if err != nil {
+		return err
+	}
+
+	start()
+
+	return nil
+}
+
+// Stop stops all sinks and waits for them to finish
+func (s *sinks) Stop() {
+	for _, stop := range s.stop {
+		stop()
+	}
+	s.stop = nil
+}
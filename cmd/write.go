@@ -8,6 +8,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/volkszaehler/mbmd/meters/rs485"
 )
 
 // writeCmd represents the write command
@@ -38,6 +39,30 @@ func init() {
 		"int",
 		"Data encoding: bit|int|uint|hex|float|string",
 	)
+	writeCmd.PersistentFlags().Bool(
+		"force",
+		false,
+		`Write registers that are protected by the device type (e.g. calibration or reset registers).
+Without --force, holding registers are only written if the device type is given (e.g. -d SDM:1).`,
+	)
+}
+
+// validateProtection refuses writing registers protected by the device type. Writes are
+// refused if protection cannot be checked since the device type is missing or unknown.
+func validateProtection(meterDef string, register, length int) {
+	meterType := deviceTypeFromSpec(meterDef)
+	if meterType == "" {
+		log.Fatal("Missing device type for checking write protection (e.g. -d SDM:1). Use --force to write without checking.")
+	}
+
+	factory, ok := rs485.Producers[strings.ToUpper(meterType)]
+	if !ok {
+		log.Fatalf("Cannot check write protection for device type %s. Use --force to write without checking.", meterType)
+	}
+
+	if rs485.IsProtected(factory(), uint16(register), uint16(length)) {
+		log.Fatalf("Register %d is write-protected for device type %s. Use --force to override.", register, meterType)
+	}
 }
 
 func parseDecimalString(
@@ -156,6 +181,10 @@ func write(cmd *cobra.Command, args []string) {
 	encoding, _ := cmd.PersistentFlags().GetString("encoding")
 	validateFlags(typ, encoding)
 
+	if force, _ := cmd.PersistentFlags().GetBool("force"); !force && strings.ToLower(typ) == "holding" {
+		validateProtection(dev, register, length)
+	}
+
 	// parse modbus settings
 	conn, client := modbusClient()
 	conn.Slave(deviceIDFromSpec(dev))
@@ -3,18 +3,20 @@ module github.com/volkszaehler/mbmd
 require (
 	github.com/alvaroloes/enumer v1.1.2
 	github.com/andig/gosunspec v0.0.0-20200429133549-3cf6a82fed9c
+	github.com/antonmedv/expr v1.9.0
 	github.com/eclipse/paho.mqtt.golang v1.2.0
 	github.com/fsnotify/fsnotify v1.4.9 // indirect
+	github.com/golang/protobuf v1.4.3
 	github.com/google/go-github v17.0.0+incompatible // indirect
 	github.com/google/go-querystring v1.0.0 // indirect
 	github.com/gorilla/handlers v1.5.1
 	github.com/gorilla/mux v1.8.0
 	github.com/gorilla/websocket v1.4.2
 	github.com/grid-x/modbus v0.0.0-20200108122021-57d05a9f1e1a
-	github.com/grid-x/serial v0.0.0-20191104121038-e24bc9bf6f08 // indirect
+	github.com/grid-x/serial v0.0.0-20191104121038-e24bc9bf6f08
 	github.com/hashicorp/go-version v1.2.0 // indirect
 	github.com/influxdata/influxdb-client-go v1.4.0
-	github.com/mitchellh/mapstructure v1.2.2 // indirect
+	github.com/mitchellh/mapstructure v1.2.2
 	github.com/mjibson/esc v0.2.0
 	github.com/pelletier/go-toml v1.7.0 // indirect
 	github.com/spf13/afero v1.2.2 // indirect
@@ -27,7 +29,10 @@ require (
 	golang.org/x/net v0.0.0-20200324143707-d3edc9973b7e // indirect
 	golang.org/x/sys v0.0.0-20200413165638-669c56c373c4 // indirect
 	golang.org/x/tools v0.0.0-20200420001825-978e26b7c37c // indirect
+	google.golang.org/grpc v1.29.1
+	google.golang.org/protobuf v1.23.0
 	gopkg.in/ini.v1 v1.55.0 // indirect
+	gopkg.in/yaml.v2 v2.2.8
 )
 
 go 1.13
@@ -0,0 +1,131 @@
+package log
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// File is a log file that is rotated when exceeding its maximum size or age. The age is counted from opening the file.
+// Rotated files are renamed to <path>.1, <path>.2 and so on, the oldest files are removed.
+type File struct {
+	mux     sync.Mutex
+	path    string
+	maxSize int64         // bytes, 0 disables size based rotation
+	maxAge  time.Duration // 0 disables time based rotation
+	keep    int           // rotated files to keep
+	file    *os.File
+	size    int64
+	opened  time.Time
+}
+
+// NewFile opens the log file for appending
+func NewFile(path string, maxSize int64, maxAge time.Duration, keep int) (*File, error) {
+	if maxSize < 0 || maxAge < 0 || keep < 0 {
+		return nil, fmt.Errorf("invalid log file rotation settings")
+	}
+
+	f := &File{
+		path:    path,
+		maxSize: maxSize,
+		maxAge:  maxAge,
+		keep:    keep,
+	}
+
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// open opens the log file. Caller must hold the mutex.
+func (f *File) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	fi, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	f.file = file
+	f.size = fi.Size()
+	f.opened = time.Now()
+
+	return nil
+}
+
+// rotated returns the name of the n-th rotated file
+func (f *File) rotated(n int) string {
+	return fmt.Sprintf("%s.%d", f.path, n)
+}
+
+// rotate renames the current and rotated files and opens a new log file. Caller must hold the mutex.
+func (f *File) rotate() error {
+	err := f.file.Close()
+	f.file = nil
+	if err != nil {
+		return err
+	}
+
+	if f.keep == 0 {
+		if err := os.Remove(f.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return f.open()
+	}
+
+	_ = os.Remove(f.rotated(f.keep))
+	for n := f.keep - 1; n > 0; n-- {
+		if err := os.Rename(f.rotated(n), f.rotated(n+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(f.path, f.rotated(1)); err != nil {
+		return err
+	}
+
+	return f.open()
+}
+
+// Write implements io.Writer. The file is rotated before writing if needed.
+func (f *File) Write(b []byte) (int, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	if f.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	if f.size > 0 && (f.maxSize > 0 && f.size+int64(len(b)) > f.maxSize ||
+		f.maxAge > 0 && time.Since(f.opened) >= f.maxAge) {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(b)
+	f.size += int64(n)
+
+	return n, err
+}
+
+// Close closes the log file
+func (f *File) Close() error {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	if f.file == nil {
+		return nil
+	}
+
+	err := f.file.Close()
+	f.file = nil
+
+	return err
+}
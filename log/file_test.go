@@ -0,0 +1,56 @@
+package log
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "mbmd.log")
+	f, err := NewFile(path, 10, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	for _, s := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		if _, err := f.Write([]byte(s)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for name, expected := range map[string]string{
+		path:        "fourth\n",
+		path + ".1": "third\n",
+		path + ".2": "second\n",
+	} {
+		if b, _ := ioutil.ReadFile(name); string(b) != expected {
+			t.Errorf("%s: expected %q, got %q", name, expected, b)
+		}
+	}
+
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Error("expected oldest file to be removed")
+	}
+
+	// time based rotation
+	f.maxSize = 0
+	f.maxAge = time.Millisecond
+	time.Sleep(2 * time.Millisecond)
+
+	if _, err := f.Write([]byte("fifth\n")); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := ioutil.ReadFile(path + ".1"); string(b) != "fourth\n" {
+		t.Errorf("expected rotation by age, got %q", b)
+	}
+}
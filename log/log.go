@@ -0,0 +1,257 @@
+// Package log provides leveled logging with text or JSON output. It is a drop-in
+// replacement for the standard library's Printf/Println/Fatal functions which log at info level.
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level is a log level
+type Level int
+
+// log levels
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+var levels = []string{"debug", "info", "warn", "error"}
+
+// String implements fmt.Stringer
+func (l Level) String() string {
+	if l < LevelDebug || l > LevelError {
+		return fmt.Sprintf("level(%d)", int(l))
+	}
+	return levels[l]
+}
+
+// ParseLevel parses a case-insensitive level name
+func ParseLevel(s string) (Level, error) {
+	for i, l := range levels {
+		if strings.EqualFold(s, l) {
+			return Level(i), nil
+		}
+	}
+	return LevelInfo, fmt.Errorf("invalid log level %s", s)
+}
+
+// Format is a log output format
+type Format string
+
+// log formats
+const (
+	FormatText Format = "text"
+	FormatJSON Format = "json"
+)
+
+// ParseFormat parses a log format
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatText, FormatJSON:
+		return f, nil
+	}
+	return FormatText, fmt.Errorf("invalid log format %s", s)
+}
+
+// componentRE matches the component prefix of messages like "httpd: starting api"
+var componentRE = regexp.MustCompile(`^([a-z0-9-]+): (.*)$`)
+
+// entry is a JSON log entry
+type entry struct {
+	Time      time.Time `json:"time"`
+	Level     string    `json:"level"`
+	Component string    `json:"component,omitempty"`
+	Message   string    `json:"msg"`
+}
+
+// Logger is a leveled logger. It is safe for concurrent use.
+type Logger struct {
+	mux    sync.Mutex
+	out    io.Writer
+	level  Level
+	format Format
+}
+
+// New creates a logger
+func New(out io.Writer, level Level, format Format) *Logger {
+	return &Logger{
+		out:    out,
+		level:  level,
+		format: format,
+	}
+}
+
+// SetOutput sets the log output
+func (l *Logger) SetOutput(out io.Writer) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	l.out = out
+}
+
+// SetLevel sets the minimum level of logged messages
+func (l *Logger) SetLevel(level Level) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	l.level = level
+}
+
+// SetFormat sets the log format
+func (l *Logger) SetFormat(format Format) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	l.format = format
+}
+
+// Enabled returns true if messages of the given level are logged
+func (l *Logger) Enabled(level Level) bool {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	return level >= l.level
+}
+
+// output writes a message at the given level
+func (l *Logger) output(level Level, msg string) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	if level < l.level {
+		return
+	}
+
+	now := time.Now()
+	msg = strings.TrimRight(msg, "\r\n")
+
+	if l.format == FormatJSON {
+		e := entry{Time: now, Level: level.String(), Message: msg}
+		if match := componentRE.FindStringSubmatch(msg); match != nil {
+			e.Component, e.Message = match[1], match[2]
+		}
+
+		b, _ := json.Marshal(e)
+		_, _ = l.out.Write(append(b, '\n'))
+		return
+	}
+
+	fmt.Fprintf(l.out, "%s [%s] %s\n", now.Format("2006/01/02 15:04:05"), level, msg)
+}
+
+// Debugf logs at debug level
+func (l *Logger) Debugf(format string, v ...interface{}) {
+	l.output(LevelDebug, fmt.Sprintf(format, v...))
+}
+
+// Infof logs at info level
+func (l *Logger) Infof(format string, v ...interface{}) {
+	l.output(LevelInfo, fmt.Sprintf(format, v...))
+}
+
+// Warnf logs at warn level
+func (l *Logger) Warnf(format string, v ...interface{}) {
+	l.output(LevelWarn, fmt.Sprintf(format, v...))
+}
+
+// Errorf logs at error level
+func (l *Logger) Errorf(format string, v ...interface{}) {
+	l.output(LevelError, fmt.Sprintf(format, v...))
+}
+
+// Printf logs at info level
+func (l *Logger) Printf(format string, v ...interface{}) {
+	l.output(LevelInfo, fmt.Sprintf(format, v...))
+}
+
+// Println logs at info level
+func (l *Logger) Println(v ...interface{}) {
+	l.output(LevelInfo, fmt.Sprintln(v...))
+}
+
+// Fatal logs at error level and exits
+func (l *Logger) Fatal(v ...interface{}) {
+	l.output(LevelError, fmt.Sprint(v...))
+	os.Exit(1)
+}
+
+// Fatalf logs at error level and exits
+func (l *Logger) Fatalf(format string, v ...interface{}) {
+	l.output(LevelError, fmt.Sprintf(format, v...))
+	os.Exit(1)
+}
+
+// std is the default logger
+var std = New(os.Stderr, LevelInfo, FormatText)
+
+// Default returns the default logger used by the package functions
+func Default() *Logger {
+	return std
+}
+
+// SetOutput sets the default logger's output
+func SetOutput(out io.Writer) {
+	std.SetOutput(out)
+}
+
+// SetLevel sets the default logger's level
+func SetLevel(level Level) {
+	std.SetLevel(level)
+}
+
+// SetFormat sets the default logger's format
+func SetFormat(format Format) {
+	std.SetFormat(format)
+}
+
+// Enabled returns true if the default logger logs messages of the given level
+func Enabled(level Level) bool {
+	return std.Enabled(level)
+}
+
+// Debugf logs at debug level
+func Debugf(format string, v ...interface{}) {
+	std.output(LevelDebug, fmt.Sprintf(format, v...))
+}
+
+// Infof logs at info level
+func Infof(format string, v ...interface{}) {
+	std.output(LevelInfo, fmt.Sprintf(format, v...))
+}
+
+// Warnf logs at warn level
+func Warnf(format string, v ...interface{}) {
+	std.output(LevelWarn, fmt.Sprintf(format, v...))
+}
+
+// Errorf logs at error level
+func Errorf(format string, v ...interface{}) {
+	std.output(LevelError, fmt.Sprintf(format, v...))
+}
+
+// Printf logs at info level
+func Printf(format string, v ...interface{}) {
+	std.output(LevelInfo, fmt.Sprintf(format, v...))
+}
+
+// Println logs at info level
+func Println(v ...interface{}) {
+	std.output(LevelInfo, fmt.Sprintln(v...))
+}
+
+// Fatal logs at error level and exits
+func Fatal(v ...interface{}) {
+	std.output(LevelError, fmt.Sprint(v...))
+	os.Exit(1)
+}
+
+// Fatalf logs at error level and exits
+func Fatalf(format string, v ...interface{}) {
+	std.output(LevelError, fmt.Sprintf(format, v...))
+	os.Exit(1)
+}
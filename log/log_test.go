@@ -0,0 +1,35 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestLevels(t *testing.T) {
+	var b bytes.Buffer
+	l := New(&b, LevelWarn, FormatText)
+
+	l.Debugf("debug")
+	l.Printf("info")
+	l.Warnf("warn")
+	l.Errorf("error")
+
+	if s := b.String(); strings.Contains(s, "debug") || strings.Contains(s, "info") {
+		t.Errorf("unexpected output below level: %s", s)
+	}
+	if s := b.String(); !strings.Contains(s, "[warn] warn") || !strings.Contains(s, "[error] error") {
+		t.Errorf("missing output: %s", s)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var b bytes.Buffer
+	l := New(&b, LevelInfo, FormatJSON)
+
+	l.Println("httpd: starting api")
+
+	if s := b.String(); !strings.Contains(s, `"level":"info","component":"httpd","msg":"starting api"`) {
+		t.Errorf("unexpected output: %s", s)
+	}
+}
@@ -0,0 +1,143 @@
+package meters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+// ASCII is an ASCII modbus connection used by serial devices with 7 data bits
+type ASCII struct {
+	device  string
+	Client  modbus.Client
+	Handler *modbus.ASCIIClientHandler
+	handler *reconnectHandler
+	prevID  uint8
+	pause   time.Duration
+}
+
+// NewASCIIClientHandler creates a serial line ASCII modbus handler. The comset may override the baudrate, see ParseComset.
+func NewASCIIClientHandler(device string, baudrate int, comset string) (*modbus.ASCIIClientHandler, error) {
+	cs, err := ParseComset(comset, baudrate)
+	if err != nil {
+		return nil, err
+	}
+
+	handler := modbus.NewASCIIClientHandler(device)
+
+	handler.BaudRate = cs.Baudrate
+	handler.DataBits = cs.DataBits
+	handler.Parity = cs.Parity
+	handler.StopBits = cs.StopBits
+
+	// ASCII frames are twice as long as RTU frames
+	handler.Timeout = 1 * time.Second
+
+	return handler, nil
+}
+
+// NewASCII creates an ASCII modbus client. The serial device is reopened automatically after disconnects.
+func NewASCII(device string, baudrate int, comset string) (Connection, error) {
+	handler, err := NewASCIIClientHandler(device, baudrate, comset)
+	if err != nil {
+		return nil, err
+	}
+
+	reconnect := newReconnectHandler(handler, device, handler.Close)
+	client := modbus.NewClient(reconnect)
+
+	b := &ASCII{
+		device:  device,
+		Client:  client,
+		Handler: handler,
+		handler: reconnect,
+		pause:   DefaultPause,
+	}
+
+	return b, nil
+}
+
+// String returns the bus device
+func (b *ASCII) String() string {
+	return b.device
+}
+
+// ModbusClient returns the ASCII modbus client
+func (b *ASCII) ModbusClient() modbus.Client {
+	return b.Client
+}
+
+// Trace implements Traceable. ASCII frames are traced in their binary representation.
+func (b *ASCII) Trace(w *TraceWriter) {
+	b.Client = modbus.NewClient(newTraceHandler(b.handler, b.String(), asciiHeader, w))
+}
+
+// Logger sets a logging instance for physical bus operations
+func (b *ASCII) Logger(l Logger) {
+	b.Handler.Logger = l
+}
+
+// Slave sets the modbus device id for the following operations
+func (b *ASCII) Slave(deviceID uint8) {
+	// Some devices need to have a little pause between querying different device ids
+	if b.pause > 0 && b.prevID != 0 && deviceID != b.prevID {
+		time.Sleep(b.pause)
+	}
+	b.prevID = deviceID
+
+	b.Handler.SetSlave(deviceID)
+}
+
+// Pause implements Pausable
+func (b *ASCII) Pause(pause time.Duration) {
+	b.pause = pause
+}
+
+// Timeout sets the modbus timeout
+func (b *ASCII) Timeout(timeout time.Duration) time.Duration {
+	t := b.Handler.Timeout
+	b.Handler.Timeout = timeout
+	return t
+}
+
+// Direction implements DirectionControllable
+func (b *ASCII) Direction(d Direction) error {
+	switch d.Mode {
+	case DirectionRTS:
+		b.Handler.RS485.Enabled = true
+		b.Handler.RS485.RtsHighDuringSend = true
+		b.Handler.RS485.DelayRtsBeforeSend = d.DelayBefore
+		b.Handler.RS485.DelayRtsAfterSend = d.DelayAfter
+	case DirectionGPIO:
+		handler, err := newGPIOHandler(b.Handler, &b.Handler.Config, func() Logger { return b.Handler.Logger }, d)
+		if err != nil {
+			return err
+		}
+		b.handler = newReconnectHandler(handler, b.device, handler.Close)
+		b.Client = modbus.NewClient(b.handler)
+	default:
+		return fmt.Errorf("invalid direction control %s", d.Mode)
+	}
+
+	return nil
+}
+
+// Close closes the modbus connection.
+// This forces the modbus client to reopen the connection before the next bus operations.
+func (b *ASCII) Close() {
+	_ = b.handler.close()
+}
+
+// NewSerial creates an RTU or, for comsets with 7 data bits, an ASCII modbus client
+func NewSerial(device string, baudrate int, comset string) (Connection, error) {
+	cs, err := ParseComset(comset, baudrate)
+	if err != nil {
+		return nil, err
+	}
+
+	if cs.DataBits == 7 {
+		return NewASCII(device, baudrate, comset)
+	}
+	return NewRTU(device, baudrate, comset)
+}
@@ -0,0 +1,49 @@
+package meters
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// comsetRE matches [<baudrate>:]<databits><parity><stopbits>
+var comsetRE = regexp.MustCompile(`^(?:([0-9]+):)?([78])([NEO])([12])$`)
+
+// Comset are the serial line communication parameters
+type Comset struct {
+	Baudrate int
+	DataBits int
+	Parity   string // N, E or O
+	StopBits int
+}
+
+// ParseComset parses communication parameters like 8N1, 7E1 or 38400:8N2.
+// The baudrate is used if the comset doesn't specify one. Modbus uses 8 data bits
+// in RTU and 7 data bits in ASCII mode.
+func ParseComset(comset string, baudrate int) (Comset, error) {
+	match := comsetRE.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(comset)))
+	if match == nil {
+		return Comset{}, fmt.Errorf("invalid comset %s", comset)
+	}
+
+	if match[1] != "" {
+		var err error
+		if baudrate, err = strconv.Atoi(match[1]); err != nil {
+			return Comset{}, fmt.Errorf("invalid comset %s: %v", comset, err)
+		}
+	}
+	if baudrate <= 0 {
+		return Comset{}, fmt.Errorf("invalid comset %s: missing baudrate", comset)
+	}
+
+	dataBits, _ := strconv.Atoi(match[2])
+	stopBits, _ := strconv.Atoi(match[4])
+
+	return Comset{
+		Baudrate: baudrate,
+		DataBits: dataBits,
+		Parity:   match[3],
+		StopBits: stopBits,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package meters
+
+import "testing"
+
+func TestParseComset(t *testing.T) {
+	tc := []struct {
+		comset   string
+		baudrate int
+		expected Comset
+	}{
+		{"8N1", 9600, Comset{9600, 8, "N", 1}},
+		{"8e1", 9600, Comset{9600, 8, "E", 1}},
+		{"7O2", 19200, Comset{19200, 7, "O", 2}},
+		{"38400:8N2", 9600, Comset{38400, 8, "N", 2}},
+	}
+
+	for _, tc := range tc {
+		c, err := ParseComset(tc.comset, tc.baudrate)
+		if err != nil {
+			t.Errorf("%s: %v", tc.comset, err)
+			continue
+		}
+		if c != tc.expected {
+			t.Errorf("%s: expected %+v, got %+v", tc.comset, tc.expected, c)
+		}
+	}
+
+	for _, comset := range []string{"", "8X1", "9N1", "5N1", "8N3", "fast:8N1", "8N1:9600"} {
+		if _, err := ParseComset(comset, 9600); err == nil {
+			t.Errorf("%s: expected error", comset)
+		}
+	}
+
+	if _, err := ParseComset("8N1", 0); err == nil {
+		t.Error("expected error for missing baudrate")
+	}
+}
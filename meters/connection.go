@@ -27,3 +27,13 @@ type Connection interface {
 	// String returns the bus device (RTU) or bus connection address (TCP)
 	String() string
 }
+
+// DefaultPause is the default pause between operations on different device ids of serial connections
+const DefaultPause = 100 * time.Millisecond
+
+// Pausable is implemented by connections that pause between operations on different
+// device ids since some devices like SDM need a little pause before the bus is addressed again
+type Pausable interface {
+	// Pause sets the pause between operations on different device ids, zero disables pausing
+	Pause(pause time.Duration)
+}
@@ -0,0 +1,95 @@
+package meters
+
+import (
+	"math"
+	"time"
+)
+
+// demands maps energy counters to the demand measurements computed from them
+var demands = []struct {
+	counter, demand Measurement
+}{
+	{Import, ImportDemand},
+	{Export, ExportDemand},
+}
+
+// counterSample is an energy counter reading
+type counterSample struct {
+	timestamp time.Time
+	value     float64 // kWh
+}
+
+// Demand computes the average power over a sliding interval from energy counters,
+// e.g. the 15 minute demand used for tariff analysis
+type Demand struct {
+	interval time.Duration
+	samples  map[Measurement][]counterSample
+}
+
+// NewDemand creates a demand calculator for a single device
+func NewDemand(interval time.Duration) *Demand {
+	return &Demand{
+		interval: interval,
+		samples:  make(map[Measurement][]counterSample),
+	}
+}
+
+// Interval returns the demand interval
+func (d *Demand) Interval() time.Duration {
+	return d.interval
+}
+
+// add adds a counter reading and returns the demand in W once the samples cover the interval
+func (d *Demand) add(counter Measurement, s counterSample) (float64, bool) {
+	samples := d.samples[counter]
+
+	// start over if the counter has been reset or readings are out of order
+	if n := len(samples); n > 0 && (s.value < samples[n-1].value || !s.timestamp.After(samples[n-1].timestamp)) {
+		samples = samples[:0]
+	}
+
+	samples = append(samples, s)
+
+	// retain a single sample at or before the interval's start
+	start := s.timestamp.Add(-d.interval)
+	for len(samples) > 1 && !samples[1].timestamp.After(start) {
+		samples = samples[1:]
+	}
+
+	d.samples[counter] = samples
+
+	first := samples[0]
+	if first.timestamp.After(start) {
+		return 0, false
+	}
+
+	return (s.value - first.value) / s.timestamp.Sub(first.timestamp).Hours() * 1e3, true
+}
+
+// Derive adds the demands of all energy counters contained in the results and returns
+// the results including the demands. Demands are available once the device has been
+// queried for the entire interval.
+func (d *Demand) Derive(results []MeasurementResult) []MeasurementResult {
+	for _, r := range results {
+		if math.IsNaN(r.Value) {
+			continue
+		}
+
+		for _, dm := range demands {
+			if r.Measurement != dm.counter {
+				continue
+			}
+
+			if value, ok := d.add(dm.counter, counterSample{r.Timestamp, r.Value}); ok {
+				results = append(results, MeasurementResult{
+					Measurement: dm.demand,
+					Value:       value,
+					Timestamp:   r.Timestamp,
+					Derived:     true,
+				})
+			}
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,47 @@
+package meters
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDemand(t *testing.T) {
+	d := NewDemand(15 * time.Minute)
+	start := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	demand := func(offset time.Duration, kWh float64) (float64, bool) {
+		res := d.Derive([]MeasurementResult{{Measurement: Import, Value: kWh, Timestamp: start.Add(offset)}})
+		for _, r := range res {
+			if r.Measurement == ImportDemand {
+				if !r.Derived {
+					t.Error("demand not flagged as derived")
+				}
+				return r.Value, true
+			}
+		}
+		return 0, false
+	}
+
+	// interval not yet covered
+	for i := 0; i < 15; i++ {
+		if _, ok := demand(time.Duration(i)*time.Minute, 100+float64(i)*0.1); ok {
+			t.Fatalf("minute %d: unexpected demand", i)
+		}
+	}
+
+	// 6 kW for 15 minutes
+	if v, ok := demand(15*time.Minute, 101.5); !ok || math.Abs(v-6000) > 1e-6 {
+		t.Errorf("expected 6000W demand, got %v %.3f", ok, v)
+	}
+
+	// sliding: 3 kW for the last minute
+	if v, ok := demand(16*time.Minute, 101.55); !ok || math.Abs(v-5800) > 1e-6 {
+		t.Errorf("expected 5800W demand, got %v %.3f", ok, v)
+	}
+
+	// counter reset starts over
+	if _, ok := demand(17*time.Minute, 0); ok {
+		t.Error("unexpected demand after counter reset")
+	}
+}
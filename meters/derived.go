@@ -0,0 +1,153 @@
+package meters
+
+import (
+	"math"
+	"time"
+)
+
+// totals lists total measurements and their per-phase measurements
+var totals = [][4]Measurement{
+	{Power, PowerL1, PowerL2, PowerL3},
+	{ReactivePower, ReactivePowerL1, ReactivePowerL2, ReactivePowerL3},
+	{ApparentPower, ApparentPowerL1, ApparentPowerL2, ApparentPowerL3},
+	{Import, ImportL1, ImportL2, ImportL3},
+	{Export, ExportL1, ExportL2, ExportL3},
+}
+
+// powers lists the active, reactive and apparent power and power factor measurements of phases and total
+var powers = [][4]Measurement{
+	{PowerL1, ReactivePowerL1, ApparentPowerL1, CosphiL1},
+	{PowerL2, ReactivePowerL2, ApparentPowerL2, CosphiL2},
+	{PowerL3, ReactivePowerL3, ApparentPowerL3, CosphiL3},
+	{Power, ReactivePower, ApparentPower, Cosphi},
+}
+
+// imbalances lists the imbalance measurements and the per-phase measurements they are computed from
+var imbalances = [][4]Measurement{
+	{VoltageImbalance, VoltageL1, VoltageL2, VoltageL3},
+	{CurrentImbalance, CurrentL1, CurrentL2, CurrentL3},
+}
+
+// derivation collects measurement results for computing missing measurements
+type derivation struct {
+	values    map[Measurement]float64
+	timestamp map[Measurement]time.Time
+	native    map[Measurement]bool // measurements provided by the device
+	res       []MeasurementResult
+}
+
+// add adds a derived result unless the measurement is available or provided by the device
+func (d *derivation) add(m Measurement, value float64, inputs ...Measurement) {
+	if _, ok := d.values[m]; ok || d.native[m] || math.IsNaN(value) || math.IsInf(value, 0) {
+		return
+	}
+
+	// derived results are as recent as their most recent input
+	var ts time.Time
+	for _, input := range inputs {
+		if t := d.timestamp[input]; t.After(ts) {
+			ts = t
+		}
+	}
+
+	d.values[m] = value
+	d.timestamp[m] = ts
+	d.res = append(d.res, MeasurementResult{
+		Measurement: m,
+		Value:       value,
+		Timestamp:   ts,
+		Derived:     true,
+	})
+}
+
+// has returns true if all measurements are available
+func (d *derivation) has(ms ...Measurement) bool {
+	for _, m := range ms {
+		if _, ok := d.values[m]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// power derives apparent power from active and reactive power and power factor from active and apparent power
+func (d *derivation) power(pw [4]Measurement) {
+	p, q, s, pf := pw[0], pw[1], pw[2], pw[3]
+
+	if d.has(p, q) {
+		d.add(s, math.Hypot(d.values[p], d.values[q]), p, q)
+	}
+
+	if d.has(p, s) && d.values[s] != 0 {
+		d.add(pf, d.values[p]/d.values[s], p, s)
+	}
+}
+
+// imbalance derives the maximum deviation of the phases from their average in percent
+func (d *derivation) imbalance(im [4]Measurement) {
+	if !d.has(im[1:]...) {
+		return
+	}
+
+	l1, l2, l3 := d.values[im[1]], d.values[im[2]], d.values[im[3]]
+	avg := (l1 + l2 + l3) / 3
+	if avg == 0 {
+		return
+	}
+
+	dev := math.Max(math.Abs(l1-avg), math.Max(math.Abs(l2-avg), math.Abs(l3-avg)))
+	d.add(im[0], 100*dev/math.Abs(avg), im[1:]...)
+}
+
+// Deriver computes measurements a device doesn't provide from the measurements it provides.
+// Measurements the device has provided once are never derived, such that devices reading
+// different registers in each query don't publish derived values instead of native ones.
+type Deriver struct {
+	native map[Measurement]bool
+}
+
+// NewDeriver creates a deriver for a single device
+func NewDeriver() *Deriver {
+	return &Deriver{native: make(map[Measurement]bool)}
+}
+
+// Derive computes missing measurements from the results and returns the results including
+// the derived ones. Totals are summed from phases, apparent power is computed from active
+// and reactive power, power factor from active and apparent power and voltage and current imbalance
+// from the phases.
+func (dr *Deriver) Derive(results []MeasurementResult) []MeasurementResult {
+	d := derivation{
+		values:    make(map[Measurement]float64, len(results)),
+		timestamp: make(map[Measurement]time.Time, len(results)),
+		native:    dr.native,
+		res:       results,
+	}
+
+	for _, r := range results {
+		dr.native[r.Measurement] = true
+		if !math.IsNaN(r.Value) {
+			d.values[r.Measurement] = r.Value
+			d.timestamp[r.Measurement] = r.Timestamp
+		}
+	}
+
+	// phases first such that totals can be summed from derived phase values
+	phases, total := powers[:3], powers[3]
+	for _, pw := range phases {
+		d.power(pw)
+	}
+
+	for _, t := range totals {
+		if d.has(t[1:]...) {
+			d.add(t[0], d.values[t[1]]+d.values[t[2]]+d.values[t[3]], t[1:]...)
+		}
+	}
+
+	d.power(total)
+
+	for _, im := range imbalances {
+		d.imbalance(im)
+	}
+
+	return d.res
+}
@@ -0,0 +1,89 @@
+package meters
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDerive(t *testing.T) {
+	result := func(m Measurement, v float64) MeasurementResult {
+		return MeasurementResult{Measurement: m, Value: v}
+	}
+
+	d := NewDeriver()
+	res := d.Derive([]MeasurementResult{
+		result(PowerL1, 300), result(ReactivePowerL1, 400),
+		result(PowerL2, 100), result(ReactivePowerL2, 0),
+		result(PowerL3, 100), result(ReactivePowerL3, 0), result(ApparentPowerL3, 200),
+	})
+
+	expected := map[Measurement]float64{
+		ApparentPowerL1: 500,
+		CosphiL1:        0.6,
+		ApparentPowerL2: 100,
+		CosphiL2:        1,
+		CosphiL3:        0.5,
+		Power:           500,
+		ReactivePower:   400,
+		ApparentPower:   800,
+		Cosphi:          0.625,
+	}
+
+	derived := make(map[Measurement]float64)
+	for _, r := range res {
+		if r.Derived {
+			derived[r.Measurement] = r.Value
+		}
+	}
+
+	if len(derived) != len(expected) {
+		t.Errorf("expected %d derived results, got %v", len(expected), derived)
+	}
+
+	for m, v := range expected {
+		if math.Abs(derived[m]-v) > 1e-9 {
+			t.Errorf("%s: expected %.3f, got %.3f", m, v, derived[m])
+		}
+	}
+
+	// natively provided measurements are not derived when missing
+	res = d.Derive([]MeasurementResult{
+		result(PowerL1, 300), result(ReactivePowerL1, 400),
+		result(PowerL3, 100), result(ReactivePowerL3, 0),
+	})
+
+	for _, r := range res {
+		if r.Measurement == ApparentPowerL3 {
+			t.Errorf("%s: native measurement derived", r.Measurement)
+		}
+	}
+}
+
+func TestImbalance(t *testing.T) {
+	res := NewDeriver().Derive([]MeasurementResult{
+		{Measurement: VoltageL1, Value: 230},
+		{Measurement: VoltageL2, Value: 230},
+		{Measurement: VoltageL3, Value: 230},
+		{Measurement: CurrentL1, Value: 10},
+		{Measurement: CurrentL2, Value: 5},
+		{Measurement: CurrentL3, Value: 0},
+	})
+
+	expected := map[Measurement]float64{
+		VoltageImbalance: 0,
+		CurrentImbalance: 100,
+	}
+
+	for _, r := range res {
+		if v, ok := expected[r.Measurement]; ok {
+			if math.Abs(r.Value-v) > 1e-9 {
+				t.Errorf("%s: expected %.1f, got %.1f", r.Measurement, v, r.Value)
+			}
+			delete(expected, r.Measurement)
+		}
+	}
+
+	if len(expected) > 0 {
+		t.Errorf("missing %v", expected)
+	}
+}
@@ -33,3 +33,20 @@ type Device interface {
 	// It requires that the client has the correct device id applied.
 	Query(client modbus.Client) ([]MeasurementResult, error)
 }
+
+// Identifiable is a device that reads metadata like its serial number once it has responded
+type Identifiable interface {
+	// Identify reads the device metadata immediately. Read errors are ignored.
+	// It requires that the client has the correct device id applied.
+	Identify(client modbus.Client)
+}
+
+// Configurable is a device that supports writing device settings
+type Configurable interface {
+	// Settings returns the names of the supported settings
+	Settings() []string
+
+	// WriteSetting writes a device setting.
+	// It requires that the client has the correct device id applied.
+	WriteSetting(client modbus.Client, setting string, value float64) error
+}
@@ -0,0 +1,219 @@
+package meters
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/grid-x/serial"
+)
+
+const (
+	// DirectionRTS toggles the RTS line using the kernel's RS485 support
+	DirectionRTS = "rts"
+	// DirectionGPIO toggles a GPIO pin, e.g. of a Raspberry Pi
+	DirectionGPIO = "gpio"
+
+	gpioExportWait = time.Second           // time for udev to apply permissions of exported pins
+	gpioFrameGap   = 10 * time.Millisecond // silence after the last byte ending a response
+)
+
+// gpioPath is the sysfs gpio directory
+var gpioPath = "/sys/class/gpio"
+
+// Direction describes RS485 driver-enable control for adapters without automatic direction switching
+type Direction struct {
+	Mode        string        // rts or gpio
+	GPIO        int           // gpio pin number driving the transceiver's DE/RE inputs
+	DelayBefore time.Duration // delay between enabling the driver and sending
+	DelayAfter  time.Duration // delay between sending and disabling the driver
+}
+
+// DirectionControllable is implemented by serial connections supporting RS485 direction control
+type DirectionControllable interface {
+	// Direction enables driver-enable control before the connection is used
+	Direction(d Direction) error
+}
+
+// gpioPin is a sysfs gpio output
+type gpioPin struct {
+	value *os.File
+}
+
+// openGPIO exports the pin and configures it as output set to low
+func openGPIO(pin int) (*gpioPin, error) {
+	dir := fmt.Sprintf("%s/gpio%d", gpioPath, pin)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		if err := ioutil.WriteFile(gpioPath+"/export", []byte(strconv.Itoa(pin)), 0); err != nil {
+			return nil, err
+		}
+	}
+
+	// setting direction to low configures an output with initial value 0
+	var err error
+	for start := time.Now(); time.Since(start) < gpioExportWait; time.Sleep(50 * time.Millisecond) {
+		if err = ioutil.WriteFile(dir+"/direction", []byte("low"), 0); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(dir+"/value", os.O_WRONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gpioPin{value: f}, nil
+}
+
+// set sets the pin's output
+func (p *gpioPin) set(high bool) error {
+	v := "0"
+	if high {
+		v = "1"
+	}
+	_, err := p.value.Write([]byte(v))
+	return err
+}
+
+// gpioHandler is a serial client handler enabling the RS485 driver using a gpio pin while sending.
+// Requests are encoded by the wrapped RTU or ASCII handler whose serial parameters are used.
+type gpioHandler struct {
+	modbus.ClientHandler
+	config    *serial.Config
+	logger    func() Logger
+	pin       *gpioPin
+	direction Direction
+
+	mu   sync.Mutex
+	port serial.Port
+}
+
+// newGPIOHandler creates a handler sending via the serial port configured by the handler's config
+func newGPIOHandler(handler modbus.ClientHandler, config *serial.Config, logger func() Logger, d Direction) (*gpioHandler, error) {
+	pin, err := openGPIO(d.GPIO)
+	if err != nil {
+		return nil, fmt.Errorf("gpio %d: %v", d.GPIO, err)
+	}
+
+	return &gpioHandler{
+		ClientHandler: handler,
+		config:        config,
+		logger:        logger,
+		pin:           pin,
+		direction:     d,
+	}, nil
+}
+
+// frameDuration returns the time needed for transmitting the characters
+func (h *gpioHandler) frameDuration(chars int) time.Duration {
+	bits := 1 + h.config.DataBits + h.config.StopBits // start, data and stop bits
+	if h.config.Parity != "N" {
+		bits++
+	}
+	return time.Duration(chars*bits) * time.Second / time.Duration(h.config.BaudRate)
+}
+
+// logf logs bus operations if a logger is configured
+func (h *gpioHandler) logf(format string, v ...interface{}) {
+	if l := h.logger(); l != nil {
+		l.Printf(format, v...)
+	}
+}
+
+// Send implements modbus.Transporter
+func (h *gpioHandler) Send(aduRequest []byte) ([]byte, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.port == nil {
+		conf := *h.config
+		conf.Timeout = gpioFrameGap // reads time out after the response has been received
+
+		port, err := serial.Open(&conf)
+		if err != nil {
+			return nil, err
+		}
+		h.port = port
+	}
+
+	if err := h.pin.set(true); err != nil {
+		return nil, err
+	}
+	time.Sleep(h.direction.DelayBefore)
+
+	h.logf("modbus: send % x\n", aduRequest)
+	_, err := h.port.Write(aduRequest)
+
+	// writes return once buffered, wait for the frame to be transmitted
+	time.Sleep(h.frameDuration(len(aduRequest)) + h.direction.DelayAfter)
+	if gerr := h.pin.set(false); err == nil {
+		err = gerr
+	}
+
+	if err != nil {
+		h.close()
+		return nil, err
+	}
+
+	aduResponse, err := h.read(time.Now().Add(h.config.Timeout))
+	h.logf("modbus: recv % x\n", aduResponse)
+
+	return aduResponse, err
+}
+
+// read reads the response until the bus is silent or the deadline has elapsed
+func (h *gpioHandler) read(deadline time.Time) ([]byte, error) {
+	var res []byte
+	buf := make([]byte, 256)
+
+	for time.Now().Before(deadline) {
+		n, err := h.port.Read(buf)
+		if n > 0 {
+			res = append(res, buf[:n]...)
+		} else if err == nil {
+			err = io.EOF // device removed
+		}
+
+		if errors.Is(err, serial.ErrTimeout) {
+			if len(res) > 0 {
+				return res, nil
+			}
+			continue
+		}
+		if err != nil {
+			h.close()
+			return res, err
+		}
+	}
+
+	if len(res) == 0 {
+		return nil, serial.ErrTimeout
+	}
+	return res, nil
+}
+
+// close closes the serial port. Caller must hold the mutex.
+func (h *gpioHandler) close() error {
+	if h.port == nil {
+		return nil
+	}
+	err := h.port.Close()
+	h.port = nil
+	return err
+}
+
+// Close closes the serial port
+func (h *gpioHandler) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.close()
+}
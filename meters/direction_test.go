@@ -0,0 +1,98 @@
+package meters
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+func TestGPIOPin(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gpio")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	defer func(path string) { gpioPath = path }(gpioPath)
+	gpioPath = dir
+
+	// exported pin
+	if err := os.Mkdir(filepath.Join(dir, "gpio17"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "gpio17", "value"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	pin, err := openGPIO(17)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b, _ := ioutil.ReadFile(filepath.Join(dir, "gpio17", "direction")); string(b) != "low" {
+		t.Errorf("expected direction low, got %s", b)
+	}
+
+	if err := pin.set(true); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := ioutil.ReadFile(filepath.Join(dir, "gpio17", "value")); string(b) != "1" {
+		t.Errorf("expected value 1, got %s", b)
+	}
+}
+
+func TestGPIOFrameDuration(t *testing.T) {
+	handler := modbus.NewRTUClientHandler("/dev/null")
+	handler.BaudRate = 9600
+	handler.DataBits = 8
+	handler.Parity = "E"
+	handler.StopBits = 1
+
+	// 8 bytes of 11 bits each
+	h := &gpioHandler{ClientHandler: handler, config: &handler.Config}
+	if d, expected := h.frameDuration(8), 88*time.Second/9600; d != expected {
+		t.Errorf("expected %v, got %v", expected, d)
+	}
+}
+
+func TestRTUDirection(t *testing.T) {
+	conn, err := NewRTU("/dev/null", 9600, "8N1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := conn.(*RTU)
+
+	if err := b.Direction(Direction{Mode: DirectionRTS, DelayAfter: time.Millisecond}); err != nil {
+		t.Fatal(err)
+	}
+	if !b.Handler.RS485.Enabled || !b.Handler.RS485.RtsHighDuringSend || b.Handler.RS485.DelayRtsAfterSend != time.Millisecond {
+		t.Errorf("unexpected rs485 config %+v", b.Handler.RS485)
+	}
+
+	if err := b.Direction(Direction{Mode: "dtr"}); err == nil {
+		t.Error("expected error for invalid mode")
+	}
+}
+
+func TestASCIIDirection(t *testing.T) {
+	conn, err := NewASCII("/dev/null", 9600, "7E1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := conn.(*ASCII)
+
+	if err := b.Direction(Direction{Mode: DirectionRTS, DelayBefore: time.Millisecond}); err != nil {
+		t.Fatal(err)
+	}
+	if !b.Handler.RS485.Enabled || !b.Handler.RS485.RtsHighDuringSend || b.Handler.RS485.DelayRtsBeforeSend != time.Millisecond {
+		t.Errorf("unexpected rs485 config %+v", b.Handler.RS485)
+	}
+
+	if err := b.Direction(Direction{Mode: "dtr"}); err == nil {
+		t.Error("expected error for invalid mode")
+	}
+}
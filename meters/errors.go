@@ -8,4 +8,7 @@ var (
 
 	// ErrPartiallyOpened indicates a partially opened device
 	ErrPartiallyOpened = errors.New("Device partially opened")
+
+	// ErrProtected indicates a write to write-protected registers
+	ErrProtected = errors.New("write-protected")
 )
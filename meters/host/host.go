@@ -0,0 +1,166 @@
+package host
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+const (
+	// METERTYPE_HOST is the device type of the gateway host pseudo-device
+	METERTYPE_HOST = "HOST"
+
+	// maximum link quality as reported by most wireless drivers
+	maxLinkQuality = 70
+)
+
+// Host is a pseudo-device reporting metrics of the gateway host itself.
+// It does not access the modbus client.
+type Host struct {
+	root string // file system root of /proc and /sys
+}
+
+// NewConnection creates a host pseudo-connection
+func NewConnection() meters.Connection {
+	return meters.NewPseudo("host")
+}
+
+// NewDevice creates a host pseudo-device
+func NewDevice() *Host {
+	return &Host{}
+}
+
+// Initialize implements the Device interface
+func (d *Host) Initialize(client modbus.Client) error {
+	return nil
+}
+
+// Descriptor implements the Device interface
+func (d *Host) Descriptor() meters.DeviceDescriptor {
+	return meters.DeviceDescriptor{
+		Type:         METERTYPE_HOST,
+		Manufacturer: METERTYPE_HOST,
+		Model:        "Gateway host",
+	}
+}
+
+// Probe implements the Device interface
+func (d *Host) Probe(client modbus.Client) (res meters.MeasurementResult, err error) {
+	uptime, err := d.uptime()
+	if err != nil {
+		return res, err
+	}
+
+	return meters.MeasurementResult{
+		Measurement: meters.Uptime,
+		Value:       uptime,
+		Timestamp:   time.Now(),
+	}, nil
+}
+
+// Query implements the Device interface. Metrics that are not available on the host are skipped.
+func (d *Host) Query(client modbus.Client) ([]meters.MeasurementResult, error) {
+	res := make([]meters.MeasurementResult, 0)
+
+	for m, f := range map[meters.Measurement]func() (float64, error){
+		meters.CPUTemp:     d.cpuTemp,
+		meters.LoadAverage: d.loadAverage,
+		meters.Uptime:      d.uptime,
+		meters.LinkQuality: d.linkQuality,
+	} {
+		if v, err := f(); err == nil {
+			res = append(res, meters.MeasurementResult{
+				Measurement: m,
+				Value:       v,
+				Timestamp:   time.Now(),
+			})
+		}
+	}
+
+	if len(res) == 0 {
+		return res, errors.New("host metrics not available")
+	}
+
+	return res, nil
+}
+
+// readFile reads a file relative to the host's file system root
+func (d *Host) readFile(file string) ([]byte, error) {
+	return ioutil.ReadFile(filepath.Join(d.root, file))
+}
+
+// fields reads a file and returns its whitespace-separated fields
+func (d *Host) fields(file string) ([]string, error) {
+	b, err := d.readFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Fields(string(b))
+	if len(fields) == 0 {
+		return nil, errors.New("empty file " + file)
+	}
+
+	return fields, nil
+}
+
+// cpuTemp returns the cpu temperature in °C
+func (d *Host) cpuTemp() (float64, error) {
+	fields, err := d.fields("/sys/class/thermal/thermal_zone0/temp")
+	if err != nil {
+		return 0, err
+	}
+
+	f, err := strconv.ParseFloat(fields[0], 64)
+	return f / 1e3, err
+}
+
+// loadAverage returns the 1 minute load average
+func (d *Host) loadAverage() (float64, error) {
+	fields, err := d.fields("/proc/loadavg")
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseFloat(fields[0], 64)
+}
+
+// uptime returns the host uptime in seconds
+func (d *Host) uptime() (float64, error) {
+	fields, err := d.fields("/proc/uptime")
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseFloat(fields[0], 64)
+}
+
+// linkQuality returns the first wireless interface's link quality in percent
+func (d *Host) linkQuality() (float64, error) {
+	b, err := d.readFile("/proc/net/wireless")
+	if err != nil {
+		return 0, err
+	}
+
+	// skip two header lines
+	lines := strings.Split(string(b), "\n")
+	for i, line := range lines {
+		if i < 2 {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) >= 3 {
+			f, err := strconv.ParseFloat(strings.TrimSuffix(fields[2], "."), 64)
+			return 100 * f / maxLinkQuality, err
+		}
+	}
+
+	return 0, errors.New("no wireless interface")
+}
@@ -0,0 +1,104 @@
+package host
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// hostRoot creates a file system root containing the given files
+func hostRoot(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "host")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for file, content := range files {
+		file = filepath.Join(dir, file)
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestQuery(t *testing.T) {
+	root := hostRoot(t, map[string]string{
+		"/sys/class/thermal/thermal_zone0/temp": "48312\n",
+		"/proc/loadavg":                         "0.52 0.58 0.59 1/187 12345\n",
+		"/proc/uptime":                          "350735.47 234388.90\n",
+		"/proc/net/wireless": `Inter-| sta-|   Quality        |   Discarded packets               | Missed | WE
+ face | tus | link level noise |  nwid  crypt   frag  retry   misc | beacon | 22
+ wlan0: 0000   49.  -61.  -256        0      0      0      0      0        0
+`,
+	})
+	defer os.RemoveAll(root)
+
+	d := &Host{root: root}
+
+	res, err := d.Query(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[meters.Measurement]float64{
+		meters.CPUTemp:     48.312,
+		meters.LoadAverage: 0.52,
+		meters.Uptime:      350735.47,
+		meters.LinkQuality: 70,
+	}
+
+	if len(res) != len(expected) {
+		t.Errorf("expected %d results, got %v", len(expected), res)
+	}
+	for _, r := range res {
+		if v, ok := expected[r.Measurement]; !ok || r.Value != v {
+			t.Errorf("%s: expected %v, got %v", r.Measurement, v, r.Value)
+		}
+	}
+
+	p, err := d.Probe(nil)
+	if err != nil || p.Measurement != meters.Uptime || p.Value != 350735.47 {
+		t.Errorf("unexpected probe result %v: %v", p, err)
+	}
+}
+
+func TestQueryUnavailable(t *testing.T) {
+	root := hostRoot(t, map[string]string{
+		"/proc/uptime": "",
+		// wireless without interfaces
+		"/proc/net/wireless": `Inter-| sta-|   Quality        |   Discarded packets               | Missed | WE
+ face | tus | link level noise |  nwid  crypt   frag  retry   misc | beacon | 22
+`,
+	})
+	defer os.RemoveAll(root)
+
+	d := &Host{root: root}
+
+	if _, err := d.Query(nil); err == nil {
+		t.Error("expected error")
+	}
+	if _, err := d.Probe(nil); err == nil {
+		t.Error("expected error")
+	}
+
+	// available metrics are reported only
+	if err := ioutil.WriteFile(filepath.Join(root, "/proc/loadavg"), []byte("1.5 1.0 0.5\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := d.Query(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || res[0].Measurement != meters.LoadAverage || res[0].Value != 1.5 {
+		t.Errorf("unexpected results %v", res)
+	}
+}
@@ -0,0 +1,140 @@
+package meters
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/grid-x/modbus"
+)
+
+const (
+	funcCodeMEI         = 0x2B // encapsulated interface transport
+	meiReadDeviceID     = 0x0E // read device identification
+	readDeviceIDBasic   = 0x01 // basic device identification stream
+	maxDeviceIDRequests = 8    // limits requests for devices that always report more objects
+)
+
+// basic device identification objects
+const (
+	objectVendorName = iota
+	objectProductCode
+	objectRevision
+)
+
+// ErrInvalidIdentification indicates a malformed device identification response
+var ErrInvalidIdentification = errors.New("invalid device identification response")
+
+// DeviceIdentification is the device's basic identification as read using the
+// MEI Read Device Identification function (FC 43/14)
+type DeviceIdentification struct {
+	Vendor      string `json:"vendor,omitempty"`
+	ProductCode string `json:"productCode,omitempty"`
+	Revision    string `json:"revision,omitempty"`
+}
+
+// DeviceIdentifier is implemented by connections supporting the MEI Read Device Identification function.
+// Serial RTU connections don't support it as the modbus library can't receive its responses.
+type DeviceIdentifier interface {
+	// ReadDeviceIdentification reads the current slave's basic device identification
+	ReadDeviceIdentification() (DeviceIdentification, error)
+}
+
+// readDeviceIdentification reads the basic device identification objects. Objects not fitting
+// into a single response are read using additional requests starting at the next object.
+func readDeviceIdentification(handler modbus.ClientHandler) (DeviceIdentification, error) {
+	var res DeviceIdentification
+
+	objectID := byte(objectVendorName)
+	for i := 0; i < maxDeviceIDRequests; i++ {
+		data, err := sendMEI(handler, []byte{meiReadDeviceID, readDeviceIDBasic, objectID})
+		if err != nil {
+			return res, err
+		}
+
+		more, next, err := res.decode(data)
+		if err != nil || !more {
+			return res, err
+		}
+
+		objectID = next
+	}
+
+	return res, nil
+}
+
+// sendMEI sends an encapsulated interface transport request and returns the response data
+func sendMEI(handler modbus.ClientHandler, data []byte) ([]byte, error) {
+	request := &modbus.ProtocolDataUnit{
+		FunctionCode: funcCodeMEI,
+		Data:         data,
+	}
+
+	aduRequest, err := handler.Encode(request)
+	if err != nil {
+		return nil, err
+	}
+
+	aduResponse, err := handler.Send(aduRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := handler.Verify(aduRequest, aduResponse); err != nil {
+		return nil, err
+	}
+
+	response, err := handler.Decode(aduResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.FunctionCode != request.FunctionCode {
+		mbError := &modbus.Error{FunctionCode: response.FunctionCode}
+		if len(response.Data) > 0 {
+			mbError.ExceptionCode = response.Data[0]
+		}
+		return nil, mbError
+	}
+
+	return response.Data, nil
+}
+
+// decode parses the response's identification objects and returns if more
+// objects are available starting at the next object id
+func (d *DeviceIdentification) decode(data []byte) (more bool, next byte, err error) {
+	// mei type, read device id code, conformity level, more follows, next object id, number of objects
+	if len(data) < 6 || data[0] != meiReadDeviceID {
+		return false, 0, ErrInvalidIdentification
+	}
+
+	more = data[3] == 0xFF
+	next = data[4]
+
+	objects := int(data[5])
+	data = data[6:]
+
+	for i := 0; i < objects; i++ {
+		if len(data) < 2 || len(data) < 2+int(data[1]) {
+			return false, 0, fmt.Errorf("%w: object %d truncated", ErrInvalidIdentification, i)
+		}
+
+		id, value := data[0], string(data[2:2+int(data[1])])
+		data = data[2+int(data[1]):]
+
+		switch id {
+		case objectVendorName:
+			d.Vendor = value
+		case objectProductCode:
+			d.ProductCode = value
+		case objectRevision:
+			d.Revision = value
+		}
+	}
+
+	// the basic stream ends with the revision object
+	if more && next <= objectRevision && next != 0 {
+		return more, next, nil
+	}
+
+	return false, 0, nil
+}
@@ -0,0 +1,44 @@
+package meters
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDecodeDeviceIdentification(t *testing.T) {
+	var id DeviceIdentification
+
+	// vendor and product code, more objects follow starting at revision
+	more, next, err := id.decode([]byte{
+		0x0E, 0x01, 0x01, 0xFF, 0x02, 0x02,
+		0x00, 0x03, 'A', 'B', 'B',
+		0x01, 0x02, 'B', '2',
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !more || next != objectRevision {
+		t.Errorf("expected more objects starting at %d, got %v %d", objectRevision, more, next)
+	}
+
+	more, _, err = id.decode([]byte{
+		0x0E, 0x01, 0x01, 0x00, 0x00, 0x01,
+		0x02, 0x04, '1', '.', '0', '2',
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if more {
+		t.Error("expected no more objects")
+	}
+
+	expected := DeviceIdentification{Vendor: "ABB", ProductCode: "B2", Revision: "1.02"}
+	if id != expected {
+		t.Errorf("expected %+v, got %+v", expected, id)
+	}
+
+	// truncated object
+	if _, _, err := id.decode([]byte{0x0E, 0x01, 0x01, 0x00, 0x00, 0x01, 0x00, 0x05, 'A'}); !errors.Is(err, ErrInvalidIdentification) {
+		t.Errorf("expected invalid identification error, got %v", err)
+	}
+}
@@ -0,0 +1,209 @@
+package meters
+
+import (
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/log"
+)
+
+const (
+	limiterWindow   = 10 * time.Second // period the current transaction rate is calculated from
+	limiterBurst    = time.Second      // transactions below limit during this period may be sent back-to-back
+	limiterLogDelay = time.Minute      // minimum delay between logging saturated buses
+)
+
+// LimiterStatus represents a bus's transaction rate compared to its limit
+type LimiterStatus struct {
+	Limit       float64 // transactions per second
+	Rate        float64 // transactions per second during the last 10 seconds
+	Utilization float64 // percent of limit
+	Throttled   float64 // total seconds transactions have been delayed
+}
+
+// limiter delays transactions exceeding the limit. Short bursts within the limit are not delayed.
+type limiter struct {
+	mux       sync.Mutex
+	bus       string
+	limit     float64
+	interval  time.Duration
+	start     time.Time
+	tat       time.Time   // theoretical arrival time of the next transaction
+	recent    []time.Time // transactions within window
+	throttled time.Duration
+	logged    time.Time
+}
+
+// wait delays the transaction until it is within the limit
+func (l *limiter) wait() {
+	l.mux.Lock()
+
+	now := time.Now()
+	if l.tat.Before(now) {
+		l.tat = now
+	}
+
+	var delay time.Duration
+	if tolerance := limiterBurst - l.interval; tolerance > 0 {
+		delay = l.tat.Add(-tolerance).Sub(now)
+	} else {
+		delay = l.tat.Sub(now)
+	}
+	if delay < 0 {
+		delay = 0
+	}
+
+	l.tat = l.tat.Add(l.interval)
+	l.throttled += delay
+	l.recent = append(l.recent, now.Add(delay))
+
+	if delay > 0 && now.Sub(l.logged) > limiterLogDelay {
+		l.logged = now
+		log.Warnf("%s: bus saturated, limiting to %g transactions per second", l.bus, l.limit)
+	}
+
+	l.mux.Unlock()
+
+	time.Sleep(delay)
+}
+
+// Status returns the transaction rate within the window
+func (l *limiter) Status() LimiterStatus {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	now := time.Now()
+	for len(l.recent) > 0 && now.Sub(l.recent[0]) > limiterWindow {
+		l.recent = l.recent[1:]
+	}
+
+	window := limiterWindow
+	if elapsed := now.Sub(l.start); elapsed < window {
+		window = elapsed
+	}
+
+	var rate float64
+	if window > 0 {
+		rate = float64(len(l.recent)) / window.Seconds()
+	}
+
+	return LimiterStatus{
+		Limit:       l.limit,
+		Rate:        rate,
+		Utilization: 100 * rate / l.limit,
+		Throttled:   l.throttled.Seconds(),
+	}
+}
+
+// Limiter is a connection that limits the transactions per second of the wrapped connection.
+// Transactions exceeding the limit are delayed instead of overloading the bus.
+type Limiter struct {
+	Connection
+	limiter *limiter
+	client  *limitingClient
+}
+
+// NewLimiter wraps a connection for limiting transactions to limit per second
+func NewLimiter(conn Connection, limit float64) *Limiter {
+	l := &Limiter{
+		Connection: conn,
+		limiter: &limiter{
+			bus:      conn.String(),
+			limit:    limit,
+			interval: time.Duration(float64(time.Second) / limit),
+			start:    time.Now(),
+		},
+	}
+
+	if client := conn.ModbusClient(); client != nil {
+		l.client = &limitingClient{Client: client, limiter: l.limiter}
+	}
+
+	return l
+}
+
+// ModbusClient returns the limiting modbus client
+func (l *Limiter) ModbusClient() modbus.Client {
+	if l.client == nil {
+		return nil
+	}
+	return l.client
+}
+
+// Status returns the bus's current transaction rate
+func (l *Limiter) Status() LimiterStatus {
+	return l.limiter.Status()
+}
+
+// limitingClient delays all operations exceeding the limit
+type limitingClient struct {
+	modbus.Client
+	limiter *limiter
+}
+
+// ReadCoils implements modbus.Client
+func (c *limitingClient) ReadCoils(address, quantity uint16) ([]byte, error) {
+	c.limiter.wait()
+	return c.Client.ReadCoils(address, quantity)
+}
+
+// ReadDiscreteInputs implements modbus.Client
+func (c *limitingClient) ReadDiscreteInputs(address, quantity uint16) ([]byte, error) {
+	c.limiter.wait()
+	return c.Client.ReadDiscreteInputs(address, quantity)
+}
+
+// WriteSingleCoil implements modbus.Client
+func (c *limitingClient) WriteSingleCoil(address, value uint16) ([]byte, error) {
+	c.limiter.wait()
+	return c.Client.WriteSingleCoil(address, value)
+}
+
+// WriteMultipleCoils implements modbus.Client
+func (c *limitingClient) WriteMultipleCoils(address, quantity uint16, value []byte) ([]byte, error) {
+	c.limiter.wait()
+	return c.Client.WriteMultipleCoils(address, quantity, value)
+}
+
+// ReadInputRegisters implements modbus.Client
+func (c *limitingClient) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
+	c.limiter.wait()
+	return c.Client.ReadInputRegisters(address, quantity)
+}
+
+// ReadHoldingRegisters implements modbus.Client
+func (c *limitingClient) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	c.limiter.wait()
+	return c.Client.ReadHoldingRegisters(address, quantity)
+}
+
+// WriteSingleRegister implements modbus.Client
+func (c *limitingClient) WriteSingleRegister(address, value uint16) ([]byte, error) {
+	c.limiter.wait()
+	return c.Client.WriteSingleRegister(address, value)
+}
+
+// WriteMultipleRegisters implements modbus.Client
+func (c *limitingClient) WriteMultipleRegisters(address, quantity uint16, value []byte) ([]byte, error) {
+	c.limiter.wait()
+	return c.Client.WriteMultipleRegisters(address, quantity, value)
+}
+
+// ReadWriteMultipleRegisters implements modbus.Client
+func (c *limitingClient) ReadWriteMultipleRegisters(readAddress, readQuantity, writeAddress, writeQuantity uint16, value []byte) ([]byte, error) {
+	c.limiter.wait()
+	return c.Client.ReadWriteMultipleRegisters(readAddress, readQuantity, writeAddress, writeQuantity, value)
+}
+
+// MaskWriteRegister implements modbus.Client
+func (c *limitingClient) MaskWriteRegister(address, andMask, orMask uint16) ([]byte, error) {
+	c.limiter.wait()
+	return c.Client.MaskWriteRegister(address, andMask, orMask)
+}
+
+// ReadFIFOQueue implements modbus.Client
+func (c *limitingClient) ReadFIFOQueue(address uint16) ([]byte, error) {
+	c.limiter.wait()
+	return c.Client.ReadFIFOQueue(address)
+}
@@ -0,0 +1,33 @@
+package meters
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiter(t *testing.T) {
+	l := NewLimiter(NewMock("mock"), 100)
+
+	// burst within limit is not delayed
+	start := time.Now()
+	for i := 0; i < 100; i++ {
+		l.limiter.wait()
+	}
+	if d := time.Since(start); d > 50*time.Millisecond {
+		t.Errorf("expected burst without delay, took %v", d)
+	}
+
+	// transactions exceeding the limit are spaced
+	start = time.Now()
+	for i := 0; i < 5; i++ {
+		l.limiter.wait()
+	}
+	if d := time.Since(start); d < 30*time.Millisecond {
+		t.Errorf("expected transactions to be delayed, took %v", d)
+	}
+
+	status := l.Status()
+	if status.Limit != 100 || status.Throttled == 0 || status.Rate == 0 || status.Utilization != status.Rate {
+		t.Errorf("unexpected status %+v", status)
+	}
+}
@@ -1,5 +1,7 @@
 package meters
 
+import "sync"
+
 type device struct {
 	id  uint8
 	dev Device
@@ -7,6 +9,7 @@ type device struct {
 
 // Manager handles devices attached to a connection
 type Manager struct {
+	mux     sync.Mutex
 	devices []device
 	Conn    Connection
 }
@@ -27,25 +30,52 @@ func (m *Manager) Add(id uint8, dev Device) error {
 		dev: dev,
 	}
 
+	m.mux.Lock()
 	m.devices = append(m.devices, device)
+	m.mux.Unlock()
+
 	return nil
 }
 
+// Remove removes the device from the device manager. It returns false if the device was not found.
+func (m *Manager) Remove(dev Device) bool {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	for i, d := range m.devices {
+		if d.dev == dev {
+			m.devices = append(m.devices[:i:i], m.devices[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
 // Count returns the number of devices attached to the connection
 func (m *Manager) Count() int {
+	m.mux.Lock()
+	defer m.mux.Unlock()
 	return len(m.devices)
 }
 
+// snapshot returns a copy of the devices such that callbacks can modify the manager
+func (m *Manager) snapshot() []device {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	return append([]device(nil), m.devices...)
+}
+
 // All iterates over all devices and executes the callback per device.
 func (m *Manager) All(cb func(uint8, Device)) {
-	for _, device := range m.devices {
+	for _, device := range m.snapshot() {
 		cb(device.id, device.dev)
 	}
 }
 
 // Find iterates over devices and executes the callback per device until true is returned.
 func (m *Manager) Find(cb func(uint8, Device) bool) bool {
-	for _, device := range m.devices {
+	for _, device := range m.snapshot() {
 		if cb(device.id, device.dev) {
 			return true
 		}
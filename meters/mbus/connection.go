@@ -0,0 +1,251 @@
+package mbus
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/grid-x/serial"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+const (
+	// defaultTimeout is the maximum time waiting for a response, long frames take about 1s at 2400 baud
+	defaultTimeout = 3 * time.Second
+
+	// readTimeout is the timeout of a single serial read
+	readTimeout = 300 * time.Millisecond
+
+	// defaultBaudrate and defaultComset are the usual communication parameters of M-Bus meters
+	defaultBaudrate = 2400
+	defaultComset   = "8E1"
+)
+
+const (
+	frameAck   = 0xe5
+	frameShort = 0x10
+	frameLong  = 0x68
+	frameStop  = 0x16
+
+	cSndNke = 0x40 // initialize slave, resets the frame count bit
+	cReqUd2 = 0x7b // request user data class 2 with frame count bit set
+)
+
+// errChecksum is returned for frames with invalid checksum
+var errChecksum = errors.New("invalid checksum")
+
+// Connection is an M-Bus master on a serial level converter. It provides no modbus client
+// but requests the user data of the meter at the current primary address.
+type Connection struct {
+	config  serial.Config
+	mux     sync.Mutex // guard port
+	port    io.ReadWriteCloser
+	address uint8
+	timeout time.Duration
+	logger  meters.Logger
+}
+
+// NewConnection creates an M-Bus connection. The comset may override the baudrate,
+// without baudrate and comset the usual 2400 8E1 are used.
+func NewConnection(device string, baudrate int, comset string) (*Connection, error) {
+	if comset == "" {
+		comset = defaultComset
+	}
+	if baudrate == 0 {
+		baudrate = defaultBaudrate
+	}
+
+	cs, err := meters.ParseComset(comset, baudrate)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Connection{
+		config: serial.Config{
+			Address:  device,
+			BaudRate: cs.Baudrate,
+			DataBits: cs.DataBits,
+			Parity:   cs.Parity,
+			StopBits: cs.StopBits,
+			Timeout:  readTimeout,
+		},
+		timeout: defaultTimeout,
+	}
+
+	return c, nil
+}
+
+// String returns the serial device
+func (c *Connection) String() string {
+	return fmt.Sprintf("mbus:%s", c.config.Address)
+}
+
+// ModbusClient returns the connection itself. Devices request user data from it, modbus requests fail.
+func (c *Connection) ModbusClient() modbus.Client {
+	return c
+}
+
+// Logger sets a logging instance for sent and received frames
+func (c *Connection) Logger(l meters.Logger) {
+	c.logger = l
+}
+
+// Slave sets the primary address of the meter for the following requests
+func (c *Connection) Slave(deviceID uint8) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.address = deviceID
+}
+
+// Timeout sets the maximum time waiting for a response
+func (c *Connection) Timeout(timeout time.Duration) time.Duration {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	t := c.timeout
+	c.timeout = timeout
+	return t
+}
+
+// Close closes the serial port. It is reopened by the next request.
+func (c *Connection) Close() {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.close()
+}
+
+func (c *Connection) close() {
+	if c.port != nil {
+		c.port.Close()
+		c.port = nil
+	}
+}
+
+// RequestUserData initializes the meter at the current primary address and returns the
+// control information and data of its class 2 user data response.
+func (c *Connection) RequestUserData() ([]byte, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if c.port == nil {
+		port, err := serial.Open(&c.config)
+		if err != nil {
+			return nil, err
+		}
+		c.port = port
+	}
+
+	if _, err := c.request(cSndNke, extractAck); err != nil {
+		return nil, fmt.Errorf("initialization failed: %v", err)
+	}
+
+	b, err := c.request(cReqUd2, extractLong)
+	if err != nil {
+		return nil, err
+	}
+
+	// control field, address, control information and data. Meters answer the broadcast address 254 with their own.
+	if len(b) < 3 || (b[1] != c.address && c.address != 0xfe) {
+		return nil, fmt.Errorf("unexpected response % x", b)
+	}
+
+	return b[2:], nil
+}
+
+// request sends a short frame and waits for the response
+func (c *Connection) request(control byte, extract func([]byte) ([]byte, []byte, error)) ([]byte, error) {
+	req := shortFrame(control, c.address)
+	if c.logger != nil {
+		c.logger.Printf("%s: send % x", c, req)
+	}
+
+	if _, err := c.port.Write(req); err != nil {
+		c.close()
+		return nil, err
+	}
+
+	deadline := time.Now().Add(c.timeout)
+	buf := make([]byte, 0, 256)
+	chunk := make([]byte, 256)
+
+	for time.Now().Before(deadline) {
+		n, err := c.port.Read(chunk)
+		if err != nil && err != serial.ErrTimeout {
+			c.close()
+			return nil, err
+		}
+
+		buf = append(buf, chunk[:n]...)
+
+		frame, _, err := extract(buf)
+		if err != nil {
+			return nil, err
+		}
+		if frame != nil {
+			if c.logger != nil {
+				c.logger.Printf("%s: received % x", c, frame)
+			}
+			return frame, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no response from address %d within %v", c.address, c.timeout)
+}
+
+// shortFrame encodes a short frame with control field and address
+func shortFrame(control, address byte) []byte {
+	return []byte{frameShort, control, address, control + address, frameStop}
+}
+
+// checksum is the arithmetic sum of the frame's control field, address and data
+func checksum(b []byte) byte {
+	var sum byte
+	for _, v := range b {
+		sum += v
+	}
+	return sum
+}
+
+// extractAck returns the single character acknowledge
+func extractAck(buf []byte) ([]byte, []byte, error) {
+	if i := bytes.IndexByte(buf, frameAck); i >= 0 {
+		return buf[i : i+1], buf[i+1:], nil
+	}
+	return nil, buf, nil
+}
+
+// extractLong returns control field, address and data of the first complete long frame.
+// Long frames start with 68 L L 68 and end with checksum and 16.
+func extractLong(buf []byte) ([]byte, []byte, error) {
+	start := bytes.IndexByte(buf, frameLong)
+	if start < 0 {
+		return nil, nil, nil
+	}
+	buf = buf[start:]
+
+	if len(buf) < 4 {
+		return nil, buf, nil
+	}
+	if buf[1] != buf[2] || buf[3] != frameLong || buf[1] < 3 {
+		return nil, buf[1:], fmt.Errorf("invalid frame header % x", buf[:4])
+	}
+
+	end := 4 + int(buf[1]) + 2
+	if len(buf) < end {
+		return nil, buf, nil
+	}
+
+	frame := buf[4 : end-2]
+	if buf[end-1] != frameStop {
+		return nil, buf[end:], errors.New("missing stop character")
+	}
+	if checksum(frame) != buf[end-2] {
+		return nil, buf[end:], errChecksum
+	}
+
+	return frame, buf[end:], nil
+}
@@ -0,0 +1,148 @@
+package mbus
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// METERTYPE_MBUS is the device type of M-Bus meters
+const METERTYPE_MBUS = "MBUS"
+
+// quantities maps the quantities of heat, water and gas meters to measurements
+var quantities = map[quantity]meters.Measurement{
+	qEnergy:         meters.HeatEnergy,
+	qVolume:         meters.Volume,
+	qOperatingTime:  meters.OperatingHours,
+	qPower:          meters.HeatPower,
+	qVolumeFlow:     meters.VolumeFlow,
+	qFlowTemp:       meters.FlowTemp,
+	qReturnTemp:     meters.ReturnTemp,
+	qTempDifference: meters.TempDifference,
+}
+
+// UserDataRequester requests the user data of M-Bus meters. It is implemented by the modbus client of M-Bus connections.
+type UserDataRequester interface {
+	RequestUserData() ([]byte, error)
+}
+
+// Device is a heat, water or electricity meter polled via M-Bus. The current values of the
+// meter's main unit are mapped to measurements, tariffs and stored values are ignored.
+type Device struct {
+	mux        sync.Mutex // guard descriptor
+	descriptor meters.DeviceDescriptor
+}
+
+// NewDevice creates an M-Bus meter
+func NewDevice() *Device {
+	return &Device{
+		descriptor: meters.DeviceDescriptor{
+			Type:         METERTYPE_MBUS,
+			Manufacturer: METERTYPE_MBUS,
+			Model:        "M-Bus meter",
+		},
+	}
+}
+
+// Initialize implements the Device interface
+func (d *Device) Initialize(client modbus.Client) error {
+	return nil
+}
+
+// Descriptor implements the Device interface. Manufacturer, medium and identification number
+// are available once the meter has responded.
+func (d *Device) Descriptor() meters.DeviceDescriptor {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	return d.descriptor
+}
+
+// Probe implements the Device interface
+func (d *Device) Probe(client modbus.Client) (res meters.MeasurementResult, err error) {
+	results, err := d.Query(client)
+	if err != nil {
+		return res, err
+	}
+	return results[0], nil
+}
+
+// Query implements the Device interface
+func (d *Device) Query(client modbus.Client) ([]meters.MeasurementResult, error) {
+	requester, ok := client.(UserDataRequester)
+	if !ok {
+		return nil, fmt.Errorf("%s devices require an mbus adapter", METERTYPE_MBUS)
+	}
+
+	b, err := requester.RequestUserData()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.decode(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res) == 0 {
+		return nil, errors.New("response contains no supported measurements")
+	}
+
+	return res, nil
+}
+
+// decode converts the current values of the variable data response to measurement results
+func (d *Device) decode(b []byte) ([]meters.MeasurementResult, error) {
+	h, records, err := parseUserData(b)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response: %v", err)
+	}
+
+	if h.manufacturer != "" {
+		d.mux.Lock()
+		d.descriptor.Manufacturer = h.manufacturer
+		if medium, ok := media[h.medium]; ok {
+			d.descriptor.Model = medium
+		}
+		d.descriptor.Version = fmt.Sprintf("%d", h.version)
+		d.descriptor.Serial = h.id
+		d.mux.Unlock()
+	}
+
+	ts := time.Now()
+	res := make([]meters.MeasurementResult, 0, len(records))
+	seen := make(map[meters.Measurement]bool, len(records))
+
+	for _, r := range records {
+		if !r.current() {
+			continue
+		}
+
+		m, ok := quantities[r.quantity]
+		if h.medium == mediumElectricity {
+			// electricity meters report active energy and power
+			switch r.quantity {
+			case qEnergy:
+				m = meters.Import
+			case qPower:
+				m = meters.Power
+			}
+		}
+
+		if !ok || seen[m] {
+			continue
+		}
+		seen[m] = true
+
+		res = append(res, meters.MeasurementResult{
+			Measurement: m,
+			Value:       r.value,
+			Timestamp:   ts,
+		})
+	}
+
+	return res, nil
+}
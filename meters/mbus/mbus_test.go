@@ -0,0 +1,144 @@
+package mbus
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// userData is a heat meter response with fixed header, current and stored values
+const userData = "72" + "78563412" + "2d2c" + "01" + "04" + "27" + "00" + "0000" +
+	"0406" + "10270000" + // energy 10000 kWh
+	"0413" + "e8030000" + // volume 1 m³
+	"4406" + "0f270000" + // stored energy
+	"025a" + "2c01" + // flow temperature 30.0 °C
+	"025e" + "1401" + // return temperature 27.6 °C
+	"0262" + "1800" + // temperature difference 2.4 K
+	"2f" + // idle filler
+	"0c2b" + "50020000" + // power 250 W BCD
+	"023b" + "6400" + // volume flow 0.1 m³/h
+	"841006" + "01000000" + // tariff 1 energy
+	"04fd17" + "00000000" + // error flags
+	"0f" + "0102" // manufacturer specific data
+
+func decodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(strings.ReplaceAll(s, " ", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// longFrame encodes a long frame with control field, address and data
+func longFrame(address byte, data []byte) []byte {
+	payload := append([]byte{0x08, address}, data...)
+	b := []byte{frameLong, byte(len(payload)), byte(len(payload)), frameLong}
+	b = append(b, payload...)
+	return append(b, checksum(payload), frameStop)
+}
+
+func TestShortFrame(t *testing.T) {
+	if b := shortFrame(cReqUd2, 1); !bytes.Equal(b, []byte{0x10, 0x7b, 0x01, 0x7c, 0x16}) {
+		t.Errorf("unexpected frame % x", b)
+	}
+	if b := shortFrame(cSndNke, 0xfe); !bytes.Equal(b, []byte{0x10, 0x40, 0xfe, 0x3e, 0x16}) {
+		t.Errorf("unexpected frame % x", b)
+	}
+}
+
+func TestExtractLong(t *testing.T) {
+	data := decodeHex(t, userData)
+	frame := longFrame(1, data)
+
+	b, rest, err := extractLong(append(append([]byte{0x00}, frame...), 0xe5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b[0] != 0x08 || b[1] != 1 || !bytes.Equal(b[2:], data) || !bytes.Equal(rest, []byte{0xe5}) {
+		t.Errorf("unexpected frame % x rest % x", b, rest)
+	}
+
+	if b, _, _ := extractLong(frame[:len(frame)-1]); b != nil {
+		t.Errorf("expected incomplete frame, got % x", b)
+	}
+
+	frame[len(frame)-2]++
+	if _, _, err := extractLong(frame); err != errChecksum {
+		t.Errorf("expected checksum error, got %v", err)
+	}
+}
+
+func TestBCD(t *testing.T) {
+	tc := []struct {
+		b  []byte
+		v  float64
+		ok bool
+	}{
+		{[]byte{0x50, 0x02, 0x00, 0x00}, 250, true},
+		{[]byte{0x34, 0x12}, 1234, true},
+		{[]byte{0x34, 0xf2}, -234, true},
+		{[]byte{0x3a, 0x12}, 0, false},
+	}
+
+	for _, tc := range tc {
+		if v, ok := bcd(tc.b); v != tc.v || ok != tc.ok {
+			t.Errorf("% x: expected %f %v, got %f %v", tc.b, tc.v, tc.ok, v, ok)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	d := NewDevice()
+	res, err := d.decode(decodeHex(t, userData))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[meters.Measurement]float64{
+		meters.HeatEnergy:     10000,
+		meters.Volume:         1,
+		meters.FlowTemp:       30,
+		meters.ReturnTemp:     27.6,
+		meters.TempDifference: 2.4,
+		meters.HeatPower:      250,
+		meters.VolumeFlow:     0.1,
+	}
+
+	if len(res) != len(expected) {
+		t.Fatalf("expected %d results, got %v", len(expected), res)
+	}
+	for _, r := range res {
+		if v, ok := expected[r.Measurement]; !ok || v-r.Value > 1e-9 || r.Value-v > 1e-9 {
+			t.Errorf("unexpected result %s %f", r.Measurement, r.Value)
+		}
+	}
+
+	desc := d.Descriptor()
+	if desc.Manufacturer != "KAM" || desc.Model != "Heat meter" || desc.Serial != "12345678" || desc.Version != "1" {
+		t.Errorf("unexpected descriptor %v", desc)
+	}
+}
+
+func TestDecodeElectricity(t *testing.T) {
+	data := "72" + "78563412" + "2d2c" + "01" + "02" + "27" + "00" + "0000" +
+		"0404" + "39300000" + // energy 123.45 kWh
+		"042b" + "e8030000" // power 1000 W
+
+	res, err := NewDevice().decode(decodeHex(t, data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res) != 2 || res[0].Measurement != meters.Import || res[0].Value-123.45 > 1e-9 || res[1].Measurement != meters.Power {
+		t.Errorf("unexpected results %v", res)
+	}
+}
+
+func TestQueryRequiresAdapter(t *testing.T) {
+	if _, err := NewDevice().Query(meters.NewMockClient(0)); err == nil {
+		t.Error("expected error for modbus client")
+	}
+}
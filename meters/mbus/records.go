@@ -0,0 +1,275 @@
+package mbus
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// media are the descriptions of the medium of the fixed data header
+var media = map[byte]string{
+	0x02: "Electricity meter",
+	0x03: "Gas meter",
+	0x04: "Heat meter",
+	0x06: "Warm water meter",
+	0x07: "Water meter",
+	0x0a: "Cooling meter",
+	0x0c: "Heat meter",
+	0x0d: "Heat and cooling meter",
+	0x15: "Hot water meter",
+	0x16: "Cold water meter",
+}
+
+const mediumElectricity = 0x02
+
+// quantity is the physical quantity of a value information field
+type quantity int
+
+const (
+	qUnknown        quantity = iota
+	qEnergy                  // kWh
+	qVolume                  // m³
+	qOperatingTime           // h
+	qPower                   // W
+	qVolumeFlow              // m³/h
+	qFlowTemp                // °C
+	qReturnTemp              // °C
+	qTempDifference          // K
+)
+
+// header is the fixed data header of a variable data response
+type header struct {
+	id           string // 8 digit identification number
+	manufacturer string // three letter manufacturer id
+	version      byte
+	medium       byte
+}
+
+// record is a variable data record converted to the quantity's unit
+type record struct {
+	quantity quantity
+	value    float64
+	storage  int  // storage number, 0 is the current value
+	tariff   int  // tariff, 0 is the total
+	subunit  int  // subunit of the meter
+	function byte // 0 instantaneous, 1 maximum, 2 minimum, 3 error value
+}
+
+// current returns true for the instantaneous total values of the main unit
+func (r record) current() bool {
+	return r.storage == 0 && r.tariff == 0 && r.subunit == 0 && r.function == 0
+}
+
+// parseUserData decodes the fixed data header and the variable data records of a
+// variable data response starting with the control information field
+func parseUserData(b []byte) (header, []record, error) {
+	var h header
+
+	if len(b) == 0 {
+		return h, nil, errors.New("empty response")
+	}
+
+	// CI 72 long header, 7a short header without identification
+	switch b[0] {
+	case 0x72:
+		if len(b) < 13 {
+			return h, nil, errors.New("short header")
+		}
+		h.id = fmt.Sprintf("%08x", binary.LittleEndian.Uint32(b[1:5]))
+		h.manufacturer = manufacturer(binary.LittleEndian.Uint16(b[5:7]))
+		h.version = b[7]
+		h.medium = b[8]
+		b = b[13:]
+	case 0x7a:
+		if len(b) < 5 {
+			return h, nil, errors.New("short header")
+		}
+		b = b[5:]
+	default:
+		return h, nil, fmt.Errorf("unsupported control information %02x", b[0])
+	}
+
+	var res []record
+	for len(b) > 0 {
+		r, n, err := parseRecord(b)
+		if err == errEndOfRecords {
+			break
+		}
+		if err != nil {
+			return h, res, err
+		}
+		if r.quantity != qUnknown {
+			res = append(res, r)
+		}
+		b = b[n:]
+	}
+
+	return h, res, nil
+}
+
+// manufacturer decodes the three letter manufacturer id of five bits per letter
+func manufacturer(v uint16) string {
+	return string([]byte{byte(v>>10&0x1f) + 64, byte(v>>5&0x1f) + 64, byte(v&0x1f) + 64})
+}
+
+// errEndOfRecords marks the start of manufacturer specific data
+var errEndOfRecords = errors.New("end of records")
+
+// parseRecord decodes the data record and returns the number of bytes consumed
+func parseRecord(b []byte) (record, int, error) {
+	var r record
+
+	dif := b[0]
+	switch dif {
+	case 0x2f:
+		return r, 1, nil // idle filler
+	case 0x0f, 0x1f:
+		return r, 0, errEndOfRecords
+	}
+
+	r.function = dif >> 4 & 0x03
+	r.storage = int(dif >> 6 & 0x01)
+
+	pos := 1
+	for ext, shift := dif&0x80 != 0, uint(1); ext; shift += 4 {
+		if pos >= len(b) {
+			return r, 0, errors.New("incomplete record")
+		}
+		dife := b[pos]
+		r.storage |= int(dife&0x0f) << shift
+		r.tariff |= int(dife>>4&0x03) << ((shift - 1) / 2)
+		r.subunit |= int(dife>>6&0x01) << ((shift - 1) / 4)
+		ext = dife&0x80 != 0
+		pos++
+	}
+
+	if pos >= len(b) {
+		return r, 0, errors.New("incomplete record")
+	}
+
+	vif := b[pos]
+	pos++
+
+	// extensions like the tables fb and fd change the meaning of the value and are not supported
+	extended := vif&0x80 != 0
+	for ext := extended; ext; pos++ {
+		if pos >= len(b) {
+			return r, 0, errors.New("incomplete record")
+		}
+		ext = b[pos]&0x80 != 0
+	}
+
+	// plain text unit
+	if vif&0x7f == 0x7c {
+		if pos >= len(b) {
+			return r, 0, errors.New("incomplete record")
+		}
+		pos += 1 + int(b[pos])
+	}
+
+	value, size, err := decodeData(dif&0x0f, b[pos:])
+	if err != nil {
+		return r, 0, err
+	}
+	pos += size
+
+	if !extended && value != nil {
+		r.quantity, r.value = decodeValue(vif&0x7f, *value)
+	}
+
+	return r, pos, nil
+}
+
+// decodeData decodes the data field. Values are nil for date and time, selection for readout or invalid BCD values.
+func decodeData(field byte, b []byte) (*float64, int, error) {
+	sizes := [16]int{0, 1, 2, 3, 4, 4, 6, 8, 0, 1, 2, 3, 4, -1, 6, 0}
+
+	size := sizes[field]
+	if field == 0x0d {
+		// variable length
+		if len(b) == 0 {
+			return nil, 0, errors.New("incomplete record")
+		}
+		size = 1 + int(b[0])
+	}
+
+	if len(b) < size {
+		return nil, 0, errors.New("incomplete record")
+	}
+	data := b[:size]
+
+	var v float64
+	switch field {
+	case 0x01, 0x02, 0x03, 0x04, 0x06, 0x07:
+		var u uint64
+		for i := size - 1; i >= 0; i-- {
+			u = u<<8 | uint64(data[i])
+		}
+		// sign extension
+		shift := uint(64 - 8*size)
+		v = float64(int64(u<<shift) >> shift)
+	case 0x05:
+		v = float64(math.Float32frombits(binary.LittleEndian.Uint32(data)))
+	case 0x09, 0x0a, 0x0b, 0x0c, 0x0e:
+		var ok bool
+		if v, ok = bcd(data); !ok {
+			return nil, size, nil
+		}
+	default:
+		return nil, size, nil
+	}
+
+	return &v, size, nil
+}
+
+// bcd decodes little endian BCD values. A leading F nibble marks negative values.
+func bcd(b []byte) (float64, bool) {
+	var v, sign float64 = 0, 1
+	for i := len(b) - 1; i >= 0; i-- {
+		hi, lo := b[i]>>4, b[i]&0x0f
+		if i == len(b)-1 && hi == 0x0f {
+			sign, hi = -1, 0
+		}
+		if hi > 9 || lo > 9 {
+			return 0, false
+		}
+		v = v*100 + float64(hi)*10 + float64(lo)
+	}
+	return sign * v, true
+}
+
+// decodeValue converts the value to the unit of the primary value information field's quantity
+func decodeValue(vif byte, v float64) (quantity, float64) {
+	n := int(vif & 0x07)
+	nn := int(vif & 0x03)
+
+	switch {
+	case vif <= 0x07: // energy 10^(n-3) Wh
+		return qEnergy, v * math.Pow10(n-6)
+	case vif <= 0x0f: // energy 10^n J
+		return qEnergy, v * math.Pow10(n) / 3.6e6
+	case vif <= 0x17: // volume 10^(n-6) m³
+		return qVolume, v * math.Pow10(n-6)
+	case vif >= 0x24 && vif <= 0x27: // operating time
+		return qOperatingTime, v * [4]float64{1.0 / 3600, 1.0 / 60, 1, 24}[nn]
+	case vif >= 0x28 && vif <= 0x2f: // power 10^(n-3) W
+		return qPower, v * math.Pow10(n-3)
+	case vif >= 0x30 && vif <= 0x37: // power 10^n J/h
+		return qPower, v * math.Pow10(n) / 3600
+	case vif >= 0x38 && vif <= 0x3f: // volume flow 10^(n-6) m³/h
+		return qVolumeFlow, v * math.Pow10(n-6)
+	case vif >= 0x40 && vif <= 0x47: // volume flow 10^(n-7) m³/min
+		return qVolumeFlow, v * math.Pow10(n-7) * 60
+	case vif >= 0x48 && vif <= 0x4f: // volume flow 10^(n-9) m³/s
+		return qVolumeFlow, v * math.Pow10(n-9) * 3600
+	case vif >= 0x58 && vif <= 0x5b: // flow temperature 10^(nn-3) °C
+		return qFlowTemp, v * math.Pow10(nn-3)
+	case vif >= 0x5c && vif <= 0x5f: // return temperature 10^(nn-3) °C
+		return qReturnTemp, v * math.Pow10(nn-3)
+	case vif >= 0x60 && vif <= 0x63: // temperature difference 10^(nn-3) K
+		return qTempDifference, v * math.Pow10(nn-3)
+	default:
+		return qUnknown, 0
+	}
+}
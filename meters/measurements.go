@@ -10,6 +10,7 @@ type MeasurementResult struct {
 	Measurement
 	Value     float64
 	Timestamp time.Time
+	Derived   bool // computed from other measurements instead of read from the device
 }
 
 func (r MeasurementResult) String() string {
@@ -140,6 +141,40 @@ const (
 	BatteryVoltage
 
 	PhaseAngle
+
+	// Host
+	CPUTemp
+	LoadAverage
+	Uptime
+	LinkQuality
+
+	// Status, boolean
+	Alarm
+	PhaseFailureL1
+	PhaseFailureL2
+	PhaseFailureL3
+	Relay1
+	Relay2
+
+	// Demand, average power computed from energy counters
+	ImportDemand
+	ExportDemand
+
+	// Imbalance, maximum deviation of the phases from their average
+	VoltageImbalance
+	CurrentImbalance
+
+	// Diagnostics
+	OperatingHours
+
+	// Heat and water meters
+	HeatEnergy
+	HeatPower
+	Volume
+	VolumeFlow
+	FlowTemp
+	ReturnTemp
+	TempDifference
 )
 
 var iec = map[Measurement][]string{
@@ -235,6 +270,50 @@ var iec = map[Measurement][]string{
 	ChargeState:      {"Charge State", "%"},
 	BatteryVoltage:   {"Battery Voltage", "V"},
 	PhaseAngle:       {"Phase Angle", "°"},
+	CPUTemp:          {"CPU Temperature", "°C"},
+	LoadAverage:      {"Load Average"},
+	Uptime:           {"Uptime", "s"},
+	LinkQuality:      {"Link Quality", "%"},
+	Alarm:            {"Alarm"},
+	PhaseFailureL1:   {"L1 Phase Failure"},
+	PhaseFailureL2:   {"L2 Phase Failure"},
+	PhaseFailureL3:   {"L3 Phase Failure"},
+	Relay1:           {"Relay 1 State"},
+	Relay2:           {"Relay 2 State"},
+	ImportDemand:     {"Import Demand", "W"},
+	ExportDemand:     {"Export Demand", "W"},
+	VoltageImbalance: {"Voltage Imbalance", "%"},
+	CurrentImbalance: {"Current Imbalance", "%"},
+	OperatingHours:   {"Operating Hours", "h"},
+	HeatEnergy:       {"Heat Energy", "kWh"},
+	HeatPower:        {"Heat Power", "W"},
+	Volume:           {"Volume", "m³"},
+	VolumeFlow:       {"Volume Flow", "m³/h"},
+	FlowTemp:         {"Flow Temperature", "°C"},
+	ReturnTemp:       {"Return Temperature", "°C"},
+	TempDifference:   {"Temperature Difference", "K"},
+}
+
+// booleans are status measurements with values 0 or 1
+var booleans = map[Measurement]bool{
+	Alarm:          true,
+	PhaseFailureL1: true,
+	PhaseFailureL2: true,
+	PhaseFailureL3: true,
+	Relay1:         true,
+	Relay2:         true,
+}
+
+// diagnostics are meter-internal status measurements reported separately from readings
+var diagnostics = map[Measurement]bool{
+	Alarm:          true,
+	PhaseFailureL1: true,
+	PhaseFailureL2: true,
+	PhaseFailureL3: true,
+	Relay1:         true,
+	Relay2:         true,
+	OperatingHours: true,
+	Uptime:         true,
 }
 
 // MarshalText implements encoding.TextMarshaler
@@ -255,6 +334,16 @@ func (m *Measurement) DescriptionAndUnit() (string, string) {
 	return m.String(), ""
 }
 
+// Boolean returns true for status measurements like alarms or relay states with values 0 or 1
+func (m *Measurement) Boolean() bool {
+	return booleans[*m]
+}
+
+// Diagnostic returns true for meter-internal status measurements like relay states, alarms or operating hours
+func (m *Measurement) Diagnostic() bool {
+	return diagnostics[*m]
+}
+
 // Description returns a measurements human-readable name
 func (m *Measurement) Description() string {
 	description, unit := m.DescriptionAndUnit()
@@ -133,14 +133,14 @@ func (c *MockClient) WriteMultipleCoils(address, quantity uint16, value []byte)
 	panic("Not implemented")
 }
 
-// WriteSingleRegister implements modbus.Client
+// WriteSingleRegister implements modbus.Client. Written values are discarded.
 func (c *MockClient) WriteSingleRegister(address, value uint16) (results []byte, err error) {
-	panic("Not implemented")
+	return []byte{byte(value >> 8), byte(value)}, nil
 }
 
-// WriteMultipleRegisters implements modbus.Client
+// WriteMultipleRegisters implements modbus.Client. Written values are discarded.
 func (c *MockClient) WriteMultipleRegisters(address, quantity uint16, value []byte) (results []byte, err error) {
-	panic("Not implemented")
+	return []byte{byte(quantity >> 8), byte(quantity)}, nil
 }
 
 // ReadWriteMultipleRegisters implements modbus.Client
@@ -0,0 +1,88 @@
+package meters
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// obisRE matches OBIS codes like 1-0:32.7.0*255, medium, channel and storage are optional
+var obisRE = regexp.MustCompile(`^(?:\d+-\d+:)?(\d+\.\d+\.\d+)(?:\*\d+)?$`)
+
+// obisMeasurements maps the value group C.D.E of electricity OBIS codes to measurements
+var obisMeasurements = map[string]Measurement{
+	"1.7.0":  ImportPower,
+	"2.7.0":  ExportPower,
+	"16.7.0": Power,
+	"21.7.0": ImportPowerL1,
+	"41.7.0": ImportPowerL2,
+	"61.7.0": ImportPowerL3,
+	"22.7.0": ExportPowerL1,
+	"42.7.0": ExportPowerL2,
+	"62.7.0": ExportPowerL3,
+	"36.7.0": PowerL1,
+	"56.7.0": PowerL2,
+	"76.7.0": PowerL3,
+	"9.7.0":  ApparentPower,
+	"29.7.0": ApparentPowerL1,
+	"49.7.0": ApparentPowerL2,
+	"69.7.0": ApparentPowerL3,
+	"11.7.0": Current,
+	"31.7.0": CurrentL1,
+	"51.7.0": CurrentL2,
+	"71.7.0": CurrentL3,
+	"12.7.0": Voltage,
+	"32.7.0": VoltageL1,
+	"52.7.0": VoltageL2,
+	"72.7.0": VoltageL3,
+	"13.7.0": Cosphi,
+	"33.7.0": CosphiL1,
+	"53.7.0": CosphiL2,
+	"73.7.0": CosphiL3,
+	"14.7.0": Frequency,
+	"1.8.0":  Import,
+	"1.8.1":  ImportT1,
+	"1.8.2":  ImportT2,
+	"21.8.0": ImportL1,
+	"41.8.0": ImportL2,
+	"61.8.0": ImportL3,
+	"2.8.0":  Export,
+	"2.8.1":  ExportT1,
+	"2.8.2":  ExportT2,
+	"22.8.0": ExportL1,
+	"42.8.0": ExportL2,
+	"62.8.0": ExportL3,
+	"3.8.0":  ReactiveImport,
+	"4.8.0":  ReactiveExport,
+}
+
+// measurementOBIS maps measurements to the value group C.D.E of their OBIS code
+var measurementOBIS = make(map[Measurement]string, len(obisMeasurements))
+
+func init() {
+	for code, m := range obisMeasurements {
+		measurementOBIS[m] = code
+	}
+}
+
+// OBIS returns the measurement's electricity OBIS code like 1-0:1.8.0 or empty string if it has none
+func (m *Measurement) OBIS() string {
+	if code, ok := measurementOBIS[*m]; ok {
+		return "1-0:" + code
+	}
+	return ""
+}
+
+// OBISMeasurement returns the measurement of an electricity OBIS code like 1-0:1.8.0
+func OBISMeasurement(code string) (Measurement, error) {
+	match := obisRE.FindStringSubmatch(code)
+	if match == nil {
+		return 0, fmt.Errorf("invalid obis code %s", code)
+	}
+
+	m, ok := obisMeasurements[match[1]]
+	if !ok {
+		return 0, fmt.Errorf("unsupported obis code %s", code)
+	}
+
+	return m, nil
+}
@@ -0,0 +1,25 @@
+package meters
+
+import "testing"
+
+func TestOBIS(t *testing.T) {
+	for code, m := range obisMeasurements {
+		if obis := m.OBIS(); obis != "1-0:"+code {
+			t.Errorf("%s: expected 1-0:%s, got %s", m, code, obis)
+		}
+
+		if res, err := OBISMeasurement(m.OBIS()); err != nil || res != m {
+			t.Errorf("%s: expected round trip, got %s %v", m, res, err)
+		}
+	}
+
+	if m := Relay1; m.OBIS() != "" {
+		t.Errorf("expected no obis code for %s, got %s", m, m.OBIS())
+	}
+
+	for _, code := range []string{"32.7.0", "1-0:32.7.0*255"} {
+		if m, err := OBISMeasurement(code); err != nil || m != VoltageL1 {
+			t.Errorf("%s: expected VoltageL1, got %s %v", code, m, err)
+		}
+	}
+}
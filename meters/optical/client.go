@@ -0,0 +1,61 @@
+package optical
+
+import "errors"
+
+// errNoModbus is returned for modbus requests to optical readers
+var errNoModbus = errors.New("optical readers do not support modbus")
+
+// ReadCoils implements modbus.Client
+func (c *Connection) ReadCoils(address, quantity uint16) ([]byte, error) {
+	return nil, errNoModbus
+}
+
+// ReadDiscreteInputs implements modbus.Client
+func (c *Connection) ReadDiscreteInputs(address, quantity uint16) ([]byte, error) {
+	return nil, errNoModbus
+}
+
+// WriteSingleCoil implements modbus.Client
+func (c *Connection) WriteSingleCoil(address, value uint16) ([]byte, error) {
+	return nil, errNoModbus
+}
+
+// WriteMultipleCoils implements modbus.Client
+func (c *Connection) WriteMultipleCoils(address, quantity uint16, value []byte) ([]byte, error) {
+	return nil, errNoModbus
+}
+
+// ReadInputRegisters implements modbus.Client
+func (c *Connection) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
+	return nil, errNoModbus
+}
+
+// ReadHoldingRegisters implements modbus.Client
+func (c *Connection) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	return nil, errNoModbus
+}
+
+// WriteSingleRegister implements modbus.Client
+func (c *Connection) WriteSingleRegister(address, value uint16) ([]byte, error) {
+	return nil, errNoModbus
+}
+
+// WriteMultipleRegisters implements modbus.Client
+func (c *Connection) WriteMultipleRegisters(address, quantity uint16, value []byte) ([]byte, error) {
+	return nil, errNoModbus
+}
+
+// ReadWriteMultipleRegisters implements modbus.Client
+func (c *Connection) ReadWriteMultipleRegisters(readAddress, readQuantity, writeAddress, writeQuantity uint16, value []byte) ([]byte, error) {
+	return nil, errNoModbus
+}
+
+// MaskWriteRegister implements modbus.Client
+func (c *Connection) MaskWriteRegister(address, andMask, orMask uint16) ([]byte, error) {
+	return nil, errNoModbus
+}
+
+// ReadFIFOQueue implements modbus.Client
+func (c *Connection) ReadFIFOQueue(address uint16) ([]byte, error) {
+	return nil, errNoModbus
+}
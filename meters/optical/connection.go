@@ -0,0 +1,349 @@
+package optical
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/grid-x/serial"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// Protocol is the telegram format of an optical reader
+type Protocol string
+
+const (
+	// SML are Smart Message Language binary telegrams pushed by most German smart meters
+	SML Protocol = "sml"
+	// D0 are IEC 62056-21 plain text telegrams
+	D0 Protocol = "d0"
+	// P1 are DSMR plain text telegrams of Dutch and Belgian smart meters read from the wired P1 port
+	P1 Protocol = "p1"
+)
+
+const (
+	// defaultTimeout is the maximum time waiting for a telegram, meters push telegrams every 1-4s
+	defaultTimeout = 5 * time.Second
+
+	// readTimeout is the timeout of a single serial read
+	readTimeout = 500 * time.Millisecond
+
+	// maxTelegramSize limits the buffered data if no telegram is found
+	maxTelegramSize = 16 * 1024
+)
+
+// defaultComsets are the usual communication parameters of the protocols
+var defaultComsets = map[Protocol]string{
+	SML: "8N1",
+	D0:  "7E1",
+	P1:  "8N1",
+}
+
+// defaultBaudrates are the usual baud rates of the protocols. DSMR meters before version 4 use 9600 7E1.
+var defaultBaudrates = map[Protocol]int{
+	SML: 9600,
+	D0:  9600,
+	P1:  115200,
+}
+
+// d0Request requests a telegram from D0 meters in request mode. Meters pushing telegrams ignore it.
+var d0Request = []byte("/?!\r\n")
+
+// Connection is the connection of an infrared optical reader or P1 port on a serial port. It provides
+// no modbus client but reads the telegrams of the meter.
+type Connection struct {
+	protocol Protocol
+	config   serial.Config
+	mux      sync.Mutex // guard port
+	port     io.ReadWriteCloser
+	timeout  time.Duration
+	logger   meters.Logger
+}
+
+// NewConnection creates an optical reader connection. The comset may override the baudrate,
+// without comset the protocol's usual communication parameters are used.
+func NewConnection(protocol Protocol, device string, baudrate int, comset string) (*Connection, error) {
+	if _, ok := defaultComsets[protocol]; !ok {
+		return nil, fmt.Errorf("invalid protocol %s", protocol)
+	}
+
+	if comset == "" {
+		comset = defaultComsets[protocol]
+	}
+	if baudrate == 0 {
+		baudrate = defaultBaudrates[protocol]
+	}
+
+	cs, err := meters.ParseComset(comset, baudrate)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Connection{
+		protocol: protocol,
+		config: serial.Config{
+			Address:  device,
+			BaudRate: cs.Baudrate,
+			DataBits: cs.DataBits,
+			Parity:   cs.Parity,
+			StopBits: cs.StopBits,
+			Timeout:  readTimeout,
+		},
+		timeout: defaultTimeout,
+	}
+
+	return c, nil
+}
+
+// String returns the protocol and serial device
+func (c *Connection) String() string {
+	return fmt.Sprintf("%s:%s", c.protocol, c.config.Address)
+}
+
+// Protocol returns the connection's telegram format
+func (c *Connection) Protocol() Protocol {
+	return c.protocol
+}
+
+// ModbusClient returns the connection itself. Devices read telegrams from it, modbus requests fail.
+func (c *Connection) ModbusClient() modbus.Client {
+	return c
+}
+
+// Logger sets a logging instance for received telegrams
+func (c *Connection) Logger(l meters.Logger) {
+	c.logger = l
+}
+
+// Slave sets the modbus device id for the following operations. Optical readers are not addressed.
+func (c *Connection) Slave(deviceID uint8) {
+}
+
+// Timeout sets the maximum time waiting for a telegram
+func (c *Connection) Timeout(timeout time.Duration) time.Duration {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	t := c.timeout
+	c.timeout = timeout
+	return t
+}
+
+// Close closes the serial port. It is reopened by the next read.
+func (c *Connection) Close() {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.close()
+}
+
+func (c *Connection) close() {
+	if c.port != nil {
+		c.port.Close()
+		c.port = nil
+	}
+}
+
+// ReadTelegram waits for the next complete telegram and returns its payload. SML payloads are
+// unescaped, SML and P1 telegrams are checksum verified.
+func (c *Connection) ReadTelegram() ([]byte, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if c.port == nil {
+		port, err := serial.Open(&c.config)
+		if err != nil {
+			return nil, err
+		}
+		c.port = port
+	}
+
+	if c.protocol == D0 {
+		if _, err := c.port.Write(d0Request); err != nil {
+			c.close()
+			return nil, err
+		}
+	}
+
+	deadline := time.Now().Add(c.timeout)
+	buf := make([]byte, 0, 1024)
+	chunk := make([]byte, 512)
+
+	for time.Now().Before(deadline) {
+		n, err := c.port.Read(chunk)
+		if err != nil && err != serial.ErrTimeout {
+			c.close()
+			return nil, err
+		}
+
+		buf = append(buf, chunk[:n]...)
+
+		telegram, rest, err := c.extract(buf)
+		if err != nil {
+			// discard the corrupt telegram and wait for the next one
+			buf = append(buf[:0], rest...)
+			continue
+		}
+		if telegram != nil {
+			if c.logger != nil && c.protocol == SML {
+				c.logger.Printf("%s: received % x", c, telegram)
+			} else if c.logger != nil {
+				c.logger.Printf("%s: received %q", c, telegram)
+			}
+			return telegram, nil
+		}
+
+		if len(buf) > maxTelegramSize {
+			buf = append(buf[:0], buf[len(buf)-maxTelegramSize/2:]...)
+		}
+	}
+
+	return nil, fmt.Errorf("no %s telegram received within %v", c.protocol, c.timeout)
+}
+
+// extract returns the first complete telegram and the remaining data
+func (c *Connection) extract(buf []byte) ([]byte, []byte, error) {
+	switch c.protocol {
+	case SML:
+		return extractSML(buf)
+	case P1:
+		return extractP1(buf)
+	default:
+		return extractD0(buf)
+	}
+}
+
+// extractD0 returns the first complete D0 telegram from identification line to end line "!"
+func extractD0(buf []byte) ([]byte, []byte, error) {
+	start := bytes.IndexByte(buf, '/')
+	if start < 0 {
+		return nil, nil, nil
+	}
+
+	end := bytes.Index(buf[start:], []byte("\n!"))
+	if end < 0 {
+		return nil, buf[start:], nil
+	}
+	end += start + 2
+
+	return buf[start:end], buf[end:], nil
+}
+
+// extractP1 returns the first complete DSMR telegram from identification line to end line "!".
+// DSMR 4 and later append the CRC of the telegram to the end line.
+func extractP1(buf []byte) ([]byte, []byte, error) {
+	start := bytes.IndexByte(buf, '/')
+	if start < 0 {
+		return nil, nil, nil
+	}
+
+	end := bytes.Index(buf[start:], []byte("\n!"))
+	if end < 0 {
+		return nil, buf[start:], nil
+	}
+	end += start + 2
+
+	eol := bytes.IndexByte(buf[end:], '\n')
+	if eol < 0 {
+		return nil, buf[start:], nil
+	}
+	rest := buf[end+eol+1:]
+
+	if crc := strings.TrimSpace(string(buf[end : end+eol])); crc != "" {
+		expected, err := strconv.ParseUint(crc, 16, 16)
+		if err != nil || uint16(expected) != crc16ARC(buf[start:end]) {
+			return nil, rest, errChecksum
+		}
+	}
+
+	return buf[start:end], rest, nil
+}
+
+var (
+	smlEscape = []byte{0x1b, 0x1b, 0x1b, 0x1b}
+	smlStart  = []byte{0x1b, 0x1b, 0x1b, 0x1b, 0x01, 0x01, 0x01, 0x01}
+)
+
+// errChecksum is returned for telegrams with invalid checksum
+var errChecksum = errors.New("invalid checksum")
+
+// extractSML returns the unescaped payload of the first complete SML transport frame.
+// Frames start with escape sequence and 01010101, escape sequences within the payload
+// are doubled and frames end with escape sequence, 1a, number of padding bytes and CRC.
+func extractSML(buf []byte) ([]byte, []byte, error) {
+	start := bytes.Index(buf, smlStart)
+	if start < 0 {
+		return nil, nil, nil
+	}
+
+	var payload []byte
+	for i := start + len(smlStart); i+8 <= len(buf); i += 4 {
+		block := buf[i : i+4]
+		if !bytes.Equal(block, smlEscape) {
+			payload = append(payload, block...)
+			continue
+		}
+
+		next := buf[i+4 : i+8]
+		switch {
+		case bytes.Equal(next, smlEscape):
+			payload = append(payload, smlEscape...)
+			i += 4
+		case next[0] == 0x1a:
+			end := i + 8
+			crc := uint16(next[2]) | uint16(next[3])<<8
+			if crc16X25(buf[start:end-2]) != crc {
+				return nil, buf[end:], errChecksum
+			}
+
+			padding := int(next[1])
+			if padding > 3 || padding > len(payload) {
+				return nil, buf[end:], fmt.Errorf("invalid padding %d", padding)
+			}
+
+			return payload[:len(payload)-padding], buf[end:], nil
+		default:
+			// unexpected escape like the start of the next frame
+			return nil, buf[i:], errors.New("unexpected escape sequence")
+		}
+	}
+
+	return nil, buf[start:], nil
+}
+
+// crc16X25 calculates the CRC-16/X-25 checksum used by SML
+func crc16X25(b []byte) uint16 {
+	crc := uint16(0xffff)
+	for _, v := range b {
+		crc ^= uint16(v)
+		for i := 0; i < 8; i++ {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ 0x8408
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc ^ 0xffff
+}
+
+// crc16ARC calculates the CRC-16/ARC checksum used by DSMR
+func crc16ARC(b []byte) uint16 {
+	var crc uint16
+	for _, v := range b {
+		crc ^= uint16(v)
+		for i := 0; i < 8; i++ {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ 0xa001
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,82 @@
+package optical
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// d0LineRE matches data lines like 1-0:1.8.0*255(001234.5678*kWh), medium, channel, storage and unit are optional
+var d0LineRE = regexp.MustCompile(`^(?:(\d+)-(\d+):)?(\d+\.\d+\.\d+)(?:\*\d+)?\(([^*)]*)(?:\*([^)]*))?\)`)
+
+// d0Line is a data line of a D0 telegram
+type d0Line struct {
+	obis  string // A-B:C.D.E or C.D.E
+	value string
+	unit  string
+}
+
+// d0Telegram is a decoded D0 telegram
+type d0Telegram struct {
+	identification string // identification line without leading slash, e.g. EBZ5DD3BZ06ETA_107
+	lines          []d0Line
+}
+
+// parseD0 decodes the identification and data lines of a D0 telegram
+func parseD0(b []byte) d0Telegram {
+	var res d0Telegram
+
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+
+		if strings.HasPrefix(line, "/") {
+			res.identification = strings.TrimPrefix(line, "/")
+			continue
+		}
+
+		match := d0LineRE.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		obis := match[3]
+		if match[1] != "" {
+			obis = match[1] + "-" + match[2] + ":" + obis
+		}
+
+		res.lines = append(res.lines, d0Line{
+			obis:  obis,
+			value: strings.TrimSpace(match[4]),
+			unit:  strings.TrimSpace(match[5]),
+		})
+	}
+
+	return res
+}
+
+// number returns the line's numeric value
+func (l d0Line) number() (float64, error) {
+	return strconv.ParseFloat(l.value, 64)
+}
+
+// manufacturer returns the three letter manufacturer id of the identification line
+func (t d0Telegram) manufacturer() string {
+	if len(t.identification) < 3 {
+		return ""
+	}
+	return t.identification[:3]
+}
+
+// model returns the model of the identification line following manufacturer id, baud rate
+// character and optional enhanced identification like \2
+func (t d0Telegram) model() string {
+	if len(t.identification) < 5 {
+		return ""
+	}
+
+	model := t.identification[4:]
+	if len(model) > 2 && model[0] == '\\' {
+		model = model[2:]
+	}
+	return model
+}
@@ -0,0 +1,289 @@
+package optical
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+const (
+	// METERTYPE_SML is the device type of smart meters read via SML
+	METERTYPE_SML = "SML"
+	// METERTYPE_D0 is the device type of smart meters read via D0
+	METERTYPE_D0 = "D0"
+	// METERTYPE_P1 is the device type of DSMR smart meters read via P1
+	METERTYPE_P1 = "P1"
+)
+
+// serialCodes are the C.D.E value groups of the meter serial number, DSMR uses 96.1.1
+var serialCodes = []string{"96.1.0", "96.1.1", "0.0.0"}
+
+// tariffTotals lists totals and their tariff measurements. DSMR meters only report tariff counters.
+var tariffTotals = [][3]meters.Measurement{
+	{meters.Import, meters.ImportT1, meters.ImportT2},
+	{meters.Export, meters.ExportT1, meters.ExportT2},
+}
+
+// TelegramReader reads meter telegrams. It is implemented by the modbus client of optical connections.
+type TelegramReader interface {
+	Protocol() Protocol
+	ReadTelegram() ([]byte, error)
+}
+
+// Device is a smart meter read by an infrared optical reader or from its P1 port. Telegram
+// values are mapped to measurements by their OBIS code, unsupported codes are ignored.
+type Device struct {
+	protocol   Protocol
+	mux        sync.Mutex // guard descriptor
+	descriptor meters.DeviceDescriptor
+}
+
+// NewDevice creates a smart meter using the given protocol
+func NewDevice(protocol Protocol) *Device {
+	typ := strings.ToUpper(string(protocol))
+
+	model := "Smart meter via optical reader"
+	if protocol == P1 {
+		model = "DSMR smart meter via P1 port"
+	}
+
+	return &Device{
+		protocol: protocol,
+		descriptor: meters.DeviceDescriptor{
+			Type:         typ,
+			Manufacturer: typ,
+			Model:        model,
+		},
+	}
+}
+
+// Initialize implements the Device interface
+func (d *Device) Initialize(client modbus.Client) error {
+	return nil
+}
+
+// Descriptor implements the Device interface. Manufacturer and serial number are available
+// once a telegram has been received.
+func (d *Device) Descriptor() meters.DeviceDescriptor {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	return d.descriptor
+}
+
+// Probe implements the Device interface
+func (d *Device) Probe(client modbus.Client) (res meters.MeasurementResult, err error) {
+	results, err := d.Query(client)
+	if err != nil {
+		return res, err
+	}
+	return results[0], nil
+}
+
+// Query implements the Device interface. It waits for the meter's next telegram.
+func (d *Device) Query(client modbus.Client) ([]meters.MeasurementResult, error) {
+	reader, ok := client.(TelegramReader)
+	if !ok || reader.Protocol() != d.protocol {
+		return nil, fmt.Errorf("%s devices require a %s adapter", strings.ToUpper(string(d.protocol)), d.protocol)
+	}
+
+	b, err := reader.ReadTelegram()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []meters.MeasurementResult
+	switch d.protocol {
+	case SML:
+		res, err = d.decodeSML(b)
+	case P1:
+		res = d.decodeP1(b)
+	default:
+		res = d.decodeD0(b)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res) == 0 {
+		return nil, errors.New("telegram contains no supported measurements")
+	}
+
+	return res, nil
+}
+
+// decodeSML converts the telegram's value list entries to measurement results
+func (d *Device) decodeSML(b []byte) ([]meters.MeasurementResult, error) {
+	entries, err := parseSML(b)
+	if err != nil {
+		return nil, fmt.Errorf("invalid telegram: %v", err)
+	}
+
+	ts := time.Now()
+	res := make([]meters.MeasurementResult, 0, len(entries))
+
+	for _, e := range entries {
+		if e.octets != nil {
+			if isSerialCode(e.obis) {
+				d.setSerial(printable(e.octets))
+			}
+			continue
+		}
+
+		if m, err := meters.OBISMeasurement(e.obis); err == nil {
+			res = append(res, meters.MeasurementResult{
+				Measurement: m,
+				Value:       scale(e.unit, m) * e.value,
+				Timestamp:   ts,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// decodeD0 converts the telegram's data lines to measurement results
+func (d *Device) decodeD0(b []byte) []meters.MeasurementResult {
+	t := parseD0(b)
+
+	if manufacturer := t.manufacturer(); manufacturer != "" {
+		d.mux.Lock()
+		d.descriptor.Manufacturer = manufacturer
+		d.descriptor.Model = t.model()
+		d.mux.Unlock()
+	}
+
+	ts := time.Now()
+	res := make([]meters.MeasurementResult, 0, len(t.lines))
+
+	for _, l := range t.lines {
+		if isSerialCode(l.obis) {
+			d.setSerial(decodeSerial(l.obis, l.value))
+			continue
+		}
+
+		m, err := meters.OBISMeasurement(l.obis)
+		if err != nil {
+			continue
+		}
+
+		v, err := l.number()
+		if err != nil {
+			continue
+		}
+
+		res = append(res, meters.MeasurementResult{
+			Measurement: m,
+			Value:       scale(l.unit, m) * v,
+			Timestamp:   ts,
+		})
+	}
+
+	return res
+}
+
+// decodeP1 converts the telegram's data lines to measurement results. Missing totals are
+// computed from the tariff counters and power from delivered and received power.
+func (d *Device) decodeP1(b []byte) []meters.MeasurementResult {
+	res := d.decodeD0(b)
+	if len(res) == 0 {
+		return res
+	}
+
+	values := make(map[meters.Measurement]float64, len(res))
+	for _, r := range res {
+		values[r.Measurement] = r.Value
+	}
+
+	ts := res[0].Timestamp
+	add := func(m meters.Measurement, v float64) {
+		if _, ok := values[m]; !ok {
+			res = append(res, meters.MeasurementResult{Measurement: m, Value: v, Timestamp: ts})
+		}
+	}
+
+	for _, t := range tariffTotals {
+		t1, ok1 := values[t[1]]
+		t2, ok2 := values[t[2]]
+		if ok1 && ok2 {
+			add(t[0], t1+t2)
+		}
+	}
+
+	imp, ok1 := values[meters.ImportPower]
+	exp, ok2 := values[meters.ExportPower]
+	if ok1 && ok2 {
+		add(meters.Power, imp-exp)
+	}
+
+	return res
+}
+
+// decodeSerial decodes the hex encoded equipment identifier 96.1.1 of DSMR meters
+func decodeSerial(obis, s string) string {
+	if !strings.HasSuffix(obis, "96.1.1") {
+		return s
+	}
+
+	if b, err := hex.DecodeString(s); err == nil && len(b) > 0 {
+		if decoded := printable(b); decoded == string(b) {
+			return decoded
+		}
+	}
+	return s
+}
+
+// setSerial sets the descriptor's serial number
+func (d *Device) setSerial(serial string) {
+	if serial == "" {
+		return
+	}
+
+	d.mux.Lock()
+	d.descriptor.Serial = serial
+	d.mux.Unlock()
+}
+
+// isSerialCode returns true if the OBIS code is a serial number
+func isSerialCode(obis string) bool {
+	if i := strings.Index(obis, ":"); i >= 0 {
+		obis = obis[i+1:]
+	}
+
+	for _, code := range serialCodes {
+		if obis == code {
+			return true
+		}
+	}
+	return false
+}
+
+// printable returns the octets as string or hex encoded if not printable
+func printable(b []byte) string {
+	for _, r := range string(b) {
+		if !unicode.IsPrint(r) || r == unicode.ReplacementChar {
+			return hex.EncodeToString(b)
+		}
+	}
+	return string(b)
+}
+
+// scale returns the factor converting values from the telegram's unit to the measurement's unit, e.g. Wh to kWh
+func scale(unit string, m meters.Measurement) float64 {
+	_, target := m.DescriptionAndUnit()
+
+	switch {
+	case unit == "k"+target:
+		return 1e3
+	case "k"+unit == target:
+		return 1e-3
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,244 @@
+package optical
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// smlMessage is a GetList response containing Import, Power and serial number
+const smlMessage = "76" + "0500000001" + "6200" + "6200" +
+	"72" + "630701" + "77" + "01" + "0b0a01454d4800001234 56" + "070100620affff" + "01" +
+	"73" +
+	"77" + "070100010800ff" + "6200" + "01" + "621e" + "52ff" + "59000000000001e240" + "01" +
+	"77" + "070100100700ff" + "01" + "01" + "621b" + "5200" + "55fffffe0c" + "01" +
+	"77" + "070100600100ff" + "01" + "01" + "01" + "01" + "0b0a01454d4800001234 56" + "01" +
+	"01" + "01" +
+	"63" + "0000" + "00"
+
+// smlFrame wraps the payload into an SML transport frame
+func smlFrame(t *testing.T, payload string) []byte {
+	b, err := hex.DecodeString(strings.Replace(payload, " ", "", -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	padding := (4 - len(b)%4) % 4
+	b = append(b, make([]byte, padding)...)
+
+	// escape payload
+	var escaped []byte
+	for i := 0; i < len(b); i += 4 {
+		escaped = append(escaped, b[i:i+4]...)
+		if bytes.Equal(b[i:i+4], smlEscape) {
+			escaped = append(escaped, smlEscape...)
+		}
+	}
+
+	frame := append(append([]byte{}, smlStart...), escaped...)
+	frame = append(frame, 0x1b, 0x1b, 0x1b, 0x1b, 0x1a, byte(padding))
+	crc := crc16X25(frame)
+	return append(frame, byte(crc), byte(crc>>8))
+}
+
+func TestCRC16(t *testing.T) {
+	if crc := crc16X25([]byte("123456789")); crc != 0x906e {
+		t.Errorf("expected 906e, got %04x", crc)
+	}
+	if crc := crc16ARC([]byte("123456789")); crc != 0xbb3d {
+		t.Errorf("expected bb3d, got %04x", crc)
+	}
+}
+
+func TestExtractSML(t *testing.T) {
+	frame := smlFrame(t, "1b1b1b1b"+smlMessage)
+	buf := append([]byte{0xff, 0x00}, frame...)
+
+	// incomplete
+	if telegram, _, err := extractSML(buf[:len(buf)-4]); telegram != nil || err != nil {
+		t.Errorf("expected incomplete telegram, got %x %v", telegram, err)
+	}
+
+	telegram, rest, err := extractSML(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rest) != 0 || !bytes.HasPrefix(telegram, smlEscape) || telegram[4] != 0x76 {
+		t.Errorf("unexpected telegram %x rest %x", telegram, rest)
+	}
+
+	// corrupt checksum
+	buf[len(buf)-1] ^= 0xff
+	if _, _, err := extractSML(buf); err != errChecksum {
+		t.Errorf("expected checksum error, got %v", err)
+	}
+}
+
+func TestDecodeSML(t *testing.T) {
+	telegram, _, err := extractSML(smlFrame(t, smlMessage))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := NewDevice(SML)
+	res, err := d.decodeSML(telegram)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[meters.Measurement]float64{
+		meters.Import: 12.3456, // 123456 * 10^-1 Wh
+		meters.Power:  -500,
+	}
+
+	if len(res) != len(expected) {
+		t.Fatalf("expected %d results, got %v", len(expected), res)
+	}
+	for _, r := range res {
+		if v, ok := expected[r.Measurement]; !ok || v-r.Value > 1e-9 || r.Value-v > 1e-9 {
+			t.Errorf("unexpected result %s %f", r.Measurement, r.Value)
+		}
+	}
+
+	if serial := d.Descriptor().Serial; serial != "0a01454d480000123456" {
+		t.Errorf("unexpected serial %s", serial)
+	}
+}
+
+func TestExtractD0(t *testing.T) {
+	buf := []byte("garbage\r\n/EBZ5DD3BZ06ETA_107\r\n\r\n1-0:1.8.0*255(000125.85462940*kWh)\r\n!\r\n/EBZ")
+
+	telegram, rest, err := extractD0(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(telegram, []byte("/EBZ")) || !bytes.HasSuffix(telegram, []byte("\n!")) || string(rest) != "\r\n/EBZ" {
+		t.Errorf("unexpected telegram %q rest %q", telegram, rest)
+	}
+
+	if telegram, _, _ := extractD0(rest); telegram != nil {
+		t.Errorf("expected incomplete telegram, got %q", telegram)
+	}
+}
+
+func TestDecodeD0(t *testing.T) {
+	telegram := "/EBZ5DD3BZ06ETA_107\r\n\r\n" +
+		"1-0:0.0.0*255(1EBZ0100507409)\r\n" +
+		"1-0:1.8.0*255(000125.85462940*kWh)\r\n" +
+		"1-0:16.7.0*255(000111.49*W)\r\n" +
+		"32.7.0(0.2325*kV)\r\n" +
+		"1-0:96.5.0*255(001C0104)\r\n" +
+		"!"
+
+	d := NewDevice(D0)
+	res := d.decodeD0([]byte(telegram))
+
+	expected := map[meters.Measurement]float64{
+		meters.Import:    125.85462940,
+		meters.Power:     111.49,
+		meters.VoltageL1: 232.5,
+	}
+
+	if len(res) != len(expected) {
+		t.Fatalf("expected %d results, got %v", len(expected), res)
+	}
+	for _, r := range res {
+		if v, ok := expected[r.Measurement]; !ok || v-r.Value > 1e-9 || r.Value-v > 1e-9 {
+			t.Errorf("unexpected result %s %f", r.Measurement, r.Value)
+		}
+	}
+
+	desc := d.Descriptor()
+	if desc.Manufacturer != "EBZ" || desc.Model != "DD3BZ06ETA_107" || desc.Serial != "1EBZ0100507409" {
+		t.Errorf("unexpected descriptor %v", desc)
+	}
+}
+
+const p1Telegram = "/ISk5\\2MT382-1000\r\n\r\n" +
+	"1-3:0.2.8(50)\r\n" +
+	"0-0:96.1.1(4B384547303034303436333935353037)\r\n" +
+	"1-0:1.8.1(000123.456*kWh)\r\n" +
+	"1-0:1.8.2(000100.000*kWh)\r\n" +
+	"1-0:2.8.1(000010.000*kWh)\r\n" +
+	"1-0:2.8.2(000001.500*kWh)\r\n" +
+	"1-0:1.7.0(01.193*kW)\r\n" +
+	"1-0:2.7.0(00.000*kW)\r\n" +
+	"1-0:32.7.0(229.0*V)\r\n" +
+	"0-1:24.2.1(101209112500W)(12785.123*m3)\r\n" +
+	"!"
+
+func TestExtractP1(t *testing.T) {
+	crc := fmt.Sprintf("%04X", crc16ARC([]byte(p1Telegram)))
+
+	telegram, rest, err := extractP1([]byte("garbage" + p1Telegram + crc + "\r\n/ISk5"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(telegram) != p1Telegram || string(rest) != "/ISk5" {
+		t.Errorf("unexpected telegram %q rest %q", telegram, rest)
+	}
+
+	if _, _, err := extractP1([]byte(p1Telegram + "0000\r\n")); err != errChecksum {
+		t.Errorf("expected checksum error, got %v", err)
+	}
+
+	// DSMR before version 4 has no checksum
+	if telegram, _, err := extractP1([]byte(p1Telegram + "\r\n")); err != nil || string(telegram) != p1Telegram {
+		t.Errorf("unexpected telegram %q error %v", telegram, err)
+	}
+
+	// incomplete end line
+	if telegram, _, _ := extractP1([]byte(p1Telegram + crc[:2])); telegram != nil {
+		t.Errorf("expected incomplete telegram, got %q", telegram)
+	}
+}
+
+func TestDecodeP1(t *testing.T) {
+	d := NewDevice(P1)
+	res := d.decodeP1([]byte(p1Telegram))
+
+	expected := map[meters.Measurement]float64{
+		meters.ImportT1:    123.456,
+		meters.ImportT2:    100,
+		meters.ExportT1:    10,
+		meters.ExportT2:    1.5,
+		meters.Import:      223.456,
+		meters.Export:      11.5,
+		meters.ImportPower: 1193,
+		meters.ExportPower: 0,
+		meters.Power:       1193,
+		meters.VoltageL1:   229,
+	}
+
+	if len(res) != len(expected) {
+		t.Fatalf("expected %d results, got %v", len(expected), res)
+	}
+	for _, r := range res {
+		if v, ok := expected[r.Measurement]; !ok || v-r.Value > 1e-9 || r.Value-v > 1e-9 {
+			t.Errorf("unexpected result %s %f", r.Measurement, r.Value)
+		}
+	}
+
+	desc := d.Descriptor()
+	if desc.Manufacturer != "ISk" || desc.Model != "MT382-1000" || desc.Serial != "K8EG004046395507" {
+		t.Errorf("unexpected descriptor %v", desc)
+	}
+}
+
+func TestQueryRequiresAdapter(t *testing.T) {
+	conn, err := NewConnection(D0, "/dev/null", 0, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewDevice(SML).Query(conn.ModbusClient()); err == nil {
+		t.Error("expected error for d0 adapter")
+	}
+	if _, err := NewDevice(SML).Query(meters.NewMockClient(0)); err == nil {
+		t.Error("expected error for modbus client")
+	}
+}
@@ -0,0 +1,189 @@
+package optical
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// smlUnits are the DLMS unit codes used by SML
+var smlUnits = map[uint64]string{
+	27: "W",
+	28: "VA",
+	29: "var",
+	30: "Wh",
+	31: "VAh",
+	32: "varh",
+	33: "A",
+	35: "V",
+	44: "Hz",
+}
+
+// errEndOfMessage marks the end of an SML message
+var errEndOfMessage = errors.New("end of message")
+
+// smlParser decodes the type-length-value encoding of SML messages. Values are decoded as
+// []byte for octet strings, bool, int64, uint64, []interface{} for lists and nil if absent.
+type smlParser struct {
+	b   []byte
+	pos int
+}
+
+// value decodes the next value
+func (p *smlParser) value() (interface{}, error) {
+	if p.pos >= len(p.b) {
+		return nil, errors.New("unexpected end of telegram")
+	}
+
+	tl := p.b[p.pos]
+	if tl == 0x00 {
+		p.pos++
+		return nil, errEndOfMessage
+	}
+
+	// the length may span multiple type-length bytes
+	typ, length, n := tl&0x70, int(tl&0x0f), 1
+	for more := tl&0x80 != 0; more; n++ {
+		if p.pos+n >= len(p.b) {
+			return nil, errors.New("unexpected end of telegram")
+		}
+		next := p.b[p.pos+n]
+		length = length<<4 | int(next&0x0f)
+		more = next&0x80 != 0
+	}
+	p.pos += n
+
+	if typ == 0x70 {
+		list := make([]interface{}, 0, length)
+		for i := 0; i < length; i++ {
+			v, err := p.value()
+			if err != nil && err != errEndOfMessage {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		return list, nil
+	}
+
+	// the length of other types includes the type-length bytes
+	size := length - n
+	if size < 0 || p.pos+size > len(p.b) {
+		return nil, fmt.Errorf("invalid length %d at %d", length, p.pos-n)
+	}
+	data := p.b[p.pos : p.pos+size]
+	p.pos += size
+
+	switch typ {
+	case 0x00:
+		if size == 0 {
+			return nil, nil
+		}
+		return data, nil
+	case 0x40:
+		if size != 1 {
+			return nil, fmt.Errorf("invalid boolean size %d", size)
+		}
+		return data[0] != 0, nil
+	case 0x50, 0x60:
+		if size == 0 || size > 8 {
+			return nil, fmt.Errorf("invalid integer size %d", size)
+		}
+
+		var b [8]byte
+		copy(b[8-size:], data)
+		u := binary.BigEndian.Uint64(b[:])
+
+		if typ == 0x60 {
+			return u, nil
+		}
+
+		// sign extension
+		shift := uint(64 - 8*size)
+		return int64(u<<shift) >> shift, nil
+	default:
+		return nil, fmt.Errorf("invalid type %x", typ)
+	}
+}
+
+// smlEntry is a value list entry of an SML GetList response
+type smlEntry struct {
+	obis   string // A-B:C.D.E
+	unit   string
+	value  float64
+	octets []byte // value of octet string entries like the serial number
+}
+
+// parseSML decodes the value list entries of all messages in the telegram payload
+func parseSML(b []byte) ([]smlEntry, error) {
+	p := &smlParser{b: b}
+
+	var res []smlEntry
+	for p.pos < len(b) {
+		v, err := p.value()
+		if err == errEndOfMessage {
+			continue // padding
+		}
+		if err != nil {
+			return res, err
+		}
+		res = append(res, smlEntries(v)...)
+	}
+
+	return res, nil
+}
+
+// smlEntries finds the value list entries objName, status, valTime, unit, scaler, value, valueSignature
+func smlEntries(v interface{}) []smlEntry {
+	list, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	if len(list) == 7 {
+		if name, ok := list[0].([]byte); ok && len(name) == 6 {
+			if e, ok := newSMLEntry(name, list); ok {
+				return []smlEntry{e}
+			}
+		}
+	}
+
+	var res []smlEntry
+	for _, v := range list {
+		res = append(res, smlEntries(v)...)
+	}
+	return res
+}
+
+// newSMLEntry converts a value list entry
+func newSMLEntry(name []byte, list []interface{}) (smlEntry, bool) {
+	e := smlEntry{
+		obis: fmt.Sprintf("%d-%d:%d.%d.%d", name[0], name[1], name[2], name[3], name[4]),
+	}
+
+	if unit, ok := list[3].(uint64); ok {
+		e.unit = smlUnits[unit]
+	}
+
+	var scaler int64
+	switch s := list[4].(type) {
+	case int64:
+		scaler = s
+	case nil:
+	default:
+		return e, false
+	}
+
+	switch v := list[5].(type) {
+	case int64:
+		e.value = float64(v) * math.Pow10(int(scaler))
+	case uint64:
+		e.value = float64(v) * math.Pow10(int(scaler))
+	case []byte:
+		e.octets = v
+	default:
+		return e, false
+	}
+
+	return e, true
+}
@@ -0,0 +1,45 @@
+package meters
+
+import (
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+// Pseudo is the connection of pseudo-devices like simulated meters or the gateway host
+// that don't access a bus. It provides no modbus client.
+type Pseudo struct {
+	address string
+}
+
+// NewPseudo creates a pseudo-connection identified by address
+func NewPseudo(address string) Connection {
+	return &Pseudo{address: address}
+}
+
+// String returns the pseudo-connection's address
+func (b *Pseudo) String() string {
+	return b.address
+}
+
+// ModbusClient returns nil since pseudo-devices do not use modbus
+func (b *Pseudo) ModbusClient() modbus.Client {
+	return nil
+}
+
+// Logger sets a logging instance for physical bus operations
+func (b *Pseudo) Logger(l Logger) {
+}
+
+// Slave sets the modbus device id for the following operations
+func (b *Pseudo) Slave(deviceID uint8) {
+}
+
+// Timeout sets the modbus timeout
+func (b *Pseudo) Timeout(timeout time.Duration) time.Duration {
+	return timeout
+}
+
+// Close closes the modbus connection.
+func (b *Pseudo) Close() {
+}
@@ -0,0 +1,89 @@
+package meters
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/log"
+)
+
+const (
+	minReconnectDelay = 1 * time.Second
+	maxReconnectDelay = 1 * time.Minute
+)
+
+// ErrDisconnected indicates a serial adapter that has been disconnected and not been reopened yet
+var ErrDisconnected = errors.New("serial device disconnected")
+
+// reconnectHandler wraps a serial client handler and reopens the serial device
+// after it has been disconnected, e.g. by unplugging an USB adapter.
+// While disconnected, requests fail immediately until the reconnect delay has elapsed.
+type reconnectHandler struct {
+	modbus.ClientHandler
+	device string
+	close  func() error
+
+	mu           sync.Mutex
+	disconnected bool
+	delay        time.Duration
+	retry        time.Time
+}
+
+// newReconnectHandler creates a reconnecting client handler. Close closes the serial device.
+func newReconnectHandler(handler modbus.ClientHandler, device string, close func() error) *reconnectHandler {
+	return &reconnectHandler{
+		ClientHandler: handler,
+		device:        device,
+		close:         close,
+	}
+}
+
+// isDisconnect returns true if the error indicates a removed serial device
+func isDisconnect(err error) bool {
+	return errors.Is(err, syscall.EIO) || errors.Is(err, syscall.ENXIO) ||
+		errors.Is(err, syscall.ENODEV) || errors.Is(err, os.ErrNotExist) || errors.Is(err, io.EOF)
+}
+
+// Send implements modbus.Transporter
+func (h *reconnectHandler) Send(aduRequest []byte) ([]byte, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.disconnected && time.Now().Before(h.retry) {
+		return nil, fmt.Errorf("%s: %w, next attempt in %v", h.device, ErrDisconnected, time.Until(h.retry).Round(time.Second))
+	}
+
+	aduResponse, err := h.ClientHandler.Send(aduRequest)
+
+	if err != nil && isDisconnect(err) {
+		// force reopening the device on next attempt
+		_ = h.close()
+
+		if h.disconnected {
+			h.delay *= 2
+			if h.delay > maxReconnectDelay {
+				h.delay = maxReconnectDelay
+			}
+		} else {
+			log.Warnf("%s: serial device disconnected: %v", h.device, err)
+			h.disconnected = true
+			h.delay = minReconnectDelay
+		}
+		h.retry = time.Now().Add(h.delay)
+
+		return aduResponse, err
+	}
+
+	if h.disconnected {
+		log.Infof("%s: serial device reconnected", h.device)
+		h.disconnected = false
+	}
+
+	return aduResponse, err
+}
@@ -0,0 +1,67 @@
+package meters
+
+import (
+	"errors"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+// sendHandler is a client handler returning the configured error
+type sendHandler struct {
+	modbus.ClientHandler
+	err   error
+	sends int
+}
+
+func (h *sendHandler) Send(aduRequest []byte) ([]byte, error) {
+	h.sends++
+	return nil, h.err
+}
+
+func TestReconnectHandler(t *testing.T) {
+	sh := &sendHandler{err: syscall.EIO}
+	var closed int
+	h := newReconnectHandler(sh, "/dev/ttyUSB0", func() error {
+		closed++
+		return nil
+	})
+
+	// disconnect closes the device
+	if _, err := h.Send(nil); !errors.Is(err, syscall.EIO) {
+		t.Errorf("expected EIO, got %v", err)
+	}
+	if closed != 1 || !h.disconnected || h.delay != minReconnectDelay {
+		t.Errorf("expected closed and disconnected, got %d %v %v", closed, h.disconnected, h.delay)
+	}
+
+	// requests fail fast until retry
+	if _, err := h.Send(nil); !errors.Is(err, ErrDisconnected) {
+		t.Errorf("expected ErrDisconnected, got %v", err)
+	}
+	if sh.sends != 1 {
+		t.Errorf("expected no request while disconnected, got %d", sh.sends)
+	}
+
+	// reopening fails with backoff
+	sh.err = syscall.ENOENT
+	h.retry = time.Now()
+	if _, err := h.Send(nil); !errors.Is(err, syscall.ENOENT) {
+		t.Errorf("expected ENOENT, got %v", err)
+	}
+	if h.delay != 2*minReconnectDelay {
+		t.Errorf("expected delay %v, got %v", 2*minReconnectDelay, h.delay)
+	}
+
+	// reconnected, other errors keep the device open
+	sh.err = errors.New("timeout")
+	h.retry = time.Now()
+	if _, err := h.Send(nil); err != sh.err {
+		t.Errorf("expected timeout, got %v", err)
+	}
+	if h.disconnected || closed != 2 {
+		t.Errorf("expected reconnected, got %v %d", h.disconnected, closed)
+	}
+}
@@ -0,0 +1,137 @@
+package meters
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+// modbus read function codes
+const (
+	readCoils            = 1
+	readDiscreteInputs   = 2
+	readHoldingRegisters = 3
+	readInputRegisters   = 4
+)
+
+// Record is a recorded modbus read request and its response
+type Record struct {
+	Time     time.Time `json:"time"`
+	Bus      string    `json:"bus"`
+	Slave    uint8     `json:"slave"`
+	FuncCode uint8     `json:"fc"`
+	Address  uint16    `json:"address"`
+	Quantity uint16    `json:"quantity"`
+	Data     string    `json:"data,omitempty"` // hex encoded response
+	Error    string    `json:"error,omitempty"`
+}
+
+// RecordWriter writes records as json lines. It can be shared by multiple connections.
+type RecordWriter struct {
+	mux sync.Mutex
+	enc *json.Encoder
+}
+
+// NewRecordWriter creates a record writer
+func NewRecordWriter(w io.Writer) *RecordWriter {
+	return &RecordWriter{enc: json.NewEncoder(w)}
+}
+
+// Write writes a single record
+func (w *RecordWriter) Write(r Record) error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	return w.enc.Encode(r)
+}
+
+// Recorder is a connection that records all read requests and responses of the wrapped connection
+type Recorder struct {
+	Connection
+	client *recordingClient
+}
+
+// NewRecorder wraps a connection for recording
+func NewRecorder(conn Connection, w *RecordWriter) Connection {
+	r := &Recorder{Connection: conn}
+
+	if client := conn.ModbusClient(); client != nil {
+		r.client = &recordingClient{
+			Client: client,
+			bus:    conn.String(),
+			writer: w,
+		}
+	}
+
+	return r
+}
+
+// ModbusClient returns the recording modbus client
+func (r *Recorder) ModbusClient() modbus.Client {
+	if r.client == nil {
+		return nil
+	}
+	return r.client
+}
+
+// Slave sets the modbus device id for the following operations
+func (r *Recorder) Slave(deviceID uint8) {
+	if r.client != nil {
+		r.client.slave = deviceID
+	}
+	r.Connection.Slave(deviceID)
+}
+
+// recordingClient records read operations. Other operations are passed through.
+type recordingClient struct {
+	modbus.Client
+	bus    string
+	slave  uint8
+	writer *RecordWriter
+}
+
+func (c *recordingClient) record(fc uint8, address, quantity uint16, b []byte, err error) ([]byte, error) {
+	r := Record{
+		Time:     time.Now(),
+		Bus:      c.bus,
+		Slave:    c.slave,
+		FuncCode: fc,
+		Address:  address,
+		Quantity: quantity,
+		Data:     hex.EncodeToString(b),
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+
+	_ = c.writer.Write(r)
+
+	return b, err
+}
+
+// ReadCoils implements modbus.Client
+func (c *recordingClient) ReadCoils(address, quantity uint16) ([]byte, error) {
+	b, err := c.Client.ReadCoils(address, quantity)
+	return c.record(readCoils, address, quantity, b, err)
+}
+
+// ReadDiscreteInputs implements modbus.Client
+func (c *recordingClient) ReadDiscreteInputs(address, quantity uint16) ([]byte, error) {
+	b, err := c.Client.ReadDiscreteInputs(address, quantity)
+	return c.record(readDiscreteInputs, address, quantity, b, err)
+}
+
+// ReadHoldingRegisters implements modbus.Client
+func (c *recordingClient) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	b, err := c.Client.ReadHoldingRegisters(address, quantity)
+	return c.record(readHoldingRegisters, address, quantity, b, err)
+}
+
+// ReadInputRegisters implements modbus.Client
+func (c *recordingClient) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
+	b, err := c.Client.ReadInputRegisters(address, quantity)
+	return c.record(readInputRegisters, address, quantity, b, err)
+}
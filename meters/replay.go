@@ -0,0 +1,226 @@
+package meters
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+var errReplayWrite = errors.New("replay: write not supported")
+
+type replayKey struct {
+	bus      string
+	slave    uint8
+	fc       uint8
+	address  uint16
+	quantity uint16
+}
+
+// Replay is a connection replaying recorded responses of a single bus
+type Replay struct {
+	file   string
+	bus    string
+	client *ReplayClient
+}
+
+// NewReplay creates a connection replaying the recorded responses of the bus. Bus may be empty
+// if the recording contains a single bus. Responses are timed according to the recording,
+// accelerated by speed. Speed 0 replays without delay.
+func NewReplay(file, bus string, speed float64) (Connection, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	client := &ReplayClient{
+		speed:   speed,
+		records: make(map[replayKey][]Record),
+	}
+
+	buses := make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		var r Record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			return nil, fmt.Errorf("replay: invalid record in line %d: %v", line, err)
+		}
+
+		if client.t0.IsZero() || r.Time.Before(client.t0) {
+			client.t0 = r.Time
+		}
+
+		key := replayKey{r.Bus, r.Slave, r.FuncCode, r.Address, r.Quantity}
+		client.records[key] = append(client.records[key], r)
+		buses[r.Bus] = true
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if bus == "" && len(buses) > 1 {
+		names := make([]string, 0, len(buses))
+		for name := range buses {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("replay: recording contains multiple buses, select one of %s", strings.Join(names, ", "))
+	}
+
+	client.bus = bus
+	if bus == "" {
+		for name := range buses {
+			client.bus = name
+		}
+	} else if !buses[bus] {
+		return nil, fmt.Errorf("replay: recording does not contain bus %s", bus)
+	}
+
+	return &Replay{
+		file:   file,
+		bus:    bus,
+		client: client,
+	}, nil
+}
+
+// String returns the replay file and selected bus as bus address
+func (b *Replay) String() string {
+	if b.bus == "" {
+		return "replay:" + b.file
+	}
+	return "replay:" + b.file + "?bus=" + b.bus
+}
+
+// ModbusClient returns the replay modbus client
+func (b *Replay) ModbusClient() modbus.Client {
+	return b.client
+}
+
+// Logger sets a logging instance for physical bus operations
+func (b *Replay) Logger(l Logger) {
+}
+
+// Slave sets the modbus device id for the following operations
+func (b *Replay) Slave(deviceID uint8) {
+	b.client.slave = deviceID
+}
+
+// Timeout sets the modbus timeout
+func (b *Replay) Timeout(timeout time.Duration) time.Duration {
+	return timeout
+}
+
+// Close closes the modbus connection.
+func (b *Replay) Close() {
+}
+
+// ReplayClient is a modbus client returning recorded responses of a bus in recorded order
+type ReplayClient struct {
+	mux     sync.Mutex
+	speed   float64
+	bus     string
+	slave   uint8
+	t0      time.Time // first recorded request
+	start   time.Time // first replayed request
+	records map[replayKey][]Record
+}
+
+func (c *ReplayClient) replay(fc uint8, address, quantity uint16) ([]byte, error) {
+	c.mux.Lock()
+	key := replayKey{c.bus, c.slave, fc, address, quantity}
+	records := c.records[key]
+	if len(records) == 0 {
+		c.mux.Unlock()
+		return nil, fmt.Errorf("replay: no recorded response for slave %d fc %d address %d quantity %d", c.slave, fc, address, quantity)
+	}
+
+	r := records[0]
+	c.records[key] = records[1:]
+
+	if c.start.IsZero() {
+		c.start = time.Now()
+	}
+	start := c.start
+	c.mux.Unlock()
+
+	// wait until recorded time
+	if c.speed > 0 {
+		due := start.Add(time.Duration(float64(r.Time.Sub(c.t0)) / c.speed))
+		if wait := time.Until(due); wait > 0 {
+			time.Sleep(wait)
+		}
+	}
+
+	if r.Error != "" {
+		return nil, errors.New(r.Error)
+	}
+
+	return hex.DecodeString(r.Data)
+}
+
+// ReadCoils implements modbus.Client
+func (c *ReplayClient) ReadCoils(address, quantity uint16) (results []byte, err error) {
+	return c.replay(readCoils, address, quantity)
+}
+
+// ReadDiscreteInputs implements modbus.Client
+func (c *ReplayClient) ReadDiscreteInputs(address, quantity uint16) (results []byte, err error) {
+	return c.replay(readDiscreteInputs, address, quantity)
+}
+
+// ReadHoldingRegisters implements modbus.Client
+func (c *ReplayClient) ReadHoldingRegisters(address, quantity uint16) (results []byte, err error) {
+	return c.replay(readHoldingRegisters, address, quantity)
+}
+
+// ReadInputRegisters implements modbus.Client
+func (c *ReplayClient) ReadInputRegisters(address, quantity uint16) (results []byte, err error) {
+	return c.replay(readInputRegisters, address, quantity)
+}
+
+// MaskWriteRegister implements modbus.Client
+func (c *ReplayClient) MaskWriteRegister(address, andMask, orMask uint16) (results []byte, err error) {
+	return nil, errReplayWrite
+}
+
+// ReadFIFOQueue implements modbus.Client
+func (c *ReplayClient) ReadFIFOQueue(address uint16) (results []byte, err error) {
+	return nil, errors.New("replay: fifo queue not supported")
+}
+
+// WriteSingleCoil implements modbus.Client
+func (c *ReplayClient) WriteSingleCoil(address, value uint16) (results []byte, err error) {
+	return nil, errReplayWrite
+}
+
+// WriteMultipleCoils implements modbus.Client
+func (c *ReplayClient) WriteMultipleCoils(address, quantity uint16, value []byte) (results []byte, err error) {
+	return nil, errReplayWrite
+}
+
+// WriteSingleRegister implements modbus.Client
+func (c *ReplayClient) WriteSingleRegister(address, value uint16) (results []byte, err error) {
+	return nil, errReplayWrite
+}
+
+// WriteMultipleRegisters implements modbus.Client
+func (c *ReplayClient) WriteMultipleRegisters(address, quantity uint16, value []byte) (results []byte, err error) {
+	return nil, errReplayWrite
+}
+
+// ReadWriteMultipleRegisters implements modbus.Client
+func (c *ReplayClient) ReadWriteMultipleRegisters(readAddress, readQuantity, writeAddress, writeQuantity uint16, value []byte) (results []byte, err error) {
+	return nil, errReplayWrite
+}
@@ -0,0 +1,57 @@
+package meters
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReplayBuses(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// devices with the same id on different buses
+	file := filepath.Join(dir, "session.jsonl")
+	data := `{"time":"2020-01-01T00:00:00Z","bus":"/dev/ttyUSB0","slave":1,"fc":4,"address":0,"quantity":1,"data":"0001"}
+{"time":"2020-01-01T00:00:01Z","bus":"/dev/ttyUSB1","slave":1,"fc":4,"address":0,"quantity":1,"data":"0002"}
+`
+	if err := ioutil.WriteFile(file, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewReplay(file, "", 0); err == nil {
+		t.Error("expected error for multiple buses without bus")
+	}
+	if _, err := NewReplay(file, "/dev/ttyUSB2", 0); err == nil {
+		t.Error("expected error for missing bus")
+	}
+
+	for bus, expected := range map[string]byte{"/dev/ttyUSB0": 1, "/dev/ttyUSB1": 2} {
+		conn, err := NewReplay(file, bus, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn.Slave(1)
+
+		b, err := conn.ModbusClient().ReadInputRegisters(0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(b) != 2 || b[1] != expected {
+			t.Errorf("%s: expected response %d, got % x", bus, expected, b)
+		}
+
+		// each recorded response is replayed once
+		if _, err := conn.ModbusClient().ReadInputRegisters(0, 1); err == nil {
+			t.Errorf("%s: expected error after end of recording", bus)
+		}
+
+		if s := conn.String(); s != "replay:"+file+"?bus="+bus {
+			t.Errorf("unexpected address %s", s)
+		}
+	}
+}
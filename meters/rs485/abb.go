@@ -134,6 +134,15 @@ func (p *ABBProducer) snip64u(iec Measurement, scaler ...float64) Operation {
 	return p.snip(iec, 4, unsigned, RTUUint64ToFloat64, scaler...)
 }
 
+// Identify implements Identifier interface
+func (p *ABBProducer) Identify() []MetadataOperation {
+	return []MetadataOperation{
+		{FuncCode: ReadHoldingReg, OpCode: 0x8900, ReadLen: 2, Field: MetadataSerial, Decode: RTUUint32ToString},
+		{FuncCode: ReadHoldingReg, OpCode: 0x8908, ReadLen: 8, Field: MetadataVersion, Decode: RTUASCIIToString},
+		{FuncCode: ReadHoldingReg, OpCode: 0x8960, ReadLen: 6, Field: MetadataModel, Decode: RTUASCIIToString},
+	}
+}
+
 // Probe implements Producer interface
 func (p *ABBProducer) Probe() Operation {
 	return p.snip32u(VoltageL1, 10)
@@ -0,0 +1,97 @@
+package rs485
+
+import "sort"
+
+// maxBlockLen is the maximum number of registers a single modbus read request can return
+const maxBlockLen = 125
+
+// block is a contiguous register range covering the registers of one or more operations
+type block struct {
+	funcCode uint8
+	start    uint16
+	length   uint16
+	due      int // oldest due cycle of the block's operations
+	ops      []*scheduledOp
+}
+
+// end returns the register following the block
+func (b *block) end() uint32 {
+	return uint32(b.start) + uint32(b.length)
+}
+
+// add extends the block by the operation's registers
+func (b *block) add(op *scheduledOp) {
+	if end := uint32(op.OpCode) + uint32(op.ReadLen); end > b.end() {
+		b.length = uint16(end - uint32(b.start))
+	}
+	if op.due < b.due {
+		b.due = op.due
+	}
+	b.ops = append(b.ops, op)
+}
+
+// fits returns true if the operation's registers are adjacent to or overlap with the
+// block and the extended block does not exceed maxBlockLen
+func (b *block) fits(op *scheduledOp) bool {
+	if op.FuncCode != b.funcCode || uint32(op.OpCode) > b.end() {
+		return false
+	}
+	end := uint32(op.OpCode) + uint32(op.ReadLen)
+	return end <= uint32(b.start)+maxBlockLen
+}
+
+// slice returns the operation's part of the block's response
+func (b *block) slice(bytes []byte, op *scheduledOp) []byte {
+	offset := 2 * int(op.OpCode-b.start)
+	return bytes[offset : offset+2*int(op.ReadLen)]
+}
+
+func newBlock(op *scheduledOp) *block {
+	return &block{
+		funcCode: op.FuncCode,
+		start:    op.OpCode,
+		length:   op.ReadLen,
+		due:      op.due,
+		ops:      []*scheduledOp{op},
+	}
+}
+
+// coalesce merges operations reading adjacent or overlapping registers into blocks
+// which are read using a single request. Operations marked as single are not merged.
+// Blocks are returned oldest first to retain the scheduler's starvation protection.
+func coalesce(ops []*scheduledOp) []*block {
+	sorted := make([]*scheduledOp, len(ops))
+	copy(sorted, ops)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].FuncCode != sorted[j].FuncCode {
+			return sorted[i].FuncCode < sorted[j].FuncCode
+		}
+		return sorted[i].OpCode < sorted[j].OpCode
+	})
+
+	var res []*block
+	var current *block
+
+	for _, op := range sorted {
+		// invalid operations are queried individually to report their error
+		if op.single || op.ReadLen == 0 || op.Transform == nil {
+			res = append(res, newBlock(op))
+			continue
+		}
+
+		if current != nil && current.fits(op) {
+			current.add(op)
+			continue
+		}
+
+		current = newBlock(op)
+		res = append(res, current)
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].due < res[j].due
+	})
+
+	return res
+}
@@ -0,0 +1,230 @@
+package rs485
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// blockProducer produces adjacent and non-adjacent single register operations
+type blockProducer struct{}
+
+func (p *blockProducer) Type() string        { return "TEST" }
+func (p *blockProducer) Description() string { return "Test" }
+func (p *blockProducer) Probe() Operation    { return Operation{} }
+
+func (p *blockProducer) Produce() []Operation {
+	op := func(funcCode uint8, opcode uint16, iec meters.Measurement) Operation {
+		return Operation{FuncCode: funcCode, OpCode: opcode, ReadLen: 1, IEC61850: iec, Transform: RTUUint16ToFloat64}
+	}
+
+	return []Operation{
+		op(ReadInputReg, 2, meters.VoltageL3),
+		op(ReadInputReg, 0, meters.VoltageL1),
+		op(ReadInputReg, 1, meters.VoltageL2),
+		op(ReadInputReg, 10, meters.Power),
+		op(ReadHoldingReg, 3, meters.Frequency),
+	}
+}
+
+// registerClient returns the register address as register value and
+// optionally rejects requests for more than one register
+type registerClient struct {
+	*meters.MockClient
+	reject   bool
+	requests int
+}
+
+func (c *registerClient) read(address, quantity uint16) ([]byte, error) {
+	c.requests++
+	if c.reject && quantity > 1 {
+		return nil, &modbus.Error{FunctionCode: ReadInputReg, ExceptionCode: modbus.ExceptionCodeIllegalDataAddress}
+	}
+
+	b := make([]byte, 2*quantity)
+	for i := uint16(0); i < quantity; i++ {
+		binary.BigEndian.PutUint16(b[2*i:], address+i)
+	}
+	return b, nil
+}
+
+func (c *registerClient) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
+	return c.read(address, quantity)
+}
+
+func (c *registerClient) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	return c.read(address, quantity)
+}
+
+func TestCoalesce(t *testing.T) {
+	s := newScheduler(NewSDMProducer())
+	ops := s.next()
+
+	blocks := coalesce(ops)
+	if len(blocks) >= len(ops) {
+		t.Fatalf("expected less than %d blocks, got %d", len(ops), len(blocks))
+	}
+
+	var count int
+	for _, b := range blocks {
+		if b.length > maxBlockLen {
+			t.Errorf("block %04x: length %d exceeds maximum", b.start, b.length)
+		}
+
+		for _, op := range b.ops {
+			if op.FuncCode != b.funcCode || op.OpCode < b.start || uint32(op.OpCode)+uint32(op.ReadLen) > b.end() {
+				t.Errorf("%s: not covered by block %04x length %d", op.IEC61850, b.start, b.length)
+			}
+		}
+
+		count += len(b.ops)
+	}
+
+	if count != len(ops) {
+		t.Errorf("expected %d operations, got %d", len(ops), count)
+	}
+}
+
+func TestQueryBlocks(t *testing.T) {
+	for _, reject := range []bool{false, true} {
+		p := &blockProducer{}
+		d := &RS485{producer: p, scheduler: newScheduler(p)}
+		client := &registerClient{MockClient: meters.NewMockClient(0), reject: reject}
+
+		res, err := d.Query(client)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := map[meters.Measurement]float64{
+			meters.VoltageL1: 0,
+			meters.VoltageL2: 1,
+			meters.VoltageL3: 2,
+			meters.Power:     10,
+			meters.Frequency: 3,
+		}
+
+		if len(res) != len(expected) {
+			t.Fatalf("expected %d results, got %d", len(expected), len(res))
+		}
+
+		for _, r := range res {
+			if r.Value != expected[r.Measurement] {
+				t.Errorf("%s: expected %.0f, got %.0f", r.Measurement, expected[r.Measurement], r.Value)
+			}
+		}
+
+		// three blocks, or one additional rejected request and individual reads of the first block
+		requests := 3
+		if reject {
+			requests = 6
+		}
+		if client.requests != requests {
+			t.Errorf("reject %v: expected %d requests, got %d", reject, requests, client.requests)
+		}
+
+		// rejected blocks are not coalesced again
+		client.requests = 0
+		if _, err := d.Query(client); err != nil {
+			t.Fatal(err)
+		}
+
+		if reject {
+			requests = 5
+		}
+		if client.requests != requests {
+			t.Errorf("reject %v: expected %d requests in second cycle, got %d", reject, requests, client.requests)
+		}
+	}
+}
+
+// counterProducer produces a low priority counter followed by a non-adjacent high priority operation
+type counterProducer struct{ blockProducer }
+
+func (p *counterProducer) Produce() []Operation {
+	return []Operation{
+		{FuncCode: ReadInputReg, OpCode: 0, ReadLen: 1, IEC61850: meters.Import, Transform: RTUUint16ToFloat64},
+		{FuncCode: ReadInputReg, OpCode: 200, ReadLen: 1, IEC61850: meters.Power, Transform: RTUUint16ToFloat64},
+	}
+}
+
+// failingClient fails the given request
+type failingClient struct {
+	registerClient
+	fail int
+}
+
+func (c *failingClient) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
+	if c.requests+1 == c.fail {
+		c.requests++
+		return nil, errors.New("timeout")
+	}
+	return c.registerClient.read(address, quantity)
+}
+
+func TestQueryPartialFailure(t *testing.T) {
+	p := &counterProducer{}
+	d := &RS485{producer: p, scheduler: newScheduler(p)}
+	client := &failingClient{registerClient: registerClient{MockClient: meters.NewMockClient(0)}}
+
+	// query until the counter is due after its initial reads
+	counter := d.scheduler.ops[0]
+	for i := 0; i < 2 || counter.due > d.scheduler.cycle+1; i++ {
+		if _, err := d.Query(client); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the counter is read before the query fails
+	client.fail = client.requests + 2
+	if _, err := d.Query(client); err == nil {
+		t.Fatal("expected error")
+	}
+
+	// the handler discards the partial result, the retry must read the counter again
+	res, err := d.Query(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read := make(map[meters.Measurement]bool)
+	for _, r := range res {
+		read[r.Measurement] = true
+	}
+
+	if !read[meters.Import] || !read[meters.Power] {
+		t.Errorf("expected counter and power read by retry, got %v", res)
+	}
+
+	// the counter is rescheduled after the successful query
+	if counter.due <= d.scheduler.cycle+1 {
+		t.Errorf("expected counter rescheduled, due %d in cycle %d", counter.due, d.scheduler.cycle)
+	}
+}
+
+func TestPrioritize(t *testing.T) {
+	p := &counterProducer{}
+	d := &RS485{producer: p, scheduler: newScheduler(p)}
+	client := &registerClient{MockClient: meters.NewMockClient(0)}
+
+	if err := d.Prioritize(0); err == nil {
+		t.Error("expected invalid interval error")
+	}
+
+	if err := d.Prioritize(1); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		res, err := d.Query(client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res) != 2 {
+			t.Errorf("cycle %d: expected all operations read, got %v", i, res)
+		}
+	}
+}
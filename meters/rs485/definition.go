@@ -0,0 +1,227 @@
+package rs485
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// DataType is the data type of a register value
+type DataType string
+
+const (
+	Int16   DataType = "int16"
+	Uint16  DataType = "uint16"
+	Int32   DataType = "int32"
+	Uint32  DataType = "uint32"
+	Int64   DataType = "int64"
+	Uint64  DataType = "uint64"
+	Float32 DataType = "float32"
+	Float64 DataType = "float64"
+	Bit     DataType = "bit" // single bit of a status register, see RegisterDefinition.Bit
+)
+
+// WordOrder is the register order of values spanning multiple registers
+type WordOrder int
+
+const (
+	// HighWordFirst is the default modbus word order
+	HighWordFirst WordOrder = iota
+	// LowWordFirst is used by meters sending the least significant register first
+	LowWordFirst
+)
+
+// dataType describes the register count and decoding of a data type
+type dataType struct {
+	words     uint16
+	transform RTUTransform
+}
+
+var dataTypes = map[DataType]dataType{
+	Int16:   {1, RTUInt16ToFloat64},
+	Uint16:  {1, RTUUint16ToFloat64},
+	Int32:   {2, RTUInt32ToFloat64},
+	Uint32:  {2, RTUUint32ToFloat64},
+	Int64:   {4, RTUInt64ToFloat64},
+	Uint64:  {4, RTUUint64ToFloat64},
+	Float32: {2, RTUIeee754ToFloat64},
+	Float64: {4, RTUIeee754DoubleToFloat64},
+}
+
+// RegisterDefinition describes how a measurement is read from a device's registers.
+// Function code, register count and data type can be chosen independently for each
+// register such that devices mixing input and holding registers or data types can be
+// described without custom code.
+type RegisterDefinition struct {
+	FuncCode  uint8     // ReadInputReg or ReadHoldingReg
+	OpCode    uint16    // first register
+	Words     uint16    // number of registers, defaults to the data type's size
+	Type      DataType  // data type of the value
+	WordOrder WordOrder // register order of multi-register values
+	Scaler    float64   // optional divisor applied to the value
+	Bit       uint8     // bit of Bit type status registers, counted from the least significant bit
+}
+
+// Operation creates the operation reading the measurement
+func (r RegisterDefinition) Operation(iec meters.Measurement) (Operation, error) {
+	if r.FuncCode != ReadInputReg && r.FuncCode != ReadHoldingReg {
+		return Operation{}, fmt.Errorf("%s: invalid function code %d", iec, r.FuncCode)
+	}
+
+	if r.Type == Bit {
+		return r.bitOperation(iec)
+	}
+
+	dt, ok := dataTypes[r.Type]
+	if !ok {
+		return Operation{}, fmt.Errorf("%s: invalid data type %s", iec, r.Type)
+	}
+
+	words := r.Words
+	if words == 0 {
+		words = dt.words
+	}
+	if words < dt.words {
+		return Operation{}, fmt.Errorf("%s: data type %s requires %d registers", iec, r.Type, dt.words)
+	}
+
+	op := Operation{
+		FuncCode:  r.FuncCode,
+		OpCode:    r.OpCode,
+		ReadLen:   words,
+		IEC61850:  iec,
+		Transform: dt.transform,
+	}
+
+	if r.WordOrder == LowWordFirst && dt.words > 1 {
+		// swap the data type's registers only, surplus registers are ignored
+		size := 2 * int(dt.words)
+		swapped := MakeWordSwappedTransform(dt.transform)
+		op.Transform = func(b []byte) float64 {
+			return swapped(b[:size])
+		}
+	}
+
+	if r.Scaler != 0 {
+		op.Transform = MakeScaledTransform(op.Transform, r.Scaler)
+	}
+
+	return op, nil
+}
+
+// bitOperation creates the operation reading a single bit of a status register as boolean measurement.
+// Multiple measurements can be defined for the bits of the same register, their reads are coalesced.
+func (r RegisterDefinition) bitOperation(iec meters.Measurement) (Operation, error) {
+	words := r.Words
+	if words == 0 {
+		words = 1
+	}
+	if words > 4 || uint16(r.Bit) >= 16*words {
+		return Operation{}, fmt.Errorf("%s: bit %d exceeds %d registers", iec, r.Bit, words)
+	}
+
+	op := Operation{
+		FuncCode:  r.FuncCode,
+		OpCode:    r.OpCode,
+		ReadLen:   words,
+		IEC61850:  iec,
+		Transform: MakeBitTransform(uint(r.Bit)),
+	}
+
+	if r.WordOrder == LowWordFirst && words > 1 {
+		op.Transform = MakeWordSwappedTransform(op.Transform)
+	}
+
+	return op, nil
+}
+
+// Registers maps measurements to register definitions
+type Registers map[meters.Measurement]RegisterDefinition
+
+// WithWordOrder returns a copy of the register definitions using the given word order,
+// e.g. for meters sending all multi-register values with the low word first
+func (r Registers) WithWordOrder(order WordOrder) Registers {
+	res := make(Registers, len(r))
+	for iec, reg := range r {
+		reg.WordOrder = order
+		res[iec] = reg
+	}
+	return res
+}
+
+// DefinitionProducer is a producer for devices described by register definitions
+type DefinitionProducer struct {
+	typ, description string
+	probe            Operation
+	ops              []Operation
+}
+
+// NewDefinitionProducer creates a producer from register definitions. The probe
+// measurement must be part of the definitions. Invalid definitions panic.
+func NewDefinitionProducer(typ, description string, probe meters.Measurement, registers Registers) Producer {
+	p, err := newDefinitionProducer(typ, description, probe, registers)
+	if err != nil {
+		panic(err.Error())
+	}
+	return p
+}
+
+// newDefinitionProducer creates a producer from register definitions
+func newDefinitionProducer(typ, description string, probe meters.Measurement, registers Registers) (*DefinitionProducer, error) {
+	p := &DefinitionProducer{
+		typ:         typ,
+		description: description,
+	}
+
+	for iec, r := range registers {
+		op, err := r.Operation(iec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s definition: %v", typ, err)
+		}
+
+		if iec == probe {
+			p.probe = op
+		}
+
+		p.ops = append(p.ops, op)
+	}
+
+	if p.probe.FuncCode == 0 {
+		return nil, fmt.Errorf("invalid %s definition: undefined probe %s", typ, probe)
+	}
+
+	sortOperations(p.ops)
+
+	return p, nil
+}
+
+// sortOperations sorts the operations by function code and register
+func sortOperations(ops []Operation) {
+	sort.Slice(ops, func(i, j int) bool {
+		if ops[i].FuncCode != ops[j].FuncCode {
+			return ops[i].FuncCode < ops[j].FuncCode
+		}
+		return ops[i].OpCode < ops[j].OpCode
+	})
+}
+
+// Type implements Producer interface
+func (p *DefinitionProducer) Type() string {
+	return p.typ
+}
+
+// Description implements Producer interface
+func (p *DefinitionProducer) Description() string {
+	return p.description
+}
+
+// Probe implements Producer interface
+func (p *DefinitionProducer) Probe() Operation {
+	return p.probe
+}
+
+// Produce implements Producer interface
+func (p *DefinitionProducer) Produce() []Operation {
+	return append([]Operation(nil), p.ops...)
+}
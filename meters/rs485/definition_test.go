@@ -0,0 +1,65 @@
+package rs485
+
+import (
+	"testing"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestRegisterDefinition(t *testing.T) {
+	tc := []struct {
+		r       RegisterDefinition
+		b       []byte
+		readLen uint16
+		value   float64
+		err     bool
+	}{
+		{RegisterDefinition{FuncCode: ReadInputReg, Type: Float32}, []byte{0x43, 0x66, 0x80, 0}, 2, 230.5, false},
+		{RegisterDefinition{FuncCode: ReadHoldingReg, Type: Int32, Scaler: 10}, []byte{0xff, 0xff, 0xff, 0x9c}, 2, -10, false},
+		{RegisterDefinition{FuncCode: ReadHoldingReg, Type: Uint16, Words: 2}, []byte{0, 42, 0, 0}, 2, 42, false},
+		{RegisterDefinition{FuncCode: ReadHoldingReg, Type: Int32, WordOrder: LowWordFirst}, []byte{0xff, 0x9c, 0xff, 0xff}, 2, -100, false},
+		{RegisterDefinition{FuncCode: ReadHoldingReg, Type: Uint32, Words: 3, WordOrder: LowWordFirst}, []byte{0, 0, 0, 1, 0xff, 0xff}, 3, 1 << 16, false},
+		{RegisterDefinition{FuncCode: ReadHoldingReg, Type: Int64, Words: 2}, nil, 0, 0, true},
+		{RegisterDefinition{FuncCode: ReadInputReg, Type: Bit, Bit: 2}, []byte{0, 0x04}, 1, 1, false},
+		{RegisterDefinition{FuncCode: ReadInputReg, Type: Bit, Bit: 3}, []byte{0, 0x04}, 1, 0, false},
+		{RegisterDefinition{FuncCode: ReadInputReg, Type: Bit, Bit: 16, Words: 2}, []byte{0, 0x01, 0, 0}, 2, 1, false},
+		{RegisterDefinition{FuncCode: ReadInputReg, Type: Bit, Bit: 16, Words: 2, WordOrder: LowWordFirst}, []byte{0, 0, 0, 0x01}, 2, 1, false},
+		{RegisterDefinition{FuncCode: ReadInputReg, Type: Bit, Bit: 16}, nil, 0, 0, true},
+		{RegisterDefinition{FuncCode: 1, Type: Uint16}, nil, 0, 0, true},
+		{RegisterDefinition{FuncCode: ReadInputReg, Type: "float16"}, nil, 0, 0, true},
+	}
+
+	for i, c := range tc {
+		op, err := c.r.Operation(meters.Power)
+		if c.err {
+			if err == nil {
+				t.Errorf("%d: expected error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+
+		if op.FuncCode != c.r.FuncCode || op.ReadLen != c.readLen {
+			t.Errorf("%d: expected function code %d and %d registers, got %d and %d", i, c.r.FuncCode, c.readLen, op.FuncCode, op.ReadLen)
+		}
+		if v := op.Transform(c.b); v != c.value {
+			t.Errorf("%d: expected %v, got %v", i, c.value, v)
+		}
+	}
+}
+
+func TestDefinitionProducer(t *testing.T) {
+	p := NewIEM3000Producer()
+
+	if op := p.Probe(); op.IEC61850 != meters.VoltageL1 || op.ReadLen != 2 {
+		t.Errorf("unexpected probe %v", op)
+	}
+
+	for _, op := range p.Produce() {
+		if op.IEC61850 == meters.Import && op.ReadLen != 4 {
+			t.Errorf("%s: expected 4 registers, got %d", op.IEC61850, op.ReadLen)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package rs485
+
+import "github.com/volkszaehler/mbmd/meters"
+
+// MetadataField is a device descriptor field that can be read from device registers
+type MetadataField int
+
+const (
+	MetadataModel MetadataField = iota
+	MetadataVersion
+	MetadataSerial
+)
+
+// MetadataOperation describes reading a device descriptor field, e.g. from ASCII string registers
+type MetadataOperation struct {
+	FuncCode uint8
+	OpCode   uint16
+	ReadLen  uint16
+	Field    MetadataField
+	Decode   RTUStringTransform
+}
+
+// Identifier is implemented by producers that read device metadata like model,
+// firmware version or serial number from the device
+type Identifier interface {
+	// Identify returns the operations for reading device metadata
+	Identify() []MetadataOperation
+}
+
+// set assigns the decoded value to the descriptor field
+func (f MetadataField) set(desc *meters.DeviceDescriptor, value string) {
+	switch f {
+	case MetadataModel:
+		desc.Model = value
+	case MetadataVersion:
+		desc.Version = value
+	case MetadataSerial:
+		desc.Serial = value
+	}
+}
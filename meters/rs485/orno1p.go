@@ -107,6 +107,30 @@ func (p *ORNO1PProducer) snip32(iec Measurement, scaler ...float64) Operation {
 	return snip
 }
 
+// snip16i creates modbus operation for single signed register
+func (p *ORNO1PProducer) snip16i(iec Measurement, scaler ...float64) Operation {
+	snip := p.snip16(iec, scaler...)
+
+	snip.Transform = RTUInt16ToFloat64 // export is negative
+	if len(scaler) > 0 {
+		snip.Transform = MakeScaledTransform(snip.Transform, scaler[0])
+	}
+
+	return snip
+}
+
+// snip32i creates modbus operation for double signed register
+func (p *ORNO1PProducer) snip32i(iec Measurement, scaler ...float64) Operation {
+	snip := p.snip32(iec, scaler...)
+
+	snip.Transform = RTUInt32ToFloat64 // export is negative
+	if len(scaler) > 0 {
+		snip.Transform = MakeScaledTransform(snip.Transform, scaler[0])
+	}
+
+	return snip
+}
+
 func (p *ORNO1PProducer) Probe() Operation {
 	return p.snip16(VoltageL1, 100)
 }
@@ -128,7 +152,13 @@ func (p *ORNO1PProducer) Produce() (res []Operation) {
 	}
 
 	for _, op := range []Measurement{
-		PowerL1, ReactivePowerL1, ApparentPowerL1,
+		PowerL1, ReactivePowerL1,
+	} {
+		res = append(res, p.snip32i(op, 1))
+	}
+
+	for _, op := range []Measurement{
+		ApparentPowerL1,
 	} {
 		res = append(res, p.snip32(op, 1))
 	}
@@ -136,7 +166,7 @@ func (p *ORNO1PProducer) Produce() (res []Operation) {
 	for _, op := range []Measurement{
 		CosphiL1,
 	} {
-		res = append(res, p.snip16(op, 1000))
+		res = append(res, p.snip16i(op, 1000))
 	}
 
 	for _, op := range []Measurement{
@@ -32,6 +32,37 @@ type Producer interface {
 	Probe() Operation
 }
 
+// Protector is implemented by producers that declare registers which must not be
+// written since doing so may damage the device or lose data, e.g. calibration,
+// factory settings or energy counter reset registers
+type Protector interface {
+	// Protected returns the write-protected register ranges
+	Protected() []RegisterRange
+}
+
+// RegisterRange is an inclusive range of registers
+type RegisterRange struct {
+	Start, End uint16
+}
+
+// IsProtected returns true if any of the length registers starting at register
+// is write-protected by the producer
+func IsProtected(p Producer, register, length uint16) bool {
+	pr, ok := p.(Protector)
+	if !ok || length == 0 {
+		return false
+	}
+
+	last := uint32(register) + uint32(length) - 1
+	for _, r := range pr.Protected() {
+		if uint32(register) <= uint32(r.End) && last >= uint32(r.Start) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Opcodes map measurements to physical registers
 type Opcodes map[meters.Measurement]uint16
 
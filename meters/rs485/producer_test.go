@@ -0,0 +1,26 @@
+package rs485
+
+import "testing"
+
+func TestIsProtected(t *testing.T) {
+	p := NewSDMProducer()
+
+	tc := []struct {
+		register, length uint16
+		protected        bool
+	}{
+		{0x0014, 2, false},
+		{0x0016, 2, false},
+		{0x0017, 2, true},
+		{0x0019, 1, true},
+		{0xF010, 1, true},
+		{0xF00E, 2, false},
+		{0xF010, 0, false},
+	}
+
+	for _, c := range tc {
+		if IsProtected(p, c.register, c.length) != c.protected {
+			t.Errorf("register %04x length %d: expected protected %v", c.register, c.length, c.protected)
+		}
+	}
+}
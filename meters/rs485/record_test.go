@@ -0,0 +1,120 @@
+package rs485
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// cycleClient returns the register address plus 100 times the cycle as register value
+type cycleClient struct {
+	*meters.MockClient
+	cycle uint16
+}
+
+func (c *cycleClient) read(address, quantity uint16) ([]byte, error) {
+	b := make([]byte, 2*quantity)
+	for i := uint16(0); i < quantity; i++ {
+		binary.BigEndian.PutUint16(b[2*i:], address+i+100*c.cycle)
+	}
+	return b, nil
+}
+
+func (c *cycleClient) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
+	return c.read(address, quantity)
+}
+
+func (c *cycleClient) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	return c.read(address, quantity)
+}
+
+// values returns the query results by measurement
+func values(res []meters.MeasurementResult) map[meters.Measurement]float64 {
+	v := make(map[meters.Measurement]float64, len(res))
+	for _, r := range res {
+		v[r.Measurement] = r.Value
+	}
+	return v
+}
+
+func TestRecordReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "record")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "session.jsonl")
+	f, err := os.Create(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// record a session of three cycles
+	const cycles = 3
+	const gap = 50 * time.Millisecond
+
+	client := &cycleClient{MockClient: meters.NewMockClient(0)}
+	conn := meters.NewRecorder(&meters.Mock{Client: client}, meters.NewRecordWriter(f))
+	conn.Slave(1)
+
+	p := &blockProducer{}
+	d := &RS485{producer: p, scheduler: newScheduler(p)}
+
+	var recorded []map[meters.Measurement]float64
+	for client.cycle = 0; client.cycle < cycles; client.cycle++ {
+		if client.cycle > 0 {
+			time.Sleep(gap)
+		}
+
+		res, err := d.Query(conn.ModbusClient())
+		if err != nil {
+			t.Fatal(err)
+		}
+		recorded = append(recorded, values(res))
+	}
+
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, speed := range []float64{0, 2} {
+		replay, err := meters.NewReplay(file, "", speed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		replay.Slave(1)
+
+		d := &RS485{producer: p, scheduler: newScheduler(p)}
+
+		start := time.Now()
+		for i, expected := range recorded {
+			res, err := d.Query(replay.ModbusClient())
+			if err != nil {
+				t.Fatalf("speed %v: cycle %d: %v", speed, i, err)
+			}
+
+			if v := values(res); !reflect.DeepEqual(v, expected) {
+				t.Errorf("speed %v: cycle %d: expected %v, got %v", speed, i, expected, v)
+			}
+		}
+
+		// replay is timed like the recording, accelerated by speed
+		if speed > 0 {
+			if elapsed, due := time.Since(start), time.Duration(float64((cycles-1)*gap)/speed); elapsed < due {
+				t.Errorf("speed %v: expected replay to take at least %v, got %v", speed, due, elapsed)
+			}
+		}
+
+		// no responses left
+		if _, err := d.Query(replay.ModbusClient()); err == nil {
+			t.Errorf("speed %v: expected error after end of recording", speed)
+		}
+	}
+}
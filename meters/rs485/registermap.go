@@ -0,0 +1,191 @@
+package rs485
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/volkszaehler/mbmd/meters"
+	"gopkg.in/yaml.v2"
+)
+
+// RegisterMap is the YAML description of a device type's registers. It allows
+// adding devices without writing code:
+//
+//	type: MYMETER
+//	description: My meter
+//	probe: VoltageL1
+//	registers:
+//	- measurement: VoltageL1
+//	  address: 0x0000
+//	  fc: 4
+//	  type: float32
+//	- obis: 1-0:1.8.0
+//	  address: 0x0100
+//	  fc: 3
+//	  type: uint32
+//	  scaler: 100
+type RegisterMap struct {
+	Type        string
+	Description string
+	Probe       string // measurement used for detecting the device, defaults to the first register
+	WordOrder   string `yaml:"wordorder"` // high (default) or low
+	Registers   []RegisterMapEntry
+}
+
+// RegisterMapEntry describes a measurement's register. The measurement is given by
+// its IEC 61850 name or by OBIS code.
+type RegisterMapEntry struct {
+	Measurement string
+	OBIS        string `yaml:"obis" mapstructure:"obis"`
+	Address     uint16
+	FuncCode    uint8 `yaml:"fc" mapstructure:"fc"` // 3 for holding and 4 for input registers
+	Type        DataType
+	Words       uint16
+	WordOrder   string `yaml:"wordorder" mapstructure:"wordorder"` // overrides the map's word order
+	Scaler      float64
+	Bit         uint8
+}
+
+// parseWordOrder converts the word order name
+func parseWordOrder(s string) (WordOrder, error) {
+	switch strings.ToLower(s) {
+	case "", "high":
+		return HighWordFirst, nil
+	case "low":
+		return LowWordFirst, nil
+	default:
+		return 0, fmt.Errorf("invalid word order %s", s)
+	}
+}
+
+// measurement returns the entry's measurement
+func (e RegisterMapEntry) measurement() (meters.Measurement, error) {
+	switch {
+	case e.Measurement != "" && e.OBIS != "":
+		return 0, fmt.Errorf("register %d: measurement and obis code are exclusive", e.Address)
+	case e.OBIS != "":
+		return meters.OBISMeasurement(e.OBIS)
+	case e.Measurement != "":
+		m, err := meters.MeasurementString(e.Measurement)
+		if err != nil {
+			return 0, fmt.Errorf("invalid measurement %s", e.Measurement)
+		}
+		return m, nil
+	default:
+		return 0, fmt.Errorf("register %d: missing measurement", e.Address)
+	}
+}
+
+// NewRegisters converts register map entries to register definitions using the default word order.
+// First is the measurement of the first entry.
+func NewRegisters(entries []RegisterMapEntry, order WordOrder) (registers Registers, first meters.Measurement, err error) {
+	registers = make(Registers, len(entries))
+	for _, e := range entries {
+		m, err := e.measurement()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if _, ok := registers[m]; ok {
+			return nil, 0, fmt.Errorf("duplicate measurement %s", m)
+		}
+
+		def := RegisterDefinition{
+			FuncCode:  e.FuncCode,
+			OpCode:    e.Address,
+			Words:     e.Words,
+			Type:      DataType(strings.ToLower(string(e.Type))),
+			WordOrder: order,
+			Scaler:    e.Scaler,
+			Bit:       e.Bit,
+		}
+
+		if e.WordOrder != "" {
+			if def.WordOrder, err = parseWordOrder(e.WordOrder); err != nil {
+				return nil, 0, fmt.Errorf("%s: %v", m, err)
+			}
+		}
+
+		// validate definition
+		if _, err := def.Operation(m); err != nil {
+			return nil, 0, err
+		}
+
+		if first == 0 {
+			first = m
+		}
+
+		registers[m] = def
+	}
+
+	return registers, first, nil
+}
+
+// Producer creates the producer of the register map
+func (r RegisterMap) Producer() (Producer, error) {
+	if r.Type == "" {
+		return nil, errors.New("missing type")
+	}
+	if len(r.Registers) == 0 {
+		return nil, fmt.Errorf("%s: missing registers", r.Type)
+	}
+
+	order, err := parseWordOrder(r.WordOrder)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", r.Type, err)
+	}
+
+	var probe meters.Measurement
+	if r.Probe != "" {
+		if probe, err = meters.MeasurementString(r.Probe); err != nil {
+			return nil, fmt.Errorf("%s: invalid probe %s", r.Type, r.Probe)
+		}
+	}
+
+	registers, first, err := NewRegisters(r.Registers, order)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", r.Type, err)
+	}
+
+	if probe == 0 {
+		probe = first
+	}
+
+	description := r.Description
+	if description == "" {
+		description = r.Type
+	}
+
+	p, err := newDefinitionProducer(strings.ToUpper(r.Type), description, probe, registers)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// LoadRegisterMap reads a YAML register map file and registers its device type
+func LoadRegisterMap(file string) (string, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	var r RegisterMap
+	if err := yaml.UnmarshalStrict(b, &r); err != nil {
+		return "", fmt.Errorf("%s: %v", file, err)
+	}
+
+	p, err := r.Producer()
+	if err != nil {
+		return "", fmt.Errorf("%s: %v", file, err)
+	}
+
+	if err := register(func() Producer { return p }); err != nil {
+		return "", fmt.Errorf("%s: %v", file, err)
+	}
+
+	return p.Type(), nil
+}
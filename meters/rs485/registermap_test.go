@@ -0,0 +1,162 @@
+package rs485
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestLoadRegisterMap(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mbmd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "meter.yaml")
+	if err := ioutil.WriteFile(file, []byte(`
+type: testmap
+description: Test meter
+wordorder: low
+registers:
+- measurement: Power
+  address: 0x10
+  fc: 3
+  type: int32
+  scaler: 10
+- obis: 1-0:32.7.0*255
+  address: 0
+  fc: 4
+  type: float32
+  wordorder: high
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	typ, err := LoadRegisterMap(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer delete(Producers, typ)
+
+	if typ != "TESTMAP" {
+		t.Errorf("unexpected type %s", typ)
+	}
+
+	p := Producers[typ]()
+	if op := p.Probe(); op.IEC61850 != meters.Power {
+		t.Errorf("expected first register as probe, got %s", op.IEC61850)
+	}
+
+	ops := p.Produce()
+	if len(ops) != 2 {
+		t.Fatalf("expected 2 operations, got %d", len(ops))
+	}
+
+	for _, op := range ops {
+		switch op.IEC61850 {
+		case meters.Power:
+			if v := op.Transform([]byte{0xff, 0x9c, 0xff, 0xff}); v != -10 {
+				t.Errorf("power: expected -10, got %v", v)
+			}
+		case meters.VoltageL1:
+			if op.FuncCode != ReadInputReg || op.ReadLen != 2 {
+				t.Errorf("voltage: unexpected operation %v", op)
+			}
+			if v := op.Transform([]byte{0x43, 0x66, 0x80, 0}); v != 230.5 {
+				t.Errorf("voltage: expected 230.5, got %v", v)
+			}
+		default:
+			t.Errorf("unexpected operation %v", op)
+		}
+	}
+
+	if _, err := LoadRegisterMap(file); err == nil {
+		t.Error("expected duplicate type error")
+	}
+}
+
+func TestRegisterMapErrors(t *testing.T) {
+	for _, r := range []RegisterMap{
+		{Registers: []RegisterMapEntry{{Measurement: "Power", FuncCode: 3, Type: Float32}}},
+		{Type: "X"},
+		{Type: "X", Registers: []RegisterMapEntry{{Measurement: "Foo", FuncCode: 3, Type: Float32}}},
+		{Type: "X", Registers: []RegisterMapEntry{{OBIS: "1-0:99.99.99", FuncCode: 3, Type: Float32}}},
+		{Type: "X", Registers: []RegisterMapEntry{{Measurement: "Power", OBIS: "1.8.0", FuncCode: 3, Type: Float32}}},
+		{Type: "X", Registers: []RegisterMapEntry{{Measurement: "Power", FuncCode: 1, Type: Float32}}},
+		{Type: "X", Registers: []RegisterMapEntry{{Measurement: "Power", FuncCode: 3, Type: Float32}, {OBIS: "16.7.0", FuncCode: 3, Type: Float32}}},
+		{Type: "X", Probe: "Frequency", Registers: []RegisterMapEntry{{Measurement: "Power", FuncCode: 3, Type: Float32}}},
+		{Type: "X", WordOrder: "middle", Registers: []RegisterMapEntry{{Measurement: "Power", FuncCode: 3, Type: Float32}}},
+	} {
+		if _, err := r.Producer(); err == nil {
+			t.Errorf("%v: expected error", r)
+		}
+	}
+}
+
+func TestOverride(t *testing.T) {
+	d, err := NewDevice(METERTYPE_SDM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	registers, _, err := NewRegisters([]RegisterMapEntry{
+		{Measurement: "VoltageL1", Address: 0x100, FuncCode: ReadHoldingReg, Type: Int16},
+		{Measurement: "OperatingHours", Address: 0x200, FuncCode: ReadInputReg, Type: Uint32},
+	}, HighWordFirst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := d.Override(registers); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.probe == nil || d.probe.OpCode != 0x100 || d.probe.FuncCode != ReadHoldingReg {
+		t.Errorf("expected overridden probe, got %v", d.probe)
+	}
+
+	ops := make(map[meters.Measurement]Operation)
+	for _, op := range d.scheduler.ops {
+		ops[op.IEC61850] = op.Operation
+	}
+
+	if len(ops) != len(d.producer.Produce())+1 {
+		t.Errorf("expected one additional operation, got %d", len(ops)-len(d.producer.Produce()))
+	}
+	if op := ops[meters.VoltageL1]; op.OpCode != 0x100 || op.ReadLen != 1 {
+		t.Errorf("unexpected VoltageL1 operation %v", op)
+	}
+	if op := ops[meters.OperatingHours]; op.OpCode != 0x200 || op.ReadLen != 2 {
+		t.Errorf("unexpected OperatingHours operation %v", op)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	d, err := NewDevice(METERTYPE_SDM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := d.Filter([]string{"power*", "Import"}, []string{"*L3"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, op := range d.scheduler.operations() {
+		name := op.IEC61850.String()
+		if name != "Import" && !strings.HasPrefix(name, "Power") || strings.HasSuffix(name, "L3") {
+			t.Errorf("unexpected measurement %s", name)
+		}
+	}
+
+	if err := d.Filter([]string{"["}, nil); err == nil {
+		t.Error("expected invalid pattern error")
+	}
+	if err := d.Filter([]string{"Unknown"}, nil); err == nil {
+		t.Error("expected empty filter error")
+	}
+}
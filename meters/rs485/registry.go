@@ -1,21 +1,29 @@
 package rs485
 
 import (
-	"log"
+	"fmt"
 	"strings"
 )
 
 // Producers is the registry of Producer factory functions
 var Producers = make(map[string]func() Producer)
 
-// Register registers a producer implementation
+// Register registers a producer implementation. It panics if the meter type is already registered.
 func Register(factory func() Producer) {
+	if err := register(factory); err != nil {
+		panic(err)
+	}
+}
+
+// register registers a producer implementation unless its meter type already exists
+func register(factory func() Producer) error {
 	p := factory()
 	meterType := strings.ToUpper(p.Type())
 
 	if _, ok := Producers[meterType]; ok {
-		log.Fatalf("Cannot register duplicate meter type %s", meterType)
+		return fmt.Errorf("cannot register duplicate meter type %s", meterType)
 	}
 
 	Producers[meterType] = factory
+	return nil
 }
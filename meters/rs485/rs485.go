@@ -1,7 +1,12 @@
 package rs485
 
 import (
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"path"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/grid-x/modbus"
@@ -15,16 +20,22 @@ const (
 
 // RS485 implements meters.Device
 type RS485 struct {
-	producer Producer
-	ops      chan Operation
-	inflight Operation
+	producer   Producer
+	scheduler  *scheduler
+	probe      *Operation // overridden probe operation
+	mux        sync.Mutex // guard descriptor
+	descriptor meters.DeviceDescriptor
+	identified bool // metadata has been read
 }
 
 // NewDevice creates a device who's type must exist in the producer registry
 func NewDevice(typeid string) (*RS485, error) {
 	if factory, ok := Producers[typeid]; ok {
+		producer := factory()
 		device := &RS485{
-			producer: factory(),
+			producer:   producer,
+			scheduler:  newScheduler(producer),
+			descriptor: defaultDescriptor(producer),
 		}
 		return device, nil
 	}
@@ -34,28 +45,172 @@ func NewDevice(typeid string) (*RS485, error) {
 
 // Initialize prepares the device for usage. Any setup or initialization should be done here.
 func (d *RS485) Initialize(client modbus.Client) error {
+	// re-read metadata, the device may have been replaced
+	d.identified = false
 	return nil
 }
 
+// defaultDescriptor returns the descriptor of devices without metadata
+func defaultDescriptor(p Producer) meters.DeviceDescriptor {
+	typ := p.Type()
+	return meters.DeviceDescriptor{
+		Type:         typ,
+		Manufacturer: typ,
+		Model:        p.Description(),
+	}
+}
+
+// identify reads the device metadata if supported by the producer. Since metadata is
+// optional, it is read once the device has responded and read errors are ignored.
+func (d *RS485) identify(client modbus.Client) {
+	d.identified = true
+
+	id, ok := d.producer.(Identifier)
+	if !ok {
+		return
+	}
+
+	desc := defaultDescriptor(d.producer)
+	for _, op := range id.Identify() {
+		b, err := readRegisters(client, op.FuncCode, op.OpCode, op.ReadLen)
+		if err != nil {
+			continue
+		}
+
+		if value := op.Decode(b); value != "" {
+			op.Field.set(&desc, value)
+		}
+	}
+
+	d.mux.Lock()
+	d.descriptor = desc
+	d.mux.Unlock()
+}
+
+// Identify implements meters.Identifiable interface
+func (d *RS485) Identify(client modbus.Client) {
+	d.identify(client)
+}
+
+// Override replaces or adds the register definitions of measurements, e.g. for firmware
+// variants with moved or additional registers. It must be called before the device is queried.
+func (d *RS485) Override(registers Registers) error {
+	overrides := make(map[meters.Measurement]Operation, len(registers))
+	for iec, r := range registers {
+		op, err := r.Operation(iec)
+		if err != nil {
+			return err
+		}
+		overrides[iec] = op
+	}
+
+	var ops []Operation
+	for _, op := range d.scheduler.operations() {
+		if _, ok := overrides[op.IEC61850]; !ok {
+			ops = append(ops, op)
+		}
+	}
+	for _, op := range overrides {
+		ops = append(ops, op)
+	}
+
+	sortOperations(ops)
+
+	if op, ok := overrides[d.producer.Probe().IEC61850]; ok {
+		d.probe = &op
+	}
+	d.reschedule(ops, d.scheduler.low)
+
+	return nil
+}
+
+// Filter restricts the queried measurements to those matching any include and no exclude pattern.
+// Patterns are case-insensitive globs of measurement names like Import*. Without include patterns
+// all measurements are included. It must be called before the device is queried.
+func (d *RS485) Filter(include, exclude []string) error {
+	for _, pattern := range append(append([]string(nil), include...), exclude...) {
+		if _, err := path.Match(strings.ToLower(pattern), ""); err != nil {
+			return fmt.Errorf("invalid measurement pattern %s", pattern)
+		}
+	}
+
+	var ops []Operation
+	for _, op := range d.scheduler.operations() {
+		name := op.IEC61850.String()
+		if (len(include) == 0 || matchAny(include, name)) && !matchAny(exclude, name) {
+			ops = append(ops, op)
+		}
+	}
+
+	if len(ops) == 0 {
+		return errors.New("no measurements left after filtering")
+	}
+
+	d.reschedule(ops, d.scheduler.low)
+
+	return nil
+}
+
+// Adaptive enables adaptive polling, operations are read at intervals depending on the volatility
+// of their values. It must be called before the device is queried.
+func (d *RS485) Adaptive(a Adaptive) error {
+	if err := a.Validate(); err != nil {
+		return err
+	}
+
+	d.scheduler.adapt(&a)
+
+	return nil
+}
+
+// Prioritize sets the number of query cycles between reads of low priority operations like
+// energy counters, 1 reads all operations every cycle. It must be called before the device is queried.
+func (d *RS485) Prioritize(interval int) error {
+	if interval < 1 {
+		return errors.New("low priority interval must be at least 1")
+	}
+
+	d.reschedule(d.scheduler.operations(), interval)
+
+	return nil
+}
+
+// reschedule replaces the scheduled operations keeping the adaptive polling configuration
+func (d *RS485) reschedule(ops []Operation, low int) {
+	s := newOpsScheduler(d.producer, ops, low)
+	s.adapt(d.scheduler.adaptive)
+	d.scheduler = s
+}
+
+// matchAny returns true if any pattern matches the measurement name
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if match, _ := path.Match(strings.ToLower(pattern), strings.ToLower(name)); match {
+			return true
+		}
+	}
+	return false
+}
+
 // Producer returns the underlying producer. The producer can be used to understand which operations the device supports.
 func (d *RS485) Producer() Producer {
 	return d.producer
 }
 
 // Descriptor returns the device descriptor. Since this method does not have bus access the descriptor should be
-// prepared during initialization.
+// prepared during initialization. Metadata like serial numbers is available after the device has responded.
 func (d *RS485) Descriptor() meters.DeviceDescriptor {
-	typ := d.producer.Type()
-	return meters.DeviceDescriptor{
-		Type:         typ,
-		Manufacturer: typ,
-		Model:        d.producer.Description(),
-	}
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	return d.descriptor
 }
 
 // Probe is called by the handler after preparing the bus by setting the device id
 func (d *RS485) Probe(client modbus.Client) (res meters.MeasurementResult, err error) {
 	op := d.producer.Probe()
+	if d.probe != nil {
+		op = *d.probe
+	}
 
 	// check for empty op in case Probe isn't supported
 	if op.FuncCode == 0 {
@@ -67,30 +222,78 @@ func (d *RS485) Probe(client modbus.Client) (res meters.MeasurementResult, err e
 		return res, err
 	}
 
+	if !d.identified {
+		d.identify(client)
+	}
+
 	return res, nil
 }
 
-// QueryOp executes a single query operation on the bus
-func (d *RS485) QueryOp(client modbus.Client, op Operation) (res meters.MeasurementResult, err error) {
-	var bytes []byte
+// Settings implements meters.Configurable
+func (d *RS485) Settings() []string {
+	res := make([]string, 0)
+	if c, ok := d.producer.(Configurator); ok {
+		for _, s := range c.Settings() {
+			res = append(res, s.Name)
+		}
+	}
+	return res
+}
 
-	if op.ReadLen == 0 {
-		return res, fmt.Errorf("invalid meter operation %v", op)
+// WriteSetting implements meters.Configurable. Single register settings are written
+// using function code 6, multiple register settings using function code 16.
+// Settings overlapping protected registers are refused with meters.ErrProtected.
+func (d *RS485) WriteSetting(client modbus.Client, name string, value float64) error {
+	s, err := SettingByName(d.producer, name)
+	if err != nil {
+		return err
 	}
 
-	if op.Transform == nil {
-		return res, fmt.Errorf("transformation not defined: %v", op)
+	if IsProtected(d.producer, s.OpCode, s.WriteLen) {
+		return fmt.Errorf("setting %s is %w", name, meters.ErrProtected)
 	}
 
-	switch op.FuncCode {
+	b, err := s.Encode(value)
+	if err != nil {
+		return fmt.Errorf("setting %s: %v", name, err)
+	}
+
+	if s.WriteLen == 1 {
+		_, err = client.WriteSingleRegister(s.OpCode, binary.BigEndian.Uint16(b))
+	} else {
+		_, err = client.WriteMultipleRegisters(s.OpCode, s.WriteLen, b)
+	}
+
+	if err != nil {
+		return fmt.Errorf("write failed: %v", err)
+	}
+
+	return nil
+}
+
+// readRegisters reads length registers starting at register using the function code
+func readRegisters(client modbus.Client, funcCode uint8, register, length uint16) ([]byte, error) {
+	switch funcCode {
 	case ReadHoldingReg:
-		bytes, err = client.ReadHoldingRegisters(op.OpCode, op.ReadLen)
+		return client.ReadHoldingRegisters(register, length)
 	case ReadInputReg:
-		bytes, err = client.ReadInputRegisters(op.OpCode, op.ReadLen)
+		return client.ReadInputRegisters(register, length)
 	default:
-		return res, fmt.Errorf("unknown function code %d", op.FuncCode)
+		return nil, fmt.Errorf("unknown function code %d", funcCode)
+	}
+}
+
+// QueryOp executes a single query operation on the bus
+func (d *RS485) QueryOp(client modbus.Client, op Operation) (res meters.MeasurementResult, err error) {
+	if op.ReadLen == 0 {
+		return res, fmt.Errorf("invalid meter operation %v", op)
+	}
+
+	if op.Transform == nil {
+		return res, fmt.Errorf("transformation not defined: %v", op)
 	}
 
+	bytes, err := readRegisters(client, op.FuncCode, op.OpCode, op.ReadLen)
 	if err != nil {
 		return res, fmt.Errorf("read failed: %v", err)
 	}
@@ -104,44 +307,88 @@ func (d *RS485) QueryOp(client modbus.Client, op Operation) (res meters.Measurem
 	return res, nil
 }
 
-// Query is called by the handler after preparing the bus by setting the device id and waiting for rate limit
-func (d *RS485) Query(client modbus.Client) (res []meters.MeasurementResult, err error) {
-	res = make([]meters.MeasurementResult, 0)
+// queryBlock reads the block's registers using a single request and slices the response
+// per operation. If the device rejects the request, e.g. since the block spans undefined
+// registers, the block's operations are read individually from now on.
+// The operations read are returned in the order of their results.
+func (d *RS485) queryBlock(client modbus.Client, b *block) (res []meters.MeasurementResult, ops []*scheduledOp, err error) {
+	if len(b.ops) == 1 {
+		op := b.ops[0]
+		m, err := d.QueryOp(client, op.Operation)
+		if err != nil {
+			return res, ops, err
+		}
+
+		return append(res, m), append(ops, op), nil
+	}
 
-	if d.ops == nil {
-		d.ops = make(chan Operation)
+	bytes, err := readRegisters(client, b.funcCode, b.start, b.length)
+	if err != nil {
+		if _, ok := err.(*modbus.Error); !ok {
+			return res, ops, fmt.Errorf("read failed: %v", err)
+		}
+
+		for _, op := range b.ops {
+			op.single = true
+		}
 
-		// ringbuffer of device operations
-		go func(d *RS485) {
-			for {
-				for _, op := range d.producer.Produce() {
-					d.ops <- op
-				}
+		for _, op := range b.ops {
+			m, read, err := d.queryBlock(client, newBlock(op))
+			res = append(res, m...)
+			ops = append(ops, read...)
+			if err != nil {
+				return res, ops, err
 			}
-		}(d)
-	}
-
-	// Query loop will try to read all operations in a single run. It will
-	// always start with the current inflight operation. If an error is encountered,
-	// the partial results are returned. The loop is terminated after as many
-	// operations have been executed as the producer provides in a single run.
-	// In case of a flakey connection this guarantees that all registers are
-	// read at an equal rate.
-	for range d.producer.Produce() {
-		// get next inflight
-		if d.inflight.FuncCode == 0 {
-			d.inflight = <-d.ops
 		}
 
-		m, err := d.QueryOp(client, d.inflight)
+		return res, ops, nil
+	}
+
+	if len(bytes) < 2*int(b.length) {
+		return res, ops, fmt.Errorf("read failed: short response of %d bytes", len(bytes))
+	}
+
+	ts := time.Now()
+	for _, op := range b.ops {
+		m := meters.MeasurementResult{
+			Measurement: op.IEC61850,
+			Value:       op.Transform(b.slice(bytes, op)),
+			Timestamp:   ts,
+		}
+		res = append(res, m)
+		ops = append(ops, op)
+	}
+
+	return res, ops, nil
+}
+
+// Query is called by the handler after preparing the bus by setting the device id and waiting for rate limit.
+// It reads the operations due in this cycle: high priority operations like power are read every cycle,
+// low priority operations like energy counters every LowPriorityInterval cycles unless changed using Prioritize.
+// Operations reading adjacent registers are coalesced into a single request.
+func (d *RS485) Query(client modbus.Client) (res []meters.MeasurementResult, err error) {
+	res = make([]meters.MeasurementResult, 0)
+
+	// If an error is encountered, the partial results are returned but the handler
+	// discards them. The operations are therefore only rescheduled once the entire
+	// query succeeded and remain due for the retry otherwise, such that in case of
+	// a flakey connection all registers are read and published at their rate.
+	var read []*scheduledOp
+	for _, b := range coalesce(d.scheduler.next()) {
+		m, ops, err := d.queryBlock(client, b)
+		res = append(res, m...)
+		read = append(read, ops...)
 		if err != nil {
 			return res, err
 		}
+	}
 
-		// mark inflight operation as completed
-		d.inflight.FuncCode = 0
+	for i, op := range read {
+		d.scheduler.done(op, res[i].Value)
+	}
 
-		res = append(res, m)
+	if !d.identified {
+		d.identify(client)
 	}
 
 	return res, nil
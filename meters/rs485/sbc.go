@@ -81,6 +81,18 @@ func (p *SBCProducer) snip16(iec Measurement, scaler ...float64) Operation {
 	return snip
 }
 
+// snip16i creates modbus operation for single signed register
+func (p *SBCProducer) snip16i(iec Measurement, scaler ...float64) Operation {
+	snip := p.snip16(iec, scaler...)
+
+	snip.Transform = RTUInt16ToFloat64 // export is negative
+	if len(scaler) > 0 {
+		snip.Transform = MakeScaledTransform(snip.Transform, scaler[0])
+	}
+
+	return snip
+}
+
 // snip32 creates modbus operation for double register
 func (p *SBCProducer) snip32(iec Measurement, scaler ...float64) Operation {
 	snip := p.snip(iec, 2)
@@ -115,7 +127,7 @@ func (p *SBCProducer) Produce() (res []Operation) {
 		PowerL1, PowerL2, PowerL3,
 		CosphiL1, CosphiL2, CosphiL3,
 	} {
-		res = append(res, p.snip16(op, 100))
+		res = append(res, p.snip16i(op, 100))
 	}
 
 	res = append(res, p.snip32(Import, 100))
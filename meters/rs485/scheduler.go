@@ -0,0 +1,196 @@
+package rs485
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// Priority is an operation's polling priority
+type Priority int
+
+const (
+	// PriorityHigh operations are queried every cycle, e.g. power, current and voltage
+	PriorityHigh Priority = iota
+	// PriorityLow operations are queried every LowPriorityInterval cycles, e.g. energy counters
+	PriorityLow
+)
+
+// LowPriorityInterval is the default number of query cycles between two reads of a low priority operation
+const LowPriorityInterval = 10
+
+// Prioritizer is implemented by producers that override the default operation priorities
+type Prioritizer interface {
+	// Priority returns the operation's polling priority
+	Priority(op Operation) Priority
+}
+
+// DefaultPriority returns low priority for energy and operating hours counters which change slowly and high priority otherwise
+func DefaultPriority(op Operation) Priority {
+	if op.IEC61850 == meters.OperatingHours {
+		return PriorityLow
+	}
+
+	_, unit := op.IEC61850.DescriptionAndUnit()
+	for _, suffix := range []string{"Wh", "varh", "VAh"} {
+		if strings.HasSuffix(unit, suffix) {
+			return PriorityLow
+		}
+	}
+	return PriorityHigh
+}
+
+// Adaptive configures adaptive polling. Operations whose value changes by more than the threshold
+// relative to the previous read are read every MinInterval cycles, otherwise their interval is
+// doubled up to MaxInterval cycles. Near-constant values like frequency or energy counters are
+// thereby read less often, leaving bus bandwidth for rapidly changing values like power.
+type Adaptive struct {
+	MinInterval int     // cycles between reads of changing values, at least 1
+	MaxInterval int     // cycles between reads of constant values
+	Threshold   float64 // relative change considered constant, e.g. 0.01 for 1%
+}
+
+// Validate checks the intervals and threshold
+func (a Adaptive) Validate() error {
+	if a.MinInterval < 1 || a.MaxInterval < a.MinInterval {
+		return errors.New("adaptive polling requires 1 <= min interval <= max interval")
+	}
+	if a.Threshold < 0 {
+		return errors.New("adaptive polling threshold must not be negative")
+	}
+	return nil
+}
+
+// clamp limits the interval to the configured range
+func (a *Adaptive) clamp(interval int) int {
+	if interval < a.MinInterval {
+		return a.MinInterval
+	}
+	if interval > a.MaxInterval {
+		return a.MaxInterval
+	}
+	return interval
+}
+
+// interval returns the next interval of an operation read at the current interval
+func (a *Adaptive) interval(current int, last, value float64) int {
+	if math.Abs(value-last) > a.Threshold*math.Abs(last) {
+		return a.MinInterval
+	}
+	return a.clamp(2 * current)
+}
+
+// scheduledOp is an operation and its scheduling state
+type scheduledOp struct {
+	Operation
+	interval int     // cycles between reads
+	offset   int     // delay of the second read, spreads low priority reads across cycles
+	due      int     // cycle the operation is due
+	read     bool    // operation has been read at least once
+	single   bool    // operation must not be coalesced with adjacent operations
+	last     float64 // value of the previous read
+}
+
+// scheduler is a priority-aware queue of device operations. Each cycle it selects
+// the due operations, oldest first. Operations that could not be read, e.g. since
+// a previous query was aborted by an error, remain due and are queried first in
+// the next cycle such that no operation is starved.
+type scheduler struct {
+	cycle    int
+	low      int // cycles between reads of low priority operations
+	ops      []*scheduledOp
+	adaptive *Adaptive // optional
+}
+
+// newScheduler creates a scheduler for the producer's operations
+func newScheduler(p Producer) *scheduler {
+	return newOpsScheduler(p, p.Produce(), LowPriorityInterval)
+}
+
+// newOpsScheduler creates a scheduler for the operations using the producer's priorities.
+// Low priority operations are read every low cycles.
+func newOpsScheduler(p Producer, ops []Operation, low int) *scheduler {
+	priority := DefaultPriority
+	if pr, ok := p.(Prioritizer); ok {
+		priority = pr.Priority
+	}
+
+	s := &scheduler{low: low}
+
+	var n int
+	for _, op := range ops {
+		sop := &scheduledOp{
+			Operation: op,
+			interval:  1,
+		}
+
+		if priority(op) == PriorityLow && low > 1 {
+			sop.interval = low
+			sop.offset = n % low
+			n++
+		}
+
+		s.ops = append(s.ops, sop)
+	}
+
+	return s
+}
+
+// adapt enables adaptive polling. Initial intervals are the priorities' intervals within the configured range.
+func (s *scheduler) adapt(a *Adaptive) {
+	s.adaptive = a
+	if a == nil {
+		return
+	}
+
+	for _, op := range s.ops {
+		op.interval = a.clamp(op.interval)
+	}
+}
+
+// operations returns the scheduled operations
+func (s *scheduler) operations() []Operation {
+	res := make([]Operation, 0, len(s.ops))
+	for _, op := range s.ops {
+		res = append(res, op.Operation)
+	}
+	return res
+}
+
+// next starts a new cycle and returns the due operations, oldest first
+func (s *scheduler) next() []*scheduledOp {
+	s.cycle++
+
+	res := make([]*scheduledOp, 0, len(s.ops))
+	for _, op := range s.ops {
+		if op.due <= s.cycle {
+			res = append(res, op)
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].due < res[j].due
+	})
+
+	return res
+}
+
+// done reschedules an operation after it has been read
+func (s *scheduler) done(op *scheduledOp, value float64) {
+	if s.adaptive != nil && op.read {
+		op.interval = s.adaptive.interval(op.interval, op.last, value)
+	}
+	op.last = value
+
+	if op.read {
+		op.due = s.cycle + op.interval
+		return
+	}
+
+	// all operations are read in the first cycle, afterwards low priority reads are spread
+	op.read = true
+	op.due = s.cycle + 1 + op.offset
+}
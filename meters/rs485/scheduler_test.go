@@ -0,0 +1,111 @@
+package rs485
+
+import (
+	"testing"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestDefaultPriority(t *testing.T) {
+	tc := []struct {
+		m meters.Measurement
+		p Priority
+	}{
+		{meters.Power, PriorityHigh},
+		{meters.VoltageL1, PriorityHigh},
+		{meters.Import, PriorityLow},
+		{meters.ReactiveSum, PriorityLow},
+	}
+
+	for _, c := range tc {
+		if p := DefaultPriority(Operation{IEC61850: c.m}); p != c.p {
+			t.Errorf("%s: expected priority %d, got %d", c.m, c.p, p)
+		}
+	}
+}
+
+func TestScheduler(t *testing.T) {
+	s := newScheduler(NewSDMProducer())
+
+	var high, low int
+	for _, op := range s.ops {
+		if op.interval == 1 {
+			high++
+		} else {
+			low++
+		}
+	}
+
+	if high == 0 || low == 0 {
+		t.Fatalf("expected high and low priority operations, got %d and %d", high, low)
+	}
+
+	reads := make(map[*scheduledOp]int)
+
+	// first cycle reads all operations
+	if due := s.next(); len(due) != len(s.ops) {
+		t.Fatalf("expected %d operations in first cycle, got %d", len(s.ops), len(due))
+	} else {
+		// simulate an error after the first operation
+		s.done(due[0], 0)
+		reads[due[0]]++
+	}
+
+	// operations not read are queried first
+	due := s.next()
+	if due[0].read {
+		t.Error("expected operation not read to be queried first")
+	}
+
+	cycles := 10 * LowPriorityInterval
+	for i := 0; i < cycles; i++ {
+		for _, op := range due {
+			s.done(op, 0)
+			reads[op]++
+		}
+		due = s.next()
+	}
+
+	for _, op := range s.ops {
+		expected := cycles / op.interval
+		if reads[op] < expected || reads[op] > expected+1 {
+			t.Errorf("%s: expected %d reads, got %d", op.IEC61850, expected, reads[op])
+		}
+	}
+}
+
+func TestAdaptiveScheduler(t *testing.T) {
+	s := newOpsScheduler(NewSDMProducer(), []Operation{
+		{IEC61850: meters.Power},
+		{IEC61850: meters.Frequency},
+	}, LowPriorityInterval)
+	s.adapt(&Adaptive{MinInterval: 1, MaxInterval: 8, Threshold: 0.01})
+
+	power, frequency := s.ops[0], s.ops[1]
+	values := map[*scheduledOp]float64{frequency: 50}
+
+	reads := make(map[*scheduledOp]int)
+	for i := 0; i < 64; i++ {
+		values[power] = float64(100 + 50*(i%2))
+		for _, op := range s.next() {
+			s.done(op, values[op])
+			reads[op]++
+		}
+	}
+
+	if reads[power] != 64 {
+		t.Errorf("expected changing value read every cycle, got %d reads", reads[power])
+	}
+	if frequency.interval != 8 {
+		t.Errorf("expected constant value backed off to max interval, got %d", frequency.interval)
+	}
+	if reads[frequency] > 16 {
+		t.Errorf("expected constant value read less often, got %d reads", reads[frequency])
+	}
+
+	// changes reset the interval
+	s.done(frequency, 49)
+	if frequency.interval != 1 {
+		t.Errorf("expected changed value reset to min interval, got %d", frequency.interval)
+	}
+}
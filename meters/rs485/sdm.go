@@ -10,6 +10,40 @@ const (
 	METERTYPE_SDM = "SDM"
 )
 
+// eastronProtected are the write-protected holding registers common to Eastron meters
+var eastronProtected = []RegisterRange{
+	{0x0018, 0x0019}, // password
+	{0xF010, 0xF010}, // reset historical data
+}
+
+// eastronSettings are the writable holding registers common to Eastron meters.
+// Changes take effect after the meter has been restarted.
+var eastronSettings = []Setting{
+	{
+		Name:        "parity",
+		Description: "0: 8N1, 1: 8E1, 2: 8O1, 3: 8N2",
+		OpCode:      0x0012,
+		WriteLen:    2,
+		Encode:      MakeRangeEncoder(Ieee754Encoder, 0, 3),
+	},
+	{
+		Name:        "address",
+		Description: "Modbus slave address 1..247",
+		OpCode:      0x0014,
+		WriteLen:    2,
+		Encode:      MakeRangeEncoder(Ieee754Encoder, 1, 247),
+	},
+	{
+		Name:        "baudrate",
+		Description: "2400, 4800, 9600, 19200 or 38400",
+		OpCode:      0x001C,
+		WriteLen:    2,
+		Encode: MakeEnumEncoder(Ieee754Encoder, map[float64]float64{
+			2400: 0, 4800: 1, 9600: 2, 19200: 3, 38400: 4,
+		}),
+	},
+}
+
 type SDMProducer struct {
 	Opcodes
 }
@@ -84,6 +118,23 @@ func (p *SDMProducer) snip(iec Measurement) Operation {
 	return operation
 }
 
+// Settings implements Configurator interface
+func (p *SDMProducer) Settings() []Setting {
+	return eastronSettings
+}
+
+// Protected implements Protector interface
+func (p *SDMProducer) Protected() []RegisterRange {
+	return eastronProtected
+}
+
+// Identify implements Identifier interface
+func (p *SDMProducer) Identify() []MetadataOperation {
+	return []MetadataOperation{
+		{FuncCode: ReadHoldingReg, OpCode: 0xFC00, ReadLen: 2, Field: MetadataSerial, Decode: RTUUint32ToString},
+	}
+}
+
 func (p *SDMProducer) Probe() Operation {
 	return p.snip(VoltageL1)
 }
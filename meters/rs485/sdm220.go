@@ -51,6 +51,16 @@ func (p *SDM220Producer) snip(iec Measurement) Operation {
 	return operation
 }
 
+// Settings implements Configurator interface
+func (p *SDM220Producer) Settings() []Setting {
+	return eastronSettings
+}
+
+// Protected implements Protector interface
+func (p *SDM220Producer) Protected() []RegisterRange {
+	return eastronProtected
+}
+
 func (p *SDM220Producer) Probe() Operation {
 	return p.snip(Voltage)
 }
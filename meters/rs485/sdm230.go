@@ -56,6 +56,16 @@ func (p *SDM230Producer) snip(iec Measurement) Operation {
 	return operation
 }
 
+// Settings implements Configurator interface
+func (p *SDM230Producer) Settings() []Setting {
+	return eastronSettings
+}
+
+// Protected implements Protector interface
+func (p *SDM230Producer) Protected() []RegisterRange {
+	return eastronProtected
+}
+
 func (p *SDM230Producer) Probe() Operation {
 	return p.snip(Voltage)
 }
@@ -47,6 +47,16 @@ func (p *SDM72Producer) snip(iec Measurement) Operation {
 	return operation
 }
 
+// Settings implements Configurator interface
+func (p *SDM72Producer) Settings() []Setting {
+	return eastronSettings
+}
+
+// Protected implements Protector interface
+func (p *SDM72Producer) Protected() []RegisterRange {
+	return eastronProtected
+}
+
 // This device does not provide voltage data
 // so it is not possible to automatically detect the device
 func (p *SDM72Producer) Probe() Operation {
@@ -0,0 +1,70 @@
+package rs485
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Setting describes a writable device configuration register
+type Setting struct {
+	Name        string
+	Description string
+	OpCode      uint16
+	WriteLen    uint16
+	Encode      func(float64) ([]byte, error)
+}
+
+// Configurator is implemented by producers that support writing device settings
+type Configurator interface {
+	// Settings returns the device's writable settings
+	Settings() []Setting
+}
+
+// SettingByName returns the producer's setting by case-insensitive name
+func SettingByName(p Producer, name string) (Setting, error) {
+	if c, ok := p.(Configurator); ok {
+		for _, s := range c.Settings() {
+			if strings.EqualFold(s.Name, name) {
+				return s, nil
+			}
+		}
+	}
+
+	return Setting{}, fmt.Errorf("setting %s not supported by meter type %s", name, p.Type())
+}
+
+// Ieee754Encoder encodes values as 32 bit IEEE 754 float
+func Ieee754Encoder(f float64) ([]byte, error) {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, math.Float32bits(float32(f)))
+	return b, nil
+}
+
+// MakeRangeEncoder creates an encoder validating that the value is an integer within [min, max]
+func MakeRangeEncoder(encoder func(float64) ([]byte, error), min, max float64) func(float64) ([]byte, error) {
+	return func(f float64) ([]byte, error) {
+		if f < min || f > max || f != math.Trunc(f) {
+			return nil, fmt.Errorf("invalid value %v, expected %v..%v", f, min, max)
+		}
+		return encoder(f)
+	}
+}
+
+// MakeEnumEncoder creates an encoder that maps values to register codes
+func MakeEnumEncoder(encoder func(float64) ([]byte, error), codes map[float64]float64) func(float64) ([]byte, error) {
+	return func(f float64) ([]byte, error) {
+		code, ok := codes[f]
+		if !ok {
+			valid := make([]float64, 0, len(codes))
+			for k := range codes {
+				valid = append(valid, k)
+			}
+			sort.Float64s(valid)
+			return nil, fmt.Errorf("invalid value %v, expected one of %v", f, valid)
+		}
+		return encoder(code)
+	}
+}
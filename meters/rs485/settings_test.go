@@ -0,0 +1,179 @@
+package rs485
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// settingsProducer has single and multiple register settings and a setting overlapping a protected register
+type settingsProducer struct {
+	blockProducer
+}
+
+func (p *settingsProducer) Settings() []Setting {
+	return []Setting{
+		{Name: "mode", OpCode: 0x0010, WriteLen: 1, Encode: MakeRangeEncoder(uint16Encoder, 0, 2)},
+		{Name: "address", OpCode: 0x0014, WriteLen: 2, Encode: MakeRangeEncoder(Ieee754Encoder, 1, 247)},
+		{Name: "reset", OpCode: 0x0018, WriteLen: 2, Encode: Ieee754Encoder},
+	}
+}
+
+func (p *settingsProducer) Protected() []RegisterRange {
+	return []RegisterRange{{Start: 0x0019, End: 0x0019}}
+}
+
+// uint16Encoder encodes values as single register
+func uint16Encoder(f float64) ([]byte, error) {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, uint16(f))
+	return b, nil
+}
+
+// writeClient records register writes
+type writeClient struct {
+	*meters.MockClient
+	single, multiple int
+	address          uint16
+	value            []byte
+}
+
+func (c *writeClient) WriteSingleRegister(address, value uint16) ([]byte, error) {
+	c.single++
+	c.address = address
+	c.value = []byte{byte(value >> 8), byte(value)}
+	return c.MockClient.WriteSingleRegister(address, value)
+}
+
+func (c *writeClient) WriteMultipleRegisters(address, quantity uint16, value []byte) ([]byte, error) {
+	c.multiple++
+	c.address = address
+	c.value = value
+	return c.MockClient.WriteMultipleRegisters(address, quantity, value)
+}
+
+func TestEncoders(t *testing.T) {
+	float := func(f float32) []byte {
+		b := make([]byte, 4)
+		binary.BigEndian.PutUint32(b, math.Float32bits(f))
+		return b
+	}
+
+	rangeEncoder := MakeRangeEncoder(Ieee754Encoder, 1, 247)
+	enumEncoder := MakeEnumEncoder(Ieee754Encoder, map[float64]float64{2400: 0, 9600: 2})
+
+	tc := []struct {
+		name    string
+		encoder func(float64) ([]byte, error)
+		value   float64
+		res     []byte
+	}{
+		{"ieee754", Ieee754Encoder, 19200, []byte{0x46, 0x96, 0x00, 0x00}},
+		{"ieee754 fraction", Ieee754Encoder, 0.5, float(0.5)},
+		{"range min", rangeEncoder, 1, float(1)},
+		{"range max", rangeEncoder, 247, float(247)},
+		{"range below", rangeEncoder, 0, nil},
+		{"range above", rangeEncoder, 248, nil},
+		{"range fraction", rangeEncoder, 1.5, nil},
+		{"enum", enumEncoder, 9600, float(2)},
+		{"enum first code", enumEncoder, 2400, float(0)},
+		{"enum invalid", enumEncoder, 4800, nil},
+	}
+
+	for _, tc := range tc {
+		b, err := tc.encoder(tc.value)
+		if tc.res == nil {
+			if err == nil {
+				t.Errorf("%s: expected error, got % x", tc.name, b)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+		} else if !bytes.Equal(b, tc.res) {
+			t.Errorf("%s: expected % x, got % x", tc.name, tc.res, b)
+		}
+	}
+}
+
+func TestWriteSetting(t *testing.T) {
+	p := &settingsProducer{}
+	d := &RS485{producer: p, scheduler: newScheduler(p)}
+
+	if s := d.Settings(); len(s) != 3 || s[0] != "mode" {
+		t.Errorf("unexpected settings %v", s)
+	}
+
+	// setting names are case-insensitive
+	client := &writeClient{MockClient: meters.NewMockClient(0)}
+	if err := d.WriteSetting(client, "Mode", 2); err != nil {
+		t.Fatal(err)
+	}
+	if client.single != 1 || client.multiple != 0 || client.address != 0x0010 || !bytes.Equal(client.value, []byte{0, 2}) {
+		t.Errorf("expected single register write, got %+v", client)
+	}
+
+	client = &writeClient{MockClient: meters.NewMockClient(0)}
+	if err := d.WriteSetting(client, "address", 2); err != nil {
+		t.Fatal(err)
+	}
+	if client.single != 0 || client.multiple != 1 || client.address != 0x0014 || !bytes.Equal(client.value, []byte{0x40, 0, 0, 0}) {
+		t.Errorf("expected multiple register write, got %+v", client)
+	}
+
+	tc := []struct {
+		name      string
+		value     float64
+		protected bool
+	}{
+		{"reset", 0, true},
+		{"address", 248, false},
+		{"unknown", 0, false},
+	}
+
+	for _, tc := range tc {
+		client := &writeClient{MockClient: meters.NewMockClient(0)}
+
+		err := d.WriteSetting(client, tc.name, tc.value)
+		if err == nil {
+			t.Errorf("%s: expected error", tc.name)
+		} else if errors.Is(err, meters.ErrProtected) != tc.protected {
+			t.Errorf("%s: unexpected error %v", tc.name, err)
+		}
+
+		if client.single+client.multiple > 0 {
+			t.Errorf("%s: expected no write", tc.name)
+		}
+	}
+
+	// producers without settings
+	d = &RS485{producer: &blockProducer{}}
+	if s := d.Settings(); len(s) != 0 {
+		t.Errorf("expected no settings, got %v", s)
+	}
+	if err := d.WriteSetting(meters.NewMockClient(0), "address", 2); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestEastronSettingsProtection(t *testing.T) {
+	// Eastron settings must not overlap the protected registers
+	for _, typ := range []string{"SDM", "SDM220", "SDM230", "SDM72"} {
+		d, err := NewDevice(typ)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		p := d.Producer()
+		for _, s := range p.(Configurator).Settings() {
+			if IsProtected(p, s.OpCode, s.WriteLen) {
+				t.Errorf("%s: setting %s is protected", typ, s.Name)
+			}
+		}
+	}
+}
@@ -3,6 +3,8 @@ package rs485
 import (
 	"encoding/binary"
 	"math"
+	"strconv"
+	"strings"
 )
 
 // BigEndianUint32Swapped converts bytes to uint32 wrapped as uint64 with swapped word order.
@@ -29,6 +31,12 @@ func RTUIeee754ToFloat64Swapped(b []byte) float64 {
 	return float64(f)
 }
 
+// RTUIeee754DoubleToFloat64 converts 64 bit IEEE 754 float readings spanning four registers
+func RTUIeee754DoubleToFloat64(b []byte) float64 {
+	bits := binary.BigEndian.Uint64(b)
+	return math.Float64frombits(bits)
+}
+
 // RTUUint16ToFloat64 converts 16 bit unsigned integer readings
 func RTUUint16ToFloat64(b []byte) float64 {
 	u := binary.BigEndian.Uint16(b)
@@ -65,7 +73,7 @@ func RTUInt32ToFloat64(b []byte) float64 {
 	return float64(u)
 }
 
-// RTUInt32ToFloat64Swapped converts 32 bit unsigned integer readings with swapped word order
+// RTUInt32ToFloat64Swapped converts 32 bit signed integer readings with swapped word order
 func RTUInt32ToFloat64Swapped(b []byte) float64 {
 	u := int32(BigEndianUint32Swapped(b))
 	return float64(u)
@@ -77,6 +85,69 @@ func RTUInt64ToFloat64(b []byte) float64 {
 	return float64(u)
 }
 
+// swapWords reverses the word order of multi-register readings
+func swapWords(b []byte) []byte {
+	res := make([]byte, len(b)&^1)
+	for i := 0; i+1 < len(b); i += 2 {
+		j := len(res) - i - 2
+		res[j], res[j+1] = b[i], b[i+1]
+	}
+	return res
+}
+
+// RTUUint64ToFloat64Swapped converts 64 bit unsigned integer readings with swapped word order
+func RTUUint64ToFloat64Swapped(b []byte) float64 {
+	return RTUUint64ToFloat64(swapWords(b[:8]))
+}
+
+// RTUInt64ToFloat64Swapped converts 64 bit signed integer readings with swapped word order
+func RTUInt64ToFloat64Swapped(b []byte) float64 {
+	return RTUInt64ToFloat64(swapWords(b[:8]))
+}
+
+// RTUIeee754DoubleToFloat64Swapped converts 64 bit IEEE 754 float readings with swapped word order
+func RTUIeee754DoubleToFloat64Swapped(b []byte) float64 {
+	return RTUIeee754DoubleToFloat64(swapWords(b[:8]))
+}
+
+// MakeWordSwappedTransform creates an RTUTransform for multi-register readings sent with the low word first
+func MakeWordSwappedTransform(transform RTUTransform) RTUTransform {
+	return RTUTransform(func(b []byte) float64 {
+		return transform(swapWords(b))
+	})
+}
+
+// MakeBitTransform creates an RTUTransform returning 1 if the bit of a status register reading is set
+// and 0 otherwise. Bits are counted from the least significant bit of up to four registers.
+func MakeBitTransform(bit uint) RTUTransform {
+	return RTUTransform(func(b []byte) float64 {
+		var u uint64
+		for _, v := range b {
+			u = u<<8 | uint64(v)
+		}
+		if u&(1<<bit) != 0 {
+			return 1
+		}
+		return 0
+	})
+}
+
+// RTUStringTransform functions convert RTU bytes to strings, e.g. for device metadata
+type RTUStringTransform func([]byte) string
+
+// RTUASCIIToString converts ASCII string readings. Trailing NUL characters and surrounding spaces are removed.
+func RTUASCIIToString(b []byte) string {
+	if i := strings.IndexByte(string(b), 0); i >= 0 {
+		b = b[:i]
+	}
+	return strings.TrimSpace(string(b))
+}
+
+// RTUUint32ToString converts 32 bit unsigned integer readings like serial numbers to strings
+func RTUUint32ToString(b []byte) string {
+	return strconv.FormatUint(uint64(binary.BigEndian.Uint32(b)), 10)
+}
+
 // MakeScaledTransform creates an RTUTransform with applied scaler
 func MakeScaledTransform(transform RTUTransform, scaler float64) RTUTransform {
 	return RTUTransform(func(b []byte) float64 {
@@ -9,3 +9,86 @@ func TestBigEndianUint32Swapped(t *testing.T) {
 		t.Errorf("wanted: %08x, got %08x", expect, out)
 	}
 }
+
+func TestSignedTransforms(t *testing.T) {
+	tc := []struct {
+		name      string
+		transform RTUTransform
+		b         []byte
+		expect    float64
+	}{
+		{"int16", RTUInt16ToFloat64, []byte{0xff, 0x38}, -200},
+		{"int16 scaled", MakeScaledTransform(RTUInt16ToFloat64, 100), []byte{0xff, 0x38}, -2},
+		{"uint16", RTUUint16ToFloat64, []byte{0xff, 0x38}, 65336},
+		{"int32", RTUInt32ToFloat64, []byte{0xff, 0xff, 0xfc, 0x18}, -1000},
+		{"int32 scaled", MakeScaledTransform(RTUInt32ToFloat64, 0.001), []byte{0xff, 0xff, 0xff, 0xfe}, -2000},
+		{"int32 swapped", RTUInt32ToFloat64Swapped, []byte{0xfc, 0x18, 0xff, 0xff}, -1000},
+	}
+
+	for _, c := range tc {
+		if out := c.transform(c.b); out != c.expect {
+			t.Errorf("%s: wanted %v, got %v", c.name, c.expect, out)
+		}
+	}
+}
+
+func TestRTU64BitTransforms(t *testing.T) {
+	tc := []struct {
+		name      string
+		transform RTUTransform
+		b         []byte
+		expect    float64
+	}{
+		{"uint64", RTUUint64ToFloat64, []byte{0, 0, 0, 1, 0, 0, 0, 0}, 1 << 32},
+		{"int64", RTUInt64ToFloat64, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe}, -2},
+		{"float64", RTUIeee754DoubleToFloat64, []byte{0x41, 0xe0, 0, 0, 0, 0x20, 0, 0}, 2147483649},
+	}
+
+	for _, c := range tc {
+		if out := c.transform(c.b); out != c.expect {
+			t.Errorf("%s: wanted %v, got %v", c.name, c.expect, out)
+		}
+	}
+}
+
+func TestWordSwappedTransforms(t *testing.T) {
+	tc := []struct {
+		name      string
+		transform RTUTransform
+		b         []byte
+		expect    float64
+	}{
+		{"float32", MakeWordSwappedTransform(RTUIeee754ToFloat64), []byte{0x80, 0, 0x43, 0x66}, 230.5},
+		{"float32 fixed", RTUIeee754ToFloat64Swapped, []byte{0x80, 0, 0x43, 0x66}, 230.5},
+		{"int32", MakeWordSwappedTransform(RTUInt32ToFloat64), []byte{0xfc, 0x18, 0xff, 0xff}, -1000},
+		{"uint64", RTUUint64ToFloat64Swapped, []byte{0, 0, 0, 1, 0, 0, 0, 0}, 1 << 16},
+		{"int64", RTUInt64ToFloat64Swapped, []byte{0xff, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, -2},
+		{"float64", RTUIeee754DoubleToFloat64Swapped, []byte{0, 0, 0, 0x20, 0, 0, 0x41, 0xe0}, 2147483649},
+	}
+
+	for _, c := range tc {
+		if out := c.transform(c.b); out != c.expect {
+			t.Errorf("%s: wanted %v, got %v", c.name, c.expect, out)
+		}
+	}
+}
+
+func TestStringTransforms(t *testing.T) {
+	tc := []struct {
+		name      string
+		transform RTUStringTransform
+		b         []byte
+		expect    string
+	}{
+		{"ascii", RTUASCIIToString, []byte("B23 312-100 "), "B23 312-100"},
+		{"ascii nul", RTUASCIIToString, []byte{'1', '.', '0', 0, 0, 0}, "1.0"},
+		{"ascii empty", RTUASCIIToString, []byte{0, 0}, ""},
+		{"uint32", RTUUint32ToString, []byte{0, 0x12, 0xd6, 0x87}, "1234567"},
+	}
+
+	for _, c := range tc {
+		if out := c.transform(c.b); out != c.expect {
+			t.Errorf("%s: wanted %q, got %q", c.name, c.expect, out)
+		}
+	}
+}
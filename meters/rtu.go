@@ -1,8 +1,7 @@
 package meters
 
 import (
-	"log"
-	"strings"
+	"fmt"
 	"time"
 
 	"github.com/grid-x/modbus"
@@ -13,43 +12,49 @@ type RTU struct {
 	device  string
 	Client  modbus.Client
 	Handler *modbus.RTUClientHandler
+	handler *reconnectHandler
 	prevID  uint8
+	pause   time.Duration
 }
 
-// NewClientHandler creates a serial line RTU modbus handler
-func NewClientHandler(device string, baudrate int, comset string) *modbus.RTUClientHandler {
-	handler := modbus.NewRTUClientHandler(device)
+// NewClientHandler creates a serial line RTU modbus handler. The comset may override the baudrate, see ParseComset.
+func NewClientHandler(device string, baudrate int, comset string) (*modbus.RTUClientHandler, error) {
+	cs, err := ParseComset(comset, baudrate)
+	if err != nil {
+		return nil, err
+	}
 
-	handler.BaudRate = baudrate
-	handler.DataBits = 8
-	handler.StopBits = 1
+	handler := modbus.NewRTUClientHandler(device)
 
-	switch strings.ToUpper(comset) {
-	case "8N1":
-		handler.Parity = "N"
-	case "8E1":
-		handler.Parity = "E"
-	default:
-		log.Fatalf("Invalid communication set specified: %s. See -h for help.", comset)
-	}
+	handler.BaudRate = cs.Baudrate
+	handler.DataBits = cs.DataBits
+	handler.Parity = cs.Parity
+	handler.StopBits = cs.StopBits
 
 	handler.Timeout = 300 * time.Millisecond
 
-	return handler
+	return handler, nil
 }
 
-// NewRTU creates a RTU modbus client
-func NewRTU(device string, baudrate int, comset string) Connection {
-	handler := NewClientHandler(device, baudrate, comset)
-	client := modbus.NewClient(handler)
+// NewRTU creates a RTU modbus client. The serial device is reopened automatically after disconnects.
+func NewRTU(device string, baudrate int, comset string) (Connection, error) {
+	handler, err := NewClientHandler(device, baudrate, comset)
+	if err != nil {
+		return nil, err
+	}
+
+	reconnect := newReconnectHandler(handler, device, handler.Close)
+	client := modbus.NewClient(reconnect)
 
 	b := &RTU{
 		device:  device,
 		Client:  client,
 		Handler: handler,
+		handler: reconnect,
+		pause:   DefaultPause,
 	}
 
-	return b
+	return b, nil
 }
 
 // String returns the bus device
@@ -62,6 +67,11 @@ func (b *RTU) ModbusClient() modbus.Client {
 	return b.Client
 }
 
+// Trace implements Traceable
+func (b *RTU) Trace(w *TraceWriter) {
+	b.Client = modbus.NewClient(newTraceHandler(b.handler, b.String(), 0, w))
+}
+
 // Logger sets a logging instance for physical bus operations
 func (b *RTU) Logger(l Logger) {
 	b.Handler.Logger = l
@@ -70,14 +80,19 @@ func (b *RTU) Logger(l Logger) {
 // Slave sets the modbus device id for the following operations
 func (b *RTU) Slave(deviceID uint8) {
 	// Some devices like SDM need to have a little pause between querying different device ids
-	if b.prevID != 0 && deviceID != b.prevID {
-		time.Sleep(time.Duration(100) * time.Millisecond)
-		b.prevID = deviceID
+	if b.pause > 0 && b.prevID != 0 && deviceID != b.prevID {
+		time.Sleep(b.pause)
 	}
+	b.prevID = deviceID
 
 	b.Handler.SetSlave(deviceID)
 }
 
+// Pause implements Pausable
+func (b *RTU) Pause(pause time.Duration) {
+	b.pause = pause
+}
+
 // Timeout sets the modbus timeout
 func (b *RTU) Timeout(timeout time.Duration) time.Duration {
 	t := b.Handler.Timeout
@@ -85,8 +100,30 @@ func (b *RTU) Timeout(timeout time.Duration) time.Duration {
 	return t
 }
 
+// Direction implements DirectionControllable
+func (b *RTU) Direction(d Direction) error {
+	switch d.Mode {
+	case DirectionRTS:
+		b.Handler.RS485.Enabled = true
+		b.Handler.RS485.RtsHighDuringSend = true
+		b.Handler.RS485.DelayRtsBeforeSend = d.DelayBefore
+		b.Handler.RS485.DelayRtsAfterSend = d.DelayAfter
+	case DirectionGPIO:
+		handler, err := newGPIOHandler(b.Handler, &b.Handler.Config, func() Logger { return b.Handler.Logger }, d)
+		if err != nil {
+			return err
+		}
+		b.handler = newReconnectHandler(handler, b.device, handler.Close)
+		b.Client = modbus.NewClient(b.handler)
+	default:
+		return fmt.Errorf("invalid direction control %s", d.Mode)
+	}
+
+	return nil
+}
+
 // Close closes the modbus connection.
 // This forces the modbus client to reopen the connection before the next bus operations.
 func (b *RTU) Close() {
-	b.Handler.Close()
+	_ = b.handler.close()
 }
@@ -0,0 +1,61 @@
+package meters
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSlavePause(t *testing.T) {
+	rtu, err := NewRTU("/dev/null", 9600, "8N1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, conn := range []Connection{rtu, NewRTUOverTCP("localhost:502")} {
+		p, ok := conn.(Pausable)
+		if !ok {
+			t.Fatalf("%s: expected pausable connection", conn)
+		}
+
+		// the default pause applies until configured otherwise
+		const pause = 50 * time.Millisecond
+
+		tc := []struct {
+			pause  time.Duration // pause configured before selecting the slave, unchanged if zero
+			slave  uint8
+			paused bool
+		}{
+			{0, 1, false}, // first slave
+			{0, 1, false},
+			{0, 2, true},
+			{pause, 2, false},
+			{pause, 1, true},
+			{-1, 3, false}, // disabled
+		}
+
+		for i, tc := range tc {
+			switch {
+			case tc.pause > 0:
+				p.Pause(tc.pause)
+			case tc.pause < 0:
+				p.Pause(0)
+			}
+
+			start := time.Now()
+			conn.Slave(tc.slave)
+			elapsed := time.Since(start)
+
+			due := DefaultPause
+			if tc.pause > 0 {
+				due = tc.pause
+			}
+
+			if tc.paused && elapsed < due {
+				t.Errorf("%s: %d: expected pause of %v, got %v", conn, i, due, elapsed)
+			}
+			if !tc.paused && elapsed >= pause {
+				t.Errorf("%s: %d: expected no pause, got %v", conn, i, elapsed)
+			}
+		}
+	}
+}
@@ -12,6 +12,7 @@ type RTUOverTCP struct {
 	Client  modbus.Client
 	Handler *modbus.RTUOverTCPClientHandler
 	prevID  uint8
+	pause   time.Duration
 }
 
 // NewRTUOverTCPClientHandler creates a TCP modbus handler
@@ -35,6 +36,7 @@ func NewRTUOverTCP(address string) Connection {
 		address: address,
 		Client:  client,
 		Handler: handler,
+		pause:   DefaultPause,
 	}
 
 	return b
@@ -50,6 +52,16 @@ func (b *RTUOverTCP) ModbusClient() modbus.Client {
 	return b.Client
 }
 
+// Trace implements Traceable
+func (b *RTUOverTCP) Trace(w *TraceWriter) {
+	b.Client = modbus.NewClient(newTraceHandler(b.Handler, b.String(), 0, w))
+}
+
+// ReadDeviceIdentification implements DeviceIdentifier
+func (b *RTUOverTCP) ReadDeviceIdentification() (DeviceIdentification, error) {
+	return readDeviceIdentification(b.Handler)
+}
+
 // Logger sets a logging instance for physical bus operations
 func (b *RTUOverTCP) Logger(l Logger) {
 	b.Handler.Logger = l
@@ -58,14 +70,19 @@ func (b *RTUOverTCP) Logger(l Logger) {
 // Slave sets the modbus device id for the following operations
 func (b *RTUOverTCP) Slave(deviceID uint8) {
 	// Some devices like SDM need to have a little pause between querying different device ids
-	if b.prevID != 0 && deviceID != b.prevID {
-		time.Sleep(time.Duration(100) * time.Millisecond)
-		b.prevID = deviceID
+	if b.pause > 0 && b.prevID != 0 && deviceID != b.prevID {
+		time.Sleep(b.pause)
 	}
+	b.prevID = deviceID
 
 	b.Handler.SetSlave(deviceID)
 }
 
+// Pause implements Pausable
+func (b *RTUOverTCP) Pause(pause time.Duration) {
+	b.pause = pause
+}
+
 // Timeout sets the modbus timeout
 func (b *RTUOverTCP) Timeout(timeout time.Duration) time.Duration {
 	t := b.Handler.Timeout
@@ -0,0 +1,157 @@
+package sim
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+const (
+	// METERTYPE_SIM is the device type of simulated meters
+	METERTYPE_SIM = "SIM"
+
+	nominalVoltage   = 230.0
+	nominalFrequency = 50.0
+)
+
+// Simulator is a simulated three-phase meter. It fabricates plausible readings
+// following a daily load profile and does not access the modbus client.
+type Simulator struct {
+	mux      sync.Mutex
+	rand     *rand.Rand
+	baseLoad [3]float64 // W per phase
+	peakLoad [3]float64 // W per phase
+	offset   float64    // profile phase shift in hours
+	imports  [3]float64 // kWh per phase
+	updated  time.Time
+}
+
+// NewConnection creates a simulator pseudo-connection
+func NewConnection() meters.Connection {
+	return meters.NewPseudo("sim")
+}
+
+// NewDevice creates a simulated meter. Each simulated meter uses its own load profile.
+func NewDevice() *Simulator {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	d := &Simulator{
+		rand:   r,
+		offset: 4 * r.Float64(),
+	}
+
+	for i := range d.baseLoad {
+		d.baseLoad[i] = 50 + 200*r.Float64()
+		d.peakLoad[i] = 500 + 2500*r.Float64()
+		d.imports[i] = 1000 * r.Float64()
+	}
+
+	return d
+}
+
+// Initialize implements the Device interface
+func (d *Simulator) Initialize(client modbus.Client) error {
+	return nil
+}
+
+// Descriptor implements the Device interface
+func (d *Simulator) Descriptor() meters.DeviceDescriptor {
+	return meters.DeviceDescriptor{
+		Type:         METERTYPE_SIM,
+		Manufacturer: METERTYPE_SIM,
+		Model:        "Simulated three-phase meter",
+	}
+}
+
+// Probe implements the Device interface
+func (d *Simulator) Probe(client modbus.Client) (meters.MeasurementResult, error) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	return meters.MeasurementResult{
+		Measurement: meters.VoltageL1,
+		Value:       d.voltage(),
+		Timestamp:   time.Now(),
+	}, nil
+}
+
+// noise returns a random value in [-amplitude, amplitude]. Caller must hold the lock.
+func (d *Simulator) noise(amplitude float64) float64 {
+	return amplitude * (2*d.rand.Float64() - 1)
+}
+
+func (d *Simulator) voltage() float64 {
+	return nominalVoltage + d.noise(3)
+}
+
+// load returns the phase's power following a daily profile with morning and evening peaks
+func (d *Simulator) load(phase int, ts time.Time) float64 {
+	hour := math.Mod(float64(ts.Hour())+float64(ts.Minute())/60+d.offset, 24)
+	morning := math.Exp(-math.Pow(hour-7, 2) / 2)
+	evening := math.Exp(-math.Pow(hour-19, 2) / 4)
+
+	profile := math.Max(morning, evening)
+	return d.baseLoad[phase] + profile*d.peakLoad[phase] + math.Abs(d.noise(0.1*d.baseLoad[phase]))
+}
+
+// Query implements the Device interface
+func (d *Simulator) Query(client modbus.Client) ([]meters.MeasurementResult, error) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	now := time.Now()
+	elapsed := 0.0
+	if !d.updated.IsZero() {
+		elapsed = now.Sub(d.updated).Hours()
+	}
+	d.updated = now
+
+	res := make([]meters.MeasurementResult, 0)
+	add := func(m meters.Measurement, v float64) {
+		res = append(res, meters.MeasurementResult{
+			Measurement: m,
+			Value:       v,
+			Timestamp:   now,
+		})
+	}
+
+	var power, current, imports float64
+	phases := [3]struct {
+		voltage, current, power, cosphi, imports meters.Measurement
+	}{
+		{meters.VoltageL1, meters.CurrentL1, meters.PowerL1, meters.CosphiL1, meters.ImportL1},
+		{meters.VoltageL2, meters.CurrentL2, meters.PowerL2, meters.CosphiL2, meters.ImportL2},
+		{meters.VoltageL3, meters.CurrentL3, meters.PowerL3, meters.CosphiL3, meters.ImportL3},
+	}
+
+	for i, m := range phases {
+		u := d.voltage()
+		p := d.load(i, now)
+		cosphi := 0.95 + d.noise(0.03)
+		c := p / (u * cosphi)
+
+		d.imports[i] += p * elapsed / 1e3
+
+		add(m.voltage, u)
+		add(m.current, c)
+		add(m.power, p)
+		add(m.cosphi, cosphi)
+		add(m.imports, d.imports[i])
+
+		power += p
+		current += c
+		imports += d.imports[i]
+	}
+
+	add(meters.Frequency, nominalFrequency+d.noise(0.05))
+	add(meters.Power, power)
+	add(meters.Current, current)
+	add(meters.Import, imports)
+	add(meters.Sum, imports)
+
+	return res, nil
+}
@@ -0,0 +1,89 @@
+package sim
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestLoadProfile(t *testing.T) {
+	// without base load the profile is noise-free
+	d := &Simulator{
+		rand:     rand.New(rand.NewSource(1)),
+		peakLoad: [3]float64{1000, 1000, 1000},
+	}
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2020, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	tc := []struct {
+		offset float64
+		ts     time.Time
+		load   float64
+	}{
+		{0, at(7, 0), 1000},
+		{0, at(19, 0), 1000},
+		{2, at(17, 0), 1000},
+		{2.5, at(4, 30), 1000},
+		// profile wraps past midnight
+		{8, at(23, 0), 1000},
+		{4, at(20, 0), d.load(0, at(0, 0))},
+	}
+
+	for _, tc := range tc {
+		d.offset = tc.offset
+		if l := d.load(0, tc.ts); l != tc.load {
+			t.Errorf("offset %v at %s: expected %v, got %v", tc.offset, tc.ts.Format("15:04"), tc.load, l)
+		}
+	}
+}
+
+func TestQuery(t *testing.T) {
+	d := NewDevice()
+
+	res, err := d.Query(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := make(map[meters.Measurement]float64, len(res))
+	for _, r := range res {
+		values[r.Measurement] = r.Value
+	}
+
+	for _, m := range []meters.Measurement{
+		meters.VoltageL1, meters.VoltageL2, meters.VoltageL3,
+		meters.CurrentL1, meters.CurrentL2, meters.CurrentL3,
+		meters.PowerL1, meters.PowerL2, meters.PowerL3,
+		meters.CosphiL1, meters.CosphiL2, meters.CosphiL3,
+		meters.ImportL1, meters.ImportL2, meters.ImportL3,
+		meters.Frequency, meters.Power, meters.Current, meters.Import, meters.Sum,
+	} {
+		if _, ok := values[m]; !ok {
+			t.Errorf("missing %s", m)
+		}
+	}
+
+	if p := values[meters.PowerL1] + values[meters.PowerL2] + values[meters.PowerL3]; p != values[meters.Power] {
+		t.Errorf("expected total power %v, got %v", p, values[meters.Power])
+	}
+	if u := values[meters.VoltageL1]; u < nominalVoltage-3 || u > nominalVoltage+3 {
+		t.Errorf("unexpected voltage %v", u)
+	}
+
+	// imports grow with the elapsed time
+	d.updated = d.updated.Add(-time.Hour)
+	res, err = d.Query(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range res {
+		if r.Measurement == meters.Import && r.Value <= values[meters.Import] {
+			t.Errorf("expected import to increase from %v, got %v", values[meters.Import], r.Value)
+		}
+	}
+}
@@ -50,6 +50,16 @@ func (b *TCP) ModbusClient() modbus.Client {
 	return b.Client
 }
 
+// Trace implements Traceable
+func (b *TCP) Trace(w *TraceWriter) {
+	b.Client = modbus.NewClient(newTraceHandler(b.Handler, b.String(), 6, w))
+}
+
+// ReadDeviceIdentification implements DeviceIdentifier
+func (b *TCP) ReadDeviceIdentification() (DeviceIdentification, error) {
+	return readDeviceIdentification(b.Handler)
+}
+
 // Logger sets a logging instance for physical bus operations
 func (b *TCP) Logger(l Logger) {
 	b.Handler.Logger = l
@@ -0,0 +1,126 @@
+package meters
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+// Frame is a traced modbus request/response frame exchange
+type Frame struct {
+	Time     time.Time `json:"time"`
+	Bus      string    `json:"bus"`
+	Slave    uint8     `json:"slave"`
+	FuncCode uint8     `json:"fc"`
+	Duration float64   `json:"duration"` // ms
+	Request  string    `json:"request"`            // hex encoded ADU, ASCII frames in binary representation
+	Response string    `json:"response,omitempty"` // hex encoded ADU, ASCII frames in binary representation
+	Error    string    `json:"error,omitempty"`
+	header   int       // offset of slave id in ADU or asciiHeader
+}
+
+// asciiHeader marks traced ASCII frames, their slave id is the first byte of the binary representation
+const asciiHeader = -1
+
+// asciiBinary returns the binary representation of an ASCII frame, i.e. slave id, PDU and LRC
+func asciiBinary(adu []byte) []byte {
+	s := strings.TrimSuffix(strings.TrimPrefix(string(adu), ":"), "\r\n")
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return adu
+	}
+	return b
+}
+
+// TraceWriter writes frames as json lines or annotated hex dumps. It can be shared by multiple connections.
+type TraceWriter struct {
+	mux sync.Mutex
+	enc *json.Encoder
+	hex io.Writer
+}
+
+// NewTraceWriter creates a trace writer
+func NewTraceWriter(w io.Writer) *TraceWriter {
+	return &TraceWriter{enc: json.NewEncoder(w)}
+}
+
+// NewHexTraceWriter creates a trace writer dumping frames as annotated hex
+func NewHexTraceWriter(w io.Writer) *TraceWriter {
+	return &TraceWriter{hex: w}
+}
+
+// Write writes a single frame
+func (w *TraceWriter) Write(f Frame) error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	if w.hex != nil {
+		_, err := io.WriteString(w.hex, annotateFrame(f))
+		return err
+	}
+
+	return w.enc.Encode(f)
+}
+
+// Traceable is implemented by connections that support tracing modbus frames
+type Traceable interface {
+	// Trace enables tracing all frames to the trace writer
+	Trace(w *TraceWriter)
+}
+
+// traceHandler wraps a client handler and traces all frames sent and received
+type traceHandler struct {
+	modbus.ClientHandler
+	bus    string
+	header int // offset of slave id in ADU
+	writer *TraceWriter
+}
+
+// newTraceHandler creates a tracing client handler. The header is the
+// number of bytes preceding the slave id in the ADU (6 for TCP, 0 for RTU).
+func newTraceHandler(handler modbus.ClientHandler, bus string, header int, w *TraceWriter) modbus.ClientHandler {
+	return &traceHandler{
+		ClientHandler: handler,
+		bus:           bus,
+		header:        header,
+		writer:        w,
+	}
+}
+
+// Send implements modbus.Transporter
+func (h *traceHandler) Send(aduRequest []byte) ([]byte, error) {
+	start := time.Now()
+	aduResponse, err := h.ClientHandler.Send(aduRequest)
+
+	request, response, offset := aduRequest, aduResponse, h.header
+	if h.header == asciiHeader {
+		request, response, offset = asciiBinary(aduRequest), asciiBinary(aduResponse), 0
+	}
+
+	f := Frame{
+		Time:     start,
+		Bus:      h.bus,
+		Duration: float64(time.Since(start)) / float64(time.Millisecond),
+		Request:  hex.EncodeToString(request),
+		Response: hex.EncodeToString(response),
+		header:   h.header,
+	}
+
+	if len(request) > offset+1 {
+		f.Slave = request[offset]
+		f.FuncCode = request[offset+1]
+	}
+
+	if err != nil {
+		f.Error = err.Error()
+	}
+
+	_ = h.writer.Write(f)
+
+	return aduResponse, err
+}
@@ -0,0 +1,192 @@
+package meters
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// funcCodeNames are the names of the traced function codes
+var funcCodeNames = map[byte]string{
+	0x01: "read coils",
+	0x02: "read discrete inputs",
+	0x03: "read holding registers",
+	0x04: "read input registers",
+	0x05: "write single coil",
+	0x06: "write single register",
+	0x0F: "write multiple coils",
+	0x10: "write multiple registers",
+	0x2B: "read device identification",
+}
+
+// exceptionNames are the names of modbus exception codes
+var exceptionNames = map[byte]string{
+	0x01: "illegal function",
+	0x02: "illegal data address",
+	0x03: "illegal data value",
+	0x04: "server device failure",
+	0x05: "acknowledge",
+	0x06: "server device busy",
+	0x0A: "gateway path unavailable",
+	0x0B: "gateway target device failed to respond",
+}
+
+// crc16 calculates the modbus RTU checksum
+func crc16(b []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, v := range b {
+		crc ^= uint16(v)
+		for i := 0; i < 8; i++ {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ 0xA001
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
+
+// lrc8 calculates the modbus ASCII checksum
+func lrc8(b []byte) byte {
+	var sum byte
+	for _, v := range b {
+		sum += v
+	}
+	return -sum
+}
+
+// hexBytes formats bytes as space separated hex
+func hexBytes(b []byte) string {
+	s := hex.EncodeToString(b)
+	var sb strings.Builder
+	for i := 0; i < len(s); i += 2 {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(s[i : i+2])
+	}
+	return sb.String()
+}
+
+// splitADU returns the frame's PDU and its annotation of header and checksum.
+// TCP frames start with the MBAP header, RTU frames end with the CRC and ASCII frames with the LRC.
+func splitADU(adu []byte, header int) ([]byte, string) {
+	if header == asciiHeader {
+		if len(adu) < 3 {
+			return nil, "short frame"
+		}
+
+		n := len(adu) - 1
+		status := "ok"
+		if expected := lrc8(adu[:n]); adu[n] != expected {
+			status = fmt.Sprintf("mismatch, expected %02x", expected)
+		}
+
+		return adu[1:n], fmt.Sprintf("lrc %02x %s", adu[n], status)
+	}
+
+	if len(adu) < header+2 {
+		return nil, "short frame"
+	}
+
+	if header > 0 {
+		return adu[header+1:], fmt.Sprintf("transaction %d", binary.BigEndian.Uint16(adu))
+	}
+
+	if len(adu) < 4 {
+		return nil, "short frame"
+	}
+
+	n := len(adu) - 2
+	crc := binary.LittleEndian.Uint16(adu[n:])
+	status := "ok"
+	if expected := crc16(adu[:n]); crc != expected {
+		status = fmt.Sprintf("mismatch, expected %04x", expected)
+	}
+
+	return adu[1:n], fmt.Sprintf("crc %04x %s", crc, status)
+}
+
+// annotateRequest describes the request's address and quantity or value
+func annotateRequest(pdu []byte) string {
+	if len(pdu) < 5 {
+		return ""
+	}
+
+	address := binary.BigEndian.Uint16(pdu[1:])
+	switch pdu[0] {
+	case 0x01, 0x02, 0x03, 0x04, 0x0F, 0x10:
+		return fmt.Sprintf("address %d (0x%04x), quantity %d", address, address, binary.BigEndian.Uint16(pdu[3:]))
+	case 0x05, 0x06:
+		return fmt.Sprintf("address %d (0x%04x), value 0x%04x", address, address, binary.BigEndian.Uint16(pdu[3:]))
+	}
+
+	return ""
+}
+
+// decodeRegisters writes each register and each register pair decoded with both word orders
+func decodeRegisters(sb *strings.Builder, address uint16, data []byte) {
+	for i := 0; i+1 < len(data); i += 2 {
+		u := binary.BigEndian.Uint16(data[i:])
+		fmt.Fprintf(sb, "    %5d  %04x  uint16 %d, int16 %d\n", int(address)+i/2, u, u, int16(u))
+	}
+
+	for i := 0; i+3 < len(data); i += 4 {
+		abcd := binary.BigEndian.Uint32(data[i:])
+		cdab := abcd<<16 | abcd>>16
+		fmt.Fprintf(sb, "    %5d  %08x  float32 %g (ABCD) %g (CDAB), uint32 %d (ABCD) %d (CDAB)\n",
+			int(address)+i/2, abcd, math.Float32frombits(abcd), math.Float32frombits(cdab), abcd, cdab)
+	}
+}
+
+// annotateFrame formats the frame's request and response as hex with decoded fields
+func annotateFrame(f Frame) string {
+	var sb strings.Builder
+
+	name := funcCodeNames[f.FuncCode]
+	if name == "" {
+		name = "function"
+	}
+	fmt.Fprintf(&sb, "%s %s slave %d fc 0x%02x %s (%.1fms)\n",
+		f.Time.Format("2006/01/02 15:04:05.000"), f.Bus, f.Slave, f.FuncCode, name, f.Duration)
+
+	request, _ := hex.DecodeString(f.Request)
+	response, _ := hex.DecodeString(f.Response)
+
+	pdu, info := splitADU(request, f.header)
+	if s := annotateRequest(pdu); s != "" {
+		info = s + ", " + info
+	}
+	fmt.Fprintf(&sb, "  > %s  %s\n", hexBytes(request), info)
+
+	if len(response) > 0 {
+		rpdu, info := splitADU(response, f.header)
+
+		switch {
+		case len(rpdu) >= 2 && rpdu[0]&0x80 != 0:
+			code := rpdu[1]
+			info = fmt.Sprintf("exception 0x%02x %s, %s", code, exceptionNames[code], info)
+		case len(rpdu) >= 2 && rpdu[0] <= 0x04:
+			info = fmt.Sprintf("%d bytes, %s", rpdu[1], info)
+		}
+		fmt.Fprintf(&sb, "  < %s  %s\n", hexBytes(response), info)
+
+		// decode register values of read responses
+		if len(pdu) >= 5 && len(rpdu) >= 2 && (rpdu[0] == 0x03 || rpdu[0] == 0x04) {
+			data := rpdu[2:]
+			if n := int(rpdu[1]); n < len(data) {
+				data = data[:n]
+			}
+			decodeRegisters(&sb, binary.BigEndian.Uint16(pdu[1:]), data)
+		}
+	}
+
+	if f.Error != "" {
+		fmt.Fprintf(&sb, "  ! %s\n", f.Error)
+	}
+
+	return sb.String()
+}
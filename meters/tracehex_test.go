@@ -0,0 +1,116 @@
+package meters
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+func TestAnnotateFrame(t *testing.T) {
+	tc := []struct {
+		name  string
+		frame Frame
+		want  []string
+	}{
+		{
+			name: "tcp",
+			frame: Frame{
+				Bus: "localhost:502", Slave: 1, FuncCode: 4,
+				Request:  "000100000006010400000002",
+				Response: "00010000000701040443660000",
+				header:   6,
+			},
+			want: []string{
+				"slave 1 fc 0x04 read input registers",
+				"> 00 01 00 00 00 06 01 04 00 00 00 02  address 0 (0x0000), quantity 2, transaction 1",
+				"<", "4 bytes, transaction 1",
+				"0  4366  uint16 17254, int16 17254",
+				"1  0000  uint16 0, int16 0",
+				"0  43660000  float32 230 (ABCD) 2.4178e-41 (CDAB), uint32 1130758144 (ABCD) 17254 (CDAB)",
+			},
+		},
+		{
+			name: "rtu",
+			frame: Frame{
+				Bus: "/dev/ttyUSB0", Slave: 1, FuncCode: 4,
+				Request:  "01040000000271cb",
+				Response: "0104044366000055e3",
+			},
+			want: []string{
+				"address 0 (0x0000), quantity 2, crc cb71 ok",
+				"4 bytes, crc e355",
+			},
+		},
+		{
+			name: "ascii",
+			frame: Frame{
+				Bus: "/dev/ttyUSB0", Slave: 1, FuncCode: 4,
+				Request:  "010400000002f9",
+				Response: "01040443660000ff",
+				header:   asciiHeader,
+			},
+			want: []string{
+				"address 0 (0x0000), quantity 2, lrc f9 ok",
+				"4 bytes, lrc ff mismatch, expected 4e",
+				"0  43660000  float32 230 (ABCD)",
+			},
+		},
+		{
+			name: "exception",
+			frame: Frame{
+				Bus: "/dev/ttyUSB0", Slave: 1, FuncCode: 3,
+				Request:  "010300000002c40b",
+				Response: "0183020000",
+				Error:    "modbus: exception '2' (illegal data address)",
+			},
+			want: []string{
+				"exception 0x02 illegal data address, crc 0000 mismatch, expected",
+				"! modbus: exception",
+			},
+		},
+	}
+
+	for _, tc := range tc {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.frame.Time = time.Now()
+			s := annotateFrame(tc.frame)
+			for _, w := range tc.want {
+				if !strings.Contains(s, w) {
+					t.Errorf("missing %q in\n%s", w, s)
+				}
+			}
+		})
+	}
+}
+
+// asciiHandler responds with a fixed ASCII frame
+type asciiHandler struct {
+	modbus.ClientHandler
+	response string
+}
+
+func (h *asciiHandler) Send(aduRequest []byte) ([]byte, error) {
+	return []byte(h.response), nil
+}
+
+func TestASCIITrace(t *testing.T) {
+	var buf bytes.Buffer
+	h := newTraceHandler(&asciiHandler{response: ":010404436600004E\r\n"}, "/dev/ttyUSB0", asciiHeader, NewTraceWriter(&buf))
+
+	if _, err := h.Send([]byte(":010400000002F9\r\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	var f Frame
+	if err := json.Unmarshal(buf.Bytes(), &f); err != nil {
+		t.Fatal(err)
+	}
+
+	if f.Slave != 1 || f.FuncCode != 4 || f.Request != "010400000002f9" || f.Response != "010404436600004e" {
+		t.Errorf("unexpected frame %+v", f)
+	}
+}
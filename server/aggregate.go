@@ -0,0 +1,289 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// aggregateCloseInterval is the interval for closing windows of devices not sending any further readings
+const aggregateCloseInterval = time.Second
+
+// Aggregate is the minimum, maximum and mean of a measurement's values within a window
+type Aggregate struct {
+	Min   float64
+	Max   float64
+	Mean  float64
+	Count int
+}
+
+// add adds a value to the aggregate
+func (a *Aggregate) add(v float64) {
+	if a.Count == 0 {
+		a.Min, a.Max = v, v
+	}
+
+	a.Min = math.Min(a.Min, v)
+	a.Max = math.Max(a.Max, v)
+	a.Mean += (v - a.Mean) / float64(a.Count+1)
+	a.Count++
+}
+
+// AggregateWindow holds a device's aggregated measurements within a time window
+type AggregateWindow struct {
+	Device string
+	Window time.Duration
+	Start  time.Time
+	End    time.Time
+	Values map[meters.Measurement]*Aggregate
+}
+
+// WindowName returns the window duration without zero units, e.g. 15m or 1h
+func WindowName(window time.Duration) string {
+	s := window.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+// MarshalJSON creates the window's api json using measurement names as keys
+func (w AggregateWindow) MarshalJSON() ([]byte, error) {
+	values := make(map[string]*Aggregate, len(w.Values))
+	for m, a := range w.Values {
+		values[m.String()] = a
+	}
+
+	return json.Marshal(struct {
+		Device string
+		Window string
+		Start  time.Time
+		End    time.Time
+		Values map[string]*Aggregate
+	}{
+		Device: w.Device,
+		Window: WindowName(w.Window),
+		Start:  w.Start,
+		End:    w.End,
+		Values: values,
+	})
+}
+
+// clone returns a copy of the window including its values
+func (w *AggregateWindow) clone() AggregateWindow {
+	res := *w
+	res.Values = make(map[meters.Measurement]*Aggregate, len(w.Values))
+	for m, a := range w.Values {
+		cp := *a
+		res.Values[m] = &cp
+	}
+	return res
+}
+
+// Aggregator aggregates the readings of all devices over wall clock aligned time windows
+type Aggregator struct {
+	mux         sync.Mutex
+	windows     []time.Duration
+	current     map[time.Duration]map[string]*AggregateWindow // device windows in progress
+	closed      map[time.Duration]map[string]AggregateWindow  // last closed device windows
+	subSeq      int
+	subscribers map[int]func(AggregateWindow)
+}
+
+// NewAggregator creates an aggregator for the given window durations
+func NewAggregator(windows []time.Duration) (*Aggregator, error) {
+	a := &Aggregator{
+		current:     make(map[time.Duration]map[string]*AggregateWindow),
+		closed:      make(map[time.Duration]map[string]AggregateWindow),
+		subscribers: make(map[int]func(AggregateWindow)),
+	}
+
+	for _, w := range windows {
+		if w < time.Second {
+			return nil, fmt.Errorf("invalid aggregation window %v", w)
+		}
+		if _, ok := a.current[w]; ok {
+			return nil, fmt.Errorf("duplicate aggregation window %v", w)
+		}
+
+		a.windows = append(a.windows, w)
+		a.current[w] = make(map[string]*AggregateWindow)
+		a.closed[w] = make(map[string]AggregateWindow)
+	}
+
+	sort.Slice(a.windows, func(i, j int) bool {
+		return a.windows[i] < a.windows[j]
+	})
+
+	return a, nil
+}
+
+// Configured returns true if the window duration is configured
+func (a *Aggregator) Configured(window time.Duration) bool {
+	for _, w := range a.windows {
+		if w == window {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscribe registers a function that is called for each closed window.
+// The returned function removes the subscription.
+func (a *Aggregator) Subscribe(f func(AggregateWindow)) func() {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	a.subSeq++
+	id := a.subSeq
+	a.subscribers[id] = f
+
+	return func() {
+		a.mux.Lock()
+		defer a.mux.Unlock()
+		delete(a.subscribers, id)
+	}
+}
+
+// Window returns the device's last closed window or, if current is true, the window in progress
+func (a *Aggregator) Window(window time.Duration, device string, current bool) (AggregateWindow, error) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	closed, ok := a.closed[window]
+	if !ok {
+		return AggregateWindow{}, fmt.Errorf("aggregation window %s is not configured", WindowName(window))
+	}
+
+	if current {
+		if w, ok := a.current[window][device]; ok {
+			return w.clone(), nil
+		}
+	} else if w, ok := closed[device]; ok {
+		return w, nil
+	}
+
+	return AggregateWindow{}, fmt.Errorf("no aggregated data for device %s", device)
+}
+
+// close closes the windows ending before the given time and returns them. Caller must hold the lock.
+func (a *Aggregator) close(now time.Time) []AggregateWindow {
+	var res []AggregateWindow
+
+	for window, devices := range a.current {
+		for device, w := range devices {
+			if w.End.After(now) {
+				continue
+			}
+
+			a.closed[window][device] = *w
+			delete(devices, device)
+			res = append(res, *w)
+		}
+	}
+
+	return res
+}
+
+// add adds the snip's value to all window durations. Caller must hold the lock.
+func (a *Aggregator) add(snip QuerySnip) {
+	for _, window := range a.windows {
+		w, ok := a.current[window][snip.Device]
+		if !ok {
+			start := snip.Timestamp.Truncate(window)
+			w = &AggregateWindow{
+				Device: snip.Device,
+				Window: window,
+				Start:  start,
+				End:    start.Add(window),
+				Values: make(map[meters.Measurement]*Aggregate),
+			}
+			a.current[window][snip.Device] = w
+		}
+
+		agg, ok := w.Values[snip.Measurement]
+		if !ok {
+			agg = &Aggregate{}
+			w.Values[snip.Measurement] = agg
+		}
+
+		agg.add(snip.Value)
+	}
+}
+
+// publish closes windows ending before now and notifies the subscribers
+func (a *Aggregator) publish(now time.Time) {
+	a.mux.Lock()
+	closed := a.close(now)
+	subscribers := make([]func(AggregateWindow), 0, len(a.subscribers))
+	for _, f := range a.subscribers {
+		subscribers = append(subscribers, f)
+	}
+	a.mux.Unlock()
+
+	for _, w := range closed {
+		for _, f := range subscribers {
+			f(w)
+		}
+	}
+}
+
+// Run aggregates query results. Windows are closed once a reading after the window's end is received
+// or the window's end has passed. Restored stale values are not aggregated.
+func (a *Aggregator) Run(in <-chan QuerySnip) {
+	ticker := time.NewTicker(aggregateCloseInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case snip, ok := <-in:
+			if !ok {
+				return
+			}
+
+			// infinite values can't be aggregated or encoded as json
+			if snip.Stale || snip.Timestamp.IsZero() || math.IsInf(snip.Value, 0) {
+				continue
+			}
+
+			a.publish(snip.Timestamp)
+
+			a.mux.Lock()
+			a.add(snip)
+			a.mux.Unlock()
+
+		case now := <-ticker.C:
+			a.publish(now)
+		}
+	}
+}
+
+// NewAggregateSubscriber decorates a closed window subscriber such that it only receives windows
+// of devices matching the selector with values converted to the target units
+func NewAggregateSubscriber(s Selector, qe DeviceInfo, c UnitConverter, f func(AggregateWindow)) func(AggregateWindow) {
+	return func(w AggregateWindow) {
+		if len(s) > 0 && !s.Match(w.Device, qe.DeviceLabelsByID(w.Device)) {
+			return
+		}
+
+		if len(c) > 0 {
+			w = w.clone()
+			for m, a := range w.Values {
+				a.Min = c.Convert(m, a.Min)
+				a.Max = c.Convert(m, a.Max)
+				a.Mean = c.Convert(m, a.Mean)
+			}
+		}
+
+		f(w)
+	}
+}
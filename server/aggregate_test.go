@@ -0,0 +1,81 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestAggregator(t *testing.T) {
+	a, err := NewAggregator([]time.Duration{time.Minute, time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var closed []AggregateWindow
+	a.Subscribe(func(w AggregateWindow) {
+		closed = append(closed, w)
+	})
+
+	start := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	snip := func(offset time.Duration, v float64) QuerySnip {
+		return QuerySnip{
+			Device:            "SDM1.1",
+			MeasurementResult: meters.MeasurementResult{Measurement: meters.Power, Value: v, Timestamp: start.Add(offset)},
+		}
+	}
+
+	for _, s := range []QuerySnip{
+		snip(0, 100),
+		snip(20*time.Second, 300),
+		snip(40*time.Second, 200),
+	} {
+		a.publish(s.Timestamp)
+		a.add(s)
+	}
+
+	if _, err := a.Window(time.Minute, "SDM1.1", false); err == nil {
+		t.Error("expected no closed window")
+	}
+
+	// closes the minute window only
+	a.publish(start.Add(time.Minute))
+
+	if len(closed) != 1 {
+		t.Fatalf("expected 1 closed window, got %d", len(closed))
+	}
+
+	w, err := a.Window(time.Minute, "SDM1.1", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Aggregate{Min: 100, Max: 300, Mean: 200, Count: 3}
+	if agg := w.Values[meters.Power]; *agg != expected || !w.Start.Equal(start) || !w.End.Equal(start.Add(time.Minute)) {
+		t.Errorf("expected %+v, got %+v from %v to %v", expected, *agg, w.Start, w.End)
+	}
+
+	if w, err := a.Window(time.Hour, "SDM1.1", true); err != nil || w.Values[meters.Power].Count != 3 {
+		t.Errorf("expected hour window in progress, got %+v %v", w, err)
+	}
+
+	if _, err := a.Window(15*time.Minute, "SDM1.1", false); err == nil {
+		t.Error("expected error for window not configured")
+	}
+}
+
+func TestWindowName(t *testing.T) {
+	for window, name := range map[time.Duration]string{
+		time.Minute:                 "1m",
+		15 * time.Minute:            "15m",
+		time.Hour:                   "1h",
+		90 * time.Minute:            "1h30m",
+		30 * time.Second:            "30s",
+		time.Minute + 5*time.Second: "1m5s",
+	} {
+		if n := WindowName(window); n != name {
+			t.Errorf("%v: expected %s, got %s", window, name, n)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/volkszaehler/mbmd/log"
+)
+
+// Annotation describes a time range, optionally restricted to a set of devices
+type Annotation struct {
+	ID      int64     `json:"id"`
+	Start   time.Time `json:"start"`
+	End     time.Time `json:"end"`
+	Text    string    `json:"text"`
+	Devices []string  `json:"devices,omitempty"`
+}
+
+// Overlaps returns true if the annotation overlaps the time range. Zero from or to values are unbounded.
+func (a Annotation) Overlaps(from, to time.Time) bool {
+	return (to.IsZero() || !a.Start.After(to)) && (from.IsZero() || !a.End.Before(from))
+}
+
+// Applies returns true if the annotation applies to any device matched by selector.
+// Device names and tags are looked up using info if not nil.
+// Annotations without devices apply to all devices.
+func (a Annotation) Applies(s Selector, info DeviceInfo) bool {
+	if len(a.Devices) == 0 || len(s) == 0 {
+		return true
+	}
+
+	for _, id := range a.Devices {
+		var labels Labels
+		if info != nil {
+			labels = info.DeviceLabelsByID(id)
+		}
+		if s.Match(id, labels) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AnnotationStore holds annotations and optionally persists them to a json file
+type AnnotationStore struct {
+	mux         sync.Mutex
+	file        string
+	seq         int64
+	annotations []Annotation
+	subSeq      int
+	subscribers map[int]func(Annotation)
+}
+
+// NewAnnotationStore creates an annotation store. If file is not empty,
+// existing annotations are loaded from and changes are saved to file.
+func NewAnnotationStore(file string) (*AnnotationStore, error) {
+	s := &AnnotationStore{
+		file:        file,
+		annotations: make([]Annotation, 0),
+		subscribers: make(map[int]func(Annotation)),
+	}
+
+	if file == "" {
+		return s, nil
+	}
+
+	b, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &s.annotations); err != nil {
+		return nil, fmt.Errorf("invalid annotations file %s: %v", file, err)
+	}
+
+	for _, a := range s.annotations {
+		if a.ID > s.seq {
+			s.seq = a.ID
+		}
+	}
+
+	return s, nil
+}
+
+// Subscribe registers a function that is called for each added annotation.
+// The returned function removes the subscription.
+func (s *AnnotationStore) Subscribe(f func(Annotation)) func() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.subSeq++
+	id := s.subSeq
+	s.subscribers[id] = f
+
+	return func() {
+		s.mux.Lock()
+		defer s.mux.Unlock()
+		delete(s.subscribers, id)
+	}
+}
+
+// save writes all annotations to file. Caller must hold the lock.
+func (s *AnnotationStore) save() error {
+	if s.file == "" {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(s.annotations, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(s.file, b, 0644)
+}
+
+// Add validates and stores an annotation and returns it with assigned id.
+// If end is missing the annotation describes a single point in time.
+func (s *AnnotationStore) Add(a Annotation) (Annotation, error) {
+	if a.Text == "" {
+		return a, errors.New("missing text")
+	}
+	if a.Start.IsZero() {
+		return a, errors.New("missing start")
+	}
+	if a.End.IsZero() {
+		a.End = a.Start
+	}
+	if a.End.Before(a.Start) {
+		return a, errors.New("end before start")
+	}
+
+	s.mux.Lock()
+	s.seq++
+	a.ID = s.seq
+	s.annotations = append(s.annotations, a)
+	err := s.save()
+	subscribers := make([]func(Annotation), 0, len(s.subscribers))
+	for _, f := range s.subscribers {
+		subscribers = append(subscribers, f)
+	}
+	s.mux.Unlock()
+
+	if err != nil {
+		log.Errorf("annotations: failed to save: %v", err)
+	}
+
+	for _, f := range subscribers {
+		f(a)
+	}
+
+	return a, nil
+}
+
+// Delete removes an annotation
+func (s *AnnotationStore) Delete(id int64) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for i, a := range s.annotations {
+		if a.ID == id {
+			s.annotations = append(s.annotations[:i], s.annotations[i+1:]...)
+			if err := s.save(); err != nil {
+				log.Errorf("annotations: failed to save: %v", err)
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("annotation %d does not exist", id)
+}
+
+// Query returns the annotations overlapping the time range and applying to the selected devices sorted by start time.
+// Device names and tags are looked up using info if not nil.
+func (s *AnnotationStore) Query(from, to time.Time, selector Selector, info DeviceInfo) []Annotation {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	res := make([]Annotation, 0)
+	for _, a := range s.annotations {
+		if a.Overlaps(from, to) && a.Applies(selector, info) {
+			res = append(res, a)
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Start.Before(res[j].Start)
+	})
+
+	return res
+}
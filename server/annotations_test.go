@@ -0,0 +1,153 @@
+package server
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestAnnotationStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "annotations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "annotations.json")
+	s, err := NewAnnotationStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var added []Annotation
+	unsubscribe := s.Subscribe(func(a Annotation) { added = append(added, a) })
+
+	start := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, a := range []Annotation{
+		{Start: start},        // missing text
+		{Text: "maintenance"}, // missing start
+		{Text: "maintenance", Start: start, End: start.Add(-time.Minute)}, // end before start
+	} {
+		if _, err := s.Add(a); err == nil {
+			t.Errorf("%+v: expected error", a)
+		}
+	}
+
+	a, err := s.Add(Annotation{Text: "replaced meter", Start: start.Add(time.Hour), Devices: []string{"SDM1.1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.ID != 1 || !a.End.Equal(a.Start) {
+		t.Errorf("expected point annotation with id 1, got %+v", a)
+	}
+
+	if _, err := s.Add(Annotation{Text: "outage", Start: start, End: start.Add(30 * time.Minute)}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(added) != 2 {
+		t.Errorf("expected 2 notifications, got %d", len(added))
+	}
+	unsubscribe()
+
+	tc := []struct {
+		from, to time.Time
+		device   string
+		ids      []int64
+	}{
+		{time.Time{}, time.Time{}, "", []int64{2, 1}},
+		{time.Time{}, time.Time{}, "SDM1.2", []int64{2}},
+		{start.Add(20 * time.Minute), start.Add(time.Hour), "sdm1.*", []int64{2, 1}},
+		{start.Add(31 * time.Minute), start.Add(59 * time.Minute), "", nil},
+		{start.Add(time.Hour), time.Time{}, "", []int64{1}},
+	}
+
+	for _, tc := range tc {
+		res := s.Query(tc.from, tc.to, NewSelector(tc.device), nil)
+		if len(res) != len(tc.ids) {
+			t.Errorf("%+v: expected %v, got %+v", tc, tc.ids, res)
+			continue
+		}
+		for i, id := range tc.ids {
+			if res[i].ID != id {
+				t.Errorf("%+v: expected %v, got %+v", tc, tc.ids, res)
+			}
+		}
+	}
+
+	if err := s.Delete(2); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Delete(2); err == nil {
+		t.Error("expected error deleting missing annotation")
+	}
+
+	// annotations are restored from file and ids continue after the highest restored id
+	s, err = NewAnnotationStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := s.Query(time.Time{}, time.Time{}, nil, nil); len(res) != 1 || res[0].Text != "replaced meter" {
+		t.Errorf("expected restored annotation, got %+v", res)
+	}
+	if a, _ := s.Add(Annotation{Text: "new", Start: start}); a.ID != 2 {
+		t.Errorf("expected id 2, got %d", a.ID)
+	}
+	if len(added) != 2 {
+		t.Errorf("expected no notification after unsubscribe, got %d", len(added))
+	}
+}
+
+func TestHistoryAnnotations(t *testing.T) {
+	hist, err := NewHistory(time.Hour, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now().Truncate(time.Minute)
+	hist.add(QuerySnip{
+		Device:            "SDM1.1",
+		MeasurementResult: meters.MeasurementResult{Measurement: meters.Power, Value: 1, Timestamp: now.Add(-10 * time.Minute)},
+	})
+
+	as, _ := NewAnnotationStore("")
+	for _, a := range []Annotation{
+		{Text: "inside", Start: now.Add(-15 * time.Minute), End: now.Add(-5 * time.Minute)},
+		{Text: "other device", Start: now.Add(-10 * time.Minute), Devices: []string{"SDM1.2"}},
+		{Text: "later", Start: now.Add(-time.Minute)},
+	} {
+		if _, err := as.Add(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	h := NewHttpd(nil, nil, nil, as)
+	router := mux.NewRouter()
+	router.HandleFunc("/device/{id}/history", h.historyHandler(hist))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/device/SDM1.1/history", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var res struct {
+		Annotations []Annotation
+	}
+	if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Annotations) != 1 || res.Annotations[0].Text != "inside" {
+		t.Errorf("expected overlapping annotation, got %+v", res.Annotations)
+	}
+}
@@ -0,0 +1,244 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"net/http"
+	"sort"
+
+	"github.com/gorilla/mux"
+	"github.com/volkszaehler/mbmd/log"
+)
+
+// v1Reading is a single measurement of the versioned api
+type v1Reading struct {
+	Measurement string      `json:"measurement"`
+	OBIS        string      `json:"obis,omitempty"` // electricity OBIS code like 1-0:1.8.0
+	Description string      `json:"description"`
+	Unit        string      `json:"unit"`
+	Value       interface{} `json:"value"`               // number, boolean for status measurements or null if not finite
+	Timestamp   string      `json:"timestamp,omitempty"` // RFC3339 read time
+	Age         *float64    `json:"age,omitempty"`       // seconds since the read time
+	Outdated    bool        `json:"outdated,omitempty"`  // age exceeds the staleness threshold
+	Cycle       uint64      `json:"cycle,omitempty"`     // polling cycle sequence number
+	Derived     bool        `json:"derived,omitempty"`
+
+	fields map[string]bool // selected fields, all fields if nil
+}
+
+// MarshalJSON encodes the reading restricted to the selected fields. The measurement is always included.
+func (r v1Reading) MarshalJSON() ([]byte, error) {
+	type reading v1Reading
+	if r.fields == nil {
+		return json.Marshal(reading(r))
+	}
+
+	fields := []struct {
+		name  string
+		value interface{}
+		empty bool
+	}{
+		{"obis", r.OBIS, r.OBIS == ""},
+		{"description", r.Description, false},
+		{"unit", r.Unit, false},
+		{"value", r.Value, false},
+		{"timestamp", r.Timestamp, r.Timestamp == ""},
+		{"age", r.Age, r.Age == nil},
+		{"outdated", r.Outdated, !r.Outdated},
+		{"cycle", r.Cycle, r.Cycle == 0},
+		{"derived", r.Derived, !r.Derived},
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`{"measurement":`)
+	b, err := json.Marshal(r.Measurement)
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(b)
+
+	for _, f := range fields {
+		if f.empty || !r.fields[f.name] {
+			continue
+		}
+
+		if b, err = json.Marshal(f.value); err != nil {
+			return nil, err
+		}
+		buf.WriteString(`,"` + f.name + `":`)
+		buf.Write(b)
+	}
+
+	buf.WriteString("}")
+	return buf.Bytes(), nil
+}
+
+// v1Cycle is the latest polling cycle of a device
+type v1Cycle struct {
+	Seq       uint64 `json:"seq"`
+	Timestamp string `json:"timestamp"` // RFC3339
+}
+
+// v1Device are a device's readings of the versioned api
+type v1Device struct {
+	Device      string      `json:"device"`
+	Name        string      `json:"name,omitempty"`
+	Timestamp   string      `json:"timestamp"` // RFC3339
+	Cycle       *v1Cycle    `json:"cycle,omitempty"`
+	Stale       bool        `json:"stale,omitempty"`
+	Readings    []v1Reading `json:"readings"`
+	Diagnostics []v1Reading `json:"diagnostics,omitempty"` // meter status, also contained in the readings
+}
+
+// filter restricts the device's readings to the selected fields
+func (d *v1Device) filter(f readingsFilter) {
+	if f.fields == nil {
+		return
+	}
+
+	for _, readings := range [][]v1Reading{d.Readings, d.Diagnostics} {
+		for i := range readings {
+			readings[i].fields = f.fields
+		}
+	}
+}
+
+// v1Error is the error response of the versioned api
+type v1Error struct {
+	Error string `json:"error"`
+}
+
+// newV1Device converts a device's readings to the versioned api schema
+func newV1Device(id string, labels Labels, r *Readings) v1Device {
+	res := v1Device{
+		Device:    id,
+		Name:      labels.Name,
+		Timestamp: apiTime(r.Timestamp),
+		Stale:     r.Stale,
+		Readings:  make([]v1Reading, 0, len(r.Values)),
+	}
+
+	if r.Cycle.Seq > 0 {
+		res.Cycle = &v1Cycle{Seq: r.Cycle.Seq, Timestamp: apiTime(r.Cycle.Timestamp)}
+	}
+
+	for m, v := range r.Values {
+		var value interface{}
+		switch {
+		case m.Boolean():
+			value = v != 0
+		case !math.IsNaN(v) && !math.IsInf(v, 0):
+			value = v
+		}
+
+		var ts string
+		if t, ok := r.Timestamps[m]; ok {
+			ts = apiTime(t)
+		}
+
+		var age *float64
+		if d, ok := r.Ages[m]; ok {
+			seconds := apiAge(d)
+			age = &seconds
+		}
+
+		description, unit := m.DescriptionAndUnit()
+		reading := v1Reading{
+			Measurement: m.String(),
+			OBIS:        m.OBIS(),
+			Description: description,
+			Unit:        unit,
+			Value:       value,
+			Timestamp:   ts,
+			Age:         age,
+			Outdated:    r.Outdated[m],
+			Cycle:       r.Cycles[m],
+			Derived:     r.Derived[m],
+		}
+
+		res.Readings = append(res.Readings, reading)
+		if m.Diagnostic() {
+			res.Diagnostics = append(res.Diagnostics, reading)
+		}
+	}
+
+	for _, readings := range [][]v1Reading{res.Readings, res.Diagnostics} {
+		sort.Slice(readings, func(i, j int) bool {
+			return readings[i].Measurement < readings[j].Measurement
+		})
+	}
+
+	return res
+}
+
+// v1Encode writes the versioned api response
+func v1Encode(w http.ResponseWriter, code int, res interface{}) {
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		log.Errorf("httpd: failed to encode JSON: %s", err.Error())
+	}
+}
+
+// v1AllDevicesHandler returns the readings of all available devices matching the device query parameter
+func (h *Httpd) v1AllDevicesHandler(
+	readingsProvider func(id string) (*Readings, error),
+) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		filter, err := parseReadingsFilter(r)
+		if err != nil {
+			v1Encode(w, http.StatusBadRequest, v1Error{err.Error()})
+			return
+		}
+
+		selector := NewSelector(r.URL.Query().Get("device"))
+		res := make([]v1Device, 0)
+
+		for _, id := range h.mc.SortedIDs() {
+			labels := h.qe.DeviceLabelsByID(id)
+			if !selector.Match(id, labels) {
+				continue
+			}
+
+			readings, err := readingsProvider(id)
+			if err != nil {
+				continue // device not available
+			}
+
+			filter.apply(readings)
+			dev := newV1Device(id, labels, readings)
+			dev.filter(filter)
+
+			res = append(res, dev)
+		}
+
+		v1Encode(w, http.StatusOK, res)
+	})
+}
+
+// v1SingleDeviceHandler returns the readings of a single device
+func (h *Httpd) v1SingleDeviceHandler(
+	readingsProvider func(id string) (*Readings, error),
+) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := mux.Vars(r)["id"]
+
+		filter, err := parseReadingsFilter(r)
+		if err != nil {
+			v1Encode(w, http.StatusBadRequest, v1Error{err.Error()})
+			return
+		}
+
+		readings, err := readingsProvider(id)
+		if err != nil {
+			v1Encode(w, http.StatusNotFound, v1Error{err.Error()})
+			return
+		}
+
+		filter.apply(readings)
+		dev := newV1Device(id, h.qe.DeviceLabelsByID(id), readings)
+		dev.filter(filter)
+
+		v1Encode(w, http.StatusOK, dev)
+	})
+}
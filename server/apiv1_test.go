@@ -0,0 +1,44 @@
+package server
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestV1Device(t *testing.T) {
+	r := &Readings{
+		Timestamp: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		Values: map[meters.Measurement]float64{
+			meters.Power:     100,
+			meters.Frequency: math.Inf(1),
+			meters.Relay1:    1,
+		},
+		Timestamps: map[meters.Measurement]time.Time{
+			meters.Power: time.Date(2020, 1, 1, 11, 59, 59, 500e6, time.UTC),
+		},
+		Cycle:    Cycle{Seq: 7, Timestamp: time.Date(2020, 1, 1, 11, 59, 59, 0, time.UTC)},
+		Cycles:   map[meters.Measurement]uint64{meters.Power: 7, meters.Relay1: 6},
+		Derived:  map[meters.Measurement]bool{meters.Power: true},
+		Ages:     map[meters.Measurement]time.Duration{meters.Power: 1500 * time.Millisecond},
+		Outdated: map[meters.Measurement]bool{meters.Power: true},
+	}
+
+	b, err := json.Marshal(newV1Device("SDM1.1", Labels{Name: "garage"}, r))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"device":"SDM1.1","name":"garage","timestamp":"2020-01-01T12:00:00.000Z","cycle":{"seq":7,"timestamp":"2020-01-01T11:59:59.000Z"},"readings":[` +
+		`{"measurement":"Frequency","obis":"1-0:14.7.0","description":"Frequency","unit":"Hz","value":null},` +
+		`{"measurement":"Power","obis":"1-0:16.7.0","description":"Power","unit":"W","value":100,"timestamp":"2020-01-01T11:59:59.500Z","age":1.5,"outdated":true,"cycle":7,"derived":true},` +
+		`{"measurement":"Relay1","description":"Relay 1 State","unit":"","value":true,"cycle":6}],` +
+		`"diagnostics":[{"measurement":"Relay1","description":"Relay 1 State","unit":"","value":true,"cycle":6}]}`
+
+	if string(b) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, b)
+	}
+}
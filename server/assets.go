@@ -215,11 +215,11 @@ var _escData = map[string]*_escFile{
 		size:    429,
 		modtime: 1566640112,
 		compressed: `
-H4sIAAAAAAAC/2SQ3Wq0MBCGj5OreL+DD9ptXS2lP7pQeiuxiTpgTIjDapHce9G1dmUPZ96ZJ3mmdPob
-kwS80pq6OmHnC7wEY08yyuOX61hRZwI+oOmMSYrd4PMyuPVKx+zsbzvK9ICS6toE/IEqF9Bb1bYm4Exm
-8C5wj0MqP63RpHBn1ZgMpLkp8Pb67sf7+dWrn0xSiDV/yrL//8jOCNXxSQpxtZznee7HXRx3oE1p75Qd
-L/riRmtLooySVdma5XRsRk5US3VXoDUVr+bOEqOi0DO4SVnDm4Dghtn1sswNHsDNI9ZSz6W+YQaqmwX6
-EwAA//8QsiIwrQEAAA==
+H4sIAAAAAAAC/2yQ3W6DMAyFr+OnOLuYtHVryzTtBypNe5WwBLBESBSswoR49wnKuqLu0j72l3zOvfnG
+QEDQxnBTbsWHDC/RugONtPvyjWhubMQHDB8xkFoNPs+D517uRbz7bY+03yDnsrQRf6DCR7RO17WNOLLt
+go/SYrOnT2cNa9w53W87NlJleHt9D/399OrFTwZSasmfkuT2ht2E0I0cSKmL5TRN09Cv4pHUf0prp2R3
+0ldXWudkpJFE57WdTye2l62uuWwy1LaQxdw7FhQcW4FUezEINiL6bnI9LUuFB0j1iKU0U2mumJHLaob+
+DAAQsiIwrQEAAA==
 `,
 	},
 
@@ -229,439 +229,440 @@ EwAA//8QsiIwrQEAAA==
 		size:    140930,
 		modtime: 1566640112,
 		compressed: `
-H4sIAAAAAAAC/+y9e4/jOLIn+v9+Cm0VCl05bbkl2bKdTnRj5jTmYA8wPX/M7AEu0FsL6EHbmtJrJblS
-OYbPZ78QHxIfQUqynT29F3d6ulOmgsFgRJD8kSKDP/zhv/836w/WvxVFUzdVUFrf1kt36VqfT01T1vsf
-fjiiJmQvl1GR/fDU0f9clG9Vcjw1lue4ru057s76nyfE8fnTuTkVVa0lfk2aBlUL6z/yaNkR/SWJUF6j
-2DrnMaqsX/7jf3IyJM3pHOLSm9ew/qEX6IcwLcIfsqBuUPXDX/7j5z//9e9/7uT7YV8VRXOx7TA9o/1H
-x9mGh8OLbSd5nByL/cfNxnUO3ottl+eqTNH+4+aw9iK3S0jyr/uPaLdCu+jFtisU7z/G0cpf+y+2XVRB
-fkT7j4d4i9z1i22/oTQtXvcfD4fIdbYvtn2sEMr3H71dsMU5GhSk+4+eEz0/d6+jtyDff3S3gRfuXmz7
-9ZQ0HTss27EK3vYfN9HW38b0px0H1df9x9V6FaydTrgqyYLqjatQjaIij3Fan7M+RxGqa06KJD8UfLFB
-lSf5kRM77upVcTVNO3PtPx52h+dDgAkEQcIKBV/LIskbu633Ukqd7f3tpmzF1Czebzc7OTU97p+fPTm1
-Tfeu5zg4+VDkjX0IsiR9s+sgr+0aVclhbwdlmSK7fqsblC3+LU3yr78E0d/xz38v8mbx4e/oWCDrP//j
-w+JvRVg0xeLD/0DpN9QkUWD9FZ3Rh8WfqiRIFwPTxYc/dUytn4u0qKw/Z8U/kg8DHzXh729ZWKQfJCGz
-Ii/qMojQ/u///kuRF/bf0PGcBtXiF5SnxeKXIg+iYvFzkddFGtSLD39JQlQFTVLkVkf+YfHh5+JcJaiy
-/opePyx6dtc/LPb74NA1mv0+RIeiQpewaO06+WdnzLCoYlTZYdFeT02WXjiR9kMVX9IkR/YJYfO6S9d/
-sV9R+DVp7Aa1TccL2UH8j3Pd7F3H+fRiZ7XhTfENVYe0eLXr5i1F+zqqijQNg2pgGpT2KTmesDvZUafX
-fVMFeV0GFcqb6x87Lt8S9FoWVXN5TeLmtI/RtyRCNv5xDaomiVK0COokRotDcoyCslNV93iu0OJQFJ1C
-TiiIuz/HqjiXiyxI8kUefFvUKOqIL3FSl2nwtg/TIvp6DYv47ZIF1THJ984Lr6ffk1NhuTqt790KZeTn
-K7Hb2nEkO/ovRLcfPdfzvecXbLIgTY75PkWH5iUMoq+dbvKYGqHrc66/NkGY5DFqf/xgux++7A9FdK4v
-xbnpmO+d/55knVmCvLmeKt7VoiJvUN50vvZCRXBemC/svyV1EqboenIXJ29xWi1O68XJX5w2VOd2U5R7
-54X+CIumKbL90q9Qdi1NJJ0arkEYVr/GQRPYRZUckzxI7SZpUvRlgd+Q5wuufoyigrSrPR5SukqJ3g5R
-WHHRNCh+GSWIzlVdVPsTSsuXvu1hQZ1rEMcVquuLWgFqVtxc8qLKglSwZJKfUJU01zhdFOninI7qo0it
-oqO1zh25hTNZQ75eori58B60dZxrHF8AG7BCOrfZO1fcYv7PuWhQ32Isx8JFx4f8wtUmaYI0ia7hom6q
-Ij8KxYVFGqPqWmdBSjsm7Ng759O1PoeL+lxeyqJOsKIrlAZN8g1xDWDrfxK05Lx8Q12/EKTUx8OgRh1B
-x+1Ca2MvPR9l1453pz172f0KLtT96QAqWzkvcqQ2Fq7H0jmQXX9Nyn0R/gNFTX0N9qeuOQyF+ZtwpXep
-a7DPi+bzr6cKHb48kWfWNL88US7UNUCRzQxIu16YaXiB7y1q6EKuURGjxdcwXpQVWtRBVgrj0mMHSL7L
-7DqTCo01n6HTCs5NYRzSrmTAUVpBkh0vkjdmSRyniHUKrK133vntiBWGwenTpS/8lMQxyq9NEKbdkI6z
-RUWaBmWN9uzhSke+SxnEcZIfcaWWW9xmWRJryCSVeh/FhfKQQNnZ3cC6JxmvzenCkbHOKA1ClPZjaJLj
-pog7BrATD89NU+SsHlUQJ+e660lwsuQgbtmy/pQl+WVrddawWEvDOeyqqx+uEeW0SPLy3CyKsiGDfo1S
-FDWLTv6gQgE8wDPPHjyFpUC9MF/QRRnf6FtSLtEb7igORZURe//avJXoxwrVqPmyID/qc5glzZcFzdwh
-NYu8ISlfLqzaQVmioAryCO3Jq6tAt9/bWfFPqpwkz1G14IvTvqYCAO+pgZQXzOH2DuDSWDeEc3RC0dew
-aL8suMTO/MUXGKe+9Ix5NnHQIIFFl9AkGbLTIgpS4VVW5M1JSOkIQR2mSd102Lj3D7HpVwh7A2vJ10OC
-0rhGzSVLcgJF984g70vvXKQ2e+eaoiPKYxFpvpCMGC5nQWtzP2VW4hjMdWQkAfDOF7GvxlNJm8w7CLC4
-llVxxDBEN1oSleXnLERV5xFUa9jqdl12UhE31RAW50YkvFARO5VS7jUKquj0hbV4uzgcatTsbTznU83E
-tRuacyiOJNhRR5iKouloh8HroitsyHNIUmSfy7QIYlafzhC9ivUtszg3XRcBdZHX+px1c/b+ZeeIdtJ0
-/TNBkHjKi6prg7IyDRrUUxJdkMHhi5DKIfPlyV0sT95ieVotlqf1YnnyF8vTZqFF36qjQX0jBW6+MtPw
-RL/D5Z9cDtR5ZBToZDp5fDpJXS1Oqwvv31tKvl6c1hfZ8a9ddU6+kO7RF5uuUuIs6bpMURCr1EKdVo5z
-XVJt2rzkG4BSrv2Qk6+bv/Rn5eU1sJ6Zl1fSalreU8UDIZdD+TwWYl0Z6+UxbdladZEmsVUdw+Czs+j+
-WbpP1yXG8gsA0csz1esyC6qvi+4//Uiy9Lry1DlpdNih1XWJm8g5x8NM3OMdMiN5wS+5EYhQkyY3hxa3
-QbjJKmQEtaVB3djRKUnjJ6ZPvKpJcc8yyZMmCdKkzjiNPDufXiRscC5LVEVBja5LZXoFzBUFrx8y2GTp
-QxpxRFMICBDI3K8j0Rn9/sP/8hx3bf0vx/mT8+G6TLKjfUjPSTdTFIYvvp/HVM3pnIV5kKScjbFvgisP
-zNcG9/oYI+ShzYuIGikPU+EUlsN2JC/tDqNDvZ/QTnpqhrNFG4qq7KY1vNtvl35PQteOX4sqJmuae/xf
-u0u4Bj/hrGIf+jWMObVVKLOWa9H+QgFYgYpa6cqPrMDOa76GscWX4fCe5UhNdus4eOqk9SteFFJoR29J
-KtGglEEpFKQsywrZZJaFpz+dralJVmunbPspmv1GJ2PXZeetQdJBUxVR0Sbp+mWPL0lvgFOEZouBH7/c
-gT3qjxmKk8D6PAA/vJj9dOGKHRzS72S8ApnwWrcm09bTZMJL4ZpMzxtNJrJSrsnlukTA4SVt0O+guWVV
-vPZu082lDylqO5zP0rrfL+yF/VoF5b77z4v0UyjK5kvHZeGU6zIv7OO5aVBVi52xI61gcYQ/LaMiXfAJ
-v0ZpUNd/+DEqUvvLRVSEI2rBuZLcHalL/zj0L/vtkb/0z4r8WZM/PvmzIX+25M+O/Hkmfzotkqf0yP6y
-sronZ3jkUr3+cXha9U/r/snvnzb907Z/2vVPz/3TIE8Ws79Mnu7JGR65VK9/HJ5W/dO6f/L7p03/tO2f
-dv3Tc/80yFNn7C+Tp3tyhkcu1esfh6dV/7Tun/z+adM/bfunXf/03D8N8rQp+8vkaQf3aAcPaQcnaXs/
-aXtXaXtvaXuHaXufaXu3aXvPaXvnaYn/AAup/Cw0yfsBj2vmppaP3f7St9qyQgdUVSgm3bxDGm8Y1An+
-DtiTYSm+ob1LCI5V8bp3pZH82nt9zx8vrOHuRfxFMtF+h/EgmA63PJHBbrnC//v0AiQN+fs0wsQTmbib
-5ab735bjwqVxVekTCZ+VyMfzOQbdjyGn59MsazHLaqVWgEsbGAyJhI8v8lm7ahW4tIHPkEj4bEQ+vsMx
-8IXVDJ+ZcStlAWzgQ0bwJSvsRD4bwAobyAobyQrPIp8tb4WtYIUts4LrSG4EmGEHmWEnmcGV/PEZsMMz
-ZIdnyQ6u7JMObwlgYem6JPjvkFR1M7RaArdt94U9MLpuTiOTuasX9sDIHJnGeWELX5TCVbgwJozCkyk8
-SuExipVMwQTp5VjLFGtKsWYUvkzhUwqfUWxkig2l2DCKrUyxpRRbRrGTKXaUYsconmWKZ0rx3GtMUarL
-tOoOalX12iu216yrqNZlunU75eIlN9u98MCIa3X0vSe85zs1SrASCHDfRd+shTd8p0QJfIGA720owUYg
-wJ0KfbMV36hy7wSCjSr3s0Cw5eR2HVEnquCuqDWufZrmCR06eM8hk2KPe0fNDh09YuDsoNWDxs4Om80f
-Pjsc96ARtAOCDxpEOyQ5fxztUOeDhtIOtj5oNO1w7/wBFYPyB42pGNU/aFjF04IbR9Y6mzy41tnU8bXO
-JgyxQnPVjbJCW9QNtEIr0421QqPSDbdCc9GNuEJD0A26gt/rxl3Bo3VDr+CrutFXdE39ACy6nX4MFl3K
-MAxjU0tLFMOrsUEam3dknMbW1Q3V2KojozU26siAjW2qG7OxLUeGbWzKkZEbW1I3eBMLjozfxHyaIdy4
-bJfaWfyeYzhdz7h3DM/ix4zhWfywMTyLbxnDs/hhY3gWP2wMz+JbxvAsftgYnsUPG8Oz+JYxHC/0PWgM
-xyuFDxrD8VLjjWN4Fk8ew7N46hiexRPGcKG56sZwoS3qxnChlenGcKFR6cZwobnoxnChIejGcMHvdWO4
-4NG6MVzwVd0YLrqmfgwX3U4/hosuZRjDsanhMRwb2DyGY/OOjOHYuroxHFt1ZAzHRh0Zw7FNdWM4tuXI
-GI5NOTKGY0vqxnBiwZExnJhv+hg+fEVL7fT4nmM4/UZy7xieHh8zhqfHh43h6fGWMTw9PmwMT48PG8PT
-4y1jeHp82BieHh82hqfHW8Zw/PHwQWM4/vr4oDEcf768cQxPj9MXuY9Tx/D0OGEMF5qrbgwX2qJuDBda
-mW4MFxqVbgwXmotuDBcagm4MF/xeN4YLHq0bwwVf1Y3homvqx3DR7fRjuOhShjEcmxoew7GBzWM4Nu/I
-GI6tqxvDsVVHxnBs1JExHNtUN4ZjW46M4diUI2M4tqRuDCcWHBnDifmmj+HcppbUbt/1+3P7mE/Q7YO+
-QreP+xDd3vQtun3c5+j2cV+k25s+SreP+y7dPu7TdHvT1+n2gR+o2wd+o27v+EzdppMH8TadOoi36YRB
-XGiuukFcaIu6QVxoZbpBXGhUukFcaC66QVxoCLpBXPB73SAueLRuEBd8VTeIi66pH8RFt9MP4qJLGQZx
-bGp4EMcGNg/i2Lwjgzi2rm4Qx1YdGcSxUUcGcWxT3SCObTkyiGNTjgzi2JK6QZxYcGQQJ+bTDeJLcn5R
-f+YJOnNgOGpLGVpNvGBPp2GbNDniKJ1saooSPr1At5f3LE8oiDt28skoLJp0nNvTcgmL+O17/N8LV6qW
-nioIPotPXtp1NtQX/+CqvMInAMgrUh6KL7qd9DIhx3ZIOs3ITlQGMaG6FLTGQEXZipxSVNe84hbA2xhK
-PIGJQtFd46eKa6qk7GTrirCaap83J7s42M1biT4XcfykGoE/3+L4T4wTPhY98CGnpM2Zt0NuGq9lIf78
-aahhn3IC3CLcxcHgGUQSMZ9Ono/PhyiekpUTxUR0Gi2ij0OzkBO4Irg0iGO8iXdxCAo9hLnR1TjaRWF0
-mJJZV2eF7DRaDI20sxB/8jVmKSCvFdpEmvrSED662oZufAgnZNXWVSQ6jRaR5IdiwT1zjMlPkAVCPoKl
-xBGJdLULwjhG/kg+XdV4itMocxoKaSH+5Hj3KSew40YoDEBBWYwlXR0PB7QL3PGsumpKRKfRIkiIp4Xw
-i2POEkBGfqRzVBo4SltLN3TC7WhOXSVFmtNoATjM0IL/wXGmv0Eu8SE+IFBMEghLVz8UoeiwGcuoq55A
-chplHwfV1wX3LFiv+wl2MptoFwUaC1Rf9T3McxiGaCSf3m4DxWmUeRA1yTe0EH5xnFnCafq4KwhD8s8d
-urUsdHUWaaYLay0xjME667DMjEN+NHHlrXYrJLHDvsXxWz/7jr8FWKJnFKGDxFIEgZ1oU+Ti6S3ROTkA
-R3+KqBGqCqbU4F0HoLkV9Xm+v2D/8tiPYz0DBorcOjv3q5vD2tLWXz6TjUaEf4Xqssjr5FsH+vVBJPqz
-kC0NWMMCMfRBa/AJyW5caIpzdIIi2nRJXeZTgs/5DAFuIFF+0qofqNV2s9XWKot/N7XK4lm1en52tbVK
-j7+bWqXHWbVy3ednbbXa9HdTrTY1VEsh/72IrZd5eSiqzI6KvKkKg5aHOT9e57DococUE1ATA1Db1ct9
-d5qU+yF0VAucyo9QvI4D+FQ+XqohRw35Ttxaun5toaDuoLhdnJsFDv5zCuLiVXnHPLOOKoRyK8hj6zP5
-wlPbFYrPEYrtrKDHGbufTxdRg5wQ+FOMqOA9thlqyyCPL8awbrCBaLSqSXoVxrGdE8aHQx/TynkZdICX
-xB2LnPOnmMD1VmS88LrRR6pBH5WnPDd2mQYROuFwehcxvldRBlHSvOFABpIKin/ekK/T3B1l3pF5fpY4
-qbv2Fi+E5F8rFMRFnr59uWhhz8CRxPES+eLodl17Y5HusnPaJGWKvjyxUEdRkEafPdIkrO8tr2yfQFbY
-k4hivgXpGU1xKrGWOD6RWEMbxyPWdiPk+wzOQGK48aHjsOCsf/necsv2SY4jB5FIgSVHIqm5S18Wohs0
-VTlGpeAJ1Pg+Y2XWGVCmN1qoB5e63G3hUgXblGmQ5A1qm9FunoTyW8Gx/GiyrHdz/NcpfR3p4tUXDCGU
-reXoqiQmp8fFJLo6WyxJr4BD9mHMMiGfmon/HZQlymMto7DJ78rPk3YJZmZlhe6TZoyBWZwOsM9WaJfp
-HoXOzD9eg7sUOpeBLM5oEBCjR8sGmKapySq9UXfTlaTTBsV8lhyeUNMTglGIptSQjpxhk5vG3sUMVnKN
-bufLlPQIGSVedwk5E7gsAHq7ziZjHXe5cwW0o44Fpj5+Wmc8ude+sXue3g/rWwTWARynbaw9rJT2oKnf
-Lb5mZnW7q+lU9AgZH9Ye0uND2kN6nIH9d1u1OWBpgJh+9DUAC7kIjSzYHyZ9l6BaSkwtHOiHlUfjZPU/
-TVGylJhBAyccEBiIRyTWWww4JFQdMyDzyIFNENZFem6QoLCVHLne1nLqJ4v/xb8i8yMpXqLyXo6qLzLH
-ESh5U9FghLLFuOTBcGQDSoTyBlUv+AeOPFmzJCmypRh/cgtUFd9VYNBj3QRNEr1AEdIp15XrATcCLL8F
-aRLbB4TibpYhxKV9UXf7DP4Mx6Ykd9Qwrk1RdI0MsDbez9Px/aeNY83v/RehZE18ZeFOAyyLOwHBGCMt
-4jWbtbNwN9vF5nmx3D3BcCc6102R2axLw/P+RFqi4JJfA/oYNCi2xNx7mEbo4nC69DmFKRcWhd4LAAvE
-Xo6LpaFUhaOraZCIxvUxTtd4fQyuzX9JjrkYoaOupqm+ys1I1jMbV5fKeXoeXTGqrkdL0WdhbVC8NEdd
-ipqoK5h2Sk1wzhuqA+Qz1mnoHTkx5b5fUyI3rmgyXqS2aCgTVqOB1KxFVbZJStRl0+iQuivTvSyo9BrW
-JcRjb2Bwgfu3OTKw0Md3yzLcxaUs4W7dON4Fo+Jp+i0z+UiPA4o/reMxZL3FBfbYodDjzDCNIWCO1TpC
-/nZMXDxIPU7YCewuyuDnlq3VgY/F1LEQX12gcXv8ztjuhtx7XVYzsJhW/Jj6JojBqYwTp7+LwCSOppUZ
-aEeamCrutPaly2duXICckm/Nt7OWwWUOHEvyx84IyB2UA9//a+YEnucs/NW8OQGtpAov+xdGdKijEtAd
-JZKaMFOzTiTd7EB6PUW8KTMEgRQU1ThH4HQPzxEo//9S3HUxgVY7VzBwHSWdNmcwlDAv36S5w/TSzNnm
-zCEm6lBPP30ucVv1NHlnzCn6vPNnFdqsF6kdG8vVqXaEfPL8Yq5iTVlngsw+/+0zDSOLC9xfzpNjHnKc
-xAra03IIvOB5gojaPnA8y6x5x+zOayT7ba7xyBnILJaQidYbZxOPi/ywWcgMhnPmIZrxVkSpsh/PQqj6
-zGYgM1WEGbORcSZz5iPjrXCEfvq8ZHb7M+WdMz+Bve4W+982R5HdE486wBca88c0fD1kVbxawwc1MWnq
-1xtBAH4M5E5LGwKK85nFy1jH6zD2XWmImBJEX1niP851kxzebHYtGE2GP38JtSLfPSeLp4u18ngTTBFe
-3pYtXHXLhX8B7/s1MAS2Akr3lPE5BRy/EN9xn7ovg0B69/rX+AmnKnD31PjHyf5bseHzJPhxEtIkM+t7
-1NVYouar8XUZNjnsZPIt/9xtzEy3wj2vuAnA10+zfePnGlVsUohXRfB+cCC1VhOVBOWEAL999LZDC2Nn
-C3SHCiRoBR07+A0PJXQWVc8ihE3O1j66R3JsDLxVvXu/5Eile9RnnR/oWA074jtu7NeFbXdfbvxrv2On
-f002uvR5ny7SDbXjOZbsLOco5Z5Q8hA5yYIjvagzECvBbmPuuVmY4EIFs9E3lDf1oEkWv8B8aNFxtqFy
-coMkClzEy/x1vDbP8bPCa+NFkcCLs3HPni15TbIwNXDPTzB0z7I3+GMUMNnm03LsWY76VLz+JFanKsq4
-eO16/OMxRaNK96JIkd+Pwhvk3wOWmVILlm+0LrfZuY9KYVYFPSAjqqK/95XnM8Wb/WDjbXYSN3/thxtP
-4sb781DEeE1dZ7dw3e3C9aC6Sl49MJ7m1zOUMd2zJ+YBfJur1hzvJvqWarFGvu+Ht9RiD1rqNg/X1uhm
-y5N4JCOHzNlWIehLJMdliod77m63kj3cRVu0Wgu8BP+m7MfryH0ik2ooezZlOc2vJytghldPyQH5NKvO
-HI8m+pWVHm3XK2e+/HvAMjd6M1yX2+yc5IdiRAvbwAsV18OJA4spPuyuduvnjczI3Qa7cGDEOzBmPF4r
-b7VwN97C3a3Fakm+i7lNc9xpVZ7utePkgMuSKszyV6xLWWxn62wPM8Xey1a4zVOhKtxoUBo/6DJybvDj
-4RC5zlbSAUkUGIkOq2WHnGDnOHK0kdUzchyBHe+2rIQJFfX9hfu8WmyVekq+y1jK7nu/GqY78aQcgB/3
-NYJdWVsFomWpCtHm2ZdUP9uh51RE9WlNdW6zNgkYZW7V/W4C6CPCwGRKDxztvNVqJbEKY89lAxphxTsz
-ZT6hdsMStlg7yZUpw2kd8dS6T/fiKRkAJ2ZVmdMdE8XK2vbcgxfPFn6vmuQ294Urcpt9ccym8a5od3g+
-BHJXhBM5NlP7Yw9tkMwsDpCDfI4Z78CE+4T6rXcLb/288HxHqqHkwITh9J54WvWne/AEesCBaU3m9sFY
-s8rwFz/Hh7nC7xWb3ObAYEVute94pLDVehWs5UZMEgcWk2ZwK2/rKeAs9lxvPTASe97q64R6+d7C3y02
-a7FSSqdbfZ3Y5U6r8JwOd4wc7G67KszCvliTCmR3AzeeKfZetsGtHa1ahZvMSRfV5TVithhqjJwBLVYb
-1k+loqYtJI+syUo8eQ+Xi7tpwVHmLzq+XITcBiZoEVbN9AYwKyfQFpQKzltynmee2S3kltqpjWWkjvd5
-hrIkzdZb7206/BKtUtiUxjO68KtwhZrP3evYailwI9Kva0/QqE5N8xvS7SvbQEXnNKb55rq5Od274j1a
-07t9RVwBZ0u99zYpfnVYKmoSyBpbcZZ4gs3pnmVzmb+mIWmW0SdoEVbNDY3oxoV0pYJzGtBc89zefO5a
-YB+p432ewS+4s3Xme1sNvzTNlzNpVX5srZtnCLWXO1bpBdZwUwFX7SfoDVDG/EZy07q9WKlZc5g5pri5
-bdyxnm+q2p0uIK3vs/Xre1sGv+QtFTVxxWl0JV1iCzWRu74IyPzhdqL7QjBBkbB25reWWz8QKBWcuUg1
-10I3t5z7PhyMVPM+5xA/JLBl83tbD7/SLpY0ZWQZXbwXWUIN556vDxJ3uNlovkZMUCColfmN5sbvEXLl
-5gwzM+1yc3O56zuFuYL3eYTw3YKtz9890nBL+kJBU8eZse8EAlOosdz1pUPkDjcW+MvHBAVCWpnfVG77
-8CFVbO7YMssqNzeVez6IGCt4rzvwH0jYl4F7Wwr/MYEvZ8qYMvp1gmcIjyg3flURGOsGE+ArywStAaq4
-ZSC54TuLWKk5g8gsQ9wxhNz8/cVUtZscIE3yrxf5wMvczwgdE9HPHcffhKsX+ZTFOY9R1VVgSmx0U2ni
-t/CcOb+hPA1L/kzHcHIBFyF/IM+/6laItScghjirONYt5nN8YPDaoYw648oYAu0/JGR0xxKfjDLccdDT
-fM+R89FdcHBMfMDs1+atRD+G56Yp8i8D9YJ7WaEaNZp39TnMEv4lf3xzeQhixJ8BomdtyPGirrZBddN1
-JxJbds1JECPSdLsm+tSf7HHw3QdpUNbCaz4uTk+R5EfgyB21idPfmbM/JXGMcv5QFqGxlit6cuqWinFC
-qNVjHcwCP6Xo0JCnitzw1z2eS1X4q9Iz7YNDgyrTkU5nqDF/mHDp+b56wzBNZYcAP3zg7xterlBGzsX1
-3kwjqbJ06LqF/koH+puUDmZRq4eysnljlZTOQva0GcrPpvBJNKYsi6LkOo4jBlI6pEXQ7Duyl+F0sutw
-11XQHoWpcL+kMcIdy5EPAIoQkTvhiPmnSd3Q+5YI1Ln/FiP+4j3XfwJPHEraIoa7sDsAsILISVvieZak
-3E6V+LwrC/UsxbtypOPHVD0Au3+B2zo3eKvq6qNuC1VzzH0r0s2oynZeiLBY6SR0tE7lRDBe4TLXf2lX
-ASmZ+Z1O51AeSf/Gms5Vu6ggqZ40T5ffZKmhp8E2Mxzs5k0lcf2NLDWjbDKgGshpgJBZsvYn3N/DrdSm
-a/QvU+XG/MisEtiPeu/5tSWXh2Uob/73j0TGLwsTTVecmQJrwEzSFOWXC9e5UOUMvT/OFyffkhhVF8ly
-/TAoYyfORtz1euQSMo5t0qBswq2AZGx1CbSOUhRU+7BoTi+a2ZQ61LK7uoBoAhOvyRNEZrNFMVGYlrkb
-d+eGL9Dhd+0ykVRKP00Xi6EHyTl0MLEQtm1LLGSYe4nF3LRFR/AzDMXl8Dk9xQkFMdevCJcjsPnaErwK
-TJ1biSKqRpbqDF1HofE18brffhK44OaDrFkbbn6YeDmCJs6KWhQ3AcUvfqLRAeTyubAzrhB2hvzSsiau
-LBdAHbyHzdrswvoSxH7sPbfkMBSvctUw43iAwlrd4/eSCodExcLW8Ajm4l7pvQMsFHg7wgEWQ0sj3stC
-bwopW6KMpijSMKgecduKGEGmboKqUQLI4Pf4lVC8PsiPbNCkqhs7OiVpLI+9IiV5JMt+acDyPIGNRqGi
-y4PiEP50GcYzgirYbErCrNJLk2RcdUyi8WS8FF3VdUII75Q5tF2XaSLf+7b0N55wHSOBjzRVw4MioYUB
-QwOEwLxIoJoMrFguCq8ELOkAK3bfwwzYQt730xQlXVtJpyH0RhplJdJYZnqcWCZUpFJiPw71jTFOKhSx
-oELnLH+BU6U4UaT18mGihpY7L1SUpn8a6bgu8mInMEJoO8CfJnSkP03oSH8ydqQdrO360RddPwTxGe2Q
-pEFwXs8E9j/mfmGCtKZOChJ3Vm+ldqa8TKR+SqFcMo99lGh3YA6LW9zGcdjCov2yGKft5Cu+jIsxh78m
-GykKWL3E635dy6VLe84T/DGEH03NiPTesZ51FxVqopPQYbA0viVzcv3ER7dcgG9YNEDhnRC00IB2XQHt
-9r+oNJ/U0Ig62RgQ1UsIUgBXqjMcutIX9v24VgQyUEUCofYeSqkS30+1x6SyGamhdP6VqXCQbtQ1xKIB
-8Gswp9zpmiwr094MEsfk4XvVUYEeABh1HvW48JqGJidr1YKi6d6Tm8HMR1prKJtXv6WP5Xy7Rej9pgvo
-PtFRA0GcLPV2WMrO0iwrsM7M07L7XuUpvDVfY8tIZSoDV5XUXARfw+/NCpjMaoK4Blrl4l1jv9VbXLzn
-VCEj+hnhJSyH3R86VxMXVb+MN+zL4NZyNRf+r599x99OCxQLhH/AC9DqJ9OPEYrXcaD5VCrrSgPzTGQU
-1vFfgaSeRLUa14/NmREsZvEFrx42jIAAO828Zn42YzubeLP11GutH9L7T7nK+4Z7vME68COLZsY1lwls
-+5kcdfPEWzKarDRjtJTiYI/dyJwlOdfRKCstJA24wuKW+4+F7tqF+cLXP7NB13Zf+L1P0B0Y0y7rmHDu
-X8P/wXdryPGcwTLJKv89VQpX8VZbpeGSbNOdOOys9g0sTHek0K+iYMnaEPHD7NVYHLwNiu18pFeH4x/g
-Z9juBWsbuGWoSw78p/n3CcKunkBByEMbXc0JvH9wxfn9B4M8XVcWdGLSJ/5dLwABKBbFKRwFBj++88ny
-nU9DZSigsMbaF4RUxnjM6R/GO4W7SqGTMHm//7lKP3+IgybY498/1N+O37dZ+hKdgqpGzY/n5rBbfFr9
-XH87Wm2W5vWP352aptz/8MPr6+vydbUsquMPnuM4Xc7vrG8Jev23ov3xu67P2Vm77z6t/vxp9XMZNCfr
-kKTpj9998laHw+E7K/7xu182S3+zXm791F4t/Wdrtdy4Xuclq133X/8vjrVeehvLWz5v19Z26fnWzvKW
-7vPqn9/9QBh3pX5a/fnD01QtdZ16g6osyYMGvbNFJpX1L7DL2lrzdqmbqviKRMs4lnda36xk1jXf6vlQ
-hJiZJd9lZ0P5eGIxq6/gOxs490N7iTuK+I1d0V5b9prrJKKkilJkVT9+t/pO7Cy0fmiq7Ds6IRktTXvu
-8H4qOp5FQRp99uhGl+8tr2yflDm7u6Rzd3EOb56UwxezDBXByMx6357EB3t4crCIdCWe5fwFdyf/zByr
-69NXp7Xaf1v9mG7RL9tEEZpRfFe2luuU7bylhR4vddPCoAryCHFwSU6Ufl+hLxHSXXE7J4wPhxfwXpUk
-r1FDgblXtsK2cWfrP2Gg7rMXrrdbuLtnjeuRwvd4FvQtSM89FKeeAe5ql3j8mp3TJilT9EW6ovbXTsNf
-8HwRP/74wf3w5YltRRSuXRK+l8NXrUiCT93mJqN0mh3XGLVlkMcXdVpGiOw6u/BNz13uXKjx0R2t4sf+
-fq+qfLnQ1v8kl5MeL2ITJ9vj7irG9bhy8EcA/XT6hn5HN5kZLsYzrT/PKmTvABNn7gI+w8V7QJOac0HR
-9LKkbxNCkRCfNMiPn1H+ZGDVT1z+rSpea/ThCtQQniuxneyOcmBmzkgyaeTQnHqZt0yrVcFY9fol6f4r
-rTQnpHLTmt5WRdkOY6vTdPFppO6d3xEZ6PkjDorkR3RRd1PTzSrmfc8PGZewBPLNXtLbPeaIaeziPLg+
-QEhFqtMELwmezll40UzW+W061GYjoS25Q0FEsa5peDbXGBR1/Iaz2QtkptLU28bgVTCYR3XO825UtJsq
-EE59Mi3zu6N5vxEvUNOu3JjOCMtGAB0GP5t94DaDz/dvRaB3tLRc0lwrc/l/j4at38Gio8ar399q9a3m
-qh9upyln/9kLUqr49YMTrZtc2WnxKk7RQXcYsTrmdC7LYe8v/T7idxOqObzz4NsjtrNLQ6X85Vo6s4uL
-JeEdjKdZmIRcGIXF8Nt0WSYjWsKzFULQBGEt7gbl0QNbMmeUFn7C56/E6pHtASIZrpvxLlTNhzo2+Go+
-tjLkppbGq0d6QxQlImSCnKz+L1xbgwpvjZKhqhOfehrKg6qwFI9wTcDAoutb/V88ix4KEE+jypuVb9mP
-2/EukzRVHQGyoUTZn9jh3pEAKz2vSR8hO+quj+B8dmyvp7I/hHAhO9UTFAOsbBLAoUJ0ScchvUIY1Emn
-pIEMzya+ob1LCI5V8bp3oRKbIGR74n/CP8qA+2JMmrZAQ/1COjKXB9/CoPpNNvNOvSOa7vhXdv7j7Td2
-iJpXhHJd/xcG1U/LLkeQ5KhaqEn2IT0n8eV3Xj1WFzusAm7rnbAIIi65uB685uIKV3sPewNGYtXoD7WK
-onF96ZA2Mtx0hPMG04mHTW4YWqksXA8kLvc5yq3rfCZNVJC6CZokopE+hKK099aL1gRN6XM+brO4NNoe
-BgM6rpPBv8f6melbbFl98LawSolTpKz0QeGQJh2PNkICdZjghJJck6VOcE5GOu9CbzGznURFbvxqsvS5
-OcgSiE5Av3GAuwOGbQGWeQtAZ3cLn5KgYYU+Z0FL4be/9ZfPu7J9ujDhyWozPtSmdKLgS9qdjjSaoew+
-4o2/3YAFDw6Nj/xXxatFd1yCiXMPh8rFWXyHBPQ3VfH6oiaZ+Vhj0YLGsmv6oiV0iJJ3/FutJ451vLq5
-BIPQfWcE9Oj/PcnKomqCvBH6di5Z14P1yIv2YOTgrlYI1hUJEAhw+u1mCzt9FhvUJry82em3G7jg39Tp
-s/gxTi/yme30cvY7nX629W5yek7of53Tc0JMdfrnZxd2+vRoUJvw8manf372wIJ/U6dPj49xepHPbKeX
-s9/p9LOtd5PTc0L/65yeE2Kq07vu8zPs9W1q0Jvw8mavdz3HAUv+Td2+TR/j9iKf2W4vZ7/T7Web7ya3
-54T+17k9JwTs9iL9b+lcehvMbj9i9oe47D3++khnnaOlW9z0X++jZgdlL1Oy0U1Y1hL2HuK9Yc9PpgzS
-jB6i4OOkTWIsmljN6E/KaJRMpNRJuJ1UkPyJgeewMnIg678/iR8PxkrjFtnHSOtT8WoiBBfnJ5mI+RVk
-HPEbhhCddoQdWaC5awOwsD101f3/uwm7SoHd6FRui/5/6T99R98RNPHjd16fkCY5ioLyx++w1H1yljSo
-SpMsaX78zqW7UdfW9uR5v6wt1yd/vdXJ84AdxrCeUNvMaRD4WGowy7A4h7HtUJIJzToOqq9g/9J/yIKp
-pOIBAvnqB5iZtiPxfH/B/uW1p89tEmmkMxEK204qDexSBD6ekY+2YzEUKfUrBkqhW1HptJ/8YIGBrkQy
-D9ChCBTuk5Hz765X6YS2hv/8y/oWUVlS1zKpiQj9i8HEUKcCUajNOgqq+K5PoRO/Uw2zROfltahiAu/C
-CgVf7e73xEDt/X6MsTjtnjZQe1fjn07S1hhHDj2GqZb48xmJ38WdrLa4dBq/louleN/WDaXc4US/Wuzw
-zhS2TCwZOOLNl22HRfw2uh2AfftyhaxN0qRI2v7C4uphgvocCjRkQ+kKjGfBeKK24esJU43u+Ompvh8e
-hSgeYk1oMN3+Ex87xOOBkTd0R4vI0Y/V04tuF5HssULpkFNRg+F94vS7o225ZftkAUmO5QgMv+f8Z6oL
-9xo+FEWj18hUDYjxqzXVJ0UBzt3v0XasSRoQak/2c4nhXZYbyKC2GGCFnG1mpNKlG2IReE+OuQyBG82d
-ZEe78900eJu+t55+8IdbYpId+U2OY97DydEUJZBT7sxUFtqOzVgaqQ1QINSP6QsF+jRtsTHibgN61PDG
-8bbIkCq6lOuXreGD8CCYupYFrGThJClQkS9Fz8QpqliTK753u/7j0wv/PHvMVzafyj2n0AN3AlOJSbjF
-d7ISCzV2m5mIaJPtBJRpUjE8vHG5vxfkZmdPuBMuQB4dPJkVqMfE1OJ7wcUUUtbVGLZKTuBCRorJBdLe
-ZmY91XFobvgcPccxxSmUgN5ml6bVGlgaqLSxMos8fQPHbmGUgnOM6UShHNHJDOBtLkerN1CicWebh8v5
-ApVQT3AoM06wJxlDPYbrmGZuZ6k1/+0sB6s8nC3nfr2CqYbJ0FODsIBOjkZGHMrhwg6Vkd92VJzzZr8i
-Z6ykNJlEzHkMyn53Ip+bTweSiqo8BXm9d19ek7h4rfcuWMWR08vX6zKIoqKKkyK3FN0XB7t5KxGvfZZ0
-0dx3N3j0BL7WxBkWwE1gpBPmhrDhcjl8pW+L+V2hII6qcxY+8OxOP+NT50su2eU6du+eeBpX6uIGmfFs
-9Hs54QJ9dBzLZL48C/q0KZ5h6Xeh/vBhQmF4+aEvUnuf692c6B2tYh7pCAw7x1QGxyTH2cZdQdpJrhgU
-tFsZHBH9UjwSFVA8vABNsd2ylc58e/7Yhb6ac+3svFIvnnTXjvdivu13kgtLwdOGssQQ5d6L4RCk4dTj
-wG/enmycT17SsThLgXMHM2oygJOhOB4+DqWZcdfLKD7iSqBOznMfQihMOPkkGY4dhxr4M6XyJYzel8zO
-iJLb3iYfOuMbJ97pxTUleZHNn3TRMszR5Ak6269GTL9idoFLm+kIqzE/UIqrM1Bdsy6Mhhneoi1vrKFo
-xb+p2Yy2GjI2BvHRPPB1+urUtZbD4Aiho7dy6OhpoaKlYx1hUCP+MnN59O+EJbdBSjtpwia3aF3UAYad
-xKQM8PKrvGNpo2xY2gz6Y2ednUEKu6ySLKjepp2kFLL8eqrQ4Ut/2RrwSv78Nv2mwY0XRay4GkVFHo/K
-yDCAlAmSUn55q5z+2g83Xl/kOYpQXZul9HbBdu1LWUAZhVe3SuiiLVqtWXFJfijM4rnbwAt3PD0kG5d+
-s2DuNtiFrKDXoMqT/HgRL+CEBpfIdbZSLkhC8ZUgJH+757ic8eoZOQ4rMQ7yo1hdIEe08gcLkxyQiMKb
-W9UYxp676sXD+2rGlUgvC+XyQPLxL+5RYIAcxKmjGjm0TULt8fSw8vr0mz0w9lxvfV3+45yFRVMVeT+w
-epqJnwdHEYEneivzSoda6rorFQ9mnEyT9tYCKwMpqqDIZ5M+8LrDiDvraCYuFC81DE2ZnjFmL/FQLw23
-7F2c1FlS10mYIqm+a449R2Uto7So0egnRE2lQQGl0dBx1s7OB2weRchXgG64i4NulBRYWSfhqhhK+nyI
-YpWU11Ivgbf1PUaoDIWr3Speu5BTemiFfBmJb+JdHCrMYBGjXRRGB5UYENJzvJW36UnFcdD1/a23hnqG
-NYqHgGSs1BXaRKHEChYwdONDqJBCOgw95K4YIT8IOpG/3jiQbC6KDq5sX4R8FPJ8YMGCMI67Lo+jg6Ta
-eNGql0oaAXf+Zu2s4enVKoolwQ4HhMJAYgXLdjigXeDKpIB4/mp1cHrxxIFv67kRaNLDLt4qJj34EWdS
-wkkjnBs64VaiBGRbP7ueux06FW7U27k7d+dBoqHuH1m0+BAfkMAIlgxFKDpsREJAsM2u+2eowDDYuaGL
-PKih4jb5LLeCTbSLAp6Ppgk8h2GIBDrI09aO7/jXP7Jl8a/o7VAFGaqtsiqOFaprOwwqu26qpET15VAV
-2QUKRO+SCIFNAb51LOd6/eM78l4yjuNre3zYL/lqeW6mvPXnjOhseYar1+PjW8y4IPVFxjzz7zOi60R4
-bCeaxijFWm5qCwU1YgCmjiqEcivIY+szOT1S2xWKzxGK7aygGKP7+XQR9cNxpmeZALeI1c203eQ5qOxj
-p3uUN5/XfoyOC2Crrv9kef6nBYdOlN++80mTU/9mK/GQfj+pMRiGEDVCDYM8yYIGxf3XLJLQKQRqIJZb
-W6TuVpIfkjxp0MvsHNclttrsK7lMN/dSnvJ2SbqZ48ptr/utY75c5a19doBz87ustJd/9fAP5sEmHZq3
-wtSDZz4++2CTMA1n8fOGdirHgkpLXKZ/nDDNA0jQr2lfINStje+3ZdiwK1jZqfqA3QhKRUCXkD64uC/w
-ZywpV78Cr/KbHFgcb2uX+VL/Ub7/3PC5gGN9SM/1SdlK239K7rdscruz1LmpzO+WneYgn1t2jkPdx9Q5
-IJvuafLP7FJGcgldjVYkNq2cx1z6mKrzEVKm5CNdolre9Hkqm5JqOcxU42g+QZFasdjkdy77SapkpQqq
-JIlAiRNn02zirMk/V43mXIIStSKxCfo85pNUyMoUVEgS1fImzfjZ5B7KPFN5pixiO9YJw1YQZnCe1ohp
-gWIjxolqYdOXJMjqgyb/TOWN5BL0ZxIJr3LMYz5JhaxM8YYFnKiWN3XZhK6QwNlnKtCcSdCfXiC6EDOL
-9yT1sSIF9ZFEtbiJKzt0EQfMPVN5xjyi7+nEYUtFc1hP8zxaouh5OBGy1IS1J7bMBGWe7XX6LOJooROG
-rWXN4DxtqKAFikMFTrzSLwgkiifGr8K2E1++WFrdHdADIYcg/n63Uzc3cnCE5f7amKVPC2RKJD8kaOfA
-U8ieybbnMmufVHhumiJnn0zo/M+ZFJ/T0V1kcV1mRRykdlGi/CKts9F3w1T0kLQoHjnd1U+fHN954XdG
-KKt43IUggwwWLZMR221PzlLeaHgTkilOgrQ4AtNlsnaAtzjR239oLBh1PxTltTwEMbJEvtzqF9MgTjoU
-VWYtV2SNzS7ODb8CdyvFYqQI5TXhkwYN+uwsbM//9PRieHfLUuAUxdBlQUJKYp8LpGaxHZ3MzpNoY5ss
-i6L4gRfVcxc/k4u5HOeTZVufyZbPP1jeUy8EXbn9LU6e81f2iN5q2rEnnTsfAhOPHDz3nqAP32rz7AqI
-q6K8tT9YO+CcVOGPvY2/Sk163XnYhe+QyXt6APqRK6Z3hTx31bO33HZf8fuEcTsjvP1QrLYljQrc1oe9
-jRe2+f/y/Sd0At5Rdx5SGxTxG9AAJp+95+Smp7Mf15RFW6E8hmOkdS8gE4nnvPtlWV7Yn9QjN8LOZLbc
-COThDuWIh63FPHVUFWkaBpWdoaA+ay/vtp+fn5/LlnYUvlP238vwcz/SEn6GPSxCP82FnHac/ujrnl0E
-qo66Q4+s60dZXqEnrTOuqFVXlCkgKcmT8uLtSJ5lUxRpk5SGq/JdZ+vId/2zqwAxKjwEWZK+7TtYlCK7
-fqsblC3+LU3yr78E0d/xz38v8mbx4e/oWCDrP//jw+JvRVg0xeLD/0DpN9QkUWD9FZ3Rh8WfqiRIF3WQ
-13aNquSw+PCnjqn1c9fTWX/Oin8kHwY+asLf37KwSD9Q+EqPMVRZkAr4de0orZP/GtI5Iv+b9GMgHOXB
-7pAwjMU4LUVNN/x0XVzXYqhAOBwKjoQipMhUwvdLnET29OKMpJ9Q9jyDkVa4wYDaXBoFnvsX1jKoquIV
-8Anokvfljrtiakl2KYmMDNfo8yHfRy5SIubEfct1GdY2LcPulPBra5dpEKEM5c3//rEpyi8LnqTpRlz2
-sWdNb/gbZUGllzkx7QxL51M5MT1oOA5nfzAaEO+PwlJT2V/ULRAYAxjlwH2lqBacNMyCLGq8CWwg1dAL
-folyKHLhhO89ZDezEFBrfGG93qRPL6rmJP0RRDBZg8Tgogrped95zkUZQUokr5gW6TeeGcxAZQlMufvM
-xc+DNE4Tryrpu+BkTXXWF/XUpcz0NMwE0lH3gmmImfxGR+PLAFXHldUrTvykx3sZqDwMSnnVMd5JnuO7
-2tiQjOMov4DnWox7XMAZgW7bTlHidRbdblFlxrFRx/5e3g4A/f8Q4P8eCPCbTHp7H5uHIvh7KpfcNoyu
-s4DY0luJF0qyFmqIpd4BPGiJJuDBSDrgIR3+91mvZGbDd3wcNwl84IkCXWn5ngTE+oNlu0/TC+j1OD0D
-102qkkH8AIijXo5Jejo6dj5GEs1AN8AmwZv9O7Wm6OAyLFm8QFuQDyPlcYiNERHEJkyYJ/iTitkEfgJm
-03nUC2cq4JZo0vZHrScBuymup8eCUCUgniBW1DrgNDecKxUIHXj8Oc8VR/UI6OTCrkIH4e+4O/Lwl1FR
-+MsFdpzijwD+FRny+PfGLk6GxVNczQClQfkgrjqsLUJs2d+ujxYMmkkKKH6ev41rE1LMhS4IwtOIyR5n
-sTGeBXAx1R8mNeNd3/mkxyRiBEeKxmXsoC5OH7bdPyMVHOZJjAbPk8TVzQn+oUyVeHbiVOl9+3dxPjWl
-zWlnYEAVII7QBA3o250ZrW2eSPDaAzfpm9fSxjSoqoMWzbUzfsaJW5nYKC6moCw9WOJ2ACjnBKGZBPZ3
-w8easPvHHFl0NTmw6IoP8Ck1eSigwNBLxG9w9YU95jjCVXGuUap+qRne0Qm87rM5/vaobAcYMpt3qONp
-4+QPN1yJ5q/t9ESL5jv6pLcLA1v2qvOPQxAh+1tSJ2GSJs0b24NgeMVyl6iqSxSRa1sdsiqiJN3yJV5U
-vvr5XXhv56htFlJaWaFvUprmvulpvHQDE5RfSuraD8RSSiJTBsP+gf/nM7x9oEu//rE+l2VRNbX1+bPC
-g86MywrVqPqG7FX8ZBWV9dlEIFvhPWu2ikm/C1avf4lD03UWhNgvAGnNyuwaoUaf+NW7qPRxFVjFWEyT
-2vr3Os3p7GdWnG3QnP0bq+6WGqxiIqZJdTavu5492Rokdk795zquM7bjM115XG5qPr2sihJVzdue5jLz
-ntDkoFy6FmjOxnpHVh9XkW2KAQyUpBfgvm4+lr3U4evUOUF30/T0u+yo/7+myckDQ8+4m/JVRSpLyJJN
-Q/mwneu9NuqYz0dTbOh/Mn9FErbnQtXut+uCL/H2XY1mtDnxy2mRa4ZoivyGBdgQXER0VVI2U9O8xtca
-aUQdvene43YR4WfuGnv+HPX8K+1NAt11B9P4fUvWIUnTH7/75K0Oh8N34qVNO2vH38cU//jdL/7S8y0n
-tdcW+cdd+nb3r0f+tehfm6b/E7hISW+Y31ldveUW19Vd+l09La5++Jmlr238j7GuSR4nUdAUVQ10JNLO
-ULfzLflTrT+jf5nQbcDHzdmtDp+kSxw+yZFjwZpZaWLa+ugIWx/7X2yT29C2VsOlEpWcgAXqfuNupNNN
-3uDNfmX7Ih4G0Nxfo1zRBVdkZGnR5m00Ehy7X2/rsgyLi/qSybqPWnB/ZP2dymZDq+b49dBugxLHHtA5
-cucvLJpYL2qXOOw76P2vU6bHbYyw+YymEe26JAMni0J50USnHO6GZTmaopSJm6JU6bIkjlOFL0lVqem3
-ElkKnArI0FUHzsK90uSDKkDT+RzhsT/lrTsNz5EHHD0d0YUkggDoARuFVE0n474u7KUk53CUWhfyUpa0
-z8HLOiTK0srk0BudxCQApiwxPbGsC36pyEvoBWlpkiKrQKqm6+QkYTAlOfGxYF0ITFnIjpiXEP+WxeOI
-pEStYDgMpiQYO3iri38py0bpefFYkiyhSKqm6+QkYTAlOenpVl0ITFlMQs5LSVNkIQVCJVknIgmFKYlI
-TpDqwq/IEmJqXkCSIMvHk8mpWgXiMJiKAquvF10ITFV91VdRedVXQHU9kZSo9UAcBlP2wFPSaAY7iZID
-9xfTWUIhG/7CcdGFsVdJpetuJmUhqyNCaJIp2aBrbCZlxN8v+dAnUzI5TAkOXOmegAQ90VVzIKNwWVux
-gZLNzTVVGQgJmgLI+hEUCBsDkHMDmZBBGcT6DGwcEciVMYSRk+5cPOUrd+WMtu9hpTCKUu/KyFlHJwbT
-lDu5Xn285+n6m4F19VWiVZp/LzVplZLMgq5x60PwLVQA3fg9UkOmkbhMEHehDY7mG4/WBJUx9d4pbTmm
-Og/F8M17qqZmFRAlVZQiyXC+8wmideTbe3iiKEVBdUhaNlmSdn92bzvsfRLmPLHdTV2Fb8c8z9gmkyhp
-CQgiIfMscK1IJBfpAIImCNOhRPwLILCr4lUk6lIgwgilqUTZJYmk3QQcOlfIWZdfWAA1oPDg0mFWHAHH
-UX/yLrbrbMxidTbFaD3VZLvV2bjp6mzceoxmigF72kk2rLP7zDjo5FGWBEy53eyoKbPRxpdNan/Z7CaY
-TWiF2YSGmM1oi9ms5pjd2SKz+DcwJTtsGtvpccyU6XGKKXuqyaZMj+OmTI/jpmQ0U0zZ004yZXq8z5SD
-Tt7RlC4+ioRt2aZjtmzTKbbsqSbbsk3Hbdmm47ZkNFNs2dNOsmWb3mfLQSePtmVZJXnTmQ8/jFmQEE0w
-Ik842Y4k06gpCdmoNTmyKQblySfZlGS4y6yClh5m2SXKwm4ygeqyyOvkGxoNb8uHQOkjHSkbEmW2yvWJ
-8nkpNYulpOCv1QuVECcA6QmOlg68KMJ/oKgBXnxLYlSMf3IXziGL3zv6a+f3jlol23PDt+fhGw/3MWLt
-LXf+1l2vPgHZ3I0um79Zej6UZR2+rcAcW5DcDd9ckJx8J8af97qGAQTMEVrHi+kl5UNi6Vy0sXf03OT3
-g2B2hb6hqkYaAdlro6AqkSCwqQiRYqwC2oJeq4C7Dr6/N1XmB7ygDEgweokFSQSZyK84OdTq9sXq6mkg
-oIwPSZpelKA0Eh8gnWY/dlZyBolIQ/2G+En6S08pTt0HBi7AwAUZuAqD+lQl+VdehhwdA1gGQgtIQZm4
-ABNXw0SQRPq2TkIiXcTP8DiNYwaG3JGIVM4ojy9K+J4xrgKJypN8R71AuwYMnGUClS8N83QBQ0MZOAtB
-okwFBHiJSOIfJ3VTJeG5QaNFkPzqx1W8B0s2IndCnGMMR8PSsRSsRxiK5lPYIb18stn4j+IalqrNeIb9
-F3SJpfoFXWCq/8DO9FihJjqpmsTJGqbKW8ZT08QwlgLbmZDNaCOwjQ2MIUuZmpnIVLbWwFdjMX07Exkr
-rWzgrDY1kbW2oYklyM1sKABsa1AZupY2WFPyEd6esJ/0Beg8pUbpAZ+8GriSaKrY/aRhbsiylwc6jpvk
-dBw72OswP5PLYa6Cv3E8IYcbOILehvnJrsax1Pga5qpzNMxT7Rs4rtoOAvPV9w9Up5LpBa3Ctsd8VcPr
-l5CJDbKHIeY6ezxoJuK9K27uxf4NoHOd3Y+e8br/QwA0leZdMHSd3Quj6+x+JM143AWmswfh6ezxkDp7
-R1RdZ+8CrLvm9k7Yus7eH17X2Xsj7Ow9QLZszHtxNmDFu6F2Z753QtvZewHu7D0xt2y0R8FuwHgPQ95Q
-G3w4+AYa4Xvg7+z9IHhXg8ei8OydgLjshA/A4oD/PQKOg/3HwxB59khQbtgNgDln8cNQeRY/HpUT8d4V
-lfdi/waoPIvvR+V4C8dDUDmV5l1QeRbfi8qz+H5Uznjcg8qz+DGofODzMFTesXw3VJ7F74LKu+b2Tqg8
-i98flWfxO6Ny1aaPQOWyMe9F5YAV70blnfneB5Vjnb4HKleN9UhULhvtUagcMN7DUDnUBh+OyoFG+A6o
-HPKaR6HyrgYPReWqJz4IlctO+ABUDvjfI1A52H88CpVDzvBQVM42dhI3Oz4MlafHx6NyIt67ovJe7N8A
-lafH+1E53o37EFROpXkXVJ4e70Xl6fF+VM543IPK0+NjUPnA52GovGP5bqg8Pb4LKk+P74bK0+P7o/L0
-+M6oXLXpI1C5bMx7UTlgxbtReWe+90HlWKfvgcpVYz0SlctGexQqB4z3MFQOtcGHo3KgEb4DKoe85lGo
-vKvBQ1G56okPQuWyEz4AlQP+9whUDvYfj0LlkDM8FJX3Z3Qw6zZ9GCxv08fDciLeu8LyXuzfAJa36f2w
-HB+seggsp9K8Cyxv03theZveD8sZj3tgeZs+BpYPfB4GyzuW7wbL2/RdYHnX3N4Jlrfp+8PyNn1nWK7a
-9BGwXDbmvbAcsOLdsLwz3/vAcqzT94DlqrEeCctloz0KlgPGexgsh9rgw2E50AjfAZZDXvMoWN7V4KGw
-XPXEB8Fy2QkfAMsB/3sELAf7j0fBcsgZ7oDly0NaBA0Ji4Mf8c2RImboCEgIIEKBn1USfEabUEgntE27
-17ucdTYuQJ1NkYGFUwHFMO7X6XJn8bgcWTxFDhYLZKocwxcKbI3juBzpcYocLJDFVDm4OVmXvU3HBelm
-RuOCsCgMsCBLdgy66wGaJBqORZPfPL+elB0aV4+Rg+TsgLV65BokPyQtigda/BMkrJsk+vo2ULKo7SSd
-a4hcncQ31yXmTi75Fgqkh8FZ8GTlbtuVw/Kya5zF7FLUZZgDH3hfUwsSbF+qwNPTZUmeRMnFrHK1aZUG
-CTznel3WlV3k6RtwHJ765RA62eWuGFaiAbzgC2C7STC9sMp5kq6f7SaL3LF5WrCNYy0GYYr2JELwAniD
-n2TXpALiOM9UQvzcC4ZvCUoRkYyEg1buw70uyf27dp1dwqJlt/E61tKllyiTP/xNXM7Wf+J9iOSRsuNc
-rpzVhXLa6VHMjLOtlLxgsaRxc9nlGB2vtudfaIx5/5P4xnfoGylM2qu9ZXm2ch7XYZlcR86FAc1gFv7l
-qRODxbcXeZ46OegrSZBTJwh9tZVzdZJwIRnEl1gUzi34txmpxXAdtZw9O/UEmgIy27mwW4aF9MZ2Fsvs
-rX+txp3MKkzSDiRAzMkslPlA4SazVGalxprMbJdJqsbOyxrbxcW4wkWcKl2F6dqBjt4wqFKGMkd2e7FK
-mspMybW0KqHt9VUAauDh8jzlKlGpAh4uywOuSJTkl/jxty9L4kssh1t1RelXTHpXFX6FC1vxwruq7Ctc
-0EqU3VVFl7ix6Kuq5BJDEm9VEXzdCw7pfY0LWwuiQ4pf47LWkvCQ5iWOTHxI9RJTUgFA9z6rwkqtgI+L
-8/kKrFTxfVySL4q/UoWXuFHhV6roEkNy64BEVtpOf9mg0JxL3MGUb8N7tYcpcQ9TthwN0MWUocIJ6mPK
-VGGmdjKl7Q53Iyrtt8S9TPk2EGm6mRJ3M2XLEer6mTJUeGo7mjJV2Gp6mtL2xDsepWp4uEhPrAZQCw8X
-58m1ACohc9T1NmWqMIW7m9Je9VVw1RqscHkrMfyPWoEVLmslVcBV5Zf5abqcMlVYgn1Oaa8H6SELrHF5
-a1F+yARrXNxargFkA5mntt8pU4WtpuMpbb+vx0qthY9L9IVarNQ6+LgwX6rDSq2BzE/T+ZSpwhLsfejp
-KtpzKvCpwa9xd8fR4UootBWjbQXaCgZmIcyZVkchT2HmuE4SsX4lJLNrA5rr3mF5OCIQ01HCViCEkR3I
-U4PvQLYQyqvHgF5HwEodh3uUuhWoDaAP5G6CfmABWgBYj2DA7j0rfhQJUuJWINbjQZC3ARWC7HXYsDbD
-w+41K3sMJFLaVqDVQkWQsx4wgsw1sLEeQ44dASt7HD9S6lagNqBIkLsJS4IFaBFlbQaV3WtW+hi0pLSt
-QKsFmCBnPcwEmWvAJu5cdHiTdEHlm0AFok5K2YqUMPaEuWoQKMwYwqG4NzFCUdLxlG8CqR6QUvJWJDfA
-Upi/CZzCRWghKu5WTCiVdEDlm0CpxaqUuhWp9YgV5m7ArXABOvSK+xcDgCUdUfkmEOpgLCVuRWItmIV5
-6yEtzF4DbHHnYsS2pB8q3wRSPcKl5K1IbsC5MH8T2oWL0GJe3NMYYC/pkso3gVAHfilxKxJrITDMWw+E
-YfYaOFyPImJKwfrnCbh4yNHKObTo2FCKHiMbCoKQsuFjXWZnsR4qd++wZBwRCJUpYSsQwlAZ5KmByiBb
-CCpn8QhU7ghYqeNQmVK3ArUBKoPcTVAZLEALlbPYDJW796z4UahMiVuBWA+VQd4GqAyy10HlLDZC5e41
-K3sMKlPaVqDVQmWQsx4qg8w1UDmLR6ByR8DKHofKlLoVqA1QGeRugspgAVqonMVGqNy9ZqWPQWVK2wq0
-WqgMctZDZZC5BirjzkUHlUkXVL4JVCBUppStSAlDZZirBirDjCGojHsTI1QmHU/5JpDqoTIlb0VyA1SG
-+ZugMlyEFirjbsUElUkHVL4JlFqoTKlbkVoPlWHuBqgMF6CDyrh/MUBl0hGVbwKhDipT4lYk1kJlmLce
-KsPsNVAZdy5GqEz6ofJNINVDZUreiuQGqAzzN0FluAgtVMY9jQEqky6pfBMIdVCZErcisRYqw7z1UBlm
-r4HKLAiAHipTCtY/T4DKQ45WzqGFyoZS9FDZUNBEqMz2k2V2etRD5e4dlowjAqEyJWwFQhgqgzw1UBlk
-C0Hl9DgClTsCVuo4VKbUrUBtgMogdxNUBgvQQuX0aIbK3XtW/ChUpsStQKyHyiBvA1QG2eugcno0QuXu
-NSt7DCpT2lag1UJlkLMeKoPMNVA5PY5A5Y6AlT0OlSl1K1AboDLI3QSVwQK0UDk9GqFy95qVPgaVKW0r
-0GqhMshZD5VB5hqojDsXHVQmXVD5JlCBUJlStiIlDJVhrhqoDDOGoDLuTYxQmXQ85ZtAqofKlLwVyQ1Q
-GeZvgspwEVqojLsVE1QmHVD5JlBqoTKlbkVqPVSGuRugMlyADirj/sUAlUlHVL4JhDqoTIlbkVgLlWHe
-eqgMs9dAZdy5GKEy6YfKN4FUD5UpeSuSG6AyzN8EleEitFAZ9zQGqEy6pPJNINRBZUrcisRaqAzz1kNl
-mL0GKrOT+XqoTClY/zwBKg85WjmHFiobStFDZUNBE6Fyf+Qhs9tUj5XblOJajgjEyi3bDcsTwlgZ5KnB
-yiBbCCu36QhWblOKZjlKPVZu2fZYntqAlUHuJqwMFqDFym1qxsptSvEsR6jFyi3bO8sT67EyyNuAlUH2
-Oqzcpkas3KYU0XJ0Oqzcso21PK0WK4Oc9VgZZK7Bym06gpXblKJZjlKPlVu235anNmBlkLsJK4MFaLFy
-mxqxcptSRMvR6bByy7bj8rRarAxy1mNlkLkGK+PORYeVSRdUvglUIFZu2W5dgRLGyjBXDVaGGUNYGfcm
-RqxMOp7yTSDVY+WWbd8VyA1YGeZvwspwEVqsjLsVE1YmHVD5JlBqsXLL9vYK1HqsDHM3YGW4AB1Wxv2L
-ASuTjqh8Ewh1WLllG38FYi1WhnnrsTLMXoOVcedixMqkHyrfBFI9Vm7ZfmCB3ICVYf4mrAwXocXKuKcx
-YGXSJZVvAqEOK7dsu7BArMXKMG89VobZa7AyOy6vx8ptOqBYkVqHlVtu/7CUQ4uVDaXosbKhIBArLxvU
-NnZW5AU+q3g5FHljH4IsSd/2f//3X4q8sP+Gjuc0qBa/oDwtFr8UeRAVi5+LvC7SoF58+EsSoipokiK3
-OvIPiw8/F+cqQZX1V/T6YdGzpkXRqAwX/IOcZ1cCNVBSGnlIPdupUDbVOY+CBl3ko6L4bZ+I0jQp66QG
-jotSRvgkNCeafBwavyJHoTkq5Tw0fkfDDnB0SkQB/VZxnIudmR8TqD83PyrTcKGHSSzDvhziLvE0ufpz
-9KNyDSGNZ8rFPoIQ6x2nydWfqx+VawjqNlOufsaJ87Ez9mOC9efsRwUbwloYBaO1KF5RFQU1utDWEuT1
-oaiyff9C4X8uSzhL/0L196BMmiBN/qnkGd4IB+K7ruYVH3q1UxJdYEjZrxxHR0zOUwvUaz11WKSxQLsF
-aLF4ESGrm7cU7UmKUkncc1yiIi2q/cfD4aAQlFWSBdUbI3GcbShQBQIZOW++kBJPXY81cNh4kSpIjaIi
-j7mSNtHW38ZqST2hWNaQLJTmr/1w46mlnaMI1TWj8nbBdu0DZREyqSSaKJTjoi1arZVykvxQ9CTbwAt3
-aiEdjVgCThHZu9tgF6rWC6o8yY+D/SLX2aolUDKxEJYolBOvnpHoTpg2DvIjRxStfEhbhEoshaYJhYSx
-567UQkibYVXZHZ4PgVoGJhKLIEliNQLkIB+oRvWVkazWq2DtQJWovspVqL5K1og911ONHRZx772e6/ne
-szounBsUaz2cskmD6KvtO5SMD2QghjEYGrBE7fn+gv0L5TklMUFGe+cHxwpeSFbcv5VBhfKG4AwuLMJL
-GERfjzjmk61SD6EpaOCIC/6bpEnzxmJJ8EIkOUBHEI4KJsoqyZvLHxb7fXBoULXY70N0KCraKYOhG170
-QR2CfV40n5dhkz8RBjGKCgL39uc8RlWa5OgahGH1a5M0KfpCi72w0FQfrM8frKBpqs/4/ZP14enDtayQ
-AOvKCtkSsAvTIvr6f85FgxYdNVWZW7ZWXaRJbH0M4tAP45cyOCI7rFDw1U7yOonRPvhWJPG1OaEgvsRJ
-XabB274JwhTZXRKq7M4u5TXJjoumuujyn7zFabUoL0VVnoK83q9eXpO4eK33K/KKz4hrTPP9sUu/1Mk/
-0T5YXbGLS2iFt2ynpSDJUWUiyoNvYVD1deksdF2GQXyE1OI4znWJq0tfdv6XBmWN9uxB8O+O0mriBXs6
-XRTXVcY5rEzCHcVDZi7pBEgWI+ShjcqI62WS/ISqRHhjNZ0Ov8f/XQjpsfjzJP1EAScHqwmRgVV7ialY
-BlGGFzDj9b/98IePVl2cqwj9EpRlkh//829/+TEsiqZuqqBcZkm+jOp6mQWl9Ycf/t8AAAD//31+z/2C
-JgIA
+H4sIAAAAAAAC/+y9e4/rOJIn+v9+Cm0eHNTJLsslyZbttFGF7in0YAfo6j+6d4AL1J4FZIm21anXSvJJ
+ZRuez37Bl8RHUA/bWV17caemT8pkMBiMCJI/vn/4w3//b9YfrH/L87qqy6Cwvi3n7ty1vpzquqi2P/xw
+RPWeR87DPP3hGdP/nBfvZXw81ZbnuK7tOe7G+p8nJPD507k+5WVlJH6L6xqVM+s/snCOif4ShyirUGSd
+swiV1i//8T8FGeL6dN6T3Ou3ffVDK9AP+yTf/5AGVY3KH/7yHz//+a9//zOW74dtmef1xbb3yRltPznO
+en847Gw7zqL4mG8/rVauc/B2tl2cyyJB20+rw9ILXRwQZ6/bT2izQJtwZ9slirafonDhL/2dbedlkB3R
+9tMhWiN3ubPtd5Qk+dv20+EQus56Z9vHEqFs+8nbBGuSokZBsv3kOeHLC44O34Ns+8ldB95+s7Ptt1Nc
+Y3ZEtmMZvG8/rcK1v47YTzsKytftp8VyESwdLFwZp0H5LhSoQmGeRUEppqzOYYiqSpAizg65mG1QZnF2
+FMSOcLlKoaQJNtf202FzeDkEO1sVZF+i4LXI46y2m2qrhFTp1l+vikYOTaPterVRQ5Pj9uXFU0ObZOt6
+jkOCD3lW24cgjZN3uwqyyq5QGR+2dlAUCbKr96pG6ezfkjh7/SUI/05+/nue1bOnv6Njjqz//I+n2d/y
+fV7ns6f/gZJvqI7DwPorOqOn2Z/KOEhmHdPZ058wU+vnPMlL689p/o/4qeOjB/z9Pd3nyZMiZJpneVUE
+Idr+/d9/ybPc/hs6npOgnP2CsiSf/ZJnQZjPfs6zKk+Cavb0l3iPyqCO88zC5E+zp5/zcxmj0vorenua
+teyuf5htt8EBV5rtdo8OeYku+7yxq/if2Jj7vIxQae/z5nqq0+QiiLTtirhL4gzZJ0TM685df2e/of1r
+XNs1amrMC9lB9I9zVW9dx/m8s9OqJyb/hspDkr/ZVf2eoG0VlnmS7IOyYxoU9ik+nog72SHW67Yug6wq
+ghJl9fWPmMu3GL0VeVlf3uKoPm0j9C0OkU1+XIOyjsMEzYIqjtDsEB/DoMCqwp/nEs0OeY4VckJBhP8c
+y/xczNIgzmZZ8G1WoRATX6K4KpLgfbtP8vD1us+j90salMc42zo7UU+/J6cicmGtb90SpfTnG7Xb0nEU
+O/o7qttPnuv53suOmCxI4mO2TdCh3u2D8BXrJouYEXCbc/21DvZxFqHmxyfbffq6PeThubrk5xoz3zr/
+PU6xWYKsvp5K0dXCPKtRVmNf2zERnB33he23uIr3Cbqe3NnJm50Ws9NydvJnpxXTuV3nxdbZsR/7vK7z
+dDv3S5Reiz4SrIZrsN+Xv0ZBHdh5GR/jLEjsOq4T9HVGYuj3hRQ/QmFO69WWdCm4ULK3QxRWlNc1inaD
+BOG5rPJye0JJsWvrHhHUuQZRVKKquugFYGYl1SXLyzRIJEvG2QmVcX2NklmezM7JoD7yxMoxrXXG5BZJ
+ZHXpWomi+iJ60NpxrlF0AWzAM8Fus3WupMb8n3Neo7bGWI5Fso4O2UUoTVwHSRxe97OqLvPsKGW3z5MI
+ldcqDZLk0jn2xvl8rc77WXUuLkVexUTRJUqCOv6GhAqw9j9LWnJ23xBuF4KE+fg+qBAmwNwurDT23PNR
+esW8sfbsOf4VXJj7sw5UtXKWZ0ivLEKLZXIgu3qNi22+/wcK6+oabE+4OnSZ+av9wuxS12Cb5fWXX08l
+Onx9pt+8an59ZlyYa4Ai9zOg9XrWTyMKfG9WXRNyDfMIzV730awo0awK0kLqlx7bQYpNJm5MSjRUfbpG
+KzjXeW+XdqUdjlYL4vR4UbwxjaMoQbxR4HUde+e3I1EYAafPlzbzUxxFKLvWwT5BF5YszJMkKCq05R9X
+1vNdiiCK4uxICjVfkzrLg3hFpqHM+xguVLsExs7GHeuWJrzWp4tAxhujJNijpO1D44xURdIwgI34/lzX
+ecbLUQZRfK62DgtWHMQtGt6e8iC/aCxsDYvXNJLCLnH5SIkYp1mcFed6lhc17fQrlKCwnmH5gxIFcAfP
+PbvzFB4CtcJiRhetf2OxNF+qN9JQHPIypfb+tX4v0I8lqlD9dUZ/VOd9GtdfZywxRmoWjaEhXy+82EFR
+oKAMshBtadRVottu7TT/J1NOnGWonInZGaOZAEA8M5AWwR1u6wAuTXRDOYcnFL7u8+brTAjE5s+/wjh1
+1zIW2URBjb7OlIA6TpGd5GGQSFFpntUnKQQTgjpM4qrG2Lj1D7nql4h4A6/J10OMkqhC9SWNMwpFt04n
+7651LlqarXNN0BFlkYw0dzQhgctp0NjCT5WV3AcLDRkNALxzJ7fVZChp03EHBRbXosyPBIaYekuqsuyc
+7lGJPYJpjVjdrgosFXVTA2F+rmXCCxMRq5Rxr1BQhqevvMbb+eFQoXprkzGfbiah3rCUXXY0wA4xYSKL
+ZqLtOq+LKbMuzSFOkH0ukjyIeHmwIVoVm2tmfq5xEwE1kdfqnOIxexuJHdGOa5RyBEmGvKi81igtkqBG
+LSXVBe0cvkqhAjKfn9zZ/OTN5qfFbH5azuYnfzY/rWZG9K07GtQ2MuDmayMNT/Y7kv/JFUCdR3sBLNPJ
+E8Np6GJ2WlxE/14z8uXstLyojn/FxTn5UrjHIla4UPIo6TpPUBDp1FKZFo5znTNt2qLkK4BSLX2XUiyb
+P/cnpRU1sJyYVlTSYlzaUykCIVdA+SIW4k0Z+6C0RWNVeRJHVnncB1+cGf5v7j5f5wTLzwBEr45Ur/M0
+KF9n+J+2J5l7KNVh9qdDeNigxXVOqsg5I91MxBOxEcmORAo9EKWmVW4KLamDcJXVyChqS4KqtsNTnETP
+XJ9kVpPhnnmcxXUcJHGVChp5cT7vFGxwLgpUhkGFrnNteAWMFSWv7xLYdOpD6XFkU0gIEEjcziOxEf32
+6X95jru0/pfj/Ml5us7j9GgfknMcXZTuS2znCVV9Oqf7LIgTwcZ+CVv5cOC+1rnXpwghD612MmpkPPoy
+Z7ActiONtDFGh1o/qZ601BxnyzaUVYmHNaLbr+d+S8Lmjt/yMqJzmlvyr40DrsFPJKnchr7uI0FtJUqt
++VK2v5QBUaCmVjbzoyoQe83rPrLEPBzRsxylyuKpgULQqOZXoig0U0xvKSoxoJROKQykzIsS2XSURYY/
+2NbMJIslngFuB2TvbDB2nWNvDWIMTXVExaqk6xfNTmoNSIhUbQnwE6c7iEf9MUVRHFhfOuBHJrOfL0K2
+nUP6WMYrkIjMdRsSrT1DIjIVbkj0sjIkojPlhlSuSwXsIlmF/gDNzcv8rXUbPJY+JKjBOJ+H4d87HmG/
+lUGxxf/slJ9SVraYO8mLhFznWW4fz3WNykpujB1lBksg/Gke5slMDPg1TIKq+sOPYZ7YXy+yIhxZC86V
+psakLvvjsL/8t0f/sj8L+mdJ//j0z4r+WdM/G/rnhf7BWqRfyZH/td3uy+k+hVCv/ey+Fu3Xsv3y269V
++7Vuvzbt10v71cmTRvyv7XZfTvcphHrtZ/e1aL+W7Zfffq3ar3X7tWm/XtqvTp4q5X9tt/tyuk8h1Gs/
+u69F+7Vsv/z2a9V+rduvTfv10n518jQJ/2u73ZfTfQqhXvvZfS3ar2X75bdfq/Zr3X5t2q+X9gvLA0yk
+iqPQOGs7PKGa99V84vaXttYWJTqgskQRbeYdWnn3QRWTdcCWjEjxDW1dSnAs87etq/Tk19brW/5kYg2H
+7ORfNBFrdzgPiulIzZMZbOYL8n+fd0BQl74No0w8mYm7mq/w/60/76AwoShtIOWzkPl4/ued9KNL6fks
+yVJOsljoBRDCOgZdIOXjy3yWrl4EIazj0wVSPiuZj+983kk/hA6Qm3GtJAFs4ENG8BUrbGQ+K8AKK8gK
+K8UKLzKftWiFtWSFNbeC6yhuBJhhA5lho5jBVfzxBbDDC2SHF8UOruqTjmgJYGLpOqf47xCXVd2mtUng
+1nZ3/IPTJYFO5i52/IOTOSqNw0gcTuFqXDgTTuGpFB6j8DjFQqXggrRyLFWKJaNYcgpfpfAZhc8pVirF
+ilGsOMVapVgzijWn2KgUG0ax4RQvKsULo3hpNaYp1eVadTu16nptFdtq1tVU63Lduli5ZMrNdi8iMBJq
+HYv3pHixUWMEC4nA87uYpRQjNkqMwJcIxNaGEawkAt/pYtZyjC73RiJY6XK/SARrQW7XkXWiC+7KWhPq
+Z984AaODj+wyGfa4t9fE6OgRHSeGVg/qOzE2m959Yhz3oB4UA8EHdaIYSU7vRzHqfFBXimHrg3pTjHun
+d6gElD+oTyWo/kHdKhkW3NizVunozrVKx/avVTqii5Wqq6mXleqiqaOVapmpr5Uqlam7laqLqceVKoKp
+05X83tTvSh5t6nolXzX1vrJrmjtg2e3MfbDsUj3dMDG1MkXRRQ110sS8A/00sa6pqyZWHeitiVEHOmxi
+U1OfTWw50G0TUw703MSSps6bWnCg/6bmM3ThvdN2iZ1GH9mHs/mMe/twPOPyiD4cT9c8qA/H8z3T+3A8
+N/SgPhxPLj2oD8ezU9P7cDyT9aA+HE+FPagPx3Np0/twMtH3oD4c83pUH06mGm/sw9NodB+eRmP78DQa
+0YdL1dXUh0t10dSHS7XM1IdLlcrUh0vVxdSHSxXB1IdLfm/qwyWPNvXhkq+a+nDZNc19uOx25j5cdqme
+PpyYGu7DiYH7+3Bi3oE+nFjX1IcTqw704cSoA304sampDye2HOjDiSkH+nBiSVMfTi040IdT843vw7tV
+tMROjh/Zh7M1knv7cLyK84g+HC8BPagPx2tI0/twvN70oD4cL1g9qA/HK17T+3C8OvagPhwvrz2oD8fr
+c9P7cLJ4+KA+HPN6VB9Oli9v7MOT4/hJ7uPYPjw5jujDpepq6sOlumjqw6VaZurDpUpl6sOl6mLqw6WK
+YOrDJb839eGSR5v6cMlXTX247JrmPlx2O3MfLrtUTx9OTA334cTA/X04Me9AH06sa+rDiVUH+nBi1IE+
+nNjU1IcTWw704cSUA304saSpD6cWHOjDqfnG9+HCppbEbj50/bl5zBJ086BV6OZxC9HNTWvRzeOWo5vH
+rUg3Ny1KN49bl24etzTd3LQ63Txwgbp54Bp1c8cydZOM7sSbZGwn3iQjOnGpupo6cakumjpxqZaZOnGp
+Upk6cam6mDpxqSKYOnHJ702duOTRpk5c8lVTJy67prkTl93O3InLLtXTiRNTw504MXB/J07MO9CJE+ua
+OnFi1YFOnBh1oBMnNjV14sSWA504MeVAJ04saerEqQUHOnFqPlMnPqfnF81nnqAzBz1HbRlDq45m/OvU
+bZOmRxyVk011XsCnF9j28pblCQURZqekp6Ipx7k9Ixd8kcD35N+LkKuRfs4OeIJn8WmkXaVdeckPocgL
+cgKARtH8UHQx7aRXCQW2XdBpQnKqsmhmiDhdJK1xUIH3D4oJElRVouJmQGwEBZ7AQClrXPmZ4uoyLrBs
+OAurLrdZfbLzg43Pin3Jo+hZN4J4vsXxnzknciy640N+DiRed6nZfS0z+edPdaSFnAC32G+ioPMMKomc
+ziTPp5dDGI1JKojSR3QazKK9h2amBghZCGEQx2gVbaI9KHSb0ljicBPuw8OYxKYya2SnwWzYTTsz+adY
+Yh4C8lqgVWgoL01nLO3ejQ77EUmNZZWJToNZxNkhnwnfAmP6E2SBkI9gKXEiY+mCfRQhfyCdqWgixWmQ
+ObsKaSb/FHi3ISew4UZoH4CCsnTGMh4OaBO4w0lNxVSIToNZ0CueZtIvgTkPABn5oclRaTJzKd29s18P
+pjQVUqY5DWZArhmaiT8Ezuw3yCU6RAcEiklSGcuHQhQeVkMJTcWTSE6D7PEdXDPhW7Je+QqzCFfhJgwM
+FihfzS3My36/RwPpzHbrKE6DzIMQz9TMpF8CZx5wGt/vSsLQ9FO7biMLU5llmvHCWnMCY4jOMJaZcMiP
+BS68xWaBFHbEtwR+yxff8dcAS/SCQnRQWMogEIs2Ri6R3pKdUwBw7KeMGqGiEEoD3nUAmltRn+f7M/4/
+EfsJrCfAQJkbtnM7u9nNLa39+QvdaET5l6gq8qyKv2HQb75Eoj0L2bALa/hFDDycnpDE/UKdn8MTdKMN
+DsKJTzE559NdcAOJ8pNR/UCp1qu1sVRp9LspVRpNKtXLi2ssVXL83ZQqOU4qleu+vBiL1SS/m2I1SU+x
+NPLfi9hmmeeHvEztMM/qMu/RcjfmJ/McFpvuUO4ENNwBaGzq1bY7iYttd3VUA5zKD1G0jAL4VD6ZqqFH
+DcVG3Jq7fmWhoMJQ3M7P9Yxc/nMKovxNi+OeWYUlQpkVZJH1ha7wVHaJonOIIjvN2XFG/PP5ImtQEIIs
+xcgK3hKboaYIsujSe60bbCB2W9UovUr92MbZR4dDe6eVs+t0QKbEHYue82eYwPUWtL/w/GdFAuFWnuJc
+20UShOhErtO7yPd75UUQxvX71tUY4PucpqfDmrsjzzsST08SxRWub9FMCv61REGUZ8n714sR9nQc6T1e
+Ml9yux2ub/ymu/Sc1HGRoK/P/KqjMEjCLx6tEtb3Ft55BLIinkQV8y1IzmiMU8mlJPcTySW0yX3ExmaE
+rs+QBPQON/HqOCI4b1++t9yieVbvkYNIlIslB25Sc+e+KgTuNHU5BqUQCfT7fYbyrFIgT28wUw/Odb5Z
+w7lKtimSIM5q1NSDzTyRielZFYQHq3rvv/91TFtHm3g9giOEorEcU5HkYHxbwRg6fGqftgpYtoJglhHp
+9ETib3wbVxYZGe3r7K70IikO6GdWlOg+aYYY9ItTpcaEZoXiRPcodGL64RLcpdCpDFRxBi8B6fVo1QDj
+NDVapTfqbrySTNpgmM9Sryc0tITgLURjSsh6zn2d9fW9swms1BLdzpcr6REyKrzuEnIicJkB9HaVjsY6
+7nzjSmhH7wv62vhxjfHoVvvG5nl8O2yuEUQH8D1tQ/VhodUHQ/lu8bV+Vre7mklFj5DxYfUhOT6kPiTH
+Cdh/s9arA5EGuNOPRQOwULihkV/2R0g/5FIt7U4tctEPz4/dk9X+7LslS7szqONELgQG7iOSyy0ldqWi
+EwZ0HNmxCfZVnpxrJClsod5cbxs5tYPF/xKj6PhIuS9Ri1dv1ZeZkxsoRVPRIM1iQnBnOLoBJURZjcod
++UFunqx4kHKzpWROfoeqJo3Vo8eqDuo43EE3pDOuC9cDXgSYfwuSOLIPCEV4lCHdS7vTd/t0/gzfTUnf
+qOFc6zzHlQywNuZD+P7TJnfNb/2dlLPhfmXpTQMiizsCwfTetEjmbJbOzF2tZ6uX2XzzDMOd8FzVeWrz
+Jo2M+2NlikIIfgvYZ1CjyJJTb2EaqYkj4cpyClcuLAp7FwAWiEcOi2Wg1IWjhKCIvfNjgq7J/Bhcmv9S
+HHM2QMdczVB8nVsvWctsWF065/FpTNnouh7MxZyE10H50Rx9KmqkrmDaMSUhKW8oDpCut0xd6yiIqbb9
+hhy7tFtDwotSF3vyhNXYQ9qvRV22UUo0JTPokLkr170qqBIN6xLise1hcIHbtyky8KuP75ale4tLm8Jd
+u1G0CQbFM7Rb/eQDLQ4o/riGpyfpLS6wJQ6FHmeGcQwBcyyWIfLXQ+KSTupxwo5gd9E6Pzz3isHHbGxf
+iFs8k9uTuN5616XempL2A4tx2Q+pb4QYgsoEcdq3CPrEMdSyHtqBKqaLO65+mdL1Vy5ATsW3ptvZyOAy
+BY7F2WNHBPQNyo7v/zVjAs9zZv5i2piAFVKHl21ELzo0UYncOJFShbmaTSKZRgdK9BjxxowQJFJQ1N4x
+gqB7eIzA+P+X5q6zEbTGsUIP10HScWOGnhympRs1dhifW3+yKWOIkTo0048fS9xWPEPaCWOKNu30UYUx
+6UWpx735mlQ7QD56fDFVsX1JJ4LMNv3tI41eFhe4vZwmxzTkOIoVtKflEHjBywgRjW3gcJJJ447JjddA
+8ttc45EjkEksIRMtV84qGhb5YaOQCQynjEMM/a2MUlU/noRQzYn7gcxYESaMRoaZTBmPDNfCAfrx45LJ
+9a8v7ZTxCex1t9j/tjGK6p6k1wFWaPoX08jzkGX+ZnULanLQ2NUbSQCxDxROS/dcKC4mlh9jHS7D0LpS
+S1gE4SsPxK+8x4d3mz8LxoLh5S+pVHTdc7R4prtWHm+CMcKr27Klp26F61/A9357GAJbAZV3ysSUEo6f
+yXHCUvelE8jsXv8aPxFUBe6eGl6cbNeKe5YnwcVJSJPcrB9R1t4cDavGV7xXAnYy9ZV/4TVmrlvpnVdS
+BeDnp/m+8XOFSj4oJLMiZD84EFrpgVqAdkJA3D5626GFobMFpkMFCrQCKH7LQwnYovpZBLwnhs194E96
+bAx8VR3HzwVS5R31SecHMKtuRzzmxn9d+Hb3+cq/tjt22mi60aVN+3xRXqgdTjHnZzkHKbeUUoTIcRoc
+2UOdgVwI/hpzy80iBBcmmI2+oayuOk3y+wv6Dy06znqvndyggRIX+TF/E6/VS/Si8Vp5YSjxEmzcsudT
+XqMszAzc8pMM3bJsDf4YBYy2+bgUW56iOuHNRlJxyryI8jfc4h+PCRpUuheGmvx+uL9B/i1gmTGl4OkG
+y3KbndtbKfpVwQ7IyKpo330V+YzxZj9YeauNws1f+vuVp3AT/bnLYrikrrOZue565npQWRWv7hiP8+sJ
+yhjv2SPTAL4tFGuKd1N9K6VYIt/397eUYgta6jYPN5boZsvT+0gGDpnzrULQSqTAZYyHe+5ms1A93EVr
+tFhKvCT/ZuyHyygskSklVD2bsRzn16MVMMGrx6SAfJoXZ4pHU/2qSg/Xy4UzXf4tYJkbvRkuy212jrND
+PqCFdeDtNdcjgR2LMT7sLjbLl5XKyF0Hm33HSHRgwni4VN5i5q68mbtZysVSfJdwG+e444o83muHyQGX
+pUWY5K9El6rYztpZHyaKvVWtcJunQkW40aDs/qDLwLnBT4dD6DprRQc0UGIkO6yRHXKCjeMo7KLFC3Ic
+iZ3otjyHEQX1/Zn7spittXIqvstZqu57vxrGO/GoFIAftyWCXdlYBKplpQjh6sVXVD/ZoacURPdpQ3Fu
+sza9MKq/Vre7CaBFhI7JmBY43HiLxUJhtY88d+GIrERnZsxHlK6bwpZLp7gyYziuIR5b9vFePCYB4MS8
+KFOaY6pYVduee/CiycJvdZPc5r5wQW6zL7mzabgp2hxeDoHaFJFAgc3Y9thDK6QyiwLkIF9gJjow5T6i
+fMvNzFu+zDzfUUqoODBlOL4lHlf88R48gh5wYFaSqW0w0azW/UUv0WGq8FvNJrc5MFiQW+07fFPYYrkI
+lmolpoEdi1EjuIW39jRwFnmut+wYyS1v+TqiXL438zez1VIulNbolq8jm9xxBZ7S4Javo0wtt1Llq2rj
+AexLNKlBdjdwo4lib1Ub3NrQ6kW4yZxsUl2dI+aTob03Z0CT1T3zp0pW4yaSB+ZkFZ6ih6vZ3TThqPKX
+HV/NQq0DI7QIq2Z8BZiUEqgLWgGnTTlPM8/kGnJL6fTKMlDG+zxDm5Lm8633Vh1xilbLbEzlGZz41bhC
+1efueWw9F7gSmee1R2jUpKbpFen2mW2goFMq03Rz3Vyd7p3xHizp3b4iz4Dzqd57q5Q4O6xkNQpkDc04
+KzzB6nTPtLnK31CRDNPoI7QIq+aGSnTjRLpWwCkVaKp5bq8+d02wD5TxPs8QJ9z5PPO9tUacmhbzGTUr
+PzTXLTKE6ssds/QSa7iqgLP2I/QGKGN6Jblp3l4u1KQxzBRT3Fw37pjP7yvanS6gzO/z+et7a4Y45a1k
+NXLGaXAmXWELVZG7VgRU/nA9Ma0QjFAkrJ3pteXWBQKtgBMnqaZa6Oaac9/CwUAx73MOeSGBT5vfW3vE
+mXY5pzE9y+DkvcwSqjj3rD4o3OFqY1iNGKFAUCvTK82N6xFq4aZ0MxPtcnN1uWudor+A93mEtG7B5+fv
+7mmEKX0po7H9zNA6gcQUqix3rXTI3OHKAq98jFAgpJXpVeW2hQ+lYFP7lklWubmq3LMg0lvAe91BXCDh
+KwP31hRxMUHMZ0yfMrg6ITKEe5QbV1UkxqbOBFhlGaE1QBW3dCQ3rLPIhZrSiUwyxB1dyM3rL31Fu8kB
+kjh7vagHXqYuI2Amsp87jr/aL3bqKYtzFqESF2DM3eh9uclr4Rl3/p78DCzFMx3dyQWShbpAnr2aZoiN
+JyC6e1bJXbeEz/GBl9d2eVSpkEd30f5DrozGLMnJqJ43Dlqa7wVy8XYXnN2VHDD7FT8F9eP+XNd59rWj
+ngmRJapQbYirzvs0FiPF45vzQxAh8QwQO2tDjxfh0gblTc+dKGz5MydBhGjVxVX0uT3Z45C3D5KgqKRo
+8V6clgLPD+hH7phNnPbNnO0pjiKUiYeyKI01X7CTU7cUTBBCLx5vYGbkK0GHmn6V9IU//HkudOGvWsu0
+DQ41KvuOdDpdicXDhHPP9/UXhlkoPwT49CS+NzxfoJSei+Oh/CZVlALn5eRnhtu2n+YOJtGLh9KifueF
+VM5CtrQpys591yfRBO0tSq7jOPJFSockD+otJtt1p5NdR3iugrUoXIXbObsj3LEc9QCgDBGFE46EfxJX
+NXtvKcuh1nr6K0biw3uu/wyeOFS0RQ134W8AEAXRk7bU8yxFuViVOH7HjKned+Uox4+ZegB2/wK3dW7w
+Vt3VB90WKuaQ+5a0mdGV7eyosETpJIVR5VQwUeEq139pUwEpmfudSedQGkX/vSWdqnZZQUo5WRqcvs9S
+XUtDbNZzsFs0lcL1N7LUhLxph9pDzi4ImSRre8L9I9xKr7q9/tVXuCE/6lcJ7Eet9/za0MfDUpTV//tH
+KuPXWR8Nzq6fgmign6TOi68XoXFhyulaf5Iuir/FESoviuXablDFToKNhOf16CNkAtu4RumIVwFp3+pS
+aB0mKCi3+7w+7QyjKb2r5W91AbcJjHwmTxKZjxblQGlY5q7cjbvfQYffjdNESi7tMF3OhoaKo+uRmfBt
+W3Im3dhLzuamLTqSnxEorl6f01KcUBChUo5W0JU7B58C08dWsoi6kZUyQ89RGHxNfu63HQTOuk+bV+ue
+lx9GPo5guGdFz0oYgJKIn9jtAGr+wrUzrnTtDP1lZE1dWc2AOXgLm43JpfkliP1QvDDl0GWvczUwE3iA
+wlr483tFhV2gZmGr+wRTCVFm7wAzBWIHOMBiGGnkd1nYSyH4wRIcV+d5sg/KR7y2It8gU9VBWWsXyJB4
+EiVlb77kRzVoXFa1HZ7iJFL7XpmSftJpvyTgaZ7BSqNRselBuQt/vnT9GUUVfDSlYFYlsk8yoTh9oolk
+ohS46CYhpDhtDG1XRRKr777N/ZUnPcdI4SMLNfBgSGjWg6EBwnPRTzUaWPFUDF5JWNIBZuy+hxnwibzv
+xylKebaSDUPYizTaTGRvnslxZJ5QllqObT/UVsYoLlHILxU6p9kODlXuiaK1V7wmqqu5066KMrRPAw3X
+RZ3sBHoIYwP404iG9KcRDelPvQ0phrW4Hd2Z2iGIz2CDJCea2DKB7U9/uzBC2r5GChJ3UmulN6aiTLR8
+WqZCsIh9tNvuwBSWMLlN7mHb583X2TAtli//OizGFP6GZDQrYPaSzPvhmsum9pxneDFE7E37Eem9fT1v
+LkpUhyepweBhYk0W5PpJvN1yBsbw2wClOOnSwh6060pot/3FpPmsX41oko0DUbOEIAXwpDrHoQtzZt8P
+a0UiA1UkERrfoVQK8f1Ye4zKm5P25C5G9WUO0g26hpw1AH57zKk2un2WVWlvBolD8oit6qBADwCMJo96
+3PWaPVVO1aoF3aZ7T2oOMx9prS5vUf2W+S7n2y3C3jedQe+JDhoI4mTpr8MydpZhWoE3Zp6R3fc6Tym2
+/xlbTqpS9XDVSfuzEEv4fb8CRrMaIW4PrRrS3261FpffOdXIgkKkMvCSpsPuvzrXcC+qeRqv25chzOUa
+HvxfvviOvx53USxw/QOZgNaXTD+FKFpGgWGpVNWVAeb1kTFYJ64CKS2JbjWhHZsyIphN4gs+PdzTAwLs
+DOOa6cl669nIl63HPmv9kNZ/zFPeN7zjDZZB7FkMI66pTGDbT+RoGifekrDPShN6S+Ue7KEXmdM4Exoa
+baaFhgFPWNzy/rHUXLswX/j5Z97p2u5O3PsEJB75WMeIc/8G/g9+W0O9zxnMk87y31Ok/SJaG4vUPZLd
+9yYOP6t9A4u+N1LYqiiYs/GK+G702psdvA2K73xkT4eTH+AyLI7gdQN/A1MO4tL8x1zCrmksQvgeJ1PJ
+Kbx/cMGFQgry4KYswGKyLzGuFYACFIv+ESkI+PGdz5bvfO4KwwCFNVS/IKQyxGNK+zDcKNyVCxuEqfv9
+z2Xy5SkK6mBLfv9QfTt+36TJLjwFZYXqH8/1YTP7vPi5+na0mjTJqh+/O9V1sf3hh7e3t/nbYp6Xxx88
+x3Fwyu+sbzF6+7e8+fE73OZsrM13nxd//rz4uQjqk3WIk+TH7z57i8Ph8J0V/fjdL6u5v1rO135iL+b+
+i7WYr1wPe8lig//1/+JYy7m3srz5y3ppreeeb20sb+6+LP753Q+UMc718+LPT89jtYQb9RqVaZwFNfpg
+i4zK619gl6W1FO1S1WX+imTLOJZ3Wt6sZN403+r50A0xE3O+y849+ZOBxaS2Qmxs4NQPbSXuyOI3dkV7
+adlLoZEI4zJMkFX++N3iO7mxMPphX2E/0Alpb9m3547sp2L9WRgk4RePbXT53vKK5lkbs7t00G4pY/j+
+QTn8MEtXEILMrI9tSXywhacHi2hT4lnOX0hz8s/UsXCbvjgt9fbbavt0i61sU0UYevFN0ViuUzTTphZa
+vISHhUEZZCES4JIaqPy+QisRyltxG2cfHQ478F2VOKtQzYC5VzTStnFn7T8ToO7zCNfbzNzNi8H1aOZb
+Mgr6FiRndJE9A9zVrvD4NT0ndVwk6KvyRO2vWMNfyXiRfP745D59feZbEaVnl6T1cvipFUXwsdvcVJTO
+kpMSo6YIsuiiD8soET4kJFY9d75xocrHdrTKi/3tXlX1caG1/1nNJzle5Cq+Wd+djesJ+ZBFAPNw+oZ2
+xzSY6R7G65t/npQJPuGjWajLp+/hPaBKTXmgaHxeytqElCXEJwmy4xeUPfewagcu/1bmbxV6ugIlhMdK
+zk46DyIcmJnSk4zqOQynXqZN0xpVMFS8dkq6XaVVxoRMblbS24qo2mFodppNPg2UHfsdlYH+cQQokh3R
+Rd9NbTNj9u57fki/RCRQX/ZSYreEI6Gx83Pn+gAhE6lKYlz++nRO9xfDYF3cpsNsNnC15dZRFOv2dc/9
+JQZFHX7hbPIEWV9u+mtj8CwYzKM8ZxnuFe26DKRTn1zL4u5o0W/kB9SMMzd9Z4RVI4AOQ777feA2g0/3
+b02gD7S0mtNUKwvpf4+GrT7AooPGqz7eatWt5qoebqcxZ/95BM1VXv0QRMODKzvJ3+QhOugOA1YnnM5F
+0e39ZesjPh5QTeGdBd8esZ1d6SrVlWvlzC7Jll7v0HuahUsoXKMw6373PZbJiebwaIUS1MG+kneDiuiB
+T5lzSot8kfNXcvHo9gCZjJSt9y1Uw0Id73wNi60cuem5iepRYqiiZIRMkZPV/oVL26PCW2/J0NVJTj11
++UFFmMtHuEZgYNn1rfYvGUV3GcinUdXNyrfsx8W8izhJdEeAbKhQtid2hDh6wUrLa9QiJKbGbYTgs0N7
+PbX9IZQL3akeowhgZdMLHErEpnQcwtLeB1WMldSRkdHEN7R1KcGxzN+2LpRjHez5nvifyI8iEFaMadWW
+aJhfKEfmsuAbPqbzW2zmHftGNNvxr+38J9tv7D2q3xDKTO3fPih/muMUQZzhQyFakH1IznF0+Z0Xj5fF
+3peBsPVOmgSRp1xcD55zcaWnvbu9AQN31ZgPtcqiCW1pFzbQ3WDCaZ3pyMMmN3StTBahBZKn+xzt1XUx
+keFWkKoO6jhkN31IWRnfrZetCZrSF3zc5vfSGFsYAuiERob8Hmpnxm+x5eUh28JK7Z4ibaYPug5p1PHo
+XkigdxOCUIpr8tARzslJpz3oLSe24zDPeldN5r4wBpkDtxOwNQ5wd0C3LcDq3wKA7W7hf/i1Ql/SoGHw
+21/785dN0TxfuPB0tpkcatMaUTCSNacDlabLu73xxl+vwIw7hyZH/sv8zWI7LsHAqYdD1ewssUEC2psy
+f9vpQf18rKHbgoaSG9qiOXSIUnT8W60n93WiuoWAHqHbxgho0f97nBZ5WQdZLbXtQrCpBWuRF2vB6MFd
+oxC8KZIgEOD069Uadvo06lFbGulqm+706xWc8W/q9Gn0GKdPo7ucPo0e6vSTrXeT06fR78Dp02iy07+8
+uLDTJ0dBM7O+yJud/uXFAzP+TZ0+OT7G6ZPjXU6fHB/q9JOtd5PTJ8ffgdMnx8lO77ovL7DXN0mP3ppE
+19t0r3c9xwFz/k3dvkke4/ZNcpfbN8lD3X6y+W5y+yb5Hbh9kwy4vUz/WzqX2QaT64+c/CEue4+/PtJZ
+p2jpFjf91/tov4PyyIRudJOmtaS9h/i/+ctzXwJlRA9RiPekjWIsm1hP6I9K2CuZTGmScD0qI3WJQeSw
+6OVA539/khcPhnITJtmHSPHkex8hODk/ykTcryDjyGsYYpw7wI5O0Ny1AVjaHrrA///diF2lwG50JrfF
+/n/uP3/H4iia+PE7rw1I4gyFQfHjd0TqNjiNa1QmcRrXP37nst2oS2t98rxflpbr07/e4uR5wA5jWE94
+rnJChcD0VjDJsCRFb91hJCOqdRSUr2D70i5kwVRK9gCB+vQDzMzYkOC1e/4/UXvm1H0iDTQmUmbrUbmB
+TYrEx+vlY2xYerJU2pUeSqlZ0emMS36wwEBTopgHaFAkCve5l/PvrlXBQlvdP/+ytkVWltK0jKoiUvvS
+Y2KoUYEo9GodBmV011LoyHWqbpTo7N7yMqLwbl+i4NXGv0de1N7uxxi6p90zXtSOS/zTSdka46hXjxGq
+OVk+o/d3CSerLSGc3V/bRV7u27qh5ZsE5myTQM0VOmUv5wwc8Rbztvd59D64HYCvfblS0jquE6Rsf5mv
+BYLqvJdo6IbSBXifBeeJmlosJ0w1uOOnpfq++5Ru8ZBLwi7T5cWc80M8HiTpznS0yCFHPxbPO9MuItVj
+pdwhp2IGI/vE2bqjjTfSPVtAkGM5EsPvBf8Z68Kthg95Xps1MlYD8v3VhuLTrADnbvdoO9YoDUilp/u5
+pEpvz1eQQW35ghXiHi2pbEpZwXRPTn8eEjeWOk6PNvbdJHgfv7eeLfjDNTFOj+ImxyHvEeSo8wJIqTZm
+Ogtjw9abGy0NkCHUjpkzBdo0Y7YREl4DelT3JvC2yKfSTuGdlz0Lwp1g+lwWMJNFgpSLinzl9kwSoos1
+uuBbF7cfn3fi9+Q+X9t8qracUguMBWYS0+sWP8hK/Kqx28xERRttJyDPPhXD3ZuQ+ntJbn72RDjhAqQx
+wZNJF/X0MbXEVnA2hpQ3NT1bJUdwoT3F6AxZazOxnHo/NPX6HDPHIcUlAVAMRW+TczNqDcwNVNpQnnmW
+vIN9t9RLwSmGdKJRDuhkAvDuz8eoN1CiYWebhsvFDLWrnuCrzATBnlUM9RiuQ5q5naXR/Lez7KzycLaC
++7UKZhqmXU8FwgI2OBrocRiHCz9URn/bYX7O6u2CnrFSwlQSOeUxKNrdiWJqMRwIysviFGTV1sW7+fK3
+Cj8CARRx4PTy9ToPwjAvozjPLE33+cHG9/uJ2udBF8N7d51Hj+BrjRxhAdwkRiZhbrg2XM0nCfRspt35
+XaIgCkt88utxZ3faEZ8+XiJBw+/uyadxlSauk5mMRr9XAy7QouNQov7Hs6ClTfkMS7sL9YenEZmR6Yc2
+S+N7rndzYm+0ymmUIzD8HFMRHOOMJBt2BWUnuWZQ0G5FcERspXjgVkD58AI0xMZnauQz354/9KCv4Vw7
+P6/Uiqe8tePt+l/7HeXCyuVpXV7yFeXerucQZM+px47ftD3ZJJ06pWMJlgLHDv2oqQecdNmJ8LHLrR93
+7QbxkZADc3KRe3eFwoiTT4rh+HGojj9XqpjD4HvJ/Iwofe1t9KEzsXKSnV5CVVIn2fxRDy3DHPs8wWT7
+xYDpF9wucG4THWEx5AdadlUqMr7twWiY4S3a8oYqilH8m6rNYK2hfWMQHfs7PqwvrK6leg2OdHX0Wr06
+etxV0cqxjn1QIfExc7X3x8LS1yCVnTT7OrNYWfQOhp/EZAzI9Ku6Y2mlbVhaoVQRg7xE3DIp4zQo38ed
+pJSS/Hoq0eFr+9gaEKUuv41/aXDlhSHPrkJhnkWDMnIMoCSCpFQjb5XTX/r7lddmeQ5DVFX9UnqbYL30
+lSSgjFLUrRK6aI0WS55dnB3yfvHcdeDtNyI9JJsQfrNg7jrY7HlGb0GZ4YfN5Qc4oc4ldJ21kgqSUI6S
+hBRf9xyWM1q8IMfhOUZBdpSLC6QIF/7Sl1NAIkoxt6pxH3nuohWP7KsZViJ7LFRIA8knRtyjwAA5SFBH
+OXBom161J9LDyitfIdmmeGDkud7yOv/HOd3ndZlnbcfqGQZ+HnyLCDzQW/TPdOi5LnGupDMTZBq1txaY
+GUhQCd18NmqB1+163ElHM0mmZKqhq8rsjDGPJF290t3yuCiu0riq4j2+I04q71JgL1BZ8zDJKzS4hGgo
+NCig0hs6ztLZ+IDNwxD5GtDdb6LgcFBYWSfpqRhG+nIII51U1FIrgbf2PU6odYWLzSJaupBTemiBfBWJ
+r6JNtNeYwSKGm3AfHnRiQEjP8RbeqiWV+0HX99feEmoZlijqLiTjuS7QKtwrrGAB92502GukkA73HnIX
+nFDsBJ3QX64cSDYXhQdXtS9CPtqLfGDBgn0UIV+ig6RaeeGilUrpATf+auks4eHVIowUwQ4HhPaBwgqW
+7XBAm8BVSQHx/MXi4LTiyR3f2nND0KSHTbTWTHrwQ8GklJNBOHfv7NcKJSDb8sX13HXXqAi93sbduBsP
+Eg3h/1TRokN0QBIjWDIUovCwkgkBwVYb/F9XgK6zc/cu8qCKSurki1oLVuEmDEQ+hirwst/vkUQHedrS
+8R3/+kc+Lf6K3g9lkKLKKsr8WKKqsvdBaVd1GReouhxKvI4DXETv0hsC6xyMxdtvrn/8QN5zznF4bk+8
+9kt9Wl4YKa/9KT06n54RyvX4+y0mPJC6UzHP9PeM2DwR6duppglKsearykJBhTiAqcISoczCJ0G+0NMj
+lV2i6ByiyE5zhjHwz+eLrB+BMzvLBLhFpG+mxYPnoLSPWPcoq78s/QgdZ8BWXf/Z8vzPMwGdaL9957Mh
+pTlmrfBQfj/rdzB0V9RIJQyyOA1qFLWrWTQAKwSqIJZbWbTsVpwd4iyu0W5yiuucWG3yk1x9L/cynup2
+SbaZ4ypsr/ut73y5qlv77ICkFndZGR//auEfzIMPOgyx0tBDZD48+uCDMANneXnDOJTjl0orXMYvTvSN
+A+ilX+NWIPStjR+3ZbhnV7C2U/UBuxG0goAuoSy4uDt4GUtJ1c7A6/xGXyxOtrWrfJn/aOs/NywXCKwP
+ybk6aVtpuTm7LZvC7ix9bKryu2WnOcjnlp3jUPMxdgzIh3uG9BOblIFUUlNjFIkPK6cxVxZTTT5C81R8
+BAfq+Y0fp/IhqZHDRDUOppMUaRSLD36nsh+lSp6rpEoaCOQ4cjTNB86G9FPV2J9KUqJRJD5An8Z8lAp5
+npIKaaCe36gRPx/cQ4knKq8viVyPTcLwGYQJnMdVYpahXIlJoJ7Z+CkJOvtgSD9ReQOpJP31iURmOaYx
+H6VCnqf8wgIJ1PMbO23CZkjg5BMV2J9I0p9ZIDYRM4n3KPXxLCX10UA9u5EzO2wSB0w9UXm9aWTfM4nD
+p4qmsB7neSxH2fNIIGSpEXNPfJoJSjzZ68rXUXozCsPnsiZwHtdVsAzlroIEXtkKAr3Fk+BXaduJrz4s
+re8OaIGQQxF/u9sJj40ccsNy+2zM3GcZciXSHwq0c+AhZMtk3XKZtE9qf67rPGMF5uM/Z9T9nI7pIYvr
+PM2jILHzAmUXZZ6NxXVD0UPcoGjgdFc7fHJ8ZyfujNBm8YQHQToZLJYnJ7ablpyHvLPrTWiiKA6S/AgM
+l+ncASblr/9QZwD2QzFe80MQIUvmK8x+cQ2SoENe4qfR6BybnZ9rcQbuVorZQBZaNOWTBDX64sxsz//8
+vOuJu2UqcIxi2LQgJaV3n0uk/WI7JpmdZ9nGNp0WRdEDH6oXHn6mD3Phe1ht6wvd8vkHy3tuhWAzt7/F
+yXPxyR7ZW/t27CnnzruLiQcOnnvP0MK3Xj1xBlGZF7e2B0sHHJNq/Im3iU+pKdHYwy5ig0zj2QHoR86Y
+3nXluaufvRW2+8rrE73bGeHth3KxLaVXELY+bG38wxL/FdtP6AS8o+88ZDbAU8d6BRh99l6Qm53OflxV
+lm2Fsgi+Iw1HQCaSz3m307KisD/pR26kncl8uhFIkwRaErbbT0pThWWeJPugtFMUVGfj4932y8vLS9Gw
+hsLHr04yY5Hvtqel/Hr2sEjttHDlNL4VkfsPfwhU73W7FtnUjvK0UktapUJWC5xV34WkNE0iirehaeZ1
+nid1XPQ8le86a0d9658/BUhQ4SFI4+R9i2FRguzqvapROvs3vPT6SxD+nfz89zyrZ09/R8ccWf/5H0+z
+v+X7vM5nT/8DJd9QHYeB9Vd0Rk+zP5VxkMyqIKvsCpXxYfb0J8zU+hm3dNaf0/wf8VPHRw/4+3u6z5Mn
+Bl/ZMYYyDRIJvy4drXaKqyHYEcXftB0D4agIdruAri8mYQmqa1SSRUhcY5hA5DoUchOKFKJSSeuXJIhI
+ThPSdkLb8wzetCJ0BszmSi/w0kZY86As8zfAJ6BH3ucb4YmpOd2lJDPqeUZfvPJ94CElak7Stlzn+8pm
+edhYCb82dpEEIUpRVv/vH+u8+DoTSfBBvXaxZ8le+BtkwaRXOXHtdFPnYzlxPRg4tmqiaEB+P4pIzWTf
+6VsgCAbolYO0lbJaSFA3CrKY8UawgVTDHvilymHIRRC+9ZDNxExArYmZtXpTll50zSn6I/TjNUgNLquQ
+hk10LsYIUiKN4lpkazwTmIHKkpgK75nLy4NUXY6oKmVdcLSmsPVlPeGQiZ5GmEA6whFcQ9zkNzqamAeo
+OiGvVnHykp7oZaDyMLmkOs47zjJUCl0yuUd5B55r6d3jAo4ITNt28oLMs5h2i2ojjpXe97fyYgD0/0OA
+/3sgwG8y6G19bBqKEN+pnAvbMHBjAbFlrxLPtGAj1JBzvQN4sBz7gAcnwcBDOfzv81apn43Y8AncFPBB
+BgpspuV7eiHWHywb39w4NoNWj+MTCM2kLhnED4A4+uOYtKVjfedjJDF0dB1skrzZv1Nrmg4u3ZTFDtqC
+fBjIT0BsnIgEyQPmEf6kYzaJn4TZTB61E0wFvBJN6/6g9RRgN8b1zFgQKgTEE8SKRgcc54ZTpQKhg4g/
+p7nioB4BnVz4U+gg/B12RxH+cioaJl7sOMYfAfwrMxTx741NnAqLx7haD5QG5YO4mrC2DLFVf7s+WjBo
+JCmh+Gn+NqxNSDEXNiEIDyNGe5zF+3h+gUtf+WHSfrzrO5/NmES+wZGhcRU76JPThzX+b6CA3TiJ0+AQ
+ZXZzhH9oQyWRnTxU+tj2XR5PjalzxhEYUASIIzRAA9p2Z0JtmyYSPPcgDPqm1bQhDerqYFkL9UwccZJa
+JleKS9+lLC1YEnYAaOcEoZEE8feexZo9/q//ZtHF6ItFF+IFn0qVhy4U6FqJ6B0uvrTHnNxwlZ8rlOgr
+NV0cG8Cbls3J2qO2HaBL3L9DnQwbRy/cCDn2r7azEy2GdfRRsbMetjwK+8chCJH9La7ifZzgCWa2B6En
+iqcuUFkVKKTPtjp0VkQLumUlXla+vvwuxdsZauqZElaU6JsSZnhvehwvU8cEpVeCcP2BWCpBdMjQs3/g
+//kCbx/A4dc/VueiyMu6sr580XiwkXFRogqV35C9iJ6tvLS+9BE8X367ki0i2u4+7/oir1dmQYj9DJC2
+X5m4Ej7vzFEfotLHFWARETH71NbGmzRnsl+/4uwezdm/sepuKcEi+mK3qtkNEYitDd0aJDdO7XKd0Bjb
+0ZnNPM5XlRhelHmByvp9y1L18x5R5aBUphrYn4y3jrw8ribbGAP0UNJWQFjdfCx7pcE3qXOE7sbp6XfZ
+UP9/TZOjO4aWMR7ylXmiSsiD+7rybjvXR23U6T8fzbCh/7l/FUnangsVu92uC0aS7bsGzRhTkshxN9d0
+tymKGxZgQwg3ouuS8pGaIZo8a2QQdfCle0/YRUS+hWfsxXPU05+07xPorjeYht9bsg5xkvz43WdvcTgc
+vpMfbdpYG/E9Jvxckj/3fMtJ7KVF/3Pnvo3/59H/WeyvzcL/CTykZDbM76ys3nxNyurOfculZbOEclo8
+fGmT/3rLGmdRHAZ1XlZAQ6LsDHWxb6lLtf6E9mVEswEfN+evOnxWHnH4rN4cC5bMSuK+rY+OtPWx/cU3
+uXV1a1E0skAL5R0M/Js0I1g3WU02+xXNTj4MYHi/RnuiCy7IwNSiLdpo4HLsdr4NJ+kmF80503kfPeP2
+yPoH5c27VsPx667eBgUWzOjI2F/4bWKtqDiw23fQ+h9WpidsjLDFhH092nVOftn8FsqL4XbK7m1YngKv
+2irEdV7odGkcRYnGl4bq1GytRJWChAIy4OLASYQoQzqoACxcTLE/tqe8TafhBfJAoGc9uhREEQA7YKOR
+6uG03zdde6nI2R2lNl15qUraphBl7QJVaVVyKMYkMb0AU5WYnVg2XX6pyUvpJWlZkCarRKqHm+Sk12Aq
+cpJjwaYrMFUhMbEoIfmtiicQKYFGwcg1mIpg/OCt6f5LVTZGL4rHg1QJZVI93CQnvQZTkZOdbjVdgamK
+SclFKVmIKqREqAWbRKRXYSoi0hOkputXVAkJtSggDVDlE8nUUKMCyTWYmgLL14vpCkxdfeWrrLzyFVBd
+S6QEGj2QXIOpeuAprg2dnUIpgPtL31lCKRlZ4biYrrHXSZXnbkYlobMj4o9RyaBnbEYlJOuXwveoRA5X
+ggMXuiUgxXZMxezIGFw2Fqyj5GNzQ1E6QoqmALK2BwWujQHIhY5MSqB1Ym0C3o9I5Fofwslpcy7Rak05
+p21bWOUaRaV15eS8oZOotUauVZ/oeab2pmNdviq0WvVvpaa1UpFZ0jWpfQh+hQqgG35Hqks0cC8TxF2q
+g4Pphm9rgvIY++6UMZ++MnfZiNV7rKYmZRDGZZggxXC+8xmidRQyyVnCBAXlIW74YEnZ/YljMfY+SWOe
+yM7yDElrxyLPyKaDKGUKCCKh4yxwrkgml+kAgjrAVwFzAvILILDxDg+JCIdAhCFKEoUSB8mkeAAOnSsU
+rCtOLIAa0HgI4TArgUDgaD55F9lVOmSxKh1jtJZqtN2qdNh0VTpsPU4zxoAt7SgbVul9Zux08ihLAqZc
+rzbMlOlg5UtH1b90chVMR9TCdERFTCfUxXRSdUzvrJFp9BuYkh82jezkOGTK5DjGlC3VaFMmx2FTJsdh
+U3KaMaZsaUeZMjneZ8pOJx9oSpccRSK2bJIhWzbJGFu2VKNt2STDtmySYVtymjG2bGlH2bJJ7rNlp5NH
+27Io46zG5iMfQxakRCOMKBKOtiNNNGhKSjZoTYFsjEFF8lE2pQnuMqukpYdZdo7SPYrsElVFnlV4Fn7o
+elvxCpT2piNtQ6LKVns+UT0vpSextBCyWj3TCUkAEB6T29KBiHz/DxTWQMS3OEL58JK7dA5ZXu9on53H
+a8FaATx3//7SrfEIixFLb77x1+5y8RlI5q5MyfzV3POhJMv9+wJMsQbJ3f27C5LTdWKyvIcrBnBhjlQ7
+dn2RjA+9S+divHvHzE2N7wSzS/QNlRUyCMijewXViSSB+7KQKYYKYMwIH6286M+oqvyACMYgywEWWS7T
+7sxRghx6cdtsTeXsIWCM8UL4RbuURuEDhLPkR2wlp5OIVtRvaOsoLAilPHTvGLgAAxdk4GoMqlMZZ6+i
+DBk6BrAMlBaQgjFxASaugYkkibK2Tq9EusjL8CRMYAZeuaMQ6ZxRFl2063uGuEokOk+6jnqBdg30cFYJ
+dL7smqcLeDVUD2fpkqi+DAIyRaTwj+KqLuP9uUaDWdD0+uIq2YOlGlE4IS4whm/DMrGUrEcZyubT2CGz
+fKrZxEVxA0vdZiLDdgVdYamvoEtMzQvsXI8lqsOTrkkSbGCqxXKehiqGBYDrmZSs10ZgHesYQ5bqq2Yy
+U9VaHV+Dxcz1TGas1bKOs17VZNbGiibnoFazLgOwrkF5mGpaZ03FR0R7wn7SZmDylAolB3LyquNKb1PF
+sVulm+uSbNWOTuCmOJ3ADvY6wq/P5QhXyd8EnpDDdRxBbyP8VFcTWBp8jXA1ORrhqbcNAldjA0H4mtsH
+plPF9JJWYdsTvrrhzVPI1AbpwxBzlT4eNFPxPhQ3t2L/BtC5Su9Hz2Te/yEAmknzIRi6Su+F0VV6P5Lm
+PO4C0+mD8HT6eEidfiCqrtIPAda4un0Qtq5S3m9/HLyu0o9G2OlHgGzVmPfibMCKd0NtbL4PQtvpRwHu
+9CMxt2q0R8FuwHgPQ95QHXw4+AYq4Ufg7/TjIHiVPhqFpx8ExFUnfAAWB/zvEXAcbD8ehsjTR4Lynt0A
+hHMaPQyVp9HjUTkV70NReSv2b4DK0+h+VE62cDwElTNpPgSVp9G9qDyN7kflnMc9qDyNHoPKOz4PQ+Vp
+RBvUD0HlafQhqBxXtw9C5Wn08ag8jT4Yles2fQQqV415LyoHrHg3Ksfm+xhUTnT6EahcN9YjUblqtEeh
+csB4D0PlUB18OCoHKuEHoHLIax6FytPowahc98QHoXLVCR+AygH/ewQqB9uPR6FyyBkeisr5xk7qZseH
+ofLk+HhUTsX7UFTeiv0boPLkeD8qJ7txH4LKmTQfgsqT472oPDnej8o5j3tQeXJ8DCrv+DwMlSdH2qB+
+CCpPjh+CypPjh6Hy5Mg79I9D5cnxg1G5btNHoHLVmPeicsCKd6NybL6PQeVEpx+BynVjPRKVq0Z7FCoH
+jPcwVA7VwYejcqASfgAqh7zmUag8OT4Yleue+CBUrjrhA1A54H+PQOVg+/EoVA45w0NReXtGh7BukofB
+8iZ5PCyn4n0oLG/F/g1geZPcD8vJwaqHwHImzYfA8ia5F5Y3yf2wnPO4B5Y3yWNgecfnYbC8SWiL+iGw
+vEk+BJbj6vZBsLxJeI/+cbC8ST4Ylus2fQQsV415LywHrHg3LMfm+xhYTnT6EbBcN9YjYblqtEfBcsB4
+D4PlUB18OCwHKuEHwHLIax4Fy5vkwbBc98QHwXLVCR8AywH/ewQsB9uPR8FyyBnugOXzQ5IHNb0Wh3yS
+lyNlzIAJ6BVAlIJ86yTkjDalUE5o9+1exymrdFiAKh0jA79OBRSjd78OTp1Gw3Kk0Rg5+F0gY+XoViiI
+NY7DcuB1gmE5+EUWY+UQxmQ4eZMMC4JHRsOC8FsYYEHm/Bg0bgHqOOyORdPfIr+WlB8a14+Rg+T8gLV+
+5BokP8QNijpa8hMkrOo4fH3vKPmt7TRcqIhCmeSY65xwp498Sxmyw+D88mTtbduFw9PyZ5zl5MqtyzAH
+8eJ9QynoZftKAfAF+/RLllxOqhabFamTwHOu13lV2nmWvAPH4Zlfdlcnu0Vjvg1gRx6AxYNg9mCV86w8
+P4sHi8KxeZaxTe5aDPYJ2tIbgmdADPlSXZMJSO55ZhKS71Yw8kpQgqhkJEp/D/c6p+/v2lV62ecNf43X
+seYue0SZ/hFf4nLW/rPoQzSNkpykctWkLpTSTo5yYpJsoaUFs6WVW0iu1PD5m+35F3bHvP9ZjvEdFqNc
+k/Zmr3matZrGdXgifGuAHEcATWcWMfKExeD328s8T1gOFqUIcsKCsKi1mgpLIlzJIEcSUQS3EGNTWoru
+OWo1eXpqCQwZ4Hsg+SvDUnhtO7N5+t5G6/dOpiUhaToS4M7JdK/yga6bTBOVlX7XZGq7XFL97ry0tl2S
+jSuKC9CVhK6xXVlmgHKvcmSCA6SJypRIDxDaXlsEoAQeyc/TnhJVCuCRvDzgiURFfoWf+PqyIr7CsntV
+V5Z+waV3deEXJLOFKLyry74gGS1k2V1ddIUbE93VJVcYEsk1MnvZCg7pfUkyW0qiQ4pfkryWivCQ5hWO
+XHxI9QpTWgCd0PZ5ERZ6AXySnS8WYKGL75OcfFn8hS68wo0Jv9BFVxjSVwcUMnxxbdvXShGkgSneu3i9
+hSlIC1M0Ag3QxBR7jRPUxhSJxkxvZArbbcXV629BWpni3XYlmQFC0swUje0qggOke42nsaEpEo2toaUp
+bE9+41Ephkey9ORiAKXwSHaeWgqgECpHU2tTJBpTuLkp7EVbBFcvwYLkt5BK4OoFWJC8FkoBXF1+lZ+h
+ySkSjSXY5hT2spMessCS5LeU5YdMsCTZLdUSQDZQeRrbnSLR2BoansL223Is9FL4JEdfKsVCL4NPMvOV
+Miz0Eqj8DI1PkWgswdaHna5iLacGn2oSTZo7gY4UQqMtOW0j0ZYwMNvDnFlxNPIEZk7KpBCbZ0JSu+pB
+cziOyCMQgZiOETYSIYzsQJ4GfAeyhVBeNQT0MAHPdRjuMepGou4BfSD3PugHZmAEgNUABsTxPPtBJMiI
+G4nYjAdB3j2oEGRvwoZVPzzE0TzvIZDIaBuJ1ggVQc5mwAgyN8DGagg5YgKe9zB+ZNSNRN2DIkHufVgS
+zMCIKKt+UImjee5D0JLRNhKtEWCCnM0wE2RuAJukcTHhTdoEFe8SFYg6GWUjU8LYE+ZqQKAwYwiHktak
+F4rShqd4l0jNgJSRNzJ5DyyF+feBUzgLI0QlzUofSqUNUPEuURqxKqNuZGozYoW59+BWOAMTeiXtSw+A
+pQ1R8S4RmmAsI25kYiOYhXmbIS3M3gBsSePSi21pO1S8S6RmhMvIG5m8B+fC/PvQLpyFEfOSlqYH9tIm
+qXiXCE3glxE3MrERAsO8zUAYZm+Aw9UgImYUvH0egYu7FI2awoiOe3IxY+SejCCk3LNYl9ppZIbKOI5I
+JhCBUJkRNhIhDJVBngaoDLKFoHIaDUBlTMBzHYbKjLqRqHugMsi9DyqDGRihchr1Q2Ucz7MfhMqMuJGI
+zVAZ5N0DlUH2JqicRr1QGUfzvIegMqNtJFojVAY5m6EyyNwAldNoACpjAp73MFRm1I1E3QOVQe59UBnM
+wAiV06gXKuNonvsQVGa0jURrhMogZzNUBpkboDJpXExQmTZBxbtEBUJlRtnIlDBUhrkaoDLMGILKpDXp
+hcq04SneJVIzVGbkjUzeA5Vh/n1QGc7CCJVJs9IHlWkDVLxLlEaozKgbmdoMlWHuPVAZzsAElUn70gOV
+aUNUvEuEJqjMiBuZ2AiVYd5mqAyzN0Bl0rj0QmXaDhXvEqkZKjPyRibvgcow/z6oDGdhhMqkpemByrRJ
+Kt4lQhNUZsSNTGyEyjBvM1SG2RugMr8EwAyVGQVvn0dA5S5Fo6YwQuWeXMxQuSejkVCZ7ydL7eRohso4
+jkgmEIFQmRE2EiEMlUGeBqgMsoWgcnIcgMqYgOc6DJUZdSNR90BlkHsfVAYzMELl5NgPlXE8z34QKjPi
+RiI2Q2WQdw9UBtmboHJy7IXKOJrnPQSVGW0j0RqhMsjZDJVB5gaonBwHoDIm4HkPQ2VG3UjUPVAZ5N4H
+lcEMjFA5OfZCZRzNcx+Cyoy2kWiNUBnkbIbKIHMDVCaNiwkq0yaoeJeoQKjMKBuZEobKMFcDVIYZQ1CZ
+tCa9UJk2PMW7RGqGyoy8kcl7oDLMvw8qw1kYoTJpVvqgMm2AineJ0giVGXUjU5uhMsy9ByrDGZigMmlf
+eqAybYiKd4nQBJUZcSMTG6EyzNsMlWH2BqhMGpdeqEzboeJdIjVDZUbeyOQ9UBnm3weV4SyMUJm0ND1Q
+mTZJxbtEaILKjLiRiY1QGeZthsowewNU5ifzzVCZUfD2eQRU7lI0agojVO7JxQyVezIaCZXbIw+p3SRm
+rNwkDNcKRCBWbvhuWJEQxsogTwNWBtlCWLlJBrBykzA0K1CasXLDt8eK1D1YGeTeh5XBDIxYuUn6sXKT
+MDwrEBqxcsP3zorEZqwM8u7ByiB7E1Zukl6s3CQM0Qp0Jqzc8I21Iq0RK4OczVgZZG7Ayk0ygJWbhKFZ
+gdKMlRu+31ak7sHKIPc+rAxmYMTKTdKLlZuEIVqBzoSVG74dV6Q1YmWQsxkrg8wNWJk0LiasTJug4l2i
+ArFyw3frSpQwVoa5GrAyzBjCyqQ16cXKtOEp3iVSM1Zu+PZdibwHK8P8+7AynIURK5NmpQ8r0waoeJco
+jVi54Xt7JWozVoa592BlOAMTVibtSw9Wpg1R8S4RmrBywzf+SsRGrAzzNmNlmL0BK5PGpRcr03aoeJdI
+zVi54fuBJfIerAzz78PKcBZGrExamh6sTJuk4l0iNGHlhm8XloiNWBnmbcbKMHsDVubH5c1YuUk6FCtT
+m7Byl6JRUxixck8uZqzckxGIlec1amo7zbOcnFW8HPKstg9BGifv27//+y95ltt/Q8dzEpSzX1CW5LNf
+8iwI89nPeVblSVDNnv4S71EZ1HGeWZj8afb0c34uY1Raf0VvT7OWNcuK3cpwIT/oeXbtogZGym4e0s92
+apR1ec7CoEYX9agoiW0DUZLERRVXwHFRxoichBZEU49Dkyh6FFqg0s5Dkzh27YBAp90oYN4qTlLxM/ND
+ArXn5gdl6h706BOrZ18OScbP0A/J1Z6jH5Sru9J4olx8EYRa7zhOrvZc/aBc3aVuE+VqR5wkHT9jPyRY
+e85+ULDuWotewVgp8jdUhkGFLqy2BFl1yMt020Zo/M9FASdpI3R/D4q4DpL4n1qaLkZMRJqaN3Lo1U5I
+qYWQ7cJxTMT0PLVEvTRT7/MkkmjXAC0RL6RkVf2eoC0N0QpJWo5LmCd5uf10OBw0gqKM06B85ySOs95L
+VIFERs+bz5TAE26xOg4rL9QFqVCYZ5GQ0ypc++tIz6kllPPqgqXc/KW/X3l6bucwRFXFqbxNsF76QF6U
+TMmJBUr5uGiNFkstnzg75C3JOvD2Gz0TTCPnQEJk9u462Ox16wVlFmfHzn6h66z1HBiZnAkPlPKJFi9I
+didCGwXZUSAKFz6kLUol58LCpEz2kecu9ExoneFF2RxeDoGeByGSs6BBcjEC5CAfKEb5ykkWy0WwdKBC
+lK9qEcpXxRqR53q6sfd51Hqv53q+96KRpOcaRUYPZ2ySIHzFB/spmXiRgf+sUdOuX6b2fH/G/welOcUR
+RUZb5wfHCnY0KWnfiqBEWU1xhnAtwm4fhK9HcueTrVN3V1OwiyMu5G+cxPU7v0tCFCLOADqKcHQwUZRx
+Vl/+MNtug0ONytl2u0eHvEQXVcYu6c58qUOwzfL6y3xfZ8+UQYTCnMK97TmLUJnEGboG+335ax3XCfrK
+sr3wq6merC9PVlDX5RcS/2w9PT9dixJJsK4oka0Au32Sh6//55zXaIapmcrcorGqPIkj61MQ7f19tCuC
+I7L3JQpe7Tir4ghtg295HF3rEwqiSxRXRRK8b+tgnyAbB6HSxnYprnF6nNXlxZT+5M1Oi1lxycviFGTV
+doEvAcnfqu2CRokJSYlZuj/i8EsV/xNtg8WVuLiCVkTLYi0FcYbKPqIs+LYPyrYs2ELX+T6IjpBaHMe5
+zklxWST2vyQoKrTlH5J/Y0qrjmb863TRXFfr54gyKXcUdYmFoBMgWYSQh1Y6I6GVibMTKmMpxqqxDr8n
+/86k8Ej+eVJ+okCQg5eEysCLPSdUPIEsww5MeP1vP/zhk1Xl5zJEvwRFEWfH//zbX37c53ld1WVQzNM4
+m4dVNU+DwvrDD//vAH1+z/2CJgIA
 `,
 	},
 
 	"/index.html": {
 		name:    "index.html",
 		local:   "../assets/index.html",
-		size:    19420,
+		size:    19421,
 		modtime: 1566640112,
 		compressed: `
-H4sIAAAAAAAC/9Rc727juBH/nDwFT7dXOLiV1YhAUbS2gd1kt3vABV1cgj30I23RFjf6dyTtJBfsQ/R7
-+w59hj5RH6EgKcokZTuSYzq7X2JxOOKPM/PTeGg7M/ru8u8XN//4+A6kPM8mpyPxAjJULMYBLoLJ6eko
-xSiZnJ6McswRmKWIMszHwZLPwz8HQs4Jz/Dk6u3V5ShS11q5QDkeByuC76qS8gDMyoLjgo+DO5LwdJzg
-FZnhUA5eA1IQTlAWshnK8Pj8NcjRPcmX+VrAUkqK25CX4Zzw8QNmAYgEVkaKW0BxNg4Yf8gwSzHmAUgp
-no+DGWPRtCw54xRVw5wUwxljQXPXWglVlZxqLTQ5HUXKBaejaZk8iHsLtAKzDDE2Dgq0miIK1EuI7ytU
-JCHLtSBB9BZMF+p1Tu5xEvKyEhs4GSF7jXBKUZHojUdB7VIkdadLzsvCuYGXi0WGaQD4Q4XHgdIJQII4
-qufGwazMMlQxrMWILkT4vi/Q6kKFIzg9OTlBlKBQxIeWmQLQs0BOKcNwMg7mKBOrSWmGpsJbNxJLmEwW
-iJOykPadjFiFtmw5JDOhNYqEijQwUrufnIpBQhr/6v1rh67tIYm1TwW5zBxAEaqchmjJS6UiIm/ohITj
-HKAZJytcK9iRCQVRdFS+pxhlnOQ4mPxSX6lbbGMZDcsiewgmg9mSUlzws7WlQlcFVVxkZOumOuyGccSX
-LJhcy9eDLYum5ZIHkzfipb3oKFpmRnxFGKRH3Ejj+zooJ68eBfkw+AJePQKhC76ohdbRT8hKPFhRgVaC
-AjYDCo5Igal6asSMwFxHQq6Vnk+uMGJLinNccDaK0nM1UU1ePYIcM4YWGHwZRZUSczTNsIbIMcdUSYy/
-IeOUVDgJwCqcl3QcDPLXgCT3Z4AUgJWU42Qg72RnmlpcZAq9qhzIJ7/xOad6MgnnGb7XE+JOg/EhDMSu
-SXIvdszTLVpxMPn5/In5+Il5uGMeBpObkqPMUBlFnGo+8PqdQd5Yp8bNVoJVSObjoCqrQT68vPhUZhwt
-8LVI8sYwtofQGp4ZrkqcTV5egFoLDD6djSKebNGNt+7kTDrcEknX71wJZwxP/pAniKV/3Qc1bqPG/lFh
-GxUeDBVuQW1h7kSEOxDXDHyaaBcq+2qi6WFsD6E1fIJotRYYvOlFtGYna0c0In8hbwxuo8b+UWEb1SPR
-1uGzMY9CtI/lHaaaZmoQmwNoDJ4gmNQBg1970avGXxtfC/wFuTbSRYx9I0IX0SOldLhMvCPQ6SIVRbqo
-6Xa+6yk1IPXA4IfdfOnk8EMpwdplhiGW27q74i3iHNOHDjVArdm5EHgRh9jm7OmT+u6fZbbRg9gcQGOw
-y2d7FU0NvH4wGoGvR7+x0UWMfSNCF9FbsnHKpOMVSfW7pGKTHsTmABqDnflon8qogdeWNwJfsW1sdBFj
-34jQRfTGJqcW6lcJvXoEgxXKrOCAH4EtitsieHY25OV7co+TQXy2Z3KT77GKjOoyXl/C5nIXDfeon2pQ
-7a566IsOtV02WuwXDdpo3ohnVUx96qU16XQwGn5pfzmCfelW78Tc9S9Yff5nkM8WxW0RbIl2kVIr6up+
-hWgPfjr70/51xL4Y5LhiM3p8HHS4Gd0bn90At7F789sNZkNr18/gx3rRk03zB6T/m6pCIoMb9LdFcVsE
-W6Jd9NeKmv6f+pQIzvZ0FByxL/45ntiMHh8HHW5G98Z+N75t7N7sd4PZsN/1s8t+1xMHqDV+yquSmqw3
-BbErgI5gF9+V2j4f5Vh70i63hL6oZhm/CTk+BjLchOyN4HY4Xdze5LaD11Db9qxLbNt6m9Z9Gf3u3mG0
-KYhdAXQEuxit1PZhtLUn7WVL6ItXlvGbkONjIMNNyN4YbYfTxe3NaDt4DaNtz7qMtq0/QKK+XuaKzuIi
-1hewvthF2+tlDga3v6Y9+CrBtO/kwBdLpDUmUuwTCZpI3vin4qFxevNNOb/hmfKQNTwUoW7Od/HmBlEy
-n4Nz0I1AL/JhsjTiGQ6IOzgg/todED+r9jPLPrPiM4u9DnVezwyjse2Sw3dl5xZ1vus5t5TzXcXZaHvW
-bu2yzcw/jWEHO3x0zEKdefYiz6E25Xme6JaOvglP7JuUVOFkVu5m0W7W6x1K9Z5JSWPbVaPv4tyty32X
-5G417rsQt9H2LL/blbeZlBrDDpCU1Fodk1Jnnr3Io6hNeZ4nuiWlb8IT+yYl/bFzcwgzBbErgI6g05ci
-qsZcIZru8aWIdV6zhL6/krDOb5bwGMhwE7L3L0KM850h2vtLEOe8Z3t2o/ggec5YsGOy683VF3nULbsO
-4Jtu6e/b8018kK+LzTOkI4s3yGBb1ik7NiXvngnSPW66ct/Jyj1+uvIj4cMt+N5Tpn08taV7J872cbXl
-7m3fHx/yFGuv2TeX9mD2i6aMZx5wnWV6JtVvzEmHSa3mSdiRxRtksC3rlFqbwn3P1Ooeml2579TmHqJd
-+ZHw4RZ876nVPmTb0r1Ta/vQ3XL3ttR6yLO4vWbf1NqD2S+aNZ55THeW6ZlavzEn7XuCvyhZlZL6F97q
-Ojau4fr66d/VvkczXlIwmJUM/O/f//pnn59619tofpVcj7397Lo21cGLPeNBB8/fj7x1yAy0I/zDwM2H
-S0UlcRHrC1hf7HwAP1yu/z3nhx7MkZDaUDnwFUNpk4kU+0SCJpI3nqioaJwjMORjihh+Uyyynf98JLWA
-VPv6Mu/ahD1z7nuKf1viYvawywWNEhh8+P0rfP9Z728/J3zAiDNS3N7gvNrlB60HhCKmiC8pBoP//ufi
-K/SJadMWt4yidTeEUSRbSRhNLsweFrp/h+5goft46N4VfZpUdO5AYTR7mFyJNZ0OEJObhwq7Mr2z3o0g
-urbOkA43m14kjjwfin1tmVLbe3Y86sYnOhyyAQpQbXjW7UTEGMzKLMMzzkBuNB0Bc1rmIC+T6ZIB1d2I
-DeUWfuLgrqS3DNwRngJlOcjILQY8xeAdYpyWBbi+vPoT/CNADNzhLBOvC0oSQIoVpus7rq/egOtlUTyA
-t+XDcDSlkTLzY4ZFRh2huo1LynnF/hJFC8LT5XQ4K/NoVWa37HeE0wzTKJ/mSTCheI4p4KXcSFLOlsIS
-2cTnVLeqAfOSgrykGJBiXtJczg6bTirV5CYlDJCCcZRlchLQZcGk48AKUyYkj4/D63LO7xDFn5ToyxdR
-RuYVyXCi/PL4OPxbmaFi0Wic1SjrBjH163dhCKJh0xoGhKFsG8NmlFQcMDobB59Z9Pm3JaYPIRzGw3PZ
-/Okzk02HpNakrZ/AUBnYRZljnmLaRdNuQPWE8mqJu6ihqnJURpFi+ekoUr28/h8AAP//Lv+GStxLAAA=
+H4sIAAAAAAAC/9Rc727juBH/nDwFT7dXOLiT1VBAUbS2gd3E2z1ggy4uwR76kbZoixv9O5J27AvyEP3e
+vkOfoU/URygoipJI2Y6kmM7ul5gcjfjjzPw0HtrOjL67/vvV3T8+TUHI42hyPhIvIELJcuzgxJmcn49C
+jILJ+dkoxhyBeYgow3zsrPjC/bMj5JzwCE9u3t1cjzw5VsoJivHYWRP8kKWUO2CeJhwnfOw8kICH4wCv
+yRy7+eQnQBLCCYpcNkcRHl/+BGK0IfEqrgQspCS5d3nqLggfbzFzgCewIpLcA4qjscP4NsIsxJg7IKR4
+MXbmjHmzNOWMU5QNY5IM54w55V2VEsqy/FJjocn5yJMuOB/N0mAr7k3QGswjxNjYSdB6hiiQLy7eZCgJ
+XBYrQYDoPZgt5euCbHDg8jQTGzgbIX0Nd0ZREqiNDz2n8CnKlWcrztPEuIOny2WEqQP4NsNjR+o4IEAc
+FdfGzjyNIpQxrMSILkX8vk/Q+krGwzk/OztDlCBXBIimkQRQV0F+SVqGg7GzQBHDhTRCM+GuuxxL2EyW
+iJM0yQ08G7EM7dmyS+ZCa+QJldxAT+5+ci4mASkdrPavPFrZQwJtnxJyFRmAIlYxddGKp1JFhL6m4xKO
+Y4DmnKxxoaCHxhVMUWH5nmIUcRJjZ/JLMZK36MYy6qZJtHUmg/mKUpzwi8pSoSuDKgYR2bupFrthHPEV
+cya3+evRlkWzdMWdyVvx0lx05K2iWnxFGHKPmJHGmyIoZ28eBfkweAJvHoHQBU9yoSr6AVmLJ8tL0FpQ
+QGdAwhFJMJWPjbgiMKtI5GuFl5MbjNiK4hgnnI288FJeyCZvHkGMGUNLDJ5GXibFHM0irCBizDGVktpf
+l3FKMhw4YO0uUjp2BvFPgASbC0ASwFLKcTDI72QXilpcpAq1aj7JH/3S55yqi4G7iPBGXRB31hjv+o7Y
+NQk2Ysc83KMFncnHy2euw2eu+weu+87kLuUoqqmMPE4VH3jx1pDfWOTG3VaCtUsWYydLs0E8vL76nEYc
+LfGtyPK1KdSnvja9qLkqMDZ5fQUKLTD4fDHyeLBHF+7dyUXucE0Enp5bCUcMT/4QB4iFf+2DCpuo0D6q
+30T1j4bq70FtYIKn51bZg1gx8HmiXcnsq4implCf+tr0GaIVWmDwthPRyp1UjihF9kJeGtxEhfZR/Saq
+RaJV4dMxT0K0T+kDpopmcgLrE782eYZguQ4Y/NqJXgV+ZXwhsBfkwkgTEdpG9E1Ei5RS4arjnYBOV6Eo
+0kVNd/BdT6qBXA8MfjjMl1YOP5aSX7isZgh46uWKd4hzTLctaoBCs3Uh8CoO0c3p6ZPi7o95tlETWJ/4
+tckhn/Uqmkp49WCUAluPfmmjiQhtI/omorVkY5RJpyuSindJySY1gfWJX5sczEd9KqMSXlleCmzFtrTR
+RIS2EX0T0RqbjFqoWyX05hEM1ijSggN+BLoINkX+xcWQp+/Fh1wDeNEzueXvsZKMcgiroV8OD9GwR/1U
+gCp3FVNbdCjs0tGgXTRfR7NGPK1i6lIvVaRTwSj5pfxlCPrSTcrP6rv+BcvP/2rk00WwKfIbokOkVIqq
+ul8j2oGfxv6Ufw2xLQYZrtiNDk+D7u9Gt8ZnM8BN7M78NoNZ0tr0M/ixWPRs1/Uj0v9tliGRwWv010Ww
+KfIbokP0V4qK/p+7lAjG9lQUDLEt/hme2I0OT4Pu70a3xn4zvk3szuw3g1my3/SzyX7TE0eoNX6OxfeS
+NdbXBdAU+IbgEN+lWp+PcrQ9KZdrQltU04zfhQxPgezvQrZGcD2cJm5ncuvBK6mte9Yktm69TuuujJ5u
+DEZPNwajpxuD0dNNK0ZPN30Zre1JeVkT2uKVZvwuZHgKZH8XsjVG6+E0cTszerrZyejp5hCjp5v9jO6V
+qG9XsaSzGEA18IvBIdrermIwuP817MDXHEz5Lp/YYkluTR0J2kTy60jW+CfjoXA68006v+SZ9JA2PRah
+7i4P8eYOUbJYgEvQjkCv8mFybsQLHABbOAB+7Q6AL6r96mVfveKrF3st6ryOGUZh6yWH7crOLOps13Nm
+KWe7itPRetZuzbLtI2xIjnj4aJmFWvPsVZ5DZcrLPNEuHX0TnuiblKabKilNN1VSmm6qpCTHLUr1jklJ
+YetVo+3i3KzLbZfkZjVuuxDX0XqW383K+yNsSI6SlKabDkmpNc9e5VFUprzME+2S0jfhib5JSX3sXB7C
+6gJoCnxD0OpLEVljrhENe3wpop3XNKHtryS085smPAWyvwvZ+hchtfNdTdT7SxDjvKd7dqf4KHmutmDL
+ZNeZq6/yqGt2HcE37dLft+cbeJSvi+tnSEMGd8j8pqxVdixL3p4J0jxumnLbyco8fpryE+H7e/Ctp0z9
+eKpLeyfO5nG14e593x8f8xSrr9k1l3Zg9qumjBcecI1lOibVb8xJx0mt9ZOwIYM7ZH5T1iq1TjetfQsO
+bdN8uG0fnk2X7MGHJ8L39+BbT636IVuX9k6tzUN3w937Uusxz+L6ml1Tawdmv2rWeOEx3VimY2r9xpzU
+9wR/lbIsJMUvvOUY1sZ+NX7+d7Xv0ZynFAzmKQP/+/e//tnlp97FNspfJRdzaz+7Lkw18KBlPN/As/cj
+bxWyGtoJ/mHg7sO1pJIYQDXwi8HBB/DDdfXvOT90YE4OqQzNJ7ZimNtUR4I2kfw6kjWeyKgonBMw5FOI
+GH6bLKOD/3yUa4Fc7evLvJUJPXPue4p/W+Fkvj3kglIJDD78/hW+/1T76+eEDxhxRpL7Oxxnh/yg9IBQ
+xBTxFcVg8N//XH2FPqnbtMctI6/qhjDy8lYStSYX9R4Wqn+H6mCh+nio3hVdmlS07kBRGRhObsSaRgeI
+yd02w6ZM7axzI4i2rTNyh9ebXgSGPB6Kfe25JLf34ngUjU9UOPIGKEC24anaiYg5mKdRhOecgbjWdAQs
+aBqDOA1mKwZkeyM2zLfwMwcPKb1n4IHwEEjLQUTuMeAhBlPEOE0TcHt98yf/jwAx8ICjSLwuKQkASdaY
+Vnfc3rwFt6sk2YJ36XY4mlFPmvkpwiKjjlDRxiXkPGN/8bwl4eFqNpynsbdOo3v2O8JhhKkXz+LAmVC8
+wBTwNN9IkM5XwpK8ic+5alUDFikFcUoxIMkipXF+dVh2UskmdyFhgCSMoyjKLwK6SljuOLDGlAnJ4+Pw
+Nl3wB0TxZyl6ehJlZJyRCAfSL4+Pw7+lovlUqXFRoFQNYorX71wXeMOyNQxwXRHJEZtTknHA6HzsfGHe
+l99WmG5dfwiHl3n3py8sbzqUa02a+oHvSgPbKHPMQ0zbaOodqJ5RXq9wGzXRsEpXGXmS5aJhVd7M6/8D
+AFISv2/dSwAA
 `,
 	},
 
 	"/js/app.js": {
 		name:    "app.js",
 		local:   "../assets/js/app.js",
-		size:    3235,
+		size:    3342,
 		modtime: 1566640112,
 		compressed: `
-H4sIAAAAAAAC/7xW32/bthN/lv6K+6pFLcOOlLb4DoM8oQ9Ni21YUmApOgyGHxjpbLORSIGk5Bip97cP
-R1Ky4iTow4a92OLd5+54P3kVGtBSGcjhPgxqNFtZ6oy+AyJjmcG6FYXhUsRmi5esmVpm0DEFJXYacvh0
-8xULk9ziXveYBUGInZCW2J1JRCFJ3B+OgLVUH1ixjXszEN/i3hsJFOrlLe5XkIPTbE9W+OCUKjStEqSX
-jocwOISHMLS3Y4axpoEcBO7gS4vxfRhglcHkhUJWGV7jZB4GJVa85gaVzmA5eXk/mcPkMFnNw6Dmd1wQ
-lXwgAml0sanRCdwf5vakNdtgBpPfJCu52CRJMgkD4j2IaJpCIxtwV9ZgVIvA18AN7JiGglUVlrDjZgtM
-7EFIcSbaqgKmNm2NwoRB0MjmmBCIfZjWUsXkMc/PF8B/6vE6qVBszHYBfDbrI8rXEA+AJV/B//IcWlHi
-mgss4dUreMS1l3iKEUW91j4N5JJNC2XC/3jWmlXa8g7z0MWiY9UQC1aW7KZCorWYWZNzuGJXwEQJWDdm
-D0whFFJ0SGUJRsJ5SDVVjQPS9dUJOTRMafxYSWbi7kGtcH3Frgj6Ds4hg+5YOFNXOVQaz1TO96vmWCSE
-zWAiUkYSxOiPY2PaMNPqZ8w55r9QpmOLa36HJeRQvqXmq5mJo+TNOpq66/ATztu/dDQNw5fxNFHIyn18
-Un6FFAILcy2LW7SdTo0ZDiCfsQtmMG4Fv6OgaMPqZnofBr5N0bv+BGjRg/aQQ3QewcwKJBt0KntALYXZ
-DpC4x1wSOZ7CDF73yD0yRS56xMe2qv5EpqwmXyAWMoNJOoGZ05zo9kYbFZ+9mQ6Mku1H5AWNnVOvP/P6
-n3i9la3So7v+TOejy1y0xo7Th3G5dPQBp7GQonyMu3b0sePO4AwmmfXcKTrx3bK8zmf9bxuyYt2narSF
-4rsq8a6PC4Mwy+hz73+0mh7h9H+EDypP4I+NX9vWiV0H2QvYEUmF72jL6AI7XmC0CoOe4oSi1Qj0SVRc
-YLSCdxBJ9w0ZRHK9tt9hSKPMmbRPDhgaZD7LvDCDKnLGNeSSlyv49s22pYf4N5RpzTciJuLci02dhZrd
-Ym9GISsM73AOGtFyt8Y0OkvTrsWvOpFqk3Zv0k3LS0w9mJt9sjV19eL9lokNnl2gQRuus/esQ2Z0GHxp
-MdFo4tPrzoGXc+vLU3G+YIYdk5ymgHdGscLQgw0V08bjRvF3+TtG387cfYMD65cP7394/eP/z3umfRUg
-d5PLp/8L0Sj11mihkCIj1XdS4ZeCZxKxpFtQ8q1Bp7lpDXBhpE8HJd2NWKfGvvzUW79jgbzDEqjHeAkz
-iCC1B+vaDKLMnjSPrfb/Pq8PfX8uq42SBWp96Tw7ZpYWBzokl1acFoLR0a8a7vldSwXjheQxbrySPGjX
-EXTJV9PhaQ6w0nZZcghXO07B6axZDLRjaS78Xjge0ONXqx8POz2HShaQw46LUu6SShaMBPwsbZQ0spAV
-5ARLjsccIperiObETtNHRh8ZTYid9rP+D7zxJgfRGURpSoVBCrdSG8FqKpfYGqDV/B1EWQ+whMxuXiS4
-0xG9tsFOJ1KgUpKetmFhx864EO10UlRSu+fy4OGWMoLDCO+c12jnrWxN/CBec3h9fm5VLbyuYx88oY0C
-91VLATn8ev3pKrGLGbGTIV8nRUdor/8Q/h0AAP//9poL36MMAAA=
+H4sIAAAAAAAC/7xX32/bOBJ+lv6KObWo5bMjpS3ucJBP6EPT4m6xSYFN0cXC6wUYaWyxkUmBpOQYqfdv
+XwxJyYqToA+72JdEnPn4Db+Z4Q/XaEBLZSCH+zDYoqlkqTP6DsiMZQbrVhSGSxGbCi9ZM7XOoGMKSuw0
+5PDp5isWJrnFve4xC4KQOyGWeLropyikGfeHI2At1QdWVHEfBuJb3PsggUK9vMX9CnJwzHZkJx8cqULT
+KkG8NDyEwSE8hKFdHTOMNQ3kIHAHX1qM78MA6wwmLxSy2vAtTuZhUGLNt9yg0hksJy/vJ3OYHCareRhs
++R0XZCUNZCBGl5stugn3h7kdac02mMHkR8lKLjZJkkzC4DA/yWiaQiMbcEvWYFSLwNfADeyYhoLVNZaw
+46YCJvYgpDgTbV0DU5t2i8KEQdDI5lgQiH2a1lLFpJjn5wvg/+3xOqlRbEy1AD6b9Rnla4gHwJKv4B95
+Dq0occ0FlvDqFTzy2kU85YiinrUvA0myZaFK+D/etWa1tr7DPHS56Fg95IKVJbupkWwtZjbkHK7YFTBR
+Am4bswemEAopOqS2BCPhPKSeqscJ6fruhBwapjR+rCUzcfegV7i+YlcEfQfnkEF3bJyp6xxqjWc65/td
+c2wSwmYwESmjGeToh+Ng2jDT6mfCOedf0KbjiGt+hyXkUL6lzbdlJo6SN+to6pbDTzxvf9fRNAxfxtNE
+ISv38Un7FVIILMy1LG7R7nTamOEA8hW7YAbjVvA7Soo2bNtM78PAb1P00p8ALXrQHnKIziOY2QnJBh1l
+D9hKYaoBEveYSzLHU5jB6x65R6ZIokd8bOv6F2TKMvkGsZAZTNIJzBxzotsbbVR89mY6OEq2H5kX4eGx
+6s98+2dUV7JVerTW/9H4KJmL1qAeRA+anX3AaSykKB/jrp19LNwFnMEks8od0Yl26/Kcz+pvG4pi5VM3
+2kbxuyrx0seNQZhl9LnXH62mRzj9P8IHyhP44+DXduvEbgfZBdgjkhrf2ZbRBXa8wGgVBr3FTYpWI9An
+UXOB0QreQSTdN2QQyfXafochHWUupL1ywNBB5qvMCzNQkRi3IZe8XMG3b3Zbeoi/Q5nWfCNiMs79tKmL
+sGW32IdRyArDO5yDRrTeyphGZ2natfhVJ1Jt0u5Numl5iakHc7NPKrOtX7yvmNjg2QUatOk6e886ZEaH
+wZcWE40mPl3uHHg5t1qeyvMFM+xY5DQFvDOKFYYubKiZNh43yr+r3zH75DD7BgfX/z+8//fr//zrvHfa
+WwFyd3L58n8hG5XeBi0UUmak+k4p/KPgmUIsaRVUfBvQMTetAS6M9OWgoofBkcbe/LS3fsICeYcl0B7j
+JcwggtQOrLQZRJkdaR5b9r+/rg+1P1fVRskCtb50yo6VpYcDDZJLO50eBKOhf2pYJL1HYPwgeYwbP0ke
+bNcRdMlX0+FqDrDW9rHkEK53HMHpWbMYbMfWXPh34fiAHt9a/fGw03OoZQE57Lgo5S6pZcFogj9LGyWN
+LGQNOcGS4zCHyNUqonNip+kjo48ssoW8RWzghmmEhpkKdhUK0KioX26w4qIEhR0q8it5t/fRmKn6SMxU
+gm0xUdjUrMA4Xf72a7r658t0Ts+wRRgGO+0vlJ/xxusa1jeDKE2p+4irktoQF12UllsqQ6vOeoA1ZMQL
+M7eIGUnpwyRSoFKSbtHhtwF2xlVjp5OiltrdzAcPt5YRHEZ4l2eN9miXrYkflGYOr8/PLdXCcx233BNs
+lLWvWgrI4YfrT1eJfQOSOxla46S/Ce35D+EfAwDSriUZDg0AAA==
 `,
 	},
 
@@ -671,240 +672,239 @@ EXTJV9PhaQ6w0nZZcghXO07B6axZDLRjaS78Xjge0ONXqx8POz2HShaQw46LUu6SShaMBPwsbZQ0spAV
 		size:    50731,
 		modtime: 1566640112,
 		compressed: `
-H4sIAAAAAAAC/9y9a3fbuJIo+n1+hczJVYhxmZG797kzWwrayw8lccexHdtJd9pb40VLJYuxBCokZMdt
-6fz2u/AkSIKy03v2WXedL4kFgHgUCvVCVeHVf2z8W6v1H629NOU5z+J56+5v0Xa03QonnM/z7qtXN8iv
-TWU0TGeviPxgP50/ZMnNhLd+6mxvb/3U2f6v1sUEnY52F3ySZrnTU8Ini2vZB7+/zl/Zbl/dZPF8kr8a
-poxnyfWCp1muRjlKhshyHLUWbIRZ68PhxXO6u56m169mcc4xe3V0uN8/Pu/L7l7928Z4wYY8SVnIAclj
-kF5/xSEPKOUPc0zHLfw+TzOet9uBGHCcMBwFG6Zylo4WU9zBULeCDL8tkgzD4Ou3BWYPASlK5ul8jln0
-NQ8I6QZm1GIg1Xm7rf6P4tloR/0ZXga6+wBMv+B0NwAkXQx5ZJdLH1fAo68fRVPg0alsSlYhnyQ5uOuF
-IXkMFjm2cp4lQx70TGUrUeAYp1l4F2ctRjs99hqjKbIbPumxzU3yKMoTipds0EsiZIsZZvH1FKn7Y7nc
-2IYkGqZsnNwsVP1GB4K7eLrAIGGtpN0Ok+g+S7iuI3AidyBSiz/N0jlm/CHkkES3+AAJWa3sLFO5CEYe
-M+SLjLWw3U5CHs2zlKcCrIAEmCwDRoAXH07CrFgcp9s9/jrObhYzZDw3i+RmkTlli+l0g9oWl3yw4/7o
-Pq4AqZ73LT7kYU56ni3WLW6Qn9wzs7Lzh9l1Os3b7RApCkgNYx6uaxnmJBonU45ZWOylBYH3ywPMh1ky
-52kW5sCJs0ErQghgNE6zfjyclDoUS0dgkPSQZpDQ/JJRPgDWSlgLd/y7JNo/ys3tJlCM0t3ogIsD4rfZ
-8+5GZ0W6Ao1osiIrvYxshRTbbYwmce4sJAxGOI4XUx6QHYz0312EIR2228M1jYe28bAnFpYBgxximMIC
-xjCCK7iBGczhDvrwABewD4ewCwdwDedwD8dwArdwCkfwFc7gHfwGH+A7fIL38AY+w0fYgy/wFr7Bn/A7
-/Ap/wAvgHJAD45BwyDjkHFIOMYcphwmHIYcFhzGHEYcrDjccZhzmHO449Dk8cLjgsM/hkMMuhwMO1xzO
-OdxzOOZwwuGWwymHIw5fOZxxeMfhNw4fOHzn8InDew5vOHzm8JHDHocvHN5y+MbhTw6/c/iVwx8cXnDg
-CIjAEBKEDCFHSBFihCnCBGGIsEAYI4wQrhBuEGYIc4Q7hD7CA8IFwj7CIcIuwgHCNcI5wj3CMcIJwi3C
-KcIRwleEM4R3CL8hfED4jvAJ4T3CG4TPCB8R9hC+ILxF+IbwJ8LvCL8i/IHwAoEzQAaMQcYgZ5AyiBlM
-GUwYDBksGIwZjBhcMbhhMGMwZ3DHoM/ggcEFg31GLTonGp1pwLOY5YkoRDZyCB63KE8lmWR0Y7unkTGR
-lJNEKcNwGl2c7R6fH14cnhxf9Y8PCnpKHhkVuAw58otkhumCh27lcjmNeJbc3GB2YSfRZ6MQyQo4ATHG
-SsxgSh/LY3SD67z0SQA3yD8dHnTd8yrIWY9v0v/9v8Nt/H//40PMJ1EWs1E6CwmBUTqU9EpQhv4UxZ97
-D4ejkJMeMevkK9HvOU5xyNPsTZbOdMtujS5Q2dEuV9wZw2AU83iLx9kN8oD0sN0O/j3YoBSXy7DeeJLh
-OCDLZRCQHs8eDO3qvE5CM09B4yRsNDnewa6gwqthzIcTh97JQjnvAkIHiywW/zetIBmHG5yYQXtqQUnI
-STTM89BBka2R7imwMJrHWY5vpmnMQyQ7kmbn82nCwwACctkZwDb+/B+lRqTbWUGG42l63/UQbB6l43GO
-/B0K2WkFPhQpz15MVLeSuJMv5lI8aPjEjrSXplOMmfwmyX2AUQ1DftkZLJecRCwd4cXDHFcgONj+BIe3
-+5KMd0tSBCt4aSK4AyPJ2LAwy4srxDkaxtNpyCAhCqMyyi6TAeQUxX8pzdvtaWRnGeZkJ0D1d9ANY5rD
-4yri6TnPEnaj+opJNJPI8eofeXgZb/052CSvEnK5PYh4epTeY7Yf5xgS0hP7z/C+dYY3/e/zMCMRx5yH
-KSF8kqX3LVHXz7I0C3nE009CelKfbr7stk7mkl4ELzeTzZdBa56ld8kIRy2xRlGaitLrBReSIw65U5Nt
-vgyil0Se8Xi1stQlGrMIZ4tpzLG0hVTIPHgnDm0+x2ESTy+r1GdAH68TNhJ71BW0eoo3ohf1cxKz0RSr
-mC8EJHVOSZTkirIRi4vqm5Prr/qvLIrn8+mDEh2tzENWK5iuQiRwyGjIaBBPMeMBxDSIgs0wp8F1Hqky
-AlMaZhRJNGaXbAAL+rh/dHLe7wbDaZpjsBmD/H2gC0aq5HD//dXB7sXu1e7poahJhrfBZrwZRJLOxPMk
-WMHYjjuiwTgeYQBXNMgn6X0AN9TBf1eqJY9iKdGVRibKV0oETApMteQwkjOiHuLndtHjUnZTZTfIz9LU
-UNiQE0XXoyt9YPdFj32xp4K0JfmBkkpOM7nPOArJcqk+yHCW3qHpSPKIaJTk89KMyGMWqYYHMY/D0rwg
-N2ObtUpaCbw6S88C91nk5wQhJyXWiO12yKigQ5edAQG2XMqfgpSKleY8FBgx1rVy8BokPONnkYLRIpLI
-YalvVqZ9gLLHEqiK3lD1xuTWiEMvPtHg2p/GeR5eEZBlkzhXBSNNjbiGwFqeEiLpyc+FXLDPGgUDh1lF
-VyPMeZY+2C6AkxXxHv+QkxVOc2ypTax+KBGiUlqCpITVCLmQ6wugaYgeGECEghlEV0pZPGQcs3E8dDCs
-UK7kNLCkJRhgZYqMgOD04oAK/UdxfkFKtfgEpg6QENDHn1LK2m0h/atGKzUdRXwOknyW5HlpR/Vk3IXy
-dlvwGXmC9HkKhVIjR9DdriANExAHAC4fb/GhG3zun50fnhxLUarOLANp8whWqwGBZBUKPCkkk5RJMLo0
-Cl5eSso00lPWlGnwEm4qqwkFSG4IgUxRRHpTg76pivZTlvNsIc4gvbGlLBVMeJoMOa0zedPrFOr9ruCG
-wC6j4ZwG1wvOUxZAX5HsO0mydSGBB+pQWrig4UxT8PkA9mkQD3lyhwEc0uCaswAOqF4+T29upvjftvvB
-S7gu15mqXNSd0yBh8wUP4J4Gken1mAaR7PZE8AovJ+hvPsCbk/1P51d7R5/OivpwlwbjdLjIAyLabAat
-6+kik+1XcOvlCayZJzAfT9DLqJ2BjQ5I2wajszIdLkjhtSCDghAxYz2pNZVC77lpl0iOHWTxKEnFUUki
-MRchXSXRUEhjOGq3a31YarZPCBe0WlARLWTNQqYHuZeDZOL7rEwV98kqGRtpQfTmivlJLpT2kZDe2Zq6
-JBqKvo6SnEfDlPE4YXn16/UttEzSsyulG+tWCrMwKahcMJzE7AYDskoiiQ+CINCN7dUK2+1SJ1Fe0kzi
-LIm35hnmQhKBtSMS4B7gK/SwgPQz7Vkz077zM222hkTjc0j0TOuwkgTfkR5fLkMuyTPT5LncAoToEqjV
-CNQTcLvEQSgpdBqyv0hLmaClszItPanS0jLvrNP2nlFBlRzbm5V4+CGRnEcWmpN3TAjc1kCo9AbR0K5U
-MGM5JQ91qcxLTWLmiNPFaOJoyQm46LALr/5bIWPCyM6LV0rr4OpMkxWBmaKwtD5TU1XiCLe2dC1HML1e
-eCCwglsCB4yGRzQYxlm6yHEawJniCV8lT7DFBN6VuMJvNDzVXOFoAB/oYyJ6vYun3f+FP8MtPlyncTbq
-bnQgnyYj7G5swzxe5NgNJukdZgHcZ/G8u9FZwXfn2yBki9k1Zstrpa2SoOgq0GWB7jEIdcEyl3ogCcwI
-oSpwOpGD2Q5W8IkGDL/zAN7TQOBXAG9oMMUxD+AzDeQ9RgAf6eP50eFBvxvIAYPNMxC/1U/x633/y8HJ
-b8fd4BYfRuk9E2UfTj6d9/vHF/2zbjAToBOCfWZrjvq7n/u6Zorxnez14uTT/jtp6eHpYjhBJns/Otk9
-cHjfNI1F8ea7Jv3obPPdCvbcnfxS8Oq3VC8CvhUtthKOsy292j+r5Qocv1eLFdx+rRYrKP5BH3f3Lw7F
-Ei1DVwVXhxf9D7Y0Kn0cgK6slB73f7+4Oj3rf65WyUlAK/JN4fD44HB/9+Lk7Lz0FRslw5inWR6AhJze
-WSWcSNAMoOX82uLpQDc9Ey21GJMlI3RAPHi5ghdewSJXVyiKmovpqXsETd4NwrtlCjJ9h/CbxvmpwOsR
-3di2JefTZJSwG1skEUfbHd1vld29UE+VAUfQvDKfOQ25tF+Z6Rlo9Yt6j6jyR1RA2/k8Ho2kAie4OzLM
-8pBomSr3yVRiM10YVtZoVGK5LeEnyVXFJ79NkH1O8uS6LI9tWFvnJBmNkLXbtbkneRh079SnAWm3A9Uy
-2KD1dUqboGybTBP+IJrLFmIGoZyLQLtnT/+9+kTsZ0ldkwy5stsdAk1gtydDQL3dFvqn17hc/lptz/Bh
-OMVwo0MIDKcYZ4caF8MyahIfqorJy++fnPx29ft2O/zh4UgJi6Oiq43ycO12pTeaI7cDWXkjKvbxnMcc
-d+xOOrjUtYUkuk40DBsmQuRu8rTBSNTzHeymLXXIpBQgCuOFPLiHHGeHbITfQ0+nyqgZ8l8ccqMt51vb
-yyV/3SFCbahgJqlNJWUYfowEaYS6JIERT0NOVkRpFEKRoZRaS7rsSiJ2SOAuTUYtB9uU0JZQ9prvfOq+
-77lHIgFn1pd8INR1r9xcn+54HJ4ROG2Wp78SaKDAmjA6JeihvB4ybVG8VGbo8bPoeY26uqY5RaGp766A
-TsLHFXwATkAc+LJRPjwCDt8JcNlRjQRXVdYShmq0NqKWh2SmLPwYaXnHZ9vC6EoLQRJFQEt5AkHcESR+
-tNuhv/9CdvIPobCLayldt5cSlb+9wj01n5RJFpnzOONBwlqWJpg/9F40rd3IaO7BQIvvWGLA7bakdOYa
-sFxZbUx9F4beVcD/6nQ2sU6EVooMmQ2g1cuuV9LKsuT4nccZxq8Sq3pIzSXi8c1xPENC8vtEXq9F95Nk
-OCGPwzjH1s//2fXY19Rpz/AuJL3rDOPbnmr898bGmmEaNLf0zIvpzpk9jWbxLe5mWfwg0IZE8zhDxsNC
-BBEUs3TOo0R0fDKWQCuG23s4SDKUY9Gy34+mtZTST5DI/99D5qO9SCCnPiIrCHCYtNsdSmm2XLJ2O6OU
-5sSyKr1pQhExFBMlUUxpmG2Gcsydre3uNiH/T30ALSxtbVNK050SvfRMZtB1W6QD1/AuCFXN8A4lY3mV
-3xABk3r5MxkZgYyeaoO+4i19eMxwGnMcXUgE7HIYmY3pIoyzdNZNgKddtrKm/9pgxtaTEcjk+nLku5Li
-Hhry6jOLF1ywSoQL/lKvKi+tbC/7Yg0S/o+j4SSZjjJkl17YDnooSA6SKB6NTI/qlEj26NslSCDTeAjp
-cwUKiH2bmBKYUlwuU2MYq5+VkENKYOL7ekpgSM3FckWeEydCnqidkNE/IaG/Q0bfkG7I6DdI6K+Q0c8E
-pmLxU8d+84WQuo4jJY6WvLh3r9MKXA6nkPkv1NrttN2e1qRyutGBoV6zIeJaKGnCo3CqdnpRQecqNk8d
-bM4UNscCmycrCZPTRqPiWyJwcOpgQiIZvfIeEMA+DVOnlomCaalATnD8rOurlPRkd09cX+kpuRjPNoNW
-sJmUMFZNrXQuVCv5LyNQ1Vr9bjL2tPuO+oKsoNN4XzbW92X1iVTB9IX41OhGCrMgPYMpWphdrcRyGi2y
-yXMssqeuvfUrATTCXamCkF7NJzWRF86yNUJCiNYUAmX0ctrtJF2MJBGRZ9GafNW1PyApj/VVGX2VAc7p
-hggNJ0yIPYS1kZi6qChcZG0Nv2QDx63i4mGOyrXi5XHamiGfpKMWi2c4agUvN9nmy+Al6YlvQr2Z6Oh8
-IS9OqtkJaY/OoyuxgN15sj9NhrfvlOvCj91rCzAIKFlGeKouteV9jaBRzDmoe8Tc4MhdkJVqu6q7Z6QI
-j7eUsTIFpJdJN1irbAjdOW8wV4uxICGQ6Tlp9BE7lBGoy14refeZ/xV7PajWuquG1q0P0rCfr+TaS4b9
-j1XD/h9RYXmDF95NE53cJ2yU3usuSkbQMlXS3Z0dHvTJ+kPWe9EATW5upSUiCU1SmrNpvb2pKpngX9jS
-tSZ40+tv8MJjgn9B4JrREDkNhul0Gs9zDCDhygjPuLLCmwoCGach59ryjnwAOaeP6p6hu9EBJRx3g2AF
-aVFRmM5NvTGRG2oXrCDm9PH83clvXeVEs5lwED+P1W8mC95Jo+lEWsPVz4P+cdfYz0RRg3E64WXvnSk3
-vjqT0sKHxS9BYmDh1I4CGHMa3CcjPglgxGkwQWW7vuLG7nzeDSLRL7Qitxs5n4uTt2+PrCXXXEjbsQcv
-V3DDvcbcuGTMdVztXBNscYH8TNurZjBStaGcO3oO52HjLC8nGY5p8O8vN3mUjDZfBgNobOq4ZZa+eElI
-r3Du5zy84pEDIiHwd3rJa2a0j8Q4w1s/vWZSmpGecpinebvdec2547HOjT+nNdjlugeae4ASzRf5JMwI
-Wak6hbxVi4Io27FAPtXaYbdm69FNjT02Ho12syTeZaN9g1+KupctSPVZVWyBCuxaQFA/rLk7fp4LAeeN
-MuGUE7U2ceZCohQ7geDK9iz+qjkjAELh9bThRVqhlq4d1G6QAppSbENeQ1O3kdY8rrhWPc7tvmtk1vsX
-vNz07IvGSxdDtA14I9Q+djyUXqm8gjuaCzJOSLuNtbUSy60514J7zCNB2pSOwpsEv4TARuJVKqTXURg3
-cZWmeYKinARwuVSN9MQle9aSnGN3OEhmyHK5s736NF0Zd8Id+XbICYQVNwf+MMXLbEA75LXnnBmQ20FK
-+F4aaSFG4jzTzhL4fR6zkfSW6OiDkbuaR8Juwo2OWllOg3yYpdNpsBlml51Bxbs2E7LqEMNtQiB9lgpT
-BogHRE/qNpyHrAGkQxekJfhOOQHmgW0QAPOsfptAZRiDYBoNjxt1mrRyTVeMVSq+zAebwfx7sJJ2g0nJ
-amBEoafowXpyYG3GzgkSssATJwgJbGDDCarYm1xct0aa8rJZZdkCQfbSBRMa3P40QcbPcMhDcskUOBzd
-uXoPVpuxe3hqJ8v9LTa/03yGiHWFlxy0sZ3LUuuNnsFjhV6i2SxRPNbdL0lfMmdZzcd2W3NX77Ft2IYg
-0KbL/0OntPFU8eceXufIKaF1zZlTx6g6ZPnG0ysJ8oaLK86bb6jstaznRkrzy/V3VCUpsnQnVZ7cs+6Y
-Qn3HlHPgxPgvFRY+XeC9fkIOHFJuLqDcI+3RidaQmzEnO2PeHdl+lEhXJWmKdoCCRW+fOeEiHumC7IRe
-wRF8gb2edpEKvZVSiaf6sjMgpMsp9w9u7nORvlwvqz8tIfUcABZRUmX1VyorbI2IG0u4Kntl2aUdLnEg
-NGF7i+jtoGKUVv6iVpmo8I5ex4YOSzZT8YtbcNhgDcSJkdUKGib7g3ELNmRhR07hsjNQEWUQ/7OWPL3n
-jv87k2ES9iAZ25DPkpfsJN1HZRjewHabWXXilRDtl4KXa0/BRMjkpl7aiAofe0VUZJSzFSmlm33NJtho
-qcPL5PmWukRZ6sQ3IVkpn9D4X2djyrk0MsWrUBL9kpUp5lUzU1mXLd0MB7vyRjoaLjJxoC5K95++OIJe
-gdR6i5+yISoE/wFM2THOp12mEaV3wxvtVagMVZxr8w/1tC1qyyEE3KlYa7Aqes84eAZYwQ0ncM5oOOc0
-GGXpXHo/Ql9bre6U1cpWEHjg5ZACTsOZMWLN+QD2lWVah+QFP//X8m+d5U//GRA45PTRtTr161anvjJU
-GbtVv2q36murVBE/0Gim6vPNB278Op1q6+BpG3w6LVcv5rpyBbsCKMaTHQ6spetaA2sxD+Bc/62dL+/1
-T+VzeexAdWuGbGF8NE84Deapjk7NecyTYQC3vMpVLOAHL+FUQl4XtMZpNgvgyC2TAwTwVZSx+O46zrZY
-fBfAWa1Rq/iZcJx1hYYbmYUS+bNrfwbwjtOAp/Mt5XIBv+mfyEYBfBAYknKezkz196JEtvjEtR+uafCe
-Kw9d8/sNp48qdrbbgfE0mXc3OnAt1IE4e+hqTVOJDgFkOMYM2RC75rSBkNOm8UM3GD2weJYMgxV8Lrq0
-jsjaTmoOCQnUWIVFtRiyZlN1h61X2gloIr2Cj37r49S1PlaNjCoTyI+4fIqtLGo/IFsYClb44B1LRKAm
-9ozjkKsi26bZx3P6PKNXoYsa9cLgTru9MWsWD3e5jdKbOuJhs8YBSG13Yu1OXwfKc24aXUmHHQGLPBQ6
-q5Fmqo4KbscrSOjM6MOHSpUHJjuc8fCZdiRHI9dAb+TQQy97DooEO/aU66wzrVOTJaZIkmMTx0RpdkOC
-ssnJhLQGSt6seW9ZdN7JKO82i9y2nRBZsoY+npa8bdNC+G7qTMrfUD70G5XZm4PabqvtsfrhiVXBzGHC
-+9YwzJzzAoV9WTbRZ4qQ1Q84lnUopWpoEyry1TGIzwrBJrpORw/EOo6EUtBRMQMqdkKe9hmPWJrOqyYi
-E/D0ZJxT2XRXOSKuhH7AVXWt1GJ49RgcAyNCjW4KgFqjD99xZy4lT88+9wVHgTNtZS+2+652s7BkK9TX
-MbMhqRNQaVJfzEcxR49D91qiqG0x5YFL4+bDCY4WU/wk+1fm+2e7aXogwgSwpRjzRLwU8Cjn6fw0S+fx
-Taz6lj6I+qqCPNvzVPlAFCJlpMfwbJhWefyeqnMOvLEzQdocI4LDn/zcQ+LrDzGEnoMxs0KNPpJ6+qrk
-gijaWDPENB7W5qHD62rrN06KgPQD7zkJFjTnueYyfcg7Dk286VhewiD9jRPSdb4852QH6Sfult3Lsve8
-+1Rn37mJmnfx16MCdF7X11TE9Cs5MTDEy0LIoY1+RAZOH1e+5FglSq1ksB2hhdA1OVNyjZXmJ2Clh9BW
-keXyUVo1uqaIekbU2t7j3Ox0tyD7pigkMEtHyTjBLO8aaZErofARmRRguqW+RdUK9Kk8ucNM5oJ51Owo
-QZuLxcutVjZVSKAF/ipX1nKktA7YqanEHef8YYrUTmtjeyXTMEz/6XBSXooWVVJU4WCkYjvq9g7UaXws
-hbcdKNejqrUC1/gV4fOtFWj8itBYK0oCX81fmi+XPwtartyg2+1Q63eyTExgufw7tfXEmvyFnOncjs54
-eCuW1Zw9r4lc4SWTbrMz/acFM+RViVTUr2R2C9VpSjN1+LUYmpSl3XZbXaZqfZeaFbXbz3USXy41NJxv
-XWgIQaaI6E7AxsdqqT0uycxCs4ec9NRMrZGeQNyQHSX8AXlrvUQ1Hq8RqQRY18tMwTieSoecGa86HGp5
-KamVeuUlZc2AnGiJqQEpqCc537MMnkwS/hkPmfLGxuWSa/Z0nI5QDqg9sd4rK4fPgS4Zh+FzUWRnI/z5
-pwIllsuf/rN0nP7WcX/+/F8bTtMZ90RUH1kxmZDuPjfD6uOnPAOfI/QoiaGuZla0S8Ow1kkT4ljPeCny
-XCuTIRMLpu4Kfyqdj3CDVUHy808bDj0x2SFk91I0OeNEbLS6GhXQSww89BViUoQ8GOj1fv4vdxKd11m7
-nW1twd86bnH2OrFhA+12trkJ2euOVLQ6BJLLbGD0idXKRH25izMpMp253qrrjpm8k7QZGXRajpWFeJGr
-QVIi7ag4/dcZkd/wakvBNZpaf1Ym56lMWFAxOR/yqGohhFsOH3nDQWr86OgZH5UNldKbWnXlGCBlLwVD
-838qpvgXFIWPTbZos5PV5An1gU+rA9dGWQkga1sw9YxY1JYs2h+5U7E+C4Lt/YL7lrSCj5zAPaPhF06D
-WTqKpwF80+bst8qcrUoJ/MlpuGeM11/4AH7n9PE6Ht6OslQaId3kBxLvZRaESXov0x386rb25DDw5DtQ
-nTj5D0RfTjaDPyo28m91G/m3so38W9VG/o2rjDqHx111VhNVeNY/P/yj3w0yzJM/Vdd6dw/PPxyen2vL
-eaQzH8kGFs9NE209LzWSsXsCh00jyYsX83qjcl+yWa23BnP+t4rX6QuzuVvKUnQdZ1szjPNFhgFwNJVm
-ewJAW5bOkQXA0OSaS9CY9TOkjweHu0cnb7uBQpKtURJP0/VupwqbBi9VG7O8ah4p2+rN4e/9g6v9k+OL
-/vFFN4jGyXccbfF0Di39tzKeQytK8i1ZAq0o58nw9kE0C+D84nD//RenC7fyePfz3u6ZnupZ194EqNlm
-wQrKFhxrm85c2/Rzkw0Y27UCE90rdPAMIwVKJ5uA2Y1KwK3AWzcjwl46ejDaVcktN7lhaYZ7uhfpbV5U
-Wjz4LRnxCe1oG3b2HBt2LV5RTarBX5N7HDbL0eJrvTXdEcjjXrN9nGHhvFlzVe6YG809I4H+oQ3XVUv3
-ivT2Gv26GCnvw3LJmuT1yn51zCXFBIe35wb4bvBVvTAefV3k/EAiS0hgrybWW0suotNRPx/Gcx0e5hSf
-SUpmivd8hrU/LAtTxxIEWjrH1B9tLHdcBhvbThWCmz5r1Mz1cdrjIVZ8oswnBZUsDbzHy7k8nc+FyN+A
-+B19gazAMUnvTX3oi/iXLQqdYkWIx8WwhsX+lHyNnodl1K7jp3U0fD5CttsbrMlzt0JAdGaC9SdKpmNb
-d6x+HO0ckXI8FsvU/NeHk64emaAXa1UfJbT1IaJqVsNEAolRJf6CS9/ej7r0lfIGCGT6IPhc2JwMMysl
-wyy+II13DHtr7hjecgkaFWhkc2ODz/HfMB8Fum9rnPU8rnmavzkl63hZqajKzdxKz7F2qisczbj9qSyU
-nxouN8ok9tkXAr/zBgv/Fw4cfrXOgA4VaSAbwJ46geX7rMKAUc3oV9TYBNaUUvm7f9T/0D++uDo+Oegv
-lyUmEsXzObLR/iSZ+rPmlJ1gjdGVBtfTdHgbVNoozKsaj7QsXutQbthFOqcdYO12o9PyXrPTcoKVoBQp
-wlvIsHGaDfGNUuQtvSsLAMceCQBKNwNY7R3rl47oDY+q8LbiXnzlpMPM/ynKU3BaH+HJGshKrslKpnHe
-hVTDTdw6yq35vP5ZInfmG2VDlhB2AVgq7lBKKyCbxLnD7M2VcX0D9GVemQ2tTf1iGaY3E8xOk3xU0fFK
-ay1bv0Jvdl4lHpMV6VYEyUbWVhnRLtRhrM9Z6I4l/GYpSsVtkOkcqinZU9f9XE1Mfa/mYzlTQwDGWlLC
-UobB0/f2mowUATfNEYlN8l1dhHYlDClFR1cZisElY5Bp38Oi1JXR98pe+OZw/+E42jtpus1s6izIsMZ2
-u5DzCu5r0lX7+CixPKbe+48xmR2G3SDouamyPIfDzqrIJmLnY8E6zDDmNoF7MErugurkVQrc43iGlKMg
-/Z51u8kefNWSa12kle18plrjQsmvL+w0qRHbXUOO6g617Xbo3FViDW47dbrVdSiCjwnaFcM6GAk2aJ/2
-UO88mFw7KiGZ5X7PZzV2mJ5nZD+34Q3cJtHcxtXzS/hVIFbjCbAL1Y5bZf5cPmOhzFHMQ8Vm12P+j/Pg
-vwCY57BhJearaSsPmUIy9RPVqiSlnlL5peleMBrKUDHVqrfRIHC329yqe2VyPY9Ho4TdHOHYDF+WuGXo
-GYHGfjee6PhMzGtNzysfba6T0+ZJBwE8OXwQyGHKVpo6+MsidEM4Xq9Jp+HRFMd8k0fSz/e1YqtRwhiq
-9foVGmtdPC+Vh1YgaJxvVhgo/DOS5y7DqGRs9QbXGCOFiiTxQBASVavyh+oa7cYtTwzqW/1KHTDvJ+C8
-KZSQzawBNSR5zDAq23qfv4JZnN0kzLMAVeGdf6nKTr9cWpr91lOzLxuj/382+2bY93xnwoMZSOviVxOW
-VJp5MYY/iTFINtfQk1VdrCsbUn74SHjnSXo+h18l9fjPidgOx4ZT7a8B2zcDaAWbP4xGPnx4zpRrCOTO
-uNybRZHnbax/dC5Hfxp9gK8HnjbxlInoGgrvk2d73BFhX3BYY1EpsvY3cIlIpnXZMuxZTsdcvZT71YKQ
-7hdwBdm6F2zgWUF8eyWHtLfcJuT6nUO5zhPHx9pttsNMHF/hB5fZRFvV7v3+bv+CPFqJTaQl9KN2W91A
-iWIZtJf96/wtflceFNmqZu52NRHjI2BuWDxBe8bZScIyeTqJl/R32hO0+rIzMHKyc8bfujF3co+dyupW
-EdILdm0ogY0UDHbP+rVi6VjlCyDM9fD6Ukfet5U9IvyXZ7n5QvuIlakyqycyZ44diPRybHDdkLMBFd+g
-yKh2ZaCeT4raku9F7las9b0oev+Tg2eAFeRI4JjRMEYa8DSd8mQewASV94V8NCiPTDmBIdIwRe1/EeMA
-FrLFlv1yjKVgwvC/l//4R06CzQVuBv/4x/lmAMFNQOAG6WPMkpnUb7obHeA4mwvlpPvy9Si5a0nSVkyo
-laVTZ36/uG3iLEvvg19evxold794Pt6SQq1tIP99ad561C9itJSLFPCET7EbBDDCafzQ7cCEz6bdjfAK
-6ePup4uTbhAveBrAxclpN5CX92eHb99ddM2rFXsnFxcnH7o6mC6Ao/6bi65620K+TqrOTXcjHJXWX3iX
-WDAY+mSmVAlhc2Pi7FLMJ2ryNopOUUwSqMU4nixmOp43OwovbFvpjOiP0yMBaN9TzOoTLvoex4LpDW9P
-62PEMufN2ri+FXEnJ/fARiIWw29s+4YZTwWKNsQprmCONEgXPIA7LPv0TLDm0zNB5dMTzowvCjFlhWPP
-BOHw+Lx/dtE/6AYJyzHjOJLF5ZjYCXocgEzhyacLXSrmJoqbnjkxVZ53Tia4gr71n3mw/jMX4qCXDwrs
-izJ1quAQqXkzZhfNA19wgFRPHa6RBrOYLeJpAOfY9PQj4F+PrNOzy38oss4omX3lfV/4PnCd+Nv8louT
-DxIUurlKHn+hjhU1sTf1aM+KS80THjg8Kd085ugJ3/Q+hakiCHyvXBSr0xexTzbcVm8mCP5rytY1rxQU
-HzfkdTYNSZGVQudmLgUbCWHjff8LMJpiWDFgat6PpKdetBR4Ue2h+k0RIXig32G1MYLQPAIwInNJlbY7
-Giobq79cNE/y3xI+2XUrQ7LDoit5CmViIGCky6IrefZMgXXhTdXDiNLUl8xt+K9jEnxAUjadytU5vels
-B/qC0Y5q3lv03smXsuKXzoKAUe2+vnQT79s8BVmnqbqTqbXvf+4fXzR8UAQ1af9OFRkgiG7kOH2a49Nu
-mx54Ur+WKNDWTdBTf5XHOfFuDGP50PuDGZ8by1gmD0VJzXHB0ISmdIKFW4+6oKKU1oGYq8f75tP4Iai/
-oxycTjHOsbXIsSWGaKWspaXWlu4jD4xynKK+mK5vo3wMuUjcJ+rVSdhPGZcYXHgTGSrwWJusuaHixhcu
-xSJgxW0apfcMs4MGO3IJwGpCDWK8vYXQlw++owfG8ebT4UF95ce7H/qkl1RuA5NRABkpzcN3XzjCfJgl
-1zi6fija55I3cMdJSV/SWIlQYrqb5LuPNo1fQ/yeyVZkpJ0db6lOZSK1uTIQu972kBb8bJfzeDjRb4Ir
-6hOPRkWpmmmqJhrTjW0TLKc7tdLZTsmm0DVIUm1GegoII0uUvExEOcL/M3gkqMlyqSFuLvViD72y2Os/
-HUbW80a0l6hqAk6cY+oJarTBjQ5gVNFKhzte4yS+S9Ks1KQm865ACnLdRz1ydx+fHw5Zi4aElO1LU1S3
-rEGnWXKTsHhqh5UuFXaF0llC3eYr2c2225dvhUr33JSpm/5u5WL0ie9Wcpvco/KAP/TiTIo/GPqfoopT
-k2g+LV8C1s8xRlfj5Htx5RYaUosuJ+phnS+lGGId9bCBKh8T4JTSOcohHVkB1Q1kiXNaSaNvLh8nz798
-FD30St35LxynDReOE20Vm4ZP+rNqw1ONXidPMirptlpzoSpAvEHpTKb8cn3Z3F8lSyeTzlVCgmIXyVyB
-ThStdzlzaT/5ge3UrhsmrQEW8gf6hI/ilrmRXj2RBCXFkJXvuP9Hz5Av2NMcIp/GdXmAg8KNply121x1
-qKqeQPTn3bIzieHsn7hL73nUy5U/xcVfS19REr58lj/3ZRh5gLh6tAG4h22XDmCjhlJkNMXNYCvYVGEV
-pWa+jGZG0jV/SF7rchhj9SoCT3gy13lAPSs0sqp/lj0jY+kiI2pxFeVyYfRxBybAS9jfRxnq96Ae+q/1
-1Px2k17OhM+m9adDUAYGGLfY5dJk1iE7bEcQB+dxrSQPZRrZCGdz/hAaqSRE0uURx+88VB/IPwnp8ku2
-E4hRg24gyoJBiMXW8GlDSuLIyeVbfSHDsPQtaX8MbESzumB5QgiV3+zUSgrh0y91ykZO+pECR31u0Fd4
-yctZrAfGC6Exo0vieAKaQbV+n8+nCQ+DVkCicZr1y/eVynpVxO0TIXNErn9X4iHkKkFMYkYy9laft2Ni
-UsLYNx5bQqnZoPQaLUeklB7ijm8kxyLoqzZOsezJPpTpsLGPk08XvdrS8blL1LYK84Yge/Z3SpwR363K
-o3sMCKxqP/C8pploh7ciGqcyDxd3y+l3xskNPFqLu7F8Fqb0wHrVCrFP0ReJluan5yg6B+hHTmS45gvd
-Zrk0lwKCwdjYNL/C6hsFnjVGsFYR1j0FATGO3ryU4KBORGtKXi9E+VBaky2PEWLzsf5LjIVMpnTlKrar
-LHdYi71NyrGzi10pjXS02LfW1Cc4gSsnCMl0p1w0w25YedWyMN5Vm4JVQ+Q9kJAa3d/SzrRTdED9j4HV
-5yQ6Mo9feLokXVOtdlme2f/bdjldcM82b+vYC49deLl87sbNn9w4QbN+fOOURqjpHXi6dP1/xcZ51lG6
-1tEJd3grMbG3JUgVbx2XAcgH2hC30dGyhLqMWBvrVH0QLXwqGVpNBWpyGxFq0w7vPq4IMeAOufqLPsoY
-f/0LBGzMj5XnjTau5Bb5vfyL6v8jnp5L4hsS72dDJU7KD/Xf1P5V+tgT5BXj89O4lU9AnQE9rqxJV2FH
-kVXJbrKuaBrxEgcbJWvfJQ7Ewi5xUCktJEnl5eoq1c8X8mUWZ5W2XN72BySaxVzIbGifQMJ2u5T9vBxn
-EX1NExZaprTGzOR5fKBqCmgyhvpsp45hjBSigdUjG0T1qgkEqddwLNcuKVSZV3/fsoMGQgwQcCUVpafB
-Fm31bUUkwHkFqeEDoXsk69zCnuMUpnden2ChFyD1On/JvD98udx4pW+93MTtTKUosl5hifUKq/bf5BX2
-r3heUfl/Jf86/68btC2Pdz/0m5rFRTPDg5uaToumUh9oanfntDM3f01tJ/gjWYFGKH3aklUorf3K/4ie
-e5ymbG3JaercrVjrNFX0PkQ49zlNnSOBE0bDY6TBPJ0rw9atdpo6UU5TppzAKdLw3jhNHeMAjpTTlP3y
-a4PT1FHZaepMu0UeM8vvnGsE44NklRPtoaEZSjcIGpyszDSe72Q1+bny7dYE45Fyspr8/Iun863rdPRQ
-8cFaEXhXW5HAAHi0c252e1oR+M06tHwXoK/MBT65hXJ8eF9x7LmtO/bcGseeD4Vjz23VsefW79hzW3Xs
-ufU59tz6HXtumx17bpsde25xBW/Ql6Cu5zjgOOk7ZW5KRQXj7EbabHOi3uVbMcohRJqQwg2FnkiSq12Q
-Q1bUEAix+OUKBQLTr65k1dUVZT2TJq3m25I9acWsWOrM+4E3zg3qCrKnDJr3Txo0jwqDZvZXDJr3zzJo
-ZmsNmvd/zaD53WPQLL2K7gLLl/5VGiapzzpX3Ff7h/4kuHLVevqbsp5+QAlLZ/QmSK6xeWg6EJiNd66I
-5eVm9qQAef+jAuTXvyJA/tPSzn1JGjlpkHZ2mEzh6kg86hroeRJPdYz/qySes+dJPMfPl3hOninxvP8B
-ief2hySed1biOWZi+7T4QN94ZB5bW5J53rgVa2WeovdThDc+mecNErhlNPyI5inJfP4QwBct9ewpqaeo
-IfAWafjZyD0fcQDf0L7xsd0BhTHGpVoFNMvHk/8smhm12Ta2btG6uect5d9RP0i8e9HvBtIKEXMMNr8g
-nO+fnRwdde1TmF8QSo9cd4NpGo9EeTl33a/Owy7y/ZUA/kCq+sYAXiB9VM9rn34xaeTy+YN9cnPwEtSr
-rN0gMt8c736+Ojo8v7h6e3by6VRlfYNWNE1yvnWTpYu5aXL8/lzVbk0TdqtKDy/6H0ypmo7syhQXneja
-g7OT0wMpwBQPA9lC+1llhbZeJ9FzGugIjhVwRgO1VQEgKx7ICYAxrzNwzRLY86enU5AzPDjYOzn4oi1v
-Kt5jR0Wsdp/73rN967j8frDEIsmvXsigNQVvIXBvrmlYAPuplmUY67mYDOqXJsue+sYp0KYzFeNfyy+k
-wqdpBz5j6IGWvAT5HSOF7L4rFRYJ6WyIea5ypslOMhxnmE8Kf0bdwvgmM59vsv6oMeG8bzOprzRS+7nD
-WRcZZFRRhWq6dUUFdirp2lUp5DSjlCLbqUQpX6TzkHQ7vecCvwTkSl+qNCTwGZ1s48U+mNeGhTQxD384
-bXQyDlXK6M9oU0abm0tsjPFOxmGiQviWyyRS77JrY+vlZwyRXGaDkEQ8nW/mwOwrC9Lx1D4T7Us0tSJR
-nma8Erhpai87gy287AxW/htTtLBWT4lztRwLcFO6PdBZSjxOy5+xOZHYHpLmAzAeh1+QNGcHK2Nk/QGn
-kqO+phxOmUEi19NYo5FT9KxT/MTToEIUs1d4ZSP4N1xv1tYZi/SBFKCySYyU4JuMAtJTDxkW/q8fNVjr
-bdXlSGSftA820aCSx0D9EYHDn64Z2p7GJkWgRicMTfCQi3l8g19OHCfJSr1N7FUeXQP9WRMopbRYLj/E
-fBLN4u9hJcjcaQSNeS/cVjb+V03/R6ZUwMRN2KB68IKhKeBXEQk5EU3nm/J7lMnopuflDsBGKsl8L31s
-4pZt74LAfXzahZfSxCo8ir2m3PXpsAfwsvTL5lcf9OpncoPSxHhaGTHRBO0+Vq+xdHYd/rpEAS47A6Ek
-VstIaQvrdMAJ65DXgyHpmSsXI6EY2qlfrsi2tno2YWB5DeXlZ4N2m/9S7kQW+lW6crPN7cFyWV2iKCU1
-OFVH1dkgbYPSqyYeOCCU1+/inCG5xikHAtLjlNd4qoHypsnvrEmTenZkAC83RdUkw7Ete7kykQeSyCkd
-HgJCeqy4lf8VyU7IrHeJI8fpVMmuZKekY8eW9AcSYKWfpBuyar1y9spDK3caVYDIOOawIo9WBU/yF/vT
-H1v/THeYcp/N3NW4lf6OkdGzqgkMUScek7tb5uhVUckIIC90b/3yvdQfSFbA/tlHcz6XbB97WEkWwJoe
-zWm3CynDfvx/+MGcNGT/OrvJN2VjYCsp07rZ+X7HqKQbg88JgFbl4Bc6l8D56RdtZNMEDCUBK46evApm
-rCFKnoF5kFe6+H9GbUKgni+K2pLtgzGnYq3to+j9LYJngBUwRuCU0TBlytiRMxUXH18HBGJGw4wpM4co
-gikr3zKkrHbLkLLSkwD6Z3HBkLKmtPopK5smJqzyXm0AQ1aYJhbMfQ93zMyNyYiZEOArRl2rwA2jPlvE
-TBSbTueMBr+0pknrl5Ytu2PVl3AFdAbQKhfOk+m0XipGCgYvoe/OxVgZ4EEOV30P1wx8wZqijmvPtq6L
-svWF37EiN3z4P5wCt93OWGNOuCEjy+Wa+gUzD+VwSABpvalhXTdSi7QBbk2ZO5ysrsk4ROMqH3w6UlkE
-xUqk1WXOujPWS2iY0Iw5Jz9j9g2anBByWTxsM1jpp7GYjtiYMvXy1NrnXQnEpS98afKTlUkNnjH3BauU
-QB0gzvNWG/73rdrtjbQhRkK+YymgnCmd3CsKGb0UPZFB+mGDMggO+rW8v8yFANYgcOwDQXnxXC6eeVaO
-ZNXTUYHO1MHFWJiS7rq83hlrVsdz5lO3G0RCDgjMFd8ho2Ed2xysmjPSVT+t6DJjRCJ3Tlm7nUksyJxD
-MmYEUupz/r3i1ttmP53NpygBkQFTYTRZu52b99KeEyySyT3ImoJF0oZgkVgHi6TaQbg+qRq81NlUYHBl
-pBGT9sYhMyk2RRNnXzUMHyQx6NkD43YxZAQkxZY7UL6Ky1KV4QZ9Ea5KjpMv2W6TlQAfKz3w63bMGzrm
-T3TckS5wJimpOt7OECOBeiWSLBqU1m+xYsKISUioejGU8koBx+KRhFifVVby9GO+K9Zus/B/4k5QEzEl
-RUlhKFcvXKP1gk10C1svbTQ/kNsK/8KVHv7TV3rGjSdj5dRUU1ZNTXXHnno366JJhLTg014cKzGeFtGo
-56uitiRGXjCnYq0YWfQeM9+0VnDBSG+jat3zKQxP5ER5mbd+je/i82GWzLnJi5K31HiR/r81W+S8dY2t
-hA2nixGOWtc4TjNs+buJgiJN3Jjp2CQnKkamelS/RFOBhpedweuf2m283B68/vtyuS1IhzSI/F3+tT0Q
-dT8NXm8vl397Lavq2QmeWlPMW1OMc24Wdbcd/T3abl0vRHmet/gkZq27v0WdqBOQlbzviT7xZEr3GfBo
-d4oZp4fiz70F5ymju+Lv/TiTLjT0QP5Kp9N4niO9Fr8OtJRJz8UvlU/8Xvx5qjyJ6In4cW6uOemt+HkR
-X9NT+YdynqLHDLTjjNrc0yydY8YfQg7B1RXmH9LRQoi2j3fxdIHdjY5MGvZvr179eytPF9kQP8TzecJu
-Pp0d0WsDomiWsOhrHs3i+f8XAAD//304LgorxgAA
+H4sIAAAAAAAC/9y9e3fbOJIH+v9+CpmbqxDjMmN3z96dkYL28UPpuOPYjh/pTjxaH1oqWYwlUCEhO25L
++9nvwZMgCcpO9/aee/afxAJAPAqFQuGHqsKrv639W6v1t9ZumvKcZ/Gsdff3aCvaaoVjzmd559WrG+TX
+JjMapNNXRH6wl84esuRmzFs/bG5tbfywufWP1vkYnYp25nycZrlTU8LH82tZB7+/zl/Zal/dZPFsnL8a
+pIxnyfWcp1muWjlMBshyHLbmbIhZ6/3B+XOqu56k16+mcc4xe3V4sNc7OuvJ6l7929pozgY8SVnIAclj
+kF5/wQEPKOUPM0xHLfw2SzOet9uBaHCUMBwGayZzmg7nE9zGUJeCDL/OkwzD4MvXOWYPASlSZulshln0
+JQ8I6QSm1aIhVXm7rf6P4ulwW/0ZXga6+gBMveBU1wckHQx5ZIdLH5fAoy8fRFHg0YksSpYhHyc5uOOF
+AXkM5jm2cp4lAx50TWYrUeQYpVl4F2ctRje77DVGE2Q3fNxl6+vkUaQnFC9Zv5tEyOZTzOLrCVL3x2Kx
+tgVJNEjZKLmZq/y1TQju4skcg4S1knY7TKL7LOE6j8CxnIFIDf4kS2eY8YeQQxLd4gMkZLm0vUzlIBh5
+zJDPM9bCdjsJeTTLUp4KsgISYDINGAFefDgOs2JwnG51+es4u5lPkfHcDJKbQeaUzSeTNWpLXPL+tvuj
+87gEpLrft/iQhznpeqZYl7hBfnzPzMjOHqbX6SRvt0OkKCg1iHm4qmSYk2iUTDhmYTGXlgTeL/cxH2TJ
+jKdZmAMnzgQtCSGA0SjNevFgXKpQDB2BQdJFmkFC80tGeR9YK2Et3PbPkij/KCe3k0DRSmdtE1weEL/N
+nHfWNpekI9iIJkuy1MPIlkix3cZoHOfOQMJgiKN4PuEB2cZI/91BGNBBuz1YUXhgCw+6YmAZMMghhgnM
+YQRDuIIbmMIM7qAHD3AOe3AAO7AP13AG93AEx3ALJ3AIX+AU3sKv8B6+wQW8gzfwET7ALnyCn+Er/A6/
+wS/wGV4A54AcGIeEQ8Yh55ByiDlMOIw5DDjMOYw4DDlccbjhMOUw43DHocfhgcM5hz0OBxx2OOxzuOZw
+xuGewxGHYw63HE44HHL4wuGUw1sOv3J4z+EbhwsO7zi84fCRwwcOuxw+cfiZw1cOv3P4jcMvHD5zeMGB
+IyACQ0gQMoQcIUWIESYIY4QBwhxhhDBEuEK4QZgizBDuEHoIDwjnCHsIBwg7CPsI1whnCPcIRwjHCLcI
+JwiHCF8QThHeIvyK8B7hG8IFwjuENwgfET4g7CJ8QvgZ4SvC7wi/IfyC8BnhBQJngAwYg4xBziBlEDOY
+MBgzGDCYMxgxGDK4YnDDYMpgxuCOQY/BA4NzBnuMWnZONDvTgGcxyxORiGzoCDxuWZ5KMcno2lZXM2Mi
+JSeJUobhJDo/3Tk6Ozg/OD666h3tF/KUPDIqeBly5OfJFNM5D93MxWIS8Sy5ucHs3Haix4YhkiVwAqKN
+pejBhD6W2+gE13npkwBukF8c7Hfc9SrEWZev0//+73AL/9+/vY/5OMpiNkynISEwTAdSXgnJ0Jug+HP3
+4WAYctIlZpx8Keo9wwkOeJq9ydKpLtmpyQUqK9rhanfGMBjGPN7gcXaDPCBdbLeDfw/WKMXFIqwXHmc4
+CshiEQSky7MHI7s2Xyeh6aeQcZI2WhxvY0dI4eUg5oOxI+9koux3QaH9eRaL/5tGkIzCNU5Mo101oCTk
+JBrkeeiwyMZQ1xRYGs3iLMc3kzTmIZJtKbPz2SThYQABudzswxb++LdSIdLZXEKGo0l63/EIbB6lo1GO
+/C0K3WkJPhYp9150VJeSvJPPZ1I9aPjEtrSbphOMmfwmyX2EUQVDfrnZXyw4iVg6xPOHGS5B7GB7Yxzc
+7kkx3ilpEazYSxOxOzCSjMwWZvfiinCOBvFkEjJIiOKojLLLpA85RfFfSvN2exLZXoY52Q5Q/R10wpjm
+8LiMeHrGs4TdqLpiEk0lc7z6Vx5exhu/99fJq4RcbvUjnh6m95jtxTmGhHTF/DO8b53iTe/bLMxIxDHn
+YUoIH2fpfUvk9bIszUIe8fRiNjOfrr/stI5nUl4EL9eT9ZdBa5ald8kQhy0xRpGaitTrOReaIw64k5Ot
+vwyil0Su8Xi5tNIlGrEIp/NJzLE0hZRDEuGdWLT5DAdJPLmsSp8+fbxO2FDMUUfI6gneiFrUz3HMhhOs
+cn4iBiXXKYmSXEk2YnlRfXN8/UX/lUXxbDZ5kMXA6jxkuYTJMkQCB4yGjAbxBDMeQEyDKFgPcxpc55FK
+IzChYUaRRCN2yfowp497h8dnvU4wmKQ5BusxyN/7OmGoUg723l3t75zvXO2cHIicZHAbrMfrQSTlTDxL
+giWMbLtDGoziIQZwRYN8nN4HcEMd/ne1WvIohhJdaWaifKlUwKTgVCsOI9kj6hF+bhVdLnU3lXaD/DRN
+jYQNOVFyPbrSC3ZP1NgTcypEW5LvK63kJJPzjMOQLBbqgwyn6R2aiuQeEQ2TfFbqEXnMIlVwP+ZxWOoX
+5ARKCVKNFdVUeukZ4B6L/DtByElpa8R2O2RUyKHLzT4BtljIn0KUipHmPBQcMdK5svEaJTztZ5Gi0TyS
+zGGlb1aWfYCyxhKpitpQ1cbk1IhFLz7R5NqbxHkeXhGQaeM4VwlDLY24psDKPSVE0pWfC71gjzUqBs5m
+FV0NMedZ+mCrAE6WxLv8Q06WOMmxpSax+qFkiEpqiZKSVkPkQq8viKYpum8IEYrNILpSh8UDxjEbxQOH
+w4rDlewGlk4JhliZEiMgdnqxQMX5R+38QpRq9QlMHiAhoJc/pZS120L7V4WWqjtK+Own+TTJ89KM6s64
+A+Xttthn5ArS6ykUhxrZgq52CWmYgFgAcPl4iw+d4GPv9Ozg+EiqUvXNMpCYR7Bc9gkky1DwSaGZpEyS
+0ZVR8PJSSqah7rKWTP2XcFMZTShIckMIZEoi0psa9U1WtJeynGdzsQbpjU1lqdiEJ8mA0/omb2qdQL3e
+JdwQ2GE0nNHges55ygLoKZF9J0W2TiTwQB1JC+c0nGoJPuvDHg3iAU/uMIADGlxzFsA+1cPn6c3NBP/L
+Vt9/CdflPJOVi7wzGiRsNucB3NMgMrUe0SCS1R7TxzKV7U7QW3+AN8d7F2dXu4cXp0V+uEODUTqY5wER
+ZdaD1vVknsnyS7j17gmseU9gvj1BD6O2BtY2QWIbjE7LcrgQhddCDApBxAx6Uisqld4zUy6RO3aQxcMk
+FUsliURfhHaVRAOhjeGw3a7VYaXZHiFcyGohRbSSNQ2ZbuReNpKJ77OyVNwjy2RktAVRm6vmJ7k4tA+F
+9s5W5CXRQNR1mORcYBo8Tlhe/Xp1Ca2TdO1I6dqqkcI0TAopFwzGMbvBgCyTSPKDEAh0bWu5xHa7VEmU
+l04mcZbEG7MMc6GJwMoWCXAP8RV7WEL6N+1p86Z959+02QoRjc8R0VN9hpUi+I50+WIRcimemcqBcgkQ
+qkugRiNYT9DtEvuhlNBpyP6gLGVClk7LsvS4KkvLe2ddtnfNEVTpsd1paQ8/IHLnkYlm5R0RArc1Eqpz
+gyhoR0qWukse6VLpl+rE1FGni9bE0pIdcNlhB179l2LGhJHtF6/UqYOrNU2WBKZKwtJ6T01WaUe4takr
+dwRT67mHAku4JbDPaHhIg0GcpfMcJwGcqj3hi9wTbDKBt6Vd4Vcanuhd4bAP7+ljImq9iyed/8Af4RYf
+rtM4GwqoL58kQ+ysbcEsnufYCcbpHWYB3GfxTKB/8M35NgjZfHqN2eJanVZJUFQV6LRA1xiEOmGRy3Mg
+CUwLoUpwKpGN2QqWcEEDht94AO9oIPgrgDc0mOCIB/CRBvIeI4AP9PHs8GC/1wlkg8H6KYjf6qf49a73
+af/416NOcIsPw/SeibT3xxdnvd7Ree+0E0wF6VCMzeYc9nY+9nTOBOM7Wev58cXeW4n08HQ+GAtoav0U
+Do939p29b5LGInn9bdP56HT97RJ23Zn8VOzVP1M9CPhalNhIOE439Gh/r6YrcvxWTVZ0+6WarKj4mT7u
+7J0fiCHaDV0lXB2c997b1Kj0cQA6s5J61Pvt/OrktPexmiU7Aa3I14WDo/2DvZ3z49Oz0ldsmAxinmZ5
+AJJyemaVciJJ04eW82uDp31d9FSU1GpMlgzRIXH/5RJeeBWLXF2hKGkuuqfuEbR4NwzvpinK9BzBbwrn
+J4Kvh3Rty6acTZJhwm5skmQcjTu63yrcvTieKgBHyLzyPnMScolfme4ZavWKfI+q8jkqqO18Hg+H8gAn
+dndkmOUh0TpV7tOpxGS6NKyM0RyJ5bSEF3JXFZ/8Okb2McmT67I+tmaxznEyHCJrt2t9T/Iw6NypTwPS
+bgeqpEAsa2UlJijLJpOEP4jisoToQSj7Itju2d1/pz4R81k6rskNuTLbmwSayG5XhqB6uy3On15wufy1
+mp7Bw2CC4domITCYYJwdaF4My6xJfKwqOi+/f7LzW9Xv2+3wu5sjJS6OiqrWys2125XaaI7cNmT1jaiY
+xzMec9y2M+nwUscmkkiga+b46usIkbPJ0waQqOtb2E1T6ohJqUAU4IVcuAccpwdsiN9CT6UK1Az5T464
+0cj5xtZiwV9vEnFsqHAmqXUlZRh+iIRohLomgRFPBSpB1IlCHGQopRZJl1VJxg4J3KXJsOVwm1LaEspe
+8+2LzruuuyQScHp9yftk2aA317s7GoWnBE6a9ekvBBoksBaMTgp6JK9HTFsWL6UZefwseV6Tri40pyQ0
+9d0V0HH4uIT3wAmIBV8G5cND4PBN3HMD94jg6pG1xKGarY2q5RGZKQs/RFrf8WFbGF1pJUiyCGgtTzCI
+24Lkj3Y79Ndf6E7+JhR3cbJ0y0uNyl9e8Z7qT8rkFpnzOOPC9MDKBPOHnoumsRsdzV0YaPkdSxtwuy0l
+nf4VljOrhanvwtA7CviPzc11rAuhpRJDZgJo9bLrlURZFhy/8TjD+FVijx7y5BLx+OYoniIh+X0ir9ei
++3EyGJPHQZxj68f/7HjwNbXaM7wLSfc6w/i2qwr/s7Gw3jANm1t55uV0Z82eRNP4FneyLH4QbEOiWZwh
+42GhggiJWVrnUSIqPh5JohXN7T7sJxnKtmjZ7kfLWkrpBSTy/3eQ+WQvEsipT8gKARwm7fYmpTRbLFi7
+nVFKc2K3Kj1p4iBiJCZKoZjSMFsPZZvbG1udLUL+n3oDWlna2KKUptsleenpTL/jlkj7LvAuBFUNeIcS
+WF4ZMyeQ+NKfuZERyOiJBvTV3tKDxwwnMcfhuWTADoehmZgOwihLp50EeNphSwv91xrTwwkzApkcn0By
+pMQ9MOLVB4sXu2BVCBf7Sz2rPLQyXvbJAhL+j6PBOJkMM2SXXtr2xW35iQAL4uHQ1KhWidwefbMECWSa
+DyF9rkIBsW8SU3FDh4tF2m6Xcp21EnJICYx9X08IDKi5WK7oc2JFyBW1HTL6OyT0N8joG9IJGf0KCf0F
+MvqRwEQMfuLgN58IqZ9xpMbRkhf37nVawcvhBDL/hVq7nbbbk5pWLrDagR6zEeKqSCMfhRM10/MKO1e5
+eeJwc6a4ORbcPF5Kmpw0goo/E8GDE4cTErnRK+sBQeyTMHVyGYFycaY6OHrW9VVKurK6J66vdJdcjmfr
+QStYT0ocq7pWWheqlPyXEaieWv1mMna1+5b6nCxhs/G+bKTvy+odqZLpE/EdoxslzJx0DadoZXa5hLwG
+ppXMjZ5GZE9cvPWLwKe1clfKIKRbs0lN5IWzLI2QEKJPCoECvZxy20kHIylE5Fq0kK+69gck5ba+KNBX
+AXBONYQLxT8hdhHWWmLqoqIwkbU5/JL1HbMKYZqgTCteHqWtKfJxOmyxeIpDYSHB1l8GL0lXfBPqyUTn
+zBfyYqWamZB4tLgejXm8M0v2BBL2VpkufN+9tiCDoJLdCE/UpbZIkxACcxbqLjE3OHIWZKaarursGS3C
+Yy1lUCZhXCTNYO1hQ5yd8wa4WrQFCYFM90mzj5ihjEBd91rKu8/8j+D1oErrqhpKt95LYD9fyrGXgP0P
+VWD/c1Qgb/DCO2mikvuEDdN7XUUJBC1Lpc+RRefI6kXWfdFATW5upSUjiZOkhLNpvbzJKkHwL2zqSgje
+1PorvPBA8C8IXDMaIqfBIJ1M4lmOASRcgfCMKxTeZBDIOA0518g78j7knD6qewYBtivluBMES0iLjAI6
+N/kGIjfSLlhCzOnj2dvjXzvKiGY94SB+HqnfTCa8laDpWKLh6ud+76hj8DOR1ABOJ7xsvTPhxlZnXBr4
+oPglRAzMndxhACNOg/tkyMcBDDkNxqiw6ytucGcB+op6BTjsVCP7c37888+HFsk1F9K2bYHl3nAvmBuX
+wFzH1M6FYIsL5Gdir3qDkUcbyrlzzuE8bOzlpTCfpMG/v1znUTJcfxn0obGoY5ZZ+uIlId3CuJ/z8IpH
+DomEwr/ZTV4zc/pI1tcrdnrNojQjXWUwL4z4Nl9z7lisc2PPaQG7XNdAcw9Rotk8F7b6ZKnyFPNWEQWR
+tm2JfKJPh50a1qOLGjw2Hg53siTeYcM9w19KupcRpHqvKligIrtWENQPC3fHzzMh4LxRJ5xwosYm1lxI
+1MFOMLjCnsVfNWMEQCisnta8TCuOpSsbtROkiKYOtiGvsalbSJ88rrg+epzZedfMrOcveLnumRfNly6H
+aAx4LdQ2djyUVqm8wjt6F2ScEOEzUB0rsbs151pxj3kkRJs6o/AmxS8hsJZ4DxXS6iiMm3aVpn6CkpwE
+cLFQhXTH5fasNTkHd9hPpshyObPdejddHXfMHf12wAmEFTMH/jDBy6xPN8lrzzozJLeNuLnlluaiJc4z
+bSyB32YxG0priU29MHL35JGwG3HV0FWeNEE+yNLJJFgPs8vNfsW6NhO66gDDLUIgfdYRpkwQD4mePNtw
+HrIGkg5ckpboO+EEmIe2QQDMM3pxE1JuxjCYZsOjxjNNWrmmK9oqJV/m/fVg9i1YStxgXEINjCr0lDxY
+LQ4sZuysIKELPLGCkMAaNqygCt7k8noyKleoh80qwxYMspvOmTjB7U0SZPwUBzwkl0yRwzk7V+/Baj12
+F09tZbm/xeRvNq8hYk3h5Q7aWM7dUuuFnrHHinOJ3maJ2mPd+ZLyJXOG1bxst/Tu6l22DdMQBBq6/F9a
+pY2rij938TpLTimtK9acWkbVJss3nl5NkDdcXHHefENlr2U9N1J6v1x9R1XSIkt3UuXOPeuOKdR3TDkH
+Toz9UoHw6QTv9RNy4JBycwHlLmnPmWiFuBlxsj3inaGtR6l0VZGmZAcoWnT3mOMu4tEuyHboVRzB59jr
+KRcp11uplXiyBc5NOpxyf+PmPhfpy9W6+tMaUtchYOElVT7+ysMKW6HixpKuCq8sm7TDJfbFSdjeInor
+qIDSyl7UHiYqe0d307oOy22mYhc357DGGoQTE1BbQ2e/02/B0A23ZRcuN/vKowziP4vk6Tl37N+ZdJOw
+C8lgQz4kT4B0jwoYXsN2m9njxCuh2i/EXq4tBROhk5t8iREVNvZKqEgvZ6tSSjP7GibYiNQJx6tnI3WJ
+QurENwJikjah8V+HMeVcgkyxAJk4L6NMMa/CTOWzbOlmONiRN9LRYJ6JBXVeuv/0+RF0C6bWU/wUhqgY
+/Ds4ZdsYn3aYSiPdG96IV6ECqjjX8A/1lC1yyy4E3MlYCVgVtWccPA0InITAmXAl4DQYZulMWj9CT6NW
+dwq1shkEHnjZpYDTcGpArBnvwx6njkte8OM/Fn/fXPzwnwGBA04fXdSpV0edegqoMrhVr4pb9TQqVfgP
+NMJUPb7+wI1dp5NtDTxtgYuTcvZ8pjOXsMNpYckO+xbputbEms8CONN/a+PLe/1T2VweOVTdmCKbGxvN
+Y06DWaq9U3Me82QQwC2v7iqW8P2XcCIprxNaozSbBnDopskGAvgi0lh8dx1nGyy+C+C0VqhV/Ew4Tjss
+5WFkBkrkz479GcBbTgOezjaUyQX8qn8K61Z4Lzgk5TydmuxvRYosccG1Ha4p8I4rC13z+w2nj8p3trMJ
+o0kijInhWhwH4uyho0+aSnUIhAMuZsgG2DGrDYSeNokfOsHwgcXTZBAs4WNRpTVE1jipWSQkUG0ViGrR
+ZA1TdZutZ9oOqJxgCR/86OPERR+rIKOKBPI9Jp9iKovc98jmRoKZEgk7koygSw2R44CrJFum2cZz8jzQ
+qziLmuOF4Z12e23arB7ucOulN3HUw+YTByC11YmxO3XtK8u5SXQlDXYELYSvyJq9BaoaKrgVLyGhU3Me
+PlBHeWCywikPn4kjFVQwRG/coQfe7TkoAuzYVa6jzrROTJSYIkiODRwTpdkNCcqQk3FpDZS+WbPesuy8
+nVHeaVa5bTmhsmQNdTyteduihfLdVJnUv6G86NcqvTcLtd1W02PPh8f2CGYWE963BmHmrBco8GVZRK8p
+QpbfYVgmIFTVtHEV+eIA4tNCsYmu0+EDsYYjoVR0lM+A8p2Qq33KI5amsypEZByenvRzKkN3lSXiauj7
+XGXXUi2HV5fBETAijtFNDlArzsN33OlLydKzx33OUeB0WyYQO+9qNgskW/6MtM9sSOoCVELq89kw5ugx
+6F4pFDUWU2641G4+GKOIF3Uh61fw/bPNND0UYYLYUo15wl8KeJTzdCZCL8Q3saobUM+l8uh6puWp7MKg
+UCkj3YZnwvSRx2+pOuPAGysTos0BEZz9yb97SH79rg2hW3yo16M8Rh/Kc/qyZIIoylgYYhIPav1Q7dbH
+b4wUAel73nUCLOid55rL8CFvOTTtTUfyEgbpr1wgC0X6GSfbSC+4m3Yv097xzlOVfePGa97lX88RYPN1
+fUyFT7/SEwMjvCyFHNnoZ2Tg9HHpC45VktRKB9sWpxC6ImZKrrnS/ASs1BDaLLJYPEpUo2OSqKdFfdp7
+nJmZ7hRi3yQJB8Z0mIwSzPKO0Ra5UgofkUkFplOqW2QtQa/K4zvMZCyYR70dJWhjsXh3q6UNFRJohb+6
+K2s9UqIDtmsqcMeZAGyp7dba1lKGYZj8aXdSXvIW5aRsYKR8O+p4B+owPgSqFSjToypagSvsivD5aAUa
+uyI0aEVJ4avZS/PF4kchy5UZdLsd6vOdTBMdWCz+SW0+sZC/0DOd29EpD2/FsJqj5zWJK4GxC9Ohqf7T
+khnyqkYq8peC+pmqNKWZWvxaDU3K2m67rS5T9XmXmhG12881El8sNDWcb11qCEWm8OhOwFRgbmHjks4s
+TvaQk67qqQXpCcQN0VHC79C3VmtUo9EKlUqQdbXOFIziiTTImfKqwaHWl5JaqldfUmgG5ERrTA1MQT3B
++Z4FeDIp+Kc8ZMoaW9w96+3pKB2ibFBbYr1TKIfPgE6Ytz+XRbbXwh9/KFhisfjhP0vL6e+b7s8f/7Hm
+FJ1yj0f1oVWTCenscdOsXn7KMvA5So/SGOrHzMrp0mxYq7QJsaynPMT6YTJkYsDUHeEPpfURrrEqSX78
+ofhlrRVU9VI1OeVETLS6GhXUSww99BViUrg8GOp1f/yH24nN11m7nW1swN833eTsdWLdBtrtbH0dsteb
+8qC1SSC5zPrmPLFcGq8vd3AmRKbT11t13TGVd5I2IoMOy7G0FLc5ShJpQ8XJXwciv+HVkmLXaCr9UUHO
+ExmwoAI5H/CoihCKoIwfeMNCavzo8BkflYFKaU2tqnIASFlLsaH5PxVd/AMHhQ9NWLSZyWrwhHrDJ9WG
+a62I6AdcY8HU02KRW0K0P3AnY3UUBFv7OfcNSWBvBO4ZDT9xGkzTYTwJ4KuGs39WcLZKJfA7p+GuAa8/
+8T78xunjdTy4FfiLACHd4AeS78UfAgKW4Q5+cUt7Yhh44h2oSorfsq6giGbwuYKRf61j5F/LGPnXKkb+
+lauIOgdHHbVWE5V42js7+NzrBBnmye+qaj27B2fvD87ONHIe6chHsoDlc1NEo+elQtJ37+KkKCT34vms
+XqhclyxWq60Bzv9asTp9YSZ3QyFFAuyeYpzPMwyAo8k00xMA2rR0hiwAhibWXIIG1s+QPu4f7Bwe/9wJ
+FJNsDJN4kq42O1Xc1H+pypjhVeNI2VJvDn7r7V/tHR+d947OO0E0Sr7hcIOnM2jpvxV4Dq0oyTdkCrSi
+nCeD2wdRLICz84O9d5+cKtzMo52PuzunuqunHXsToHqbBUsoIzjmz1bmYtPPDTZgsGtFJrpbnMEzjBQp
+nWgCZjYqDreCb92ICLvp8MGcrkpmuckNSzPc1bVIa/Mi0/LBr8mQj+mmxrCz52DYNX9F1akGe03uMdgs
+e4uvtNZ0WyCPu834OMPCeLNmqrxpbjR3jQb6WQPXVaR7Sbq7jXZdjJTnQcRzatDXK/O1qT+UUZ3ODPFd
+56t6Yjz8Ms/5vmSWkMBuTa23SC6iU1EvH8Qz7R7mJJ9KSWaSd33A2me7hallCYItnWXq9zaWMy6djW2l
+isFNnTVp5to47fIQKzZR5pOLk/oHXH5RiuXpfC5U/gbG39QXyIoc4/Te5Ic+j39ZojhTCE/juolhjYv9
+IfmaeLvC2nX+tIaGz2fIdnuNNVnuVgSIjkywekWJ1pNVy+r72c5RKUcjMUy9//p40j1HJujl2tGoxrY+
+RhyNLFu5nEggMUeJP2DSt/u9Jn2luAGCmd6LfS5sDoaZlYJhFl+QxjuG3RV3DD9zSRrlaGRjY4PP8N9s
+Pop0X1cY63lM8/T+5qSs2stKSdXdzM30LGsnu7KjGbM/FYXyouFyoyxin30h8BtvQPg/cRDB5g2O70iR
+BrEB7KkVWMpzAIx2uynHBrCmlMrfvcPe+97R+dXR8X5vsShtIgIvRTbcEwCRN2pO2QjWgK40uJ6kg9ug
+UkZxXhU80rp4rUI5YefpjG6K5yoajZZ3m42WE6w4pUgV3lKGjdJsgG/UQd7Ku7ICcOTRAKB0M4DV2rF+
+6Yhe96jK3lbciy+dcJj5n5I8xU7rEzxZg1jJtVjJNM+7lGq4iVslufU+r3+WxJ35RmHIksIuAUvJAqCp
+kGwc585mb66M6xOgL/PK29DK0C92w/RGgtlu0o8qZ7zSWMvoV+iNzqvUY7IknYoi2bi1VVq0A3U21ucM
+dNsKfjMUdcRt0OkcqSm3p477+WhUfK/6Y3emBgeMlaKEpQyDp+/ttRgpHG6aPRKb9Lu6Cu1qGFKLjq4y
+FI3LjUEUzMMi1dXRd8tW+GZxf3YM7Z0w3aY39S3IbI3tdqHnFbuvCVft20eJ3WPqtX/fJrPNsBMEXTdU
+lmdx2F4V0URsfyxZBxnG3AZwD4bJXVDtvAqBKyBzylGIfs+43WAPvmy5a52nlel85rHGpZL/vLDddIzY
+6hhxVDeoFfdLxV0l1ui2XZdbHUci+DZBO2JYRSOxDdqnPdQ7D/pHSwUks7vf87ca20zX07J/t+ENu02i
+dxv3nF/ir4KxGleAHag23Crvz+U1FsoYxTxU2+xqzv/+PfgPEOY527D8Q3dbWcgUmqlfqFY1KfWUyk9N
+94LRQLqKqVLdtQaFu93m9rhXFtezeCgczg5xZJova9zS9YxAY71rT1R8Kvq1oualTzbXxWlzp4MAnmxe
+xA/gVZSmTv6yCt3gjtdtOtPwaIIjvs4jaef7Wm2rUcIYqvH6DzQWXTwrpYdWIWjsb1YAFP4eyXWXYVQC
+W73ONQakUJ4kHgpConJV/FCdo8245YpBfatfyQPm/QScN4US4SrrZw0pHjOMyljv80cwjbObhHkGoDK8
+/S9l2e6XU0u933iq92Uw+v9nvW+mfde3JjycgbSufjVxSaWYl2P4kxyDZH2FPFnW1boykPLdS8LbT9L1
+Gfwqrce/TsR0OBhOtb4Gbl8PoBWsfzcb+fjhOV2uMZDb43JtlkWeN7H+1nm7Xf/cRzy+mnga4ikL0RUS
+3qfPdrmjwr7gsAJRKaL2N+wSkQzrsmG2Z9kdc/VSrlcrQrpeYc6YrXrBBp7lxLdbMkgTACFaiKuc5/Hj
+Y+0222bGj6+wg8tsoK1q9X57t78gjlZiA2mJ85HA5cX/Ilk67WV/nb3Fb8qCIlvW4G73JGJsBMwNi8dp
+zxg7SVomTwfxkvZOu0JWC3snrSc7a/xn1+dOzrGTWZ0qEYBtx7oSWE/BYOe0V0teLOpQhw58oVrQlzry
+vq1sEeG/PMvNF9pGrCyVWT2QOXNwINLNscF0Q/YGlH+DEqPalIF6PilyS7YXuZux0vaiqP13Dp4GxN0y
+gSNGwxhpwNN0wpNZAGNU1hfy0aA8MukEBkjDFLX9RYx9mMsSG/bLEZacCcP/WvzrXzkJ1ue4HvzrX2fr
+AQQ3AYEbpI8xS6byfCNMNDhOZ5OYY+fl62Fy15KirehQK0snTv9+csvEWZbeBz+9fjVM7n7yfLwhlVpb
+QP770rz1qF/EaCkTKeAJFxG7Ahii8A7bhDGfTjpr4RXSx52L8+NOEM95GsD58YlwZpsFcHrw89vzjnm1
+Yvf4/Pz4fUc70wVw2Htz3lFvW8jXSdW66ayFw9L4C+sSSwYjn0yXKi5srk+cHYr5RHXeetEpiUkCNZii
+Ldsdz5sdhRW2zXRa9PvpkQC07Slm9Q4XdY9isekNbk/qbcQy5s1Kv74lcTsn58B6IhbNr235mhHW4EGT
+n+ISZkiDdM4DuMOyTc8YazY9Y1Q2PeHU2KIQk1YY9owRDo7Oeqfn4o3FhOWYcfHK4hgrPrFj9BgAmcTj
+i3OdKvomkpueOTFZnndOxriEnrWfeTB9hnOx0MsLBfZEmlpVcIDUvBmzg+aBL9hHqrsO18KcJmZzYbN1
+hk1PPwL+cc863bv8uzzrzCGzp6zvC9sHrgN/m99ycPJBguJsroLHn6tlRY3vTd3bs2JS84QFDk9KN485
+etw3vU9hKg8C3ysXxej0ReyTBbeWYGxpTNqq4pWE4uOGuM6mIElGZfS37GwklI13vU/AaIphBcDUez+S
+rnrRUvBFtYbqN4WH4L5+h9X6CEJzC8CIjCVVmu5ooDBWf7oonuS/Jny842aGZJuJCyyOmQwMBIx0WHQl
+155JsCa8qXoYUUJ9ycy6/zqQ4AOSMnQqR+fUppQtley0at5b9N7Jl6Lil9aCoFHtvr50E++bPEVZp6i6
+k6mV730Ut77+DwqnJm3fKauQQjdyjD7N8mm3TQ08qV9LFGzrrDJef5XHWfGuD2N50fudGZ/ry1gWD0VK
+zXDByISmcIKFWY+6oKKU1omYq8f7xD1WUH9HOTiZoHgdYJ5jSzTRSllLa60tXUcemMNxivpiuj6NItkJ
+3Cfy1UrYSxmXHNxuVyaCPNY6a26ouLGFS7FwWHGLRuk9w2y/AUcuEVh1qEGNt7cQ+vLBt/TAGN5cHOzX
+R360815YQFVuA5NhABkp9cN3XzjEfJAl1zi8fijK58gt0VzGiKxGKDndDfLdQxvGr8F/T1dhdaNtb6o6
+g6jTXJmIHW95SIv9bIfzeDDWb4Ir6SNiFNlU1dNUdTSma1vGWU5XarWz7RKm0DFMUi1GuooIQyuUvJuI
+MoT/M3wkpMlioSluLvViAs3c618dRtfzerSXpGoCjp9j6nFqNP+7hFFJS+3ueI3j+C5Js1KRms67BKnI
+dR51y509fL47ZM0bElK2J6GoTvkEnWbJTcLiiW1WmlTYEUpjCXWbr3Q3W25PvhUqzXNTpm76O5WL0Se+
+WxLQE2eWygN+14szKX6n63+Kyk9NsvmkfAlYX8cYXY2Sb8WVW2hELbo7URfr+1KKIdZZDxuk8hEBTikV
+HoFY0hVQ3UCWdk6rafTM5eP4+ZePooZuqTr/heOk4cJxrFGxSfikPasGnmryOnlyo5JmqzUTqoLEa5RO
+Zcgv15bN/VVCOpk0rhIaFDtPZop0BOzsNJicubKffMd0atMNE9YAC/0DfcpHccvcKK+eCIKSYsjKd9z/
+o2toNGpeRL4T1+U+9gszmnLWTnPWgcp6gtGfd8vOJIezP3GX3vUcL5f+EBd/LHxFSfnyIX/uyzByAXH1
+aANwz7ZdWoCNJ5QioimuBxvBunKrKBXz9MRquuYPude6O4xBvQrHEy7c9rmjKdVvR5p62TU6lk7SFYRc
+ebmcm/O4QxPgJe7voXT1e1AP/ddqan67SQ9H4Gz1p0NQOgYYs9jFwkTWIdtsWwgH53GtJA9lGNkIpzP+
+EBqtJETS4REXb3mpD+SfhHT4JdsORKtBJxBpQT/EYmr4pCEkceTE8q2+kGG29A2JPwbWo1ldsDyhhMpv
+tmsphfLp1zplISf8SMGjPjPoK7zk5SjWfWOF0BjRJXEsAU2j+nyfzyYJD4NWQKJRmvXK95UKvSr89onQ
+OSLXvivxCHIVICYxLRm81WftmJiQMPaNR2GpJPSoa7Q7IqX0ALd9LTmIoC/bGMWyJ+tQ0GFjHccX593a
+0PG5Q9RYhXlDkD37O6XOiO+W5dY9AAKr4gee1zQTbfBWeONU+uHybjn8zii5gUeLuBvks4DSA2tVK9Q+
+JV8kW5qfnqXoLKDvWZHhii90mcXCXAqIDcb6pvkPrL5W4FltBCsPwrqmICDG0JtjtlKI1g553RDlQ2lN
+WB4jxMZj/UvAQiZDunLl21XWOyxib4NybO9gR2ojm1rtWwn1iZ3A1ROEZrpdTppiJ6y8almAd9WiYI8h
+8h6o3S7/ljjTdlEB9T8GVu+TqMg8fuGpUpiRqmw1y3LN/l+bZXEDU5/mLe174cGFF4vnTtzsyYkTMuv7
+J06dCLW8A0+Vrv0vcO84Stc6OuAObyXG97ZEqeKt4zIBeV8DcWubWpdQlxErfZ2qD6KFTwVDqx2BmsxG
+xLFpmwuzEWLIHXL1F32UPv76FwjamB9LzxttXOkt8nv5F9X/Rzw9k8I3JN7PBkqdlB/qv6n9q/Sxx8kr
+xueHcSuvgPoG9Li0kK7ijiKqkp1kndHU4iX210po3yWKp9FFeiW10CRl90qH6ucr+TKKswpbLm/7AxJN
+Yy50NrRPIKEIvOJEPy/7WURf0oSFdlNaATN5Hh+oQgFNYKgPO3WAMVKoBvYc2aCqVyEQpF7gWI5dSqjy
+Xv1twzYqbFUkVEAqh54GLNqet5WQAOcVpIYPxNkjWWUW9hyjMD3zegWLcwHS2ioWxl+Cd4Xd19orfevl
+Bm5nKkSRtQpLrFVYtf4mq7C/4nlFZf+V/HX2XzdoS4rri6ZicVHM7MFNRSdFUXkeaCp355QzN39NZcf4
+PVGBhiht2hJh05aitj+iZx6jKZtbMpo6czNWGk0VtQ8QznxGU2dI4JjR8AhFTPCZArZutdHUsTKaMukE
+TpCG98Zo6gj7cChLbNgvvzQYTR2WjaZOtVnkEbP7nXONYGyQ7OFEW2joDUVYNfmNrEw3nm9kNf6x8u3G
+GOOhMrIa//iTp/INAQtWbLCWBN7WRiQ4AB5tn5vNnpYEfrUGLd8E6St9gQs3UbYP7yqGPbd1w55bY9jz
+vjDsua0a9tz6DXtuq4Y9tz7Dnlu/Yc9ts2HPbbNhzy0u4Q36AtR1HQOcQuCq2JRKCsbZjcRsRYROkbBk
+lEOINCGFGQo9liJXmyCHrMghEGLxy1UKBKdfXcmsqyvKuiZMWs22JXsSxawgdeb9wBvnBlWYID8BaN4/
+CWgeFoBm9kcAzftnAZrZSkDz/o8Bmt88gGbpVXSXWL7wrxKYpD50jsDqpi/ErlxFT39V6Ol7lLS8uvGO
+uLT/N2MeWg4EZuKdK2J5uZk9qUDef68C+eWPKJB/Wtu5L2kjxw3azjaTIVwdjUddAz1P46m28X9K4zl9
+nsZz9HyN5/iZGs+779B4br9L43lrNZ4jJqZPqw/0jUfnsbklneeNm7FS5ylqP0F449N53iCBW0bDD2ie
+ksxnDwF80lrPrtJ6ihwCPyMNPxq95wP24SvaNz62NkFxjDGpVg7N8vHk34ti5thsC1uzaF3c85byb6gf
+JN4573UCiULEHIP1Twhne6fHh4cd+xTmJ4TSI9edYJLGQ5Fejl33i/Owi3x/JYDPSFXdGMALEYVOVHF2
+8smEkctnD4ZOIoac7FCvE0Tmm6Odj1eHB2fnVz+fHl+cqKhv0IomSc43brJ0PjNFjt6dqdyNScJuVerB
+ee+9SVXdkVWZ5KISnbt/enyyLxWY4mEgm2g/q4zQ5usgek4B7cGxBM5ooKYqAGTFAzkBMOY1Bq4hgV1/
+eDpFObMHB7vH+5808qb8PbaVx2rnue8927eO3eLai17uVy8wsvQWCvf6ioIFsZ8qWaax7ouJoH5pouyp
+b5wEDZ3J5Hp8IeU+TTfhI4YeaslLkN8wUszuu1JhkdDOBpjnKmaarCTDUYb5uLBn1CWMbTLz2SbrjxoD
+zvsmk/pSIzWf25x1kEFGlVSohltXUmCbdzypkNOMUopsu+KlfC688Tub3ecSv0TkSl0qNSTwEZ1o48U8
+aC4jQpuYhd8dNjoZhSpk9Ee0IaPNzSU2+ngnozBRLnyLRRKpd9k12Hr5EYWbbtYPScTT2XoOzL6yIA1P
+7TPRHjbhSxLlacYrjpsm93KzvyEef1n6b0zR0lo9Jc7VcCzBTepWX0cp8Rgtf8TmQGK7SJoXwGgUfkLS
+HB2szJH1B5zcokZyOGmGiZwkw0ZO0rNW8RNPgwpVzF7hlUHwr7ga1lY9MgtSkMokacU3GQakqx4yLOxf
+P2iy1suqy5HIPmkfrKNhJQ9A/QGBw+8uDG1XY9NBoCYnjEyoZ0az+AY/HTtGkpV8G9ir3Lom+rM6UApp
+sVi8j/k4msbfwoqTuVMIGuNeuKWs/6/q/vd0qaCJG7BB1eAlQ5PDrxISsiNazjfF9yiL0XXPyx2AjVKS
++V76WMcNW94lgfv4tEsvdRKr7FHsNeWuTYddgJelXza+er9bX5Milnu77aTHHI3T7mP1GktH1+GvSxLg
+crMvDonVNFKawroccNw65PVgSLrmyiWjpcrMyxXZxkaXPNbrs4ZjZvhZv93mP5UrkYn+I1252PpWf7Hg
+r+uppEanaqs6GqQtUHrVxEMHhPL4XZ4zItcY5YDw6KK8tqcaKq9rnduIJvXsSB9erouscYYjm/ZyaTwP
+pJBTZ3gICOmy4lb+FyTbIbPWJY4ep0MlOylaO3awpM9IgJV+kk7IqvnK2CsPrd5pjgJE+jGHFX20qniS
+P1if/tjaZ7rNlOts3l2NWelvGJlzVjWAIerAY3J2yzt6VVUyCsgLXVuvfC/1WeBI7M8+mvOxhH3sYiVY
+AGt6NKfdLrQM+/H/8oM5acj+Otzkq8IY2FLqtG50vt8wKp2NwWcEQKt68AsdS+Ds5JMG2bQAQynAiqUn
+r4IZa/CSZ2Ae5BU0ENq2ghCo54sit4R9MOZkrMQ+itp/RvA0sATGCJwwGqZMgR05U37x8XVAIGY0zJiC
+OUQSTFj5liFltVuGlJWeBEhZ5YIhZU1h9VNWhibGrPJebQADVkATc+a+hzti5sZkyIwL8BWjLipww6gP
+i5iKZFPpjNHgp9Ykaf3Usml3rPoSrqBOH1rlxFkymdRTRUsCJem5fdkwr8U+yOaq7+Gahs9Zk9dx7dnW
+VV62Pvc7VsSGD/+HQ+C22xlrjAk3YGSxWJE/Z+ahHA4JIK0XNVvXjTxFQrbq2Fn6UgwWjal8cHGoogiK
+kUjUZcY6U9ZNaJjQjDkrP2P2DZqcEHJZPGzTX+qnsZj22Jgw9fLUyuddCcSlL3xh8pOlCQ2eMfcFq5RA
+nSDO81Zr/vet2u21tMFHQr5jKaicqTO5VxXSTQF6PIMU31VIIKKKVIbEXApgjQJHPhKUB8/l4Jln5MIR
+iG9Xuw4ux8KEdFbF9c5Y83E8Z77jdoNKyAGBueo7ZDSsc5vDVTNGOuqnVV2mjEjmzilrtzPJBZmzSEaM
+QEp9xr9X3Frb7KXT2QQlITLhpC0YKmu3c/Ne2nOcRTI5B1mTs0ja4CwSa2eRVBsI1ztVo5dam4oMro40
+ZBJvHDATYlMUceZV0/BBCoOuXTBuFQNGQEpsOQPlq7gsVRFu0OfhqvQ4+ZLtFlkK8rHSA79uxbyhYv5E
+xZsEnKCkBCpNDAXrlUSyKFAav+WKMSMmIKGqxUjKK0Ucy0eSYj1WGcnTj/kuhU1S+D9xJ6iFmNKipDKU
+qxeu0VrBJrqEzQf0qabNsa3wD1zp4Z++0jNmPBkrh6aasGpoqjv21LtZ500qpCWftuIQIdWZVtGo56si
+t6RGnjMnY6UaWdQeM1+3lnDOSHetiu55ZoU/ERPlZd76Jb6LzwZZMuMmLkreUu1F+v/WdJ7z1jW2EjaY
+zIc4bF3jKM2w5a8mCoowcSOmfZMcrxgZ6lH9igLFhpeb/dc/tNt4udV//c/FQvh7owRE/in/2uqLvB/6
+r7cWi7+/lln16ARPjSnmrQnGOTeDutuK/hltta7nIj3PW3wcs9bd36PNaDMgS3nfE13wZEL3GPBoZ4IZ
+pwfiz9055ymjO+LvvTiTJjR0X/5KJ5N4liO9Fr/2tZZJz8QvFU/8Xvx5oiyJ6LH4cWauOemt+HkeX9MT
++YcynqJHDLThjJpc8eIaZvwh5BBcXWH+PhX+fAE83sWTOYq3yYTfz7+9evXvrTydZwN8H89mCbu5OD2k
+14ZE0TRhIsbNNJ79fwMAfTguCivGAAA=
 `,
 	},
 
@@ -914,42 +914,42 @@ Pp0d0WsDomiWsOhrHs3i+f8XAAD//304LgorxgAA
 		size:    4634,
 		modtime: 1566640112,
 		compressed: `
-H4sIAAAAAAAC/5RY7XLrttH+n6uQN8ccIFhRpJ2cOJQhTt68af/EiaduZ9IyTIamIAnHEsABQduKxdxV
-b6BX1gG/z4mT6flhC1gsFsA+z35Ii8VsZ21RRovF+vJd6WuzXawv5xttDpldzB5D/9K/mH2ji6OR252d
-XQTh1exGPojZ/+nS6vzhk7NNpXIrtSIWFX0Bff9O5BY4t8dC6M1MPBfa2NLzoFJrsZFKrOGsXzzodbUX
-sSKdFo2gNzdaaHd5XvvpZ4d13A5JAt0+SFHRSBHrry+5+3c6vdS0JnYnSxwvSF+gKsWstEbmFpb9wqy7
-u9wQYjixXMXWt/rb50IroazM9kTNQxp9KKTUl2otnn/YEBBA6XVAjbCVUTNV7ffLx8zMDApu/XIvc0EC
-NHTZKiTC3wu1tbtVGIskSJnodC5oJJD1OwwLaVoP1zTuBa0Bd0lyk9mdn92XxFJKY5uEafR99n3tzhUo
-+eJnEkfEpzFJrlf855S6EftpTmapG7351H0ENCY/rRmNCbqR345/cwrZ/NfzlMZvFnL0lB6vMFPiaZYR
-S8cLupnz4hlRXPriWeTN1ezO6KdG/VtjtCEg1WO2l+tZS7NoBszSxl1q6RDzN3K/5yoJ09MJZoCNLNvL
-reIquXDCVScsW9mlk8172fFwr932z520E/4qjOZnZyr5Im0FT3Jtd1wlb1PPY+6jFef6cMgaxS87SWFE
-Lkup3TlXrfJV2uET0lbHGnloNn3VbXLMdQ8ImiuMDqqIRUNfGoS4aiZL5y/RE8cygMYTkjteoOYiCdOO
-NTN9HcQQ+MCcL782JjuSuab+Oy0VgQAok5HsiaVZGMuBeZqFlIEPrBc5QSQnhvS838ouJiZr7RdGW928
-KBvHOBn7Vt9ZI9WWD6E2kGTAk40osgE7NkGMkQGpGAKIACgjLpA4HxGLAaKG9ofsmYQYnMYlSjsLDYYx
-4O9NDFg6M84bg6mgNzWoDOYcuDH81lob0K0blEr+AucQvZ8CuwgNg+AzS32r/yKfxZooWuN9NM1FnYea
-OxhdqTVp1FtXkgtaY/6avqNIjeuPMBUGtEbx6i1nv0t0tMbNH6uOb9n+sdJt78NGUX/ETa9ojcXrhqvW
-oehMmqjCcqrWxZScxpT8XUxpLl1MZVwmYYoVz+ZE8MvPBhLMr7AdS0W64WavtSHZ4pJSSlmIJdddlPQx
-WXHOy1hH1aqM9SSeqnnJwkkgRdUqiPUQkFUbjr2gotEHgR3Oq2lku4dN2GpYNQ8pTYK0xh8/hgpv3eQf
-RSHMN1kpCK3x+eO21/VYDfIpLZu6s8EdT+AICL8CQgYIG0AoAEEBwn/+DQgHQACEB0C4AYS/AsLfAeEW
-EL4FhH8Bwj8hHQ/Zu0Oa4oASM279rdFVIdXW86xvd7oqM7UuY6ImSyj5ZA3fZ8pGG9JmYNthiZonjhcB
-Vlw5ipQ8WIpV4HmV+0dKVrFwZTyPVHySfcy8pBS1X1Tljli/rO7L1k1izisUrKIUzwgpGa9YSFeG0iV1
-B2ScZCyk56o7fszvvhGPwjhcWuglrWmUY8Wtn1fGCJUfceMaHJHLQ7bHPbe+qg7CZPsyfgXGQTTGkfKN
-KPZZLsgiCeZfpYstvqJlE6bSmtY1GQ9wNzlw6xfC5ELZ0wnOJz1UMcDkOijXJ9Am76Phtk37DSgu62Pu
-Bk3Sx4LbJuXjlts2j+PavdblcLxxp/XJBJ8dpkYe8OgGx0IswXWJ/BiTNT8L8MhhCzQqk2N6OnVp/8bz
-yA0PLyg+jypIitMJArdXeR5wNzDU80jhdBSHANBw4NA2Jfcc3jiVPK6SII3g02bieYvkXj//mC58K0pL
-jtRVLXb0rf5OP/XhFQHg42R/mEaL5LyYbDo4lSfubo3v+CJZi822MOX5qDL6+KH3scYKS8z5PR74o8t2
-kDeeoAf+RCxlB7QcYCn2pWj0HxwmzNLrwClb/jTtHfGG4rPnEcunDLJRHyeqgbCLFMFDlHweLsW1WTIm
-aPkkbb4jNhEpfcmzUoAPkeSKi+W9EdnDspEFEAWcc+l5RHJBcVxei01W7W0kN0SugrZ9ZI21Zn1ml5IH
-dd3xUq6CeOynJR1aZeX6GVs72uGD5wWcM+t55IGfhRRzTh5iIM5FMpYRzMH9udnp1IsBIkmZYziBsqXV
-LrliYnGZNpX/wMiD5w3atOkH8B11XtJ8HjZB2pUGxvR1taRyQz6/WpHS8XmXmW/0Wnxtiab0dCpXX3xJ
-Xw6cfP62qR+b4SFNYzZMaIvl+GRNW7/V9drzzooGtoxYDBcBbel6x/O+kesvxA49fLf87nobj1VmO78b
-qpRybF12eK49rzd+yyzedgbi7by3Fbkj8ZY7N5gO+muILM+ZZQd2O4Wft/JbtzKV/+zkt8Pj7nh/zmoV
-UtYZ6pbv6AeMsfy2VenJ4SpFP77hXQKI30aLxEXUJOYmOXwo+Bch3lAaTWrsuBS4JXz40z636coeuuNf
-um82BbaDWyM28vmDxqatQQUhQ75smmxXMR2L5f/UmhiiaNOdYNaWpkI/kTDAuaRYccdgubgcCswrJUKQ
-7DNLWVXX9fg95TApBRvuukK0fvsUvukGg6R93CBvp7ipD+Slq1Ou18ahHEeAgH2ljpLLFPvaFiXwxnUH
-aT2e9/8t2t/pPNsLfhjknWA/CO4KkcuNFIZrnJSNpmPlf9TitEjPzfvfpeupgf55rzWlExsfBdafHvg3
-13a9fp7l07ytxpmi8/f7QycxzjYLa/yh+U3Gb381uTW6EMYeiUX45RdR3jS/wQC+PGb7SkRnQU1ruvzk
-vwEAAP//M1LpsRoSAAA=
+H4sIAAAAAAAC/5RY3XLrtvG/z1PIm2MOEKwo0k5OHMoQJ//8097EiaduZ9IyTIamIAnHEsABQduKxbxV
+X6BP1gG/z4mT6bmwtVgsFov97Ze0WMx21hZltFisL9+VvjbbxfpyvtHmkNnF7DH0L/2L2Te6OBq53dnZ
+RRBezW7kg5j9ny6tzh8+OdtUKrdSK2JR0RfQ9+9EboFzeyyE3szEc6GNLT0PKrUWG6nEGs76zYNeV3sR
+K9JJ0Qh6daOG9pTntZ9+dljHLUkS6M5BiopGilh/fcndv9PppaY1sTtZ4mggfYGqFLPSGplbWPYbs852
+uSHEcGK5iq1v9bfPhVZCWZntiZqHNPqQSakv1Vo8/7AhIIDS64AaYSujZqra75ePmZkZFNz65V7mggRo
+6LIVSIS/F2prd6swFkmQMtHJXNBIIOtPGBbStB7MNO4FrQJnJLnJ7M7P7ktiKaWxTcI0+j77vnb3CpR8
+8TOJI+LTmCTXK/5zSh3FfpqTWeqoN5+6j4DG5Kc1ozFBR/kt/ZsTyOa/nqc0frOQo6f0aMJMiadZRiwd
+DXQr58Uzorj0xbPIG9PszuinRvxbY7QhINVjtpfrWRtm0QyYpY271NIh5m/kfs9VEqanE8wAG162l1vF
+VXLhmKuOWba8S8eb97zj4V674587bsf8VRjNz85U8kXaMp7k2u64St6mnsfcR8vO9eGQNYJfdpzCiFyW
+Urt7rlrhq7TDJ6StjDXy0Bz6qjvkItc9IGhMGB1UEYuGvjQIcdUsls5fog8cywAaT0ju4gI1F0mYdlEz
+09dBDIEPzPnya2OyI5lr6r/TUhEIgDIZyT6wNAtjOUSeZiFl4APrWY4RyYkiPe+PsouJylr7hdFWNy/K
+RhontG/1nTVSbfmQakOQDHiyEUU2YMcmiDEyIBVDABEAZcQlEucjYjFA1IT9IXsmIQancYtSRkYMY8Df
+qxiwdGqcNwZVQa9qEBnUOXBj+K3VNqBbNyiV/AXOIXq/BHYZGgbBZ5b6Vv9FPos1UbTG+2haizoPNTYY
+Xak1sXRwJbmgNeavybsQqXH9EarCgNYoXrVy9rtCR2vc/LHo+JbtHwvd9j5sBPVHWHpFayxeV1y1DkWn
+0kQVllOxLqfkNKfk73JKc+lyKuMyCVOseDYngl9+NgTB/ApbWirSkZu91oZki0tKKWUhllx3WdLnZMU5
+L2MdVasy1pN8quYlCyeJFFWrINZDQlZtOvaMikYfJHY4r6aZ7R42iVbDqnlIaRKkNf74MaHw1i3+URTC
+fJOVgtAanz/ueF2P3SCfnLBN39ngjidwBIRfASEDhA0gFICgAOE//waEAyAAwgMg3ADCXwHh74BwCwjf
+AsK/AOGfkI6X7N0lTrdCiRm3/tboqpBq63nWtztdlZlalzFRky2UfLKH70fKRhvSVmDbYYmaJy4uAqy4
+ciFS8mApVoHnVe4fKVnFwpXxPFLxSfUx85JS1H5RlTti/bK6L1s3iTmvULCKUjwjpGS8YiFdGUqX1F2Q
+cZKxkJ6r7vqxvvtGPArjcGmhl7SmUY4Vt35eGSNUfsSNG3BELg/ZHvfc+qo6CJPty/gVGAfWmEfKN6LY
+Z7kgiySYf5UutviKlE2YSmta12S8wFly4NYvhMmFsqcTnE9mqGKAyU1QmlhKm7qPhtu27DeguKqPObdd
+0ceC26bk45bbto7jmtu2huONu60vJvjsMDXygEdHHAuxBDcl8mNM1vwswCOHLdCoTI7p6dSV/RvPIzc8
+vKD4PIogKU4nCNxZ5XnAHWGo55HCySgOAaDhwKEdSu45vHEieVwlQRrBp83C8xbJvX7+MV34VpSWHKnr
+WuzoW/2dfurTKwLAx8n5MI0WyXkxOXRwIk/cWY3v+CJZi822MOX5KDL6+KH3scYKS8z5PR74o6t2kDee
+oAf+RCxlB7QcYCn2pWjkHxwmzNLrwAlb/jSdHfGG4rPnEcunEWSjPk9UA2GXKYKHKPk8XIprs2RM0PJJ
+2nxHbCJS+pJnpQAfIskVF8t7I7KHZcMLIAo459LziOSC4ri9Fpus2ttIbohcBe34yBptzf7MLiUP6rqL
+S7kK4nGelnQYlZWbZ2ztwg4fPC/gnFnPIw/8LKSYc/IQA3EukrGMYA7uz61Op54NEEnKXIQTKNuw2iVX
+TCwu06bzHxh58LxBmjbzAL6jzkuaz0OsBictGdPX1ZLKDfn8akVKF8+7zHyj1+JrSzSlp1O5+uJL+nLg
+5PO3Tf/YMDsdzIYFbbEcn6xp67e6XnveWdHAlhGL4SKgbbje8bwf5HqD2KEj8JbfXW/jscts53dDl1Iu
+WpcdnmvP65XfMou3nYJ4O+91Re5KvOXODaaD/hoiy3Nm2YHdTuHnLf/W7Uz5Pzv+7fC4O97fs1qFlHWK
+uu07+kHEWH7bivTB4TpFT9/wrgDEb6NF4jJqknOTGj40/IsQbyiNJj123ArcFj786ZzbTGUP3fUv3Teb
+Alvi1oiNfP5gsGl7UEHIUC+bIdt1TBfF8n8aTQxRtJlOMGtbU6GfSBjgXFKsuItgubgcGswrLUKQ7DNL
+WVXX9fg95TBpBRvupkK0fvsUvumIgdM+jm/eW+KmPpCXrk+5WRuHdhwBAvadOkouU+x7W5TAGzcdpPV4
+3/+3aH+n82wv+GHgd4z9wLgrRC43UhiucdI2momV/9GI0yI9N+9/l66nCvrnvTaUTnR8FFh/euHf3Nj1
++n2WT46hGleKzt+fDx3HON0srPGH5jcZv/3V5NboQhh7JBbhl19EedP8BgP48pjtKxGdBTWt6fKT/w4A
+M1LpsRoSAAA=
 `,
 	},
 
@@ -959,512 +959,507 @@ vwEAAP//M1LpsRoSAAA=
 		size:    86659,
 		modtime: 1566640112,
 		compressed: `
-H4sIAAAAAAAC/8z9e5ebOLYwDv//fIoyk0OjWKbsdPc8p3EprHSnM5OZvk0nfZnBpJcAgXFhcAGuqrRh
-Pvu7tCWBwDjpOed91vqtrJQB3aWtrb239uX66exq948jK99f3X9qP7NXV82VFaKrv725elUc84jWaZFf
-0Ty6KuotK6/CIq/LNDjWRVldNVe7O17ULsrkOktDllfs6un1/5nFxzzkBS2KA3QyjhW7quoyDWtjbRTB
-joW1QUj9/sCK+GpfRMeMmeaFBJs9HoqyrtzhK6F2VITHPctrN7Aoni2R07eKTmlszfosqN6WxcNVzh6u
-vi7LorQMOeaS3R3TklVX9OohzaPi4eohrbdX9EqVNNC6ZPWxzK8Ci6LWgb+WccwjFqc5i4yZ6q4o74of
-p96mFf7ANNzT8iokno8jbSSYke9hEuyE1T+URV3wur+PcUxCu+ITjBMS2mGRh7TGWxLah2O1xSkJ7TSP
-2OP3Md6RU4tvyc6uizd1meYJzsjO3tLq+4f8h7I4sLJ+j/ck69NzsrdDmmWWaBrhgpzater61UF0PiBB
-00Sy24EdlozW7OuM8W5bRhWW6YHPVWjX7LEmFAf2ltHIpocDy6OvtmkWWSGyD7Rkef1dETG7ZPvinqmU
-lld8RwwAQQOXZDh1cgn4+pV2nNtpntaQ0uKKXL/zNtXm+OrrV682jy+W/rwZvT+5TnBNrt8t9tXiGh/J
-9cLy6OJ3H10n+H66pcCui58OB1Z+RStmoXbNmyWlfVCLQk4C9J07HBZ5VZfHsC5Kp8QZy5N66yxxXbwo
-S/q+h8qu8ljMNwcR1OKE1QPIVWM9Zhkh1NUzO/Rm6fInj875jy0a8x3xzW8xh4c3NQ1vB1XyyQ1Iae9Z
-mTCoytY6bSFMeyi3DyW7F6BAAIiDFjMabqf6WNo8BSrEFLV4Tw9T2aDBrmdWae/pwRpukACHXXYqRhzg
-kC8w4mvMQX9iIkcVxxzcsveyP2UCe6riFcRpWdWXKmB31hK1OKMfzLJYoRazu4l51VYCh2RO5xZfpsBZ
-dpM66mf4nCxNM7wJXA8WLvR9x/N59Xl0cZTdqjTN+QKKhXe2uCrK2glt/oOrA0xbaIuHFpc2e6xZHhHY
-Q/JZa48Ph2I+7xFmOMYJ6SbRW/pNc2rxlqxw2n9Ww96R2Wodc7waFEXGaN5j8cQ0rR1JBpVtZWXzOcJn
-aD9pmtJOq1eqXwlqGishpxbhLSEkNU0rEZC5XSzQenuTrnlFaWzxLTMjFh20hBDvV3CV5lcUhSTxAsC5
-/CeZERLx7pkm/+Gt/pDRNBfzbEW8YUZgF9tpBb9WhBByLeZajMxWHC+b5jBDiNyQr6YDaeM6IfXUYt4N
-otbD2uEYRwg590UaXS1lryBLhDogSvoFtE7s8UDzqHDkOWbMrbv5t7Te2iX/vLcQskt2yGjIrOvNy+sE
-GwbCafUjo9F7Z7bEjJ+CA1gen5B8P+dFcdABssX9ukxsdEN9Mgghpc3XE6pJq1/EoXgBzc0INU1KCKG2
-OD15ke+Oe1am4SQekzXLmbGM/LgPWMlbDZrGqOBcgzdkmrO0+o5+Z9HFgZYVe5UVtLYogl5pC3PeDA5l
-9TNrRpvG8AScXon8vjEj5FYgKoqQaVozKyC8UxxoQpIpHGZo+9RAphnoGxfrMybBPzRNeR6HiBCSi65+
-vT/U7y91da1BuOzzSnV+2WJe8YdOGDo3DOdsG/Ixn/eOujuvG7bfNKqYo9JbnGRFQLOv72k2aPQAoBDS
-Pcv4mTrVIdrBbI2NfbUweiA+4nt0dg7x8xoIEhyR5TqNrQc+/yc+GyGhEjeto5twHQkEEciee5GPI8x/
-+BTPVigoGb1tWVaxK146EnP50RJqa9IW12W6/9AkG4Zj8Ynuh1TxPcnPzFs2IhT6gXF49vz1eK9YD5JY
-4+N11bke4h70u+XyqO9Q5GwlSGGKEA5bnObnbWpHsOh14C5WTqogmeKQd5c3NeoqnzLR3XmgToSILDE7
-XwTqsfncJ4EXdaNSeQjDHHpKdjjrlWogwoyTzDFZ8uNENbUls3Ad3yTreD5HEZkFFvViH8cIRzNCtqbJ
-4OyFrx02ZWNqRbQkW4EmtsTzO6gCsOjHE99E0BwjqjUcIiwWiJnmVrTI0LqDqVjA1EcLKGwvaRnPx1tO
-JB7TyFnhQ1k8vp/eAZjxvp5BQGCafDPw4y4gFFMSIjw4XimSOOMqIpLW7I5P/AxhRs4pEio7FwhaBEeS
-JbHGFSCOvZjNe0+o/sPPeP47n2PGz5kH5yWtmZ0XD7g6HjiH5xQtmsKPb97vgyKD4zrOPfFmpzUraV2U
-PgnPPvHxAplqfClok6vv4Li4EvzPlZqJK9gRV7wfVz+y5OvHg8T14kCUDRtATdWWcWWgEX+387pDwpgH
-c8M3fML5iG+Kh46PQD1j9dCfaLMZNU1DgJbBwcQ0aU9Rjs+7flJmhNMYM76e4oS1KD+KDMpHwg/AsGmW
-8lTszsgeMILnS9MMFisBmcCCPZKJg1CRg3iLU7zDtzjDe5zjAh/wHS5xhWt8JEaV/v57xoz56iknIvg0
-4nudt30gS/xIlvg92VIL4d/Fzwvx8+U0G8aJAg7BGZktEV62+Ctyasfc7EuOE74mL+1DccCv+C9niv+i
-Hv5KXkre+TW5hLeWWNvb4U20DsWBQb3QBzpC9kfRBItVi/9GjHDLwlsWNRXLWFizqKHV+zxs6LEu4iI8
-VvB0yOj7BsQmRVY1EYtZ2URpRYOMRc02jSKWN2m1p4cmK4pDsz9mdXrIWFMcWN6UjEZFnr1vpKAiaqqw
-OLDIwH8nhrfZPD5bbjb1ZlNuNvlmE/sG/oYYlutsNpuN3XibzcPCb7x3m+Vis3mkSx/NDfwtMTYbz5j/
-fW48tYz5N3MDWa4j372n7540s3/7LkHyi+t8YvU1vuO/n/joKfqk2RjjhI3BUzZGI+tFjaxls/EN/B0x
-nL7BzcayrP+8atSMUyzkbTa+3xjzb+cGeooa+ynabHjT+HvCYVHsZuvvc2NuYCMxEP5B/268gz7OoeJ3
-slIfqVbQUzGG+RNZ+B8ThZ9i8WMg/ONUsuU9n/+bd/HvcwN1Wd8MshKV9d1m43+yMfynrj570PZbvcR3
-CP80buybufHEQPhncnr90hmk/UlOvYHwV9+8ePNmmLrZ2H362xd/GaaKpMZ76vPkF2/f/uiM2v0W4R/e
-fP3Ty+/HCd8h/NVfX38z6oxjAVQDX95wzrvJ6y3/v+AvaGGF2zSLmiJecHQlwUXOD7tneVNEUWNZ3nzh
-N8jabKKnKG96iJUJ8n2zieaoQd1kAmgYqYEw51ZHI+U74W9zAz2RWXLGouqrIq/ZY+1MrKxYWKfvFbtr
-krrJxIj6AQ7HYLnOYrOJkAtd1zpmucR7t/CbJ7KLLf6FXPNepfnhWEtM0/DO0JLRJjjWdZGjJ9cp/pVc
-v9tuIv74T3L9znt38ueb06Z6uvFyWqf37GrzcI3/JWr7k+Vx1DBHjbV5mKNmY6sP6Mk1fkKuvfm//Wv8
-2wC8YLN5m01EF7F/WuE/t9BxtxGjQo0NneZwSimZJK2IsXw05sHiz59//umfFa3DybSoaUI3cKKbpSvO
-ZTsui/1XW1p+VUTMiuZQAjmTic+fr5bN558/++LPeLV89qkZNZ//+dNnS9TigJJry+Po73EVbx7/b+w3
-7xbuJkLNu8UTiRhlymJzfPXq1Ss+C9cJDukFwaBrbJb8aKWusTnGcRwZDhXni7XEixWaG5uNMad2KHv3
-orbUybJYoU7saq3+jObGleGI7C2OqE5o7S1gd0hNrUlGSR0gnBlZ8iM/Lso9kA5NY2Q0YJkhDnV8itLS
-MXrBq4FzDslGxhKWR0aL1nX5/vQXSdG9JH8VJNy9DTuQl6gQHr699PR3JYS08yJib98fWBvSOtxaMUWn
-v5AT1Ou8lLnc4aS+ks1SLJsNEGonGSGqMRbrh22aMX44S15iPvfRuuMjwsWqbduOzkooQGCEmagrxltJ
-xBSceOH0RWAXDzkrX/akSuAG3XicLzhlHXEQ9fyOx5ppjPKMNs1qRsiDaX4hflbw2lHWvIIZM03L4hUP
-GmuawLlHM0Jy09xbAcIgac/xAcElxkrWa2XkXzZ7ZCEn2DlpEpPMW/mQ5wvC24IrD2tHAjthtZTMf/n+
-dWTFCA06srNTDjdx91HwHjuEI8ECp7FVgdSumqjKNGsrwDtkmh+rh3co8575Kl3BWIT1LlZfvn9Lk+/o
-HoQnGHoIg/vUR6YZDnN+ldGq4nn5mk2nfLS1LicfDY7aNLZC+66ipjl74VG+J33TtGZ3TTO7s2tWAZMt
-1gLWtCIBLgldq6lSIpAZIQJmeN1Dsh+drFuxLi9qcXvGLCONDITcW3LbSQQCikOKnMCuxhnxLTkiXJDE
-oghvSaFIVbERtosFKrytT4w/GfNbPoJ5RS3+Ba1LUti7Is0tAxsIV+SJGpJp3lEr0G5kUNMEfDJKxBHC
-2SxWNtx3vIEDqChfZJlVwvyJ3f6ITm2c5jTL3p9uCSFHvkLijmc05rZtZeWp1Ut8fsDGkxU/kWGj9ruX
-cwdCMM258e5zYIV8P3f4ECAv5ENHzyM7pOGWfQNTZJoRy1jNrgKP2tU2jWsL+TjwIK9PmOpL0DeZUh3V
-ekefzJaY9uk72nNu+fgmLE5ZFlWsNgRelVK4GbUCJGeqk7LMVt2M6evAJ+7CPRlgh/yYZRp6u6VDNCnZ
-08bgrHs4BBS2WKDIpnVd/pXmUca80GO+T7SxZ4PaAtOkOOI85gqkswojivdAe6d2VRzLkL3OI/a4CPQ3
-QJ4DBBQi0Z2QhDY/jN6kQZbmCcdqocZtLVadiMhdOYtV38u9vkL9HVLX7QvbUPHPQEoBe8znGm7zCKHa
-nOb/q/otrYGmMQSJBm/oQnvFpfYUwy8Pd354DAFFpwNWbn/yB/qzVmZQgV6aOsH4Na1eah+aRv8yI2RG
-TZNxuJ4qrbU+6qY+7oM+7lQjdTR6i8wDrCeFOBLrwHBMqOX5WAE5DhBOSDwE+WSxQKHHSOwlPsfrHOLJ
-zIr4D39GqOX/ui7dDTa/aU7d9NPJ48s0aRuShNpSgEVOLY75e1r9+u0353IVEOTSMTFAUScxkS0oSf4s
-ME3jr2+//WZ40rR4D42yWlUyIcJhOCHUPW/Mue/kjYIC4aREou3rZNwb18pJgguSjxPwgcxiK0f4XtRk
-MZ6HxfSY1T+n7AGZJt8uhxkhnBBiNo2ir+9ZXn+TVjXLWemef7KMY54VNDJwRPFshRzGkRcNt5CLV6i9
-WkaR99kRwiFgOjh5KrK7QEiHihogRmrgGR0d0l2ygVpe49TCX6xbV35QB8VXxV4cFAZCsrlzQsh4aiAJ
-xuetdvQL+ac4y/NLlJAoyQm3C10sBl2kiBN0RzwbVcjrapqpr9Zx3E3emGtFdpxmNSvt1y+n4L479H/D
-tNc8mJzCc5KJHxBti3kTeTRsgB9cnCCb2LJjotg0Dz02HxG5fZdC1wt9x/PbFjn/+0GJ5i4ilG6cAref
-fxPj7/rGieN7mh3Z/79nRIh7J+eF8x1QY0jiC/3Dg46pC0kv9teMBBMgRJHG0sWECW7uf9aEJOZgwdR8
-vH3xFzK9b90pBv9jU6UVv8jFOMBPuME5xUzVHX974RrT8zEjy9HsDyqHW56nIIFAJ0VHxXCthjhVFmrY
-W3JkYQc0kSJ34w5eQBZILvNZ/zEwDYsfUKdYdIELo6jFJR/4Hf8jeLEetY2nEO7aR9jsHI3lOSv5UUmM
-G3qVRuQTY36cG588v7mmz2+EDK3/vNiUm80nV/uKZlnxENJDfSwZ+eST5zfFAWgCJd6Hb9fi4/Oba/H5
-uYHp+UIb3rC6d+STT/wOqZvmnVgZw3v67olPekn7J83G2IAMdrJS1ZO+qqZRVfUyfdeBvdEIQealutLo
-30SMf6q2f5ML5Rx56TFRpk+aLEn/BM3Nn04Utf9kz735v304ZkfLS0fruS1ZTD755KojKj9RT8MFnkwX
-q3etLd/6AicniHi0HrPjHOQNbIibGxiqDn0BGmXPOfGAjZeXloGnk2gKNqCkkDF3lzIGws9mnBWbWBiW
-wyAnauqSsOGouTAQPts33YzNlpeb6Sv4o+1MVfMUO48Gwqoktp86fO0RRwB7ziezSuVXyKAihUpqmsJ+
-YMFtWn87zMsT9sXvE1+LqZzV6CPHLiPoC/mshEWew8aD/KSS19wYbnH6N6+a8X0OIyvlyGbEwN9xqL4j
-d92EaVL1OymeaThBWJJyKk+p5wnUfBR2WOw5N6cI+h+KKuXdRrgmQdNo2fKapnmF3CnJ6hcD1t6lY8Le
-oTgSQtKec1xr97NR08ysWSQkoFFXEf8adk27/aMVIYde6rpprv5sXkwF7bvx0ZnGViAFCgEZCLR4ikYg
-zJbrTvCCvySBe1YP1e96M74LlmtxbTG72KfFLLiU1J26buRYEZli9ggh1rlAGLmXpyBAzgqvTD7rQs/z
-JeMsEIuEbtl0IWgocvn48qYZ9YMQcm+atXWPKXIXKycQuYJLuQLkrpxb97V1iyla8J8AOUvnMzPipVdT
-C3RpYsNOT6hfNiB+tNeEeNTHW+IFvhCjN82skznDiLpOuyuH8Zd4qoO8MNPl1VLWtQ4JXfcCKQ1+EvuY
-C5FhyHMF07m2ei4pdPAinxCy9SIfRfN5DwcZhTQMKY7Mds+7vFXPK2fZ4hw5eYsTqvDd9F0U3GzkxywT
-fwKkF+mw59liTMGhun+gcP/Q8TJvsEE+ebLipAs+w8umeQCZedDJzMummZUC6wRCKVKTogcIgWRZbKgO
-aQZA0UZNM4FoOagqbCTvVPoPHabpZItSwMrQqe1nKMC5mB6P+urEer6EmVIYaXJ2PzJLyvwgoSBlGFXx
-4cIA+owMJLEjRSEfx4SZ5ldilvSceJQTuQzut2YHxVsocOu0iWM3dnRhSNPMDu6Itw6QY8VkgumEhYzt
-6sDCNE5Z5MaC83JADs3Hz6qQHhg5599HCo/iekMUKcsBZJ6rHxtv3uc1fbyCnPjqmJcsLJI8/Z1FV+zx
-ULKqSovcuTLmsspjnt4d2ZuinJJ/aYwV4IGMzEI7YjUL65fHQ5aGtGYVviUSpb6pOekCUm1xsctpGJ5g
-fYlwptiugFAv5mwXHDJe7IPQS/JcMUKa0J1KHXyQO+IVUvB5C9J8TFvMSAKT/5Y9Tg0gJIYBuDLWzuqe
-HeeMX9w0X4ifFbwKZu1MIxQMdECfIa87LKp/BG1iSqgNugtAG67pmn/QZfXhHLSduwvDT0XTn+kIVvT0
-Zw4tIl8/b8DkQx1BL9FocSREmQK/VOSkXeQ4ny+xoNB/qNgxKpyUYkBIzs+43x3OqcWcreW/JctA78E5
-Gc8N5/weXBiFzJYtNq4m0ltszLvPJbtPi2MlRz8o++9LmdoWH0r2CoRGzgmUZqZkXN7KJ/zPUICEqfep
-Tyz+t2mo9xn8/dxvGn1HiZycVwMIfMYhEMoZfF94n/pwIYZ7/YTPUCu1cT7YkwGCwUZeb0UDK7+r6VPk
-ys6p7WxRb+nzfn/mk7nFf1zeY/74Z79pVsh59tQy2D3LRWWfgvp4FKk3xMt+Lsr+X39Ovf8+y+DwH9Mc
-t9gq1aOpfTPjzZsmnx0FaD/bMAfyJpTX4fJt6MCAXJ6TDGfcCU3zrcge8mMtIIkV4tkSiZfO+s4ykNFd
-RywCtFDPCBZmyetd9nMY8BE/85VtH3zRV+tThFoOzgKA3r74i/NRueP0hZcQF7lniryzgRxKE7uqywWO
-AqfvuqTQUWiSnXfrvbxJ78zKmsYKBspE1rtOG47ODaFB1DxBBp/T9xbFE/0KxBJM4LSwFzhpL03zcWHr
-WNAqRf4Ggp3WohaPNu5AT777rO6kiKQHrEizqRPa9MzlvCCfN2fGp4PNOU43xCeXU6aho3K4bAav7+Rr
-aJpLQgjrAC1EjvG0T9QTni9WjvFETxPw1AOjaOrfMovFkQXrwOh7jg1BNQmNK21ECTjm4G6TdaCq6p6v
-oPa5sTAAeMfIRlmXSVUcArgFyLoe6HFCjIxWtf598RnCW2JIfUDohppdfthFcn7cc6iZzXTmQoN33pNU
-9GOg00ziGSGJa2innTFxAtwNuZSSbDkDNr1ZcEVmqWnOtrgmsxU/tu/gdI4VKXFAp33HX+zJ3juACHzr
-7i9vv9LhI9+PyeDZal2QAzGKPAMVcGqas8I0B8Npu+2fxlZBvMS90057587m0w/PPk5Ms+K9u8MZ2XtH
-v2ks/gP2eLck8/aS8nr9kicN3iHPjtx61AfVqZzsOA4Efaadt/JxzSninffMx3v+dKdplXm5303HfM4J
-Z9Pk09I0Vk1yskRNU9iH4mCBMtRwJkxzPq9Ncw9M54k3T7wHnOPaXwtDn4Ga054E/2+GhnAtbIX++Dj+
-wxWXA4Vh/M+GoCan9hEW8zW0bKoXhMEwoqap/ysihCxNs76OnpNl206cu/1dBlDCQKlVMEmRXbFaEEOV
-R0fcjkZFGMdcXqCz6EpUIKj8znLHO/ouMCxMsXMr1wqJRzHFhoEDH+ttjawFLDpmn3TlAtrbAMUE+KoL
-KgURec3PKC8BiifyycwK+Q98adHUqcqrW+KQpzJOGoq5cU55UTvpUOYozlDPx9JDwPZcUaq/0uHTMRwD
-x2ydsmNCIiUlYNjzORql5+pjVkwSb+sLOmXLhxPwnxgNB4MZjvvjGAgaHHEWm1cPFk3wEV5noYDrtkV4
-S6vxGC9qu0iFTY1XbxFWrPqFWuiYDsLn9VqBzuWAOkues5IzXE0DfG533lF+3vFmM5onF5r8SZKPQCdc
-gl8oD9CL6YdJNXymZrSOiiu4Bj24gQ0VjTUIH/eZwxN4++M08b2zWyHhqLlQ6PQs4cawGzec2xIsxtLU
-sdIX6qWpLcI1LQeODHTF3SKkQrbbP/NduR1cZYtzHsiHwE6jFpdFMekYgRJCihaDgc2l9NymIWf9pAjb
-NK0ZNPkKrHKa/tni5OZsxrECyKypvS1Z3DT/pnZNA9BfA7t8uMpwCmrNVgirqw14XyIsr70mqfM/qIkW
-8F5QWxkUNYa4p9KS1NVfi9XTNOmu64fpb10FMCjcVwgq5C1m+0P9flDlH5IDpLHVCyRu/jxlfyz6MNHb
-WXdC2NA6XAVvGY1YOTW2X+WO6+YUtRgmcCrzLxOZhSbe/3KZNH0+BTTap6DFYChybqM9rupSm6Zp8Br6
-+k3TEgyEFZAxrwLEMOK8iiozlg4q3xcHDYmpSfKWPuC4UbImBPWCxYrnYXfjHD0T5IU3SzecB04IOe9Z
-fl6bZmm3Dm+CdTgnzxAdayfQFuEiij5UfPWR4lk91dHOfph0fV0vFpyIWatqokE1yR+uZj6PboLpWkAn
-RwF4Xm+JBu53nc3+qaRRWjizpUAjQfHIn+M0Y/z3QKvqoSgj/pzuacI/tqinrAKf7KkVoL666hjs05rn
-L1nF6vP8ucivFB5LaqFTW1LNtY3SdKr6Hg9IKuDjS4oTzu3WxS3L098ZmSQCdbNR8rsSCqSxddvphbhL
-57aTs663hOKUUz073rgSnylKBZ1C05xZjPxD2EJswd0C3yJbspWVMG/pK063abYIp1IiSzwf8YNvtsIW
-Iz92NYCFNFPK4TgW2U9Cyh0K9wlQqUaCXYGiQt9ox1uLlUiu0vxKTSPiHf7ZS3ytzzsv8cVI+JPFpNuI
-j/QiwfIKxmGXWk9jaxZKfwbdDG9lurN1e9kZcn63KE5RN/ea06eKqgNAIMglHljgGMY6uAnXwXyOojkY
-l4s7gV7NqKuppkP7r8CO0hIzTkewx5pT2U0T4YSEpqkLXwkhMd6Sx/7yLBDnkDvg31kviw+8yFesYKDd
-QiedNFqW6MmWD8kCOFO0BUhNz9uYaMQ0qaylu20eyrwv9w/uIwLBvAU68xaMmLdgxLwx02TkA1YnotGm
-CdbKHMfiLGvMyfwhu0oI2ap52nvPfMKZctimXuyTPYZv5wPsCcBuvY8D/emeQRtMdScto+f2CeC4zWO+
-zDhBUDiUH1xdi/f0HD8vAcI63xMMfE8IvsKLfBxqdw69PT7VxVOqLs5AeT7ekiVO+y2wI8JzQ9C5H7Ji
-QiUDxVFUaMVQT9NYiboQwjtQouAvW6T79em68Kh1QWO0ItOccTbPNK2IPFIrQrD2Mya+Mf6N50cDRXmJ
-eUdQ7eOc/ylIosZyIHHT3FMraBrjqYG3vSqIt/WdLfCMd2RGm2YWm2bgHpwHah3wHlOoHpckdFnTWLFL
-naJpIuR6vpM4d2DpYZqhdYdLkTNCpx15oFaJc4Qja4f5xPKEW7IbAsItZ0kzsvNuYUZLL/dufc6V3smn
-DKG2u/3idLt44A14Pr7t1GiG9ZWivp1YhDvvlle0ZkBfCR3EHU5R+5Hi1o4w97UV4ww5e/7p+WJlmlbs
-7XgPE/7Duye2fwkDJoQkbqkuAQus6kdOiTBzZQ8SXOIUOcrmKsHlwDTh/RApYzhee5hMSGSrKy8P7hn4
-mcFBN2kaLYmfwDglibtylvj2koWpoGW3mPM32YVMr60AC1ZZZtwTTxel9Pt8lnDYbZpwRsiObworICHq
-Ie1WZncy+dD7oxPCBNb66/QmXqfCL0M4HGsqx4r2xKupdaTWHuEQ+R3eCzuaRsstp1ncwPKv8nBFOPSO
-vphpRubzdM1u4jUTLQ/aZardgczskVrp85Vpim7AIz9POwl3ulgh5TBFnu/GlbjkShfPRJWu8dRwDKPV
-/HgpC7kQpzfMNN/3VaaYcYxwE4uvndC8+wrHOWr3imJWlAH0sAew34fGX50ARnPk83yJYzJGMfhWlMlw
-ge9wSZa4IsbSwDWJTdPz8ZHvrHuyw48c1YBeslLNtjjKuUX4PXmYE8Hm3LsrZ+DKrGnsFf6dPHa+d4rS
-uhVe5YSmUtI0t2hdzQj53TSlE7iMPHqVj9bVfC5Qg2lm6FSQJU6aJjtTbcqbxtpbGSeqZgclAbkj1Cuk
-NvqdlfGSOd4idJIUZYakPJn35oG8R21ompaVkdkdb800y8UCx6ZZq+yAs8o5qXBomry/JXSpay4Qzd1Z
-NT7yqdUU7svnSylHrhYLVHuV3zRH+GvxH/K10BRJEVofOco5olbhkRQfEb41TY6+j906mmbZeWviIDpQ
-obDSXkVBjA3vyD3CddubRvCzBjmxyrcliVBaS7NpXkDqYID/phcaJzCL0UlcDyZgAdxDntK3WixQTN5T
-K/BCH+HYO/pup2XhMPW0jskLi+Lf+VnIT8i4UyQgVFN7T3sVA3JJZtp5uSFTLtBMk+KczJhpJhYl+64Z
-fgrBoUdCuBNYgSBKInq+hinJQTqq3QFz7kSuwTPTNF6/5HjA2pHUW/pIsv9fjGwtD3wDdVgohQt7wEKg
-CkmszhDE2imUpnMvQj4aIN5H5C19PNPc2+zhQnsg98M9QkkVeyLIfTW2NiY/27qfDGVj7C6ddLiW8WIB
-3eQjjH2sjQN81mrIlFOrGRFjkecuI9mHhiRNm1N19E2bOEtb7lQdxjFe8SGyTu+2olaK8IyODck5fOBQ
-3SBJiLL2TbO1KM4RshhoYeEQz4Km+bCdNfhg03WNyFEZ7hpIaRpJpV8weTric30lMptleG8hPNQDvWDr
-tfqAWu0HrJgn9PE74n5KLf9PQgffwMafhAirlx6OZFc8P+eim+aWCklWA7LXLUuTbd08pFG9NfBYBiP4
-22n7sAAb3e3wkBVyV84zYcDXa8GdaXpPjgvkdddg5KGNZKjiD5vBABebxkcGLbJ2o5YlJwdpmh8XFvYT
-oSwtQdnq0pJJZ4SjPvWa+rJbf8OTvlvWw7n3Ah9Ex+5orh3wkzetRxhpeoSRrkeIcEJbsLyDDU8ewdfp
-oSSPvTqY/OQZjiF8px7KTjxV6vp/6oU8al9xKVxTPyotO3CD9+u337wsQvIoHnHZ64Q+do+8XVBx7FRq
-H+UHUOR8f8HTDZx2nQ5mqG5VKMhLkPAU0ovOlWhA+yLJlhIsmiorVFez8uyj3REdtfj3i67NPH9Caj82
-56czkG2HqmZNJe+FmmrAuwM038uLvhx6bfuD+kMj33i8v1+R63c3wju3925zvVk+d8DHWb0pN/km9p8i
-b/i+uXafW65zs7nerJ434AjpJbl+Z3vvnD9tvI2N/adPrvuOfj1S4Bm4QgyQW9pJyQ4D9SPOxXQm2Epr
-OcKg0Bu2yOmP5qnSQ45KFjnzIRN8sGjvgpMzW7KOl0qr2i0layN9dDpWQPRPCP9ndY+dPbQItaq+C6Ae
-eEtfu8yzKDGcvKgtUO1CBsJC6KVOWNDY0WcN6JWRdjloULle5Due7wyzWFQNKZga0nn3sebw2jqBfuik
-m8OBK28Gb7pTzV6FDF3wbM63qpx57bYFZPNkuQ5uIpCZcnK+N4dhXuBjcO/eC9akGDkkowY8H2G9JjEv
-FsVQiWbn+Xzl6ijRCpETdqqEH3fR/rX0oQ507GyFwBd0/R8XXArnxVNXfzOZ81yZzzRfdPQjiKm7Xihb
-81Zo0L/CfxHOzDbVU+vG2zxsfvHnz5H37rn/tPmT7s/sr6SLGjAJwQzHcLgN1rUj5V9NdFIgZ2LcCL59
-6Zum8Vw8996+/N6N53PyqesJSQMoS/jOX5Q3JwwWLczj2RUlzinIwBYRBlwraJoQyZVGzpn396BLAxMX
-5RUqIMFVmlc1zUPe5dLlu9QJsB4GAJc2uMXmxA6UxIHSYoOtOeGrKoJlxV+JNYLWzt2cB8JhLrtK86sA
-DZAseLxnPnLlgxXwNzEq0JxkGL7ovvM79o1EY6N05j3jPKFpioqXPomxtovJCmG9Bs3QrStAhwXEXnRG
-TnLd/iS3S0aj9678BQi1Sl6gc+VsiSpRu/6rdq3GYRC/IqUVCfh9LaBXcAZVcyjZveU6P+V1mjVg6XyN
-/0ZOoBJXshxuCIXuSsWfwYvbbIl5MWe2FBEqOhy3pdWU53TVMZ3J1jfyNO4S7peWa3oTrukZ/hJBIjzq
-6/irxWFWVEyPqDB01y3Rq0K2MSeXEnKOaQX9A7uzQwsAW51Yv0eUXuSvQ9MMOTGzHllpgZevTk9htTJN
-K3ETofki9VvH1vMXziXwoY1OcXf9PLhsOwtJ0d2ADPBxjJwY1Bci9jipx+FO+PKWZ3UJ9kcCeJHyzy3Q
-rkIZHCM5ElcAcpIPuicgueBlxfl5DkUvssxSONZZrFpMo8iZNDw7C+ihjWwQZSRhtYVwCYXBFzSNoi/H
-0Un0SmkUWcpl+ijuhTN6V8BKEWp1v8p/F10dk9urIbnd39NL79CnCTUVpR1ybvgaSHM0HVdKiyi5n6eG
-yBGDfsuJutyw6y9pmY+LgSP2fKxmIjPz4Q+UfVErcMSlvGPNZln3iyy7OISJ6j+U/UILHx+z3g4Mmtf0
-B6ZqrKvNi1biZXJdfrcsfZGb5tQijYeH2DYdHp4sr7P8PLNC1BOZOa9kpHEJToVcYYXI8s57k2NBhprt
-DxmtmQEqmaTL1jRA0Is95vmY6p45wVxkuGHtOPeoTybcZxEtDk/Q044GzO9A9/5zBDePIcKRaZ7hpQgk
-cx3HEcFlhIbbn3Nc+zfQAR4gCobw615IxuyS3bMShBp4hF8YUiTfN+TaezdgAOfXSb/1v+037amTVMv9
-LQ1jrW9A8ImHKr+BF/qEn1s4aEv7K5plAQ1vq4EhHiUTKJm36PRhWVosrTu7S1xxrsEN8mKFUzI6WhkB
-pcMiDxmOSEBmy7W6mF3zEugUkkRJXaUIYT7f3qhzBcXeVl1khd7SxyEnyEDtHMwX6+Lwff6KZpXQkYl7
-r/KzFWqpvWf7onwPuiezFectZivMs8YkdD3fgQgVO3IanAS97q687A4GdS9WOFFnY6/WehWBJq5Yi2Ac
-+EmntUK+KQScmCaELrNC1DTdeeuAuqjiJXuKQfnJD5FpQkgx1GqBCLDsaKrgq8XCs+LEuBTEdGEQJmgY
-Jc4JSWnLYBp8WDhEnJZAsRIvh3iFcHhDtqa5XSxa1faYPuuO/b42imMgS2LNaHmkpDlYCdBvktVLkd5E
-TkYScQUDFqWD3BNLPItbnBX6eT2uKGyaoGksUZ9qnheZrG7GQB+R/ZLWg0AuPQJnAIviAgV0+qVmsKs0
-hJET+j2A4aBptCXldU/0dWerRsexwbRyk/2N2g6P7DT9qO/0NesvUL/vLZm1rz8opQ6JeMExKBVMUwf1
-4AOhLPZpxZDLVIQdOypyBgweTTMO+1PF6i3L+zKAy51A4gTBtWCPqhunCCk/pODbZJwLtW2PzV6ymJWD
-iekuhz3PyIs6jd8b/LgtkpJVlYE11GkZArWAJ5Spr8987Bklq4rsnhnY4AMdVcCR4tV0LcOkJVYVRYao
-FRzyYoPP2v+00hWW9fBKfRwR48DyCMgJRk5VTespSItaTLMH+r6aDPsHq9kDn1jVM2A0YH2MSRsSWGyp
-ssDpofQw6IXg1Loa+yNQLeXQxabAcyGepg50/teLvM98TkbLp3XsRd7K962zxhm4ZZyKw7ceA68G8d0j
-8CICnixOXHMYU9vAlqssJ46/Q8RKJ/Aib+nPDb7DDV+0y0Too671FrUIU+E9F/WttZjPqK4JqFknLvsd
-n1idatjYckXk3oqYdFpwPLw7D663A07WCm5iJB2jRHKyQBGKAgvadU4LW8pZDOkZ4e1W2CNcVSyLFzAn
-R7iiRusdOBX9o7G8+HICQA0jAu2Qy9ydQieJFeMQf4cZko/fcwLPseL5HH84U/c1lOvIlwfxstGMkO+A
-aJCYJyUc92CLNU2oVhlyw7RwsvaWMHengzpHojtLQ2Y9kNvsMWRgP/HXorjlQDedYlF8a1ecxHxb0pAh
-HMxXzwk/S3kHv5/oYChBDo4S2bV14N5ayLG0VhJWA+Uqmrf0RsiFbJaw/Gb123TPimNt3fK6P7B/Ofr2
-lr73qQ9Mc2ItMR2uIz9InO8wHcw+EIgfKBQgN3C+g3zPPpQvQm7kfI/QcC/Jxw8E+nO744ViJizh4nNi
-PZwguRISeM84HR14n/tr5gUc/5CEdxBvTRMedHQUkW2LQ+/TBfW9Z77ybYZh0p75NqdREBalAj5QTgMg
-HHuBt/T9iaBXImWAZAghsRQMOhcIi0EhknSESIsw62YuRkLuCrspxjHCcYsftmzSVmUcezMkAY6ICj6J
-2VkUL4QTDeoshLfkQ4Z4ITpFnGUUWJQ/jZt8vnLP2nBCvFgETZPo2xeYwbblOC+4IZwP5LRQItA58Acq
-N07kzsKzAOHuqAVvxHDWWmgUFpR5oW+a/K8ggLq78ESckUjTHvoBcmMgGFVDWqS1HoAlm/kPcv3O+vqe
-Zs3rvGZlTrPmR5onrPmRTyLLQ9YI9zQN6HD/9ONrBLj5yfX6Eq4hQ44H+PmqEE5m5KP9QMvcNAPT/Ie8
-27NzumdonEUFr+5auupacq6MeWDvWVXRhOFA4B0Qf5RCWv21ykl0QmCAeHQcC8dP0KIWpuXHARgJgTPU
-OgVPP4ploMiT9Iw/1EgY9ceiqGOOegq0i1i6wpD9F5rWjnwe7A3wMDZbuouFrBhycoCBClDTWN0LuPOf
-icApg/zPl03z4wh+vdIH+aKaQBgVEYPrqYM3HN9IV/0jD9Yvv/9Wmpx+U9CIRQZ+g/D/odOZhffqN11r
-FmqNsNgfMlaDWkskPr/hO6JpILtkfvUU05xFYy9+dlS8Ccsiy9zBYst2wL3xufftyb7TiYyq32L7vCXn
-Dh9wjBNFKE2o0hMSrrVYGkTj5tGJkdkS7kO3V2l+FaK3UO0Wh97Wx7MlVN2Z9A9i6PKS43MLognPlqCO
-byWu1d/pq+gKyLF2JMABuSBk3Gly+BCcVsr7rs4aIAArahzixI2cSMUQ3fp4i1WSZgHAXOrsXNUP5KRu
-AN5iOCMXt/gn8tFLbuH6SX+fzbUr8B5Qf4ZdnVa2DCAslDv40/xnG+IxtvBLVvhnPdg5OGKaOo08vbaR
-u5dTi38SEQl1/5LDEiRwpDhfOGrp7fSxng9LRekAh0Uep8mxBPkGXJUjHLS4YvWlYJ7ioglGoPwnn0XJ
-RMwr7S44rRUgn4TrYTzYcZ4IDeOYsnEod+3CRAAluFsZdMYZzQbnrwYfxr1qMQ1DVlWX5N99Q00TTAhr
-A9PssoRud0fD++qIK5tKvOIQ4f7K0w2dAJ1LzAZXemNQGOxFYHa61wCdhkGzOdVJApBHawOWqiz6BGBx
-q30VuV7gO8FApntBdVjGf4m8wAt9v7X0KeKoQQutbEXoI9AqiT1Z5yiV8ylbWr2kNf3jW6WfFBnJU+9P
-wKknXvwXsLn8Gf8qf/8pNR9OQu3h6aZtNp569tETCPTmvVj8y9fl40+0WN11eYQjBXhDmlUMRP6ce+Qo
-UDiMAq9Y/GSd07lhuHPq/LNTzfjbm++/E8oDAMeaGcFvZ1qDPciNlYvApIIYEa3pgpMuSqP3X9hYPDEN
-dOa1YKS8GKFz5YxQMIcheWKFSPMJ+asG2+K4CMnQY2KoCb+mFrIzRJeJFkVN84v21uJoWGawOX+1xeZV
-fZD76eW4CDr9KskD6WTytw/V+su41t8uVvvLoFogajSdgei8gB4PQF4h44RzyLGmSDtYYaEZo13/wCn8
-K2CZWCiCxdod++wXkYINOYl8aSsDIXHxcbaZE6D6rYjwByCP8RLoos4DmwAlcWM1QNdSAPo5Qvg3YZ3n
-RT4/hX8BuBh3Ac+WnYKlihJ1LlgRKBR4Vo1uFpAmOELUIueteD538ZHGVqxh5EDGVpDThamOg3slfcjz
-28V0Adsf7RgHAdRK17H4nMcTKXwWpuF0eHF/3pIEtW4WBLwpYLs7siNzLuoZd5pYAQHDxPjRQHMDChk4
-Ir90pxYOTRO8Tw8PlBC5PJe2M3T1nBAhJ+rvqCCwX4sjdt4pdIJwfLx94bCYlDbkEo1HXYQtCLbVGW+T
-0v4NsnHWr+r8CGkyBVu2Jrbi2kjzToxOZIQcrcJosQCTT4t3hEhdXeV2WC+LsDyaYrj7w92tQAL2obPI
-NGH3wkUSCCMsjjH6vk5HoZdTDzmMdYd4xCKEgAS7qQ7xSVxTXRauj0U1Gl7ygm6ZQ1/EZhrjqalVEv18
-pu6wJ9SILBH+nE8g5pjkDOJvQletrUJ1XbiOjnJzJoF9CBnd9loPoUDuBCzXkAoFf7VyfAuDqk4PGv3W
-mYDND2+/yUrCjNHyHx+sR8KkgHjs+ZMyPZ3qW2E2FC6JgwInA/WurQ77i0XUNGzAZ8fYi32+DS4vnFgI
-sEaS+1EL8CURQuwlPqYDYJXBYgAi+Zkwn2P5BkC47bmwraWL5IJOGEQpufbmC9/l9Fb0dGM3aBPNLdfx
-2Nc+JGyieYOuZcw7HFAyjigM4YgRaZBlzCmdGwg03P/Lf9pFGQ4p8Yy3xcHAxo9psq0NbHxZ1HWxN7Dx
-DYtrwx8EiB3GSA+ahmIjL3JB0NlV/T6D4G4QerwxJr5yMOs0+EbOqjFn2brqSjuEbW3IkoYITDvm8DtF
-WpyQUwvceiy4psSLfdW8F/u4fySBCAsUdlFgARMjvbSWm1fUM1s9VRvTs06QFU7IsyXeksiduKSzw2Np
-6X7qtav/UJ4XoPqQEg4VO063huCw1oIM30EsfS/wXcNwjMOjgfAtGSU1DU+YEbIzzXmKTDOgQuO3a0J4
-67g1zVvvUx8Mo087smsa/orVHfgtmadNs1pHxVVM4qYx7M8NfHtNYlyKmYHO3s53St4ZzwixYt7v61Sq
-vcWmuVgkHTXDT8xbMr9tGl71kp9c3sp3b+cW/52v0NPQe+Y7c/4XR3zT2Mc8rckOR3ZV07ImtziyWR4R
-MDAGu42EwqprUTs1F7xj+Io0eyZwfK1z0S5zwKVuUETvB/FJwpGRGnhBkOAZaOCJPxQ+swNrccIaQVaE
-twbC0AfCBqFHUzqypeE0o1CeWOKk910R3yTreD5HETgBh0l6nzHwKhMNdx0OXKvrAV8GgGuBuyJtCE0j
-jN69mIPcqBICoeMMIQ4cbeqIWhFS9W7BUwRyRIszrUXxBQvCV2tYSKVg95FlNy5xc8OEg3M+xFF/eMpA
-n1I7q6tt8TCxzVIqziWgLrdpxC7nQS2uiyTJps4sIyiKjFH9TtOVJDlv2JJq5bwB9Tw+KCPZiluKX1VQ
-vYqybXca7KjgvJWzpgbcN4Hd0S0l10PbpaGmGrpOccaLP2k215br7Og9bVi4p6gKy/RQX6d4T8lJeH5z
-vBU2VCSj/TGr00PGyCfq6ZPnBjb6GEY+rreMRqIQGJSKdPno47DIHO9Zl3gTFllSFseDyNa9aSXqclCg
-5vtRVgqPetbI8T4dZ72pS5m9fD5R5jdpsOh4S2wY2DD8dr2ndnGooSdEPKdFjvfUhtL8Ux0XRc0fVI/h
-mYobjT1cZtMISmzhNVoPYrr22ludX8Q/Ht/TvRCsUfhNQc50TePIR+5EMCRVg+fjkfxO2NW5nb4nBZlw
-OIwcO3KwBuhVoqbwJlqH8zkSO516oY+NJCsCmn19TzMDLJUF+gnGaUgY5B04UDfmn9zNw3ytGdLdXfKW
-o7x94YwEEmMrxP+qpAmgbqQ80Sxx0Xc2vynWuTAviAn1ch/HwkFljCavBmKE1MTscay5rYl9J0ZdfO4D
-FTKrGKFTQsD7wyBg19j2OUrvOYLdEutWntgxahpPACk6d4+fkj31tn7T7KmtoBonmhFxyk/V0t7W++yH
-kikTCzRP+fl6C6b+mp+ZhCS9++V1P8BkEII/IZmuoJzork2JYQgRgPT3oUb4lj3CmQixKbJRCb4YXRDG
-vZdLtxcRqOxIjcQYR6DcCCo+yuuCmuYd0SjKeHTix7zHObWGUx8jbAjUZ8BtTEGtBOEQnW61viTeLe9L
-plZR+Vw0UGevGnekTdbOzhSSostQGJBh8LboAiyEJLoUHS6cjg4HpwIEzRkmqwh52Ohj5YXTEeNqIGUG
-FBDChXAR+lVW5IzvL/4LazpbotFbB0TKqygOBpbtfP1pyejzx5vr7tnAhZ0XUP1XohQBq9cLNQ+szi1x
-QpZ8ysfBgSt+7t2y99e4lgfovjhWrDkUaV6zsgmFRfGe5ccmKmnSRGVxQE2YpeHtNT5CGe+d7T9FnAuz
-LXuOGqSho3uqBzXQfXrp0dY1P1tCRtwxBAN3sb1OUTv0vqT75gKqF2+Ht5bdddLpjJMNQSIZNU2IldgZ
-9ZeaAYL6t1B/4G19HGskVRpLo5hI+LchhLkWI5z/7upyZIJpnl9vhTx3hKMur3zVusJpaXBWzsgD7Tb1
-rAuuRHV3+7FpWgmnlqduJksL2UUcWxS0a6YUAVvM7OSYRiSBH/CRBu8l/MznoAt1LtJg9yyvgW2XRgsM
-R3AB28o0chIHmHM6N14axB3Qjynl9x/fKbEikh76Q3sLQWVKoRCPQxKrL6BmozwTgGjugrVYSbEIxCwG
-Go4Giq2U3InOV6hptDfw8Gcl5E42Ke6t1RuZiBI/QX+UvF9i2uoyTRJWQuh2EYTeVUmckAfjfsWGa2o9
-Mlwtx5VApxhIv3MzDB/vxpduu8UCbclRHp6Bt/OR9JF/IFtv5eOCWFvvmYxlI92e2MrvCcI5eLNXnQOH
-ETTzcr9pTi3OicXczI5YxhJ+pr0/MCezgzSP4DqpaXJ8sewt6eXP4E8zx0WZJlDHQdzcRFgusBNivkSO
-WDCsltphWPeA4MDCX3CPIC1kEeYIvTrQkDmF9OliG6jlh6K1J6mX8/mRT5ws2nej+6o45jVZ4oyfEMeD
-acqH3gtBgRM04/u2ac71MkxzQlcjxwlCOOMJfJ75r6ruFuFbBeAKYIcfiJgOji3cvTJxGPV3PsdLfIsc
-SX3cgtQflkNsTj5KzvdNXif/sV3aX7iZ5mDPmqa+n9DpPwPaNLb+V3CLTh8C2+gjYCsAAFrcki0EcxrF
-y9lsbGTMFQxtNrblOvbTzcZukIHmhsWfniADrhrI/tzx0i3Ze7GPZ8w0DzNCbm0F/U0DQlK+tPBdrP3W
-NGdbAcS3dgfDqGki0xT5qi4SoGU8fQpqSE0z678DXA8cLellRnCzWOBMims4oIunHjTROjHN2b6/VeQU
-LC2j4iHn2dWzKlDgDnHK/VHqKlcWxXmfQ12awF5sO7WPnJ/MKVJL2t1R5HMOHACqsyVajy/tU4DKLrch
-GrkSQGmAW2iJcKdMtGVjcfoI6kVKd4rvBJArS+Og0eUFwjtiia0g3Cqo1sCW0RMI35cSxTGMdqlScMs5
-EhJg4W173BLwk6kXamqhXgjaHkEP3hAMQFwEzG7tQ8leyhE3zeBVs10OxDqh07brn8Q8lZ4L7zg5rtgD
-KyZbL+T8gWnOAjutfiiLA00gxMCbujgcWGRxNGCHx7JkeS07FtssY3st7ryV9Ed7JYLTyQpf7/csSmnN
-JmsO7LLbGFCgfxUbJxlsHCuQjXwf7EiCA5ufOSSBH8yIZY1XJum2qC+MUkXxpklUb5E8tOWQUu1GmJOC
-YDNxzGrClFGgFYANNcvrl4J453wQXBZqI7QQ6h0A2oeiqtWKmebwfbCCWDUHijBiNi9rFHCg5uddSoIh
-JsA7Qm0RUAIcMJvmTlcZsAxgC3QP+dQWLvifk5XUxNvNiPDfsiO7gWkviPWksyW9WlXrrPfrv+sCYoO2
-KBJyDmnSeWqx8Gafwo6ICEhQGIk6DDc3roxeoJN4zOdks8d8Eg3oBLe0mHDLIN0R7MDuT/p2EUkyhOmu
-C2GKsKgw7hzPxx1q3Erf4RwinJ22Em23qDtprXITfLRUoJz/gcdzIKw5pIzWdVqJr7QB2/aahJjiE8uP
-e6b098b6fKBDN7ZI0LgApVzC90Wa0wzq75QFptIG1zwfLH6e4lF/pFp4cajy1vcjo3so01o9S41GkC23
-OE6nHUF4nYam71IHPO/b8ghDLZaIwjllBY2cU158eQykYqSMkHKShP/ELMwI4Zyw8A5hQ/bBVMQiQgqe
-rVo8oFwMSElzo8VBdiw/1AYZtMFzD5rgHy63UBxro8WwLy+1YShBPEcHUKXACELNkpc0zS/lmShlNoMO
-QBbZg04mPW21L1ESNigc4gGLi5IdczHzOk4cHusKHVOJGzm2GgAZaBwOvtiiURCrdOVQ27ZCj7+jYs4U
-lCfVynmDU9rmUgfNPq9qoBMwcCIkMrsWBdOxzo3OsPvKow6oDots4kNayTPnB3ECsYh0ngu7T03TK5Od
-JYqx9JPDzzT3njoPqklxwKu1Ms1PxSkBb3qQe/mlPxmcbn0FXAwIBjp8F1nAdSmLuiyDd3ndJKYAB4JN
-BNZTnZUiPd2zNzXdH4iYUfXKydW8eLDEcS4UUnpEAIfREBeAnuAZuiUnzVGUI5Px+TLw+ZsinsT3D9BA
-IsObdH+EsTuzFR5SF+fWqefwsr4EHPcUU9OcyeSuFQhsNKJhWjwiYf6Ths/H9aGmz2gl0fbULP0nnfjA
-LH+sN1NFLQlh570FAxtweUOz+u/sPT+LAjg2wLFUyPd61h1gW5onLHpbHCHuCP9Sl5ksFbGaphl/gsX4
-YUsrKLRnNZVZDjRhv6qHf/IHUFqTqfcpe+C/RrilpSHbK7/i23G2xLci0y17r77ICE7dk+hQlrK8/rV/
-hGaKOK6Y+Coe4auUN7+OtBc4cHjHwpKx/Nf+EUoIpKCNvy6kmFi8dN8ftukkR6do0/XICSvkN82KqkhV
-4LPYlXaStpoIt390qN3NRVde1x+vR3WtzMBdOc/MwP3U+cwM3GfO0pEFBRQogSoHENSBBYjm+Q4sHQOe
-i3tWGhgeM0bvmfp8rA01iTK7fBMF5IssopLgTB97qRmyPdQnpwE5EGAlKnECSZmezbTy34jZGBdDlISO
-8eo0TWQc24jj2u7mCtzXNI2cQhJ3DJgu/Z0Wa2NZBrw7n2ktDgKPKXUl5bWIdkqD8L3FRc7+cHa84gXi
-+JL9C+jTnuNM/qWbFEUTRfpM4dKiI55eiPajnqt1o26G5oZtzLUkp0/CPVeEo451xZ2DzTO9aukd5yrN
-rygSODOOwcM2veQcMJgJBre3dO/dq4JTkQAHnRZhqLjhkDxIb2oXLxwGisw4FHrTUh3kd9D5cGe0ZLQJ
-yiYssobtAxY127JJ90kDNGeTpfltw7Fic6Al3SPrsprIU+HAEm2un18nKX7BGxCXpM0N6N00N7y26xR/
-Scm1vNrbVE8t1/HeEb8hm+qpuvGz0XWKv6Lk+l1dHtnm2rKfomv8kn/YVE9vZpbrbLyvXr54+2LjNYsF
-avgHf+Pz5+eb6ukT3XjkazogDYWXKn5MGIiT2Ofu0AL9htqoSwO5pWU8B2UOA1PkLf2moY7mKeXVMHgQ
-7CZLIMRLoe/mxrUxlzSmVtNfegU08pWU6Eqs2NmouWq/eivfUSTyWQt6rX+lk0IMnAq/DqP4mJw9Gcqq
-rVjTSUc4IUIvI8AxwjsS93JrKY9UUh6c9JdC625j7KTrwyWOyM5j/ljpQ78wCzDDPI8X+qj9ddipLflV
-61RKdHdWW4R/lX0EZwfdVLwexQq5EECxd2EemuZudDwFCk5JF/nSkQWEtpqh7qXhHeRn+o0zGbo911T3
-/qaphAZEXUN6Pg7EtuUL1+upLPG+t0fNyX6xwnfg0xhXQzcod3DLUDXN/vlqwrjuzjRn+gW9aX4pR3yn
-eWofopnO1wi12Z3F0LoyTQuC6N5pojWGY9AfsRBC+G/UitVBAR3aw0l2Ry2OHZf+SPditsJg2xoTpm/I
-FcST73VKdHOdGOG4aSIp8NpKT3E5tZimsPEKgEWFlltnN/t1Np+jHWGY79ccIrSUQnXA2uHZEkIEpZwT
-EjotW5xTa9fXiBAOlals5uMdzpCIvwYBX8jW2/aedUdjFB3c4r9QxNdznd2ksjNbXpVSHtnpyiOzbivu
-hopPA93oW7yDkE92VYZuaf/G7mn2U5nxPOpZJCLnwOvvlWo6W7aXFEID3PYiVQ2l/P08ehlmJBg49UYO
-xTFZrmVom0hqXMbzOQqbZjXTvWkDMZMxmsP+zkWwsGgQFdYKByOMxtq5CDRxeFFtbQZ1DLRqtWBNVLef
-09WdpkPVqgn6nWLj5snq+c31k2fPDeE7NmeXyBkpOKZDpRTAWx/QaBd+d8bKLWL2dE/4q+EHYSIqogRY
-FAnhMqgybfmOyjkKle5s43G8udfUir3Ix4kX+dB+AF5okRAix00jSoNOmqjwQkV/HVQE11NwEm17HxbQ
-I21zJlqcn4JaCZ6lpgkqkNqSboVJSH5m2KXHGItAyXpAnwMwdnyHFYLes4DHNLZ+Apt9cEAder/0xrHw
-SR1xvVW1+sK8yHdHJBeEBBve24F+jLq3W+sNSNquDb1fNXtqS39V5N85dR5B8JRJH6kdsa2Zwn0wI5qI
-ASzzjA0B6bnhNnVFoAqhfyyVliE6MjqjUFfykmMMv6NvX4w/NY2Q2ukqgLS3BqRntlGoxUIRbUJP+290
-xAgNhifJolHzq4lvX4w/Kfrt625i10N9OCok54eS/X+ia2lesbL+EsTCHGcNXMLyjgqJ8X/cT+ENVUPg
-ow/jhoXWPF+yuB7Ky/+fNTcILsKbPnNU2fkRh/gF8iQT4jAv8BFEMxhHJbFGRxmFUASczR6org65wYnD
-QzOVktIV06TS1wchgUudQIyCExGTNkFAwwzMZvnx9gd3uAAVaVMI2iCCZtcM5IYm1GeR9rsQuJ3+5qQX
-C2qasxe096k721OvU2KmH1Ji9tGJkrGKMkXgqRKuUxVPIccRinGsRpHJxgsWiAXT9U75TiFLzSq/DSSE
-KSQndjjcbl3GRpJ0GPoRVTJWr7Mm+gCkd7zLCLzXvb6z3No3y/ORiXgdwrZQ9kWqk8tQHi2myuJZiFph
-VG8LxxBPhkJb/JN8NLC+tRxD4Av19QXsZgM2taEm4EWWOYY2GcZHHUDTgUGTiFIEnmziLgbaYoUTslwn
-NyReJ5zIhECLsWYAKzYEJ7tKi3mJj7zAt0KElQphhEPh+32wNzWXzlHv0pmS63d7WiZpfo2/HdtOKntJ
-d3Z4RMJocq5sJr+j5Hw1RxErOgYxZQ9ahJrQLg4sZyXIhSgSvf2q2B+ONYvegFldgNq1rkzeUeyBuHhM
-0SmVhlBhVb1ljzUxguJxUaW/p3niBEUZsXIRFI/rgwyz5qhYd2tpOeWA9dlaDN6hx7pYi2LO6vC4PtAo
-4jXx57o4OKv/WkNMNOfz5X8ZONV1uQ1cDhXZt9ILtZCaDAeWonVIjNV/GUIRtDjghBjPDsKu3Bad+YbF
-NWbE+Ex9hpaxGrDIBIaqxIDuxFpeLZX3S+cVtpxOBxec7SBAPJnWvMfphYR12hnZqT4FNLxNyuKYR19l
-6YEY0l86XwE+W0MN9ukiBi5gl5fAIsBsDeshhEw3h7fnoAALuZRr9t+Hx7UIbOcsYTWX64zFtbP44osv
-vtAWeymhYQErftCAhwbg55MZYpN1S51qXhUKfDqkjyxTgf0mzv7AgtiDQfH4BgD1R5alF1xF86ysxVDj
-t/2aXsgZc6Qs6vq2g6ELeZO2Rai1tKAN39NLXB7Ma2+vFTbNd8A2haCDDhtX6WCAFMgKUNOEXuBjg0N4
-MpDun/OFoFWtmdIihGeFPR6zhUzzW3myJsg0v6Eq+hZo9W/l/mBka+/T/Bd4ifkLfRQv/XftqypHEsxH
-8iBzim+RXoZhrVSMNCWvxE3mhuFoQcR/GMhpTwOfVD0lZMmINlLSqDxBOV3cDhKgC9r7Yu/+A2wy8iJn
-DUiBLXe2CD1GfWTP0TX+kScvFtf4DSWnDoiNHorv0yoN0iyt3zvGNo0ilhtY4UVpltvit5ScMlbXrHxz
-oCHfIMbSwHGR17+IzWR8tlwaLf6JEs/4hQW3aW1g49vidwMb+8rw8c/0AgaRcNXN2i9UsgSUc6U/097u
-QXlvWnKa6afDQdFMcxUfYYVwSH6i585qwL/vT9QL/XmAx/X2C/Yr1dU/wwquoyuPjrypDZLIL8L5EEU4
-6Gv6Jx3FZVO6zH1gMBdCK+/po7XEkffMX1hh0ywRmlsRGLiDNbuj1fkvOqGXTZbSSjgkhFiRK9Gd4Sh0
-aSD3M8cQMTzBecbKWa7jm8/W8Zw8Q4ZAcsoKOpl3XgbCeQhG1LMlhJGIXKurUWVe9C4JJNY0hoVU7bPz
-ArKbIv/c+EXEGBXlkKN3ZLLu/uvsrOcfrnsi2P+TMcojArHFRCJDhvjJ3BMSA28MHQI38GyF+1uNbztr
-Rzd2rIgkpmkV9hm6t1DTxL1bCM6CYYNTIIY074kJ9QxxcW7Mg3PgDzrg93mXwRHYq6ygNVhLLnE8V4DD
-MewUgABAzQHeNLFhWFXCD82p4Du+fu+czr3qgSxNEnwwWYbM3PGCYB4fusbKcELQm+pcMzgnmqd70IV4
-XbMSHkDrVKjrZcd9/xqnWfa97AZ/zdjjX8riQT2/2ZZpfgtvPUaaLXGW5uyv3VvRVyBoAng4bKnQXnhI
-o+IBnn5/DdGg+FNR7EGFT+1452TEfHL5/FUVzLPRYli6iWticd/76Uiy+d+jd7n0muMOvB1720vJj91J
-B1q5g9M4Bbz0K7W2SPgYVosH7i+0161m2By6iWkaCauNNL9KNP0Fi5FEehSarXCEkMucnRf4jhV3dmya
-szeAUtahuBAh0xRB9KyQxN0OiomRw7obCAvmn5P/EpeoJFlbOAfv9eeOPra9ow90TiM2jSHvp0ANo3dF
-1tOIBqcz+GCIkeZbVqYgozVNoxrNAwF5aiI91fHVBKswd8e/dLqmEOIBqgs7WgAJIHc+4Jjlw+v7P1nV
-4VLqK7jEfdc6dxsSsUUIHHGUe5pJVxzg0/EthUxvqcBGsIObJnStAXph8vJ+2TRp9SrN05oBsmuapSMc
-indMt2cIktvA8iTyz5hjbWxkjGjUXlKe1Wb/kHN17hKHn8TAaYH+0Y8srKsulBrfaQmrv+RwkOZJn8VC
-gtRzn6hpcRh/eqMFau0Iti5Pi86cnQ4830Sm+Z28V4hMUyLhCP+xcyRGmt1pcr7BpEsbi/W0AmivqkME
-jCQ1BzcYKJIQJ0rkruZb4zPJD9Qq7HPeAU/gfBl0XQMHgf772vq1mJxwznQtYJZPfRln2U7M+IcraZE4
-uzQRj+TjDQMrbs4wsOQDJTnQXgZAOucgKG4qHN0Ze3cvCJdDp5ajtHMD31AZpF0ZyPFCfx3dfAaXR8yj
-nCSJfF5/7EV+08RetHgGv0vNoVKLv+lkhwr/9V3j+If8E5x0Dy5OxijnsiB0xN7xkXA6Mo2tsS9YMeZI
-0kOsN9VLbhgIpGIv8BLf7yHNS3xxaKjhxO3YvWro6g6TQuT0YNqCJtW5B8RhXMHfBoSwUuZjD1e/0V7h
-FmLMavna0n77wFhOfqNYzzdUzP2NYl7OmXKbrfw1Z2yv9KoPZXEgoVJaqtI8IXBRKZ57JxRCbwqco1Qk
-UOqgtKzVZcYDUbrOSluU5RGJxCP4fIpHJ2HYn4QtDo/lufBVjPIgIKfrbgdnVKJD4U1FXnLpZbru9+kt
-Lo/5VMTmjzWmT4AdHQWxJ+NKFhUBazuYM0+bS1/daYwLPqV4iVfTafKiTtSq7kSKB2KpWV30s4+eBvP+
-bVhfVbODlIfrn3pVEGHmpepX0VxNE5xIuGHn3/PSpHbpwtd+i8+gVwNSPQ3r9ZFTZysxOjXl2nTeAoDo
-5H3WrtOUiiv/7IlafeXYQGYWB4pMc4dZHcUMW+I7Ft9BySIwTcHGcErMDZzl2WkJIvFHmNWu/rMvFkXO
-xa6POqnz5aK0D56ce/Q5OQqgxR+cDikNhgJpcwiDB3Yfg8m3K3Dk/7Y4kInPcKSexmMeDYRvQvgw0AuZ
-6qBSFwcsc+KcDZ3W5mhx9cDPvPM0+/MFCB3CorLoU3j84TW6fqYZ3BhQ1oBA7Y/kDCRxt0DkJPxh0wAH
-AQ4D4UtEOAhrqm3x0GzTiKEn1zgKyHXvB/KJ5iuEBRY6BQE4thOSpy5eYsnujqyqXygO8VUpHD9NfrdY
-gJxBNAUWiJ6CovM9zZB4rcHESFNPiwOdzJiOvUGVsmqLMOUAD+YgfR1JcB5R+SRFzFRoCQZS7iJIAfJs
-EaCQAC2AmaekJPPQJ8zTxB0+oVqUW4vZkoElTEom+Znc92MbTCkwWbeBXfODj5WCb/B8ZIdFHtJ6kGQ8
-NUB+sMRJd/3UOZ4D3+DMi32B/kJ+SHeKdJHmqC+YFB53+n2S+k9BkqZ4Av6G90JjPOfESKHYW3wY8MkR
-UCGdcxBsxI8c0MD3DwCYFCEPHf6Cx2Is0EViH3NIjEzT6l/IEm9JornixfqL7jG1L9M0W4vTYP2X+Rzv
-bRFzTgefqW99ocUC936Moaty7psmGfoGRq30CChjIEDY+4CDUBgoBxdAnEtBMiQJT5ViVwKHB85lrINr
-8N1pOIaYQGHAB88zwkmY2Z1m/HXnRT4Ki7xO8yNbH8hs2eZe5JM707wDMran5yLUprGVkgn/+ThtmrPP
-OUKnbOyK3jSt0C7uWRlnxQPxiu4Z94+/as//9PEOOtN5dxSrvQPFxw5aeh4RLO/HnGPvkfKWl3Nvyc6x
-Ugqu3iBIybk/1910RaIQQghbRppzTA21No18WwihumhJHMG7SUevQtLEGbsU/DdAtCYNjIrO8+OuRdqg
-b0mXgnekHxenGG9BcNJ5sBz0CPQ+1ayCuLJbhe5mYAqctXx9eW/p6+ulp6z0lH/qKc/8FiGcktmqB/Uc
-8dHfuaoLaX51Z5rWgdzJQwM5d7qHcYUW8EndYvDZiU3TUgXI7P9H3Ls2u20r6cLf51dIeP2yCAtLS8tJ
-nZqhgrAcx9nJ3nacHTs7OUVzUgQvErUkUaa0bhH130+hG1eKcjIzdep8sJcIkiCJS6O70f08O8p2QeD0
-7nnj7rpOdSRzMSSMzHHezxn/TM4Mih+xFOEuljMkmrGCbSiDyz/Jj5HTp1Zq5w72TqW+r0vUXz7zAr1X
-YiDmHV4jx+wMkNKuXwlIyJQcAMhB376rKMYYy/7AC7g0RCnLFWkPQLFWzHBs5GnPD1Wg4wltZfA90WMl
-JSkUhBW19xapfdWK4ht3XaiemqcMmJ9LBfohAATWfvvt+cICK9UtYChgmIyDce3iYA+MVw0yAlrWifpk
-0FKuaj8TjkK17yS6roJNW+7s3Kywq6TaMFkZI+QKEemvbQl44vnNVSFNbVbzFa68e2tQ12BQ6/JkkU7b
-u21onUBLhz0wzFiyYhWTHVJ9dRMEdZxHUkicX3TDZI8uPZDvDPAL2PgGOKWXBmobEQcytgNPt5t9IChr
-dgenbDxjRxWE+hpU0uh4YqicRmf274nllOlUS+U7rct9JEzhOzSxopyZ5oxMg+s2jHLTnAxbKUpSpiAV
-5XHkU7uhr0bZ/WHGVtKSA4p19XPqfQFoR+oEfoBpe9NbCuKBFZhpOsDHO2MFF3GveyPwrpRuvjtmj/Dx
-zER0ma7PsetvnASZ8K93LBMppdHS5cfUxcrSlOvfCkwLfIuVCG8HW4T6CqA32YwyuGIZ07cbndBn+oLU
-VwqxW65utkJLSz0ZTlC4lLfy7R6f1J3gpi4sMeUm24W3bAkIND5fqvoGsO2DwD3UaEQrylaW11ZdoY8V
-va0qlb/1y2nqN0V4q0rlbyNfVBkeGXtjU7ahmTQ10zMs29abaMWQSsBtALlyrE7t1Bg4NgnoVrCjVtej
-I3lOomSIegEdSXZShEiAoLxxWZgr+5bZPWk5PfNTemKq+r4f0aMKprHiAUCTIcq4JfSfuwFtM8j/7EXQ
-5xysHtfyyFPuHyI4kldkKDYEhhmrQRgltUjtcZ8wJPaXB11HRiP/BMxqHSW435VlwQe9pcAUcc484wrK
-jEZHPViivOvGeRCIHmEm0P1aqZZpuYmXavYpB3YlCITDy1o9TpuqigsjDPks0vtnJtHSngXrWh/IZRdN
-ePmVe7cSpzixxWk0fImR7Xozr8DxGwTqxxh3hkJ1yNEMK6bNuuCFmU/M/vRJWTzx0awLKitu1oX1v8nK
-1CN7HBuqnJ6kmPZ85FVWlB+ay/m8CLaCQbdFRkEPNzvabKYxyaWyJhULmKNlaDbIxYllKm1Ynbu0Ccj7
-hlCGXDXQuEhw3eOpQSXkFoF1mD/kWEXnYakBpBFipaq39X5JqBw8IEXD8YyeLNUqnucLVnZdpfoIMDxs
-Bu5C+VKxadVFbEH7a583RYbS7uXlc60JokgXYW7eZpBrBLOEXb4REQSZyiweZkhhFylhBB9LaWRjzj1m
-Emh5ENV7yAQ17m5ctBHeSf4Prx4EBWBHUctKCEmgi4ELjWFub8Jl32QWlVdXc1rJW6RUVukhxjEbBHBK
-TyipMEOBHF3YqTllgo9vWKXB/Up2Q+l8LIIgl2JngHwGe37QdaiaNzTULp9rU5a7bSX13US3K0mBy8Jv
-5tRv5yIurHYEpoEek8AU6jH4yM4FrGtsV/zrZGVKC07YRhXYqAIbVaWMyLYUqRnpcoxBgW1LWYtpRwHt
-iM672Vx8tYDEiCIRaRDI/9XLegeOcNLDXX/UiXrb4cojw9DnwtAbk7Kh9RzixkU6V3/dlcnb/0J/eNcN
-UTHkwwGEOL21GFuIUEBLYsVuzPx+XRflt83DNloI5SeiDAp/2UERvL8q+oAkEbJYfSZlUvD+sLWhRFjH
-Ccrf3R2cE1ATnlAV2XOqutOfh9afS3X9lUKLaPg8HI08Sa1/uC90IU8GNtPxYhgT1g88F1/lZtxNJjTj
-EPKahSr4FadtbobV1RW7ofPcePmUQ73ZheBdVp5mR330kZ3VS2iVRfvjsxaQdzyfN7/5wjntfpaAOEYU
-iiKk+kZQvb3LMEKcOes+P+7XzUP0v2YzVmX7Q/RiNrObB1/OZmrBLcp19tRj2jY8crK62FU3pOElIoFU
-OyDNHQHvMNA766fnrM+lhlucvX+G8UFmT0DBu12Cpe8jyjNxfgqxMwgSnH4WsB43DQmlcwVuYPHRNHb8
-uy0niHIIAHSskXYA4lWXBc8VUEdZsM+8o7qXkwPRqCcK8p41U/jxL32emydpgPi1YBvBFWxydji030M2
-59zTmWT5ZwML8NaLO/fMwjn8F0jT7E2XeAvP3sv3Dll3slzFv0DypX/HPy/kH3oG1s092hGDPxGDNbrT
-0Qqhwl330pG7LpQ6HbQhRjH10rpg996Bp5ZCWsd7xWsRGfB3J0wCRTrPMT7caxOgCS5t2Frphu8VvDRh
-a4LSuIjCzGc2ECyfEDmKsZKFqURBfEAVmtgRKii4glXP9OPZvykk/FhT8UKulW6BCIG9j/uhmNGxOzaD
-QI1YpFMEOBMNEKjWQDVu5+5O3RnVg6Kg1DNCakYI1Dc0/vobdkKqycaMlXrfGUGuCifnJcLSnuOU5DBC
-14IfhziwjfgDLbvXm7nav3QqQ6PcQISdjx5kuVMvff3xYXK9oIMaxEaogEHTgXMo8o1cjziuN3xN0LpU
-QGV9i5TB/7ChpKI5VS3xAggQ1PmKslInmG3VJjEi8aB06zSwSIfYXMDf1KgLs06ekUW+SNqdo572RRJu
-3n9eJPXAUz8vkpz0jASr/65+TLK067K0L5rO3u+/J5pGFySN4PYFYOkEYAAbfiNST4z8dTGRQeDgXxQJ
-8mLgpNzp+Z4JjJseDkTpCRByyATE5xLHzQnhhD9sD1L7ntFoKzRMjQa1oV3XnBcCgFZbVvEsurqRU161
-TnQkVdOSiCwPm/V3TUsYydfZfk8i/CtvJrLrvJUXRLUTzKEX5AuflXkpq2a7XngRHR4Tnq4RWouhirX/
-87oFQEb7N//Vp/SsD9VThJG2zIp32/UTYWSTPb6BKSKbqVyvVaKPOvpJhSUw0jYP73fZVpY3a/Xrbl++
-zXaEkarNNuU3mGLAdIrB6wKxdl0LRy7yehAjBIK3XoLJ5gb97YTbLC7bvGl0Bcc/Ik5wxifhAZ2467qK
-f3M5gWBw0K4jxCfPy4rilTw3FPrm4cKDn7/n7KOXxYoUXIreTj8izDyQ9U9CZTgrhKGhVPOMHs/aRC9W
-IK9qxSFV8k8izKWpLiVL7my4kxGZ7ERY0olsvuPCoX0SyULe7hB7j8ikgusgH7uYcDyaL/lOhAVl5Zjz
-pQqEO2tdtqSnkwcToNJS/y+3r/OUP2ni8VmWu2dHSvGlv4WQ/7edgodDXfP11Q0teGGQfcwZ9j/pKjTA
-+13l7D2oz59fYoUULlgXz2PkcnYGv/JJeL1Fo/4OxEBX57arnbf0ujo3XQ0I+fREo894t2DY+b3Lc3pE
-dVE9ChbzgS4WvEIlsZwusz2+iaBx6X2X1ODtlwu1821RqoPAtJ8GXeP2A4LgV0shT37/3Sxov/9ODKjx
-3hN3Z0Wmo0XXZaiXEhK5XmS/XpCMFFE31GcNz1g+mwsuh1wmh5w/7gs17vujPVTDHWYDDnkzrgWMZ80q
-Njc8YkqxbAW//theL3wt8T5bX5InGgNkDhlaF6Z7wXvDjg0PO9Ca52dgNRBbUcT+ANRD9D5bh5RGGdMk
-YiUnJLJZUGq6lHE5kSf8uI0S4zZwI7QcwjBSzkDZm9mEkBOlTOpf99naCdBWVCP94mH8wBQCepUaKVyw
-WzsKS0bA9IIcK6gLLbFSS1aLn+K+TDnwJuVnX2Nx/hphzgWMW/sSNM4jaa4p78pgqoYWjy3QTCvmtly2
-W64TZZzhZPIhYd/ur6m56mVczOExBETvRKjwpTJKTydFbHWhVjVsNRXrHgSPrwouuPJOXRn6cYgWX/IF
-eBIiIPhYxNXkJrIBnpDeUX01i+toEVcQolrDRm1doambstB6orquABbQIBjnhpIjCMJx7mqe+kTXjb8J
-3TOMaFZZQjUyWRvmajqwhRkec0WBIQxQ3/Is18qNhPIaRs6L21KnsLjhrJanvuCltGHDwqr3Fm3GjkNV
-K9JCU1aBGILNKaAbUlAIAFLsdQa/uqGsOp08rVu55awfsKcNe9Mw5cOek7MMHaPaagRP5zvCTDctCNDT
-yXoewcjxnwhYAxeFyRnsqxrYMWm2JNKORaok8l5Z8IqeAv50mkkCuCeeXc/N3FJgc+ycWUIOfJfkUUUS
-AwUZT8quK1K242uUsYIppNgYeZQiwT455wwcMlxg6YEsf1iUAH3Skte85LJyJo3zspe66x6P98oa3U3O
-6P3kQNlZ1WwKSlkQhJ/4znkm2/FPU4xLoOyTYjCj7JY7t0aoasuWnuyY4MKlIhEe/cCOnXNgBgEQAtX7
-D/hqvIpfRF8wpw34J0uHx1zuJO5cFA+SoH36UxI0dAcZ/iMU2UwokgfAklVkEZBRqmVwItLInco5A8fG
-9ow0a4cQWFXXjbe69bvO/FRbXyXLFa8VeiCrIBhvp5qmRcVc/Fpvi+YBAqkhMIpvPaK4rtsx3eGryU4u
-c0u+dAQcnS/nvZIGJRng7SznNec8LH3sk64DoE+8rnZxkrquVlXhi3VdRk/WDAiXvEE74HOcWzgX+OLr
-m3gVbQ3TnfyWjWYqW16gKdOkfkvNnUYo2wTBRrXpkuWUbfhtECyT29Q5EwS/hEvqsl55t5hS3G4+58Gy
-LiF8+Z3sXTFATBFS2dN6AyoI7G/1vGa6g621XJPPjX8JS9p1t+hWM9pdmeygGb1RACSGpfy0Ggj7b1MY
-nZZH0cx1vmOyhnDolBrwpoqaevRce8UhcSGewghJZ5aznKF3vUf5MTvRee/5YYHYbYKeIW2eCds+38xF
-HHZdt3xRg3GoCr9XrJ2XQtOk1u2kco+G6swB4dOunUSuGCNYPkZqRRnpxWTUlvv6j3KEOVcjYBEaFWKN
-P4AdoWgetvjrbod/pRE2MoQKI82hMLJ8CyPLsTBC5o0RrvCj/Z3Y1IfRbfkE9d6WT7u23O/lj7vdyKFP
-Jk4i8NCGte+AtzsEZx7rGdq7sBwqIL5cs9qoVhvo3qX8tM90rv1WqSbYzw0FiBnwiqrG5qTZaqKpejvK
-7BlMds2X4RE5rgwfFdJRueRRQwPC39pWYl2N51AwQwTkczHS0/yMOBH1pbtdPw1WgTv2BK5ipzBJAwUT
-dC4X/HOW1gxHI3OvZWHZdTM6uQGAWaS7/O8/+OpmXsZe9SWNwmKQKcq+jcpIkBWApQJq10HwbLpucoyR
-vjNBCuxeWsixVLnAxf7b2zfniH3g+xJddxaOpbP9QRMEJMqcgzjKpt++e/uTrLClWPF3bbN5D7eDNlY+
-Hq4fN2tCFcxkQY+a5/pkMQDHgB2mtrb33zx9yBbS8gsJVNmWbdu0Tl5UO4WSkPywvc/WdTH67e2baEQm
-wPYBzfAgvzb5mD67Zo/gGog/bq8X7EkppTiD1YZTV2+yRdm15b48dFW9LmEH6o/PblXdlk+Lckuva+ue
-fil6EX6D6fRqsggH/JKV9Jh33YPe1aBxEWZyBMgaJyQhk3NSDrMrU8YiIlLZSgkrNQ69pgzPu07fOea8
-hZVUvkSBEcF+9Jqg5nklVCeSMsUaYcxkGz68eQqwlcNxSz51oAhpJOZFoqO+Ul5u86Yof/n5h1fNZtds
-kUlvQjiZDJzxTfPz1gUMrunq013ZPql1/Kd1Vm9NfKVufA/TAyPMQMdl1mNhWtFJrnkpwpxBEo1wQJ4K
-pf0GhPZCTfdlW2fr+o8hPD/VoqFyyKkL8Tso5Em7RQMVDGHkYjCLilVQ1LNqSln3Qxa7KrXytp6ojnkd
-JsmSA8dL5JfNJdtYObPqfUgibfQTGgR/qMHs+ZOkdvUkLCYuns01/PvYcFJQeqL+x7mjynWg+UROGDDR
-85XlNEY/WT7gJzvKD4nQxFEkkBYL/1Ew8rH9uCVyLYwGLs2HL0W4VS2RvxH8+v9/MbtesFeCX/9/0+fP
-rtm3gl+HSRyk9Hee/GeQPr9mr0HeTJ/HNEpGHw/p8zD5T1lj+pw+u15s2Hd6R1w0d4cu2+3kv6v9oWml
-8JpOrmDY7etmCzJMirPuoS6A/u7ZNfubuv1vrz90379++S19ds2+l2Ufrz9eX7MfBD+e2N/h/38ITp5f
-E50iTJ4Tyt4MREJlhM7fCNh25Qf8a6XhW3fXzY8CO19dTLCvfNZc5y3PWNWPQvA97f2NoJzq7RBeJaW0
-isiEcM6LZJbGYcELg5PWdeQ5YZjdlkECa5JSkx2QUxr1z4FplrvJeD/25T3iwWSc878L2xCLcInnawPv
-kixTjHBFaZQsMd/BGezqlhVfqkjwS3HSq66ruq5MVmlcxeOw5iuqAomiECmNpaVnEx9WlC3kf+MbeqKs
-1kvwwr04maW068YlZHcEwQJGgP3ud/0kRN5Os1X2+L48HOrtYj+t1tlBZXMZGuscVxjrM07yNAjCsEzy
-NM6iouvCgh9PlCZ5CietfHXoGsczpE9xABt/EhcpKXDnec9qntmPU4YzeQ4AsvJTa+N7MenOBSCJZtNN
-vVFWP3iWfy73u2a7L78vs6JsQ6Jgz68+IP2RHI8FNevpEjjsISBc/o+iraD0WJveKOlctGV2CxnTySyt
-t6OcVvBasPpY4q0cXRY18jTJb7svW0jCKidkRCbyREqPFS9VjQvIhC/pCfK/F/IJJqikGuOnB4F9lYqy
-PKlS27D/FIOoZaxmKznQb91GVfMKWuA2uUmRmQNWTedV6SpZ9Pf4vU9ZpPOK3+ru0DudAN0pjWVo++/q
-cl3skwrpJAbKUy4oMGwAI6N8xe9gZQuCUHC3AKwL/QmQrVsx5/HysThKKtklRqciwK1fB0ENcTqyWxZ8
-ldTQDVXadauEPIefbLygDkEUuBVL1yJcJjepgruzVSxlT5pa4IjS4wJg1uIFkEtF8j/IrgFwSHkNuzU9
-KWulzsBaIGG2/BUEWUIOy7Z52JOUCr4ItRYoVXo8Vnr62qyQ+0N2KCNPE2fwJ1rE64j82IywC/eAptE2
-GzkcJ2R0aGQrnPT+sa5nfwdWDmGy6SNxcvAms/xQ35fRjK2z/eFtU9RVXRaQVXrIILvUFTPR8a5dR2rd
-YeALIX97/YGwev+mybN19J1WQQQimOTNmjLkFgLCybaR7wF8K1Ku7J+2uWKJlpMamY+z3W5doy11/Xj1
-8PBwVTXt5uquXaN+WsxH+VI2zIH/8uG7q38nTNpwu4PKzvuHQPYRNIN2UhklyFaAJfInYY/y2HvSZs1G
-xnJiqz2g9ToXyBJ1xSq7zxSLzEm/+z46yjqvP4rHzfqjuMZHXn8U8u811nf9Uci/H8X1iflzCG8muvC3
-t2+I+gpd9KF8POjX0mV/f//uR3wDNZtlC8ArkgjNQTQGR/DNwDMKh7IWEsm70XxUxfLDI2uqnpizqGDP
-6656PETj2cmMjbsLYYEifidCWLr81YoyQaN3IvRLgThGFljWpLci/EFQKPzQZtv9rmkPsvDvqrCXeTzk
-gz/jPcxhfexJV3bL1mp7w7zU3S48Qgr1jjdT9dld17BP9hC2Giw40k4ZQjRuwx2N9P7K3kuMZwfeTl9l
-67XI8tt9SJptXo425aZpnwhld7yZykl7t38FbP3HE7uXov9B/vfICZLTlgVhT/zYllnx9B6mOPDG+2vl
-ACiVXCpwUVvS45IfTyZw4rXArNQFpctEnPNjcJG8SE+CL5Osd+bkWQMCrQFxku/zcr32X2k/YFTdqshV
-2Gn8GXGGzj7AGVT4nFtIl3o4e5uBoq7L2H2SwRqF8TTNfdm2dVG+VapGdHH77RbgOLRKwjNdg+2i4VYG
-VIhb+qSzlLPkSXWrm8Am0Ma9S0TKkzvIX0lEmvp0LpmQg34gSLHrHh0q13IKF4aCspfhTIeinMBk3xuo
-gSfKmuldu+ZhKLoOfnadkud0Qgg1xtX3gjlCfEKur4m8F/YF8ummPCyboutyRSjXmBK8hDVWUeGhPQAb
-gF62LwhJVWxGM83bZr//ttlk9ZYeV8OmkFxCV2gNwccwdYB/mFcJf9P7nok0pJr9YYxp+c6JFZSrVfkP
-evTrkaKvrtRXwRaSXdZsoJUxF/R16gau3RB4KNu0zQqAh8/WlLIfpcxjDcvZE2W3Wn18mq/1Dpx8Ii6p
-bB0EM0CuwVV8MgG93XPsE5Bmh6w92P7DPz6gNWsgZkqRCo3/phZyvJQy1b5meLyCyA3vpvhCgwCmbzN1
-1niIZ7L7rH+64AMWj2o9/GPe4xvByIRI23Gr3hDVYp2USJlp/mrCw3th0MFJQCISEzpR/aAizfEIUI6z
-fFlqmtyKV5bPUDDy7IZQth2ukPzOyeROTCajyVbPtwp/1pVWsQCWwdW5QL1+mvaFYEh+qK70NVfv621e
-EnZ2J+zZHLLF5yr5sdmWV2/lkCb2akqZM5BtZ8ojp8fULl7ultHhJ3kGGvNqoWzohpegvRFXZICd1EyV
-Xpf4Z9L44pmJshT84piwEZn8Q0zIfPSJz6azGxIRQiNbDUAqgM28kSK5mS5xuaID77th5nSyQV7DZopM
-Re/LbYFw8+YQwyB27Ik1lGMj3prokScls6GSR07giDBkrG0s+gV7QoSMZqoUeVkCiBgNOuUpK/mPUi1S
-cgPW+Kep1Q/4jZQVn3pSASiXkifWpJ6kaaagmMseOGAS39cz2JO9BNWnv4Ooywk9MXMvRSl9y8c3rJzu
-pdFxz15SK1xhpQQDafTH/GV4dcP+oCeMyoQjae0Y7Y84sekv0U9jHDegwN2zB/bI8/lt14W3fAwbwL2M
-xFo2l9oeXvCllEbMayzx9Sz+MpqxFRdf8xezWRCIr76Yzbrui9mXnHPBZC/f859E2LAngEm55/+UB/fs
-CaBO4rA31R/405Az4022P5jJTSh7GJIK/IGyC/fLSWxuUzOaP1DKXuCLdh35/vXLb6VBjYI8fuRk22gK
-gkh9D5YeNvpFovCR34O+UrINv0f5uOX3ONjYio+3KHEf2VgEwWPXydGrzFQB4emCzyiF+Q5aDxfmJ9AD
-hXnXPUqFg63ivYels2PJhj2yp5RGexdMZycH6iPbprZSqX6Fd1JnVp3pDfFVjINcmb4RHL3Gd5Rjnq3i
-TSSrOwDUn/OQlMqawt5seaVmo5kxV1d63YXNsaFVt4GIO60hP4FSLM2uS1lqrU71YTkjYKVRuOc9GJvD
-+rC+Rwf4WIJTLwxuUR4II7tmfzhHge/viXsZ7j1vL8TAQpBWAWDn2rBCs8mC7oC9mDEVEKbFcVSiCyJn
-SpJFgBXS3zQKAgjOlLVqkuGhEDn1TPdR6I4wj9NtwWAxd3wON8YrccO0dwYiOXr7SQ9ttnu5Xl8G+FXB
-FTADe2AzYcadMORklmI8sIJXPiOqptPyUzijDrecvsxPNvLoJ3XNTAxvTAHmFRp5GfJxKv0ZaTnlG56V
-WjZjQ0ao6P7QIpFt8sN2O8xq/BdyBpz0EFOVnxxCP58kYPIAci6Myzm06f1xPlX9FmY0Eg6jIsW3H4wf
-9t57fjkgJ/dfXz5F+PHmNMJH3W3PHuaxAUK3hhmdbptDSERTPJFzjlubSWNIDvUenqYupyeLMaYSVnf7
-8q5o9hp58fwVxr0LgY5KjhKYAUOnhioZh9kUSXIA37/r9CHSv3yGFQIe421hPC5bF0ZAKg0OyHw2/e3t
-m+8Ph53SxpT+IOjxhN6cnwU/zgAY4ebFiy+iF7MvT+y94OcPCelc6lbtno/H70UQkIf6sHzVlkW5PdTZ
-ek/q7ei9YA3cyN8LuEy9rNFDwrM4DlagKihr7jpZ8Vh4Nqx2yXpUvW6ULRfq9eoqXAJBY4iBeEyAbStQ
-L5NH+xKCRSERcb9/aNpCSoDHZYvuRLsX4BYuk0XKnYJkkc6FcW4EwXLad4wMlYX2FvlM5wu7rkzIb1eq
-g8riCgg5064LB8s58XtUgXvCa5d0ea55LxhQbc7zobXAGTk5eP0Kvpw223WT4Q/QTuAX6KrwC1Q+UHMw
-1gzCDJlSw4GKeqk19EipN1B6Bvu1VBpJXIUzpq6kkexFLGdLR/mRJ34WiS5Ku27wMnTKAtvI0uy4KOsZ
-ztCBmCHnyvLxEB9Fvc3ap8gWn6IjuG/9C09sOR3014UUkiRMS+YhZW575qH+Wpv6bNo4tq1dRIPt7XSa
-VESXjhIO/EyD1kYeBAUAM7NcPh97C42MJdoXwjNkcZcVwZ202VErYhs0O+rTmZsNHpOHOhvFc0uHPrC8
-MwMAB0EvSFPUPThu+3puZbNhgZeonQm7rcBG3s7DhfIy3wyWP17ZM94GhXra9UcRxpGstZMXUiyGXQlv
-KwE2BVQ1w0s96lCv7zO50LJssLGMCuatuYbrGd0s2HDgcBnfUHbeqAixIpU725hWGA8+A7YwPSeiyumZ
-X5bEUrUgX2FtXxOKUT1Htd0UZapLX+Ex27d5lEnRfKLTZhsSOUVGygzyhZTQgYM61J5lQVCFjlBB8+zL
-2ZewhOGh/NQCnA0e6I2QCt/nRqwKgvkgeJKyXwS/Djn9GIcxD7pntPsYY0iiMx6lqbGLSK52JXCfaac3
-Kc45Tj4IjO8GwwczIibkd3R6eYpxBkEX2eC4kM+AragdYV7mSY+yS0zhIg2S9ovyuMGaSGNy165JdJZl
-JpRPC5yP4n/qfLTPBOdgEBD5F7OQl11H8CuA/NeL6NDuef0NukV7UXr+SRr3CkKpw3olbBmLZJly+Z9x
-S/6CbslJaS7XTQZN5Xg/ddNph6W6HGMAYVG38QFqauGeYcrP928WNjS0nJDRQ7YfbZvDSI4l8Gkskll6
-Yn7DcDRuAf66TJn8z4P75yYY+8SKASRpIz6qGMKoLbBIWNIIq4NQSvh4v+nzXlPKsXy3X4YlBUY6r2cq
-KmfpAgysBa8sIYUWOBCujfsS338ArBB4bT6AdlVvdmhjwVgbuCmU41IaARZvx6G4/koOy6+/usY/7gFh
-L1CQGntAqdgnyIuA7Vyoo+90cJKsLQakGrNJOh9MI1fbqeMbardQXZrWodaIQ/FXvx/Irnr7PCLbl/IM
-7uwUJtAat3jEuXwsKY0ELyir+CvN/c8WfJwHQZKyKk76j6iSm5QCz+InhKYXbAGDYWEo7NpwQa0Mb6eb
-sl2UoazOtcO09wB0pYtINGyBrBtLnrkQAhZyHBPWCr4ToebYXVLKpLGORzMmj/uhvv5WdyQGUHOB0IST
-n969/yCnpkl1kOP+zJciRWXPnYLBEyqKhPbA+zN6rOzMZYupvDosYrmkFvW9XE+VJe6MyjCjFFJ3wwLN
-Zj3d8yDw3VSLM9PYw1esus4JTQINWrAsBQYP5bgwzjC7J8asp4713Hyuw9BzJTLrPU//NMPEN/lhhcvo
-gKGugBKLYT/Xoi13oUHx9DwpSlbA4oPA+nr6s1YZ45Ck8Q5+Rp8ZlToUwuEAVrzVhLI1l5KWbfjxNCdS
-h69zTdqhCTr01Zxoon9C2ZKv1VuEiLWrqgbfKKttwRrIC9mKh5YkW/F3VPUj4MTxWwr7eLW7ft8dGkjx
-ZKs4LPjavEUIDJDA719KqVFWBxqFi3Om3tItqmXR2dQCh7bQYOa5hwO8pFSDMYsp4KSGG/kXj66W8v/J
-wl4iXwSukT/U8dUS/sjFl9ztpUCWpnssDf16u9DP3dBoDa21QVIqx03Z9DuXfg5iwWrf4J6LLvjoVL9N
-zeDRuLBC5xsbnBg3x8yGPF7y/ciOqi6xSwKirM9Sn0u9Sh0omQ0LhZOzyY6HZhdBd0/K6S5blP8b3/kq
-n+ZQ+4dmx2QjR4Vqa7jqt95Vb4DaMoLaZnj97HRihsDdZ6zQ3leNWMpMsh0ruF+HDiY2YxkHfu5Oslgg
-n/9Qs0ShSgvATvkJfYeytZzSkLJvwkw+HiU1hRDfzJyl+rWwodTcg+uRp/NDszO83dRrrvNrZVPZi08U
-uwBHPdR/ZT4RqbY+SCFr6lUjH6vvXwpMppiOeGLuF/+XsjLO2kt7wiFKQ4uwAWkHfnHvRi1mu67NTj5I
-r2Gdi4gzoggzLHVYrsbjhZQ87xIpy+dn6LrFZ6hFLTf93PHDq4zajMYVz6L/6LM9VTxzp5DLVhxXcZWI
-NMqSIoXY9rCKK8O7F+Zx5c6eqGR5XEaVO+8o3MtLIBctzvAA+7jMcvmFFeDzFMVAlburH8v1T6qzmJ/M
-6ea4Km50QZkmhc8p2gt2jUhECjS2UX6iTqcq9nLDnwzjXPP5n+Mg402G9RbUdjLJtOslEoyQiDR3Byg+
-9aB1oacLp6etU5j3201q4A7YtNHbS7nS5oCHigwAFQYva5a7SBPQGy2zP4gA8+HyIBI0hih9u/Li59BY
-JOaD08gK6r7ETghKWLjsP0Bd0WMxDqsBCW/ofgRYRWCJNuu1vJ9V3pG+QNP14wX+kX041dkaMNBx9suP
-X1JDRon4F0t6kpZAXEY6WACgjHsJv6J2vUj+fq7W+TKVDc5y2BU6u6V3Q1WZO+iJaSiEP+FPQNVS8SDc
-bS/cZe4BlNXe4Iqdx5Pnz4nag5MFgmVdJ8tYrqbuslkXP5dZ8eRprBlArGbF069ZfZhMInUELAigVUF+
-GPeyxbSR+vf3737kTlRya5LY+DeM6KdYYwYBhIMA/06zTaF/hwQDcQlL0gFm61apL/8SPJuu/imvZL/K
-38/swN82r5ptta7zAx9St6fP5MoByu8z/qtAmgRVlzmjDv8lKGtPTABgjSqTt7WymM7/7f8EAAD//yn/
-ExSDUgEA
+H4sIAAAAAAAC/8z9eZfbNpY4DP8/n6LE9jCEBakkJ92/CWWYx7HjjruzdewsPSwlByRBiSqJVJFULSmq
+P/t77sVCkKLs9Mz7e57n+LhEgthxcXFx18uno4vNPw6ifLi4/XT6bDq/aC68mFz87d3Fm+KQJ7zOivyC
+58lFUa9FeREXeV1m0aEuyuqiudjcQNFpUa4ut1ks8kpcPL38j1F6yGMo6HEakUfnUImLqi6zuHYWThFt
+RFw7jNUPe1GkF7siOWyF6575MBX3+6Ksq6D7yvg0KeLDTuR1EHmcjmbEb1slj1nqjdospF6Xxd1FLu4u
+vizLovQcNeZS3ByyUlQX/OIuy5Pi7uIuq9cX/EKXdMiiFPWhzC8ij5Ojj38955AnIs1ykTgj3V1ZPpA/
+fr3OKvqBabjl5UXMwiVNrJFQwb7DSZiuRP19WdQF1P1dSlMWTyuYYLpi8TQu8pjXdM3i6f5QrWnG4mmW
+J+L+u5Ru2OORXrPNtC7e1WWWr+iWbaZrXn13l39fFntR1g90x7bt95ztpjHfbj3ZNKEFezwudNcv9rLz
+EYuaJlHdjqZxKXgtvtwK6LbnVHGZ7WGu4mkt7mvGaTRdC55M+X4v8uTVOtsmXkyme16KvP62SMS0FLvi
+VugvR6j4hjkIgg4tWXfq1BLA+pXTNJ9meVbjlyOt2OWv4VV1dXjz5Zs3V/cvZ8tx03t/crmiNbv8dbKr
+Jpf0wC4nXsgnvy/J5YreDrcUTevix/1elK94JTxyXECzrJzu9aKwRwn6/g2Ni7yqy0NcF6Vf0q3IV/Xa
+n9G6eFmW/KGFSlN5KucbQIQc6UrUHcjVYz1st4zxwM7s8+ezAJ5CPoafqWxs6cu05ZECPLyreXzdqRIm
+N2LldCfKlcCqplanPUJ5C+XTfSluJSgwBOLoSAWP10N9LKfwBSuknBzpju+HsmGDpmdeOd3xvdfdIBGN
+TXYuRxzRGBaYwBoD6A9MZK/iFMBt+6D6U65wT1VQQZqVVX2uAnHjzciRbvkHs0zm5EjFzcC8WitBYzbm
+Yw+WKfJnZlJ7/YxfsJnrxs+jIMSFi5dLP1xC9XlydpRmVZrmdAHlwvtrWhVl7cdT+KHVHqctnsqHIy2n
+4r4WecJwD6lnqz0YDqcw7wkVNKUrZiYxnC2b5vFI12xOszZZD3vDRvNFCng1Koqt4HmLxVeu623YqlPZ
+WlU2HhN6gvZXTVNOs+qN7teKNI23Yo9HQteMscx1vZWEzPVkQhbr59kCKspSD7bMiHm80xIh0K/oIssv
+OInZKowQ58LPasRYAt1zXfiBVr/f8iyX8+wl0LBguIunWYW/XkIICTwReIKN5oCXXbebISZBDKvp47d+
+nfj18UihG0yvh7ehKU0I8W+LLLmYqV5hloQYIFq1C+g9ivs9z5PCV+eYM/Zuxt/wej0tIXnnETItxX7L
+Y+FdXr2+XFHHITSrfhA8efBHMyrgFOzAcv+EhP2cF8XeBsgjbddlYKM7OslhjJVTWE+sJqt+lofiGTQ3
+Ytx1OWOMT+XpCUW+PexEmcWDeEzVrGbGc/LDLhIltBo1jVPhuYZvxHVHWfUt/9bjkz0vK/FmW/Da4wR7
+ZS3MaTM0VtWPvBFvGieUcHoh8y+dEWPXElFxQlzXG3kRg04B0MRsq3GYY+1Th7huZG9cas+YAv/YddV5
+HBPGWC67+uVuXz+c6+rCgnDV57nu/OxIoeIPnTB87Dj+yTaEMZ/2jgeb0Ax72TS6mK+/H+lqW0R8++Ut
+33Ya3SMoxHwntnCmDnWIG5itqbOrJk4LxAd6S07OIRrJCYhpwmaLLPXuYP4fYTZixhVuWiTP40UiEUSk
+eh4mS5pQ+IEpHs1JVAp+fRTbSlxA6UTO5UdL6K3Jj7Qus92HJtlxfA8muh1SBXsSzsxr0SMU2oEBPIfL
+RX+veHeKWIPxBvpcj2kL+ma5Qr70OfHXCqQoJ4TGR5rlp21aR7DsdRRM5n6mIZnTGLoLTfW6ClMmuzuO
+9ImQsBkVp4vAQzEeL1kUJmZUOg8TFKCnFPuTXukGEiqAZE7ZDI4T3dSajeJF+ny1SMdjkrBR5PEwXdKU
+0GTE2Np1BZ69mGqwqehTK7Il1Qo2sWbh0kAVgkU7nvR5gs0JplujMaFygYTrrmWLgiwMTKUSpj5aQGN7
+RcuES7oGIvGQJf6c7svi/mF4B1ABfT2BgMh1vVgedxHjlLOY0M7xyonCGRcJU7SmOT7pM0IFO6VIuOpc
+JGkRmqgridevgAD2ElPoPeP2D5zx8DseUwHnzJ3/mtdimhd3tDrs4YbnF0cyhB/fPeyiYovHdZqH8m2a
+1aLkdVEuWXySBONFMtX5QtImF9/icXEh7z8XeiYucEdcQD8ufhCrL+/3CtfLA1E17CA1VXvOhUN697tN
+aA4JZxyNnaWzZHCP+Lq4M/cI0l6s7toTbTTirutI0HIATFyXtxRl/7xrJ2XEgMYYlVN9wnocjiKHw0jg
+AIybZqZORXNGtoARvZi5bjSZS8jEK9g9GzgINTlI1zSjG3pNt3RHc1rQPb2hJa1oTQ/MqbLff98KZzx/
+CkQETCO9te+2d2xG79mMPrA19wj9Xf68lD9fDF/DgCgACN6y0YzQ2ZG+Yo/H/m32NeCEL9nr6b7Y0zfs
+tbwU/1U/fMVeq7vzW3YOb82otbfj58kilgcGD+Ml0hGqP5ommMyP9G/MidcivhZJU4mtiGuRNLx6yOOG
+H+oiLeJDhU/7LX9okG1SbKsmEakomySreLQVSbPOkkTkTVbt+L7ZFsW+2R22dbbfiqbYi7wpBU+KfPvQ
+KEZF0lRxsReJQ//OnPDq6v7Z7Oqqvroqr67yq6t06dCvmeMF/tXV1dW0Ca+u7ibLJvz1aja5urrnsyUZ
+O/Qb5lxdhc7472PnqeeMvx47xAt89R4+/fVJM/rXMmBEpQT+J15b46/w+8mSPCWfNFdO/8OVA1+unEbV
+SxpVy9XV0qHfMsdvG7y68jzv36+aNP0vHgmvrpbLxhl/M3bIU9JMn5KrK2iafscAFuVu9v4+dsYOdVYO
+od/b6c6v2McxVvyrqnRJdCvkqRzD+Ikq/I+Bwk+p/HEI/WHosxe+GP8Luvj3sUNM1nedrExn/fXqavnJ
+lbN8Gtizh22/t0t8S+iP/ca+HjtPHEJ/Yo9vX/udb39SU+8Q+urrl+/edb9eXU3b7+9f/rX7VX5qwqdL
++Pzy/fsf/F673xD6/bsvf3z9Xf/Dt4S++urt173O+B5CNd7LG7h5N3m9hv8TeCETLwbGUFOkE0BXClzU
+/IhbkTdFkjSeF44ny4Z4V1fJU5I3LcSqD+r96ioZk4aYyUTQcDKHULit9kYKO+FvY4c8UVlyIZLqVZED
+X8sfWFm5sH7bK3HTrOpmK0fUDrA7Bi/wJ1dXCQmw61bHvICFv06WzRPVxSP9mV1Cr7J8f6gVpmmgM7wU
+vIkOdV3k5MllRn9hl7+urxJ4/CfwxH59XI6vHq+qp1dhzuvsVlxc3V3S/5a1/ckLATWMSeNd3Y1JczXV
+CeTJJX3CLsPxv5aX9LcOeOFmC6+uEj5Jl49z+pcjdjxo5KhIM8VOA5xyzgZJK+bM7p1xNPnLn//86V80
+rQNkWtI0cRD5yfNZIM/laVoWu1drXr4qEuElYyxB/MGPL17MZ82f//zs87/Q+ezZp27S/Pkvnz4Dpk7E
+2aUXAvq7n6dX9/8nXTa/ToKrhDS/Tp4oxKi+TK4Ob968eQOzcLmiMT/DGAycqxkcrTxwrg5pmiaOz+X5
+4s3oZE7GztWVM+bTWPXuZe3pk2UyJ4bt6s3/QsbOhePL7EeacJvQ2nl43WE19wYvSvoAgcvIDI78tCh3
+SDo0jbPlkdg68lCnj0lW+k7LeHVoDpDsbMVK5IlzJIu6fHj8q6LoXrOvJAl3O8UdCCUqQrtvr0P7XTMh
+p3mRiPcPe3GMeR2vvZSTx7+yR6zXf61yBd1JfaOa5VQ1GxFyHLwIcetisbhbZ1sBh7O6S4zHS7LQOVg8
+mR+PR0NnrThCYEKFrCula0XEFEC8AH0RTYu7XJSvW1IlCiIzHv9zoKwTANFwae5YI+uiDAyC+YixO9f9
+XP7M8dVQ1lDBSLiu50VBr7GmifxbMmIsd92dFxGKnPac7gkKMeaqXm/L/nsq7kUMBDuQJinbhvMl5vmc
+QVvwNPI2LALhgeLMf/HwNvFSQjod2UwzgJvUJMq7x4bQRF6Bs9SrkGtXDVTlurUX0Q1x3Y/VAx3ahs+W
++ruGsYTaXay+eHjPV9/yHTJPKPYQB/fpkrhu3M35asurCvLCmg1/+WhrJieMhibHLPXi6U3FXXf0MuSw
+J5fAzrlpmtHNtBYVXrLlWuCaViyiJeMLPVWaBTJiTMIM1N0l+8mjdy3X5WUtpWfCc7LEISS4ZteGIxBx
+GnPiR9Oqn5FeswMIZlYeBy5ooUlVuRGAD1qE6yVz/uSMr2EE44p7kEIWJSummyLLPYc6hFbsiR6S695w
+L7IkMqRpIpiMkgBCOJnFaoryjnd4ABXly+3WK3H+5G6/J4/HNMv5dvvweM0YO8AKSRlPb8zH41FVnnkt
+x+d76jyZO0Rt1Hb3wu1AMqbhNm6SIy+G/WzwIUJeDEMnL5JpzOO1+BqnyHUTsRW1uIhCPq3WWVp7ZEmj
+EPMumdB9idomM26j2vCwZKMZ5e33DW9vbnlfEpZmYptUAmRh7TSORtyLiJopw2UZzc2M2evguvZbR06G
+2AF4BhZ6u+ZdNKmup40DV/e4CyhiMiHJlNd1+RXPk60I41Asl8wa+7ZTW+S6nCZwx5wjd1ZjRPkeWe98
+WhWHMhZvQQw5iew3RJ4dBBQT2Z2YxVM4jN5l0TbLV4DVYuu2NZkbFlEw9yfztpc7e4VaGZLp9pltqO/P
+SErh9RjmGqV5jHFrTvP/Vf2e1UDTOJJEwzdypr3iXHv6wq8O9yiwQQMqs+mAedCe/JH9bJXpVGCX5n7U
+f82q11ZC09gpI8aAZyEArodKW633ummPe2+PO+Pe6dAvIjaOqP0ppolcB2DSceCPaSCnEaErlnZBfjWZ
+kDgULA1XS8DrAPFs5CXwA8+EHOGf6dJNZ/O77pCknw8eX67LjzFb8aliYIEgPoX3rPrlm69P+SrIyOV9
+YoATwzFRLWhO/ihyXeer99983T1pjnSHjYpaV3LaFAW9AR6cNubfGn6jpECAlFhZ+3rV703g5WxFC5b3
+P9A9G6VeTuitrMkTkEek/LCtf8rEHQE+bF3sRwz4nZ6Y8iT58lbk9ddZVYtclMFpEuhZbAueODThdDQn
+vgDkxeM15nLdzqvnFHmbHdjcU65PnoptzhDSsaYGmJM5dMR7h7T57JAj1Di08GfrtpUf9EHxqtjJg8Ih
+RDV3UqEHzAEFxqetGvqF/VOe5fmZ76okEG5nulh0usgJEHQHOupVCHU1zVCqd+h3ExoLvGSaZttalNO3
+r4fg3hz6v1Heah4MTuEpyQQHxPFIoYk86TYABxcQZANbtk8Uu+6+xebdTy2j9SIOwhhE88cj8f/3g5LN
+nUUoZpwSt5+myfGbvgFxfMu3B/H/7xmR7N7BeYF7B9YYs/RM/2inY1ogGabLhWDRAAhxYl3pUibkbe5/
+1oQi5nDB9Hy8f/lXNrxvg6EL/semyip+9hbj430iiE4pZq5l/MczYsxwSQWb9Wa/UzlKeZ4iB4I8ajoq
+RbEaAaostrC3upHFBmgSTe6mBl6QF8jO37P+bWDqFt8To1g0nAXFwiUM/Ab+yLtYi9r6U4iy9h42O0Vj
+eS5KOCqZ85xfZAn7xBkfxs4nL55f8hfPJQ+tTZ4A9/yTi13Ft9viLub7+lAK9sknL54Xe6QJNHsf0y5l
+4ovnlzL5hUP56UI7Ybe6X9knnywNUnfdG7kyDrDbl6zltAPn+wp5sIOV6p60VTWNrqrl6Qc+7o1GMjLP
+1ZUl/2Jy/EO1/YudKecrocdAmfbTYEn+J2xu/HSg6PRP0zGwHPGY7S0v763nuhQp++STC0NUfqKfugs8
++F2u3qW1fIszNzlJxJNF/zoOIO9QR0pucKg29EWklz0H4oE6r88tA3xnyRBsYEnJYzZCGYfQZyPGhmry
+RY6DHKjJfKKOr+fCIfRk35gZG83ON9NW8EfbGarmKfXvHUJ1STp96sPaE0AAO7gni0rn18igYoX+1DTF
+9E5E11n9TTcvfNgVvw+kFkM5q14iYJce9MUwK3GR57jxMD+rlJibohSnfQurEexzHFmpRjZiDv0WoPqG
+3ZgJs7jqN4o90wBBWLJyKE9p54n0fBTTuNjBbU4T9N8XVQbdJrQGHqKVLa95llckGOKsft652ge8T9j7
+wAKIulyJhSWfTZpm5I0SyQFNTEWQGpumg/bRS4jPz3Xdded/cc9+Re27/tEJWjqKoRAxu5Oov2MRCKPZ
+wjBe6BcsCk7q4basF2TOdLaQYovR2T5NRtG5T+bUDRLfS9jQZY8x5p0yhElwfgoi4s/p3IVZl3qerwVc
+gUQidcuGC2FDSQDjy5um1w/G2C1wdW8pJ6DxE8lc0blcEQnm/nXw1rumnEzgJyL+zP/MTaD0fGiBzk1s
+bPSE2mVD4sd6XbGQL0EZJ1pKNnrTjAzPGUdkOh3MfQEv6VAHobCw+dWK17WIGV+0DCkLflbTQy5ZhjHk
+ioZzre1cMscqTEBtYA3qYsl43MLBluM3il98le0WurzWz3N/dqQ58fMjXXGN74ZlUSjZAHag/BMRu4jB
+nieLMQSHWv7AUf5g7jLvqMM+eTIH0oWe4GXX3SPPPDI887JpRqXEOhEhPS56RAhyluWGMkgzQooWxH+n
+iLZpWmykZCptgsE0hreoGKyCPB7bGYpoLqcn5Et9Yr2Y4UxpjDQ4ux+ZJW1+sOLIZehV8eHCCPqCdTix
+PUUhUHITrvtKzpKdk/ZykkBAm3S013cLDW5GmzgNUt9mhjTNaB/07tYR8b2UDVw6cSHTabUXcZZmIglS
+efPykQ8N4xdVzPeCnd7fewqPUrwhi4BGFfuQ+rHz7iGv+f0F5qQXh7wUcbHKs99FciHu96WoKlBCvnDG
+qspDnt0cxDtgvJ3yv6yLFeKBLRvF00TUIq5fH0A7nteiotdModR3NZAurmsEu0DDwAfvC0K3+toVMR6m
+cO3CQyZMl8j0UneulBCL6c6VDj7yHemcaPi8Rm4+6D0KYOKtRP1e3A8NIGaOg7gytc7q9joOF7+0aT6X
+P3N8xQ+nGqFooIP6DHltsKidiNrEnPEp6i4gbbjgC0iwefXxGLWdjcDwU9n0ZzaClT39CaBF5mvnDS/5
+WEfUcjSONJGsTIlfKvZoCXL8P8+opNC/r8QhKfyMU0RI/k+03R2gWw/XWvgtxRb1HvxH54Xjn8rBcXw+
+aEg7FwPfj9QZm+RS3GbFoVKj75T917lMxyPdl+INMo38R1SaGeJxhfMlgz9dBhLl4adL5sHfpuHhZ/j3
+z6Bx7ZBeTrirIQQ+AwjEciAChAcUiNFWP+EzclTaOB/sSQfBUCev17KB+dLU9CkJVOf0dvZ4OFtCvz9b
+srEHPwH0GB7/smyaOfGfPfUccStyWRmUdYok0W8Eyv5Zlv0/yzEP/+skgw8/rttv8ahVj4b2zQiad12Y
+HQ1oP01xDpQkFOoIYBv6OKAAcrLujPux676X2UGG40Vs5cVgcihfjPWd5xDHiCMmEZnoZ4ILM4N6Z+0c
+RjDiZ0sWWyn2an1KyBHAWQIQKGV9lO84LPCS7KLgRJF31OFDkcfOvlUyjTOyLsV0lJpkp916UJJ0Y1bW
+NF7UUSbyfjXacHzsSA0i0HqCOQWFkIF+RXIJBnBa3DKcrJem+Tiz1XUHWf4OwZ12JEfa27gdPXmTrGVS
+TNEDXmLZ1EltehHAXRDmzR/BdIgx4HRHJgVAmca+zhGIEb7+ql5j1wU9XmEALSa+87T9aH94MZn7zhP7
+m4SnFhhlU/9SWUCVeSwMGH0H2BBVk0i/0kaWwGMOZZvCgKquezzH2sfOxEHg7SMbbV0mpytliFuQrGuB
+nq6Ys+VVbadPPgO9B0fpA2I39OzCYZeo+QlOoWY0si8XFrxDTzLZj45OM0tHjK0CxzrtnIET4KZ7SynB
+0OCcYJhWbJS57mhNazCUy1LvBk/nVJMSe/K4M/eLHduFe2SBr4Pd+e1X+jDyXZ8MHs0XBdszB/Qrcdu7
+7qhw3c5wjmb7Z6lXsHAV3FinvX8z3XL1vKQr162gdzd0y3bhYdk0HvygPd4124Y7RXm9fQ2fOu+YZ8Ou
+Q75E1amcbQAHoj7TJpwvaQ0U8SZ8tqQ7eLqxtMrCfGmmYzwGwtl1YVqaxqtZzmYE+Df7Yu+hMlR3Jlx3
+PK5dd4eXzkdonoV3NKf1ciENfTpqTjsW/d8ZGrBh0Fboj4/j31xxNVAcxv9sCHpy6iWhcr66lk31hAkc
+RtI09X+C/H7muvVl8oLNjseBc7eVZSAljJRahZOUAF9UEkNVyHu3HYuKcA65EqCL5EJWIKl83SURHpYB
+XliEvs7NAy9mIaecOg4F+06rrZ61gMf71ydbuYC3NkApw3vVGZWChL2FMypcIcWTgEpBDD+YciRDpypU
+NwMbKuILIA3l3PiPeVH7WZfnKM9QUG1g6CFgfaoo1Yp0YDq6YwDMZpQdVyzRXAJBwyWgUX6qPualbAX6
+YkinrGE4EfykpDsYEAy2xzESNDTxIlk9WjRhIr6OYgnXIItb86o/xrPaLkph07qrg3BZXdXP1ML7dBA9
+rdeL7FsOqrPkuSjhwtU0eM815x2H8w6a3fJ8dabJHxX5iHTCOfjF8gi9lH+YVKMnakaLpLhAMeg+iKZY
+UV+D8H639eEDtN//JtON3QqLe83FUqdnhhJDM248txVY9LmpfaUv0nJTj4TWvOw4MrAVd4uYS95u+wy7
+ct0RZctzHsmHaJolR1oWxaBjBGD5FUeKBjbnvudTHsPVT7GwgROFTb6BQk3TPntAbo5GgBWQZ82nIF1q
+mn/xac0j1F9Du3wUZfgF90ZzQrVoA99nhCqx1yB1/gc10SLoBZ+qmuDes9eG1OqTFv0dqX4aJt1t/TD7
+zVSAg6JthahCfqQCLIw7Vf4hPgAwdw1IPP/LkP2x7MNAb0fmhJhi6ygKXgueiHJobL+oHWfmFCyjYQKH
+Mv88kFlq4v0vl8nS59NAYyVFR4qGIidtnFR1rk3XdaCGtn7X9eQFAhgnvV0O38HKqjFl+txB7ftibyEx
+PUnhbIk4rvfZYoKG0WQOecRNP0d7CQrj57MgHkd+jDlvRX5am2Vpt4ifR4t4zJ4R3tdO4EdCiyT5UPH5
+R4pv66GO6vIJM31dTCZAxCx0NUmnmtUfrmY8Tp5Hw7WgTo4G8LxetwTRVNwYm/3HkidZAT4ZcPNHxT08
+p9lWwO+eV9VdUSbwnO34ChKPpKWsoiXbgQ5mW111iHYZsKZoKSpRn+bPZX41tosSFKyPJbdc22hNp6rt
+cYekwnt8yekKbrt1cS2ALcsGiUDbbJT9rpkCWepdG72QYOZfGz7rYs04zYDq2UDjmn2mKRXyCPaunmD/
+kLYQa4I+OlzXW7O1qkQAi0XddJtmTWimOLIsBB5TDH47PMF+MDWghbTQyuE0ldkfJZc7lu4TsFKLBLtA
+RYW2UXO3liuxApNaPY0EOvxTuFpafd6Eq6UcCTx5QrmN+EgvVlSJYHxxrnWQkMXKn4GZ4bX67q+DlndG
+/N89TjNi5t5y+lRxfQBIBDmjHQscx1lEz+NFBPb2YzQulzKBVs3I1FTzrv0X6AKXFFTB4BQBKrtpEvBq
+5bo28xXYyHTN7lvhWSTPoaBzfxctLx5cCuirYGRJoVeGG61KtGTLh3gBcClaI6Rmp20MNOK6XNVipM1d
+nvf5/qE8IpKXt8i+vEW9y1vUu7wJ1xXsA1YnstGmiRbaHMeDK2sKZH73ugrCSj1PO2BJwqUct2mYLtmO
+YtrpAFsC0Kz3oaM/3V7QOlNtuGX81D4BAJiHYqkyDhAUPjBR2xZv+Sl+niGEGd8TAn1PyHsFiF9jS+bQ
+2uNzmz2l64ILFPhgYDOatVtgw6Tnhsi4HwLhmbpAAYqKvRTrAddFWiBEN6hEAS9rYvv1MV24t7pgXbQS
+1x3BNc91vYTdc9CCgLUfCZkmIA3yk46ivMK8PagGVkW4BFMiPZY9yG1uOThzcJ46dN2qgoTrpb/GO+MN
+AxO3Ueq6UbD377i3pzvKsXpasjgQTeOlAfeLpklIEC79lX+Dlh6uG3vgIwBzJuRxw+64V9KcUPB6FC7l
+B/BX1wGEa7iSbtkmvMYZLcM8vF7CrfRGPW0JORrpF9Dt8gEaCJf0mpWD9ZWyvo1chJvwGipaCKSvpA7i
+hmbk+JHi3oaJ4K2X0i3xd5D0YjJ3XS8NN9DDVbiR3ZPbv8QBg1Z9UGohYEF1/cQvCRWB6sGKljQjvra5
+WtGyY5rw0EXKFI/XFiZXLJlqkVeIcgY4MwB0V01jfYITmGZsBZoG9PqchamkZdcg5KDbM5neehGVV2WV
+ccdCm5XS7vMReAEDGf+IsQ1sCpCakBbSrlV2f6seWn90kpkgjstF9jxdZNIvQ9wda6bGSnYsrLl34N6O
+0JgsDd6LDU1j5VbTjPVjqjpcCY3Dw1LOtGDjcbYQz9OFkC132hW63Q7P7J572Yu568pu4COcp4bDnYEd
+sHKYos5350IKubLJM1ll4Dx1fMc5EtJn/AAKfi5c96GtMqMCMMLzVKYaprlJxeOcHHeaYtaUAfawBbDf
+u8ZfhgFjOfJ5ARLoPoqh17IMWNTe0JLNaMWcmUNrlrpuuKQH2Fm3bEPvAdWgXrJWzQZVZnpN6AO7GzN5
+zQEVmI4rs6aZzunv7F7vSViXa+lVTmoqrZrmmiyqEWO/u65yArdl92G1JItqPJaowXW35LEAz0FNsz1R
+bcqBceptgaga7TUH5IbxsFDa6DfeFkrmdA22oHIit0Txk6E3d+yBHGMw8d2y0Q205rrlZEJT1611dsRZ
+5ZhVoMEO/S2xS6a5SDZ349X0AFNrKdyXL2aKj1xNJqQOq2XTHPCvBz/sS6kpkhGyOADKOZCjxiMZPRB6
+7bqAvg9mHV23NN6aAEQ7KhRe1qooyLHRDbsltD62phFw1hA/1fnWbCWV1rLt8F1A6WCg/6aX1k1glJJH
+KR5coQVwC3lqXuLJhKTsgXtRGC8JTYHhabQsfKGfFil76XH6O5yFcEKmRpGAcUvtPWtVDNg5nqnxcsOG
+XKCBUWTOwJx75XG2M83AKYSHHojGwiWdIyNKIXpYw4zlyB21ZMBwO1Fr8Mx1nbevAQ94G5aBVFpd/z/v
+2VruXdfCQlk411joERUePWMI4m00SrNvL5I/GhHoIwlnSzqy3NvsXLfP96MtQsn09USS+3psx5T9NLX9
+ZGgb42DmZ921TCcT7CaMMF1Saxzos9ZCpkCtbpkcizp3Bdt+aEjKtDnTR9+wibOy5c70YZzSOQxRGL3b
+insZmGb1DckBPmisJUgKorxd06w9TnNCPIFaWDSmIN79sJ01+mCzdY3YQRvuOkRrGimlXzR5OtBTfSU2
+Gm3pDni5HT3QM7Ze8w+o1X7AinlAH98Q90Nq+X+SOvgOdf4kWVgt97DHu4L8cItummsuOVkNJDVrka3W
+dXOXJfXaoX0ejLzfDtuHRdQx0uHuVSiY+8+kAV+rBXei6T04LuTXXaKRhzWSroo/bgYHXWw6Hxm0zGpG
+rUoODtJ1P84sbCdCW1pChWeXTB6wfSZiq6mvuvU3Oui7ZdGde+AlAes46M217yW9Jlo9wsTSI0xsPUJC
+V/yIlne44dk9+jrdl+y+VQdTSaHjO9J36r407KnS1v/TL+zeSqWldE19r7Xs0A3eL998/bqI2b18pGWr
+E3pvHmmpVByNSu29SkBFzocznm7wtDM6mLGWqnDklxDpKaRlnWvWgJWiyJYSLZoqL9aiWXX2cXNEJ0f6
++1nXZuFygGvfN+fnI+Rtx7pmSyXvpZ5qxLsdNN/yi77oem37g/pD3RT0P/eKXf76XHrnDn+9uryavfDR
+x1l9VV7lV+nyKQm771eXwQsv8J9fXV7NXzToCOk1u/x1Gv7q/+kqvJrS5dMnl21Hv+wp8HRcIUbgSxMc
+UHbUj2iic49G2h0oBfUeWNUjuOsw15iB0t0blSpy4kMm+mDR1gUnXLZUHa+1VnVQqquN8tHpexGzkwj9
+9+ruO3sAD9y6vjOgDkJfS5jnceb4eVF7qNoFBmiS6aVP2DmzDUUCueX72uWoQRWECdjf+t0sHtdDioaG
+dNp9ajm89h6hKn/QzWHHlbfAN9upplkuQxv0PZvDVlUzb0lbkDfPZovoeYI8UyDnW3MYAb45oSKLsabY
+yDHrNQC8GLsmOS8ep1iJZef5Yh7YKBH0xWKjSvhxF+1fKh/qSMeO5gR9Qdf/dsGZdF7sD2llqZynynyu
++9LQj8imNr3QtuZHqUH/hv5VOjO7qp56z8Oru6ufl+MXJPz1xfJp8yfbn9lXzEQNGIRgQVM83Drrakj5
+NwOdlMiZOc/lvX22dF3nhXxuvX0tWzeeL9inQSg5DagssfT/qr05UbRoESFk15Q4UJDRVEYYCIAzFxO1
+0sQ/8f4emW/QZaG9QkUM5EFVzfMYulwGsEv9iNphAMBKjZeVAGIHS9JIa7Hh1hzwVZXgstJXco2wtVM3
+55F0mCtADBKRDpKFZsHvRqAevAje5KhQc1JQTLF955vrG0v6RukCHEzBJVtWPFuylFq7mM0JtWuwDN1M
+Ad4tgG/E7znJDdqTfFqCF/VA/SKEeiUUMK6cPVklOS6+ssRqAIP0DStBSghQ91ZCr7wZVA1oGHqB/2Ne
+Z9sGLZ0v6d/YI6rElSJHCaHUXangGb24gYSwFLcg5Ft0cBwo3Ax4Ttcdsy/Z9kYexl236H5ptuDP4wU/
+wV8ySARY11j460jjbVEJO6JC1123Qq8a2YJwDvQ+TzCtpH9wdxq0gLBl2PotogSn0sBxBmJm0bPSgs3c
+6inMgWG7ClZS80Xpt/at58+cS+hDmzymRvzcEbadhKQwEpAOPgbOCaovJOJ+UI8jGPDlrc7qEu2PJPAS
+7Z9bol2NMgAj+QpXIHJSD7YnILXgZQX3eYAisMvVOBbcLVGeJP6g4dlJQA9rZJ0oIysBsswSC6MvaJ4k
+X/Sjk9iV8iTxtMv0XtwLv/eugRU4m7Zf5b/LrvbJ7XmX3G7l9Mo79OOAmorWDjk1fI2UOZqNK5VFlNrP
+Q0MExGBLOYnJjbv+nJZ5vxg6Ys/7aiYqMwy/o+xLjhJHnMvb12xWdb/cbs8OYaD6D2U/08LHx2y3g4OG
+mv7AVPXaw6KVfBlcl989z15kCE5CrDs8xrYxeHiwvH3lJ8cWUQ9khruSk6UlOhUKpBWiyI33Jt/DDLXY
+7be8Fg6qZDKTDZiM5ggPl5TbnjnRXKS7YadpDiq+A+6zmBWHJ2ppRwfnt6N7/2eCkseY0MR1T/BSgpw5
+c+NIUBhh4XZgLnt/Qx3gDqIQhL5tmWRiWopbUSJTg/bwiyCa5PuaXYa/di6A48tVu/W/aTfto+FUq/2t
+DGO9r5HxSbsqv8BZBi04QqNjOX3Ft9uIx9dVxxCPswGUDC36bViWI1XWnUaIK881lCBPIHRO72gVDJUO
+izwWYGXPRrOFFswuoAR5jNlKc10VC2E8Xj/X5wpJw7UWZMXAzI2BIEO1czRfrIv9d/kbvq2kjkzaepUf
+zcmRT3diV5QPqHsCmo0RKMdA1pTFIMbFCBUb9tg5CVrdXSXsjjp1T+Z0pc/GVq31IkFNXLkWUT/wk01r
+xbApJJyAqHbNgfXRNOa89VFdVN8lW4pB+8kHCy0MKUaOViACqjqaafg6UulZcWBcGmJ0YTpAw2h2TszK
+qQqmAcOiMQFagqSavRwDezl+DtYh68nkqNvu02fm2G9r4zRFsiS1jJZ7SpqdlUD9JlW9YukN5BRsJUUw
+aFHayT2wxKP0SLdFfH36RVcUNw0Ib2R9unkoMljdSKA+ovg5qzuBXFoELprGCFBQp19pBgfq1yN+vGwB
+jEZNYy0p1D3Q181UN9qPDWaVG+xvcjR4ZGPpR31rr1krQP2utWS2Ur/XSh0K8aJjUO66HahHHwhlscsq
+QQKhI+xMkyIXeMHj2RZgf6hYvRZ5WwZxuR8pnCBvLWD7rqYvIdoPKfo26ecix2OLzV6LVJSdiTHC4TB0
+8qLO0gcHjttiBfbYDrVQp+dI1OKQM6nPljR0SlEV21vgncNAexUAUrwYrqX7aUZ1RYkja0WHvNSBWfuf
+Vjqnqh6oFIwwnL3IEyQnBHusal4PQVpypHx7xx+qgW+pXM0W+OSqngCjg+vjDNqQ4GIrlQWgh7J9pxfy
+pmZqbI9AvZRdF5sSz8V0mDqw779hEn62BDJaPS3SMAnny6V30jgopQ3H4Vv0gdeCePOIdxEJT140lTCm
+t8FUrbKauGgqF4j4UZiEs+XYgR3uLGW7QoY+Mq2Dm0/Kpfdc0rZ2pDCjtiagZZ04a3f8yjOqYX3LFZl7
+LWPSWcHx6OY0uN4Gb7Je9DwlyjFKoiYLFaE4XkFN56ywpXDFUJ4R3q+lPQL4JUsnOCcHaMQhiw06Ff2j
+sbxgORGguhGBNiQQwUajk5WX0ph+C8JP+fgdEHi+l47H9MOZTGqs1hGWh0BZcB/7LRINCvNk4OOFUNDk
+ivUqY26cFiBrr5kINjaoAxLdeBYya4F8Ku5jgfYTXxXFteue++Jxej2tgMR8X/JYEBqN5y9AZwU7+N1A
+B2MFcniUqK4touAapF5WKytRI+Uqm/fsRtiZbJ60/Bb1+2wnikPtXUPdH9i/gL7D2TL8dImX5pU3o7y7
+jnCQ+N9S3pl9JBA/UCgiQeR/i/mefShfQoLE/w7Ew/ZeUo8fCPQXmOOFUyEt4dJTYj0eILlWLAJL0TWL
+wj8vFyKMAP+wFXSQrsFTLfTUApCErY80Dj+d8GX4bKl9m1GctGdLsES6JlSWimCgQAOAdgmwSZcDQa/k
+lw6SAS1lxRj0zxAWnUJsZQgRUN81M5cSyXfF3ZTSlND0SO/WYtBWpR97M2bg30QHn6TiJIoXmDBaEAQ6
+Th8yxIvJYwJXRolF4anfJKjz9tvwYzqZRE2zsrcvXgaPR8B50XMG90CghVYSneP9QOemK7WzQBuFmqMW
+vRHjWQtqYF3oDuOl68JfSQAZWfgK3z1iaQ99j7kptGgasiKttQCsrpn/AM4sRDBs3ua1KHO+bX7g+Uo0
+P8AkijwWjXRP06AO948/vCWIm59cLs7hGta98eB9viqkkxn1OL3jZe66kev+Q8n2pjkYLvWz6ODVpqUL
+0xLYGkbTnagqvhI0kngH2R+l5FZ/qXMymxDoIB4bx+LxEx3JEaflhw4YSYYz1joETz/IZeAkVPTMsquR
+0OsPiLPNxcxQoCZi6Zxi9p95VvvqubM30MPYaBZMJuW0zVlOVQWgcmpe0J3/SAZO6eR/MWuaH3rwG5ZL
+5C/qCcRRMTm4ljp4B/hGuervebB+/d03yuT064In4BvxHaH/wYczS+/V70xrHjk6oLSzFTWqtSQy+R3s
+CAj0UvBEXX7tL6Au3vfiN02Kd3FZbLdBZ7FVO+je+NT79mDf+UBG3W+5fd6zU4cPYAikCaUBVXpQkLBi
+aTDrNk8eBbBFgFuyBnlSTN5jtWsah2sQMmLVxqS/E0MXSvbPLYwmDNrKG5QBeK1MX0dXID6EUaERO8Nk
+3Fh8+BidVip5l7EGiNCKmsZ0FSR+ohqAlDXVnywLABFwfxPofhA/g4j2yMwB/Ur6I/uokFu6frLfR+P2
+zbq4/oS7OqumKoAwK/XT+KcpxmM84i+b05/sYOfoiGnoNArt2nruXh6P9EcZkdD2L9ktwSJfsfOlo5bW
+Tp/a+ahSlI6AtZpmq0OJ/A0UlQPTjlaiPhfMUwqacATaf/JJlEwiwnJqgtN6EVmyeNGNB9vPk5BuHFPR
+D+VuCUwkUKK7lU5n/N5suG4vod+rI+VxLKrqHP+7bahpogFmbeS6JkscGBkN9NWXIptKvoItfyvyDGIf
+nN31OWYdkV4fFDp7kTzarxF57AbNBqqTRciPtgasVFnsCaBSqn2RBGG09KMOT/eM6rCK/5KEwORdHj17
+isppJ7QycEc+DK2ysK/q7H2Fe8qaV695zf/4VmknRUXytPsTAfUExX9Gm8uf6C/q959K8+FRqj08vTo2
+V6F+XoLOw3+zy/Dl5L+XNn/8iRWruy4PeKTg3RAYxMjyh9sjoED8Ir1iwckKejxOMOb+P41qxt/effet
+VB5AOLbMCH470RpsQa6vXIQmFcxJeM0nQLpojd7/ps7kieuQE68FPeXFhJwqZ8TychizJ8AFbn1C/mLB
+tjwuYtb1mBhbzK+hhTSG6Oqjx0nT/Gy9QRi3TpnO5vxlKjev7oPaT6/7RcjjL4o8wLcj/e1Dtf7cr/W3
+s9X+3KkWiRpLZyA5LWDHA1AiZPCHAg4hW0XazgpLzRhL/IOn8C+IZVKpCJZaMvbRz/ILddQkwtJWDgjY
+Y7Y63cwrpPq9hMEDksd0hnSR8cAmQUlKrDroWjFA/0wI/U1a54FFJiGLn6fVQBdAzeVoULt8OGWsSBSK
+d1aLbpaQJm+E4EDlvXw+dfEB5h0WRo5UbAU1XZTbOLhV0sc8v539LmH7ox0DEABJLLqOpad3PPllNDsH
+p13B/WlLCtTMLEh408B2cxAH4Z/VMzaaWBFDw8T0HnySYSHwg/mzObVAroPep7sHSkyChHV2hq2eExPi
+J62MCgP7HWkiTjtFHiOm2pcOi1k5xVyy8cRE2MJgW8Z4m5XT3zAbXP0q40eonZ5yqlqTW3EBqt6ajc5U
+hByrwmQyQZNPDzrClK6udjtslyVUHU0pyv6okQqs0D50lLgu7l4UJCEzAtg2Vl+Ho9CPnTaHszCIRy5C
+jEjQTHVMH7F2/zxzvc+qsfBSGJlljpfkaODGwlNDqyT7+UzLsAfUiDwZ/hwmkAImOYH453Gg11ajOhOu
+w1Bu/iCwdyHDbK9FFwpkOqFqDbnr2isHWxhVdVrQaLfOAGx+ePsNVhJvBS//8cF6ZBkuIR5Mf4d4ejbV
+N6eiy1ySBwVdddS71jbsTyYJuA6079kpBav04+IDCycXAq2R1H60AnwphADetyjvAKsKFoMQCWfCeEzV
+GwLhur2FrT2bJRcZZhDnEA13sgyA3kqeXk0bcpWMvcAPxZdL/HCVjBtyqWLe0YizfkRhDEdMWENAIxpU
+olHD/T+XT02U4Ziz0Hlf7B3q/ADmLw51vijqutg51PlapLWz7ASI7cZIj5qGUycHCRoSV1X9sMXgbhh6
+vHEGUgHMjAZfz1k1hSubqa6cxritHVXSkYFp+zd8o0hLV8DOhVtTKm9NK3AhoJoHm6/2kUUyLFBsosAi
+JiZ2aSs3VNRetlqqNuUnnWBzumLPZnTNkmBASDeND6Vn+6m3RP+xOi9Q9SFjABUboFtjdFjrYYZvMZZ+
+GC0DB1xDQriHa9b71DTwAeydXXecQeQ/LjV+TRPSW8e1616Hny4hI1ivb5oGXqmWgV+zcdY0c3AGloL1
+rDP9s0OvL1lKSzkz2Nnr8UbzO8F7pZdCvy8zpfaWAp9rZagZODGv2fi6aaBq0MQEmUBwPfbgdzwnT4H3
+74/hL2j8eMn0kGc124ATmJqXNbumyVTkCUMDY7TbWHFcdStqp+WCtw9fiWXPhI6v7Vt0IHx0qRsVyUMn
+PkncM1JDLwgKPCMLPOmHwmcasJYnrBOBGMAB6yPw3ic6oUcz3rOlAZpRKk/M6Kr1XZE+Xy1ScIWCTsBx
+kh6AsYtW551dR8Hbqu4BLAPCtcRdiTWEppFG72EKINerhGHoOEeyA3ubOuFeQnS9a3ghxJctjqwWZQqV
+hK/VsORK4e5jMzMuKbkR0sE5DLHXH/jS0ae0zupqXdwNbLOMy3MJqct1lojzeUBCW6xW26Ezy4H47YLb
+Ms1AkeTQsKfUyqEB/dw/KBPVSlDKX11Qv8qyR3MabLi8eWtnTQ26b0K7o2vOLru2S11NNXKZ0S0Uf9Jc
+XXqBv+G3vBHxjpMqLrN9fZnRHWeP0vObH86poyMZ7Q7bOttvBftEP30CFpZtDCMwIBE8kYXQoFR+V49L
+GhdbP3xmPj6Pi+2qLA57mc28WSXqslOghv2oKsVHO2vih5/2sz6vS5W9fDFQ5jdlsOiHM3Da6TjL42LH
+p8W+xp4w+ZwVOd3xKZaGpDotihoedI/xmWNG/A6zgCXW+JosOjFdW+0t4xfxj8f3DIaTld8U4g/X1I98
+FJwmmRrAS0KXfyft6gKj78mRJxx3I8f2HKzRpEVN8fNkEY/HRO50DkIwZ7UtIr4F6ZaDlsoS/UT9b0Qa
+5O0BqBv3T8HV3XhxuejESR30lqO9fdEtixTG1oj/TclX8OsR7YlmRou2s/nzYpFL84KU8TBf0lQ6qEzJ
+oGggJURPzI6mltuadOmnxMTn3nPJswKNixVD7w+dgF192+ckuwUEu2betTqxU2AvSiAlp+7xM7YDjn7T
+7PhUQzVdWUbEYKE/Lqfrerf9vhTaxIKMMzhfr9HU3/Izs2Kr1v3yoh3gqhOCf8W2toLyynZtyhxHsgCU
+vw89QjB6heIYm2LbKwGLYYIw7sJcub1IXLfVSExpgsqNqOKjvS7oad4wi6JMeyd+Cj3Ouded+hSYd4j6
+HJTGFNxbgUTl8drqyyq8hr5s9Spqn4sOMfaqqSFttsfRiUJSch4KQbff7lByBhZilpyLDhcPR4fDUwGD
+5nQ/6wh51Dyd5lER42okZToUEKGFdBH6alvkAvYX/OKajmak92aASHsVpVHHsh3Wn5eCv7h/fmmeHVpM
+8wKrfyVLMbR6PVNzx+rckydkydmJ9JFWcO5di4dLWqsDdFccKtHsiyyvRdnE0qJ4J/JDk5R81SRlsSdN
+vM3i60t6wDLhr9PlUwK3sKk3HZOGWOjolttBDWyfXna0dcvPluQRmwtBx11sq1N07Hpfsn1zIdVL112p
+pREnPZ7cZGPkSIIPJKrZzqQVakYE619j/eB6maYWSZWlyigmkf5twNM98IeA/6Tr8tUHYBKdcMQhd0IT
+k1e9Wl0BWhqdlQt2x82mHpngStx2tw8qUiuglockk6VHpkUKLDy6Uhe7nm7MkYrpCoSMK/xBH2n4XuLP
+eIy6UKcsDQFCZ7y2K6MFQRMUwB7VN/YoDzCI/tI3XurEHbCPKe33n95otiJRHvrj6RqDypRSIZ7GLNUp
+MHTjmQBZc2esxUpOZSBmOdC4N1DqZexGdr4CyXT7hh7+vBW7UU1KubV+YwNR4gfojxL6JaetLrPVSpQY
+ul0GoQ/0JyDkodvmGt4ulQ5XC7gS6RSH2DI3xwFHnT2h22YyIWt2UIdnFG6WRPnI37M12JgWzFuHz1Qs
+G+X2ZKr9nhCaozd73Tl0GMG3Yb4EsxyaM08E2ylwOVdwpoFbzO00yvIEHuHmRM+WBb9u+mICE+DntCiz
+Fdaxl5KbhKoF9mMKS+TLBaN6qX1BbQ8IPi78GfcIykKWUEDo1Z7Hwi+UT5cpMBdSQr0dy8Ic5kc9AVm0
+M6N7VRzyms3oFk6Iw9511UPrhaCgK/BDMJo3zalehuuepnk5XRFCt/AB5hl+dXXXhF5rANcA201gcjoA
+WwQ7beLQ6+94TGf0mviK+rhGrj8uh9ycMEq49w2Kk//YLm0Fbq7b2bOua+8n8vjvAS1E3PjfwC15/BDY
+Jh8BWwkA2OKarTGYUy9eztXVlDhjDUNXV1Mv8KdPr4AjCdIRD54ggg6IGtju1PHSNdsBgwI8V0EY/uup
+hv6mQSYpLC2my7VfQ8QSCcTXUwPDpGkS15X5KhMJ0HOePkU1pKYZtekI1x1HS3aZHtxMJnSr2DWuq59a
+0CSLleuOdq1UEShYXibFXe667bMuUFCDONX+KG2VK4/TvM2hhSa4F49G7SOHkzkjekmNjCIfA3AgqI5m
+ZNEX2mcIlSa3Ixu5kEDpoFtohXCHTLRVY2l2j+pFWncKdgLylZVxUE94AUxKT24F6VZBt4a2jKFE+EvF
+UezDqPmqGLdwI2ERld62+y3hfTIDeznzJYxR2yNqwRuDAUhBwOgabHZfqxE3TefVsl2O5DqRx7Xpn8I8
+lZ2LboAc19cDYHSGMdwPwLoLnBCUxZ6vMMTAu7rY70EkQR4jYPiWIq9Vx9Kp2IqdFXfeW7VHexUmVoVv
+dzuRZBB1bqjmaFqajYEF2le5cVadjeNFqpHvog1b0WgKZw5b4Q8VzPP6K7MyWxRXh6jioGarekvUoa2G
+lFkSYSAF0WbisK2Z0EaB4IusxFZeS+Id7kEoLLRG6IGxtnYAON0XVa1XzHW7750VpLo5VISRs3leowCA
+Gs67jEVdTEA3jE9lQAmAqwzMAC2VAQjRlcXXtod8PpUu+F+wudLE24yY9N+yYZuOaS8kamdLdrW61lHr
+139jAmKjtiiRfA5l0vl4pNKbfYY7ApzgxEuMeqMxHHg4bBk6q1AA/xR+WNKhE4LSE9Itg3JHsEG7P+Xb
+RX5SIUw3JoQpobLC1DieTw1qXMu0R4AIf2OtxNEs6kZZqzyPPloq0s7/0OM5EtYAKb11HVbiK6eIbVtN
+Qsrpo8gPO6H19/r6fKhD17dIsG4BWrkE9kWWA6/KimMZDX07+n+0+OmXkC97qoVnh6qkvh8Z3V2Z1fpZ
+aTQibxlMEIcdQYRGQ3MZcB8970/VEQZW7RJR+I+ggQuxhL44RLJ6HSHlURH+A7MwYuA5mkjvEFPM3pkK
+TPEIhXjZHcrFwS9Z7hxptD2UH2qDddqA3J0mIOF8C8WhdkBsncXX59pwNCMe0AFWKTECPmJJ4KLKM1Hx
+bDodwCyqB4YnPWy1r1ASdTge4pFIi1IccjnzNk7sHusaHXOFGwFbdYAMNQ47KVPZKLJVTDlyhIhbHSrm
+REF5UK3cdQeTPaWDNj2tqqMT0HEiJDMHHodKa+NGp9t97VEHvitkKhOySp0538sTSACrOuklNU2rTHby
+UY6lnRw404Jb7t/pJuUBr9fKdT9lzLzZQe5VSnsy+GZ9JVx0CAbefZdZ0HWpSEyWzrsSN8kpAOsec/XU
+Z6X8nu3Eu5rv9kzOqH4FcjUHQRQeIFjGQgR4GHVxAeoJnqBb9mg5ivLVZ3q6DDB/Q8STTP8ADSQzvMt2
+Bxw7GGp0qYtT69RTeFmcA45bTiF8ofpsWoGZ6tMwR9ojYf6dhk/H9aGmT2gl2fbQLP07nfjALH+sN0NF
+PQVhp70FOJEub/i2/rt4gLMowmMDHUvFsNe35gBb83wlkvfFAeOOQEpdblWpRNQ828ITLsb3a15hoZ2o
+ucqy5yvxi374Jzyg0pr6epuJO/h14jUvHdVe+Qq242hGr2Wma/GgU1QEJ/MkO7TNRF7/0j5iM0WaVqL+
+pX3EVMVvfptYL3jgQMfiUoj8l/YRS0ikYI2/LhSbWL6Y9Lt1Nnij07TpoueEFfODu2EdqQo2rIxEPAKE
+oyYiaB99PjVzYcrb+uN1r665G4HnWzcKPvU/c6PgmT/zVUEJBZqhCgBCDFggax52YOk7+FzcitKh+LgV
+/Fbo5EPt6ElU2dWbLKBeVBH9Cc/0vpea7rWHL9ljhxyIqGaV+JGiTE9mWvtvpKKPizFKgrl46XUQKo5t
+ArjWSK7QfU3TqClkqbmA2dzfYbY2VWXQu/OJ1qKNDIy6kurKAzdKg5h+pEUu/nB2iCcPMH7O/gX1aU9x
+JqSYSdE0UWLPFC093rvTS9Z+0t5qg8TM0NiZOmPrk99+ou2tiCbm6kqNg80TvWrlHQcYMJxInJmm6GGb
+n3MOGI3kBbe1dG/dq6JTEbDo0lqEsb4Nx+xOeVM7K3DoKDLTmEaWOsjvqPMRjHgpeBOBHGvbiF0kkmZd
+Ntlu1SDN2Wyz/LoBrNjsecl3xDuvJvJUOrAkV5cvLlcZfQkNSCFp8xz1bprnUNtlRr/g7FKJ9sADZuCH
+v7Jlw66qp1riNwWVk1cgPKvLg7i69KZPySV9DQlX1dPnI5Clha9ev3z/8ipsJhPSQMLyagnPL66qp09s
+45EveYc0lF6q4JgASewX3qk7tMiWUDt16YCSjfMClTkcysH3fNNw3/KU8qYbPAh3kycR4rnQd2Pn0hnL
+rNSq6a+tAhp7pTi6MlcbWSTQ+zWcL31NIp+0YNf6FR9kYgCPenES2gmvJ11etZdaOunAppV6GRGIAjYs
+lfAGfGvFj9RcHrpqhUILszE2yvUhaHxsIBZJT+nDFpiBrAzyQASF4y/dTq3ZL1anMma7s1oT+ovqIzo7
+MFPxthcr5EwAxdaFeQz+1rvHU6ThlJnIl74qILXVHC2Xxnfkn9kSZ9Z1e26p7v3NUgmNmBZDhktQytZ6
+qq2eyozuWnvUnO0mc4juDQrgVdcNyg1KGaqm2YFTsxMx6w2E2rYE9K77hRrxjeWpvYtmjK8RPhU3niAL
+iOUMbbMbi7UmaIr6I8CXo3/jXqoPCuzQDk8yCDNAMe5PV/diNKdo25oyYW/IOcaTb3VKbHOdlIDqTaIY
+XmvlKS7nnrAUNt4gsOjQcovt891iOx6TDRMU9muOEVpKqTrgbcBOF4xuM9fVOi1rmnNv09YIihbaVHYL
+oZi2RMZfw4AvbB2uW8+6vTHKDq7pXzmB9Vxsn2eqM2uoSiuPbGzlkZHZipuu4lNHN/qabjDk07QqwT7g
+N3HLtz+WW9dtn+VH4u+9ja1UY2zZXnMMDXDdslQtlPL30+hlVLCo49Sb+Bx0ThcqtE2iNC5BNzNumvnI
+9qaNxMxW8Bz3dy6DhSWdqLBe3Blh0tfOJaiJA0WttenU0dGqTUhH+dPYz9nqTsOhavUE/c6p8/zJ/MXz
+yyfPXjjSd2wuzpEzinHMu0opiLc+oNEu/e70lVvk7Nme8OfdBGkiKqMEeJxI5jKqMq1hR+WAQpU727Qf
+b+4t91KINrcCOzMUkKAXWiKZyGnTyNKokyYrPFPRV52KUDyFJ9HaTLzskbU5V1acH1CroqPMdaE9e0nX
+R2pA5STyr+XFsC8wQmA09w4vZtzAY5Z6P6LNPgyXxeHPrXEsJukjrrWq1ilgjRf0SC4MCdaV20GHtBSF
+LOwGFG13jMNfLHtqz35VWcgpdZ5g8JRBH6mG2LZM4T6YkQzEAFZ5+oaA/NRwGzzkQHFPuqGukTLF6Mjk
+hEKdKyFHH357aZ/3k5oGa++oAPLWGpCf2EaRI5WKaAN62n/jvYtQZ3iKLOo1Px9I+7yfpOm3L83ELrr6
+cFxyzvel+P9E17K8EmX9BbKFAWd1XMIeieYY/9v9xJZtBN5L6DcsteZhydJalP+PNNcJLgJNnziq1CiF
+Y/wCdZJJdhj4Z8ZoBv2oJF7vKOMYioDyDtw6Tvc2OHB4WKZSsmEM4SV9fYBhH/cjOQogIgZtgqDKrtks
+HG9/cIdLUFE2hagNIml2y0Cua0J9EmnfhMA1+puDXiyARfiStz51RzseGiVm/iEl5iV55KyvoswJeqpE
+caq+U6hxxHIc815ksv6CRXLBrH4z2ClsZlnlHyMFYRrJyR2O0q3z2EiRDl0/oprHGi4XH4d0c3fpgfei
+1XdWW/v57HRk8Iko20LVF6VOLrcf7D5t8SxZrTiq94XvyCdHoy1IUo8OtbeW70h8oVNf4m52cFM7egLA
+7bZjTYbzUQfQvGPQJKMUwVrj/aD14Mtmi9Vzli5WQGRioMXUMoCVGwLIrtIT4WpJwmgJ6slahTChsfT9
+3tmblkvnpHXpDNyIHS9XWX5Jv+nbTmp7yWC0vyfSaHKsbSa/5ex0NXsRK8wFMRN3VoSaeFrsRS5K5Atx
+Inv7qtjtD7VI3qFZHVhl28rk+vEikoLHDMJASkOouKpAt545UXE/qbLfs3zlR0WZiHISFfeLvQqz5utY
+dwtlOeWj9dlCDt7nh7pYyGL+fH+/2PMEnDvhc13s/fl/LjAmmv/n2X86NLN1uR1adhXZ12Shmc8nA8vI
+ImbO/D8dqQha7CHuwrO9tCufys6AySkVzPlMJ2PLVA9YZkJDVeZgd1Irr/UV+mXfFSAau3TBeewEiGfD
+mvc0O/NhkRkjO90nsDMHk6A8ebXN9sxR/tJhBWC2uhrsw0VA+R1tpPGKgLPVrYcxNtwcXZ+CAi7kTK3Z
+f+3vFzKwnT/D1ZwttiKt/cnnn3/+ubXYMwUNE1zxvQU8PEI/n8Kh685SZ5ZXhYI+7rN7sdWB/QbO/sjD
+2INRcf8OAfUHsc3OuIqGrAJ8zN6L7Tftmp7JmQJSlnV9Y2DoTN7VEaIyeVbQhu/4uVsezmtrrxU3zbd4
+bYpRBx03rtbBQC6QB5EtYwiH5ACEr5rmg/dC1Kq2TGnBSUIx7Y8ZFBi+UScrxHr/Wj1H0s/IWu0PwdbT
+XZb/jC8pvPB7+dKmW6m6HFtBAHxVh05L7DKCWqVSYil5rYLV2HF8K4j49x0+7WPHJ1VLCXkqoo3iNGpP
+UL6J28EickZ7X+7df6BNRl7kokEusBeMJnEo+JJMx+SS/gCfJ5NL+o6zRwPETgvFt1mVRdk2qx98Z50l
+icgdqvGiMss90vecPW5FXYvy3Z7HsEEgrnBa5PXPcjM5n81mzpH+CObzP4voOgPL+W+K3x3q7CpnSX/i
+ZzCIgiszaz9zdSXgcCv9ibd2D9p7E0QVLX7c7zXNNNbxEcDBO/uRnzqrgdrYj2B1N45ov952wX7htvpn
+XKE4ugp5z5ta5xP7WTof4uD8zNT0T96Ly6Z1mdvAYAGGVt7xe29Gk/DZcuLFTTMjZOwl4afKaJ34Vp3/
+zQf0stlMWQmDHMVLAoXuHF+jS4cEn/mOjOGJzjPmPhgUf7ZIx+wZcSSS01bQq7HxMhCPYzSiBpEyMJ0C
+z9SoM09MZkdhTadbSNc+Oi2guinzg1dW6J8qR3y7I4N1t6mjk55/uO6BYP9P+iiPScSWMoUMBfCGnJaQ
+6HhjMAjcAQZvK9X4xlg7BilE4oTA68X0BN2DO9W0dQsBVzDqAAXiKPMesMB0pODcGUenwB8Z4IfwWwwd
+gb3ZFrxGa8kZTccacADDDgEIAtQY4c1iG8ZVJf3QPBaw4+sH//HUqx7y0hTBh5PlqMzmLojm8XHgzB0/
+Rr0p45rBf+R5tkNdiLe1KPEBtU6lut72sGtf02y7/U51A1634v6vZXGnn9+tyyy/xrcWI41mdJvl4ivz
+VrQVSJoAH/ZrLrUX7rKkuMOn399iNCh4KoodqvDpHe8/OilMLsxfVeE8O6B18rAdEhNLee+nPc7mf/Xe
+1dJbjjvouu9tL2M/mJMOtXI7p3GGeOkXYF1KH8N68dD9hfW6tgyb42Dlus5K1E6WX6ws/QVPsJW05QCI
+Tgh4r96Alz8vNXZslrM3hFJhUFwMnvtkED0vZqnZQSlzclx3h1B5+QfyX+ES/UnVFo/Re/2po4916+iD
+nNKIIE+Q8qkZktTGFVlLI4IevgeDYU6Wr0WZIY8WREi9eWDIT10pT3WwmlAyCzaQYnRNMcQDVhcbWoBI
+IPc/4Jjlw+v7P1nV7lLaKzijbdeMuw2F2EAk4eRFueNb5YoDfTq+55jpPZfYCD7FTRMHXge9CCW8nzVN
+Vr3J8qwWiOyaZuZLh+Lm0h06kuR2qDqJlieXY2tsrI9o9F7SntVG/1BzdeoSB05ivGmh/tEPIq4rE0oN
+dtpK1F8AHGT5qs3iEUnqBU/0tPgCnt5xekqwmTxH0tNI5p1VBhPRb5VcIXFdhYQT+sfOERD8tWfV6QZT
+Lm080dIKqL2qDxE0krQc3FCkSGLwzyvXRc+3dc9k33OvmJ7eHegAzpc98yxwkOi/ra1di8EJh0vXBGf5
+sS3jz44DM/7hSo5Enl0Wi0fd4x2H6tuc41B1D1TkwPE8APIxgKCUVPi2M3YjF0Th0CP4yx8w8I21QdoF
+OJKIl4vk+WcoPBIhB5IkWUL9IEZqmjRMJs/wd2Y5VDrSrw3vUOO/tmuAf9g/0Ul3R3DSRznnGaG96x2M
+BOjILPX6vmDlmBNFD4nWVG/1XCBDCrzqr5bLFtLA7xceGno46bHvXhWkt63DpJj4pjDw6gY9IHbjCv7W
+IYRV9cCt+o23CrcYY9bKdyyn7++EyNlvnP7Gzynm/sYplPOH3GZrf81bsdN61fuy2LNYPgteZfmKoaBS
+PrdOKDCD9KJSMcXilg6c8DEv7pjWddbaoiJPWCIf0edT2jsJ4/YkPNL4UJ4yX+Uo9xJyTHcNnHGFDqU3
+FSXkssuY7rffj7Q85EMRmz/WmD0B0+QgiT0VV7KoWMT0nIXWXC61TKNf8CmnMzof/kZ8q1ZOzfx6elYn
+7eyTp9G4fevWV9Vi77onSa0qiDTz0vXraK7A0axEHcTT6iOTar5j0eORnkBv+8rsb9Sujz3qCvvkuVob
+4y0AiU7osyVO0yqukBzKWpfasYHKLA8U9S3oZvX1ZdiT6VSmo5IF+LzGawxQYkHkz05OS2SJ3+OsmvpP
+UjxO/LNd73XSvpfL0kv05Nyiz8FRIC1+5xuk1BkKfhtjGDy0++hM/rRCR/7viz0bSMYj9bE/5t5AXFcl
+dPRChjqo1cURyzzCzYYPa3McaXUHZ97pt+mfJ8h0iIvK40/x8fu35PKZZXDjYFkHmkrv2QlIUrNAoACH
+eCaiUUTjSPoSkQ7CGnDd1YDDLvB/nUTssvUD+cTyFSKAgx9F6NhOcp5MvMQSnGZW9Ut9Q3xTSsdPg+me
+iIjfiaYgItlTVHS+5VsiX2s0MbLU09LIJjOGY29wrax6JJRHTJmDtHWsotOIyo+KxcyllmCk+C6SFGDP
+JhGJGdICVISaSzKOl0yEFrtjybgV5dYTU3WBZUJxJuFMbvuxjoYUmLzraFrDwSdKeW8IlwTYsDGvO5+c
+p86SKId6ou9QL0uVrpNEfzEc0kaRLmm7kEWDzGOj36eo/ww5afpOAG90JzXGcyBGCn29pfvOPTlBKsQ4
+BwH3rQBo6PsHAUyxkLsOf9FjMZXoYjU95PgRPC60Lwz8U64sV7zUfrE9prZlmmYN3uhomzIe091Uxpyz
+wWcorS00mdDWjzF2Vc1909h98NABL1m02joEw95D2AMaR9rBBRLnipGMn6SnSrkr8YaHzmW8fQCcXuH4
+jpxALCefwVy5aUY3lvHXDWg7xUVeZ/lBLPYQnjUHJ403rnuDZGxLzyUEXORkbMB/Ps2a5iQ5J+Rx23dF
+DwyAKRgupNvijoWFeabt4y/W8z/BiwR0xnh3lKu9QcVHAy3tHZFe62PLSm09UkKksE1wzTa+l3F09YZB
+Sk79uW6GK5KFQIvSc7J8m6lam0a9TSRTXbYkj+DNoKNXyWmCi12G/hswWpMFRoXuCtsciTXoa2a+0A1r
+xwUU4zUyTnS5bo9Q71PPKrIr9QszkoEhcLbyteVBa9daL/vL3P7yT/vLsyUEccnYaN6Cek5g9DeB7kKW
+g36vtwcXPJhC/Bvbw7hGC/RRSzFgdoCtpAuw0Z7Qvetaq3s6ufumUQtJbR8SBudY/bPgn8LOIHIQ68jb
+B7BD/BlN6I5QzH4Dg4Htkymyc4+yU6D3dYr6ZbOOovcmGtB5x27E0joDsbTNV8IgZAoPoMvB7v0uJVLH
+OJzrDAwuooTGKmgPumJNqYmxES97fKhEMp7kXRl5T+QxBUyKCV5K2rLJsu1qSvCHg3WPbDVeUoz8LJTT
+D+g0s86269ODBU+qa/ShINVkLB/Xth/sAXhVncqQyjqSbjBowKuazyShUMmdoqZJUWjLLMnNRi4VkA3j
+jbmETKRH+ss2BTnxbD5J4KpNM7aRJ2/VXqgzvFDr9HC1nJaH3GuZQGsreqDHabihKThmp+nzuetmQewD
+kjjNNKewouuOk2+O/gtAJQhiSq+Nq23pcYCDo/F91QmmHRFa7GsrbTSjj0oJ9UskScHTlyRO/ZP775HG
+hGpTS8U7zUTlRybxO3nF8mNqptM3E67n0I/NdFI5S+CoU7lUhHe/G9pN8mrUvd/jdAM3OQyxrh6nnREg
+daQ+yAGYuTerpVw80ERamg7E4wV9sijoLa8/W1hghbfhhQw6PpoZjS6z9LFc+rllIOP98YWl0ZIQf23H
+x9TJ6qYJ598GrxayF5sIlOsHZoR0CcDOZjPE4IZyqosbmrAb6QtNXwnqbtm02UbetFTL+IFgVlZC7+4f
+VElkUydtYEpQDryma/RA02lIjwHv9q5rv2pvRBtCN21cW5VDv6vwtioVnnXndOg3FfBWpcKzwS8qTb6Z
++8ZOlJ7ZNBnVOwwkX/6G4ph9ewLg5Ngcy6m54LRGQNcRfdTkuv/oPHX8cCj0gmQktZvCkwEQFDeOe7G6
+39JWJg3bMz4uj1RV3+cj2nPMSaDiAMgrg89ZG9B/YSu0zdD+s6dBDyrqyZLaN494ybqv0jlSJ8mE2Iik
+mrECQj/MomX73ut3FHSPB10HJ373A+5qrSVY7YVI2CC3FCNFnEaesRElJ/6jBhY/bppR7LpRL2Amhvtt
+sRrXeFNm1dGnLLcrrhtZcVnTezDBDBKDDNnM1/IzY2jZfsXbtX65yFQNOMrKrsRKDtvkpT+cxeB2LcxL
+JPy6rnoYScmQp16ZvIYl02KbgCKImiLaPnaDsnTQR7EFUxj8bflvhKqqTwJ1qHQI1nHs8shTnoj3xXl7
+Xtw7Suk24QTpcCPRpjPtkxyINSAscI8KzwjIoyPlymxYfTsnBGT9ixCXsWpwcmWA616cGkmEXEvHOrQL
+ciAqgojNttuxNMuzau2ArEg6lQJ1u2MbalV+ZysqmiZVa4Q+PFoL3JXipcqpVZlAgtQ7+zpbZMjsHrIv
+NCWIbzTyYtObwVgj0krYjjcSuS5XlsXDEVLo2ZAwEUSfFJbOeScyCc48ouoKLUENu1se2tK9E/zFrrtu
+gr6jSBuVEI1AVwMZzcW8LYT5W8siMZksSApFACsr8xDDmHVd/KQ3FBDMmADQJRc1Bn+gIC/Uzv0EnROy
+GEFcoqbpbQxpWSJXfpB1qKbXM6FdPjSnNLbnCujdUM+rs6TCepXTvOzOcxIkLXWEVwMNkxgp1O42Li76
+upbzKn8tq0y4wUXtpEZyUiM5qcpkBOYyWhpIBxjDhHYuoRYzjxHOo2TezRbR8xUaRiRhtHRd+Ks623mx
+kJMGdz2oI+mIwxVHhkqeC5XcmCUdOs9RbzxaLtSvfTJ15F+SH940Q6EY4mEFQrm9NRpbRWAmAIF9qIwW
+3QpUq22WiNfFXe6vIsUnIhQTf9xjEvZfJb3HsWGyGiahgHjf5q0qkazjiOnfHWrrA9YkP6iK2m+quuPH
+VetPsboeZaRRNA5PQiPo3hv+cB/pop0MCtNlZoSJlg+8iJ7HBu7GY8IZqrxyTym/ym0bG7CaTOicLHSR
+ppFnKoAfcpcVp9kiH7uenVUnNMmi+fG8RM87HZ43m39qfbaHFaEeo0SKEfDDqelFN5vUEKfWuc8eq21x
+5/9lNqMpr2r/2WzWCg8+m83UgZsI4C51r2MmjhxUF9jkBly8Iogoy2KJdqiF4K0I9Nb52WHWgyeIRXLS
+fy71g3Quody7nXNL3/coT6PTT9J3hiMDnH7QYb0UGjqELJRzg9Y/mvYd/13OHOnlEB3QAU9sX0t/1QIc
+/FbqkX6gj6osc2pHez1RLu9pMcWHn/R3ZlrSDuK3Ed1FTLlN5nVdfoXWnIsOzQTpH1QskEXPSu5p687h
+3wia1hY6F7fwpF9d7lDLToZT/FMZfOm/5M8z+CEnzrpZJ+yI8T8R4G10r5rwPeV3vWOO3DQe0HQ4h1KL
+qWfWhdJ7yz01IGmt7xVsI984f7fUJCRKZ7HUD+/MCSyDL1q1NWGr7yVMGLW1iJAg8T3ejWwQ0XgMQtpY
+VrIylSgXH1iFDuyIFSRMesHEIWIy/Q/ZvyTQoXjR1krPgC8dez9WQzqjIxs2XVdBrAyniO5MFHjrM1DB
+7cKW1J2EelAhKPWOAMpIOuobgr++wC4CMtlcY4HuOwmQq9TJmZBuaU/9lMQIoduIPQ7FwDboD6ns3mrG
+Sn5pVSYv5cZF2Cn0yCh3qtOXV3fjyxUZpCB2kVIYNAu4wKTuJbcTOK4HvkZpHQhQqG+1pPgXBUpKm1PV
+EqwwAIL6noKIUhmY5UpIjIvbSOzWaMcijfTNhfGbCpWRN/AFkrooaX/q9bSPkqTw/sMoqec89cMoyTLP
+CGX1b7L7kIPjnGUfNZ3073+Gmi7OYJqItR3Ao5MKlaI1KDto5I+jCY6Kg38QJUDmIzWt+o81j6Te9LAi
+Sg+BODWPUD/XsdicqE74Nq+B+oZAGpF2U6Od2pCmKU4T0YFWKdJg5k/mx6Ps1ZvsHpS1C1B1B3PaN0Xp
+UCfe8qpyfPkLhR1Yus7Ji6jaUuZQyezMsHjHZNWI66OORof9ZmrE2aKSxKo+XneELqO7hf9oK73bh1op
+hzql4Ml3+fYBzHT4/de4RWCaxHarDH3U2/dKLYE6ZXH3bs9zSC+26ulQiW84xMxMS74TX0gTA6pNDL5M
+pK9d+4ZDHuUkAxDjhuuel3hls5X+9pE9LXa0eTPpyh3/hWMpZ9xEHUcn9rnuut13TwEHATXybvA8niSv
+4NuQ6lvHLzzy+XvMPnIerQDiUuHtdBMe7zhZv5EMH/ACTs6ZmnPyeDIn+rBCfJWpGFKC3QDfBYLFAl1h
+CdydC2e8jzwwA7lwIDpXG/YpCldQ3ArsfeGMU8yH9tjJmMm3xZrtIxCOAKtirRThTmaXrsnx2HEToMxS
+/y/Pr9XKR6Z4dGLlbjeA6EuPxXH+310U+Tq0NBAdDBit2rOP+UL/N0slL+D9pbJkD2r4i3NRISPbWReL
+AxnL2QJ+xZPorBbx+xKIgaWO26W2etlZ6tgsNXrIh3jwH+BuIdh1V5fF5FGSi6opmg4vcQT63bBAYrrm
+lexJRALRGRdQ8O3IIyX5NuczyBr0/Gmna6wdAES2MCHknd9+Mwfab785xqlx1UF3J0lmoSFusaRLHce3
+ucjdehEzEul1g1d9MLB2LHDMGIAcB5Drwn2i4L4P7Z4Cd9wNEuQNXEcIzzqq2MLEEVOEZRmxy6vyctWl
+Em/59hw+0T5AFmihdWa7J6wHdnQY7KBesThxVoO6FUnQBUANorccPMj5nOogYoI5jpHimO0iAjGGD129
+DSH1NqQgVAz5MJK1clhNPnYcECoC/XXLt5aCtgo10k8e9h+4pFFLRka2s9sWCgV18OqFNlZYF74yoTFr
+6z/F7owY6In4YDdWp90AeQHCbdsJAjoRMROyF3TQVEOjxxLDTKvIbTHMW6wNZSxwMvaQKLf7Y2Su6ozt
+c3iECtH7yFP+pTio+ajAVmdqVWCrQ7FWiHi6pOCKKe7UxIQfh4GCZiNyEnwM8LEK0vHcbxU80bwjfT4L
+Mn8VpKiimqGgNkvlVXdJvZYT1TQJRgF13VFsQnK4rjeKbcpTf2ia0Ree/YU6OqqsQ7RnstKL1XagKwMe
+CxUCIzKO+tYntla2JlRnYmBfXAttwmKrs7Zx6kGbdbWkXtKS9623mRYOVa0yLDSI2QANoXAKww3JrsXo
+pLizGGwyBz8Mxw7VrdhyLR+wRw13tuGSDXNOTix0DGmrPXha4/C4nlpEoMdjy3nES063xSkG5DmHTE7c
+virADpwid3zNWCQKI1fqBq/CU+BPoyNJYOyJJ5cLs7eUszl6GlkCAN8O8qg0iTEEGQtF0yRLumdbiWMj
+qjzFBjKOkh/RG+ubcYeMGcybFT/MDzF80pplTIAWbELhci56prv2+6hSt9H9+CS8HwDKviXNpkiUgRYi
+21ttUtBjlHoJhN6oCGagoWMV9SWpDTM93tOIRXYokqgTfmBPT2Ngui4GBMqq97JrLA2e+Z9Saw7YTRsO
+j9qxk5iVKRgMgnbz0SBokh1k4h9JlE0jFeQBfcnKR4YWpRoHg8GxvZVjioyN/CRo1l66wEqbZpTr2W8a
+86hEX4LGKq6V5ECmrjvKpzpMi9K5+DnLk+IOFalRMYrlnUBxTbOnesE34z0cc+A6pEVwZLFe9FIKicnQ
+3856kYH2tej6PgH/rq6r8mW2n6SmyVRVsmPgZeLYXgO8NSvkPeBDMbfkXmCrF/Ng4+cm0h2MZacjla3P
+hCnTQf3WOnaaQ+gO4sXJOV0Dj3IHWszr8HppfXHdH701saNedYqYVCluPo2D1bKEZOf3sLrRQGAKkLLl
+RhfFddtn1V4x3aNoLdbB50Y/esBFunbdDnUnwj1OYwcKMIihgKFlGLD/eonQ2cZRNHud7SnU4A19UgBv
+qshIJzxXpWJInNGnMEjS2uU0ppK73gv5MTuSRa99L5G+2yJy4mnzBNn2482c9cOu64aOGh+HKvErFbXz
+nGoaUN2WKffFUJ0xevhsz04HTowLPD4u1IlyoQ+Ti1JU2e/iQtpcXWAUoYsk2soHjI4A0Qjl02Evf+ES
+dmECKqinQ33Rxlu4aGMsXMjIGxfyhL+oDtEuqy+uxQPWey0e9qWoKng47C+s8MmOZQg8JLDuMuBbCcEJ
+x3om77t4HCpHfLGOaqNmbWB518XtyTLYi9uOFciEdrgQp1PqwhVTNdnMKXL1CHQ3b780jVYRwIQ2HpUM
+R2UHjxoCiK5oW6F1Bc8gqdeBgLqxGEFxqB84UdJLh33fDFY5d+whXBWdwhgNJCBAhgP/NEorl9BI7bwU
+lK1mZAyiBB3u8n/e8GS+EEGnekF8LxmMFNX2Rn7FCvCmgmRXDSynbRFLHemDUVKgt3BDDoDkQhb7L998
+feqxD3lfYJfVV8eKiEUJoifKmCE64tPX333zPVRYElnxm7LYvcPiSI2J+/ryfrd1iHIzmZBHHef62PoA
+HKHvMCXarr54eM9XcPPzHKyyFGVZlJZdVDnFFM95m9/ybZZc/PLN1/6FM8ZoHzgNdzDa8Gr55JLew2MZ
+XOWXK/qgiFK5g5XAqcl2fCWaUlSibtJsK1AC9fsHRVXX4mElcnKZtezpl1FPw2/QnF5tlohaBK4gj3HT
+3GmpBgkSjwMEQI1jJ3TGp0E5jFQGgjs4QGwtHSq0H3odMhx0KVTJEWMlnqTQiURqBHe11yJi2hNYXRSK
+pawRYYbv2LDwFN1WDustdUMHRh7xo0USaq2vJRN5XCTixx/eglvEIpeR9MYOc8YDX7pX89PZRR9c083N
+QZQP6hz/fsuz3OhX6snv+PSQGmZI49KWY0GO1vQo45qXkRdTNKKJLCdPiaJ+XYf0VE0rUWZ8m/0+5M9P
+zainGHIqoxwHQTtpO2mggiEfuVKZRekqqNCzaku17Ace2CS14rYeidZ5HQ6SBYDTMeSH6YI5VsysrPIc
+X1/6wVztdwXMHX4SUFcPUesTV36Ntfv3kYlJAdyi7uBsqLIZaN1ATlJhoscri0kg+WTxAJ/sEQbiyyuO
+CgLZ+sK/j6gDkWAgxCDxB7LGw1mlu1WNkb+I2OV/PptdruiriF3+afr0ySV9HbFLLwzcJfmNhb+6y6eX
+9EvEN9OnAfHDi6t6+dQLf4Ual0/Jk8vVjr7REvGoONQN3+/h/6SqixKQ13Q8QbCrwNAIcBigs+YuSzD8
+3ZNL+ldV/K9fvm+++vLlazDL/grSri6vLi/p2wjMbv+Gf/8eMefppaNNhJ2nDqFfD2hCcYcsvo5Q7Mpq
++dtiw29sqVtXC+z0dDHKvtDWQtstg7lUTwuhy2nvC4JiosUhLA0F3IqcMbDBknC2DDyQhWg/aU3jPHWo
+tG7jYaJsorV1QAz+53rf8GoW28Z43/bxvfQHA1z0v0XtRKy8tfyeaVgV4XopNVwlNgrX2IZNHqkiG7ZW
+muDn9KQ3TZM2jQg3yyANRl7GNkQpEvmeDGkMN73W8GFD6MrbSMMvQjN9BK/szGAA2DQjgdYdrrtCCGjH
+/V3fCJGVU77h9+9EXWf5qpqmW14ray4TxjqWJ0zLMw5j8A7mCfBiwn2QuCfs8UgI2ILAxxa/WuEaRzMZ
+PsVy2Ph9dDYkhZQ8VzRjvB2cujg7TwEwILg2yQzvxZg7J+hJlE932U7d+pGz/IOo9kVeia8ET0TpOcrt
++eS9DH8E8JgQc56uMYY9KoTDX4naII5LZlZDkEVUCn6NFtPhbJnlFzFJsVt4+rSBt2LJsshknCYY260o
+0QhLgJxkDB+WYHkpVI0rtIQX5Ij23ytowSiVpCM5dNdtu5ISGofpsp3Yf0SDXsuA/QaAfm1PqtpXOAPX
+4XyJk7DCU9PqKtmEq76MvzOU1XKRQiR7uRxa0gnTiHFXce7fZGKbgOkZhpMYSF+yiGCEDYzICF18gycb
+sCKYnYC3Cz0EtNZNqdU8NCuhJIUlMTSVg7H1M9fNUE8HlmXFNmGGy5CC/WDoPMVHOloRK0AUshWFfSNc
+h/OlcnfXVrGGlTS14BsByS8Y0wQrDC7lwx+0rkHnkJCHXpuVhFqJBVgrzAnNr1yXh069Lou7ylmSiK08
+TQUCSS/fFZ2+NSdkVfNa+B1KnOKPvwq2vvNtcSGXEI6ei7QsdgCOY+eiLmAWjsdjt57qgLcch8LU+9HR
+8jfJ4zq7Ff6MbnlVf1MkWZqJBK1Ka47WpTaa8R8P5dZX5w5FXojz1y/fOzSrvi5ivvXfaBIkkh5M4mJL
+qIwthAEnywL6gfFWAK9UD3msokTDppaRj4GDlMm71OX95O7ubpIW5W5yKLeSPk0WwBooK1GzH9+/mfyX
+Q+EOt6+Vdd7fIxl9RF6D9kCMOjJagUyBR4fe77a9lnZbemFuTnRTFXk3A6SoHBt+y1UUmaPue+U/Qp2X
+V9H9bnsVXcomL68i+L2U9V1eRfB7FV0eaXcPycKOTvzlm68dNQqdBF61dbd02t/effet7IHazTAD2EXH
+l9dBeRm8wDHDRMtXqMXxobS8PqpkGLjfXlWP1DpU5MrrpboHx55HAxuHM2qBUfBd5OHR1T2tQDDifxd5
+3VQMHAMJbdSkbyLvbUQw8X3J82pflDUk/k0l9iyPh3jwJ3EPYzwfe9gV3KQo8Ybp1GEPZl4xiAqKqRp2
+0xT0pn1FUUPrHGmvLkIQ529PfMUtoVXHMJ7WrJy+4tsteMcEO7c8Fhc7sStKcGJxYMUUNu2heoXR+h+P
+9BZQ/x38uWeODE4rEoc+sMdS8OThHW7xGT05KwecUsFRIQ+1NXlcgy8hrTjxZSStUleErMPoND4Gi8BJ
+RMTWIe99OXZuA5G8DURH6M/L7bbbpWrgUnWtNFdR0viD9DN0MgALqGQ712gudXfSm4GkpuH0FuxUlHrG
+kQKLrswS8Y0iNfyz4rdrdMehSRLGdQ3tEg3PMnqFuCYP2kqZhw9qWW0DtkjecQ/ARwsPaL8SRstlN5wL
+jwDoB5QUm+beCuUqppgRRK8vvZlWRTnilb0yrgYegL14KLfMA34jPjaNwucEdNPN5eqriFpIfOxcXjpQ
+FuUC8XQn6nWRgIGNDChXmBSZhRbmlK+Y177gHYCcv184zlLpZhTTuCyq6nWx41lOHjfDVyE4QjfyNoSD
+oepF/tBOJezr3njGcJEqqnrENr0PG0xXp/Lv5LFbD6C+LFWjQhFSe6y57sl1QedTBZhmQ8hXmFMQUcPi
+8i0h9FvAebSgMX2AmHk65O1iqyVw0KI8UunWdWfouUae4uOx66pMhkXtIDareVm36yd/ug6taYE6Uyqo
+0Oiv6iCXWQlV82vA4xVqbnQKBWcmBHroFVPrjEd9plbO+tEDH33xqNmTP6YfX0TUGTtwd8xVDyVZrI0S
+CTXTn46ZdxsZ7+CO6/hO4JCxWgelaS7foFjM47XQYXJTlrbxDCPqPJk7IBQdrND5jTnjQzQeX4xzvd9S
++ZilmsRCtww2zYXk9cO0jwQ952060Xkm77I8Fg49KYkym5qvPlTJt0UuwBFdvHba3IRQC5DbxXTdzoop
+KV5sp5HhljoXNNqphdChAi+RenNslIH3pGKq6Lqw+2UZnP0yVjeFbnLg0Atn/Pdo7CwubthsOps7wL71
+22rQpQLemXeAkovpWh5XZKC/O2o+hzsZ17CYykhF70SeuG7nVapB7OkDLQiTk3httEceFM7GSu6Zg28O
+lRFri9b7BX2QHjKKqSLkIQU9YhSSKU/AeT6QRQpv4Bn/MG3pAzYHXHHTwwoYcil8oMWyg2mKKRLmsAK1
+NOKDKIpexs656tPjcFR24MqaskRi6WswlxbTCi4dt/QlaZErnpR4Qbr4ffHSm8zp7+QotTLxDW47hvpz
+LN30l5JPYxg3SMDd0jt6z+LFddN418DxyVy3Z5GYwXQp8fCKrQEb0c5kRS9mwWf+jG5Y9II9m81cN3r+
+6WzWNJ/OPgNrKQqrfMu+j7yCPqCblFv2D3i5pQ/o6iTwelv9jj0MMTO+5lVtNrdD6N0QVmB3hJ4pD5vY
+FFM7mt0RQp/JjjaNA3xHuFBLRB7cMycv1JZ0fDUemVrvdEd8757dIr0i6I7dSvyYs1sJbHTDRrnEuPcU
+LN7vmwagV11TI1RPj8CLFqGa6mGRecTwQCAluQeCg26CquNLZ0/DHb2nD0viV7YznT0A6j3Nl22lQH55
+B6CZ1WJ2QHwTSCBXV18f376UfQSYp5tg50N1Nbr6sxpZEqjJ6+2WV2o3mh0zmehzt2nOnLoFatxpCvkB
+iWK4dp2zUiu1qQ+NqYO3NIJl3uFlc5ge1mXUHERtgNOOGtxK1A519kVVn3qB78vEOxbuPW4v6sCiklaC
+zs71xUpem1qnO3hf5FQphGl07At8BIdTcl189BXSFxqBHr/qvg4yPKQip9q0m5LsCNOcnguKh7nFc5gb
+rsScau4ManL05El3Jd9DjLmzDn6VcgXuwJ6zGY8zSw0Z2J6oD6zcK58EqiYQAHtGrNhyOlvX2KgTflLX
+TKNhwRR8V5c8LuNxKvpZhuXkbCC1jWZsghGqcH/yRgJz8jbPh6Ma/wGbAcs8xFTVNQ4hHzYSMHYAoH2s
++S9ea94fxFO1biBri6yIikT2flB/uNPvxXmFnLjbfWgl6uqbE182dchPGrOrlcvqcTLNi9pzoiJ5cE5j
+3LaWNCbIoZbh6dDlIFbQPsaUweq+EoekqLTnxdMujHoZMRwVQAnugKFPQ5WMPD6VQXLQv3/T6FcZ/uUD
+USGwmY4I435d2m4EgGiwnMzz6S/ffP1VXe8VNabohwgiaCI354eIPc7QMcL82bNP/Wezz470XcROG/HI
+AmirsmKj0TvQa7/L6vWrUiQirzO+rUDX5l1ECyzI3kWYTXXW0CHeiR4HTSQpCDU3DVQ8ijp3WM2S7YTq
+tbVsWaS6B1xrDNDoSUU8GuHdNpJ0GbxVApVF0RCxqu6KMgEMcL8uJTuxlQXYiWuwDLYSgPcfGeaG666n
+fcbIUJrXFiG0M0KQiTm/TNQCiWQCsOqADf5gOnO6K6qce2K3BVmfUt4riqE2F/HQWWBBToxcP4hKV+Tb
+gssHpE7wCWlVfEKSD8kcqWuGaoZUkeEYinqtKXRfkTeYeuL2a60okiD1ZlTlJH7q6XS6togf+PBDFOqk
+ZdPox042yZTFaCNrI3FRt2f8QgZ0hqyc4r4OHqMs5+WD3yYf/Uco7HczHul6Osiv8wgaSZiZjMEvpDWf
+sadH25o+mzkOzBNL/MH5thYNCNG1RYS77rnbRgxenRC/xtC+XC15yVjL+0XUuchKKat07qSvHZkKbCOv
+HdnxhM2GzcSetkbpsKW9rmN5awegHwR9IE0l7cGk2LfDVjYCC5nFd3piBXrRkTycSRfxbjD9ftJ+6Qgo
+VGuXV5EX+FBrAxmJTEapREeUgEIBVc3wUS9pqC9BK4SDhHhosgwJ1jlzTaxnyWaREwdPMF30dFKlixUg
+7trJbJHxYBsowuwwEZVNz+I8JgbSwnkua3vhEKnV86jETT5XS/pKvtOqjH0OqPlIpkXuObBFLtQ1qIuk
+Iq04qFXtKTgZ8yykIq9nn80+wyNMvsJQE2Q2dJzeQDQ68iGIVUow7yNQE/sR1F4YuQq8gLnNE9JcBVIl
+0YJHuGrsfSdWUgkpZ9prIcVpjJP3kdTvbhpjETF2fpNMrw5hzFHpgg/CBbSBoqi9QzuWJ72QXdEUM2kn
+aT8qjhueiSRwDuXW8TUibCU/iqeFzMfof8t8bNuEWsFpOPxKK2TgH8hRYPDfjkaHmgqhx6BntKel1/1I
+gl4CaPB1U+g6iEChBf4YtuSPki05FsTvTRlOlcX91FOnGZYqu9QBxEO91Q9QW0vKDJfsVH6zalVDQS3i
+jlcXeVFfACwhT2MVzpZH2p0YJi+36P5agNRDdGpeMaOMfaTJgCdpgz7SANWoW8ciniC+rA5VKXHw3amP
+e1P5PpJ6RoJgRLrOyoDJXeqt8IK1YmkbkEIjHFTXlnIJiEStr3JswNtVBuwD+IiwNlDIA7iES0Drb8cK
+cf0cwPLF80v5Y7849JlEpOY+oEjsI9pFoDgX6+gzHSwj69YHpILZcLkYNCNX4tTRnLQiVDtM69BsgOfa
+Pzh+DHbVk/NEvBLwRUp2EqNoje80OsWPghA/YmA4yF7p2P90xcAnLGh8BWG/CXDFTjDO4o10TR/RFQLD
+yoSwK70VaXF4Od2JciU8qM6+h2nuAdJKZz3R0JWMugEKU5YLgdbluDRYS8BZgI6xC7EIOdNvMwrvfVXf
+rqjbjwa85kbIvnG+/+7de9iaxtTBdSV67vBSAFX22ClSeUJpkZCe836IrN/uXLqaQm4vCeBITbJbOE/V
+TdyCSo8Tgqa7XiKvzXq7x67bZVOtTq7GHf+KadOEvEPV0ojyJTma23HLDGtlYrTl1NEem89mGHZYibTl
+ni8/amHSvfLjCcfJwEVdOUpMhvlcq1LsPePFs8NJkVk4Hj7Ssb7e/rRUl3E00vgOH/0PQKVWhbBiAKu4
+1Q6hWwaYlu5AacCpYBPHOmiHDtChczNHB/oHfSu2Vb3wpK9dVTXyRmnWJmwxeCHdMK8Nkq3id6TZPfqJ
+Y9cE5XiZfX4f6gJNPIHrnrCt6YWHESAxvr8ArCHSGiIcn0bqFXZSBkknWwsZ2pF2Zh53/ACvgc+t7Mun
+6CfV28GvfJus4e941WaBjmAeeFDvkzX+wOHrgB3PCu3sA7joZ/lKt7sj/hZna0eOPTZl0V9c8iEXCy31
+jew5/wyPTq3b1ACP9gsbaXtj4yfGtjFrVR7P8X5godJz0SXRo2w3Sj2w+RL1onA2HhSWzSZ9BN/IuNxj
+Md3zlfin7PMknsZY+/tiT2GS/UTNNeb6pZfrawxt6WNtM5kfFJ1MAPduxAo1auOxlBpjO5qwbh1amdjA
+sgT82N5kQcTic9Pie8osQC7K95J3CLNlpXqEfuFBpHYqMTVBFV9uvhLdLTlRau9hfhmn832xN3G7SWe6
+TvPCVLWZj0QugYR6rH9ihihDbb0v9la9CvJl9f2sULesFqznrRH/W1YZJ/OlOeGua6GwAWyHfPFOQdUW
+b5qSH7tOek3UOd+xIMqhJkqd71jweMYkr5MFcPnixLtu8oHQom1s+oXFh1cWtZwEKeP+5/1oTynj9hay
+oxUHaQBemn3QxUfddi8NUhN3z4uD1N49vqBxIPzU3ncEy4IyNDo2PkFFPb/McPziCfDhEMUYKnef3Yvt
+92qxaNeY07ZxVbHRI0J1UPiYyPtCe0aE0RLD2PrxkViLqqKXm/jJCOc6nv+pH2RZyES9RbLdGXPNevFB
+WcZ3ikONyceea11c6WTJuqwIZAqz/rwBBW45mzZ0u4CTNkZ/qDICQIq/gdpPjq82bUtl9oEIcPkHgCgi
+AWrptyevHA4JotAMeOm3iLqPsUNHYljM9jmSKxoWIcL1KYY34X4ivBXhTbTYbqE8TTtvOoMO1y8zdN/a
+xgnxLUCXux8GvyYmGCW8CvDDBTeBQPhaWYAcFZRYRy9Y9p+T52qajytrcBqjVOikSK9AmpoSEE1BuUL4
+SPwESVqqOAiH/EwpUwa9rPaAK7Cad54+dYhvEiIKPs6fPnVorLbuutgmPwC7tkOxcnSxypOHn3lWj8e+
+esMoCLTU9mGsYy2mL6kgGWeWVnJpjNjYF9TRrbSXGelA2HXl75TvEv3sOVIRF+ITDES2LhX58lPE+HTz
+D8hJf4bnJy3g58WrIk+3WVyzIXJ7+gRODiR+n7CfIxkmQdVlvqjXnyJCyyN4+2rToFgJyWTxH/+/AQAp
+/xMUg1IBAA==
 `,
 	},
 
@@ -1474,129 +1469,129 @@ ExSDUgEA
 		size:    24632,
 		modtime: 1566640112,
 		compressed: `
-H4sIAAAAAAAC/+x8a5PbNrLoX5FYuywgajHS5PjsuaQxU7bjvZsqJ5OKnYevStfFEaERHIpQQGhmZIn/
-/RaeBCVqPN7c/XDq7JcZ4tVoNBr9QkPD5bZaSMYrJIHifeSKESFyt6F8OSjoklU0js3/JF8XV+YTUZxG
-/OYjXci2N33YcCHrqzUvtiVNbJFQJOgfWyYo2BowHXAqk3dUrqggFOEGyRWrIUQJON5H25oOainYQkaZ
-axxYjNkSDZEcsKqWebXQKGAsV4LfDyp6P3i329DXQnCBold5VXE5WORlOcgHizKv60FeD/KBXzNuPHSG
-JN7f5WJAiUxuqXzJt1XBqttXJaOV/IkuJMLAyb7Jllwg1bEasGpAMZ9Vc0Jn1TxjSyQTfl9R8S1fbNe0
-kkNCCvtpgAty1CMp6DLflvIXRu+TpcjX9HVJVYOCJsygmjAkcMYTyTcjUqt/wJMbLiVft+WSLqUqqf/A
-E8FuV77cNILKragGvF2waBd8S+Urvt5sJS3eyl1JkcSHw74BTmiy4TVT/aEiM73EaMkeaBERQjg2UGdy
-7onCiMwQIyzZ5IJW8gdeUBzHLI6nhBCWVLygaocyR447zorBJJNitxeneDDcLHK5WKEa7xs197YyrFi0
-DCgOh2pbloQQYfEZVMlmW68Qw1BlapqcCFiSPOF3VCxLfg9lUPgNVkHpffY1yreSH+qF4GWJv04krSVa
-jlajEscxivKbmpdbSaMhIfxwmEWClrlkdzSCtg0skeYJqwr6cL1EwhMSX5IJjmOPY9NF+pg/bnixw3Ce
-rzykRxgLQ9Xue43w/kUcF+EEiaBrfkdfrVhZoBcYXhBF0nZM3vKK2zDSonCFqP8G6T8T92E5Gqf0mPv1
-7iguO+oKFVHnEQT5EeHME0jyzZgIze+V5mtV0vzexxhVcs8KuYpjZL9Id81mh39VLWMLrjLHBp+Bt6Kq
-VQM0n70Q/6Gbxgbdyp5TDLpMTC1PFlqsvPMrIRYD1/JGrcqiQ6gBb1ocwvcB4uCm6Xb1mKwClEJeWKp9
-teSVCV8uayp/1Af3cDi3je3oEhmmCMiwEDSXToShqGB3Ec5kUqvTbDchmk4mf43AVVpCRheTyeYhygyT
-PQ5vhagZDHt3qNLg7Em+SSegyJJOYMNZJal4fUcrWadRxSsawR2r2Q0rmdyl0YoVBa0i0MilFg0wWKXR
-9JkuOunguzcYaJJvNrQqzKGRGLq8EDZSbBnd0VjvYWZX4WUPiQwDGSpUR705IaRSvEdoyAnH84Yn2c7L
-CB9X9hTtzTqZWyFr2v1c+f3Mxa0GqbRHdStXz8nkcDAnn5C2dTaZX+2bNCwDVWrCstQLIfJdshFccnWG
-tJxShCl3iIIfpUhZl2xB0RQnSy5e54sV8iaB0fgUOwXDjdZF+wYnq7y+vq9+FHxDhdwlStEjZUDEMZIz
-rrQyn+MGQ8CzW29EBEd86I64TLSV8IbVEtOk3pRMomgQ9aNFEynYGuE4DoZZ+iOKG5zRsqZ7s/PKNPmJ
-3r5+2KAI/d/DAEejzgQfOatQdIjwKEKDw19wBNEtizBUZIEkTgTdlPmCIg6qd3aLJFQ42LriX72svCiC
-NRkqWqZWCI4UvBE1mPEQs82TMfNyqJ11wSuZs6pGncm8QuihaZd8RnnzwMxbdCSenumHfE1Dc/IxC+3t
-L//7RcXWubZQBKturwIgyU1e01/yMg3q2plvDSFkUlP5QkrBbraSokj3jIAGOK6dHcxPN4jj/ViZUtRb
-FordN5rqcbw11Fcnqm9rzV4cDoZbmmDKf61tfdfygG/2LEDjWBkbQ0Jo/1RvtxsqlJ8haF1r+mxrOaBM
-ORGDGzpQowdcBHPDQOEWjdwMSgV5KUSutQdjVQuicUzbRtgveFVLsV1ILtL9XV5uaSqBVts1FflNSdPh
-FO4Fk+Z7AgteLdnt1rZNGkX7OEZ2DqVOHejr5VVvraZNKpMPHzQWHz6QkBd2hnSG+U/F8kWvWL6YX03T
-sOhOjBzxS6JWfEnkOPAFbpA7FH2HdEPFkou14og4/kyHpOL3V0dlhNOR2tNvcxmch3uE906oS7IPtXYD
-9GStwIlWJ4gqoTjJ6GWVVaORcb/atVZ+rbz3CDiDUxtChGrrKYtqfZZbU4/HMeJkk4ua/r3kuUQcphOM
-4aSjUchBx8p0lMZX4yCtX1Z11dArs62W5sdQzaA4Hk+HhJiCP+7RXyOt34zVGMxsatT0X08nk6+sudWD
-s8bNA5d8cwpbWaod0IpcLWRjO3QWdN1dkLZjjCWpPEV6pUz/sFbZCml0z6qC3+secYwomW3yW/rbtbZ6
-QH2/t9+sqqjQ9s4o7KKrjZU7CrrPMVASukiInvoYHSO/lUbeRY3jNlTi7CIKnOSGAzmwU3+VYuUhUzLz
-ZrnxzfVWjCrnmxv6jXTrHP5UzCCjs8l8REQSkoXOpr7uva+76On3zXG/5i+nx0bplL0kcjaZJ5L/vNlQ
-8SqvKcIjmdTbm1oKNMUQveMbtZPycIiU96K/r5QJNgp5ic2iGy4KKqKRHEV6S6M5TlW/8ef7NbhBGGij
-6PKaBPvjGVEaRvTmIplk/Dm1QiTjo5EhaqWNw6xKWtFOwsLhMJxClYTinQwnEGmNELHKnPzEqQIynGCw
-4t3wlLNKlaWW/E532l6zoqkVSMCh8vYIj2OJQmXEMVS6Diq1aLX0B+eA93moD3GMHsjeRNrqdDZvjNlk
-3Hl4R04YepIdo+SwGY2knvAt2Tfw4+nIF5k8HNBnXD84tnaKXOZjqeN/Y1ZE8A5hDCsknT935LudOHfN
-Y26WxPCCSJwFUbzzUzsrso+Ob2d0HsdI/TNxiO9RsP6CllRS3anBGPR/+JXM5vB9SyaJ97/auAxu4EOH
-Vb3S87GUX5MN3yCcYYlwA7+fYWy8r0y8VPqg0WskYbb/ne7SiFcRGHvlOJxq+P3UePim13j4Zn41DK2H
-b+a9vKYQSW6YjpHWSmmEZbJv+mMonV4zOT8eOJNzMptjOK40pNyv8qooqUgpLORDyoFXC5pWDW4acERY
-0HNk0DBdEYaTYNRy2TfovNfSXftnu8zkHJ+JXlJs2el4gHa0Bl0xdkIVK9XwccOMzxNLK619TwYqH1C7
-k1OcjkbcU0IKdntLxSk1nkqLk3V7dqdkAqcmLFTWrOOX0ys+nqYTDIxMM37JMjYa4WrGxtPQwGMm1pzR
-89RwWvOYKHQONRGOMJATkSzkAyyJSBTfQEnyXlYv4xiVGhyG2sYvSiWSl2cJSw1hadM0jWLnBuHsIflZ
-srImeyWXFnKbl6d3DCmDWyrfhoZSKsBdR9Rprr6vgzhdugT6IGlVpCvIi+KVcsTSAmwUSJe2sMpr87mB
-7abIpWmgdbqGgi6pSL+HZbmtV+kH2Fbsjy39rkjfgY6Z0SL9vUXpZS7esk80Le0EakFW4tdp3WjJ+/Ix
-tWx4eTaHSmlTRoZT6NwBOGapISdy9na3vuFlwiQVueRijnA2RBVBNcmTij5IhHFS8Ioqo5UbEVEnmnMx
-DOnhwC1LaN8yU1PizF4oLPGeKRQEWTZLVuVludsrBIZVHOezyOqGeaeAsO/KlohZb1UElys9+l0fHBMI
-Y7XzYFysgypz8WiVA1YNjCURdJQaVNbrH39X3eUlKwa5lHS9kQPJBwU1PuxW0EHFq7GGfVO2YY4Ia+X+
-P9KE+kSONYO/0SPDSVZZAcKIBEEo1IQr1pmCvWVicYwY+bsF0U6F7VWTXYI6p21s8ltaLwTbSC4QA6G9
-BMP1Q0JyI1s9WXK36bnhZQ13SXIFsh1ICFnajt6GMxHQGmvzuAwwCcMNTM9uF1M6EJKUQIkATmpzNnNS
-2oPZNNkZ5fUQBGwsM77nWxOfYdWi3BZKr9HBVsm95GM9WLKSDm7okgs6MKZY8rGODOU+EisgQZCPybEU
-hNxUGjkIS1MKJSGsyMfEyEIoyMfESUPYko9JIA9hTT4mHTEI35OPiRaE8IF8TLQohDJEwso9qD2oUPLB
-+/AYnaGV9+msNImy1g583IamZBZJkVf1kot1BNGv9OZ3Jt8FNddh4Xv+KSyu67Y0h6OzPBrxzlkO2dLa
-5LNq7q9V9fF5o8T3t+GK3/S4jHgv/Y0nGk5xg+EDwk126lNr14Saf9z8q0hwLf9YZEqHsqb/eYUo+T6X
-q2TNKkTH0/+Ei2cTDGopiJOayndsTflWIqYbME51U685E8c3CI/l8+nkcEAmMMnjGC1KmgsHhmOLKQZJ
-bhCGb5VTSvRAjJusD7AJdfQbi6ZNcazWuW9YLWlFRRwr3VOzTzQCdzUEkeTbxUqxYDTvpfsZYObGQLnP
-+rT9RvYLpd5FGpn/kfG6In3jGIEw91+qLmrg72S/ZkVR0jQy/80tW2QuHSMw/9NI8k3UwD+60TxwQ59N
-/hqBm1UX3DhzJWjn1IUGfiBdW9zdoJnAiolcOR8u30quMxJ03O63mYnrzTH4FhOo+/tMR/7mGPYaN66X
-UTUN/Nxx3ZwHaeI13bl69u8fM9N1riNNvtQfYVLNCgeNqis4hKhGSNnjv/Qi1N7dACcvEYULHYuaTebA
-CJ9NXfRTb0BlNoA1DfxEfoE/OhD9CaMOPtcmbmZ9TIrhE+rXIIF+xRAFAfNI+23YqCFjL2sD2QkC0ilp
-u9l2e2N95XbMitWSi50SNrpcU3m9UcNqJGE4xfCQ2DhH7ynov2xiFZMsL9knGsdhyWCsb046KCLvaN8h
-ChLDa0Sdv31L5Stzd3PkK/0Tl6hRdHyJapxVs2Bzm0Tr7HFBOJPzq75hMzlPT+t/FHTJHq7ONYyicTSS
-qfSeYUv/PufQ8qdinL5rimnvwqfzq+EkDctQkb25806jyWASgcyFV/OdqhdS5ouVGpfqMz7QBx2CFaSR
-sTCiJgsXSVaoAukuxsMWqAlLqE1AyQlLzEyw9J/f84ItGRU600uNtL1JbdjG9CJ5WHJjyBKiO0bvN1yY
-0Gjb5QqFozvxrV5Ikc5fKGmEU6sWxsanPYLrgitPB9yFhmEW2RUqvaP7nlE656IbMzk/tRFN/p8ppYOX
-XK4GdppBXhUDM5GxIm/owAHFvWpVzZB8/GNLxe5KfRP1R98tHF97UBNu0p08KfTAt7SkSnzpNqyEQNHZ
-XkMrd96Rpwm20sIdn7wo3mncrV05JGQ4jeMi3IVjWJaqGMOwC8vz9+fo5y3ujeB3rKCDvBq0oyOcBbO3
-p4b8hDrzHbfbpeVnB+THXc25Jb90u5laHLLbdW/HsK1fcRo1EDoFNtxUsFo5kAjDo8fBsfnVKSAyC/pZ
-adntIMJNPNp3WqnpC7vdhnN0ldIoTn7eUmmYw3gwPVrjzBnqWYF3EDtIaS/NiYb+petJQplAulIBB9ZD
-eHmk7Yjw5shlD7X3bzaBqr2oM9EgSbpIAiV7O1jaezA7UppbMm0AmQtHPauxpRrn4/qEsdbed2AcvDEK
-UB9rUBjDkwaaa9teQKNgrbgXXkiM9o7UfY3JBbgUuAAFu2abzYcCGo/NyvVUnxtmb5n7wIzaHcF9sNpm
-8FfG9sOgLPnmeUAHJULt/bCvUyMUAs+DeXU/e0ftKzFQncb7lGPqzChjOAAP++hkuA7nXiF+NvEUJNmf
-cPAxiz+Fo3Gq2Jljm593cv3LsbLCw8TSSx7m6h0Os6pNQm4/f2vzhJ2fhy+VtRgseXi0YBBkkrE4RoJM
-nxmMauI5N8xISMw96ju+MVzQ1/hSO2K2XahDahY/feYIk/yvvz37qv4K1V/zTp4rDo+p58CeKd7Qpc1Y
-HE+fNbAkk+w/Jv916YR4v0CKY7Qk4+mUjp99pVl2w+9RDRd4nEwmf7v421f16OIiefZfHQUzPAVydFrz
-h/b0X/wHNuQrQw6za3zHN1+j7oLHNW6FkToI5VeoHvtjvsQjI0Ue2wJ4fMmfwRZo49WKjku8yhcr2qNR
-9CwfFqqV7IMxvd3D/I1zasjAcnalh/zIXZ/pE9z0+QpCAz/RUsQ3elyNIj3rYxlX9UtcrY7HMZlnf9qC
-s9pfmXCfMRvbjoGNUJDhBE4Njv7UTJOxYxi0kxISx/Q04uNjRm0oDpv8tGMn11HbWlKPkjvbPpEa23+O
-GtMvM/6eQDUbsn0KcVpK0FoKvnucEkfWZ08cVF8vUKXg4vhNeOvaYFCs+cYybBzXwTaY6LQxxi0n9h/X
-IGwjibT6orXMgRLaUSKtfW9V2MxE+EBH7sDH81wU0Ef7XJBwnp3dnA95UXRQtht0Uu+XfKbZUsmeinPD
-H5M5p5ig/gYym3ciAScdMpssx9r41KkjODYUV8xswiba5ASXxfeFY7XRZ+yuc2PNZh4PpWZa+ui054bq
-WTNzcT6bZ1Wvly8MSP6EZWDo6dw/uVSHoZNh08djvHdb14h7AdPTAwQG/jnprmBYYXYGhE3R6GkNRYYT
-I/9CZeXjTkZYWg3f2gDIpcn/gHpP/bFTb4MDPfIGHYsSb/WaKfVsfvdvrE97kkWmXFS3QWqbGbEPl0AQ
-9yxJrWpiLlbVP/FIQk2Z1/It+0Tdu8hOZcZI7YHXFnij02g6/cjxGxhhXNVVZ2mWV8+vTIfFQ5ceqQVu
-yQoK8gr9fExADKfTYtjorhzDFsOCvEJBJKW3/63rE4ZLYIuzgtyjAhYK4j3awC3214trsjK2+ca8MCvM
-rcYdWRkzVT9IK7TDvSOTbPe8Daz7W8KdIfgN8W2z3RyuyU0bzPe2nL3JWGsP6+40XMtt1Y+8TldgeUMV
-KrCh36Ib9t3AOq+2eWmLC1t8F/a5BaPOb2yCyltoKZ8e7USjIyXXRJ1+G/AYTnv12nUcn7x3vo5jdEeu
-Nb3W5NoITpuPulcOZbo3CzdUaMAFfW39OHQ67f1XGK+4G4sw1GDBjEPX1dyThaGC9ZgFfn7TwIuOL9Mx
-FeEdeRFmEMNbl4DjXm2dPI+8fJe00OMYve2cFE/5sbmqLDG8Ttyq7bvEMXnrIyIvTh9Lugn+4R5YfskM
-woRX3rroBdIPZGZRBFEtc8kWwTNcN7d+d+ftvsPh80PMe2YbUTgGgOMYvdZp1O4dZs8i1daC7WXedZ5S
-Wu3kI9FPH1XdSLZmn3JdsoZUb1uiDV+DtFV3T4wxnqa/UyKPhKM5r4YyfSJyiZy3okQjf3T8ozqEqvFV
-X749MMJBkL3OUZnpHHQw6ecQvXTW7E/amu2/uRD6BvYNv3dJ7fPOc44zWejugX3fFpqn+GNms/6F6elf
-7Peyhn7bP2buZYCwfR27SL65JOb9vzCGYYfbVJv58g0KBVWtJb+wJmHIfKrRPHa2upS2IQ8ofUmRMnvt
-gvtahLU4GbRH9Vi08dpgfkuJUTkW9lcIGmS9Pf1G8nX3qjfZVvWKLeVJvVFDl99YRnfVOmVaebMfEIbh
-pI1v6OSIRy8ps8eSWa1KCn/BwDpaxz/+IPFePz8K6pmun1VB4qtQPm1NJln9vGdZ2WhUG/g5CZtn9fwc
-lvmsmsfxcIfUx4zNQZr/GO8FGU6yG0Hz35tGHA6Imxais51NkEvvYeTSPXzWRZuMETWwJd3HH8by638v
-0yt3lKF3daYtud1s02pblmp9TEul7q9BQO1TQhVfXaFSx9kmIMwZwCkq3WFSlWPuH9kb90mN0KFnBcow
-oR5jjqyqHDvmh3L2fk5MflWZS/obikY66iaUOEI1HkWbBzzw7e+77cK0H+VZDQl5H8dIgR6RqB38f9AE
-R9iapHppZmXmGEWbhyj1C/M/8KGq3brssuy5sgPMqtwvfqjKBgoynCryIu4P6+HAQ5lhc2bdWfWthiyK
-gj5rI3hbv7VjQOp/GKeIt5rYznKk+81MvtLN1tXfRzOan8/Q07l+IP0nxmm/i8jt1YP7MrLSlzQNQ7XW
-u0jr59AvVna0VXaZKjm/Z6jTjzoeLu2RMt2n+340VN3fEgjdKUUf6y1If9VakOGkQZrh+7dQS4ChTc8a
-Thq/mTAsWrG1IcMJLMgZkZgtzE+6LIL3ctHL62/fK+ZfJDK//SFf08zcalqbakhONfgCt1bUfqO41kiv
-BVkEszWbwwGdwaRDtrCPCyzaEac/q9J9PBSONAb9Ldk3sFY4daR7aaTVHSmV7L3pUsjkTLJ5djMk5C6O
-0VqR8VbJ4DvcrI/YYNVup30BdasjCs0cA23Q7zj7wzlcZDaHh3ZDv9Vc+h1ZoT/gAcO/k+7/+yTdt4nO
-3ZzmIH/5sRTlvxwHbV2s1gZv51nrwJtXU/4d3dmMLWnfQ9uMR+ZvGYKAMVuiboqKSfzLWSVr50ZPsuDp
-zRfEiDpCTb/RMc7vkghjRbsY0dLEiOonx4jKoxjRkpQ2RlST0sWIVMftl0V/aG/0pyBbh/qGbO1EC5td
-0sZBN8rZLSJ8fCfCt0rNuFnx0eVUQO9eT8b5aHwrr5cGKZPwrvbXTKnLGY3jhWENioH7gk5/XPSC/gzH
-9V67WKjSBJiVWDMRxFslie7ICu0bYBh25uskMOnO4JdSQGhmVpIsjF6WhgS9z7vyOEY5iewzhJUzQrdO
-RLIlytv7+YG+mjdzLUgeZuSuyUu0gAucbcl6NpnDiqxn07kx91ZkS/LMBNGuEQWBM5/8RQjZHg6Kmiv9
-rSXr85vZdB7HmuKEkDtjyiA+IgXoAnHkx8BH9eXN7BvV3daFI8btCAUMY7WNtyY3Uk0Xx+h4lgDMztpQ
-Gp+rvvmBj0gNu2CG1MFzgz1+fIzqcYEviVmdQq4enwDcddeHoWdZZ6a4OlkvqDmOQabnVqggoYuvFJLP
-icFZrTlAUsPcdelpr8k6++S3JERUo3eKjd/tE6zQCXExhpBzVgHnrBTnVM9vZhMFq7SP8O+s94+qEdmA
-KRF7hjFUo+XlzexCDTBVnRHjYISGd8w9q/MzXrUAd9Yt68EAqhFZwq4zR+oh7R7BHapxMNKuJz2/nqsj
-qFc9y+uHebyQXsr0L8Tem3a3wWPYg1DPihx5e7A4nVKzh8tQfVSyPIHX4Awb25N7elA7U59lTdTLGY8R
-r7Piry+O1xxwMXoaUXthjzuwbde+n30pr0pSOtEPEU7W+aYbVXR3ROa3tBqcloSQ4US/JJ5ZNWp16rE2
-xVCS8nCYzbW6uFfq8hWZzTO3cahstZHepUsyuUJci1S4N1rXior0VacYbGcIxG2fh/PNfFx7SH5zPbB2
-u/2GBtDMlmhYFVHNHpLdLA+nZ/MCOJb8HtDFfLz0oNzeeFiuAu59esHpUzP/ewuPR7ICW+mqtzalfcbc
-rfu9B7jvNYhuTwwihOHVn0f32N67Ot/UQfzI2gzwf/V0/NF9z84fDvd9+6hEgD1cVnIOp10Ijp07AAL2
-NBCUzDHKWI/Xn0NCmIk/GdC6bEJNO9tOA4PQdN25rp0mHS7UWXh99+I7uNPZGeaHEpDN1YlgH1w47k5v
-Pe8afJLmQD+fmeBCSnALCwzr1lHSFQ02v5jCTSinaprm+C1t18V8xK/8/+I1/hm3TxB3/aEf4hjfaUmc
-i9T1taAkfCRgRapRDVsjHcnSX4KUl2Rp9NSsK2Xnj3hOy5mcZ4gSQqrDgVqdtfU/4qKkHVmaaGUcr9QM
-Rm/MuslTT5uCmynK7hR68wol8Tfqz+Kzrr4lZdGb65PfbKU0KXb9bp0dRvvGtKk5Wy+iNp+bZts7zeZJ
-0/zTR2MDRfdoqIrGXAnhf/8kxX+v6Jjj6C+RRuZH1qwY8jlSjoH0naK7wjptyXp+Af+0l349fFodZr0o
-QUyNIOYNdmmFNpgggmDC6Y8I4n1FqjCSUM2mc6L+HA7VbDK32f0V5OSlzn7PGMmVZSVIrow+1vnhNJhO
-lDQNqoSqMnEIZn6BWqdfaXK5bR8Rphx5cbQQpVLguwZn/y8AAP//R8Hs1zhgAAA=
+H4sIAAAAAAAC/+x8/ZPbNpbgvyKxZllA9MRInfXOHmm4y+54bqYqmU7FniQ+lc7FFiGJDkUoINTdisT/
+/erhgwQlqt2e3F7V1s0vEomPB+Dh4X2Dw+WuXKhclEQBp4fAvQaMqf2Wi+Ug48u85GFo/qN0k12bR8Jp
+HIi7T3yh2tb8cSukqq43ItsVPLKvjBPJf9vlkoMtAdOAxip6z9WaS8YJrYla5xX4UwJBD8Gu4oNKyXyh
+gsRVDuyM8yUZEjXIy0ql5UJPgVK1luJhUPKHwfv9lr+VUkgS3KRlKdRgkRbFIB0sirSqBmk1SAfNmmnd
+QM+Joof7VA44U9GKqzdiV2Z5ubopcl6qH/lCEQqCHepkKSTBhuUgLwecilk5Z3xWzpN8SVQkHkouvxWL
+3YaXashYZh8NcMlOWkQZX6a7Qv2U84doKdMNf1twrEBo0nSqWE4kTUSkxHbEKvwDEd0JpcSmfS/4UuEb
+/oOIZL5aN+91LbnayXIg2gXLdsErrm7EZrtTPHun9gUnih6PhxoE49FWVDm2h5LN9BKDZf7Is4AxJqiB
+OlPzBik5UwnJWR5tU8lL9XeRcRqGeRhOGWN5VIqM4w4lDh33Is8Gk0TJ/UGezyOn9SJVizWp6KHGsXel
+IcWsJUB5PJa7omCMSTufQRltd9Wa5BTKBIdJmYQlSyNxz+WyEA9QeC+/wNp7+5B8TdKdEsdqIUVR0K8j
+xStFlqP1qKBhSIL0rhLFTvFgyJg4HmeB5EWq8nseQFsHFknzKC8z/ni7JLJBJH3FJjQMmznW3Umf0sed
+yPYULtNVA+kJwqJQtvteEXp4HYaZP0Ak+Ubc85t1XmTkNYXXDFHa9klbWnEbxtopXBPePINqHiP3YCma
+xvyU+vXuIJWdNIWS4XkEyX4gNGkQpMR2zCT+QanpGt/wH/oIo4we8kytw5DYJ9Zds9nhn7FmbMGV5tjQ
+C/DWHGs1QPPYC/Gvumpsplvac0pBvzNTKqKFZivvm5UwOwNX8x2uyk6HcQPe1LgJP3gTBzdMt2kzk7U3
+JZ8WlrivFr0qEstlxdUP+uAej5e2se1dEEMUHhoWkqfKsTASZPl9QBMVVXia7SYE08nk3wJwhRaRwdVk
+sn0MEkNkT8NbE246w8Edqtg7e0ps4wkgWuIJbEVeKi7f3vNSVXFQipIHcJ9X+V1e5GofB+s8y3gZgJ5c
+bKcBZlZxMH2hXx13aJrXFHiUbre8zMyhURS6tOBXcmoJ3eFY72FiV9HwHhYYAjJYKE9aC8ZYibTHuE8J
+FC6fZDtuzsS4tKfoYNaZuxXmdbuf62Y/U7nSIFF6lCu1fskmx6M5+Yy1tbPJ/PpQx/47cBQTlqReS5nu
+o60USuAZ0nwKEVPsCYemF6KyKvIFJ1MaLYV8my7WpFEJjMTn1AkYYaQuOdQ0WqfV7UP5gxRbLtU+QkFP
+UIEIQ6JmAqWymNOagkezu0aJ8I740B1xFWkt4bu8UpRH1bbIFQkGQf+0eKRkviE0DL1uFv+E05omvKj4
+wew8qiY/8tXbxy0JyP8+Dmgw6gzwSeQlCY4BHQVkcPwTDSBY5QGFki2IopHk2yJdcCIAWycroqCk3tZl
+/9XLSrPMW5PBoiVqnOAI4Y24mZnwZ7Z99swaPtSOuhClSvOyIp3BGoHQg9Mu+ozwFp6at+hwPD3S39MN
+99XJpzS0dz/9z9dlvkm1hiLzcnXtAYnu0or/lBaxV9aOvDKIUFHF1WulZH63U5wEumUA3JvjxunB4nyD
+BD2MUZXijWaB5L7VWA/DncE+nqi+rTV7cTwaaqlp/f9It75vaaCpbkiAhyEqG0PGeP9Q73ZbLtHOkLyq
+NH52lRrwXK25HNzxAfYeCOmNDQOcWzByI6AIargQu9UWjBUthIchbyvhsBBlpeRuoYSMD/dpseOxAl7u
+NlymdwWPh1N4kLkyzxNYiHKZr3a2blIj7sOQ2DFQnDrQt8vr3lKNm1hFHz/qWXz8yHxa2BvUGeI/Z8tX
+vWz5an49jf1Xd2LUSLxiuOJXTI09W+COuEPRd0i3XC6F3CBFhOFnGkSleLg+eSc0HuGefpsq7zw8EHpw
+TF2xgy+1a+BnawXBtDghHJniJOGvyqQcjYz51a61bNYqeo+AUzi1IsS41p6SoNJnuVX1RBgSwbaprPhf
+CpEqImA6oRTOGhqB7DUsTUNlbDUBytplZVcM3ZhttTg/hWo6heF4OmTMvDTHPfi3QMs3XeqPbEpw+K+n
+k8lXVt3qmbOeWwNcie05bNRUO6ARXS1kozt0FnTbXZDWY4wmiZYiv+ZMRX4p6gpx8JCXmXjQLcKQcDbb
+piv+y63WegCfP9jnvCy51PrOyG+ii42WO/Kazylw5ptIhJ/bGBT6SJk3JmoYtq4SpxdxECw1FCggP7dX
+OUULmbNZo5Yb21xvxah0trnB30jXzuEP+QwSPpvMR0xGPlr4bNqUfWjKrnrafXParv7T+bFBmXJQTM0m
+80iJf2y3XN6kFSd0pKJqd1cpSaYUgvdiizupjscArRf9fI0q2MinpXwW3AmZcRmM1CjQWxrMaYztxp9v
+V9OaUOA14uUt8/bHPQ6UIcRGXWSTRLzklokkYjQySC21cpiUUcvamf9yPA6nUEY+e2fDCQRaIgR5aU5+
+5EQBG04oWPZuaMpppQSp4Fe+1/qaZU0tQwIBZaOPiDBUxBdGgkKpy6DERePSH50B3mehPoYheWQH42mr
+4tm8NmqTMefhPTsj6ElyOiU3m9FI6QHfsUMNP5z3fJ2o45F8xvSDU20nS1U6Vtr/N86zAN4TSmFNlLPn
+Tmy3M+OufsrMUui4UDTxvHiXh3ZaZB8e3834PAwJ/hk/xPfEW3/GC664blRTCvoffmazOXzfoknRw8/W
+L0Nr+Ngh1UboNb6Un6Ot2BKaUEVoDb9eIGx6KI2/VDVOo7dEwezwK9/HgSgDMPrKqTvV0Pu58vBNr/Lw
+zfx66GsP38x7aQ0nEt3l2kdaodDw39mh7vehdFrN1Py040zN2WxO4bTQoPKwTsus4DLmsFCPsQBRLnhc
+1rSuwSFhwS+hQcN0rzCceL2Wy75Ol62W7to/22Sm5vSC95JTS06nHbShNeiysTOsWK5GTytmYh5ZXGnp
+e9YRbUBtTk5pPBqJBhNK5qsVl+fYeC4uztbdkDtnEzhXYaG0ap14Nb0W42k8oZCzaSJe5Uk+GtFylo+n
+voKXG19zwi9jw0nNU6TwOVRMOsRAymS0UI+wZDJCukGvcC+pF2FICg2OQmX9FwWy5OVFxHKDWF7XdY3k
+XBOaPEb/UHlRsQPypYXapcV5jCHOYcXVO19RiiW4cEQVp/h86/np4iXwR8XLLF5DmmU3aIjFGVgvkH7b
+wTqtzOMWdtssVaaCV/EGMr7kMv4elsWuWscfYVfmv+3437L4PWifGc/iX9spvUnlu/x3Hhd2AFyQ5fhV
+XNWa8755SiwbWp7NoURpmrPhFDoxAEcsFaRMzd7tN3eiiHLFZaqEnBOaDEnJSMXSqOSPilAaZaLECAMR
+hkVUkaZcCkN+PApLEtq2THBImtiAwpIecpyCZMt6mZdpUewPOIFhGYbpLDDsNZh3XghtmuZLkltrVXrB
+lR75rg+OcYTllbNgnK+Do7p4skr0bxlNwmuoNKik1z7+W3mfFnk2SJXim60aKDHIuLFhd5IPSlGONey7
+onVzBFQL9/8vVajf2alkcCtFCklKy0BypkAyDhUTSDpTsFGmPAxJzv5iQbRDURtqskvAc9r6Jr/l1ULm
+WyUkyUFqK8FQ/ZCx1PDWBi2p2/TU0LKGi+GrFVdtR8bY0jZsdDjjAa2oVo8Lbya+uyHXo9vFFA6EYgVw
+JkGwypzNlBX2YNZ1ckF4PXoOG0uMH8TO+GfyclHsMpRrfLBDvhd9qgbLvOCDO74Ukg+MKhZ9qgKDuU/M
+MkiQ7FN0ygUhNYWGD8LSvPmcENbsU2R4IWTsU+S4IezYp8jjh7Bhn6IOG4Tv2adIM0L4yD5FmhVC4U/C
+8j2oGlA+54MP/jG6gKvGprPcJEhaPfBpHZqzWaBkWlboUwkg+Jnf/Zqr917Jrf/yvfjdf91U7dscTs7y
+aCQ6Z9knS6uTz8p5E1bVx+c7ZN/f+iv+rsdkREen09/JcIo+yY+E1sm5Ta1NE27+hPkrmReWf8ozpV1Z
+0//AAOT3qVpHm7wkfDz9D7h6MaGASyGCVVy9zzdc7BTJdQWlsa7qg6jC8I7QsXo5nRyPxDgm0Ru0KHgq
+HRhB7UwpKHZHKHyLRinTHSmtkz7AxtURhpfrkGK1zEXXNy+5DEOUPVX+OwaUbWgIAiV2izWSYDDvxfsF
+YCZigOazPm2/sMOCl4rLODD/gbG6Ah1xDECa+BeWBTX8hR02eZYVPA7Mv4myBSboGID5jwMltkENf+16
+88B1fYFxPzeqfnH9TEjQjqlfavg76+riLoJmHCvGc+VsuHSnhM5I0H67X2bGrzen0NQYR91fZtrzN6dw
+0HMTehllXcM/OqabsyD1WKI7Vs/+/XVmms61p6l56/cwYTXOQU/VvbgJcT0h1Md/6p1QG7sBwd4QDlfa
+F4WBt5yJ2dR5P/UGlGYD8rqGH9lP8FsHonsecAdfaBU3sTYmp/A76ZcgnnylEHgO80DbbdSIIf2oCxpG
+wDpvWm+2zb6ztnLbZ51XSsg9Mhv9XnF1u8VuFVEwnFJ4jKyfo/cU9Aeb8jJXeVrkv/Mw9N/MjHXkpDNF
+0hja94SDovCWcGdvo/PPxG5ObKV/IogaBKdBVD0NYRZsokm8Sp5mhDM1v+7rNlPz+Lz8B8mX+eP1pYpR
+MA5GKlaNZdjiv884tPSJhNMXppj2Lnw6vx5OYv8dSnYwMe84mAwmAahUNmK+U/RaqXSxxn6xPuMD/AnA
+W0EcGA0jqBN/kWxNSlAuMO7XQMXyiNsElJTlkRkJls3j9yLLlzmXKCd1T9uaVYZsTCuW+m+uD1tCcJ/z
+h62QxjXaNrkmfu+Of6sXUqDzFwoe0NiKhbGxaU/gOufK8wF3oVGYBXaFKHd02wtC55J3Az0AZzqiyf8z
+b/HgjVDrgR1mkJbZwAxktMg7PnBAaa9YxRGiT7/tuNxf4zPDHx1bOA17cONu0o0aVOiO73jBkX3pOopM
+IOtsr8GVO++kwQml4NMPitz3qXTteDVkbDgNw8zfhVNYFquUwrALq6Hvz+Gv0bi3UtznGR+k5aDtjek3
+7ejtqWE/ks54p/V2aenFDulpU3Nu2U/dZqaU+uR229vQr+sXnLp5J4Zk3U1ZXqEBSSg8eRwcmV+fA2Iz
+r9087mkg/U082Xde4vCZ3W5dZYpQojj+ueLKEIexYHqkxoUz1LOCxkDsTEpbaY419C9dD+KVM9blCtTT
+HvzgkdYj/MiRyx5q4282gaoN1BlvkGLdSQJnB9tZRWu/pzJRMq0AmYCjHtXoUrWzcZuEsVbfd2AcvDHx
+pj7WoCiFZ3XUjUe9gEbeWmkvPB8ZbYzUPY3ZFbgUOG8Kds3mf0w8HI/NyvVQn+tmmo76wIzaHaF9sNpq
+aELG9sFMWYntSw8PyEJtfLgpwx44gZfeuLqdjVE3heiCQSp9zjF1apRRHED4bRJxSrnXRFxMPAXFDmcU
+fEriz6FoGiM5C2rz887CvwJ918JPLH0l/Fy943FWtknI7eMvbZ6ws/MwS/h49JY8PFkwSDZJ0Bcl2fSF
+mVHFGsr1MxIiE0d9L7aGCvoq32hDzNZLPKRm8dMXDjHR//jzi6+qr0j1tejkuVL/mDYU2DMExoTtANMX
+NSzZJPn3yX++cky8nyGFIVmy8XTKxy++0iS7FQ+kgis6jiaTP1/9+atqdHUVvfhPCk+gSpNi57Smj00J
+XP07NegrfAqza3wvtl+T7oLHFW2ZER6E4itSjZtjvqQjw0We2gJ4esmfmS36MZ1Y0X6Jm3Sx5j0SRY/y
+cYG17OD16W3u529cEkMGltMrG8hPxPpMGy/S1xQw7tmJFLqVzVyNIL1oYxlT9UtMrY7FMZknf1iDs9If
+VbjPqI1tQ09HyNDZeq5w9KdmmowdQ6CdlBBMYjvz+DQ+o9YVR01+2qmR67BtNakn0Z3snomN3T+HjemX
+KX/PwJp12T4HOS0meKWk2D+NiRPts8cPqsMLHAVcGH7nR11rCkia31mCDcPK2wbjnTbKuKXE/uPquW0U
+Jt6c6OzAGe8IkVa/tyJsZjx8oD130PjznBew8fY5J+E8ubg5H9Ms60w5DPvLmyVfqLZYmsCFetP9KZ5z
+PhPSX4G5Br4n4KxBYpPl8tY/dW4Ijg3GkZiN2wT7UHBZfF/YFztRo3dd6ms287QrN8PyJ4e91FWPmpjA
++QyDen1WvjQgxTOWQaGncf/gitYnGTZ9NCZ6t3VDRMNgelqApCA+x90RhmVmF0DYFI2eWp9lODbyXyis
+Gr+TYZZWwrc6AHFp8n8nvaf+1Ki3zoEefkNOWkKj9Zoh9WjN7t9Zm/YsiwxNVLdBuM05sxeXQDJ3LQlX
+NTGBVfyTTyTUFGmlMBDn7kV2CpOcVQ3wygKvdRpNpx07vQMjjam67izN0urllWm3uG/SE1zgjq0hYzfk
+H6cIpHA+LIWtbioo7Cgs2A3xPCm97Veuje8ugR1NMvZAMlggxAeyhRVtwosbtja6+Vb/jTP9B/dsbdRU
+/B1n+At7Nkn2L1vHehMl3BuE37Gmbrafwy27a535jS5nIxkbbWHdn7trhS36QVTxGixt4EsJ1vWbdd2+
+W9ik5S4t7OvCvr7326zAiPM7m6DyDlrMxyc7UWtPyS3D028dHsNpr1y7DcOz+863YUju2a3G14bdGsZp
+81EPaFDGB7Nwg4UanNPXlo99o9PGv7yi8f1Y+q4GC2bsm64mTuaVjDfj3LPz6xrzID1bpqMqwnv22s8g
+hncuAcfd2jq7HvnqfdRCx8TIzklpMD82ocqCwtvIrdreSxyzd41H5PX5ZUk3wF/dBcsvGUEa98o7570g
++oLMLMCgqUpVvvCu4bqx9b27Ru87Hj/fxdxnth6FUwCYgPRWp1Hb9bKeReLWgm2l58zOMY07+YT3s/Gq
+blW+yX9P9ZtVpHrrIq34mklbcfdMH+N5+jtn6oQ5mvNqMNPHIpfEWSvIGsWT/Z+UIXhZCcq+fHt0uIDE
+a/n5ksx0DjqY9HMI3jht9ketzfZHLqSOwH4nHlxS+7xzneNCFjqIy1toruKPc5v1L03L5sZ+L2nou/3j
+3N0MkLatIxcltq+YbjOSRjHsUBvWmaemAqeAxfg/klYl9IkPK/WDk6W8dXlA0bwhKpO3zrmvWVg7JzPt
+UTWWrb/WG99iYlSMpf0KAeaPaFrTdyTfdkO90a6s1vlSnZUbMfTqmzDsFOuUabRmPxIKePXKKWII/Okg
+ZfJUMqsVSf4XDKyhdfrxB0UP+vqRV57rckyaabLKJNq0FZsk1cueZSWjUWXgp8yvnlXzS7NMZ+U8DId7
+gg+zfA7K/FN6kJjBdid5+mtdy+ORCFPDdLazcXLpPQxcukeTddEmYwQ17Fj38ofR/Prvy/TyHVT0ri/U
+RavtLsbsGVxfrrlS92sQUNkHY31dkwL/GCZraoKjMSncYcLCsaN+Z3NhD/xnCMoQoe5jjiwWjh3xQzH7
+MGcmv6pIFf+FBCPtdZPIjkhFR8H2kQ6a+g/demnqT/Kshox9wJzh2Yf5iAVt5/9FJjSgViXVSzMrM8co
+2D4GcbMwtyZd7NZll2XPle1gVmUXpAtrQD8KopcIcxyV2B6P9tniyuTMurPa1Bq0IAabrA3vbv3O9kGv
+SbrilMZEtJLYjnIi+81ITaEbrSu/T0Y0n8/Qw7l2oJpHSuN+E1HY0IN7MryyedM49MVa7yKtncO/WNjx
+Vtgl+ObsnqFOP+pYuLyHy3Sv7je9oex+S8A3pxA/1lpQTagVPYo10QTfv4WaAwxtetZwUjebCcOsZVtb
+dEwu2AWWmCzMJ10W3n254M3ttx+Q+BeRSld4+zkxUU2rUw3ZuQRf0FaLOmyRag33WrCFN1q9xbtG/TPp
+oM1vQ8F/6/msSgevnZ5GoV/h1acNzqnD3QvDre5Zgbz3roshkzOZz5O7IWP36KFANK6QB9/TenNCBmvC
+uz1hpT0KeGeA1+RXmvzmDC5MhHpsN/RbTaV/Y2vyGzxS+FfS/X+fpPs20bmb0+zlLz+VovynU6et89Va
+5+08aQ14c2uquUd3MWNL2fvQNuMxb6IMnsMYvw3QzRbTiX9pXqrKmdGTxLt68wU+og5T03d0jPGLV3O0
+Fu18REvjI6qe7SMqTnxES1YYiFCxwvmIsOHuy7w/vNf7k7Gdm/qW7exAC5td0vpBt3mJ86anMRGxQzHj
+RqXz5BK+ey0ZZ6OJnbpdmklpuHp/zZD6PeFhuDCkwSmI5kWnPy56QX+G4nrDLhaqMg5mZGvGg7hCTnTP
+1uRQQ05hb57OHJPuDH4pBqQmZuRkHjgoDAp6r3elYUhSFthrCGunhO7sA5Je2sbnBzo0b8ZasNTPyN2w
+N2QBVzTZsQ1mca7ZZjadG3VvzXYsTYwT7ZZwvAPSJH8xxnbHI2JzrZ81Z315N5vOw1BjnDF2b1QZIkYs
+A/3CHPopiFH16m72DTa3ZX6PcdsDgVGK27gyuZE4HH7V7GQUD8zeFJn5XPeND2LEKth7I8QOnuvczE+M
+STXO6CtmVoeTq8ZnAPfd9VHoWdaFIa7P1gs4xinI+NIKERK5+gon+ZKZOeOaq3EX5r6LTxsm6+xTsyX+
+RK9J72ya3T6bFTlDLqXgU87ao5w1Uk758m42QViFvYR/b61/Uo7YFswbs2eYQjlavrqbXWEHU9TpMfZ6
+aHin1LO+POJ1C3BvCvpmAOWILWHfGSNuIO2fmDuUY6+nXU98eT3XJ1Cve5bXD/N0Ib2Y6V+IjZt2t6GZ
+Yc+Eelbk0Nszi/MhNXm4DNUnOcszaA0ukLE9uecHtTP0RdIkvZTxFPI6K/766nTNHhWT5yG1F/a4A9s2
+7fvsS3FdsMKxfghotEm3Xa+iixGZb2nVNMbbdsOJvkk8s2LUytRTaUqhYMXxOJtrcfGA4vIGY7Ju40jR
+SiO9S6/YBNPfsBYejNQ1FTS+6bx62+kDcdvXwPlmPq4aSM3mxjenJdBsqAfNbImGVTKsbiDZzYpvuu/+
+5nlwLPobQFfz8bIB5fYmvjkpgIcmveD8qlnzvYWnPVmernTdWxrzPmVu5b73AA+9CtHqTCEiFG7++HRP
+9b3ry1Uxv6xtevO/ef78yUPPzh+PD337iCzAHi7LOYfTLgRHzh0AHnkaCMhzjDDW/fXjkLHc+J8MaP1u
+XE17W889hdA03bumnSosozoLry8uvod7nZ1hPpRAbK5OAIcWQrw/j3re1/QszYF/PjPBuZRghbHdTWso
+6YKami+mCOPKKeu6Pr1L2zUxn7Ar/69YjX/E7JPMhT/0RRxjOy2ZM5G6thYUTIwkrFk5wgu+mjuyZRME
+KV6xpZFTsy6XnT9hOS3xqxuEM8bK45FbmbVrPuKC3I4tjbcyDNc4gpEbs27y1POGEGaIojuE3rwMOf4W
+fxafNfUtKrPeXJ/0bqeUSbHrN+tsN97Xp03N2TUsavu5YXa9w2yfNcw/fTS2kHWPBhbUJiRE//VJiv9e
+3jFH0V/CjTQbcleDmxwpR0A6puhCWOc1Sc8X8M9b6dvD58V+1gsyYm4YsaipSyu0zgTpORPOPyJIDyUr
+fU9COZvOGf4cjyXmfZkIbQkpe6Oz35OcpahZSZai0pd3PpyGH+oD6RdJLDJ+iNx8gVqnX2l0uW0fsRwN
+eXmyEBQp8LeaJv9nAEfB7Nc4YAAA
 `,
 	},
 
@@ -1606,485 +1601,481 @@ lDQNqoSqMnEIZn6BWqdfaXK5bR8Rphx5cbQQpVLguwZn/y8AAP//R8Hs1zhgAAA=
 		size:    78479,
 		modtime: 1566640112,
 		compressed: `
-H4sIAAAAAAAC/7y9a3PbuLYo+P38CovlYYAtWJHT+9w7TQatSpx0J915deKkH2ptFU1BNmIZUIOQHzF1
-f/vUWgBIkFKy96mZmi8SiReBBWBhvfHwH4P/OvjHwaeNGH2uDq4fjb4bjSGBlPTg0fj4n0ePxsf/++D5
-daEO/tAbyHkvVqKoxOJgoxbCHNgLcfD65enBK1kKVYnRfx384+F/DZYbVVqpFRHM0vtEn30WpU04t3dr
-oZcH4natja3SNIFGllKJRTIImVd6sVmJifsb+aLcEpolodm2JVc7Td3/qLhaTNwjsTQTo08bATW3xF7I
-ijW9ovfJphIHlTWytEke0g8EEfTeCLsx6uBay8XBmHMu6lptVit42jYl7U7JAeciTaHkoFNStSUH424j
-ps1KoC/qPAJRXSdqc3UmTJTWVpXR98M303QX0G0NHX1s6sodvMW/WcI5fyJHZbFaEUHbKsWeKu/F+fPb
-9VerVL1+cS4mSZLtdmzy84e3b0Zu1HJ5RwSD4uwRzT5gWqfVElq9LsyB5evCVOLHlS5gBnL/JVm9Kd4Q
-Sycis22tjVt9S20I1FXcDXdUGlFYQeB7lBkuRtV6JS1JWEKZ5ONcPjajlVDn9iKXwyFVUzOVsxkfjMPn
-7KRd3+1op2Jk9St9I8xJUQlCZ9tsf7HZtu3jyvVRLonw33QDVVyMpFqI27dLYmkul0T9cHRMfSOuy6Ug
-ih3TqLWla82X+hDmh9kIlusWlnvgEYbY9Fw1zdmpmtU1gT8uiKLxhxce1O2iV+4jhhfmfHMllK0CTH1z
-ZmJ+OJ6IUbFer+6IZU052LfYb8tU+0y3AYCjuWuIB4gx1Xbk2nXEclvX47yd+lD2yDLDlbg5eGJMcUcU
-zdXRUU4NDmqqhnbW9K9t9KK3kA6kOrBUQB2ARqgRbbYrAHIob/n9lik+ztXj0I1cDYfYQJpeEMvgqQF9
-tILPCb1v3+5cN9yAAAEww6VfHQNV1wPj18dApenApGmzkzjn/tnS3Jq7MKX9PQgFe2mWbsvClhfRQhCc
-cxtN/ry/z3ZGKpfkjsAgmaVhDSs/3qPjtqWzdm0OjneWIr23sP74YMyaZQNovV058ZI8bNsiYpgkdFRe
-FOZEL8QTS8YNtL/7XzCauv7+v3FUTfVbGBRTzNB7v03cufLO6LUwFueC3V8Xq43IFBNqcyVMcbYS2WBg
-2I2R1r2MWanVUp5vfOZ4G23FG+giTN6PcmRFBRgtdLlBSqNkd0+KPrBtDGxsUXgw54KLKSzS2bZZpRGQ
-Ttwosc47ORLGaPOiUIuVMLT37vYhImlXJxerSuDHPsq6jk7yBsmXWlV6Jai9MPrmQOT+3TULCL7tyGV0
-1Oye8iJNH6rCymtxUOqFeOihNbLaL2saQfUJNKX1yBbmXNg0LfRovakuSJNEWfMYn8ZvSVSNQy29JlG7
-H9wyF6P5fG201fN5vF5OAyTDzBg+ZpKrBus9lrmB2YE8zdXUzHJYY5rZqZ7FoHjeHAmyWRCqWQNEsGQ+
-12fzeULTFDoDzwdSVbZQJQBrqSeA71xGttKj6kJvVosTra6FsWk6EJrQNCWIAUeycphQ0LoGEoGmqV/v
-snp+a4Wq5NkK6CKoOJrL6tNGpClRiESXWIPZNFVpqkbXVyd6o+xwyFQ0ns/tToKhSKypNdPh/DkX9u2N
-ChvrmahKI9cWVgjzyE3X9eB4wLkexbvJtVdwnaYaGmGVe6yEZSV/Dsj9G1s33rI7u5SdC5tFiMdtymJS
-BIona2YkWmlEjhZiLdSCUFamaQlvbUoX3pam6UtiAXpbVsVfs83J2fmc5ZyburYDzm2amgG+kWpStYd8
-prgbuaVMjpS2gMABKcbL60205ftLIE13aE7b0hz+2H1d2IvRVXHbUCzMUtZQJJYdM0WZgnlz5EjTwNTO
-uGIqd6MLK7Q5Pf3iqmuTpiaspYnKzIR8JmaEqBZ7zgwCNowPqAQSGo/266tmH/1PhonEfDwamgcKwve3
-7Wi86OvajTZNyUKshBU4XoZF4t5GHXzZJRIcGwGEAhKlAaM/NojVLXfnZ5pa35H26T9ZZ+1nnzVwGbST
-25JKzDDJirA3L8VdBcup4uO8elyEXlVAF/NiWs2gr9AxiaQQAyAoOtHE0DTVRNI0fUYMkzSDdaeYpHuo
-pdcdstVOxETARi8LC1xcfzwTm03tLIvqv4sJoy5R65m3lriaXBCFW6Wt/r6lFMRobfS6gsUbGvTwuN/m
-OyvJUgpgU7w9f4GY7PNyxCBsYGlIfiqJoayYyhm/h+wMurd1h+mBXBIdWoWPV47ShPoVgPhUkspXBghP
-TObaMNvc95wX0Vb/MR7YQhpRwhHqR9ejZj3OQXp2zwls0tRR/vdnUi0ywzbrRWHhyzFuedocgi2L26D9
-tZ6aWV1f67ycmhkQsFMzYxZ+4HDY7vkq4FTL7UivIb2i7D2sxR+BinVt2pGAM2qBY5JpSgSHPkimALGO
-ruStVFUzVM3HrOAhOcyZflzkGshxrBpyp3oGNA7OAith+qEVnBBBDalo+24prPqK1jWm+5VWtmB50Z6A
-ckl2WH0VIAQYw+FNHEBgbmFKXPdPJRxqvoSOSmhXouDPJdFNiSIqUbgSFZfIxkEV+C0a7qWK5vFj/8i2
-UzFjmg+WRDFBWcGBjYXP/Eyear0ShWJyBMOBRa7TdACfTxZiWWxWNqGTgg+Os5+Jo9ZC0bpOkgHnRZoW
-A84/S6RBSMEHY0pZI34p6H3BPwEOYcLPB+8TNXk/AXiE56SgbCejCnRw0Q73U3QkLomNOt5si5FParAX
-0F6Hfl26rfessEWatmKjPdnI8LXiotEcc6ZqNomes/3bL/kxpA44/0Ksg+HENCSCiSbwS7TzoactoTy6
-Qm7u4b/+qv7RFCd/3QzpwwhJ2unxLEuStsGf20NjBwf6Wl+IBf4RPh0x3nCY2f5hJpfkC6KTUCEwrkHE
-MoiYwl9i6Ym4ObjQxB+V3b+G243Our9bOPiqYmSLcyZGi8IWTIzKC7laGKGYGFlxa5kYidUVw+PHv5f6
-aq2VUPatR0INlEaq4mKkKga08gdbWFly0TwyPDy5gF8scbLSSixgZUZsw29dGqAVaURCCguM/jg3jy1y
-EMA68L8RezZ9iU6zP7HFVk7pxi9aOQyD82qpEGX2JrNFOsoz2MjvtQx2HnE3uXnccje+X3Et0Yhs8HNc
-xOP+iSB+YYDGHBnPKlayDX6gcFi14nZazFjJFfxt+FzDjhYEMC0RpKQTIkgFbQPWcaX5n6SilBmyGani
-SrCKbUZalYJtRmWxthsDT+uiquS1oDSrBpyXaUpK7GHFsImS0rYbigoCqfCJ0AUZWncdCy3HC+8P4scW
-CVPpvd4vtmArNwxW0G04cMxUznIcZMX/JNCBzLpu0jRVpBxdCXMuFnRCKl4yrO7Y3AKGBVVKVswoq3xB
-WHfQJq/aTv5KcAoCT2pGHYSF60MQTQOfdb9lFVejwloDPVQRrYRzYknZki4bAJ6f2xUg9w3NfycFzDFb
-scGY1jW8Vu71mLZoue3f70gyekDiZxT1SBrOppaxMDNkqJmua0984+tgnPvCsl9YdgtLKLzdxT1CdPfn
-OLetZMs6TNbjLqZ21nzMZaG0ALC1J2r9IpjOmNhHDdtYC2GIoJMpoMBZ1mdjJgpKZg79RQoHgQN2k8pw
-X/HpDFe05uNct7sWqB44yvWMCVLAeXzmDvP24CmAx+TltAzy0+NZj8Eo6KTEleeHVTIlSMGIhOOdDpN5
-MtSUZgYKwjpJU0sqRLZ04v6HQThZ0CxQBK5J8gsuZ0sKVwHrdlro9Iz/4tOHvnxGsGpxTtNUkAJQMdYG
-tsS98mQ+v17JyiZD6fo6TObzhLKy2U37KDojOiwLkC6TQIvCnMSaGkEM06zAhauIcdIv7IZ/PtFX62bJ
-RGluZ5mREZVeXYtFVCYk5b7NlS4WUp37Vv1br90oFbc2lPTHXOX3eMXbJO5w72DMNjyShIS9IPiYWd4Q
-0eKxzcVwSKupmI0Ol9qU4iNyCIRu2YqfkY4qpOk/R0Bqysq63hC6pWzZK9uFUpqGV+wYsNOUrbkhK7Zs
-tUEEC+4ho9YjeyHURMRATVOXik1klqzbE5+m6d5GmgJYMU1JPwnbYtBWmOx4FDBonwNEPJZrJ7A7f66s
-TwBAAWkJzP6quJs0JflgnFXCnsoroTeWRNPVG6qI1h+JqztYMt9yXT8aj33PrGuUpun+Lyyd4gjqhqKU
-wsI5Zs0HJp0xZW2Xtu16C/st0lgKLx/vIz76DVWDJ9gFME1u//hNsUPHNTsjOnEK/KQYzcU1nMx7NYZi
-NL8oqhdaXz6HQnxwnAfiraH45+vCCGVfycoKJUyV2zTdiJ4mrhKB71CTcz06BDrFyfHcW6+4z7zHzOWy
-l7tpGjvXXDCkr+oajmzBSsFibepKxPISJ9hoFAYHKqLxpjMm+ZjpVhwlH2tUinqSQACVIpekCNPopJPF
-KHysWJ20GXU9KJDwDhp19zaqVtpSExaA0ys4dBQVQDqwmtU1gT8+ndE8seJqvSqsSIBPBDzfP4qKhsCn
-WYPRt9sW114Lc0eWyHiqwONxQ2OV4jI+j4G+P9FXQLfVdXKQIKMH44sUrOI/Uf7ZKSzR6XiGesfZ9Hi2
-R/+3ELEEJ6wuJODdAkP8n6YDOyrOKmuK0uvhctWuxZCTpmp06KtRxdsXNToMUHIgEnQrQi4H1ujQaG25
-mij3lAlIC3VglUARsaxgrGI0vwEWUxju2IDRXKoCJU9NgpNFvQzJg2MsVb3WG2WBVPXvz0Rljb6LUp4K
-qc7j5Ejz22wAMToUKw68WwMDI9RCGGAb+mn8TlM2h7rJmVhqI7ATiZM0mEYZHB+BYjR30i8iRnPXCkqh
-u2MXNwcfNBHMsHPKFIzA20OMDq+VXog0Jd1Bj303rlxCQimLyIgLEWQyLcE+GACVFaMd15sTPzN1bfz+
-KfVaLD6stK2wfPQ+4Py9RInRLvr6BP3khoUu4+M86j0++qUIu+brXeGS2V2RCb3fFdwcN/inaCQkrOoi
-V6PXv4i7qq6nQKKM8/JxQ4iUATlteDUtZ3kx3cz4R7Jh/W8zC0hxn+Bon+SG2y1stcDPfAvVfyOPK4Z4
-WrEV3WqE4SHgtoqvBJGsOQwRkh0iKkLfVw1+yXEaeLNXaU5xHpsN9y2xyrloRDrBAGXPvsSP+Wa2QUa9
-UzIom7tfb+2moh7dx/ggmuyAIltUFCFL6Gubg8YSbq9gOwXulugUv2uHNiDWLdX+0MZ+aLAf2t71ujf+
-z7p397XuLcT+Ds47J3C7YLz8V9F/o0S25u4exS3B/qox0Tghiglmh8nBhdaXCSDyDrWCe1BcSUsSKJAl
-w5iIOBOE3h/qoOm7bZ7G7EYDcj/R/FJ30O4hVIG0MWJMwSzC7FCPKm0iQjFml8RILo7sSC62lD0BnvSJ
-ftx8NX/ihPGHevpEzxgcfHLBbjQq+Nz5YTaKBLXcrR5VqKqjzPDD5iWHobATQRRlt4IYyqxO03dytBDX
-VutVlaZWjxwglqtNdZFEYLgV+6VyuT06yptZm9oZM1yNrq9y0+J9zlWamgixp+kcOMDEHRnddXATyM1o
-R7Bb3ZzCrZ3Gv5NDQG86K5fBnoEuDsZRO5cdgkI6/hE3KQIYt4wD9WDMLnVsZ9JM0NFxrn7g4zQ91FM1
-G8nFD9gSVUdHOUyA05w2WmLFnmg6PGZjQLeIQQ6bAeYnuq7JiUbhlCaHomPB8wQ7e6pH5UoUcMS+hW1z
-qqPxvI03EjNM8z6vACMkuq7lt2wbAv5DbWpD28baVQcqO7ooKtKoVXI7KhYLoCjlkmivCxQdXSB00Nk9
-OQ0flDEd5aoA6sDsVjJTNYN6EUA+NCTOczR4iImSQDxeSMAiUzXbsudoCsE73HSTzcWWfc06QrHnMZBP
-wyr1a7zi09kOy5Nbd1im6XPspT/gmR1dCXuhF1WavnYZ/h2ygDiZfEYp1nNE0nDS3m9hzTKLHNsGd9Ar
-VzMkQCb2JU3fuRx8i7r8/CsItj3QkTcyDYUBX5V7iQwgbzUfNIfsrq5JtxSLE1y35s+a3ktvfUS9hu4j
-0UjHCZp/9pIhplGtWNc4wYnrUcI03QI7tIdEiYxr9nIICNgcUhxI96lWJ28EARIoswgKTSxF67r7bay+
-6ZkBmB1YxseTRFPKNF0Sw9RUzmhdHxL/4IcG3UlcZv6c2C52euNnLbJQbM0/3RHXcmLOdC2BBglNKLuP
-7dpe9eZ/HpbOb2EJ77N9bU7dSCUuOWqo9T4YyonMJGzEHHUyDcmv2Tn7rCkzflpfCuQDZLyXXzZ7eU/D
-akL8Dn8mAOx+J5/TLKQr+J2gUZQalUV5ISZYNMOM7DxUUfA7wd/snLKv20N1dvyzmOXdMYMCJLIL0TTd
-n+4VxY2piR0tpLF3aWpH4rpYbZC8ZZEVlW1NWayz+onA9loEK7zuMsz3Gee6k81O1Wxyni1Q4dgRhLwT
-ew17Y1OIHemTaaVPe2zF3zvq3uD6RoQfUuK5f9/MvWMyNVEoj+OKAV9+4Q0w2a6lAFreobUwcAiI9Zwi
-LRrVj/tRwtroa7kQuaeH/etiP26wjVY5Jg+fRi2/EDHDKBWsK8BptjljPc4YLbV5XpQXJDYp/4xAQQ1w
-bBD7oiXcOzau/UOdmb2SOcnVRGRKT96L5Qpy9Y36xZ2xWe/IdZoQGWtCWrZTTvWMVVxNikw4KbjNyxy7
-VbaQS1O0/ohS6L1B/WGbMq1m+ZkRxeW25GU4QbZ7BI8fRazrivRtYlcpZ0nVLsIS+lDRYloCm1uyKljm
-HlhinK6OpuknQQoWXhnkuOO5yXGvuWOcu7CVlK14TMc7wxA/iD8F8NZeMgHYnC2jPjshQGQ6vGL3gLEz
-w/CLWcEC25Rp5sCTSbYKDHNmRtqJMd0Kg0YzXHq9lScpQ0Y62yWJVoJoxL2tIXVsLnsBfPhyV2DJgR+H
-NtOUkKUXW/oHOCQpZnJXhlK2jOxJxFccBtB+xzkNRLYaXkHEKlZ6423AMl6IUUQkyVlRCTR0Ik5+zzdB
-5WQoZV+x2oLWRqVc0LpuLE+I4aiW2rCCUkrvNxY4JM01gtoSPbrSC7Giafob1A5yR0/DrPivRDPDShrU
-T6GHLRAb6fpH+M7Kjc9VX3I90iqHH65Hzqz7rWJxO40wM00J8J2U/SICBbWO9NSquBJ1XeZd65DkeiOO
-GnH/UTLE8Q/JepIcJcN1liSUabbXhqRg9ydWm7A8gVDMVtFyXDJbnGdlu2irLY3pjp+bbRxkdLsGJKzg
-93OUIrvkbDAOK19Fn9UtrcrmTRunxXmm0YQlltFlgvVFTZkeNd1mPWFcplu7F1/v+eoqM05gA6WX8C7d
-u8NCKEeUaiWVOPVS90ZgjTpZUgSJatj2rBhVaArzHl9/VBWv+imUoTX4CKDeUf784pmOC60vUW4LDx3i
-1HHBr3TMBnuC75V2zLmr5cxT3wC3mjcp3Ez+RpkbzWQ0gX93FbvtmRVQs2gemW0eowZ+wxXQqKHcRkpT
-/4BzWtcJkjUJchy9Aqh4qutEqvXGJjnxVhaonwicCqVIbvoa2JY3EFSILgn8Iaws0UCKTOCXT0MNQMdn
-RXk5C1a2WCjDQv0ykWGRo1qk38seRD3ySKIVpKS4ILhmmksmvWkzZcqvFP5MU/ZT1F408T+JHauPoMLz
-/HlALXeaeN+nTkq/Q3uVuHI6niEKNY4RjEXk/N4rgjIotWWykYIBjuT8mZ5IjgdjBq8vNRr2CkjwTB7D
-c/prhp5lXvF3yDecFudviitRrYsSJVUFpMvqvaiEuRaL0+KcKDrxaO2dHKHz47tVYZfaXPnKUA+A1UVk
-As2F+IuISGNJg0WqhClKJ18EKZlhzl42859Re1tzkpuCf3HrX0SG3M2pUkxIlaZ/AEFRUVbQDKYjMonC
-qs4MYqQqbliy1EbIc+XojQR5GVucp2nPKq1V4sW0N4Pl3hfH9vSTbYGpnOVdYxC0pMLedojzX/siJean
-c9dfZtdp18uAWtu9xquTma4i1TzWrTWfdbbQpjUD3/X93W06mAWKuCEzPI7bkUFdXuwKndqmil4vi34v
-K16gnXbobDVjFXxm1/zwd7FjOwxsYaxy8hyhbDTO3JF4Jk0vcIkwIJPq2nqk5hsIVYPfTV1HmlIRO2S/
-IK5Ks/CXcmWFqRImnOXZKxmZW9kOMwY78FLcneiFqKZ2VteNo0+fD5wcHXPOTeOmK2hmeq7ftmP4rShO
-iKL0vm92iXzdFWS5MXclSs4yOSlXRVWhqhuX3t3K6b2p5iLWmQtH5sORgg9oKJxrwHTv5OhqU9mPFfL+
-xLKKFXQiRgt99c6fMu0LHCCZbwMz8AlSt9grXddEA7+jpsWMbhFD7DFnMzYWxTgZgSMCTo0Q8YyiInvS
-B83kN6Jo9jf8kP31eWe2+xTGVHg1iavqSJN3Rt/eUabhCE/mvr35PBkKNjimHTci2ayPsPCs98FTpYAq
-dkjUJJknQ4WU5WDc0dtq+w1/K9o1pxWxOS1ghDQNKKaJjuCSC48xLNqsmYbnK8LXIpMW2+rFGzNl1HJe
-ijvUksvqrSoFjzYxuqLeBwVvo7aPwI5prRDYlfuKBpkpjmIdr9zMY6Xn1zXGTKGMIz6X30voRdkyo40+
-vGVGGyU5GpWinQKysc9X4koo+5/WHcdHQmn3S5P7vkYwwsqaTWl1w6PQXAUFeTDaYCrWK0dkvuoq3m0P
-irvqZLuTBKV6mne7C9lRh6GAEvF7+6Xnq6v2G89XV671pU92T8x6ch9wWGABQhpTOyyA3WEBIiMmG4uu
-gmYBdTPVZi1MmAIo51OQBUVnCnwPxl333XcevA5XLmwEnDTCM8+BLTOURV/lT4livSKUWWQ505TYlq2r
-pi51xkVrWhQdTat2UEy1H0D2pNsDYAcqUaxESGmOAe2OATXVswFH+RT0Icjr0YWYLy1BU14DP1CE7gsp
-sPwmProPdl95X+gGdGiGmiy+3yEv74pFRSwWJbY9JYHV+IGP61p10x6PaZp6IzBMaAMx7B4qaxu0WaO5
-VICio1W08NhrU4mO8gtFKyMU/axWYhEW2jVpXSOOI++Ojaou5NLiubFXutK0NWmevOEZGvHt92MXsYsE
-Gno0HULXkAtZxQKFaz8WdErbUeVFizV+ZR3X+gvfRCkXfOxR9nEe1l6nTcEFUGJ5e1ajhruUC1ybc+DS
-kRTAJ+QwgaSZmlnraGaCs5rw4hnVEdewYncUzRQG4BetzXxf0NvmUFZ0TesD6uUFK3C0djhkRbyhIwCx
-wiEIrtoiQYN5ZUlBo+RWEXnuczzslH9ghZ8g5f5ZgYtPwS97JndF4ILeF0C5YDQYyjSKT+LPecyiZ7xo
-utrgsmYYRReBcAFFYwTCL8j9th0JZTA/AJ9ofVztQ7r7tSof4JBrYN5qKmN643xvcwGE/RZfdlsMOoHW
-lAdb2wdCS+/R6TuSTLc0mpqQltsFKtmmqdeyKFyGXPnVidRJtHeclDXIVRWlLGk8Z31D+xR2SMA731gV
-fGMVYOao6akdJlUyw3kHhnt/3jZWiMxjtsYZ3Z1ExEUYA3K1kRmPjYVYu1zqJA55FIUZ+uHoOBsMCvSQ
-Fy7GRqz+ObQ7MS6cO2zjvmo8Y+dln3NL5B7/OZ2mA0U0+kM7e+FbSyRlzjUHjcmj5Xnr0Bf0qGnqpRff
-jw4XzhY0ljPc2K7yyPv6GS4AieWdPoWGaE4l35PuKWAmsQ2c5BNoAFtU3k8st8RwM2ps8EynsGLuvT2N
-Ly2JN8yJjZfuvaOOToDXy54gndMmNOQUvlGGHGEGhfCJTqb+iSn3P8v8QxxqpdmgABtXHKNgtS23kl2D
-fl2KTp5YYthb9MDqOIY+sV3TromdiGFykAxtJjJb13FZrI7KvdbTMEm+IiprzNEdSeLK7bgrNvOsWcHH
-6J/m6Y7icZUXwyEFqgjd9yzR/K1/o2mKnkaAeM2Qa+hxS3B4I7IxOzpG0x7Z+VIZaLFy5mqX36i9G0zq
-Q7ylC9hsk6S6Pk+y5KqwF2hQPnGPO55dpzaQMR9la9opl6QsIh/aYySYeS8aGXNR4jZw7ATY4ounCBa6
-RBpo1GGXohhrbTyy5CgBVDHB6tx2jl7Ob6Ra6JvRi9PXrz6qS6VvlG+rrr9R1JfJXJsPd2v7mD92f8yf
-5wEye0Ry970B/r0R5u6DWIkSY8y0FF9dfwUIyUJeJ3QPGfq5wxj+OxAmFX4U1p2YqIxYjyfcf5DaNOLU
-OHl0tVlZuV6JNEVbjifWGnm2sYIkISdh7WNXgvGmu0X3d/PNByIB9h2nklfxWu3VOxW39o1GD4XIquUb
-FbzbRKf8s1g4oSph7FM0widdGcZrG6w+jLjS1wI52c7Z9K4pUazXQi12S7zvHKUeW8MAIsuJbhElbu0H
-ebaS6jyygeiWsU4IH5kwNB2x4taiLhlY/0jTHw25M5PdIX/qyRxwLRixzFszdMNbP3XJ9xyPQBmI1RXT
-3Dj/jLwvXtNAbU1W+M8kzeCfcy7TFJOC4iZrv/9S/ajNvlbS1JmDeiQh6ePxBFOQowttT+XMPcRKty+d
-E/DASaY4V/CPlEhxzhvdQOSBg4nNG2B4108KdBpR7jlNf3bNR1pa/z3AF07PhgIEIKIarBq7fxA44EVo
-zp32wbLCuDgMnHMspb5ZKlJvWm8W5Ggnpl2IGThjJFe5fMxNPhxKqtHFysUQcCRTAUxBq32Jwlj87ZeM
-h0IU8QVQby+JpulvtudD9pvtqj4k01xwztcFK7h1DxX/0+5+oI2YQFkJJXa+x2xbYsOnM7YKTsnICZTU
-8Mopakv4MxMiR3q1+FSsNoKH6E9/WCKDJXWCDhxAmYllmuJfuwOc28QiTVdh+dGMuNpAo++r69AP1Nk0
-dWCzbTpxOZf7fGGdc8Amdgn4w5INjCMJzbpPbnM9+SMAx6u2ozcU5vgqCVvSbEmQAFn5ptP031Ze68qu
-C1teJOyrPV31errCnvaB53tM2cCbNjtLoxLjx/xhCU5XslEOoIKJjhr3T/v1oEzO2rLndYgbjsncWUjv
-iMMde8Hk6Eov5FIK1ElEb/yioExNfwJOYsalm1v+gthIDdSuxoRJJ40YjPcpsX7qInlT3LzxjBZUGiaj
-ZNjRpsWdut/S0WctFYZujLSfdtd/S7j1h3/e9UOjb4cmysUh8eW98auh9yfEAG3vsX47ooNkGDoHtHfr
-/xEpNm2jfx0Ijx09gqprtBKKUmK8ZLAvFY+roMKu5KaXlFtSBjV9mpJuASeMCNE1pNv2mpdTOWPVVM4c
-Pf67JQXq/vOfZZqWbucPOK/cky8QLCh8ftRkRQWBJuH7tiCSTgpPNTRH7ZsPRBRMFYgWftVotLBTCDLj
-WBTNsf27JpZOTAFckdiphWGne+e6xa9YKN7LwVbqOlkWqwrFC2rin7PEmo1IaGaLb3yuGYml/e+6LIyh
-+z/pa3xOCkUiPa5paAnkfgu/HnAfE0F0hzVNU0gp/TNx4SUEKXYKFb4QbUIQ3KAxWsnlaG5NoSoJfcEK
-ooL15YK9PLGkAn60pJSyasCh+NqIayyIoRi7ZLJTnrIKsH5bkFfxHFu1EzuHFBiSajqj1IumPXt3zSQd
-WSOvCKXsmsvh8TY6NhkclINj5wa/gZ8V/Cz5mK35mC34mF3zsT/ud8TlcultgUUcs1ZSVtHvvnceRt8/
-GnBuEBKD41bdX9Lv/tkrUHYKbOj3/6tXYNMpsKL//N+9AqtOgeNH/xxwrur6+NE/0bcv7uLwmO7POIKM
-ZV2v63pB76sb6f3VyqISB9/9Myv5YOwsZXOX9H1W9ZK+/1/Zppf0z3G2GA47KcfZ4uioU+04W3fLfP9d
-tu6WOX70XbbsFjp+9N/Z8ugITl8HkPYQveDy6JhdeeI4v0BnKOcLTq78uJ9YckFpfnF0RPOrNJ0Xjn29
-onUN8Bx7fXkbZV5PCK4itBEJEoR2iWXW2TpF5fcWzIBzvAbyk7KCtuuriNeX5koRjXEEm9NPR2YLqqOx
-b/l+0ko51OPx5MF8SZIHQzt8kFDyYCiGCU2ykNh0TdE2nyVNhhoeR5jG4M7rxgdOpp824gCoErkSZpbB
-ERdHh+j5FE7E6KpYkz0h18XUzrZ05IxA9hbY0mwaWeQWKuB6IhrzuxBgsUUF93DeZpb5INCx3LaKW+hb
-UPxHLZQqUkdrR9RH9HzndU+LnmTJlG/bsMKcZ5I1hEqm469tel/DEKw+rFUb0bRJYpYng2RoKcOCWpWd
-Uhhwy/Lk/7RFfMituJRPgoIpFHSmczkWd1bBk7awS2AFapXgEb1ZHSDiBLSOzKCYaAuIJsvHD2zidrfg
-MEDOFECFdXnbkk7kpGyUgVUTR6KiGRTn5UROphUrZ9m0ZNUsi+JrrVTXtGgJ7wn63Na1e7k+Qp0Bjt/7
-Yg64aXxlFHEabufoE07ipWpCI7sKjSlVL3h7x+NoGW/rti66LOLCfF2sMXhVa5TiM17JyvpAIKZvaAdn
-FXCoqFThHDZkcAGV7Jh674ddEnvdg4238udm5Czf8FuA01jBk8PD6yRHJRlPiBfsHR5eH3DODx644T6Y
-QErAgvBME8qkqzNXJBkWgJ/CClgoYllBc292G1ZEQpB4pgkTt2sjqkpqlT3wSO4BC0axrW73gMCXDu6T
-YTVMtkl8JUIHi16rSNLo3eLVSC5uJ2KY8GRoswQKHh6K2/UBP0iGaiRu18OEHRweysWtT5KL22GSy+VB
-P3Af1qP0Phn65oYJni/3mBEmBJtiB8fMsQh0G8nmr4OK/IXmgj3V/EUwrGafNP+i+c+aj1kkBJ4m9PEY
-WKJVUdmXIXWW0MdPdXNTxb24XWeCycWti3Pr9CWDK0VoTj9qfqEIZeeKfNR0Mse/7PtjzvlHnaZ3+J7H
-DY2qzZmbbjJmXzTFhuPUL3p4zH7uGGRfRK4gL3RMlAyHn2Knt6uo4Cf9A38aHYnn8XGBJJaoa6TFItHf
-nWo1ny6wwRfNP+kwXJTKhwELSue4JAJZhcMWaWqHQ/b9d/756AjjPVl6/zO25DZTpB5UPUd19y2MCgFf
-ogPObU7zSDXYbLtfdGMU493smOS25WSRELHFOeLcgB+cLSHK2Z0sGygReqO8f2NrvurEahw5uqS8EOXl
-mb515oqH/6a0KRZSu6K33ypa1wkwwYURhevGyU7pwY45dRs0dx1Ks+aqidix9rCPn9JUBdQk+cohb+RB
-Kd7Us1olTPt04NyO2kxk5FjhM5G9i3Idu5cjzeEgJRYJS7r72+3XyVy6J4bR6ugPR8fJkLj2kSRMsoC/
-siSb/90U1oj6KMNj/hC5Z8A1hcMU7PBQrPghnpLeC5MdHpYckke+RxPiWsk8It1Vxh0eFp6FOzy85smQ
-mInDuxL7Iyl+SPK5hKIM0CY0cnhY0vvDQ/l4nKauv/zwsAiOCVCKBkwmfzg67hQKJObhoaRtjZB+eAjs
-CPWU9n0yRJyfHB6WCQVc7S4c6jgg3/7PJz2XvDdQ1p/KaCKwSDsP2CNJ9/Tk5ps9edAPLOloW2fn2pnI
-xlQkdkkPJP/IbWKx2NIu7exdEK+LFRw8czdktPk9mBzokUs4yA60dz7xDT4Ik35drGAJXhcrBDWFreHX
-XPjkJ9c2QCRPcu1UsGGKOiMIWq2DSa961n2fjmcBtEl5UahzkTC9B7QnPdD2sBuTnhbRXI5WxZc7VnAZ
-QF9x6YiSkg8croLPAJ/MNrD//Hczn8E5N5OzIvNYi614b2wOsnmFXPa+PE/QJJQVrgyutFVLyiwdKbOi
-eZmmZMkT2FOddlCuW0kVlBt5MlxSv0i9FK0hezz8NmzZAI6Rqq6L+DYybmiaOjifrTYmYUk3VlEs9rxs
-zkSVWyKmZy6SruJfZAQtDx502p42XklYGhPrejqjrLlXARphkH8YWvsTWlvJ8jLJmrnfae1wf2vQSGTP
-oGI5bats09yygv+t88iEWNF7f/cagdO7f9PVRBNFM70/kjJN07fuU4YVdLv9W4+KxQKZmGDMi7k/ycm9
-57oywzzPlMltFrt8v438comp67819cK+3QZjAd8HNIMOImGvS9DKyYNV89ogoZDiaXUbv+d/a+6k1pfA
-tbCfMALyE8XeqlZiHRNmp2qPPDo4H3RE0k3iN6TSrQtDLJiOU78mm278HXbF07EsGW9dSJKO8FouiZdf
-J5G2F/CBTNNEKiXMi9PXrzChrolpvJEw+mbPdwndywacV/BBaNrvTs65pPeFx7pceydtx0ToSZKEG/M0
-zZ8rUjDDNs4f01XYBOctvDYivmhIdT0bBqLFFXDOuqMDjd6AEKzrz87Irq7fuIdoJX1W+20MXDwib2Pg
-LikUjUg/0or7FhuUHAhSMZpfvw4kaWuWJGmaBqTcQU0CcfikJAZo3xI2YCgNmHRiPEJ1vmaOWTTwEhk9
-RGT8S4UGWHer2A7GibA/QOrkgnTemaXxDYQvY7ZhJ3zzFRE022Odd1L0Qgg/Ux29rA/ZaSNnOJHvMV77
-z23aXilv0wZo6YKgP4lLFv3k9i4NAad2iENJdac13a+2awf1uqdcQKWC7ikVZAxc1BdIPx1OwdDP1T6X
-RkHlPabY8E5xtuJ6pLS5Klbyi1hgWl37+ohElnxT1yu2hkUQ4ihi44hMfEqvBW7JOuCYCeKTNc3WuGMX
-/JkiGCKhvTlkSQVZTCvAL28LDN4QEAxmLygpOOYPOF9Oq1lTzIdPhc1fxDj1nWqD+vm7U7wA2UUGRC0I
-HAc0EuseOHMu641CH/5VDR/SvQa3bVCe0A4GwLIUPcX6ia1TmuJA3BExOt+jk6E+UPhBkqu4U6jFTCgw
-BmK/MoeooW0k5HF8lf/foeAO211ANOkOFq0a/j+CBzO8gUMEG0N/4GMXwtWI9aooMb7cQULzr8BJ7YHR
-j0He4+3mdi5oDSjwftsEj0QrmbKq3KWVnwsSTPyTa+Au8SZLykJ8kz2Y7XMRBayPbKqwLz8WcVjpH6Fo
-JzaMaqxbdvWCzilhJzkSjFvK3vUjKn9UzZV+O1XTdLW3SWYpe99v6FOPpfjiCqA4E3kKFGa66NhIuqyN
-XuO1YYjoWk9YEuSTknP+qpi8LrL3BSt5Lwi72EvdVWxFmXIB1+P1GjgBJ1QaDssfeJGmG0K3+f6Q3uVj
-n8/08JgysUuZwqci+63e+eTtOs+FPfFm/4gciUCITJ8Vw+SZWBV3yayxQz9IKADJ5W1MgZRHN7vgPyu8
-9ohV3EzffaWRMuTtb2QDjVSspGzFx2zJ2zt9HcCLH8buDCvYihdsyYMUlGZQ4mUx2fgSL6HEhi152ZQg
-q/ZyvIJtKFN89cN4UvywmbwqspcFykDZkquJcl8LjWehjWxMmb8WjPn1kq1Ys1qyJbsoqlNYk0ttrjLX
-TJo+9epFB78Q1yvpXCv5s2qD0uRB3dzeHEoFby5da4mdMJyO01JXVhC7xv4C6234i8KYh3QbE4i/xKTQ
-sfjuH2+QZiMiNpeOb+6JyAPPWOSW6NF8JYprcXKGoWGat1EJlE1womrTSYjIwH9UgcVot7S3QSIFTdMB
-Ni6UFQYbB4YOCISFOL1bC3r/X82dbLxgG14BHmQr7ryr2ZJXI1cXzenX4fVUu4RFSHiC9LBLvOYVWqsW
-vtZF8x6qXTUpcb1zXo1crOrn0CS7C42zOZRfWmFcxmHTqwAddtvUfYLtspvmE+wk1PZZl23/mvpPeDVa
-+K3F3vIzzT7wM+0dgPMPafqhoQg/8PDM3vIPjfcvwPCUD95GIVLreqBGsnqvNRCpwjiseFrXN3AUcs5v
-3Dp4zk/T9HpynS3ZZ3i8mlxlC/YGHi8mF9maveKnk9u6Ps/O2UtI3eOpczO5ye7YM346OanreTZnr/np
-5LKuD7ND9o6XRJIndPLEgS17Qtl7jufeJk0HP0v2I/9JkZeUPeXtYokvrKD379OUfFREszeU4f9nStnT
-dn1OyPs0xYznlL1O09dEU5o9S9NnRFMWtervBfSLtrrQN3X9R1jD3vpPfdV0sBuzTzSE+hug/P2lYPO1
-UAvk9drnKRrdznJ02O7Y3VrYgs3G6r8Tyl6m6Uui2VO6pexVmr6C8QA0XvjBvnDQYE9VDK8XMayed0BV
-1z/WNflTkXd0Eh1UT9k7mn2C4iv2lAKWYRGQgLNOUxP3h7L32NRT0nEs+s1jmOiGfHp/GX+edIBPSBEB
-sYFYXe9Pd3F6HDi5ouwCqKaCslsHkoKtESQFu94DkoItECRYqtOnGweSkw5ILtkJgqRgS3bpQHKVplek
-YJeU3WKlSyD/QlAUh0yLGN9Fb11k2qYHZFp9HZkKEt2r4aPz4IdarI2Ssgix+ljtcJgiTl3yjcOpa74Z
-uWoehfrXgByvQ0KMGy/4xuO3V5DFrkIhds43Dru5jLum9Qa3zfnGXX/iShzCa0B1tw4NrBwauAE0cEXZ
-CSKMQzo5dG1lh5Rd8na4XdQQL5M03btocB72pDfrCOMc3joc0y6Sa9pZJRNyizgGV89dmt7hLUoENsV5
-mp7DG4s66THNfDInmmaaxFTDn9GxvRuTJk0HsnpTvOn4dPykdr28/B0phiu8eS5yL5v8pHYibE7MdDzL
-DM1Ic9l/XQcxGf0hij7+hydqBpHDjMMCf6uO98evPQrdRhKmoGBwtHhd7wT8jJ3MWMXHrIw8W8ONuI9L
-vBVXLknR5uK1rVTzOTFMGAD8D0fH6KTr1BbeBa1N4LrVXt4RrBLddAacE1BMvjBq3QecV+gY2knlFaW5
-RLaom3503LFqVTt393/lvkbsjHWRR6NZ3VWcAk3XrBivOpLOPsu9ZV66F9ldGueh3ldYdCIdq68WOmbG
-kJDBvDIhNi4z3/LSukYPL/RBQzOhhCKrLa3LsWwwdjFdRgtZoWW9y+jEiInGPPiKI44Tj4k+2pyIDGp/
-VUwXW7yZ3q2efdfaZl/B6dzxEw4BFSfaEC2IjSJqxYLGIvoCXlsTxffu+PxGEUyonZqZ8/3117vvXonR
-CWMhqZ2eSqLpDANZ7IlRUZlGfvPwr8XRpRDro2Ilr8Vh6wVYnLdX+pGkLZKwexdZ1O6Ap+1zx9DOtNdp
-cNFQrygo6h9xu6t9Y7p3V3vXJeFclwIJhYYUkV2YX8pIQKE/2xlMZ/RKKPPv/uxt8puzODLs8s05gaS4
-eacr7kqfC/tUb/AEOVlJoex7Udq46tp0roiGBta6Yo3DmWvNo4SlPVL4h6ofq9dHCn4BbZq6lk0XYAiL
-cCGE9l1B0WmuHTSBeeV69Js4u5S2YWd5gpmrwgqSDM0wWd86lfn6liZMRxMRmHuejKuOzVW0Dw9+L/jv
-xb/x6WS/F6NGTcMFvEaKnMg6qoNC7OR1lT2rWo1AEMMptse69GWFQR4iiyTfWIvaiKX3qyG3TPDYmik+
-wEzXX6xqb25wsQdW1AuFDYY1XFEm0Dq85wYM2NtZBRftdQ4SLZiLqZyNVqHo4rQ4h6Mll0dH4Uji6GwM
-pSNv6Lih8gcu8/LoiNqRUAvkEdSCFNNyhpZE0P88FOeSaS7xs0fHmI9qquQM9TjVBOMEGURl+EAEm84A
-GUMrWbLGUhiJZ2+5YyzHOh1xEKTbRm+CisTpjFV4kfhalBbWASu5HcnqoyrM3WlxXtdvJNtwC/TVU/FK
-LO3btVAhY8XHuXCxoSUXTKfpm6q5M3XJdc8Le81fVdPlrK4J/mMMvvfi/PntmiRk+tdf1V9/fZj9Y0LJ
-44fJcDlMpv/6YfaPH2jCEplQ6h0H2gW3ZnH4iygs86Kx1GRvKrJEcxVdlUau0XhqCcxNJD9++HhwdESm
-f1Xu60dHPzw8Z8nhcULjIn9NT549OX3y17QtOZs1JfHKhosCI8G7B6IoS5ItzVfDJvDh0XXol+DXzJAl
-Wx0t2Mob+Tjz+sjK8LEzNRpzzi/c1a+VOwJCsKKruPTR0V+33wlX5QqXqSJXw+9oXmplpdqI7VYuybrX
-xHncxOwHV/3cVz8fPoqrQ/k7oBnRIWtVYdk7KHg3Hc8CidpWgPLzpvzGlfdXjJzxVa7IPKrHDJlPj2fs
-DO054jYO+U4Y/tBo5Rpt4tbfe5/kzEJTaNuSwRqHzZGttrkiNu5qtBnyAZFNsyXaKBDdJGggKakiutNh
-758e7riI4zqa0Qa20IdVUV1wCZ1RRHYrC7XgK2a2W8crHtL7QYxBg/OzHxFS622baDbjEIFOU1xuwPlr
-yjb4qP1lPCQE6nDBq0vncnVhr1aJK5FciGLhbQoHA4kRzN2w/FJdR7Eyl7gL88XjZb4IgUavQ4XpYpYv
-qjTF0JDXMNawsB4kyQO8lTTBnO9maeotUOCF+eR/xsn/bJL/O07+75ljwy+wmbqGgvD737O6TpJ8PV3M
-uHMDuAaYO5r72pALZv1FIs9EqRfijbhZSSUqut2unBADHQhscZ4p1jkHMtXDYm5RrbeUaa5g3/dQsGJr
-tmLCvQEloxZ0Sw77G+nWu9Cwm/BwEpxq5JJcNOfMTePpckHzAdn4/XtD67qKnpfR8zp6Jif8JsYo7JjS
-x2Oa04shP2Gdxm9518b4gjJFLuj2As0Tb7lggidJhOluW2R3i666wplr7KBCEUzhtyYmwK56hMX7ilia
-vXPHe4umGuIbfbKnM/S+awyv+Tg3GItKlPAdei9DWFImfyjS1N+31HMOCMMumKSNZMcqYqZwHDuFYe6r
-JvOKoIk7WqJxOTTtPm5u7m6c2P7tFymlTHsv2WHHSfV8lzZC2nZtRHB2Y/PK3eJPg3fb9hoO8JvCqLo2
-ih3CWy+6Mp7VZ5ARBUDFxHmFp/07I8KRfl5xrQiaQm9WomLJ2oiGSH2jFyKh7KpfxvYK3O00oivbb+UC
-vr0QK3klLfBJvSlGkZOFsaMB828X0rrRtM6FgQZFmvIeQJBdV6ylZbIOYdMSNVlM4bA+ZZPtEjtsH+rI
-9mMUf9ZEpEnBNuHqRImWO1VdH+Km+CLTFOPfcJSukYK/RjmJN250yrBjDLsvWOONkhUsGG1mr1BI0twd
-0YTmn862+QrvdlAV0MPPDFnSNB0IjRbyy9FSmzO5WAjFg1GGu19gnK8fnzeCnfVwSM+r6XpGlt7npqpr
-cgeNsWW7LscUF+YyWphjyio6h4JO+3+LdW7w9y3+noVWVoVUfLB0XOMgkMjsEPM/4O8pNhR66Y6gq6aX
-cBRdwfHjevkcCgM6Mo2Osa7NSC5x4NAbuaxr90TT9IkhhqGLRchkZytdXmbLLc0MXzKZpoMIYMAd7zRz
-AsRcZHy/xJsxPpR6LfAWLDdGJwi85i73FGU2df0g8SHXkwc5kd37SrvvTqx+PeNLfx3lqHt3rYOnC7gq
-881EkSXNiORLpkOBFooXfJxfPL5roHgxHNK7anrhoLhlQi2yXVXKVEeX4Vt0+PQxxkXfpu/oGFUp3zkb
-uru1CI6iFvnMNB2UKMhphqDXBFCjr82Pmex9D/DhluGZkvWCW8o0JYMvMvaLQL9otN2TrZUz0vMXerUQ
-GK+aBoKyBaWLIFViZDk8CCYvDZF0IrJf8DIdDFrkjVyTgyRLkmDjO6hwhM7SjyiO59tFRenEBvoCNvSj
-2L9KMehvJrY08zcqt8038JraFggIu7rutPhd08h2u91SFlma3aFUwFnqBq+7tREJ+snADuYd+/B5RybS
-oJzG8S22vGt85yIC0Ya47LaNy+7osai1qXEuc5482zkoOwVdiIOt21nYY+eP6nZU3PezqO/OXeFS3CXU
-X/qEAZ/jgH47pY1YNqWNWGJ46BD0h3+G4rG3RFsdodIAd6lNQlv/Ycc+vKi6lzXkAvAJV9NHgdoIKoKW
-/kADGlf9I1SfAGRWsqiQnQiFxAhOz8Jqc8xl2xyTSGWTNvsRh6TGFiwLjcUXMd1EMAnjkcvEs1hiJAEo
-T/C0BXxpPaIUW4/ku8sMJRpuncEjHjQOLHEJ9wHVFJNLrmKi6KRDJF6aWH6bo2cIIPYnhijm/eQ6SFzE
-wqdL8827TeUSTdnxOlEMWRT5UOfC4af4ik4TLKjk8kSrBUrnnDVWnMCneGFZnBSssiIj9j3bVKsywA8e
-u4v9g2mCvq20TYKclQo8WoD086saNloSmSOG1Y7VwnJvjyMOvBrgnEl0LGWWss5988KpxX1NPOZ8t/G0
-SjrWdqfRViHh87JKqBtbI6nmNgTqc025C3eOmu+64t1reLpAeW6iaNNtMApUWTVIBQ9Ay8dMNdZLuX2s
-8AodDD4heQkrADGUds9OX/bU81XGm1JeFNVTiQLmCpXF/I0hxjmrGG4a4dHPaNlK2Ze2epz9BbOZBvYD
-7bsGx9gEBvhdewKLeYN6z7cTw08lNISfioztUblZFldihTmuECtG1Z0qg9+Kj9CaDDGXLRTRwe0owYAi
-dX3muQzW9w8ydFI430FNsyo8NWTPj/uH+KMb4saXZwUbHLPryq/KuORTZwXs9OIB/X2qKFvxTZpupsez
-fOXB25hONRGi0OONld63kWm2YoW3//dd7R01umPF30cOuXU4IQpKuNSmjZLWBrXf7t4w/qZzkLpx/NwR
-VqnWuvTA7o2SDDSVG+UxnfHBeEuZiu/57HUYlVZjDGfaU2HaqZiqWQjUDo8d17XYaD9WYLYiU+HoqPbi
-jZ5S51mnWqcQ1Oq2g/eyddeVJ9sefi6ui7h0p1AcE7E39OmMhUvKo+hewaFiqmb532FlIhQoOqSg2777
-axbgb26pevLKULonqP27gPjTlNxW/M+KhLj+7mJ+aODGcdaR+y2y1z9Cx9lTaGDQMfl7H2tvNiTB3YYe
-yE0QggAMhrSPZ/oals9lJ0MiJglLhgLo0vhizHBeBJcN/hG7ctz4jThnpJtWvuCOiEEza07FEsVL2MHQ
-IbhqfE2Zj+TTvako4Ns2lGPgIuws/xGxqPH9dGp8f3PHcceI6WmrKu0No6kCVDwcnu7scGmOoMPw8y6h
-w4UE0pt4i+9uel1/10mejj2p0LdScNc8aG2R7Ing7tKOWdsG7Rog7EIrbOOnqCtvb9pntq4HAyQlad6l
-MNL0henRId3rtF+YvvHD8T7MAZ8EfIHEVNd+PF6wjwYB/GlKvmvmoq4HsNWdnKBzXsK8APuMna/rt9Kv
-urpuV2BdfzJ41dSge3vTSFwLc0duMW5WZIce6bIbTfa9DwfsN0tLxPSDXrrAwR0EvwerfzE9S5pJEu5w
-zO6TLNHw12oVogjhLpZlbob8QfJgKIcPkuzB8GeDNt3DhCXbfRGUMapGa8TcLvdBuFE4iTj07Z4o0bYJ
-NT1Nhn3j5Wb6fMtN8D6W0GEySzy1/kcVDA8cL8YM/6mX5O6Hb8InBMemBH2fE9QzNlBB7564sCin1WxC
-9JDjE/u1Qm+fIoSaoVnz2ChQ05TIIf8FpWV4g4Ac8iZeRgsUR9XQ+2Qoh0RNfHeHSchIMjNB/9+QQduc
-MDachOCjUNcmtJLt+0zTUDxxv8SnIzp3PTjbWKvVAwCFr5ymydBFcfrbhHlIU5gIGkVOyaNm/45IDLwc
-7yXeuXU8pvGd08Gx2t/rNeA8GVo/s79GN1Al80vSLcqSYZ81p3jd026Gohm67dCoe7817NGNKdZ430/s
-PBy+ekaSoRom7AEM3xbnw+QBa+EYr6o0jV6QOJ4kDOM/NB+PbPc6bONpxQw/xevsJX9e5c8rPmafK27Z
-SUd6fvlVyfaJE1o/6Rc4FwqGllD2FuXZUZiq+y37sJcKCLYhk59gBrMH89JbZDwIFNlpxRV7XnHJ7t1d
-QllyI+2Fu44l7FoMSrFNWO9KoSzm6H/qH/tw/KTpILy+M7oUVSUWYZH9gVHBsYKLbDVwDzsFf20LLrXB
-ckttdopZ2RQDPn0AfzuFfg9t7eJnOCJa7nQfxxuU/vihRiAT8ZXU8msZJzDRcc/zwqSJo/MzJd3tgn1W
-cwL7L6skcYFTLU/mJYlWbRL2Bl6FhtEgWDI07cboXBF0WcVB6Sy/rKZy5iJbNQSnf4BP0rpOXP+iHfaH
-6URq7c0ocI2nXtSwd/Xg2oAVRFkyvyLJkJxWjZyRDrv00s5Wa61L2yXWWSjunrX/YNI734mp+iRB4tGf
-5Sr312fCGX1vXYRsr1dUXHVZsQM7SeaaNINkyfB5NRwOicVZsWEYGeZv48UfGYh24RuNAccmewSWP7lj
-mgTKdIJqxu0BtCYIPd8Ld0eTNxAI+FEQGg5iMXJx2NpYWiNxu8YDzEfMopNkaIly5BodJlkyxC5kSZwe
-GZ/KmEtdaoMAR8Gg2wFBtuh4ivY9S9wFvo10sVfgUZYkrYlYjBhQmDFfER91o7XhgWPAYJCY3SUarzcV
-9Tm5hyVicOurNCV2yOEsSSjzRoiQklyKOwAEpIRMI5Y+04glZhqxjDJxLbYl8DWDDeBKOIoWMtdGROkN
-inG5D2xxniUPPIp4kLAHrfrFicmfVHGcZzvkT6qpmTUbw1+LgF9ydgf3yfBChhwATegQXt6EBUNMh6as
-u9++KesiArrCQND6BDY4ZicVDUCIAwtGZeNkNhjHNVo07TsCCQjbNqcp2yq1XGENHY1Sm1b9FdHYID5n
-IVzdUMSXQA+TKEJdkxWShp2gdm1+m9iAp4v1Aykr3TnmOiKxcw2qwRv/vKEYO3zIEh9jqaV80BG7fQWy
-nEUCBdPZhi0NEUuLgjiTR5G8s2mC8kIXSn6/+fq9xCv3mQ5GqSV/W01dBPBZXdsyPGMMHc0Hg5IIJmFi
-HWXtZJtD/sDpcYB7ccG2HyRNpE1M9C9DYHCIdLMySbyShyRD2RDZ3bnox230dLc7ReWoMOeTBxjBE79S
-GNhJD0JuQxBOkiig5Z5GW37D4f5tNIPVpOiyXbNk11FbdiYpEgDkXv5gvfzBIes/DbHsc9XwJN1llQW6
-LuLfAIu7VOhdn6qbQnYv8StBX5NOo44Z6bJ2nWCNWnZkfs0WzOYbMu0Fe6dfYyELSSwTUzvrc5Ex6i5k
-526uKXYuPgF8MBfbCv6wP7RzKJCOKgLNzCeVJDaikeBAtRRmeRZ9vpLda0Vija/q3HRgeDyxrVDIOFq3
-Q6YaJx1rpGUmpkp/MsRQ7xtgJ6UETim4XSc4pQhP2eO8h0TigSphrcaXscqu3HP878SefgSmFY1tJN5r
-ZXriItMjZfSV2BcteANo2lMRFKiwR54CI6v/V+2uOu0iujzee6HoJlqs7e3rXoy0q6Oq6y63EPkhRA0N
-PgSZU+RYEH+oFS96nm0tO5TiWnb8foCWftRWEdExk13JXcswjA5Fe3T1ooNvglavYy7CFK88t5LMLQnX
-MQceBCk0T0IkgAnCfUr7Q0WfSh/TwhOO3lOpszS3CYbGbEXALoZvEqQIA5mmA13Xqq6JGXIXZ9DHosX3
-ZCjdsWKGHBZ5koUyLlgjZaYLhmvZD8i9lymz0W0WwJglPuS2AsTQ5cw6zV/0dhTyHN/cUtaJ8Ew4BLMH
-wytJTCMtamV5tnMbWmv2KLsXbATS4a/No/Gj//vhOUv+co+xATqmfN9mfh87eZ13YHSODmHODMDS/J0h
-itlgYPCnf/ORZYvKZor5s8iEi4t3JAr9gydCSXf+29bcNRdQipuDH9v15S/wUJGjkLNdEcZkipV6ITKx
-pew8Du7aGRFxIhrm9MI+UkwwGoguVeA7Yqkw7FVUkw2O8RbkEAzHy6R5HFTuLGYykn74q3DPAhK50ZWF
-SOu274GEjb/ia5VN+TjTVYiw3eE3wODUbD0wuEBMPTCcFACJLihc7QgUmLAPFLffBAV+sAMKTIlAge8N
-3R99pakF5Ymr0OYCmdgFxk1YaeEyFBfGMQ4+x5ytbivOjXfJyf76rfL8m7UvZSvm2FhXg7a8fEj69o2C
-TszXan3j+9pgKWgl8Fo5tKi0rXcWWow/keEqoTb2abgQkL2VfOMkY6y9+xV9Nz/sqXZRVG9vVIiVwk4l
-3395QIt/jshfN/TheexyE3n+TezI6o/rdTCSz5JkS7eUPf9qw/6eiDHtVhy2Gu8tZZ//fb/I9F9HM0qm
-T47+nFHnjXN0+CjGnF8t0THr31L2RsZ+Jo3yh72SfO+9Ceyl5MmisMURSnfNkcOeYpGwZ5JPozt442t0
-WeLi1SYz9hpKOYf9E1wmCUvcelkkzGdglJCEJVcuWkiT/tHfA+atOdqMZ+5SWPime8I8DEXoyy1E+zZj
-7yS/d26ur4U5Fx+sKaw4l6LK9txbxSq5Espmg2O2NnqxQaCcyjUkLMS11XpVYaYwS22uClUKeMU7LV4U
-arESxsUBkudKG7Hw+wJ9dLzWYf93OzL07I2MEp5Ya1xK99JMSOsZwmfnDBUl71aFhf75vOyVZJFlPFSc
-r+RSlHflSrzQ+rLKXsste99spKUR4osg91vKfpT84fRff92MDmcP2VPJz9kLyZP5HPfafJ5Idb9lHyVP
-NmohllKJRTJowrFgqCj2SfKPMk194ChVXMvzwmoz2lTCPDnHYLYdB5Qvkn+SafrwqpKitkYu2htCP0nK
-fnbZn2Tr9gElD74fjRP6w5j9spsvFufiIWb+vZtZqIXRcoHZv/lPy/WFVqKW62JRy7Ve1FJXURf+9MXK
-C6OvxF8P/1oM29w0Hfwi2U+SO53rR4m3fgGC+0Py+23uYeygFVAU+UOyxAeATdj9ubAx5wyNjbdbyjwM
-d0J2JfDxo6Y+LsE/mqvFBL1HBPurZL9LJvQuGmivqvkVKNlfJR/AlO2b1POVPitWwA8iUgDOwyUB+i1F
-VY2Euh59+vh8/vzNJ8p+lVtmdbwA5nPIfPb80+nbt68+zH969fbpk1fzF2/f/jKfM6X3rqQPd1dnepWm
-l8Q90f19ey+WK1FaKOcfR/pG/SLuKspMZ9itBBtgECzXBVeBpKV5G7o1NvfIbUs52+GQiqmdERd8xTLF
-pzNm/MTv6+A7o69kJaCD/jGEveX+fWREpVfXsA90By13788RdBsHK6b3cmQvBBQcuTnXlP0m0zSKI3Me
-riXq9q0J+PF6Y9Hv+u2Zm9m6HlySfiIAfurCCe5UOGnv/50lA877+fHtvp2+R50UbOxtoV2MT37MnPl1
-vzHgCkv+tWtr/XcKSvNqpF0VUrJ7OJWL0grzrLBFhhZnnZ4UnBTDY/p/PWIlurvzpqGGV48IhHDXn5Ov
-OAeEaEaWRODWFy50e7wdT1AEmShxa09leZl4Kz6dphrKUWaAv+SDMbOEUjYQ+9d7WEMRX+KTSJeO0Rxt
-5wnNf9+Pqj8I3DUfhKWTD13k09kqeK19Jey+Sx+37f27MSG2h7gY4MUXvqmpmG1ZXKdYLDp1ooKABjtl
-y5UoTDyD3+wgE1tviS41HzOtd2rKBZd6OHRX+1ebs4pPZ9tc6273PmzO9vRwc+a1kYJuWaeKC+jYr7Ui
-TT22U2UhgHKO+6e1j4KSps0jdOaZWDvNZ68Fpa1c3u270lTwtsNep8eCJVvPgA1txTc+9jt+wIebRGMN
-3KLoz1Zp3ru2gJW6NweVpvk0AQglLFnrdcIS1PcBkafCk7vNBh60gXcjroWpRDLba0HqkHKlp2KW35JS
-M7H/YtQmNDszO5HcmYz8OwzNzdFRTuXUzLgKcU80K7j1YRIBdExSVjkgunjAub/0TrhL79wgM3wMQ8s0
-l9EddGGgkOwn4VGzg3SaNmjL38JMWQVrws8qoawABsQZEjeAPhc2YnuQLCSlpmyl+b3z5TvR6loYmw3G
-TFYfhLVSnTtlGnACy+6RI5duhbpQ48JtioVwDtNau/frK4xeycfsFrhNB48E8yjrh8Wm9+SFnHzITimB
-BcI2mrpWOoMVHh1izk2xusTjbhkvbkjt9LU9orvyfC/tsrG06zMgxamaMTGFvxmFkUeNx535ykf2bpbn
-xCkIcFbWmr+To72cR752IbR5jKSjSJtqIuraTnrOaYbviXgInCmeLopmlsl9RcRENEW8A3awxZs8I4ZJ
-msmtz8nspG1h0H5EZGKySzQ+I/7jbrJF9EK3mc3Elr2We/ftGnYsf72l7NnXCwyTKpnxd1vK1np0A0cn
-755qsaHgQRfXiLrecxFxsAu73+YXRDFBu4GPbKDFYOMzzS3sf5mmvfu4gMQnkk/ljDJ0AJMTGaKrappN
-9ay9Eg267q4WXOvRlbAXeoGPpY+d+//ViPbdwRy8h3Gk7ALloky51Xmt+V5JR8MHwJzbLbvQvUUa1LT+
-0LPFeYMYYEVb9xw0T1y5dytuLTfuWayuuHSPqgqxAVwtF0WUe8wSPlysTnxGXBj93dLUuuvTXfVuSChe
-9NJD9K1OO96dNE4yxQ0fHLtnWX0IttkhoQ1ligSaSwy31rfFTlZaiUWU8BadnI637Erze4RQdr/d5lfa
-gQuw9x7ObP8QtmwfGylFRS4iTMautDsizjW703zH2xXw+IVuRZ4I5CRh8TX8gzETWzbXPUmVl5emSXMb
-LEq7cgErp5FxZcKvz+T/7BZUuwUNTwb9gqF33HTKM+92ybQqRabY7j0qFjjmM42kCjtEMuUWf280v9+y
-Ew1zc4m/T4AcfAs/H3YXvF/q11dcMDGaIyYSISyKQ31mQvzhKNZ8MDD44OZ9UwmDSfDgklbFlztMwhuI
-HC12p0pMggeatY21bbRVoxp+cZVnYafJBR8O3/od5C7oaFbpQhp717bTnOdoN+o2pLh5Fr8vxPrlwjFg
-v8u4SD+1VQDC+tl/UEG5c2EtjCUj8esNsZ4OcAl13cmObcCpL+hokmYswbgxVCN0m3+Iz/Te5nriJs5x
-/cxyP8N5IHkA4o5541FP/AHHbI+Vs+yBK3Cw1ObAL5CD5MGwB5vhg+SBp22+0m4e73pYAGn6BGOrvyV+
-4MDxqM0apolQ2JofeszJM7HmuztVjOQi704gMGdoVUB66e6Gh86KCIxNvCya+oEnCkd/t0tRh7/OjIQp
-gNXYdVoNxgyQ4/xUuwNQI7mgda1aJguoRe++0HY2j9dzd7z9dW16CY7JJJS1DVbR3onB1N1FpgvCIE7q
-wcdxVzucKK7qaNsO/OKGfe/SzUYRml2KwP11WjUbxbvCiAgjBPzf7hY0xxt4phz3Vl07s9xmJYaV1BbJ
-9zIIzdbxmKmlCkfXV0zsbh6f8yDYsv3nm+jrX9j2wCGgi/vAHOERBESMKeGs/vBtpvw/XMXN8vVNkP50
-WVGYhb5Ru2c0NJj3p8+PdTSX1VMh1fmzoAqp6wYQ7UnlmLFIivnvOhlvpbxzkhxvHf14qgP6f675vVCb
-K2GKs5UAxrLUainPN837ubDZOavgd8s+a34PSzsbjLfsjeb3UslONBl/5Dpb4b3BVvdEU5VVAwG8RGSX
-5PtZEMHOtAsSODpEdRMBUkWsrrJA/NFGQOqjXV4KsX6yanaM5CJ/A9MmMFYskUzS7ZaF96xLUgeLjp1I
-rhcYpHWni3u6zaJYrEyNViHoKkCp9a/bMhcaPtuH9j1R7dwUd26RUp1I/SR+BbJhLohqdXMUA+V24IIx
-Gts6kxsBbOY5VBuM4SjwSrqvdK3fHduZSncTeud7kzvhTGEyOzr0bePdN+yV7nD+bzRlLzU/Zs80f8Re
-az7OO9HnYiHiHNbgnjMTt54dzTdywV+jVBC692mDFJWLso90shvEpLR4Rw70zMfV5U/JxuJcN5JxytwF
-VHC+juZOo/rO6Ns7YJ1G80qsltyyhYADuMDfysLvHMbdVaZS9hQLnOLvj6ItFpSs8I3Ql5FYAbcUVn6c
-TrdbsraU7ULgfpvbb/Elc5idbctVq28WxqW8n3O5I9GEsOQQ2k0ASP9JYWw3YYp2RMOHlbD8TTfJxdnj
-r7qpu7KFyAMS1oDyt4YqT82PQzRkcXPwQRPDXJXWbUFeXYmFLCwGu8HTybBg98u6WhuP+PH6gq/MwsN/
-XWh9mT3MO73WKu5yp8NMNsfGjgSuveNtjLc/+xNAPy5yPRxSAw0TMdUzGBCgQyJHc2dBPxUzjNjUvkZ3
-MGGoQB/TLk2h1EVRvdD6EjWVvLVeO5BdEf4hBv/oos4oQhy9hy4tlzhGFqSwJrptMyL22hlYKm6ZHwxU
-NP2PLpd8L7oG8JkGfIO+tLiNf+mhsE/0wsxe0N9HLlJoZti7bl/5gU7lLGIFjL/ZwURwd11r+hLMYP9t
-Z2HKkB02rbsqa6P85iXKvpeEVLyYljPKObd1/f9w9vV9atvYv//fV5HxzodYwxmYtLv725oo3EmbPqVp
-0kzaNAXCFbYANcZ2JMFkArz3+9GRZMvA/Lq7fyRj9PysI+mc71eZ1kSmcubZ5tMj+v/DBuare9dUMLti
-uzIF2Ra1HvKTR8ON2UaKwJhkEzd3+I8I2BYsjlpwJCb+nh5kgG1wPLVaG8ChDH4wIFrbZKdjd8WWnkqN
-b9Q75zkgTrolYizprBzMSlqAd6JmVz/nORXDojedouQwncbCbB2hg03KrCxnj0wMv147APZn+arlKvn8
-Wb7CC48SECe6N51u1nw6dcQSmF6nY9Nt/NADC9bpFL1zmzh+2tJSWru6Ctg0nJOpCKZIDvo/YA2+R6ht
-7lJwK3XfwWvTwdKNm/39AvIZPxaHyXbKkcq4pTpk9omjoH5VN4KJq9zgDIHITgjZDXR+jb6PknfvvIar
-4ATuqWCz5A9CqLHmYsk/VVihvHYfFZMwLre7r3uBwhyC3/5d5vIS2vKxNVtshllNGWpHiW2vRrXK1AJX
-JfzAAcTbAwij8VPjk/Kj8Wn5SP5iOp77d/FTemmydGfGgwHiZKnT4wOR/HxZcPlxmsCS6kPdXwTAd5X4
-DZmv8DzQLACkxXhgBA006PIfo3JC38bBL9NV5yHKIaJkWjqTEAvxtQBrZzy1JXojObfsH6GDg9tys9Pa
-WrOBOW8zWlhZg7cESxO4paXpz9/anL81IDKcCV2rNiAMrB8XNQLsA+Gk9HL+YFnudjGjd2WM6EsekhHY
-QZ+UVOi2S0HTtoOiqu2Q019420XT3w9cPtK7toOg07bDisqDnOeUH7h8oPrAZUaLA5cNfd524PSubLus
-acVxQGNfvCrpyOmsWuz3CbwuHUZgi8rCLxzmiGxZLbaiSPN1xpNXJfBP/nMPTpxPDu8uUpYu+WlFh3oC
-J6fXy/ouwA5hTIl80rH7xGdMwIWnKVU4Gc913BTB3pm4ZeSE7dRMO/iNfV2t/zatsyatPRybmDkQT3sl
-5mZgz5mTELiPWSXErJqa0xZKJOZoizfqtvqdjsk7dEG2bHc55YL4EM4BCtIQmXgJwaEMfeB3Q0fkkoqs
-a2lPhlGSRF38TKKIJBhs0LTOSE6Gp243WiGO/ZOWPz5jtI/VtEaDecD3e/i2pNvn3i95Xe4HZ//R2fCV
-uPeUB5G9HsLDHe+ttciphTZ+KoB/0rzIkiWsuFxw1z3JU7CJvLZ6vTz506wy/nQXHOnqbUOaGerP4Kfk
-81Ovzgjl4amdtH18PjW3SJN2bzpjygh0y7hxq1tfwbclgcwciGCD/y/x/zvzv90BTzdSpVvHW6FurKql
-1Qvl5Z5ApXsbLu17yxe9L3tX1ob+aQnfl/BrCb+V8LmEH0t4XsLHEt6W8EeJCvRIj+0sTOA7dENOJfCw
-smDrAZZZKiLwrrxHT6GhsC86ne9KPPdFFvglOsODQ+TpqTwWf8M6z3e7KF3y9EPjabmdnN9qHUTbiIyX
-6LOHX7DMqTWI4JnQbJZzyCRbLPBLVTzPMeWIwO8YmOV5eTtf57lKJecFMHVXpMDWupyX6VrhV5WzO3AF
-QgsDlmowucgyV5DxNGeSg1tK/F8f3v3EMvsfvurmN5eQCWWKlwEv7N95KVdFuWG5MOIuLBF7GUSRcc3l
-ShTGURRcahBqxSoQmq9QXIC8LCvwvGZgMy3KpeRzKErJlfjMoSjVkpkFqMmhKG8lq6CseAEVWyteFvyT
-0CA5y8oivwPJP66F5Bk4nasMrHgCirNVzpWCuk6qlLbda1IfRM9QFecZmGG7WiuNoICwEUrMcm4ESUaj
-pdZV0u/f3t72br/slXLRf/TVV1/1P+Wi+BBBSyWmHmZJ60X4H2aU2fDo7E3Q/kH2UJyK/0CzmJP67fif
-UENQJFG0B3kyjluld7uzR3bYCUa3arNITtTgi6urq77aLCJYMb1MTtfxX/0XTC/xvxc/RXsomRmZS73K
-YVZmd2AWElhyloGpGqy4ZmAnqxY658CyTJouYFKL1PxWwmyQZam5xHjmzyNYfgHLL2H5d1j+A5b/hOVC
-lusKCrYBxbGOkIkNZBlkOWQa5mKRMjvf8cqew1KCWC0gF7BiooAyhwoqyWGdA4MZsNlMwiwTMMtKmElI
-heZo4QZI8Z/NC+ArEPBhlsGKyQ/wEWQFUoPUKcj17A4UKLaqQK1YnoOqWAFGUikWoNYzUOsKqZFhDRsm
-4XYmAdckts5ECWYaGJHpA+CCAHw14xlYbWSomGQrUOVaphxSVmyYAgu1CJ4cBzJzNi8U+DqnZW7+2UZy
-Axr7QGOf6Az0ErQEu6hhFXOhTLPxPFNc4yQGu37mbMZzyPmCF5npPi7NSmqTdktqudYmZCXLhWymU7Py
-ZlwzYVYbwfJyASterPE/M/VBrVcrJu/ArXzArTAPZp6Xt9CgyALDoaU2C2CFWJm5mQqZ4rIvqorpJaRr
-qUppViaztKkUeJ6LSnGwpjswLwt9OWepGWCSi0Vh9ylYwCK/q5YgVmzBzUDl2MlcwoqpD7ASSolicWkD
-YU4V05rLAqoyv1uU9i/GkyY9qzUJCnX6sR3wP4ypcWysFYeN4LfW1EudXB8qyd3ekJ6cyyXDh0yGpPOw
-ZiclgpwdGr+0zkzJnxpaDj/fJD97J692nvzkXZzyTPKDdk8zT/ECIvnGLLfIAofqPy80BBZyySsNDT1o
-8lqDEWhuxCwXxSL5VoPn/nmqQXH9prELTL5Hl2utpZitNU9+1XsCc0ZdJQ4epn4zMu4eHE5u289JhpLP
-G9JNCybzm7bIUIDR73na8YH2e6iY0y+Kowi2ezDn1ozRkXudCIy2aisv1Pc1zdNYd0UT2DT1+Kh9qT/q
-k/l/NPlXzOS/PN3RK0ZHcwYbNoFFk/AvdcK/6D3cNR688B682MOU0f5ofEt63fHl9Hw8mfRhxmg0ncoI
-zvEjjeBTE/umjn1TmPNU7fGm9nhT7OFrdlK7qsHP5Y7nvj+Ih2ej9/HkYkxIf0FO6k6i4UGtN+EiJnGv
-S/rk/wyaS1ZzwBlZhE80pp6E6OxkvydgRtEHRvvvLy/7cM1of6wuzsSqMrt/oc/78PJYh1XM4w+OsV4T
-4mxejbRei7favyw8EPP42oUt7g1bWzpeM4SujeoChLDfkr5h7uTWvncvmiePey6NXb5W41tMPBJ/47jf
-w40ZuJbSMILoRfk5gmilogm8Oew6MY/flvRt+VfkhN5M8ozSmNM3IuaEdDrcnMffljbz5ugYQrr/tWWp
-03m+YSdM/G/YqJh0EUJOnspLmn5/1gzUF/VAfVHs4U920mB129DjJ7wbXeLPCEKW/Mb9UpfOK+B2DryZ
-syNt6KHRE3861zBNdMA0D/miA2+XpqnbzwyN0c5+FPATc6yUCN4RwQ+MRnbLxJ/fHHi/aP3mRRbBq3aM
-1+FPE2DwM+t04lqp1lnAmVNFkEynU+N81AFucagdBItNiQJezaBctweuz4osInCccVi6+/Nth4pf1dle
-t+t623bETO0t2LcsNPkzJwuumoDfSrbiw//NszcTRRbbECRpDMTgqVmFZnGNW7ljeU5i2J2TPnxvMh36
-kfuuAL/BmG+7sRzsdmcWXKWmlh6+tR6Jjsl+n2z38CujowWDOwafGNwyeMbgezaB3xj9lXml7xUj8DlY
-CUWLdz44VNjt8JmnvIv5gZG23SZdt0EIwMJOvIteUXp5GSiFdDoqwPoLPKiGIkAJanaHZwEdOar6ILXe
-s14go6DyeADQU2PPMwcW0NaHZnCW+yDOtFG5BkaWgvp5ZG3xagY6XpMhUmOsKO8VavisdyBnxcYZ1iQ5
-8InXwAks8HYl5pAiI2isSKezMiUgkMXCEu2aoiRFHGg111n6NJ27x6o5iHsQuDYzPBk6QPlomsJZM9qW
-wAvHmNXto09pLJFOJ7igc1Eo6y3L8gPpdOwPUQhtAiLcOmYEpxPzy/vcNk2B7VTVxYOzEAnMvrVvtde+
-cTz1to9Jp2NtHd07awEnQ4Hv0mNtrXOew9IcyeOVfZs3fUgSFDAJFF7VNIQfsgSoIFps7ZQP2AkdIsvS
-zu4l3QYdl5RhmbEdSxqyD7tX+pJeDcrHNz2/gvidtNstSeM6KidxxYCRgSs184/iWSzdiBBBPTxL7dYp
-SSBHvgwmoeVHetYLDw0uTvKsjaYBRdhCm0D8OkLIbiOz6qYugqSxHokJFK6wqBtwduXEM+kHuClSG8rD
-BL5vOoRsyAFk+YmeOqnz5rqp1oQ+Qq6yo2bbBpq8cYVpKieJdxtJ7CZOBtd+sTezCHR8bXvh2gUknY7/
-tBFMENcZ9bhvD81FaBdWgKR8IAdExwWVXvUOR1lBw8d6vFj7IcOGDQ9urmULFHQl9UPDLMx0VpoiWHR+
-m/B/l24AbRSa9Lh+ko+pwMZLY2sFZfVpG+Cievcw0VoLmrAjzZRJNMuUKaA7qZnyNyZ5N9656bKC1I6j
-wqOEmiQCMgHMw/b5MZOAKfg0JBGQkxD0qL2MNHV1CPZo76nj0g6KEofdMD6PSwLT2OwDKi5NO7bW+HM7
-Gm1ByW7nV023uptGunEbak3jMnDtRIbBLt2lIikoi/2yATqW9PTGoJEgxs4T+9MvZ+exRG0nNyda+bo5
-Yd0QhdUcxGwu9aSoU7fByBC5yc326ZlmbAFDAKU4sApr1IrWkMMcKnrliK1ljb0MSypHVxNYUTnaTGBB
-ReM1pcJ4nVMxWkzgEz1jg+ox3XQ62WO6GBAeL8nQRO92q0nC4xUZmlQuLzeT5LOOlzAlw/jW/DW7mg+I
-qXa72YSYQCs4x0ArODeBfHzM8/JyYQMtXaClDfTpaFUuYOlWweCWJl7Z8dHk3Ep05Yq3ssU7lSgmYJMO
-itaUP+a4dceKPtexhAo2hMCa6nja+8DvyFCN8GNiYWg4SldpPIUSCp9qkFhO5Wg9gc86zl3Rcls04+xt
-4k4VcxoWM0jwvqwIGVRPNsN4TnksRovuowmx0Hb2B6ZmZIk5CMhggVJX9mTR6cxwcJlqBkBZcSiCyjMk
-5RXzuIhFba+Ha40Mfwo0GaRUOuRkG9pa6ZHdzv58icKSl5YEyjASS4fEJ+LE26s8MUUHXrPO6nkMdJzi
-xFI0bWaZoqpWJSdGeDcrk6OGCnPOTRZebp4fMDnalJdm8cW5p+jVQD2+cVpUzcxXxLuN1MTmdKI4NkRd
-mD2Phd3ThzrOMdScDPMzSuedzqfYznDTDwl6GHmmERjaV5TxGve0u3hthYw5XMG8mfamv00WwxmmegV5
-g9Se/GWyifW3hJi4MZ4I52oC9/dFqXS7M0KaPisr+3GGkTx0OyH+64QkTKUVpgK920H5WIYCpRyVk2YF
-dlMtNq4hPpuXHQsrWHsVZKQxK80ma85YqAPUGh5uN7umZVPba3rtjw7XVlPfCDom6vHBoTk5mNzh7Kre
-7Lf+GOPJ4lA4NJKgigNlV8RXNme/uZBKYxhY06vB+nF9P7VGHOd5fJYi6EwKzKxKkpAtoj9bkTqlabjU
-Ipq82u3SmuHG7k+buAAGkvja19Jv/kAUD0pT2LM/45yQ7Qpr5Jm73a2facUzapoVx5HbzN1vr5N7dmUB
-6+Al3NDtHt5QUTNmPKOiZ/bll5XVsrmmV4PrxxlrOvwai3QzytjoejKhowm8pFeDl4/fNEFeEh2/Gb2c
-uDCk0/HBrQga+uFA+BPfubWWCoInfgjAGiFAKwRLHHqIaGNWVQa4c9vDvenlkPfJnFc6nWksawrns0dQ
-0RGq8HLjPMfOjgVUmEpzP5tR5CYrM/7mrrKC3VnW6XzWONGIXdIr8Jx5Yh5nNfFVE6/TkWagNXLtD2iM
-76WVljswZGkuYiMXfbDp19X5Goto/OVA0jKWVot845bcVftqZIPltdXa9Kb2ZnJmN7BDCcDsxzoW9dJQ
-zwMj5jhxfjEgC2sHj7kt6ML7iHm89IK0iXRHrwZ3Jw43d83h5g4PN3WGe22Eolm8AiO+X8GVWz+R8sx0
-XY1kZNtgTgBLsd/HWzduk5yBG87Jb2xPBj+KTqe+zT4GH7PvmKIs0iUrFjyCI42sJjJexLobnAGqY20c
-Ar6UFq2yWuvIQ5v8yOjWLoehJltz1HVZo54IVtHdcgcF+MWFr09j+4GMCcSfxW733IyeAH1KwpWVclsk
-y4UDwDZuUUAEhnhtt6XMGke0WJpuXngsdhow+kDI7pOzz3cY+Lg1fSMWksBHcV8gs1DbGwvkY49ASwJ/
-ERKvrAtJCJgOjX3TI7852Te4mlZr+99s8KP2HcS857VTPJfUAS52oxr4exHrRp+K7ImnpcLXxzLPfrNQ
-piacYyltQhM/aFyTodnnc0a3M1Ecl95ZiTjT1YIKGRek3kUzZDGwf4PrIDTTmE43L6VYiILl3whV5eyO
-RkVZOBRu+2iVWY9hFCUHTgPZ6dgHh2FcNFfNZqH8WBiRtxmrBzFpuUci47ajHJaJzf7kE+5xVeVBW8au
-7nBvrU+XVg7/qrynmmpPkrd/Ec3V5mRdTyXpqk/2sC5OdLWT0xyx4l+Vj+z38JHRreXe+JGBqW7ynO3h
-LXNqu06dl1UVZzJ5WpY5ZwWkStXfJrIPhozl7jt4F3MuwatWGMa/aIWhDtyOnszCsCfcbXFPON0b9iDD
-bC3xQSYZ/bxezbgED8WLr+qTPfzhG6j1TGZ1md+yloLzoarukT3Qsb6uV8ItaNGzb6WnoXI1W+zNWHZK
-Xd5SF1NcWRMcT3aQSqcYbG9OvDBQWiV6Wsq4JG3TqrI2vcZdXxQ184EyK4+DV1E0mk6bNriMrM36dC2y
-bnQZDRgePa1OGX4PVZehkq+MmT23X1Fagw2iSw1QqsgQXRITx/zF/x3VSWwvsnc790G3e0KCmUyZdBAl
-axpqVuemsmusrI0YsLJ0OkdO9/EaRGay2GXQDIQ9QV7ncNUgkHc6uVtoztYyZpC7PprTvNOJ88PFhy7j
-7R5SYgnTyrW+FKgxKkjLmNR1h1mY3sVziNhcc/mTfSQOFeWDkI9At+ySEBXZ96PJTBSXpVVBddCOFbSs
-/auY7Afv4tTl9sw+c2cE0M1OdnNqynOeOfc5RBnP2d1hyTjZVhaU0Y80s3l9x0ztzcBwcw3f4YJJaVYl
-MrA6zw++YzjAcSi8Y3RrZ9537NRsOzD+R+qIYC64TrCEEhUrIihOGzba6JXkm689xlML8cmZnh7M5d1u
-hIBaLWyo0QRYMz7txYW/CRwor7KQUjFSk0HacPSe0dRe4Vyd1XMmbc+ZaDrd5EJp5FQv7ZkpJVCMMNyE
-phCnfuKk90wc5CsL+NrWpsC5+W9Orwbz5gg/90WtqBzNJ4PqaEQzcG5VqWhlpO3eguun5RrvCb7OBS/0
-a57q2BSxwiIO17bUFUly/7V3wFeVpjzW9h5l7RAr7AEoo3mtx+9DlGQPoa3ikRlJYwsWrhB1XnD2yN4Q
-NJ609vQiyD12Jq2B4tEx6jFdI88g70e0iUg3ukT1L2ty5VlpMNCSqRflhsd8dGWvC83ZitcKUDmKv7U6
-VOtX5ShqiuYcMiuzu0HRK+dzxfX3XCyWesDv1aaynYcN3ChWmTJIWlgRY/B9YfHVZK9WTaCyFyhrOJdm
-VHzjtlek5DgW3F8wKHpTbKsGNvSBOWRvZadz1q+zOe975u8qAH4kiOZgR8VRwvjM6tPGQfKrLT7a1RiJ
-yEHVJVvX7MkxTt3PrMUtbGemHSQuUnvBdo4111/DBoC2jE3D4Ngw29Ap15N99LqwagoEXrluKE6KmHYM
-n/O89ZpZeOPdz0VctBGYTNCWMoSfBq4yFK8j6v41S/gvjG4bPZzkDwbNr+9kua6Sd2w/qBAUYi4WvQCY
-nb4rofFoQcLTlJ30utZa0j/CaAeQ8PRGtyK2MeTpGw3LuNK18Uqz5cNHRtp+gWHLLwxNUAIjOL+G0F/F
-8DNLFm1vizpxGnKQU97p/CqGz3TMPUIlbKwJl318hOCIHiwzr0TPY/J3Oro0/3p8JbTZx4WOoDJRr2zn
-/s6AK3qGqOanrLz/LT6LoiGsoA8fZ2LzgNHoYZd3H0ZPHkLgW+9Dmjy52sfRuIgg6vzt0dUgIqAV3tVJ
-zqzS/0yiHrpVZp9LtqqV8K1iuVCYGnzgdwteBOYBLY13qxd/O5MRgUJZExmn2J5lkGnIBXj71wo12+dl
-qVG/3Wq8S5dSREDaEp60OLBFzsv0w8d1qbk1XjipT2+ybSu0o+mBszvwCvRHBgjOpAE1608YNJiyenMH
-m89SAppRONX7XDQ689hMh1r4ttmsVYJrPHtl6jXsG+X/gyZCQwwtbVtHBISio34Uj95HkwsSdfs9l1z/
-YTx6/3ByQR4GbvHo/VhFD+njJ/9v0iXefQKlBdKztqJx9H48VhdR1wd//GTcp0H4bhQPExMkNmGGCQ18
-CLoPk6grlOO02iEdFBlGBJii0Yhdfr6+/GM6GY3Ht+Px5Xjcm1xEoA6K8DjGVJjqRuNxQob2k0QEUkX7
-700u4/6QPOnD+jDmeNw/HXn0/snk4klEIDdJPD775uXXb969evbAuHef9AXMrfvlZR8q+zke9SHDB4Do
-U9TwnXyKe2R4xNSSKRohXZ67OdwoWCpYKVgouFMwVTBTcK7gk4JbBV8r+KDgWsFLBTcK3ih4puBPBT/j
-yHfWIs78xt8EohD0k6LbPfyg6Dbq5HoQJdFjM7UX+PnEfJppMYiSh9FDiDpsVRmPTj39E7MW7OEbRfud
-eJjkerfQOxNlx1YVGfQX8OKk1+5vj67Q+5Wi/fF2vDWt3NuNC9IdkvF+vO8v4LU6qUWOAlPdeqPL3kV3
-+P58u4/JbjSejPvj8cTSbp13zNpB+ejRvx9+EKg2ulGgu5ErnC1d1C0gWkRmd/7WdOz/3b3fXJZF0oen
-5ufmcmeckj58r2g/7l0MyVh142Eiil05x+/eBenDr6bicTzejt7vJxfj/W70HiYXBGL7Nx4m/pMMx6QP
-vynaT0zU8z58NhklJl+8LurDjyax3uh9b9LtL+C5abhMEvhown1a5YVKfr4ZZ90+vDUu+J304Q8T7rUk
-8J2fBqPx7fl00t2N49F7MrkYjgkZqwv6ZPfe98NYXYzjPrxzMUbXl3+wy8/T84mJOjHTddw7dNyNRw97
-F8OHk914FPUuhpH5GGdd8+cw7IRcjNXFeR9+UXTLVZp88T+g2Sz5yt4XJY++BEsN8+UXsK6SL/8FOZ/r
-5Mv/AWmE3uTLryArb4vk71dgT5XJ6F/w939O9vD7KeT+yByTu/yItB94SrdKl1Xi+fjNDyPUsAWz+/Ug
-AnMa4IWuw7jf39hDIgZRPJ8nv6vYB7Fw8w/OKH3gXNK1lLxwfLtmTdIyxxhnPoCW+XN+FxFA5PWWH7pY
-T5a3vVjuPMyu0fIxDtYL2854PbQmYQ8fiMKV60Gn40to/bDMVyY9kWU5/4+iPTK7MPbPfxLri4jsQafu
-/vuthDQv2YdksYcCe4dpkT7ndyoZhaxu0QTqswQaHk0FONb/ZCb2IE/HxYfEo7jnTdxPYg8ipaMiBZlO
-oEz9HeuswEU1uRW4cSdfiz2wlG75p4qn2shPydlV/QAlUggIgcsUhHol+Ru2SBRDTiQm78wvrVocR+9K
-SFnxlP/E5/plxQsTpFAHPEspO2JQutEQVPYebq5snR4ybDTEX1Yn3MOKmGR2u9GEoAFUQzW5j0VqJNu0
-DTDfMKkHF/kHQOAEBMXrFPvuKpE070C2jvWwTARp+CgKsi38GzW+mLpvGnP/icX05a8DE3wnCC4HYxn8
-pMv4oHSBZzsmaeBumBnJBYlcFtEZpazTCYifnUssR2xCixGb2OdZRRcCgZ38tqMsHY6iAlRPi0rRElRA
-pY46EwKxpXa7rQWqQ10JnouV0FyqobtACt1IlyeocY0qGN4wZlRO3D2xtmrLKAqkpheUg5OhdyJmHlom
-9bo87IjW2N9xHXoERBTrprVyejXIH68HebdLjqKM8onN9djdlCAoPFX7U8MpxIrfmiOeyHliSfZEzt+U
-nuhSJcV+H7OUQJpSlfaOA8A6PSmA4MGuPlZ3OiHvH4E8pSeOij74X58ixTyO8QiJ2RBK25c8ux0PnfyH
-h8IJDvuDBu+r1qjFxwDPWl1TJ/e8sS2OEYLPojiKGrhrSaK/Rai6UJtrmeFM1ykqT3gdh7NAJSIsiqQy
-aCSrmoLxkaWR2HzdQ0eaxhIcvcc3PC0z/jO/zUXBVcIVNKM6CYf43hF0lFT48cqoOBxEA191WsIROTdl
-9d11njYQvO7IXmk/QmiaQmXE8v8fAAD//6fOK16PMgEA
+H4sIAAAAAAAC/7z9aXvjNtMwCn5/foXF18MGIli2k/t+3jdUozW9Jemkt/SWRVY0NAVJSFOAAkJe2tL5
+7XNVASBBSk7u58xc54NlEiiAWAuFWk+/6v3X0VdHnzZi8Gd1dPX14JvBGSSQgh59fXb+r5Ovz87/99Hz
+q1wd/aY3kPNOlCKvxOxoo2bCHNmlOHr14sPRS1kIVYnBfx19dfpfvflGFVZqRQSz9C7Rl3+Kwiac29u1
+0PMjcbPWxlZpmkAlc6nELOmFzJWebUoxcv8GHpRbQrMkVNvU5Eqnqfs/yFezkXsklmZi8GkjoOSO2KWs
+WN0qepdsKnFUWSMLmwxD+pEggt4ZYTdGHV1pOTs645yL7VZtyhKedjWk3YPscS7SFCB7LUjVQPbO2pWY
+JiuBtqhFNETbbaI2q0thorSmqIy+H76ZpvsD3ZTQ0cfGDu7oDf6bJJzzx3JQ5GVJBG2K5AeKvBOL5zfr
+e4tUnXZxLkZJku03bPTj+zevB67Xcn5LBANw9jXN3mNaq9YCar3KzZHl69xU4rtS5zADQ/8lWb3OXxNL
+RyKzTamNW31zbQiUVdx1d1AYkVtB4HuUGS4G1bqUliQsoUzys6F8aAalUAu7HMp+n6qxGcvJhPfOwufs
+qFnfTW/HYmD1S30tzNO8EoROdtlhsMmuaWPp2ijnRPhvuo4qLgZSzcTNmzmxdCjnRD06Oae+EtfkQhDF
+zmlU29zV5qHeh/lhNhrLdTOWB8YjdLFuuaqrs2M12W4J/OOCKBp/eOaHuln0yn3E8NwsNiuhbBXG1Fdn
+RubR+UgM8vW6vCWW1XCwb7Hdlqnmme7CAA6mriIeRoyppiFXriGW2+32bNhMfYA9scxwJa6PHhuT3xJF
+h+rkZEgNdmqs+nZSt6+pdNlZSEdSHVkqoAyMRigRbbYVEQ285Xc7pvjZUD0MzRiqfh8rSNMlsQye6qGP
+VvCC0Lvm7dY1w3UIEAAzXPrV0VPbbc/49dFTadozaVrvJM65f7Z0aM1tmNLuHgTATpqluyK3xTJaCIJz
+bqPJn3b32V5P5ZzcEugkszSsYeX7e3Le1HTZrM3e+d5SpHcW1h/vnbF62QBab1ZOvCSPm7qI6CcJHRTL
+3DzVM/HYkrN6tL/5b+jNdvvtv7FXdfEb6BRTzNA7v03cufLW6LUwFueC3V3l5UZkigm1WQmTX5Yi6/UM
+uzbSupczVmg1l4uNzzzbRVvxGpoIk/edHFhRAUYLTa6R0iDZ35OiO9g2HmysUfhhHgouxrBIJ7t6lUaD
+9NT1Esu8lQNhjDY/5GpWCkM7724fIpJ2ZYairAR+7KPcbqOTvEbyhVaVLgW1S6Ovj8TQv7tqAcE3Dfkc
+HTX7p7xI01OVW3kljgo9E6d+tAZW+2VNo1F9DFVpPbC5WQibprkerDfVktRJlNWP8Wn8hkTFOJTSaxLV
++94tczGYTtdGWz2dxuvlQxjJMDOGnzHJVY31HsqhgdmBPM3V2EyGsMY0s2M9iYfieX0kyHpBqHoNEMGS
+6VRfTqcJTVMxcM9HUlU2VwUM1lyPFA8ZWakH1VJvytlTra6EsWnaE5rQNCWIAQeywv9E0O1Ww/fS1K93
+WT2/sUJV8rIURFAoOJjK6tNGpClRiETnWILZNFVpqgZXq6d6o2y/z1TUnz+bnQRdkVhSa6bD+bMQ9s21
+ChvrmagKI9cWVgjzyE1vt73zHud6EO8mV1/OdZpqqIRV7rESlhX8OSD3v9m68Zbd26VsIWwWIR63KfNR
+HiierJ6RaKUROZiJtVAzQlmRpgW8NSnt8bY0TV8QC6O3Y1X8NVufnK3PWc652W5tj3ObpqaHb6QaVc0h
+nynuem4pkwOlLSBwQIrx8nodbfnuEkjTPZrTNjSHP3Zf5XY5WOU3NcXCLGU1RWLZOVOUKZg3R47UFYzt
+hCumhq53YYXWp6dfXNutSVMT1tJIZWZE/iRmgKgWW84MDmzoH1AJJFQe7deX9T76n3QTifm4N3QYKAjf
+3qah8aLfbl1v05TMRCmswP4yBIlbGzXwRZtIcNcIIBSQKA0Y/aFBrG65Oz/T1PqGNE//yTprPvusHpde
+M7kNqcQMkywPe/OzuK1gOVX8bFg9zEOrKqCLeT6uJsy4hkkkhRgMgqIjTQxNU00kTdNnxDBJM1h3ikl6
+gFp61SJb7UiMBGz0Irdwi+v2Z2SzsZ1kUfm3MWHUJmr95Y02pPuSKNwqTfF3DaUgBmuj1xUs3lChH4+7
+3XBvJVlKYdgUb85fICa7dzlicGxgaUj+QRJDWT6WE34H2Rk0b+cO0yM5JzrUCh+vHKUJ5SsY4g+SVL4w
+jPDIZK4Osxv6lvM82urfxR2bSSMKOEJ97zrUrMc5SM8eOIFNmjrK/+5Sqllm2GY9yy18OcYtT+pDsLni
+1mh/rcdmst1e6WExNhMgYMdmwiz8wOGwO/BVwKmW24FeQ3pF2TtYi98BFevqtAMBZ9QM+yTTlAgObZBM
+AWIdrOSNVFXdVc3PWM5Dcpgz/TAfaiDHsWjIHesJ0Dg4C6yA6YdacEIENaSizbulsOorut1iul9pRTMs
+PzQnoJyTvau+CiMEGMPhTeyAr0jClLjmf5BwqHkIHUFoB5Hz55LoGiKPIHIHUXGJ1zgoAr95fXuponn8
+2D2y7VhMmOa9OVFMUJZzuMbCZ34kT7QuRa6YHEB3YJHrNO3B55OZmOeb0iZ0lPPeefYjcdRaAN1uk6TH
+eZ6meY/zPyXSICTnvTNKWc1+yeldzj8BDmHCzwfvEjXDbgLcEZ6TnLK9jCrQwXnT3U/RkTgnNmp4vS0G
+PqnGXkB7Hft16bbes9zmaVq3+1A2XvgadtFgijljNRlFz9nh7Zd8F1J7nH8h1o3hyNQkgokm8Eu089M0
+JpQHK7zNnf5xUX1Vg5OL6z49jZCkHZ9PsiRpKvyxOTT2cKAv9YVYuD/Cp6OLNxxmtnuYyTn5gugkFAgX
+18Bi6UWXwp9i7om4Plpq4o/K9r/6thuddX814+CLioHNF0wMZrnNmRgUS1nOjFBMDKy4sUwMRLliePz4
+90Kv1loJZd94JFSP0kBVXAxUxYBWfm9zKwsu6keGhycX8IsQT0utxAxWZnRt+KVNAzQsjYhJYeGifzY0
+Dy3eIODqwP9C7Fm3JTrNfscaw5v1xKto+DAMzqu5qob7k9kgHeUv2Hjfq4s2E+sa1NxufLviUqJm2eDn
+uIj7/T1B/MIAjTkynlWsYBv8QO6wasXtOJ+wgiv4t+FTDTtaEMC0RJCCjoggFdQNWMdB899JRSkzZDNQ
++Uqwim0GWhWCbQZFvrYbA0/rvKrklaA0q3qcF2lKCmxhxbCKgtKmGYoKAqnwidAEGWp3DQs1xwvvN+L7
+Nm+YqfROH2ZbsNJ1g+V0Fw4c4DkOsZMV/51AAzLrmknTVJFisBJmIWZ0RCpeMCzurrk5dAuKFCyfUFZ5
+QFh3UCevmkb+THAKwp3UDFoIC9eHIJqGe9bdjlVcDXJrDbRQNWAW58SSoiFdNjB4fm5LQO4bOvyV5DDH
+rGS9M7rdwmvlXs9pg5ab9v2KJKMfSPyMoh5Jw9nUXCzMBC/UTG+3nvjG197Z0APLLrBsA0sA3u3jHiHa
++/NsaBvOlnWYrHO7GNtJ/TGXhdwCwNaeqPWLYDxh4hA1bGMphCGCjsaAAicdQljQkQLIzKG/prwS2GE3
+qQz3FR9PcEVrfjbUza4FqgeOcj1hguRwHl+6w7w5eHK4Y/JiXAT+6fmkc8HI6ajAlee7VTAlSM4I8IQS
+2k+mSV9TmhkAhHWSppZUiGzpyP3vB+ZkTrNAEbgqyU+4nC3JXQEs26qh1TL+k0/ve/iMYNF8AawSkgMq
+xtJwLXGvPJlOr0pZ2aQvXVv7CbBWWFHvpkMUnRGtKwuQLqNAi8KcxJIaQQzTLMeFq4hx3C9shn9+qlfr
+eslEaW5nmYERlS6vxCyCCUlDX2ep85lUC1+rf+vUG6Xi1gZIf8xVfo9XvEniDvf2ztiGR5yQsBcEP2OW
+10S0eGiHot+n1VhMBsdzbQrxEW8IhO5YyS9JSxRSt5/jQGrKiu12Q+iOsnkHtj1KaRpesWFwnaZszQ0p
+2byRBhEEPEBGrQd2KdRIxIOapi4Vq8gsWTcnPjAKDlVSA2DBNCXdJKyLQV1hsuNeQKd9DhDxCNdMYHv+
+HKxPgIEC0hIu+2V+O6ohee8sq4T9IFdCbyyJpqvTVRGtPxIXd2PJfM3b7ddnZ75l1lVK0/TwF+ZOcLRj
+DSilsHDOWf2BUatPWdOkXbPewn5rdo4Wnj/eRXz0b0QNnmAHnoTfP35T7NFx9c6ITpwcPykGU3EFJ/NB
+iaEYTJd59YPWn58DEEgqAvFWU/zTdW6Esi9lZYUSphraNN2IjiSuEuHeoUYLPTjWqnAgmXvrgPvMO8yc
+zzu5m7qyheaCIX213cKRLVghWCxNLUXML3GMjVpgcKQiGm8MTIczpht2lHyoUSjqSQIBVIqckzxMo+NO
+5oPwsbx82mRst70cCe8gUXdvg6rUlpqwAJxcwaGjCADpwAokkPCPjyd0mFixWpe5FSCNRjzfPYrymsCn
+WY3Rd7sG114Jc0vmePFU4Y7HDY1FivP4PAb6/qleAd223SZHCV70oH8N/Fr8J8I/O4YlOj6boNxxMj6f
+HJD/zUTMwQmrCwl4t8AQ/6dpzw7yy8qavPByuKFq1mLIAU7ksS9GFW9e1OA4jJIbIkF3IuRyuBodG60t
+VyPlnjLBRFMGVgmAiHkFfRWD6TVcMYXh7howmEqVI+epTnC8qBchuXeOUNUrvVFWzOr3Z6KyRt9GKU+E
+VIs4OZL81htADI5FyeHuVo+BEWomgF++l8ZvNWVTKJtcirk2AhuROE6DCXNi4iNQDKaO+0XEYOpqQS50
+u+/i+ui9JoIZtqBMQQ/cmheD4yulZyBEaXf6zDdj5RISSllERixF4Mk0BHuvB1RWjHZca576mdlujd8/
+hV6L2ftS2wrho/ce5+8kcoz20dcnaCc3LDQZH6dR6/HRL0XYNfc3hUtgXXd5IvRun3FzXuOfvOaQsKqN
+XI1e/yRuq+12DCTK2bB4WBMiRUBOG16Ni8kwH28m/CPZsO63mQWkeIhxdIhzw+0Otlq4z/wdqv+bPK4Y
+4mnFSrrTOIbHgNsqXgoiWX0Y4ki2iKgIfa9q/DLEaeD1XqVDivNYb7i/Y6ssRM3Scb8H9yV+zFezCzzq
+PUgPMGx/vdGbilp0F73Ekx1QZIOKImQJbW1yUFnC7RWsJ8fdEp3it03XesSm6X6DYZpd17bbqEG007yz
+/6x5t/c1byYON3DaOoGbBeP5v4r+gxAZVDiQ3RL0r2oVjadEMcFsPzlaav05obsOtYJ7UKykJQkAZEk/
+JiIuBaF3x9p/jt/UT2fsWgNyf6r5Z91Cu8dQBNLOEGMKZnHMjvWg0iYiFOPrkhjI2YkdyNmOssdwJ32s
+H9ZfHT52zPhjPX6sJwwOPjlj1xoFfO78MBtFgljuRg8qFNUBk+y4fhlCV9hTQRRlN4IYyqxO07cgoL2y
+WpdVmlo9cAMxLzfVMomG4UYc5soNLYh3wqyBeM9wEAAOTYP3OVcgvmwQe5pOBTEscUdGex1cB3Iz2hHs
+RtencA349J/4ENCa1splsGegib2zqJ7PLYJCuvsjblIcYNwy+AQ1fNaxnkk9QSfnQ/WIn6XpsR6ryUDO
+HmFNFGRfxzpITmspsWKPNe2fszNAt4hBjusODp/q7ZY8hdXDjCbHoqXB8xgb+0EPilLkcMS+gW3zQUf9
+eRNvJGaY5t27AvSQ6O1W/p1uQ8B/KE2tadtYuuqGyg6WeUVqscrQDvLZDChKENx5WaBoyQKhgU7vyUn4
+AMa0hKsCqAOzXwhU0qBcNCDvaxLnOSo8xERJIB6XErDIWE127DmqQvDWbbrO5mLH7tOOUOx5PMgfwir1
+a7wCdlKXKB1ad1im6XNspXtDIZywSz2r0vSVy/DvkAXEyehP5GI9RyQNJ+3dDtYss3hj2+AOeulKhgTI
+xLak6VuXg29Rk5/fg2CbAx3vRqamMOCr8iCRAeSt5r36kN2XNemGYnGM60b9GfiWbrFr6iV0H4lGOk7Q
+4Z+eM8Q0lBPbLU5w4lqUME13cB06QKJEyjUHbwg4sEPLw5AeEq2OXgsCJFBmcSg0sRS16+52sfimowZg
+9sYyPp4kLF6TpnNiGPBU6XZ7TPyD7xo0J3GZw+fEtrHTaz9rkYZio/7pjrjmJuZU1xKokNCEsrtYr+1l
+Z/6nYen8EpbwId3X+tSNROIg+wTO8qExlCOZSdiIQ5TJ1CS/Zgv2p6bM+Gl9IfAeIOO9/KLeywcqViPi
+d/gzAcPud/KCZiFdwe8IlaLUoMiLpRghaIYZ2SIUUfA7wt9sQdn9+lCtHf8svvLuqUEBEtkf0TQ9nO4F
+xbWqiQVNBHsL2isCdHqQvGWRFpVtVFms0/qJhu2VCFp47WU4PKSc6042kDOOFtkMBY4tRshbcVCxN1aF
+2OM+mYb7dEBX/J2j7g2ub0T4ISWe+3f13LtLpiYK+XFcMbiXL70CJtvXFEDNO+gb3hAQ6zlBWtSr7w6j
+hLXRV3Imhp4e9q+zw7jB1lLlmDx8EtX8g4gvjFLBugKcZtO0jTMGc22e58WSxCrlf+KgoAQ4Voj9oSHc
+Wzqu3UOdmYOcOcnVSGRKj96JeQm5+lr95M7YrHPkOkmIjCUhzbUTlCNA1DXKM+G44HZYDLFZRTNyaYra
+H1EKvTMoP2xSxtVkeGlE/nlX8CKcILsDjMePIpZ1RfI2sS+UsyDiDK0toA0VzccFXHMLVgXN3CNLjJPV
+0TT9JEjOwiuDHHc81znudeguzu2xlZSVPKbjnWKI78TvAu7WLhGxOZtHbXZMgEh1uGR3gLEzw/CLWc7C
+tSnTzA1PJlkZLsyZGWjHxnQrDCrNcOl1Vp6kDC/S2T5JVAqiEfc2itSxuuwS7uHzfYYlh/s41JmmhMw9
+29I/wCFJMZM7GErZPNInEfcYDKD+jjMaiHQ1vIAIBHVeeRuwjGdi5BFJcplXAhWdiOPf800QORlK2T1a
+W1DboJAzut06SWGPc2I4iqU2oCJE6d3Gwg1Jc41DbYkerPRMlDRNf4HSvgHM0zAl/5loZlhBg/gptLAZ
+xJq7/hG+U7r+ueJzrgdaDeGH64FT636jWFxPzcwEbSIYbPaTCBTUOpJTgxB+uy2Gbe2Q5GojTmp2/0nS
+x/73yXqUnCT9dZYklGl2UIckZ3dPrTZheQKhmJXRcpwzmy+yolm0Fei/RWoy9TYOPLp9BRJQJpwiF9kl
+g7qxX/kq+qxuaFU2rev4kC8yjSosMY8uE6zLasr0oG426zDjMt3ovfhyz8tVZhzDBqDn8C7du8NCyEeU
+qpRKfPBc9zDsTiZL8sBRDdue5YMKVWHe4et3quJVN4UymDQ9gFFvCX9+8pcO4FIg3xYeWsSpuwW/1PE1
+2BN8L7W7nLtSTj31NdxWh3UKN6O/kOdGMxlN4F9twW5zZgXULOpHZuvHqIJfcAXUYii3kdLUP+CcbrcJ
+kjUJ3jg6ACh42m4TqdYbmwyJ17LYbsMT4h4kN30JrMsrCCpElwT+4VhZooEUGcEvH4cSgI4v8+LzJGjZ
+IlCGQF2YSLHIUS3S72U/RB3ySKIWpKS4ILhmmksmvWozZcqvFP5MU/Z9VF808d+LPa2PIMLz9/OAWm41
+8bZPrZRugw4KceX4bIIo1LiLYMwi53deEJQB1I7JmgsGOJLzZ3okOR6MGby+0KjYKyDBX/IYntP3KXoW
+w4q/xXvDh3zxOl+Jap0XyKnKIV1W70QlzJWYfcgXRNGRR2tv5QCNH9+WuZ1rs/KFoRwMVhuRCVQX4j9E
+RBpLaixSJUxROvoiSMEMc/qymf+MOlib49zk/Itb/yJS5K5PlXxEqjT9DQgK0FKmGUxHpBKFRXFK1UBV
+3LBkro2QC+XojQTvMjZfpGl7ClUjxItpb6Z5k3OPfLIBAEFlWxkENamwtS3i/OcuS4n56dy3l9mbYOF5
+QI3uXm3VyUxbkGoe6kabzzpdaNOoge/b/u5XHdQCRVyR6Z/H9cggLs/3mU5NVXmnlXm3lRXPoYF1Y6sJ
+q5ipl0AkLf1V7OkOw7UwFjn5G6GsJc7ckXgGjCGhIAMyabu1Hqn5CkJRX0pvt5GkVMQG2T8QV6Re+HNZ
+WmGqhAmnefZSNiWtbV3GYAd+FrdgJwicsu22NvTp3gNHJ+ecc1Ob6YIKbsf027YUvxXFCQEdtq7aJd7r
+VpDl+tzmKDnN5KQo86pCUTcuvdvSyb2p5iKWmQtH5qepf0BF4aEGTPdWDlabyn6s8O5PLAOVw5EYzPTq
+rT9lmhc4QDJfB2bgE6TusFXAutVw3wEtSLpDDHFAnc3YmBXjeASOCPhghIhnFAXZo+7QjH4himZ/wQ85
+XJ63ZrtLYYBCkuMhYVFHmrw1+uaWMg1HeDL19U2nSV+ALmLLjEjW6yMsPOtt8FQhoIjtEzUC1TGFlCXw
+LqPua/s39la0rU4rYnVawAhpGlBM7R3BJeceY1jUWTP1nS8PX2saUCfdNbrJTsoJ6m+WQeobVQgebeLK
+eo6vE/sGsX007JjWMIEd3D0SZKY4snW8cHMYCz3vlxgzhTyO+Fx+J6EVRXMZreXhzWW0FpKjUinU4K6x
+z0uxEsr+p2XP4iOhsIe5yV1bI+hhZc2msLq+o9ChCgLyoLTBVCxXbp6ZagvebWcU98XJdi+Jqe44cttN
+AZj4QsFt+7350vNy1Xzjeblytc99snti1pP7gMP8Iw9pTO1dAWw3JVZisjHrKkgWUDZTbdbChCnY2DoF
+chUaU+B7UO66a7/zYHVYOrcRcNIIf3n2IHDuRF/lT4hiHRBgSMKVE6ySmmtdNXapEy4a1aLoaCqbTjHV
+fACvJ+0WwHWgEnkpQkp9DGh3DICqbo8jfwraEPj1aELM55ZAPjPwAyD0kEuB+d/io7ug9zXsMt2ADs1Q
+ksUPG+QN22xREbNFiW1OSbhqPOJn261qpz08oyA4dZJBSGgcMewfKmsbpFkg9AQUHa2imcdem0q0hF/I
+Whkg66csgVvn7S5JYxpx3gzZYKOqpZxbPDcOclfqukb1k1c8QyW+w3bsIjaRQEWPukFoGrKUVcxQuPJ9
+QaO0PVFetFjjV9YyrV/6Kgo542ceZZ8Pw9pr1SnA2c3dbtic1SjhLuQM1+YUbulICuAT3jCBpAFLlNrQ
+zARjNeHZM6rFrmH5fi/qKQyDnzc6811Gb5NDWd5WrQ+ol+eg9ydnHCzR83hDRwPEcocguGpAggRzZUlO
+o+RGELnwOX7slH9guZ8g5f6zHBefgl/2TO6zwAW9y4FyQW8wlGlkn8Sf85hFT3heN7XGZXU38jYC4YLl
+bQTCl+Ru1/SEMpgfGJ9ofawOId3DUhUQ4jVj3kgqY3pjcbC6MITdGl+0awwygbq2W6zt0BCCEAgUFeYN
+Z7qh0dSINLddoJItWCM7OhuXIVd+dSJ1Eu0dx2X14woEOUtqy1lf0SGBHVTsbWNVsI1VgJmjqse2n1TJ
+BOcdLtyH83axQGQaX2uc0t3TiLgIfcBbbVPq0sZMrP1b6ih2eRS5GXp0cp71ejlayAvnYyMW/xzbPR8X
+zhy2Nl81/mLneZ9TS+QB+zmwD1VEw2RIpy98Y0HK4ExzUJk8Wp43Dn1Bi+qqXnj2/eB45nRBYz7DtW0L
+j7ytn+ECkNiw1aZQEWjO8QPpngJmEuvASX5qiXsDFgou56ElhptBrYNnWsCKuffmNP5sSbxhntp46d45
+6ugp3PWyx0jnNAlMxW+U4Y0wAyB8oqOxf2LK/Z9k/iF2tVJvUBgbB45esJqaG86uQbsuRUePLTHsDTzS
+lmHoY9tW7RrZkegnR0nfZgJUC2JYLI7CvcbSMEnuYZXV6uiOJHFwe+aK9TxrlvMztE/zdEf+sBrmoCtH
+lDPfs0TzN/4NmILAbwLEa/pcQ4sbgsMrkZ2xk3O6q+/sLYkb0GLFxJUu/qb0vjOp9/GWzmGzjZLqapFk
+ySq3S1QoH7nHPcuuDzaQMR9lo9oJUsk8sqE9hxTBO97ImPMSt4FjJ4wtvniKYKYLpIEGretS5GOt8UeW
+nCSAKkZYnNvW0cv5tVQzfT344cOrlx/VZ6Wvla9ru/0bUA+TuTpP90t7nz/2sM+f52FkDrDk7jod/Gsj
+zO17AQJibaIO2u32nkFIZvIqoQfI0D9bF8N/GsKkwo/CuhMjlRHr8YT7H7g2NTs1Th6sNqWV61KAEn8l
+7GNrjbzcWEGSkJOw5rHNwXjd3qKHm/n6PZEw9i2jkpfxWu2U+yBu7GuNFgqRVsvfFPBmEy34ZzFzQlXC
+2CeohE/aPIxXNmh9GLHSVwJvsq2z6W0Nka9BeWQf4l3rKPXYGjoQaU60QZS4se/lZSnVItKBaMNYx4SP
+VBjqhgCvA2XJysaOoj5GXW7NZLvLnzo8B1wLRsyHjRq64Y2duuQHjsft1pmza26cfcawy14DmRgdlfgf
+nLTAf845eNLARy+4yZrvv1DfaXOoljTVqA7qkYSkD89GmII3ulD3WE7cQyx0+9I6AY8cZwqEAZ/FLVIi
++YLXsoHIAgcT6zfA8K6dFOg0otxzmv7oqo+ktP57gC+cnA0ZCEBE1Vg1Nv8gcMCLUJ077YNmhXF+GDjn
+CKX+FioSb1qvFuRoJ5AyBB8jkquhfMjNsN+XVKOJlfMh4EimHC4FjfQlcmPxl18yfhQijy+AejtJINu3
+HRuyX2xb9CGZ5gLsDXOWc+seKv673f9A4zEBTP9+t2Tve8w2EBvQqSyDUTLeBApqeOUEtQX8MyMiB7qc
+fQLhJg/en36zRAZN6gQNOIAyE/M0xX/NDnBmE7M0LcPyoxlxpYFGP1TWoR8os6nLwGbbtPxyzg/Zwjrj
+gE1sEvCbJRvoRxKqdZ/cDfXotzA4XrQdvSEzxxdJwBx1TpAAKX3VafqPhde6smvQC0vYvS0tOy0tsaXd
+wfMtpqynaT1PFS3Qf8xvluB0JRvlBlQw0RLj/m7vd8rktC07VocAaJgcOg3pPXa4u14wCeJpOZcCZRLR
+G1/mlKnx93CTmIDQGSaV/0BsJAZqVmPCpONG9M4OCbG+byN5k1+/9hctKNRPBkm/JU2LG3W3o4M/tVTo
+ujGSftp9+y3h1h/+86YfGm07NFGIuIMuiVd+NWDfYZgKOynq0VHSD40D2rux/4gEm7aWv/aEx44eQW23
+qCUUpcR4yWBbKh4XgX6ygptO0tCSIojpwa1GC8AxI4J3Dem2veYFeF+oxnLi6PFfLclR9j/8UYIfANz5
+Pc4r9+QBggaFz4+qrKggUCV83+ZE0lHuqYb6qH39noicqRzRws8alRb2gCAz9kVRH9u/auB5mhxuRWKv
+FLqd7pzrFr8C7om7OVjLdpvM87JC9oIa+ecssWYjEprZ/G8+V/fE0u53XRa0OPuftDU+J4UikRzX1LQE
+3n5zvx5wHxNBdOtqCiJ27W+lwLZA9xKC5HtAuQeitQuCa1RGK7gcTK3JVSWhLVhAVLC+nLOXx5ZUcB8t
+wNocrBglaF2KKwREV4xtMhm/koBiQgzIq3iOrdrznUNydEk1nlDqWdP+enfFJB1YI1cEnFVx2T/fRccm
+6AyALQ+awW/gp4SfOT9ja37GZvyMXfEzf9zvscvl3OsCi9hnLag40m++dRZG334NDiBxJHrnjbi/oN/8
+qwNQtAA29Nv/7gBsWgAl/df/7gCULYDzr/8Fihrb7fnX/+K808T+OT2ccQIZ8+12vd3O6F11Lb29WpFX
+4uibf2UgknSaskOX9G1WdZK+/e9s00n611k26/dbKefZ7OSkVew8W7dhvv0mW7dhzr/+Jpu3gc6//nc2
+PzmB09cNSHOILrk8OWcrTxwPl2gM5WzBycr3+7ElS0qHy5MTOlyl6TR319cV2FiUHMWLOKC1uzA9IriK
+UEckcBCaJZZZp+sUwR8EzODmeAXkJ2jgNOsrj9eX5koRjX4E69NPN9tAqZbEvrn3k4bLoR6ejR5M5yR5
+0Lf9BwklD/qin9AkC4l10xRt8llSZ6j+eYRpDO68tn/gZPxpI46AKpGlMJMMjrioSNemcCQGq3xNDrhc
+Bzbxjg6cEshBgB3NxpFGbq4CrieiVr/zTxEquIPzNrPMO4GO+bZVXENXg+I/qqFQkThaO6I+oudbrwdq
+9CRLpnzdBtxNZZLVhEqm469tOl9DF6zerVXj0bROYpYnPTAdZQioVdGCgncA+b8aEO9yK4bySQCYAqBT
+nRsiuNMKHjXALoHlXPhHtGZ1AxEnoHZkBmCiARB1lvcfWPvtbobDADmTAxXWvtsWdCRHRS0MrGo/EhXN
+AJwXIzkaV6yYZOOCVZMs8q9VqrZq0RzeE7S53W7dy9UJygyw/94Ws8dNbSujiJNwO0OfcBLPVe0a2RWo
+Vak6zttbFkfzeFs3ZdFkERfmq3yNzqsapRSfAdoF3hGI6SrawVkFN1QUqnAOGzKYgEoQpzrrh30Se90Z
+G6/lz83Aab7htwCnsZwnx8dXyRCFZDwhnrF3fHx1xDk/euC6+2AEKQELwjMYhUlXZqpI0s8BP4UVMFPE
+spwOvdptWBEJQeKZJkzcrI2oKqlV9sAjuQcsKMU2st0jAl86ukv6VT/ZJdFwz1pY9EpFnEZvFq8GcnYD
+3HIO3PIEAI+Pxc36iB8lfRAtrvsJOzo+lrMbnyRnN33ggx91HfdhOUrvkr6vrp/g+XKHGWFCsCp2dM7c
+FYHuIt78VRCR/6C5YE80/yEoVrNPmn/R/EfwnhoxgccJfXgGV6Iyr+yLkDpJ6MMnuo5UcSdu1plgcnbj
+/Nw6eUlvpQgd0o+aLxWhbKHIR01HU/yXfQtadR91mt7i+zCuaFBtLt10kzP2RVOsOE79ovvn7MeWQvYy
+MgX5QcdESb//KTZ6W0WAn/Qj/iQ6EhfxcYEklthukRaLWH+3qpF8OscGXzT/pEN3kSsfOiwoncJvTVZh
+t0Wagsz622/888kJ+nuy9O5HrMltpuaLU9UxVHffSlP/JQps5CEdNiUu6233k66VYryZHZPgGzdgRCRE
+wNAgj/CD0yVEPrvjZQMlQq+Vt2+se+PZahxvdEmxFMXnS33j1BWP/wHa5DOpHejN34FutwlcgnMjcteM
+p3vQvT116sZp7jpAszrURGxYe9zFT8B596hJ8tIhb7yDUozUU5YJ0z7dmo04aTLhNWG5z8TrXZSL78kQ
+aQ43UsB6Sdr72+3X0VS6J4be6kAIk/SJqx9JwiQL+CtLsulfNbCGJEoZHvPHOfO4JneYgh2Dv5xjPCW9
+FSY7Pi44JA98i0bE1ZJ5RLovjDs+zv0V7vj4iid9YkYO70psj6T4IcmnEkAZoE2o5Pi4oHfHx/LhGbhs
+g+bw4+M8GCYAFA2YTD46OW8BBRLz+FjSpkRIPz6G6wj1lPZd0kecnxwfF+CWcJe4gEMtA+Sb//mkDyXv
+dJR1pzKaCARp5gFbJOmBllz/bUsedB1LOtrW6bm2JrJWFYlN0gPJP3CbWMx2tE07exPEq7yEg2fquow6
+v0ejIz1wCUfZkfbGJ77CB2HSr/ISluBVXuJQU9gafs2FT35ydcOIDJOhdiLYMEWtHgSp1tGoUzxrv4/P
+JmFok2KZq4VImD4wtE87Q9vBbkx6WkRzOSjzL7cs5zIMfcWlI0oK3nO4Cj4D92S2gf3nv5v5DM65GV3m
+mcdarOSdvrmRHVZ4yz6U5wmahLLcweBKKxtSZu5ImZIOwY/tnCewp1r1IF+3AueCbpqGSX9O/SJ132c1
+2ePHb8Pm9cAxUm23eRyNjIM9jhvny3JjEpa0fRXFbM/P9ZmohpaI8aXzpKv4FxmNlh8eNNoe11ZJCI2J
+4OiJsjquAlTCIP841PY71FbK4nOS1XO/V9vx4dqgkkifQcV82kbYprllOf9LDyMVYkXvfOw1Aqd3N9LV
+SIPSuj7sSRl8kbhPGeCb7/7S4EsELzFBmRdzv5ejO3/rAqtEd2fK5C6LTb7fRHa5xGy3f2nqmX37FcYM
+vveKBFXQngiyBK0cP1jVrzUSCimeVrfx+/AvzR3X+rMihrLv0QPyY8XAtrOW/UTEywd1gB8djA9aLOk6
+8W+40o0JQ8yYjlPv400HmAPs6ZiXjFEXkqTFvAaGveNfJ5G0F/CBTNNEKiUMaDJgwnZLTG2NlKbRS2xe
+Boxu+CBU7XcnyGPpXe6xLtfeSNtdIjRE1/MKEZoOnyuSM8M22GCHQfgmGG9BB3QcaEi1LRt6osEVcM66
+owO+D7hksd3+6ZTsttvX7iFaSX+qwzoGzh+R1zFwQQpFzdKPpOK+xholB4IUNLBeBZK0UUsCq76AlFuo
+SSAOHxXEAO1bwAYM0IBJR8YjVGdrho9otRPdTF9GZPwLhQpYt2WsB+NY2O8hdbQkrXdg9Ue9ehFfG/bc
+N6/AZOiAdt7TvONC+JlqyWW9y04bGcOJ4QHltf9cp+2l8jptgJaWBO1JXLLoJjexNASc2sEPJdWt2nS3
+2L4e1KuOcAEKoElfLFSQ8eCivEAOqvpZ7+Vqn0sjp/IeU2x4C5yVXA+UNqu8lF/EDNO2W18ekcicb7bb
+kq1hEQQ/ilg55A59SqcGbsk64JgR4pM1zda4Y2f8mSLoIqGJHDKngoCPCDgMcnTekCRR9oySnGN+j/P5
+uJrUYG7z57D58xinvlWNUz8fO8UzkJ1nQJSCwHFAI7bukVPnsl4p9PSi6p/Sgwq39Rao64FDi1iKlmLd
+xMYoTXEg7ogYLA7IZKh3FH6UDFXcKJRiApMBUMZBYQ5RfVtzyGP/Kv+Pj4I7bPcHok53Y9GI4f+j8WCG
+1+MQjY0B+wnnwtWIdZkX6F8OVBDvGSd1YIy+C/we97sfoDWgwLtd7TwStWQKEK8tiWV/5iSo+CdXcLtc
+oj8pFvybHMBsf+aRw/pIpwrb8l0eu5X+DkBbvmFUrd2yLxfcbg8mR4xxS9nbrkflj6oO6bdXNE3Lg1Uy
+S9m7bkWfOleKLw4A2Zl4p0BmpvOOjaQLiBIwbBgiusYSlgT+pOScv8xHr/LsXQ4uTttO2MVB6q5iJWXK
+OVyP12u4CTimUr9fPAIP+xtCd8PDLr2Lhz6f6f45ZWKfMoVPRfpbnfPJ63UuhH3q1f4RORKBIzJ+lveT
+Z+BmPJnUeuhHCWU65G1MjpRHOzvnPyoMe8QqbsZv76mkCHmHK9lAJRUrwKfNGchja5rCDXj+6MydYTkr
+ec7mPHBBaQYQL/LRxkO8AIgNm/OihiBlExwvZxvKFC8fnY3yR5vRyzx7kSMPlM3BkbL7Wqg8C3VkZ5T5
+sGDMr5esZPVqyeZsmVcfYE2CB4DMVZOmT7x40Y1f8OuVtMJK/qgapzTDIG5uIodSweugaw2xE7rTMlpq
+8wpi09ifYL31f1Lo85DuYgLxp5gUOhfffPUaaTYiYnXpOHJPRB74i8XQEg3Bf/Mr8fQSXcPUb4MCKJtg
+RNWkk+CRgX+nwhWj2dJeB4mAvl4PKxfKCoOVw4VOD4BQ+gCae3f/Vcdk4znb8ArwICu5s65mc14NXFlU
+p1+H1w/aJcxCwmOkh13iFa9gXEXuSy3r91BsVafE5Ra8Gjhf1c+hSnYbKmdTgJ9bYVzGcd2qMDrspi77
+GOtl1/Un2NNQ2md9btpXl3/Mq8HMby32hl9q9p5fam8APHyfpu9rivA9D8/sDX9fW//CGH7gvTeRi9Tt
+tqeAT6s1EKnCOKz4Ybu9hqMQFMXdOnjOP6Tp1egqm7M/4XE1WmUz9hoel6NltmYv+YfRzXa7yBbsBaQe
+sNS5Hl1nt+wZ/zB6ut1Osyl7xT+MPm+3x9kxe8sLIsljOnrshi17TNk7jufeJk17P0r2Hf9ekReUPeHN
+YokDVtC7d2lKPiqi2WvK8P+flLInzfockXdpihnPKXuVpq8IREd5BmESNWVRrT4uoF+01VJfb7e/hTXs
+tf/UvaqDbZ99gutI4Zn5oGBTUJTGu17zPEal28kQDbZbercWtmC9sbrvhLIXEGhSsyegMvgyTV9Cf2A0
+fvCd/cGNBnui4vH6IR6r562h2m6/227J74q8paPooHrC3tLsE4CX7AkEAKEsGiS4WaepidtD2Tus6glp
+GRb94jFMnaDBk3H8edIafELyaBDrEdtuD6c7Pz1uOLmibAlUU07ZjRuSnK1xSHJ2dWBIcjbDIUGoVpuu
+3ZA8bQ3JZ/YUhyRnc/bZDckqTVckZ58pu8FCn4H8C05RHDLNY3wXvbWRaZMekGl1PzIVJIqr4b3z4Ica
+rI2csgixel/tcJgiTp3zjcOpa74ZuGIehfrXgByvQkKMG5d84/HbS8hiqwDEFnzjsJvLuK1rr3HblG9c
++BMHccw3Daq7cWigdGjgGtDAirKniDCO6ejY1ZUdU/aZN91to4Z4maRp/BZtxcOLqV5H6OfwxuGYZpFc
+0dYqGZGbNA2r5zZNbzGKEoFNsUhTiLNEWdRIj2mmoynRNNMkphp+j47tfZ80adqT1ev8dcum43u1b+Xl
+Y6QYrjDyXGReNvpe7XnYHJnx2SQzNCN1sH8wI3ZP9FHkffw3T9T0IoMZhwX+Ui3rj587FLqNOExBwOBo
+8e2225yWkRlEwmVFZNkaIuI+LDAqrpyTvMnFsK1U8ykxTBgY+Ecn52ik68QW3gStSeC6kV7eEiwSRTqD
+mxMRNTBK3YFjmKbdVF6B9vp2u5cORmmRVqvai91/T7xGbIx1nkejWd0XnAoTrRgvOpJOP8u9ZZ671xSx
+xlmodwUWLU/H6l6gc2YMCRnMCxOiuTfm76y0rtDCC23QrtA1FsWrtrQuB1wUO58ug5msULPeZbR8xER9
+7t1jiOPYY6KLNkcig9L3sumir6C1WhzVs2taW+8rOJ1bdsLBoeJIG6IFsZFHrZjRmEdfwLA1kX/vls1v
+5MGEgn9kZ/vrw7vvh8RoubGQ1IIjTA1q+hC4dt9HRWVq/s3pxezksxDrk7yUV+K4sQLMF/WuECRpQBJ2
+h03L7N7wNG1uKdqZJpwGFzX1ioyi7hG3v9o3ph272psuCWe6FEgoVKRoCpV+KSMBhfZsl2naeiWU+Xd/
+9qZp+z02eJ776rC1Sly/1RV30Athn+gNniBPSymUfScKGxddm1aIaKhgrSumeKs2jxLm9kThPxT9WL0+
+UfALaNNst7JuAnRhFgJCaN8UZJ0O9cCGyyvXg1/E5Wdp6+ssTzCzzK0gSd/0k/WNE5mvb0CCHE1EuNzz
+5Kxq6VxF+/Do15z/mv+DTSf7NR/UYhou4DUS5DQVX7VQiB29qrJnVSMRCGw4xQ5ol76o0MlDU9nSV1Yn
+IJem7HPLBI+1meIDzLTtxaomcoPzPVBSzxQ26NawpEygdnjHDBiwt9MKzptwDhI1mEE+NCgDKGjLwNEC
+Tt3DkcTR2BigI2vouKLiEZfD4uSE2oFQM7wjqBkB38SoSQTtHwZwLkHCjp89Ocd8FFMllyjHqUboJ8gg
+KsMHItgYollgLVmyRij0xHMQ7hzhWKshbgTpLrTdCRLH4OsZgjeuRWFhHbCC24GsPqrc3H4AuddryTbc
+An31RLwUc/tmLVTIKPnZUDjf0JIL8OTxuqpjpgL7qDX8bM1fVuM5RDHD/+iD751YPL9Zk4SMLy6qi4v3
+k69GlDw8TfrzfjL+49Hkq0c0YYkEFqszHGgW3JrF7i8it8yzWlOTva7IHNVVdFUYuUblqTlcbiL+8enD
+3skJGV9U7usnJ49OFyw5Pk9oDHIxfvrs8YfHF+MGcjKpIWGwQbsOPMG7B6IoS5IdHZb92vHhyVVol+BX
+zJA5K09mwE2shQVLHmkZPnSqRqAFt8RBnlfuCAjOilYx9MnJxc03whVZ4TJVZNX/hg4LraxU4Elezsm6
+U8UirmLyyBVf+OKL/tdxcYCHoM4uinZZIewtAN6OzyaBRG0KAPy0ht84eB9i5JKXQ0WmUTlmyHR8PmGX
+rOzUccz33PCHSitXae23/s7bJGcQxJuhbksGaxw2R1buhorYuKnRZhj2iKyrLVBHgeg6QQNJSRXRrQZ7
++/QQ4yL262gGG9hC78u8WnIJjVFEtgsLNeMlM7uduyse07tejEGD8bPvEVLrTZ2oNuMQgU5TXG5w89eU
+bfBR+2A8JDjqcM6rC2dytbSrMnEQyVLkM69T2OtJ9GDuuuWX6jrylTnHXTicPZwPZ8HR6FUoMJ5NhhDq
+BF1DXkFfw8J6kCQPYDyRn3U1/maSpl4DBV6YT/5XnPyvOvnfcfK/J+4avsRqtlsAhN9/T4BbNlyPZxPu
+zACuYMwdzX1lyJJZH0jkmSj0TLwW16VUAiygSsfEQAMCcHCtWOscyFQHi7lFtd4Bz17Bvu+gYMXWrGTC
+vQElo2Z0R467G+nGm9Cw6/Dw1D/AYljW58x1bemypMMe2fj9e0232yp6nkfP6+iZPOXXMUZh5xS8jQ3p
+ss+fslblN7ytY7ykTJEl3S1RPfGGCyZAB6TBdDcNsrtBU13h1DX2UKEIqvA7ExNgqw5h8a4Cq7y37nhv
+0FRNfKNN9niC1ne14jVIMdAXlSjgO/ROBrekTD7K09THW+oYB4Ru50zSmrNjFTHj80kQGA590WRaEVRx
+R000Lvum2cd15O7aiO0fvwg2e9pbyfZbRqqLfdoIadu1EcHYjU0rF8WfBuu23RUc4Ne5gYiHih3DW8e7
+Mp7Vl5AROUDFxGmFp/1bI8KRvqi4VgRVoTelqFiyNqImUoEtk1C26sLYDsDtXiW6st1alvDtmSjlSlq4
+J3WmGFlOFvqOCsy/LKV1vWmMCwMNijTlHQxBdlWxhpbJWoRNQ9RkMYXDupRNtk/ssEOoIzuMUfxZE5Em
+IA3zoRMlau5U2+0xboovoCkF/m84ctdIzl8hn8QrNzph2Dm63RestkbJchaUNrOXAN/Ejqhd848nu2GJ
+sR1UBfTwM0PmIOgRGjXk5yDbv5SzmVA8KGW4+AJnw/XDRc3YWUP8wWq8npC5t7kBafMtVMbmzbqEcMnT
+isyjhXkGBptTAHTS/xssc42/b/D3MtRS5lLx3tzdGnuBRGbHmP8efz9gRaGV7gha1a2Eo2gFx49r5XMA
+BnRkahkjBAOVc+w4tEbOt1v3RMHfNjEMTSxCJrssdfE5m+9oZvgc7Gh60YDB7XivmqdAzEXK93OMjPG+
+0MDllaGPjhF4xV3uB+TZbLcPEu9yPXkwJLIdr7T97tjqVxM+9+EoB+3YtW48cTFwOdyMFJnTjEg+ZzoA
+NKO45GfD5cPbehSXEMyxGi/dKO6YULNsX5Qy1lEwfMtF3YKx6Or0nZyjKOUbp0N3uxbBUNTiPTNNe0Wa
+RqXWek0ANfrS/JzJzvcAH+4YnilZx7klGDv1vsjYLgLtolF3TzZazkjPL3U5E+ivmgaCshlK50GqQM9y
+eBCMXhiwpBfZTxhMB50WeSXX5CgBv8dex7dXYQ+dph9RHM+3ZUUhPL6nL2BDfx3bVykG7c3ACtMNjmiq
+r8drbJtBwLHbbls1flNXstvtdpRFmma3yBVwmrrB6m5tREKRbbo2grf0w6ctnkiNcmrDt1jzrradiwhE
+G/yy28Yvu6PHotogeCekefJs76BsASIM3bmdhS129qhuR8Vtv4za7swVPovbhPqgT+jwOYI+3oM2Yl5D
+GzFH99DB6Q//E8Cj4jdNcRyVenDn2iS0sR9214cfqnawhqEAfMLV+OtAbQQRQUN/oAKNK/4Rio9gZEqZ
+V1xGQGIAp2dutTnnsqmOSaSySZP9NYekWhcsC5XFgZiuozEJ/ZHzxF+xxEDCoDzG0xbwpfWIUuw8km8v
+M0jy6wwe8aBxwxJDuA+oGkzOuYqJoqctIvGzifm3Q7QMAcT+2BDFvJ1cC4mLmPn02fxtbFMw8IcdCOFE
+YV/RyIZ6KBx+ikN0mqBBJedPtZohd85pY8UJoIfF2klBKytSYj+wTbUqwvjBY3uxvze107dS2yTwWanA
+owVIP7+qYaMlkTpiWO1YLCz35jjicFcDnDOKjqXMUtaKNy+cWJyI5pjzzcbTKmlp232ItgoJn5dVQl3f
+ak41t8FRn6vKBdw5qb/rwNtheNqD8rz5EoucUaDIqkYqeABafsZUrb00tA8VhtBB5xPghcl6DKXds5OX
+PfH3KuNVKZd59UQig7lCYTF/bYhxxiqGm5p59CNqtlL2pSkeZ3/BbKa5VQT1u3rnWAU6+F17Aot5hXp/
+byeGf5BQEX4qUrZH4WaRryCqTg0EMrdbVQS7Fe+hNeljLpspooPZUYIORbbbS3/LYF37IENHubMd1DSr
+wlNN9nx3uIvfuS5uPDzLgUV5VflVGUM+QUiv5R/Q36cKNNY2aboZn0+GpR/eWnWq9hCFFm+scF+BuS/B
+ugTb5pvaOWp0S4u/ixyG1uGEyCnhXJvGS1rj1H63H2H8desgdf34scWsUo126ZE96CUZaCrXy3MKQYl3
+lKk4zmenwSi0OkN3ph0Rph2DTlpw1D5WfkEfkD69iAWYDctUODqqCbzREeo8axVrAW233XowLlt7XXmy
+7fTP/CqPoVtAsU/ETtfHExaClEfevYJBBcS++iusTBwFut36J+7+1QvwF7dUPXkFDPP9QXobED+wRSr+
+e0WCX38XmB8quHY368j8Fq/X30HD2ROooNdS+XsXS282JMHdhhbItROCMBgMaR9/6auvfC4bFKtHYGwp
+gC6NA2OG8yKYbPCP2JTz2m4Ec3vXDX/BHRG9etaciKWZlPEehg7OVeMwZd6TTztSUcC3jSvHcIuwk+F3
+iEWNb6cT47smA7sjVp1uRKWdbtRFtlt3eLqzw6U5gg7dz7uENN1rYZoSr/HdTt9uv2klAycIv9rVUnBh
+HrS2SPbIeSftnDV10LYCwv5ohW38xJAmE8MRAMsWSUk6bFMYafqD6dAh7XDaP5iu8sP5IcwBnwR8gcRU
+W388XrBf98Lwpyn5pp6L7bYHW93xCVrnJcwLXJ+x8dvtG+lX3XbbrMDt9pPBUFO9dvSmgbgS5pbcoN+s
+SA89kmXXkuw77w7YvUdETNfppXMc3ELwB7D6F9PRpBklIYZjdpdkiYZ/jVQh8hDufFkOTZ+DRw3Zf5Bk
+D/o/GtTpBuvo3SEPyuhVo1FibpZ7L0QUTqIb+u6Al2hbu5oeJ/2u8nI9fb7m2nkfAxOMSeKp9d+qoHjg
+7mLM8O87SS4+fO0+IRg2JWj7nKCcsR4VtO6JgUUxriYjovscn9jPFVr75MHVDM3qx1qACk7O+vwn5JZh
+BAHZ57W/jGZQHFUDrkEkBCryze0nISPJzAjtf0MGbXJC33AS/EABjzXUkh36TF1RPHE/xacjzB55cLmx
+VqsHMBS+cJqCF0OYoL9MmIc0hYmgkeeUYVTtXxGJgcHxXmDMrfMzGsecDobVPq5Xj4PfAz+zP0cRqJLp
+Z9IGZUm/ezWnMIrJfoYCv+jQ1Kh5v9TXo2uTrzHeT2w8HL56SZK+6ifsAXTf5ot+8oA14xivqjSNXpA4
+HiUM/T/UH49091rXxg8VM/wDhrOX/Hk1fA6KbH9W3LKnLe7553s5208d0/pxF2AhFHQtoewN1BS7qbrb
+sfcHqYCgGzL6HmYwezAtvEbGg0CRfai4Ys8rLtmdiyWUJdfSLl04lrBr0SnFLmGdkEJZfKP/vnvsw/ED
+fGD/+tboQlSVmIVF9hsUcLjQebbquYc9wJ8bwLk2CDfXZg/MyhoM7ukQg2a+B/RrqGsfP8MR0dxOD914
+fR0z/FDNkInuldTyKxknMNEyz/PMpJGj8zMlXXTB7lVzBPsvqyRxjlMtT6YFiVZtEvYGhkJDbxAs6Ztm
+Y7RCBH2uYqd0ln8Gy2fn2aomOP0DfBKEtq590Q77zbQ8tXZmFG6NHzyr4eDqwbUBK4iyZLoiSZ98qGo+
+I+236aW9rdZolzZLrLVQXJy1/2DSW9+JqfokYao+u4dq6MNnwhl9Z52HbC9XVFy1r2JHdpRMNak7yZL+
+86rf7xOLs2JDNzLM38WLP1IQbY+vnLf7JjsElj+5Y5oEYFpONeP6YLRGOHq+FS5Gk5uBgL2ngtBwEIuB
+88NWLxH0k4UHmPeYRUdJ3xLlyDXaB3dr2IQsidOb9uHurG+pc21wwJEx6HZA4C26O0XzniUugG/NXewA
+fJ0lSaMiFiMGZGZMS+K9bjQ6PHAMGHQSs79E4/WmojYnd7BEDG59YCHaPoezJKHMKyFCCrCBYSAgJWQa
+MfeZRswx04h5lIlrsYHA1ww2gINwFC1kro2I0msU43IfgOQueeBRxIOEPWjEL45N/riK/TzbPn9cjc2k
+3hg+LAJ+CZ+zu6S/lCEHhiY0SK8DYPDpUMNiZgPrPAI6YCBofQLwZJ5WNAxC7Fgwgo2TQSEtKtGgad8Q
+SMCxbXJq2Eao5YC1JK3UulYfIhorxOcsuKvrizgIdD+JPNTVWSGp33Jq1+Q3ifXwtLF+IGWlO8dcQyQ2
+rkY13Nbsg1N2fMoS72OpoXygGG9egSxnEUPBtLZhQ0PE3KLAzuSRJ+9snCC/cOjC4x5UX7+TGHKf6aCU
+WvA31dh5AJ9st7YIz+hDR/NeryCCSZhYR1k73mafP3ByHLi9YAFYz8HTJib6F3AW2CfSzcoo8UIecBFV
+E9ntuej6bXRgjqIkcpCbxegBevDEr+QGdtKDkFsThKMkcmh5oNI605Oqu2gGq1HevnZNkn1DbdmapIgB
+MPT8B+v5Dw5Z/27ATLyq7yTtZZUFui66vwEWd6nQui5VN4bsTuI9Tl+TVqXuMtK+2rWcNWrZ4vnVWzCb
+bsi44+yd3neFzCWxDAQl3VtkjLpz2YrNNcbGxSeAd+ZiG8Yftoe2DgXSEkWgmvmoksRGNBIcqJbCLE+i
+z1eyHVYklviqVqQDw+OJbZhCxtG6LTLVOO5YzS0zMVX6vSGGetsAOyok3JTOhk3/lRtP2bl594nEA1XC
+Wo2mqpBtvufZP7E9fQ9MwxrbSIxrZTrsItMhZfRKHFhZRxtA056KoECFfe0pMFL+/1Rv2aoX0eX5wYCi
+m2ixNtHXPRtpX0a13bp5OWCHEFXUex94Tk3+PP5Qw170d7a1bFGKa9my+wFa+uumiIiOmWwl9zXD0DsU
+7dDVsxa+CVK9lroIU7zyt5VkakkIxxzuIEiheRIiAUwQ4ikddhX9QXqfFp5w9JZKraW5S9A1ZsMCdj58
+k8BF6IGyjN5uFTDY+9z5GfS+aPE96Ut3rJg+h0WeZAHGOWukzLSH4Up2HXIfvJTZKJoFXMwS73JbAWJo
+38xa1S87OypJ/mlLWcfCM+EQzB70V5KYmlvU8PJsKxpa/cmVbAfYCKTDxebrs6//D2iUX7jHWAEdU75t
+Mr+NjbwWrTFaoEGYUwOwdPjWEMVsUDD43b+5z9/llc0U82eRCYGL9zgK3YMnQkm3/tsQusP3CdRBvmvW
+lw/goSJDIae7IozJFCv0TICYni2iWqetHhHHomFOLuw9xQSlgSioAt9jS4Vul1FJkLyAp+3gDMfzpHns
+VO4yvmQkXfdXIc4CErlNiqN1m/dAwsZf8aWKGj7OdAUibHf8N8PgxGydYXCOmDrD8DSHkWgPhSsdDQUm
+HBqKm78dCvxgaygwJRoKfK/p/ugrdSmAJ0m/nQtkYnswrsNKC8FQnBvH2Pkcc7q6DTs33iVPD5dvhOd/
+W/qzbNgcG+tK0OYuH5L+PqKgY/M1Ut84XhssBa0EhpVDjUrbWGehxvhjGUIJNb5PQ0BA9kbyjeOMsSb2
+K9puvj9QbJlXb65V8JXCPkh+8ESIkNMJubimp4vY5Cay/BvZgdUf1+ugJA9RMsEVwvN7K/ZxIs5ou2C/
+kXjvKPvzn9tFxn+cTCgZPz75fUKdNc7J8dcJ/Q8gWmr9O8pey9jOpBb+sJeSH2rDjr2QPAGjvxPk7poT
+hz3B7e0zycdRDN44jC5LnL/aZMJeAZQz2H+KyyRhiVsv4DnXZaCXEAhvqDcqTv/o44B5bY4m45kLCgvf
+dE+Yh64IPdxMNG8T9lbyO2fm+kqYhXhvTW7FQooqOxC3ilWyFMpmvXO2Nnq2wUH5INeQMBNXVuuywkxh
+gGmeq0LAqzBGmx9yNSuFcX6A5EJpI2Z+X6CNjpc6HP5ui4eevZZRwmNrjUtpB82EtI4ifLZgKCh5W+YW
+2ufzspeSRZrxUHBayrkobotS/KD15yp7JXfsXb2R5kaIL4KA15HvJD8d/3FxPTienLInki/YD5In0ynu
+tek0kepuxz5KnmzUTMylErOkV7tjQVdR7JPkH2WaureByq/kIrfaDDaVMI8XgETaBihfJP8k0/R0VUmx
+tUbOmgihnyRlP7rsT7Ix+wDIo28HZwl9dMZ+2s8Xs4U4xcy/9jNzNTNazjD7F/9puV5qJbZync+2cq1n
+W6mrqAm/e7BiafRKXJxezPpNbpr2fpLse8mdzPWjxKhfgOB+k6AS48fYjVZAUeQ3yRLvADZhdwth45sz
+VHYGiq9+DPdcdiXw8ZO6PC7B3+rQYoLeIYL9WbJfJRN6Hw00oWp+Bkr2Z8l7MGWHJnVR6su8hPsgIgW4
+ebikwdqxQAdCXQ0+fXw+ff76E2U/yx2zOl4A0ylkPnv+6cObNy/fT79/+ebJ45fTH968+Wk6ZUofXEnv
+b1eXukzTz8Q90cNteyfmpSgswPnHgb5WoLRCmWl1OzweCRiDoLkuuAokLR02rltjdY+hbShn0PAAlgBx
+zlcsUyCAM37iDzXwrdErWQlooH8Mbm+5fx8YUenyCvaBbqHldvwc0Bi2cYfkwC4FAA7cnGvKfpFpGvmR
+WYSwRO221Q4/Xm0s2l2/uXQzu932PpNuIgz82LkT3CvwtIn/OwFGQTc/ju7banvUSMHOvC70nfNnc86c
++nW3MrgVFvy+sLX+O2BqUg20K0IKdgencl5YYYBLmaHGWaslOSd5/5z+v75mBZq787qi+q4eEQgh1p/j
+rzgDhGhG5kTg1hfOdXu8HZ9CaZYocWM/gGNrr8Wn01QDHGUG7pfAmLSEUtYTh9d7WEPRvcQnkTYdoznq
+zhM6/PUwqn4vcNe8F5aO3reRT2urYFj7SthDQR93TfzdmBA7QFz0ANOEqsCAnsVl8tmsVSYCBDTYgi1K
+kZt4Bv+2gUzsvCa6hIAnWu+VlDMudb/vQvuDsSAHSyOt2817v7k80MLNpZdGghFHq4hz6NgtVZK6HNsr
+MhNAOcft09p7QUnT+hEa80yssaJuDUpbOb89FNJU8KbBXqbHgiZbR4ENdcU33vf7jtUfRr9COJI52rNV
+mnfCFrBCd+ag0nQ4TmCEErCYWycsQXkfEHkqPLloNvCgDbwbcSVMJZLJQQ1Sh5QrDWoeN6TQTBwOjFq7
+Zmdmz5M7k5F9h6FDA7ryEgw7VPB7AmIJ690kwtAxCXrM8OT9AQ990Dvhgt65Tmb4GLqWaS6jGHSho5Ds
+J+HregfpNK3RlmuXpqyCNeFnlVAGfk68InE90Atho2sPkoWk0JSVmt85W76nWl0JY7PeGZPVe2GtVAsn
+TIObwLx95IB0BvroXI0LtylmwhlMa+3er1bovZKfsRu4bbrxSDCPsq5bbHpHfpCj99kHSmCBsI2mrpZW
+Z4VHh5hznZefIWU4jxc3pLba2hzRbX6+53bZmNv1JyBFkBmJMfyb0B1rVR435p6PHNwsz4kTEOCsrDV/
+KwcHbx7DtXOhzWMkHXnaVCOx3dpRxzjN8AMeD+FmiqeLopll8hAI8GcDiDfA9t8xo2fEQGRwufM5mR01
+NfSaj4hMjPaJxmfEf9xNtohe6C4Dey32Sh7ct2vYsfzVjrJn9wP0kyqZ8Lc7ytZ6cA1HJ2+farGi4FEb
+14jt9kAg4qAXdrcbLoligrYdH9lAi8HGZ5qDfRaIRDvxuIDEJxKinUNQYjPhciSDd1VNs7GeNCHRoOku
+tOBaD1bCLvUMHwvvO/f/Xz06FIPZg7iesiXyRZlyq/NK84OcjvoeAHNud2ypO4s0iGn9oQcukAJigBVt
+3XOQPHHl3oGHxY17FuWKS/eoquAbwJVyXkS5xyzhw3n51GfEwGjvBipzGD7dFW+7hOJ5Jz1432rV481J
+4ySTX4MGMz7L6n3QzQ4JjStTJNBcYoha34A9LbUSsyjhDXwa0OxK8zscoexutxuutBsuwN4HbmaHu7Bj
+h66RUlRkGWEyttLuiFhodqv5nrUr4PGlblieOMgJCPajDp0xsWNT3eFUeX5pmtTRYJHbNRSwcmoeVyb8
++kz+r31AtQ9oIPRkBzC0jpsWPPNml0wDE0ax/TgqFm7MlxpJFXaMZMoN/l5rsCV5qmFuPuPvYyAH38DP
++/0F75f61YoLJgZTxEQiuEVxqM+MiD8cxZr3egYf3LxvKmEwCR5cEgQewiR4cElgv4RJ8ECzprKmjqZo
+VMIvruIy7DQ54/3+G7+DXICOepXOpLG3TT31eY56o/iixPWz+H0m1i9m7gL2q4xBuqmNABDWz+GDCuAW
+wlroS0bi12tiKYsSIPZ9lB3rgFPKIpqk7ktQbgzFwP/5+/hM72yux27i3K2fWe5neBhIHhhxd3njUUv8
+Acds5ypn2QMHcDTX5sgvkCOILNkeG1Ds8LTNPfUO410PCyBNH6Nv9TfEdxxuPGqzhmkiFLbm+87l5JlY
+8/2dKgZyNmxPIFzOUKuAdNJdhIfWiggXm3hZ1OXDnSgc/e0mRQ2+/zISpgBWY9toNSgzQI6zU213QA3k
+jIIH0fqSBdSiN19oGjuMnnm7v911bToJ2ANDQApdtzHaO/EwtXdRq6KqZid1xsfdrvZuoriqo23bO8vq
+fe/SzUYRmn0W4fbXqtVsFG8zIyKMEPB/s1tg4WPUnnpvbbdOLbdeiWElNSDDgxeEeut4zNRQhYOrFRP7
+m8fnPAi6bP/5Jrr/C7vOcAho4qFhjvAIDkSMKXvnnVr2L+X/4Squl6+vgnSny4rczPS12j+jocJhd/p8
+X8HN+hMh1eJZEIVst/VANCeVu4xFXMx/amS8lYatk+R85+jHDzqg/+ea3wm1WQmTX5YCLpaFVnO52NTv
+wMxesAp+d+xPze9gaQPzjb3W/E4qabP9I9fpCh90tnrAm6qs6hHAICJ7IPxHQQS71Fg5HRyjuIkAqSLK
+VRaIP1ozSL23S3A9+risd4zkYvgapk2gr1giGQRhZuE9a5PUQaNjz5PrUpAotW7igWazyBcrU4MyOF2F
+UWrs63bMuYbPDqF9T1Qzdaj+oWp56ifxK5ANU0FUI5ujlHXHBX00NmVG1wKumQsoBjLeHfNCunua1m2O
+bU2li4Te+t7oVjhVmMwOjn3dGPuGvdStm/9rTdkLzc/ZM82/Zq80Pxu2vM/FTMQprMEDZyZuPTuYbuSM
+v0KuIDTv0wYpKudlH+lk14lRYTFGDrTM+9XlT8jG4lzXnHHKXAAqOF8HUydRfWv0zS1cnQbTSpRzbtlM
+wAGc429l4XcK/W4LUyl7ggAf8Pc70YAFIStlTVsGoGVs65UfpwNneG0p2x8BCKz3d/eSKczOrrlVq78F
+xqV8+OZyS6IJYckx1JvAIP0nwFhvwhRtsYaPK2H563aS87PHX7ZT93kLkQUkrAHlo4YqT82fBW/I4vro
+vSaGuSKN2YJcrcRM5had3eDpZFjQ+2VtqY1H/Bi+4J5ZOP0DgkBkp8NWq7WKm9xqMJP1sbHHgWtivJ1h
+9Gd/AuiH+VD3+9RAxUSM9QQ6BOiQyMHUadCPQaG69RrFYEJXgd6nHfBHBtNlXoFwGSWVvNFeO5JtFv4x
+Ov9oo87IQxy9gybN59hHFriwJoq2GRF7zQzMFbfMdwYKmu5H53N+EF3D8Jl6+HpdbnHj/9KPwiHWCzMH
+h/4uMpFCNcNOuH3lOwoxJ20UVs9Jwkw07q5pdVuCGuw/NhamDK/DpjFXZY2XX3Dvi96xScXBsS8FRtB2
+W8FoYqTyPESbL/bC/3cHWKzuxakMTsV2ZxS9U7Ue8qPz0RUcIyoyJrkidefYOWVuBNXeCIKbY8+nZyby
+bbC/tVoHQJcG7yyI1jGZpu5UbOmp1P6NBseiZOgn3QVi1PxSD4EJwUISh1MdgnLLkRpMp0g5TKdEwtER
+J7iqALOAv+MGX3sH7M/LVSvViPnzcoUMD83QT/RgOr3aiOnUB5bA+tLU1dvkYQY2DHwNHbvK0zQkonq4
+T/UdcHX4JOgI1kg78x9FDb6HqG14KWnaPEfSpg7qxsP+fgK5J/bJYXo3FUSEufLJqKi3BxqwOhAmvnPD
+HjoiO0BkN67za+/7SHkPjmt3FYKyezrYoPxh7GqsYSwFUYUjyut08FMQlRXu9PUSqDRtvwe5zMkJa9PH
+zmyxWWZ1yFC3Stx4NapV0AvESviAC0i0FxAWE4fWJxd76xPBd/+wHY+DXPyQXprR/s7YWSCeljq8PpiN
+2oLox0GjQVFH9xcd4PtOfIKRcaZqDQKgrYgHQGigQVd4GOsJ/4VEbzBVx7GXQ/SSiXPV8oX4TjJnZzx1
+LfpghHDRP+IE727L705na50P4b6dc+VoDdEiLAG4paUZ7t8W7t+WoWc4gK5VG9ANbFgXtQfYI+mpdD0/
+WmpwKsxvNUHvS8ElI8s7c6K5tO0UxYt2QsWrdkLJfxbtFMt/7aT8xW/bCZJP2wkrbjpfnnPRSfnMbSfl
+kqtOyhX/qZ0g+K1up2z4WuCCxrl4q/nY66w63+8T9k57H4GtUBYBccAV2UW1uJOqKDczkb3VTNyExx3z
+5HzW5V0UebEUhxUd6g2cHcaXNS/ALWGsid5Y4h9RjMkQ8TStijfjsSVNExzPxKORA7ZTl9a739jV3fq/
+W1evqWvH9k3MXA+1Y4n5HTjw5iSU3RdZJfZZNbXEOYJFc1uoxXc/TeHbcQpGy/bMKQ8SIHwCU7QJZBIo
+BO9l6LO4HflALoWc9V3Yk1GSZaCgjXa1CTgxBBvwZnTGZjI6xN1oQeznZ618FGO0r9W89gZzJHY79p3m
+dz+FvOyd3g17/6O74Vt57y2PJY49hJc7MdhYWXLn2viJZOLGglfWJVuBwNxPT/aEuUreOb1ekf0JWCbc
+7qIrXX1sGNih4Q5+iD4/JHVGVx6+0Ng64fOhvUWbugfTy7wCgm5JmrR69Cv2naZsZomg7Ap/l/h7C7/u
+BDw8SGvbut6CogaqWjq9UKFBIm4HV8I4ecvXg28GZ86G/olmP2j2UbNPmn3R7EfNftLsL81+0ex3jQr0
+GB7bW5iw7zENYyqx4FaWuX4wF1kqoew3fY+eQhPCXqXp9xrvfYlz/ALqfxZs03x4Kg8URZ0Hl2jFUhSf
+m0xsR8hbbaJiV3ImNObs2M/Y5sIZRIiZtMBmZDOTLxb4VK1FWWLNEAEGgfOy1NfzTVlWhRFCsRx46Czf
+WD3XxabCp3WZ3zLfILQwyAvL4CtGlxWbiaLMjWAelYT/Ad6/YpvDS+g6vAvDZrKC5s2YUO4/6GcrfZWX
+EshdtkTfy0yqmbDCrKSCRKmEsUxWq3zNpBUrJBdYqfWahbhmzH1U6aURc6a0EZX8IpjS1TIHBNR8QWkw
+z2Z6LRRb55tKaCVupGVG5DOtyltmxF8bacSMeZ2rGXPkCatEvipFVbG6T5U2btzroD7oPaNaCzFjsGxB
+3RydArIrWclLMORhIufJ0tp1dnp6fX09uP5moM3i9Pzbb789vSml+pywlkpMvcyylkT437DKHDwmBxO0
+f9MdU4fKH9kcAvIHyP9mtQsKsOJg5mAZj6W32965W3Yy53fV1SI70IOvz87OTsHLOVvldpkd7uP/OYVw
+u/jz6mWyYzqHlQlxKtilnt0yQCQMQlUw6BpbCZszt1mttKVg+WxmYApyY2UB75WEA1JrKwyWg3/nbPk1
+W37Dlv9iy3+z5X+z5cLozZqp/IpVAvvIZvKKzWZsVrKZZXO5ALk5pCPLXrClYXK1YKVkq1wqpku2Bv42
+25QsZ5csv7w07HIm2eVMs0vDCmkFWrgxDPE/mysmVkyyz5cztsrNZ/YXM2tmLDO2YGZzecsqVuWrNatW
+eVmyap0rVlmj1YJVm0tWbdYYGplt2BXEr700DHFSvplJzWAbAMn0mSFCYGJ1KWbMaSOD4UO+YpXemEKw
+IldXecWcq0UWguOwGdzNVcVCnwtdwp8bJL+gcQ4szomdMbtk1jCH1LCLwIJncynKWSUsbmLm8GeZX4qS
+lWIh1AymTxjApK5qj1L1xgLk2uiFabZTg3lnwuYSsI3MS71gK6E2+ANbn1Wb1So3t8xjPiYcMc9gn+tr
+1niRZTkurepqwXIlV7A3C2kKRPtyvc7tkhUbU2kDmAlQW1UwUZZyXQnmTHfYXCt7Ms8LWGBGyIVy5xRb
+sEV5u14yucoXAhaqwEkWhq3y6jNbyQqC9p04IPzSOrdWGMXWurxdaPcfyxmoz2lNsgp1+nEc8AdLWlwb
+m0qwKymunalXdRA/rI3wZ0NxcC/rHAWZeY6KuZv8IEVQ5l3jl9adKfvTslbC6/fZa8vaaufZy5DilWey
+F9aLZp4gAyJ7ZpmTraFJXPbKsshCLntrWRMeNHtnGRA07+VlKdUi+86yEPvniWWgMt/YBWY/YApYCsnL
+jRXZR7ujbJ5z34mOYOqTJRZGYj3bz/OUoRHzJuimcybzyTrPUAyL3yPaCUAgFcu9fhFJEna3Y3BvneVg
+NxZswYKZVm3lhfq+MDyNdVcCQW7qfvxlQ6v/sge//xd8fw3q+mx5eKJXOR/Pc3aVT9iiqfjnuuKf7Y7d
+NhlChQyhdmya89PxxTUd9C9OpscXk8kpu8zBHMok7BgfioTdNKXf16XfK7hP1Rkf6owPasee5ge1qxr/
+ucLHuT8dklFv/AeZfHVB6emCHtSdlPNQheJ1wYwM+vSU/tewYbLCBWfsPHyiMfUk9s5OQXkKVtHnnJ/+
+cXJyyh7n/PSi+qonV2s4/ZU9PmVv9nVY5Zx89hHrLaXe5hWo9Zq8tUGyAILWxx5W3QtbWzo+ztF1bVI3
+IHb7bfiH3N/c2nx31Yg87mEa++86jW85CZ74m0RQJoCF60IaJix5pb+AyWKVTNiH7tTJOflF81/0PwUn
+DGaSPc6JAEfWAvxCCLiP/6Ldx5urY+zS/Z8tS73O8/v8gIn/+3ysJn10IWcOfcvAvD9vFuqreqG+Ujv2
+Z37QYPWuCY+fiX5ygq8Ji6PkN+knVvusKLZzlJ17O9ImPDRm4qtPjevEBKyzGy86yvZ1Qt9e52iMBpGe
+X+Y+KiU670jYi5wn7sjE12ed7Fetd6FmCXvbLvEufgWA4WtwjVQr1XoLOK1a1aRp7eejBrjGpdYBI9Ci
+KK5m1K7rTupzBazb/Q/Hrbv/u20o8rb+7ON2X6/bifhRxwX7Lo9N/uBmIaoG8DuTr8To7zIH4HWDOAia
+NQZi7AlgoUtS+63c5mVJCdse01P2A3x0FFbub4qFAwae3cHSOe16zrlKHVp69IvLyECBcJeBaWvOx4uc
+3ebsJmfXOXuesx/yCfuU8495UPpe5ZR9iTChbMWdjy4V7jh8HkLeEdEx0nbHpJ82FjtgyQ/IRc84PzmJ
+lELStIp8/UUZ3DLVVFU1p8PzKBw5qvpgaL3ng4hGQeXxpnBR+57PvbOAtj50znplAPGmjZUfYIxSUItH
+Ns5fzdCSDR1haAwQF6hq9HzQobMIJLMNzTo5ZMMEZQvkrhDBCowISioKsfEFvMyIdIF2oSmZIpFWc/3J
+UKdPD75qOmU7wLWZ4UHoyMtHMxTemtGNBDIcwTjRj489pLFE0zRi0PkiPB+AKgBNU/cilQTPujm6W8cP
+scOVBfQ+d0OjcJzWdfNYL/YE5mTtdzZo3/g49W6OKcgGQeLv5ayKHYRiYUr32oJiTdD+G5EVfgjnkGZI
+YFKmgqpp7H4I4KCprWjtXAzz/eqHLkp7fm/QbWaJ5jm2GcdR8zj6sJfSg76Qfvh+EDBIOEn7fU2b1LGe
+kHXOcjr0rc6DUHxGjF8RMupHiFJ755UkMEa+iTahi4/0fBBfGnyZ7HnbmwZT8QhdReTXnofstmdW2/RF
+0oJY0DZQvrGoGwCqGkgDmbDA07TzcQS+bztEzVpGLssPzNRBnTc/TbUm9J7nKrdq7tqOJt/7xjSdMzSk
+gcfJdc4EHT4OyB52EbPksZuFxx4QoqX5R1cAQAayve7bS3MR24UpZrgYmiG1RHETVO9wlSkeC+uRsfZi
+hgMbX9z8yCokdA0PSwMQM7/U0ATnnd9V/H+v3qbtt7FJj58n85BLHLyCOCsop09bl5nWpwcUayE06VYa
+tEk2aAoa6G9q0P7GJO99SG6mTNE6cayCl1CoQkQx7eEbbs73IwlAw6dxEAEziZ0etdFI01fvwR7Ah5Zo
+tyg0LrsROSaasimBc6AiGsaxheOP3Wp0DQWBkMeaHrvDIL33B2odxmXox4mOolO6z2WmeE4C2mCWGH74
+YLAYIMbtE/ca0NkxMajt5PdE67t+T7g02BPQ8KH7Sr0p6todGB1hbHI4PkOkGdfA2IESiazCGrWiDSvZ
+nK35mQ9sbWrfy2zJIcQoW3EzvpqwBZdN1pRDFGF2zOV4MWE3vJcP1w/5VZrOHvLFkAqypCMo3u+vJ5kg
+KzqCWk5OribZF0uWbEpH5Br+w6kWALHWfn82oQC0YscItGLHABTK4zdPThYOaOmBlg7oZg8rK7b0WDDi
+0pCVWx/Nl1uVrnzzVmx6X6VYgas6alrTfiLw6CYV/8kSw9bsilK24ZZMQVRIR9UYHybODY1A6qogU6aZ
+CrVGlZXcjDcT9sWS0jetdE2D5GATd6iZ07iZUYX3fYrS4frR1YjMuSByvOifT6hzbedesDagJeZMshlb
+INU1e7RI00tcXNDNyFEWiUlQiHMo8UkRWdvrIa4x8auEcUFfkc5zsoN2VnpgQEKkt9FrZLYSaRgDv0iU
+E3lA9mr203zAXVZwp+cxtKTAjVXxotllFa9qVXIKxDtgJh8aKv5yyU2N0Ni8E8nR1bwE5It7r+Jnw+rh
+e69F1ez8ioa0cTVxXzrQHAdRN2YniHRn+siSEqHmdFT2OJ+n6Q1xOxzmIcMMoGcagqHNoiQbPNNuycYR
+GXN2xubNtof5hk+MLrHWM1bWeTT7x2ozl+8CYuLBeADO94TdPxe6su3JiMP0OVo5rDMsFFy3UxqeDlDC
+3DhiKtK7HeqHJiYozVhPGgzstxqB1Ng/W6AdlSOsgwoynFLI1YIKMHR0e3n40+wx101vH/PH4erw2Gnq
+A6HD1YGVHN0c4Ousd1Yf9nfhGhOCxSFxCJRgRSJlV/SvDHe/uTSVRRi24WfDzcOaP7VBP85z0ivQ6UzB
+csBKhtI79P7sSOqCFzGqRW/y1XZb1BFu3Pl0RRTLmaGh9zX1WwK7S0Nje3+SktK7FfbI177zXD8YxR7n
+yq8jf5j796CT2ztzDuvYG/YeTEk/cFlHzHjO5QDO5Tdrp2XzmJ8NHz+c5c2EP8YmvR/P8vHjCahQszf8
+bPjm4YcG5A215MP4zcTD0DQN4I4EjfNwIfyJcm5rTcUiET+LnDWyyFshc4FDux5tDJMYTnDDSne5h1mO
+4z7BfSVNp8TUIZzBFxoEv0FoQ+kcJ5tItsZaGv7sjMMuhoH5AJ56oUBvlqZfLG406lD6moWYeXJOZnXg
+q6Yc+Ntd5lVD175AY/xArbTSIcb2GVx1gS767Oqvu/MUmwj5Zmi4JsZpkV95lLtqs0ausL2uW1eDqeNM
+XroDrEsBwHlsiaxRQ70PFjwkDhdDusBdjGieLfgi5EBo/EBIQ6Fbfja8PXC5uW0uN7d4uak/uLNAFF2S
+FQPy/YydefyZL/zU7XatMZhThq3Y7cidX7dZmTO/nLNP+Y4Of5RpWnOz952POTmm1KpY5mohErankdUU
+Rkas5+AMUR3rynvAN8Z5qwQlkODa5Mec3zl0GGuyNVdd/2nUE8Euei531ICfPXx9G9sNDaGMQCzln2D1
+RN6nDHifwrCucZBl5R1gQ1oSBQJDf23X2syaRLRYml69Cr7YeRTRh0XPaN2JwPujGQZRGcr+kvcBAaJ2
+HAuMx54wayj7B0hkWStDwYceojY39LBNQJ5XI3+ntf0fDvje+A6JGATtlBBLquMXu1EN/FUR2+hT0R0N
+YalQ+qjL2SfnyhTgXEYETcOi8UOGZp8/5fwOmMfZPfY8rpah4tIQRetTdIZRDNz/iB2EZhrT6dUbIxdS
+5eUzWYGmEE+UVt4LtxNazVwGOHzuJIH/WSdwGBHVsJoBUf6lgORt1mqnJNc7DGTcTjQjnbnPHxTh7nfV
+dMaS+L6ze3t9uLVm9E/tPTRUO5r98g/FfG8O9vVQlb77IMJWB6ba02k+sOI/tQ9ExX/l/M7F3vgxZ9Dd
+7Kd8x37JvdquV+cFDlhusidalyJXrKiq+hkKBzCMWO6fI7mYT4mkWjHMB91Ka0m5YrhYvBXDHkh3zT2Q
+dC9s54OzjUGBTDZ+vVldCsN8upOqT3bs9zBALTGZ02X+JW8pOHdVdffsgfb1dYMSruJq4GSlh13lgtd7
+WMteqStY6mKNK2eCE4IdFMYrBjvOSSAGtFOi58D3pW3TKl2bXuOpL1Ud+aACzOPdq1Qg+W/G4CRxNutg
+ONpPTpJhjldPp1OGz6Oqn0PDM0Nyd28HKV1wNogptYPSio4wJav67j/++lAnxDGyt1v/wMFDRrSTeW68
+i5INjzWrS+jsBjvrCkZRWdJ0L+m+uAYJbBaHBmEh7CjGdY6xBmVlmpYe0fQ2huSs9HM052BNUnaRD1+S
+ux0rKDYu0Rt7IlFjVNKWMamfDkBMv5E5S/K5FealExLHivIR5DmzLbsk9Ioc5hE+JtWJdiqo3rXjmrWs
+/SE++vA3UvivPXdi7hllmOY2O9yaylLMfPocNFnK/LbbMvBx5ZwyhpUGh9f3OfQeFobfayiHizYlYCU6
+dDrPR9/nuMBxKfyW8zu3877PD+22jvE/ho6I9oKfBBdQYp2rhKnDho2u+NqIq6fBx1PL4xOTh/bydjtG
+h1oxJFx88mZ9OsZF4AQOq6CyUHA5ribDoonR2+OFY+GA8NbvmaK9Z5Lp9KqUlcWY6trdmQrK1BjhJrxg
+pAgbp7hn42C8sihe2wYaXMLPHFzCNlf4eWjqmpvxfDJc763onPm0ta74GqhtUJx/ojfIJ3haSqHsO1FY
+Ak1cYxNHG9fqNc3K8LTzjq/WlgtiHR9l4z1WuAvQjJdhPdUQmu5YbKu4Z0bS2ILFGKL+FkgWkUPQZPI6
+M5Ag99iZtBZK8I5Rr+na8wzG/UiuIHbFCeQmzuQqRKVBoGVevdJXgghQWIJbE9ytRK0AVSL5G97mrbe1
+D1GjmnsI6HcO1UDP55WwPwi5WNqhuFebyk0eDnCjWAVtMFw5EmP4g3L+1cygVk3gZhApa/iUZlU888cr
+huTYJ9xf5WAximPVuA09gkv2HViJndafOT4Nkb/XkeNHit4c3KrYq1jQqG5cJB9d89GuBigi76ouu/PD
+nu37qXudt2ILu53pFokv1EbYPrGO9ddEA0BbxmZgcG3AMXQo9eAcvVNOTYGyt34a1EES061hsF6MpZkq
+GO9+UUS1PTABaEsZgrJWZziyI+r5BRT+c87vGj2c7PecNW/fgzZw9lu+G67RKcRcLgaRY3b+m2ZNRssl
+PC/yg1nA8eC/x8U6LuH5e9sq2PYhzz9YtgR7kyBBbI589ldO23mRYcvPOZqgREZwAYeABs6XPFu0s53X
+icMuBwWIvD/K0XNLRPBQya6cCZcTPrLoih6hmbdyEHzyp6nV8DcQK2nhHJc2YWsoeuYm99eciYr30Kv5
+ISvv/yiehWoCVvAHD2fy6ijnEP2w/yB59IBFufU5ZOmjsx1JLlTCkvR/nZ8NE8pshbw6I3Kn9A9a9Lr0
+yuxzk69qJXynWC4rrA14dguhIvOAlsa704u/vjQJZapyJjJesR20/S0rpVdDr9gaNdvBdgD1253Gu/E1
+geDZtfCgxYFrMgSy+mujrXDGCwf16WezrkI7mh54u4OgQL9ngOBNGmA7HTJogLYGcwf3naVhaEbhVe9L
+2ejM4zB1tfDdsDmrBD94jmUaNOwb5f/OEKEhhjVurBPKZMXHpwkZ/5FMvqJJ/3Tgqzt9QMZ/PJh8RR9E
+aWT8x0WVPOAPH/1/Jn0a0idMO0d6zlaUJH9cXFRfJf0A/vDRxSmP4PsJGWUAQgBmlPEoh2L6KEv6svIx
+rbYYDoqOwCqg4sk4P/ny+OT36WR8cXF9cXFycTGYfJWwqtOEhwRryat+cnGR0ZF7pAllRcVP/4CvXJyO
+6KNTtumWvLg4PVx4/MejyVePEspKqOJh79mbpx9+e/v8CNL7j04lm7t00DReu8eL8SmboQAguUmaeCc3
+ZEBHe5FaZhVPMFye5xxeVWxZsVXFFhW7rdi0YpcVO67YTcWuK/a0Yp8r9rhibyr2vmIfKva8Yn9W7DWu
+fG8t4lZFzQlEIuhlBfz+FxW/S9LSDpMseQhbe4GPj+ARtsUwyR5AmO80X60hI623fwa4YMeeVfw0JaOs
+tNuF3UKRbb5a0+Hpgr06mLX9XxD2/HTB3lb89OLu4g5GebC9ULQ/ohe7i93pgr2rDmqRI8FUj974ZPBV
+f/TH8d2O0O34YnJxenExcWG3jlPAHVyMz/9z+GGk2uhXge0nvnGudUlfsWQBLF32HUzs/3v7x9WJVtkp
+ewKvVydbSMpO2Q8VPyWDr0b0ouqTUSbVVs/xefAVPWUfoeOEXNyN/9hNvrrYbcd/sMlXlBH3n4yy8EhH
+F/SUfao4KL1/RY9P2Rf4UAbfRXbRKfsRKhuM/xhM+qcL9hMM3AzYrQB3sypVlb1+D0E92C+Qgs/ZKfsd
+4N4Zyr4P22B8cX08nfS3F2T8B518Nbqg9KL6ij/a/hHm4aL66oKcst98CYjSk598mR5PoOgEtuvFoJu4
+vRg/GHw1ejDZXoyTwVejBB4uZn3414Wd0K8uqq+OT9nPFb8TVZF9/b/Bkin71vGLsvNvmAsN883XbLPO
+vvk/rBRzm33zv5kBojf75lsG/iSyf50xd6vMxv+H/eu/Jzv26yHP/RDeH0PPdYL2M1Hwu8rqdRbi8cML
+EDX5Infn9RBYQ5hXw/j3Z+6SiCCVKOfZrxUJIM7d/FGP8yOfUmyMEcrH2wWcZE2JJXoBwJryJxBwMfS8
+3srDFJeZl+2svPQZcGq0ciDBZeHYQdYDZxL2AGSKDuooTUMLXR62+Qzqk7NZKf5Hxc4T6ufnf1Lqa2CJ
+2sLzv38xrCh1/hm87imcndzKAoKhZOM4qlsyYfVdAg2PppL5qP/Zpdwxc7gsChL3yh43ZW/kjsmCj1XB
+TDFhugg81kuFSDW7lnhwZ0/ljuUFvxM3a1FYoJ+AYxgEULJgUUBgXTBZvTUCoiRVOcZEys0tvNmqFePo
+N82KXD0RL8XcvlkLBSCq6sRZKvK9CErvLYs6e09srtmm6EbYqHO9TrgdNNUA94OiAVQTanJHZAGUbdF2
+MN9EUo8Y+R1H4JRJjuwUJ3c1GDSvQ1sTO9KZpE08CgWKA35MUWLqnzkR4RGbGdpfA1OUE0TMQWKiV74k
+ndZFme2StHF3k8NKVjTxnwD5WZ6mUeBnn0LMOAejnHzixLMVX0h07BSOncqFw6m4ZNXAynXFNauiUOqo
+MyHpnXfgNvR6foOZKOVKWgir7BlIcRrtiww1rlEFw38Knj2f2Dq1ZSQFCpiFyruT4beS5P6ZFUGXJ98L
+axx4XN2MKBDFphmtkp8Ny4ebYdnv070i43LivrqfDi2IGs+r3aHlFPuKv4MrnixF5oLsyVJ80CHQZZWp
+3Y7kBWVFwatisA/ANsVBAgQvdqEl6LK9iftHWVnwA1fFAP7Pt0g5JwSvkPgZynmbybPdijgpPARXONFl
+f9j4+6o1alEYEKJWh+2oBsHYdogcQhSL4ipq3F0bmvyvBFUXanMtWM58U6DyRNBx6EUqEXFTDDfRIDnV
+FCyPURqp+64XdBQFMcyH93gmwBb7tbgupRJVJirWrOosXuI7H6BDcxnWa85ldxENQ9e5ZnvBuXle867L
+onHB66/saxtWCC8Ktgay/P87AKfOK16PMgEA
 `,
 	},
 
@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/volkszaehler/mbmd/log"
+)
+
+// ErrForbidden is returned by authenticators for authenticated principals that are not allowed access
+var ErrForbidden = errors.New("forbidden")
+
+// Authenticator authenticates http requests. Integrators can implement
+// custom schemes like header-based single sign-on by providing an Authenticator.
+type Authenticator interface {
+	// Authenticate returns the authenticated principal or an error if the request is not authenticated
+	Authenticate(r *http.Request) (string, error)
+}
+
+// AuthenticatorFunc adapts a function to the Authenticator interface
+type AuthenticatorFunc func(r *http.Request) (string, error)
+
+// Authenticate implements Authenticator
+func (f AuthenticatorFunc) Authenticate(r *http.Request) (string, error) {
+	return f(r)
+}
+
+// HeaderAuthenticator authenticates requests using a header set by an authenticating
+// reverse proxy, e.g. X-Remote-User. The proxy must prevent clients from setting the header.
+type HeaderAuthenticator struct {
+	Header string
+}
+
+// Authenticate implements Authenticator
+func (a HeaderAuthenticator) Authenticate(r *http.Request) (string, error) {
+	if principal := r.Header.Get(a.Header); principal != "" {
+		return principal, nil
+	}
+	return "", fmt.Errorf("missing %s header", a.Header)
+}
+
+// ClientCertAuthenticator authenticates requests by the common name of a verified
+// TLS client certificate. Requires TLS with client certificate verification.
+type ClientCertAuthenticator struct{}
+
+// Authenticate implements Authenticator
+func (a ClientCertAuthenticator) Authenticate(r *http.Request) (string, error) {
+	if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+		if cn := r.TLS.VerifiedChains[0][0].Subject.CommonName; cn != "" {
+			return cn, nil
+		}
+	}
+	return "", errors.New("missing client certificate")
+}
+
+// Authenticators tries each authenticator in order until one succeeds
+type Authenticators []Authenticator
+
+// Authenticate implements Authenticator
+func (as Authenticators) Authenticate(r *http.Request) (string, error) {
+	err := errors.New("no authenticator")
+	for _, a := range as {
+		var principal string
+		if principal, err = a.Authenticate(r); err == nil {
+			return principal, nil
+		}
+	}
+	return "", err
+}
+
+// Restrict limits an authenticator to the given case-insensitive principals.
+// If no principals are given, all authenticated principals are allowed.
+func Restrict(a Authenticator, principals ...string) Authenticator {
+	if len(principals) == 0 {
+		return a
+	}
+
+	return AuthenticatorFunc(func(r *http.Request) (string, error) {
+		principal, err := a.Authenticate(r)
+		if err != nil {
+			return "", err
+		}
+
+		for _, p := range principals {
+			if strings.EqualFold(p, principal) {
+				return principal, nil
+			}
+		}
+
+		return "", fmt.Errorf("%w: %s not allowed", ErrForbidden, principal)
+	})
+}
+
+type principalKey struct{}
+
+// Principal returns the authenticated principal of the request if any
+func Principal(r *http.Request) string {
+	if principal, ok := r.Context().Value(principalKey{}).(string); ok {
+		return principal
+	}
+	return ""
+}
+
+// authHandler is a middleware that rejects unauthenticated requests and requests of principals
+// not allowed access and adds the authenticated principal to the request context
+func authHandler(a Authenticator, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, err := a.Authenticate(r)
+		if errors.Is(err, ErrForbidden) {
+			log.Warnf("httpd: forbidden request from %s: %v", r.RemoteAddr, err)
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		if err != nil {
+			log.Warnf("httpd: unauthorized request from %s: %v", r.RemoteAddr, err)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), principalKey{}, principal)))
+	})
+}
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// withClientCert adds a verified client certificate with the given common name to the request
+func withClientCert(r *http.Request, cn string) *http.Request {
+	r.TLS = &tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: cn}}}},
+	}
+	return r
+}
+
+func TestAuthenticators(t *testing.T) {
+	header := HeaderAuthenticator{Header: "X-Remote-User"}
+	auth := Authenticators{header, ClientCertAuthenticator{}}
+
+	tc := []struct {
+		name      string
+		auth      Authenticator
+		header    string
+		cn        string
+		principal string
+		code      int
+	}{
+		{"missing header", header, "", "", "", http.StatusUnauthorized},
+		{"header", header, "alice", "", "alice", http.StatusOK},
+		{"missing client cert", ClientCertAuthenticator{}, "alice", "", "", http.StatusUnauthorized},
+		{"client cert", ClientCertAuthenticator{}, "", "bob", "bob", http.StatusOK},
+		{"header before client cert", auth, "alice", "bob", "alice", http.StatusOK},
+		{"client cert fallback", auth, "", "bob", "bob", http.StatusOK},
+		{"none", auth, "", "", "", http.StatusUnauthorized},
+		{"allowed principal", Restrict(auth, "Alice"), "alice", "", "alice", http.StatusOK},
+		{"allowed client cert", Restrict(auth, "alice", "bob"), "", "bob", "bob", http.StatusOK},
+		{"disallowed principal", Restrict(auth, "alice"), "mallory", "", "", http.StatusForbidden},
+		{"disallowed client cert", Restrict(auth, "alice"), "", "mallory", "", http.StatusForbidden},
+		{"unauthenticated restricted", Restrict(auth, "alice"), "", "", "", http.StatusUnauthorized},
+		{"unrestricted", Restrict(header), "mallory", "", "mallory", http.StatusOK},
+	}
+
+	for _, tc := range tc {
+		t.Run(tc.name, func(t *testing.T) {
+			var principal string
+			handler := authHandler(tc.auth, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				principal = Principal(r)
+			}))
+
+			r := httptest.NewRequest("GET", "/", nil)
+			if tc.header != "" {
+				r.Header.Set("X-Remote-User", tc.header)
+			}
+			if tc.cn != "" {
+				withClientCert(r, tc.cn)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != tc.code {
+				t.Errorf("expected %d, got %d", tc.code, w.Code)
+			}
+			if principal != tc.principal {
+				t.Errorf("expected principal %q, got %q", tc.principal, principal)
+			}
+		})
+	}
+
+	// certificate without common name
+	r := withClientCert(httptest.NewRequest("GET", "/", nil), "")
+	if _, err := (ClientCertAuthenticator{}).Authenticate(r); err == nil {
+		t.Error("expected error for missing common name")
+	}
+}
+
+func TestAuthWriteRoutes(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m})
+
+	control := make(chan ControlSnip)
+	defer close(control)
+	status := NewStatus(qe, control)
+
+	as, _ := NewAnnotationStore("")
+	if _, err := as.Add(Annotation{Text: "maintenance", Start: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+
+	var reloads int
+	conf := HttpdConfig{
+		Auth:   Restrict(HeaderAuthenticator{Header: "X-Remote-User"}, "alice"),
+		Reload: func() error { reloads++; return nil },
+	}
+
+	h := NewHttpd(qe, NewCache(0, status, false), nil, as)
+	handler := h.handler(NewSocketHub(status), status, conf)
+
+	tc := []struct {
+		method, path, user string
+		code               int
+	}{
+		{http.MethodPost, "/api/v1/reload", "", http.StatusUnauthorized},
+		{http.MethodPost, "/api/reload", "mallory", http.StatusForbidden},
+		{http.MethodPost, "/api/v1/annotations", "", http.StatusUnauthorized},
+		{http.MethodDelete, "/api/v1/annotations/1", "", http.StatusUnauthorized},
+		{http.MethodDelete, "/api/annotations/1", "mallory", http.StatusForbidden},
+		{http.MethodGet, "/api/v1/last", "", http.StatusUnauthorized},
+		{http.MethodGet, "/", "", http.StatusUnauthorized},
+	}
+
+	for _, tc := range tc {
+		r := httptest.NewRequest(tc.method, tc.path, strings.NewReader(`{"text":"outage","start":"2020-01-01T00:00:00Z"}`))
+		if tc.user != "" {
+			r.Header.Set("X-Remote-User", tc.user)
+		}
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		if w.Code != tc.code {
+			t.Errorf("%s %s: expected %d, got %d", tc.method, tc.path, tc.code, w.Code)
+		}
+	}
+
+	if reloads != 0 {
+		t.Errorf("expected no reload, got %d", reloads)
+	}
+	if res := as.Query(time.Time{}, time.Time{}, nil, nil); len(res) != 1 || res[0].Text != "maintenance" {
+		t.Errorf("expected annotations unchanged, got %+v", res)
+	}
+
+	// allowed principal reaches write routes
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/reload", nil)
+	r.Header.Set("X-Remote-User", "alice")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	if w.Code >= http.StatusBadRequest || reloads != 1 {
+		t.Errorf("expected reload, got %d with %d reloads", w.Code, reloads)
+	}
+}
@@ -9,7 +9,7 @@ type Broadcaster struct {
 	sync.Mutex // guard recipients
 	wg         sync.WaitGroup
 	in         <-chan interface{}
-	recipients []chan<- interface{}
+	recipients []chan interface{}
 	done       chan struct{}
 }
 
@@ -18,7 +18,7 @@ type Broadcaster struct {
 func NewBroadcaster(in <-chan interface{}) *Broadcaster {
 	return &Broadcaster{
 		in:         in,
-		recipients: make([]chan<- interface{}, 0),
+		recipients: make([]chan interface{}, 0),
 		done:       make(chan struct{}),
 	}
 }
@@ -47,6 +47,7 @@ func (b *Broadcaster) stop() {
 	for _, recipient := range b.recipients {
 		close(recipient)
 	}
+	b.recipients = nil
 	b.wg.Wait()
 	close(b.done)
 }
@@ -62,13 +63,36 @@ func (b *Broadcaster) Attach() <-chan interface{} {
 	return channel
 }
 
+// Detach detaches and closes a channel created by Attach
+func (b *Broadcaster) Detach(channel <-chan interface{}) {
+	b.Lock()
+	defer b.Unlock()
+
+	for i, recipient := range b.recipients {
+		if recipient == channel {
+			b.recipients = append(b.recipients[:i:i], b.recipients[i+1:]...)
+			close(recipient)
+			return
+		}
+	}
+}
+
 // AttachRunner attaches a Run method as broadcast receiver and adds it
-// to the waitgroup
-func (b *Broadcaster) AttachRunner(runner func(<-chan interface{})) {
+// to the waitgroup. The returned function detaches the runner and waits
+// for it to finish.
+func (b *Broadcaster) AttachRunner(runner func(<-chan interface{})) func() {
+	ch := b.Attach()
+	done := make(chan struct{})
+
 	b.wg.Add(1)
 	go func() {
-		ch := b.Attach()
 		runner(ch)
 		b.wg.Done()
+		close(done)
 	}()
+
+	return func() {
+		b.Detach(ch)
+		<-done
+	}
 }
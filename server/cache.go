@@ -2,11 +2,11 @@ package server
 
 import (
 	"fmt"
-	"log"
 	"sort"
 	"sync"
 	"time"
 
+	"github.com/volkszaehler/mbmd/log"
 	"github.com/volkszaehler/mbmd/meters"
 )
 
@@ -21,10 +21,12 @@ var verboseLoggable = []meters.Measurement{
 // Cache caches and aggregates meter reasings
 type Cache struct {
 	sync.Mutex
-	readings map[string]*MeterReadings
-	maxAge   time.Duration
-	status   *Status
-	verbose  bool
+	readings   map[string]*MeterReadings
+	maxAge     time.Duration
+	status     *Status
+	verbose    bool
+	staleAfter time.Duration // age after which current values are outdated
+	omitStale  bool          // omit outdated values instead of flagging them
 }
 
 // NewCache creates new meter reading cache
@@ -41,6 +43,16 @@ func NewCache(maxAge time.Duration, status *Status, verbose bool) *Cache {
 	return cache
 }
 
+// Staleness configures the age after which current values are flagged as outdated,
+// or omitted if omit is set. Zero disables the staleness check.
+func (mc *Cache) Staleness(after time.Duration, omit bool) {
+	mc.Lock()
+	defer mc.Unlock()
+
+	mc.staleAfter = after
+	mc.omitStale = omit
+}
+
 // Run consumes meter readings into snip cache
 func (mc *Cache) Run(in <-chan QuerySnip) {
 	for snip := range in {
@@ -59,7 +71,7 @@ func (mc *Cache) Run(in <-chan QuerySnip) {
 		if mc.verbose {
 			for _, m := range verboseLoggable {
 				if snip.Measurement == m {
-					log.Printf("device %s %s", uniqueID, readings.Current.String())
+					log.Debugf("device %s %s", uniqueID, readings.Current.String())
 					break
 				}
 			}
@@ -80,15 +92,17 @@ func (mc *Cache) SortedIDs() []string {
 	return keys
 }
 
-// Current returns the latest set of meter reading
+// Current returns the latest set of meter reading including the age of each value. Restored
+// readings are returned flagged as stale until the device has been queried.
 func (mc *Cache) Current(device string) (res *Readings, err error) {
 	mc.Lock()
 	defer mc.Unlock()
 
 	if readings, ok := mc.readings[device]; ok {
-		if mc.status.Online(device) {
-			// return a copy
-			return readings.Current.Clone(), nil
+		// return a copy
+		if res := readings.Current.Clone(); res.Stale || mc.status.Online(device) {
+			res.age(time.Now(), mc.staleAfter, mc.omitStale)
+			return res, nil
 		}
 
 		return res, fmt.Errorf("device %s is not available", device)
@@ -2,6 +2,7 @@ package server
 
 import (
 	"testing"
+	"time"
 
 	"github.com/volkszaehler/mbmd/meters"
 )
@@ -30,3 +31,41 @@ func TestAddDivide(t *testing.T) {
 		t.Error("could not add reading")
 	}
 }
+
+func TestReadingsAge(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	readings := func() *Readings {
+		return &Readings{
+			Values: map[meters.Measurement]float64{
+				meters.Power:  100,
+				meters.Import: 1.5,
+			},
+			Timestamps: map[meters.Measurement]time.Time{
+				meters.Power:  now.Add(-time.Second),
+				meters.Import: now.Add(-time.Minute),
+			},
+		}
+	}
+
+	r := readings()
+	r.age(now, 0, false)
+	if r.Ages[meters.Power] != time.Second || r.Ages[meters.Import] != time.Minute {
+		t.Errorf("unexpected ages %v", r.Ages)
+	}
+	if len(r.Outdated) > 0 {
+		t.Errorf("expected no outdated values, got %v", r.Outdated)
+	}
+
+	r = readings()
+	r.age(now, 10*time.Second, false)
+	if !r.Outdated[meters.Import] || r.Outdated[meters.Power] || len(r.Values) != 2 {
+		t.Errorf("expected import flagged as outdated, got %v", r.Outdated)
+	}
+
+	r = readings()
+	r.age(now, 10*time.Second, true)
+	if _, ok := r.Values[meters.Import]; ok || len(r.Values) != 1 || len(r.Outdated) > 0 {
+		t.Errorf("expected import omitted, got %v", r.Values)
+	}
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// utf8BOM makes spreadsheet applications detect utf-8 encoded units like °C
+const utf8BOM = "\uFEFF"
+
+// CSVOptions describes locale dependent csv formatting
+type CSVOptions struct {
+	Separator  rune
+	Decimal    rune
+	TimeFormat string // go time layout or "unix"
+	BOM        bool
+}
+
+// csvLocales are formatting presets for spreadsheet applications
+var csvLocales = map[string]CSVOptions{
+	"en": {Separator: ',', Decimal: '.', TimeFormat: time.RFC3339},
+	"de": {Separator: ';', Decimal: ',', TimeFormat: "02.01.2006 15:04:05", BOM: true},
+	"fr": {Separator: ';', Decimal: ',', TimeFormat: "02/01/2006 15:04:05", BOM: true},
+	"nl": {Separator: ';', Decimal: ',', TimeFormat: "02-01-2006 15:04:05", BOM: true},
+}
+
+// NewCSVOptions creates csv options from locale preset. Non-empty separator,
+// decimal and time format override the preset.
+func NewCSVOptions(locale, separator, decimal, timeFormat string) (CSVOptions, error) {
+	if locale == "" {
+		locale = "en"
+	}
+
+	o, ok := csvLocales[strings.ToLower(locale)]
+	if !ok {
+		return o, fmt.Errorf("invalid locale %s", locale)
+	}
+
+	if separator != "" {
+		if separator == `\t` {
+			separator = "\t"
+		}
+		r, size := utf8.DecodeRuneInString(separator)
+		if size != len(separator) || r == '"' || r == '\n' {
+			return o, fmt.Errorf("invalid separator %s", separator)
+		}
+		o.Separator = r
+	}
+
+	if decimal != "" {
+		if decimal != "." && decimal != "," {
+			return o, fmt.Errorf("invalid decimal separator %s", decimal)
+		}
+		o.Decimal = rune(decimal[0])
+	}
+
+	if timeFormat != "" {
+		o.TimeFormat = timeFormat
+	}
+
+	if o.Separator == o.Decimal {
+		return o, fmt.Errorf("separator and decimal separator must differ")
+	}
+
+	return o, nil
+}
+
+func (o CSVOptions) formatFloat(f float64) string {
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if o.Decimal != '.' {
+		s = strings.Replace(s, ".", string(o.Decimal), 1)
+	}
+	return s
+}
+
+func (o CSVOptions) formatTime(t time.Time) string {
+	if o.TimeFormat == "unix" {
+		return strconv.FormatInt(t.Unix(), 10)
+	}
+	return t.Local().Format(o.TimeFormat)
+}
+
+// WriteCSV writes readings by device id as csv, one row per device and measurement
+func WriteCSV(w io.Writer, o CSVOptions, readings map[string]*Readings) error {
+	if o.BOM {
+		if _, err := io.WriteString(w, utf8BOM); err != nil {
+			return err
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Comma = o.Separator
+
+	if err := cw.Write([]string{"Device", "Timestamp", "Measurement", "Description", "Value", "Unit"}); err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(readings))
+	for id := range readings {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		r := readings[id]
+
+		rows := make([][]string, 0, len(r.Values))
+		for m, v := range r.Values {
+			description, unit := m.DescriptionAndUnit()
+			rows = append(rows, []string{id, o.formatTime(r.Timestamp), m.String(), description, o.formatFloat(v), unit})
+		}
+
+		sort.Slice(rows, func(i, j int) bool {
+			return rows[i][2] < rows[j][2]
+		})
+
+		if err := cw.WriteAll(rows); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
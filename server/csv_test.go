@@ -0,0 +1,91 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestWriteCSV(t *testing.T) {
+	defer func(loc *time.Location) { time.Local = loc }(time.Local)
+	time.Local = time.UTC
+
+	ts := time.Date(2020, 3, 14, 15, 9, 26, 0, time.UTC)
+	readings := map[string]*Readings{
+		"SMA2.1": {Timestamp: ts, Values: map[meters.Measurement]float64{meters.HeatSinkTemp: 41}},
+		"SDM1.1": {Timestamp: ts, Values: map[meters.Measurement]float64{meters.Power: 1234.5, meters.Import: 0.25}},
+	}
+
+	tc := []struct {
+		locale, separator, decimal, timeFormat string
+		golden                                 string
+	}{
+		{"en", "", "", "", "" +
+			"Device,Timestamp,Measurement,Description,Value,Unit\n" +
+			"SDM1.1,2020-03-14T15:09:26Z,Import,Total Import,0.25,kWh\n" +
+			"SDM1.1,2020-03-14T15:09:26Z,Power,Power,1234.5,W\n" +
+			"SMA2.1,2020-03-14T15:09:26Z,HeatSinkTemp,Heat Sink Temperature,41,°C\n",
+		},
+		{"de", "", "", "", utf8BOM +
+			"Device;Timestamp;Measurement;Description;Value;Unit\n" +
+			"SDM1.1;14.03.2020 15:09:26;Import;Total Import;0,25;kWh\n" +
+			"SDM1.1;14.03.2020 15:09:26;Power;Power;1234,5;W\n" +
+			"SMA2.1;14.03.2020 15:09:26;HeatSinkTemp;Heat Sink Temperature;41;°C\n",
+		},
+		{"fr", "", "", "", utf8BOM +
+			"Device;Timestamp;Measurement;Description;Value;Unit\n" +
+			"SDM1.1;14/03/2020 15:09:26;Import;Total Import;0,25;kWh\n" +
+			"SDM1.1;14/03/2020 15:09:26;Power;Power;1234,5;W\n" +
+			"SMA2.1;14/03/2020 15:09:26;HeatSinkTemp;Heat Sink Temperature;41;°C\n",
+		},
+		{"nl", "", "", "", utf8BOM +
+			"Device;Timestamp;Measurement;Description;Value;Unit\n" +
+			"SDM1.1;14-03-2020 15:09:26;Import;Total Import;0,25;kWh\n" +
+			"SDM1.1;14-03-2020 15:09:26;Power;Power;1234,5;W\n" +
+			"SMA2.1;14-03-2020 15:09:26;HeatSinkTemp;Heat Sink Temperature;41;°C\n",
+		},
+		{"DE", `\t`, ".", "unix", utf8BOM +
+			"Device\tTimestamp\tMeasurement\tDescription\tValue\tUnit\n" +
+			"SDM1.1\t1584198566\tImport\tTotal Import\t0.25\tkWh\n" +
+			"SDM1.1\t1584198566\tPower\tPower\t1234.5\tW\n" +
+			"SMA2.1\t1584198566\tHeatSinkTemp\tHeat Sink Temperature\t41\t°C\n",
+		},
+	}
+
+	for _, tc := range tc {
+		o, err := NewCSVOptions(tc.locale, tc.separator, tc.decimal, tc.timeFormat)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var b bytes.Buffer
+		if err := WriteCSV(&b, o, readings); err != nil {
+			t.Fatal(err)
+		}
+
+		if s := b.String(); s != tc.golden {
+			t.Errorf("%s: expected\n%q\ngot\n%q", tc.locale, tc.golden, s)
+		}
+	}
+}
+
+func TestCSVOptionsInvalid(t *testing.T) {
+	tc := []struct {
+		locale, separator, decimal string
+	}{
+		{"es", "", ""},
+		{"en", `"`, ""},
+		{"en", ";;", ""},
+		{"en", "", "'"},
+		{"de", ",", ""},
+		{"en", "", ","},
+	}
+
+	for _, tc := range tc {
+		if _, err := NewCSVOptions(tc.locale, tc.separator, tc.decimal, ""); err == nil {
+			t.Errorf("%+v: expected error", tc)
+		}
+	}
+}
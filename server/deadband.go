@@ -0,0 +1,101 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// Deadband suppresses values that did not change significantly since they were last passed on
+type Deadband struct {
+	value    float64       // minimum change
+	relative bool          // value is a percentage of the last value
+	interval time.Duration // maximum interval between values, disabled if zero
+}
+
+// NewDeadband creates a deadband from an absolute change like 0.5 or a relative change like 1%.
+// Values are passed on regardless of the deadband once interval has elapsed.
+func NewDeadband(spec string, interval time.Duration) (Deadband, error) {
+	d := Deadband{interval: interval}
+
+	if spec = strings.TrimSpace(spec); spec == "" {
+		return d, nil
+	}
+
+	if strings.HasSuffix(spec, "%") {
+		d.relative = true
+		spec = strings.TrimSpace(strings.TrimSuffix(spec, "%"))
+	}
+
+	value, err := strconv.ParseFloat(spec, 64)
+	if err != nil || value < 0 {
+		return d, fmt.Errorf("invalid deadband %s", spec)
+	}
+
+	d.value = value
+	return d, nil
+}
+
+// exceeded checks if the change from last to value passes the deadband
+func (d Deadband) exceeded(m meters.Measurement, last, value float64) bool {
+	if m.Boolean() {
+		return last != value
+	}
+
+	limit := d.value
+	if d.relative {
+		limit = d.value / 100 * math.Abs(last)
+	}
+
+	if limit == 0 {
+		return last != value
+	}
+
+	return math.Abs(value-last) > limit
+}
+
+// deadbandKey identifies a device's measurement
+type deadbandKey struct {
+	device      string
+	measurement meters.Measurement
+}
+
+// NewDeadbandRunner decorates a QuerySnip runner such that it only receives values changed by
+// more than the deadband or after the deadband's interval has elapsed
+func NewDeadbandRunner(d Deadband, run func(<-chan QuerySnip)) func(<-chan QuerySnip) {
+	if d == (Deadband{}) {
+		return run
+	}
+
+	return func(in <-chan QuerySnip) {
+		out := make(chan QuerySnip)
+		done := make(chan struct{})
+
+		go func() {
+			run(out)
+			close(done)
+		}()
+
+		last := make(map[deadbandKey]QuerySnip)
+
+		for snip := range in {
+			key := deadbandKey{snip.Device, snip.Measurement}
+
+			if prev, ok := last[key]; ok && prev.Stale == snip.Stale &&
+				!d.exceeded(snip.Measurement, prev.Value, snip.Value) &&
+				(d.interval == 0 || snip.Timestamp.Sub(prev.Timestamp) < d.interval) {
+				continue
+			}
+
+			last[key] = snip
+			out <- snip
+		}
+
+		close(out)
+		<-done
+	}
+}
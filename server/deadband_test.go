@@ -0,0 +1,83 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestDeadbandRunner(t *testing.T) {
+	start := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	snip := func(offset time.Duration, m meters.Measurement, v float64) QuerySnip {
+		return QuerySnip{
+			Device:            "SDM1.1",
+			MeasurementResult: meters.MeasurementResult{Measurement: m, Value: v, Timestamp: start.Add(offset)},
+		}
+	}
+
+	tc := []struct {
+		spec     string
+		interval time.Duration
+		in       []QuerySnip
+		expected []float64
+	}{
+		{"0.1", 0, []QuerySnip{
+			snip(0, meters.Frequency, 50),
+			snip(time.Second, meters.Frequency, 50.05),
+			snip(2*time.Second, meters.Frequency, 50.2),
+			snip(3*time.Second, meters.Frequency, 50.15),
+		}, []float64{50, 50.2}},
+		{"10%", time.Minute, []QuerySnip{
+			snip(0, meters.Power, 100),
+			snip(time.Second, meters.Power, 109),
+			snip(2*time.Second, meters.Power, 111),
+			snip(62*time.Second, meters.Power, 111),
+			snip(63*time.Second, meters.Power, 112),
+		}, []float64{100, 111, 111}},
+		{"", time.Minute, []QuerySnip{
+			snip(0, meters.Relay1, 0),
+			snip(time.Second, meters.Relay1, 0),
+			snip(2*time.Second, meters.Relay1, 1),
+		}, []float64{0, 1}},
+	}
+
+	for _, c := range tc {
+		d, err := NewDeadband(c.spec, c.interval)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var res []float64
+		in := make(chan QuerySnip)
+		done := make(chan struct{})
+
+		go func() {
+			NewDeadbandRunner(d, func(out <-chan QuerySnip) {
+				for snip := range out {
+					res = append(res, snip.Value)
+				}
+			})(in)
+			close(done)
+		}()
+
+		for _, snip := range c.in {
+			in <- snip
+		}
+		close(in)
+		<-done
+
+		if len(res) != len(c.expected) {
+			t.Fatalf("%s: expected %v, got %v", c.spec, c.expected, res)
+		}
+		for i, v := range c.expected {
+			if res[i] != v {
+				t.Errorf("%s: expected %v, got %v", c.spec, c.expected, res)
+			}
+		}
+	}
+
+	if _, err := NewDeadband("-1", 0); err == nil {
+		t.Error("expected error for negative deadband")
+	}
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/volkszaehler/mbmd/meters"
+	"github.com/volkszaehler/mbmd/meters/host"
+	"github.com/volkszaehler/mbmd/meters/mbus"
+	"github.com/volkszaehler/mbmd/meters/optical"
+	"github.com/volkszaehler/mbmd/meters/rs485"
+	"github.com/volkszaehler/mbmd/meters/sim"
+	"github.com/volkszaehler/mbmd/meters/sunspec"
+)
+
+// DeviceSpec describes a device to be created at runtime
+type DeviceSpec struct {
+	Type      string   `json:"type"`
+	ID        uint8    `json:"id"`
+	SubDevice int      `json:"subdevice,omitempty"`
+	Adapter   string   `json:"adapter,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
+}
+
+// DeviceRegistry adds and removes devices at runtime
+type DeviceRegistry interface {
+	RegisterDevice(spec DeviceSpec) (string, error)
+	UnregisterDevice(id string) error
+}
+
+// DeviceMetadata describes an attached device
+type DeviceMetadata struct {
+	Device string `json:"device"`
+	DeviceSpec
+	Manufacturer string  `json:"manufacturer,omitempty"`
+	Model        string  `json:"model,omitempty"`
+	Serial       string  `json:"serial,omitempty"`
+	Firmware     string  `json:"firmware,omitempty"`
+	Interval     float64 `json:"interval,omitempty"` // polling interval in seconds
+	Health       string  `json:"health"`
+	LastSeen     string  `json:"lastSeen,omitempty"` // RFC3339
+}
+
+// device health states
+const (
+	HealthOnline      = "online"
+	HealthOffline     = "offline"
+	HealthQuarantined = "quarantined"
+	HealthUnknown     = "unknown" // not yet initialized
+)
+
+// DeviceLister lists the attached devices
+type DeviceLister interface {
+	Devices() []DeviceMetadata
+}
+
+// sunspecTypes are the meter types handled as SunSpec devices
+var sunspecTypes = []string{"FRONIUS", "KOSTAL", "KACO", "SE", "SMA", "SOLAREDGE", "STECA", "SUNS", "SUNSPEC"}
+
+// NewDevice creates a device of the given meter type. Subdevices are only supported by SunSpec devices.
+func NewDevice(meterType string, subdevice int) (meters.Device, error) {
+	meterType = strings.ToUpper(meterType)
+
+	switch meterType {
+	case host.METERTYPE_HOST:
+		return host.NewDevice(), nil
+	case sim.METERTYPE_SIM:
+		return sim.NewDevice(), nil
+	case optical.METERTYPE_SML:
+		return optical.NewDevice(optical.SML), nil
+	case optical.METERTYPE_D0:
+		return optical.NewDevice(optical.D0), nil
+	case optical.METERTYPE_P1:
+		return optical.NewDevice(optical.P1), nil
+	case mbus.METERTYPE_MBUS:
+		return mbus.NewDevice(), nil
+	}
+
+	for _, t := range sunspecTypes {
+		if t == meterType {
+			return sunspec.NewDevice(meterType, subdevice), nil
+		}
+	}
+
+	if subdevice > 0 {
+		return nil, fmt.Errorf("invalid subdevice number for device %s: %d", meterType, subdevice)
+	}
+
+	return rs485.NewDevice(meterType)
+}
@@ -0,0 +1,187 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/volkszaehler/mbmd/log"
+)
+
+const (
+	emoncmsWriteTimeout = 10 * time.Second
+	emoncmsQueueSize    = 100 // pending inputs while the server is slow or unavailable
+)
+
+// emoncmsNodeRE matches valid node names
+var emoncmsNodeRE = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// EmonCMS posts readings to the input API of an EmonCMS server
+type EmonCMS struct {
+	url    string
+	apiKey string
+	nodes  map[string]string // node names by device id or name
+	qe     DeviceInfo
+	client *http.Client
+	failed bool
+}
+
+// emoncmsInput are a node's readings received at once
+type emoncmsInput struct {
+	node   string
+	time   time.Time
+	values map[string]float64
+}
+
+// NewEmonCMS creates an EmonCMS publisher. Nodes is a comma-separated list of <device>=<node>
+// assignments of node names to device ids or names. Other devices use their id as node name.
+func NewEmonCMS(url, apiKey, nodes string, qe DeviceInfo) (*EmonCMS, error) {
+	if url == "" || apiKey == "" {
+		return nil, errors.New("emoncms: missing url or api key")
+	}
+
+	m := &EmonCMS{
+		url:    strings.TrimRight(url, "/"),
+		apiKey: apiKey,
+		nodes:  make(map[string]string),
+		qe:     qe,
+		client: &http.Client{Timeout: emoncmsWriteTimeout},
+	}
+
+	for _, assignment := range strings.Split(nodes, ",") {
+		if assignment = strings.TrimSpace(assignment); assignment == "" {
+			continue
+		}
+
+		kv := strings.SplitN(assignment, "=", 2)
+		if len(kv) != 2 || kv[0] == "" || !emoncmsNodeRE.MatchString(kv[1]) {
+			return nil, fmt.Errorf("emoncms: invalid node %s", assignment)
+		}
+		m.nodes[kv[0]] = kv[1]
+	}
+
+	return m, nil
+}
+
+// node returns the device's node name
+func (m *EmonCMS) node(id string) string {
+	if node, ok := m.nodes[id]; ok {
+		return node
+	}
+	if node, ok := m.nodes[m.qe.DeviceLabelsByID(id).Name]; ok {
+		return node
+	}
+	return mqttDeviceTopic(id)
+}
+
+// post writes the input
+func (m *EmonCMS) post(input emoncmsInput) error {
+	fulljson, err := json.Marshal(input.values)
+	if err != nil {
+		return err
+	}
+
+	params := url.Values{
+		"node":     {input.node},
+		"time":     {strconv.FormatInt(input.time.Unix(), 10)},
+		"fulljson": {string(fulljson)},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, m.url+"/input/post", strings.NewReader(params.Encode()))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+m.apiKey)
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("node %s: unexpected status %s", input.node, resp.Status)
+	}
+
+	return nil
+}
+
+// writeProc posts inputs until the channel is closed. Failed inputs are dropped,
+// errors are logged when the server state changes.
+func (m *EmonCMS) writeProc(inputs <-chan emoncmsInput, stopped chan<- struct{}) {
+	defer close(stopped)
+
+	for input := range inputs {
+		err := m.post(input)
+
+		if failed := err != nil; failed != m.failed {
+			m.failed = failed
+			if failed {
+				log.Errorf("emoncms: server unavailable, dropping readings: %v", err)
+			} else {
+				log.Printf("emoncms: server available again")
+			}
+		}
+	}
+}
+
+// Run EmonCMS publisher. Readings of a device received at once are posted as a single
+// input named by the measurements, e.g. PowerL1.
+func (m *EmonCMS) Run(in <-chan QuerySnip) {
+	inputs := make(chan emoncmsInput, emoncmsQueueSize)
+	stopped := make(chan struct{})
+	go m.writeProc(inputs, stopped)
+
+	pending := make(map[string]*emoncmsInput)
+	for snip := range in {
+		for more := true; more; {
+			// restored values have already been written
+			if !snip.Stale && !math.IsNaN(snip.Value) && !math.IsInf(snip.Value, 0) {
+				input, ok := pending[snip.Device]
+				if !ok {
+					input = &emoncmsInput{node: m.node(snip.Device), values: make(map[string]float64)}
+					pending[snip.Device] = input
+				}
+
+				input.values[snip.Measurement.String()] = snip.Value
+				if snip.Timestamp.After(input.time) {
+					input.time = snip.Timestamp
+				}
+			}
+
+			select {
+			case snip, more = <-in:
+			default:
+				more = false
+			}
+		}
+
+		devices := make([]string, 0, len(pending))
+		for device := range pending {
+			devices = append(devices, device)
+		}
+		sort.Strings(devices)
+
+		for _, device := range devices {
+			select {
+			case inputs <- *pending[device]:
+			default:
+				log.Warnf("emoncms: queue full, dropping readings")
+			}
+			delete(pending, device)
+		}
+	}
+
+	close(inputs)
+	<-stopped
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestEmonCMS(t *testing.T) {
+	var inputs []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.URL.Path != "/emoncms/input/post" || r.Header.Get("Authorization") != "Bearer key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		inputs = append(inputs, r.PostForm.Encode())
+	}))
+	defer srv.Close()
+
+	emoncms, err := NewEmonCMS(srv.URL+"/emoncms/", "key", "garage=heatpump", deviceNames{"SDM1.1": "garage"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts := time.Unix(1577880000, 0)
+	in := make(chan QuerySnip, 4)
+	in <- QuerySnip{Device: "SDM1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Power, Value: 1500.5, Timestamp: ts}}
+	in <- QuerySnip{Device: "SDM1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Import, Value: 12, Timestamp: ts.Add(time.Second)}}
+	in <- QuerySnip{Device: "SDM1.2", MeasurementResult: meters.MeasurementResult{Measurement: meters.Power, Value: 10, Timestamp: ts}}
+	in <- QuerySnip{Device: "SDM1.2", MeasurementResult: meters.MeasurementResult{Measurement: meters.Import, Value: 1, Timestamp: ts}, Stale: true}
+	close(in)
+
+	emoncms.Run(in)
+
+	expected := []string{
+		"fulljson=%7B%22Import%22%3A12%2C%22Power%22%3A1500.5%7D&node=heatpump&time=1577880001",
+		"fulljson=%7B%22Power%22%3A10%7D&node=sdm1-2&time=1577880000",
+	}
+
+	if len(inputs) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, inputs)
+	}
+	for i, input := range expected {
+		if inputs[i] != input {
+			t.Errorf("expected %s, got %s", input, inputs[i])
+		}
+	}
+}
+
+func TestEmonCMSInvalidNode(t *testing.T) {
+	if _, err := NewEmonCMS("http://localhost", "key", "SDM1.1=a b", nil); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,381 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+const defaultRate = 1 * time.Second
+
+// EngineOptions configures an Engine
+type EngineOptions struct {
+	Rate      time.Duration // query rate, defaults to 1s
+	Query     QueryOptions  // default query retries and timeouts
+	SerialIDs bool          // identify devices by serial number where available
+}
+
+// Engine bundles connections, devices, querying and result distribution.
+// It allows embedding mbmd into other Go applications:
+//
+//	e := server.NewEngine(server.EngineOptions{Rate: time.Second})
+//	conn, _ := meters.NewRTU("/dev/ttyUSB0", 9600, "8N1")
+//	e.AddConnection("/dev/ttyUSB0", conn)
+//	dev, _ := server.NewDevice("SDM", 0)
+//	e.AddDevice("/dev/ttyUSB0", 1, dev, server.Labels{})
+//	e.Subscribe(func(in <-chan server.QuerySnip) {
+//		for snip := range in {
+//			fmt.Println(snip)
+//		}
+//	})
+//	e.Run(ctx)
+type Engine struct {
+	mux      sync.Mutex
+	rate     time.Duration
+	serial   bool
+	managers map[string]*meters.Manager
+	labels   map[meters.Device]Labels
+	query    QueryOptions
+	options  map[meters.Device]QueryOptions
+	qe       *QueryEngine
+	rc       chan QuerySnip
+	cc       chan ControlSnip
+	tee      *Broadcaster
+	teeC     *Broadcaster
+	hooks    *Hooks // optional
+	done     chan struct{}
+
+	supervisor *Supervisor
+}
+
+// NewEngine creates an engine
+func NewEngine(opts EngineOptions) *Engine {
+	if opts.Rate <= 0 {
+		opts.Rate = defaultRate
+	}
+
+	e := &Engine{
+		rate:     opts.Rate,
+		serial:   opts.SerialIDs,
+		managers: make(map[string]*meters.Manager),
+		labels:   make(map[meters.Device]Labels),
+		query:    opts.Query,
+		options:  make(map[meters.Device]QueryOptions),
+		rc:       make(chan QuerySnip),
+		cc:       make(chan ControlSnip),
+		done:     make(chan struct{}),
+
+		supervisor: NewSupervisor(),
+	}
+
+	// tees that broadcast meter and control messages to multiple recipients
+	e.tee = NewBroadcaster(FromSnipChannel(e.rc))
+	e.teeC = NewBroadcaster(FromControlChannel(e.cc))
+	go e.tee.Run()
+	go e.teeC.Run()
+
+	go func() {
+		<-e.tee.Done()
+		<-e.teeC.Done()
+		close(e.done)
+	}()
+
+	return e
+}
+
+// AddConnection adds a named connection. Devices are attached to connections by name.
+// Connections can be added while the engine is running.
+func (e *Engine) AddConnection(name string, conn meters.Connection) error {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	if _, ok := e.managers[name]; ok {
+		return fmt.Errorf("engine: connection %s already exists", name)
+	}
+
+	e.managers[name] = meters.NewManager(conn)
+
+	return nil
+}
+
+// RemoveConnection removes a named connection without devices and closes it.
+// Connections removed while the engine is running are closed once their current cycle completed.
+func (e *Engine) RemoveConnection(name string) error {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	manager, ok := e.managers[name]
+	if !ok {
+		return fmt.Errorf("engine: connection %s does not exist", name)
+	}
+	if manager.Count() > 0 {
+		return fmt.Errorf("engine: connection %s has devices", name)
+	}
+
+	delete(e.managers, name)
+	if e.qe == nil || !e.qe.removeConnection(name) {
+		manager.Conn.Close()
+	}
+
+	return nil
+}
+
+// Manager returns the device manager of the named connection or nil if the connection does not exist
+func (e *Engine) Manager(name string) *meters.Manager {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	return e.managers[name]
+}
+
+// AddDevice attaches a device with given slave id to the named connection.
+// Devices added while the engine is running are queried from the connection's next cycle on.
+func (e *Engine) AddDevice(connection string, id uint8, dev meters.Device, labels Labels) error {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	manager, ok := e.managers[connection]
+	if !ok {
+		return fmt.Errorf("engine: connection %s does not exist", connection)
+	}
+
+	return e.addDevice(connection, manager, id, dev, labels)
+}
+
+// addDevice attaches the device to the connection's manager. It must be called with the engine locked.
+func (e *Engine) addDevice(connection string, manager *meters.Manager, id uint8, dev meters.Device, labels Labels) error {
+	if e.qe != nil {
+		if err := e.qe.AddDevice(connection, manager, id, dev); err != nil {
+			return err
+		}
+		if labels.Name != "" || len(labels.Tags) > 0 {
+			e.qe.SetLabels(dev, labels)
+		}
+		return nil
+	}
+
+	if err := manager.Add(id, dev); err != nil {
+		return err
+	}
+
+	if labels.Name != "" || len(labels.Tags) > 0 {
+		e.labels[dev] = labels
+	}
+
+	return nil
+}
+
+// RemoveDevice removes a device. Devices removed while the engine is running
+// are no longer queried from the connection's next cycle on.
+func (e *Engine) RemoveDevice(dev meters.Device) error {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	if e.qe != nil {
+		id := e.qe.DeviceID(dev)
+		if id == "" {
+			return fmt.Errorf("engine: device does not exist")
+		}
+		return e.qe.RemoveDevice(id)
+	}
+
+	for _, manager := range e.managers {
+		if manager.Remove(dev) {
+			delete(e.labels, dev)
+			delete(e.options, dev)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("engine: device does not exist")
+}
+
+// SetQueryOptions assigns query retries and timeouts to a device, overriding the engine's defaults
+func (e *Engine) SetQueryOptions(dev meters.Device, opts QueryOptions) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	if e.qe != nil {
+		e.qe.SetQueryOptions(dev, opts)
+		return
+	}
+
+	e.options[dev] = opts
+}
+
+// SetDefaultQueryOptions sets query retries and timeouts of devices without own options
+func (e *Engine) SetDefaultQueryOptions(opts QueryOptions) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	if e.qe != nil {
+		e.qe.SetDefaultQueryOptions(opts)
+	}
+
+	e.query = opts
+}
+
+// RegisterDevice creates a device from spec and attaches it to the spec's adapter connection.
+// If the adapter is empty, the only connection is used. It returns the device id.
+// RegisterDevice implements the DeviceRegistry interface.
+func (e *Engine) RegisterDevice(spec DeviceSpec) (string, error) {
+	if spec.ID == 0 {
+		return "", fmt.Errorf("invalid slave id %d", spec.ID)
+	}
+
+	dev, err := NewDevice(spec.Type, spec.SubDevice)
+	if err != nil {
+		return "", err
+	}
+
+	qe := e.QueryEngine()
+
+	// check and add atomically such that concurrent registrations can't add duplicates
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	conn := spec.Adapter
+	if conn == "" && len(e.managers) == 1 {
+		for name := range e.managers {
+			conn = name
+		}
+	}
+
+	if conn == "" {
+		return "", fmt.Errorf("missing adapter")
+	}
+
+	manager, ok := e.managers[conn]
+	if !ok {
+		return "", fmt.Errorf("adapter %s does not exist", conn)
+	}
+
+	if manager.Find(func(id uint8, d meters.Device) bool {
+		return id == spec.ID && d.Descriptor().SubDevice == dev.Descriptor().SubDevice
+	}) {
+		return "", fmt.Errorf("device with slave id %d already exists on %s", spec.ID, conn)
+	}
+
+	if err := e.addDevice(conn, manager, spec.ID, dev, Labels{Name: spec.Name, Tags: spec.Tags}); err != nil {
+		return "", err
+	}
+
+	return qe.DeviceID(dev), nil
+}
+
+// UnregisterDevice removes the device identified by device id.
+// UnregisterDevice implements the DeviceRegistry interface.
+func (e *Engine) UnregisterDevice(id string) error {
+	qe := e.QueryEngine()
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	return qe.RemoveDevice(id)
+}
+
+// QueryEngine returns the engine's query engine, e.g. for device information
+// and settings.
+func (e *Engine) QueryEngine() *QueryEngine {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	if e.qe == nil {
+		e.qe = NewQueryEngine(e.managers)
+		for dev, labels := range e.labels {
+			e.qe.SetLabels(dev, labels)
+		}
+		for dev, opts := range e.options {
+			e.qe.SetQueryOptions(dev, opts)
+		}
+		e.qe.SetDefaultQueryOptions(e.query)
+		e.qe.SetSerialIDs(e.serial)
+		e.qe.SetSupervisor(e.supervisor)
+	}
+
+	return e.qe
+}
+
+// AddGroup adds a consistency group
+func (e *Engine) AddGroup(group Group) error {
+	return e.QueryEngine().AddGroup(group)
+}
+
+// Subscribe attaches a runner receiving all query results. The runner's
+// channel is closed when the engine stops or the returned function is called.
+// The returned function waits for the runner to finish. The runner is restarted if it panics.
+func (e *Engine) Subscribe(run func(<-chan QuerySnip)) func() {
+	return e.SubscribeNamed(runnerName(run), run)
+}
+
+// SubscribeNamed attaches a runner like Subscribe, the name identifies the runner's health state
+func (e *Engine) SubscribeNamed(name string, run func(<-chan QuerySnip)) func() {
+	return e.tee.AttachRunner(NewSnipRunner(func(in <-chan QuerySnip) {
+		e.supervisor.Run(context.Background(), name, 0, func(func()) { run(in) })
+	}))
+}
+
+// SubscribeControl attaches a runner receiving all device status updates.
+// The runner's channel is closed when the engine stops or the returned function is called.
+// The returned function waits for the runner to finish. The runner is restarted if it panics.
+func (e *Engine) SubscribeControl(run func(<-chan ControlSnip)) func() {
+	return e.SubscribeControlNamed(runnerName(run), run)
+}
+
+// SubscribeControlNamed attaches a runner like SubscribeControl, the name identifies the runner's health state
+func (e *Engine) SubscribeControlNamed(name string, run func(<-chan ControlSnip)) func() {
+	return e.teeC.AttachRunner(NewControlRunner(func(in <-chan ControlSnip) {
+		e.supervisor.Run(context.Background(), name, 0, func(func()) { run(in) })
+	}))
+}
+
+// ControlChannel returns a channel receiving all device status updates for
+// consumers not run by the engine. The channel must be drained until closed.
+// The returned function detaches and closes the channel.
+func (e *Engine) ControlChannel() (<-chan ControlSnip, func()) {
+	ch := e.teeC.Attach()
+	return ToControlChannel(ch), func() { e.teeC.Detach(ch) }
+}
+
+// Restore distributes restored last known values of configured devices to all subscribed
+// runners. It must be called before Run, values of unknown devices are skipped.
+func (e *Engine) Restore(snips []QuerySnip) {
+	qe := e.QueryEngine()
+	for _, snip := range snips {
+		if qe.deviceByID(snip.Device) != nil {
+			e.rc <- snip
+		}
+	}
+}
+
+// SetHooks sets the hooks applied to all query results before they are distributed.
+// It must be called before Run.
+func (e *Engine) SetHooks(hooks *Hooks) {
+	e.hooks = hooks
+}
+
+// Run queries all devices until the context is cancelled
+func (e *Engine) Run(ctx context.Context) {
+	results := e.rc
+	if e.hooks != nil {
+		results = make(chan QuerySnip)
+		go func() {
+			defer close(e.rc)
+			e.supervisor.Run(context.Background(), "hooks", 0, func(func()) { e.hooks.run(results, e.rc) })
+		}()
+	}
+
+	e.QueryEngine().Run(ctx, e.rate, e.cc, results)
+}
+
+// Supervisor returns the supervisor running the polling, hook and subscribed goroutines
+func (e *Engine) Supervisor() *Supervisor {
+	return e.supervisor
+}
+
+// Done returns a channel signalling when the engine has stopped and all subscribed runners have finished
+func (e *Engine) Done() <-chan struct{} {
+	return e.done
+}
@@ -0,0 +1,206 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func newTestEngine(t *testing.T) *Engine {
+	e := NewEngine(EngineOptions{})
+	if err := e.AddConnection("mock", meters.NewMock("mock")); err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestRegisterDevice(t *testing.T) {
+	e := newTestEngine(t)
+
+	id, err := e.RegisterDevice(DeviceSpec{Type: "SDM", ID: 1, Name: "garage"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "SDM1.1" {
+		t.Errorf("expected device SDM1.1, got %s", id)
+	}
+	if labels := e.QueryEngine().DeviceLabelsByID(id); labels.Name != "garage" {
+		t.Errorf("expected name garage, got %+v", labels)
+	}
+
+	tc := []DeviceSpec{
+		{Type: "SDM", ID: 1},                  // duplicate
+		{Type: "SDM", ID: 0},                  // invalid id
+		{Type: "FOO", ID: 2},                  // invalid type
+		{Type: "SDM", ID: 2, Adapter: "foo"},  // invalid adapter
+		{Type: "SDM", ID: 2, SubDevice: 1},    // subdevice of rs485 device
+		{Type: "DZG", ID: 1, Adapter: "mock"}, // duplicate of other type
+	}
+
+	for _, spec := range tc {
+		if _, err := e.RegisterDevice(spec); err == nil {
+			t.Errorf("%+v: expected error", spec)
+		}
+	}
+}
+
+func TestRegisterDeviceConcurrent(t *testing.T) {
+	e := newTestEngine(t)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 100)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := e.RegisterDevice(DeviceSpec{Type: "SDM", ID: 1})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	var added int
+	for err := range errs {
+		if err == nil {
+			added++
+		}
+	}
+
+	if added != 1 {
+		t.Errorf("expected device added once, got %d", added)
+	}
+	if devices := e.QueryEngine().Devices(); len(devices) != 1 {
+		t.Errorf("expected 1 device, got %d", len(devices))
+	}
+}
+
+func TestUnregisterDevice(t *testing.T) {
+	e := newTestEngine(t)
+
+	for _, id := range []uint8{1, 2, 3} {
+		if _, err := e.RegisterDevice(DeviceSpec{Type: "SDM", ID: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := e.AddGroup(Group{Name: "group", Devices: []string{"SDM1.2", "SDM1.3"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := e.UnregisterDevice("SDM1.1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := e.UnregisterDevice("SDM1.1"); err == nil {
+		t.Error("expected error removing device twice")
+	}
+
+	if err := e.UnregisterDevice("SDM1.2"); err == nil {
+		t.Error("expected error removing grouped device")
+	}
+
+	qe := e.QueryEngine()
+	if dev := qe.deviceByID("SDM1.1"); dev != nil {
+		t.Error("expected removed device not found")
+	}
+	if dev := qe.deviceByID("SDM1.2"); dev == nil {
+		t.Error("expected grouped device retained")
+	}
+
+	// removed devices can be added again
+	if _, err := e.RegisterDevice(DeviceSpec{Type: "SDM", ID: 1}); err != nil {
+		t.Error(err)
+	}
+}
+
+// closingConn counts connection closes
+type closingConn struct {
+	meters.Connection
+	closed int32
+}
+
+func (c *closingConn) Close() {
+	atomic.AddInt32(&c.closed, 1)
+}
+
+func TestRemoveConnection(t *testing.T) {
+	e := NewEngine(EngineOptions{Rate: 10 * time.Millisecond})
+
+	conns := make(map[string]*closingConn)
+	devs := make(map[string]*countingDevice)
+	add := func(name string) {
+		t.Helper()
+		conns[name] = &closingConn{Connection: meters.NewMock(name)}
+		devs[name] = &countingDevice{serialDevice: serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}}
+		if err := e.AddConnection(name, conns[name]); err != nil {
+			t.Fatal(err)
+		}
+		if err := e.AddDevice(name, 1, devs[name], Labels{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	add("first")
+	add("second")
+	qe := e.QueryEngine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		e.Run(ctx)
+		close(done)
+	}()
+
+	// wait for the handlers to be running
+	timeout := time.After(5 * time.Second)
+	for atomic.LoadInt32(&devs["first"].queries) == 0 {
+		select {
+		case <-timeout:
+			t.Fatal("device not queried")
+		case <-time.After(time.Millisecond):
+		}
+	}
+
+	if err := e.RemoveConnection("first"); err == nil {
+		t.Error("expected error removing connection with devices")
+	}
+
+	if err := e.RemoveDevice(devs["first"]); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.RemoveConnection("first"); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.RemoveConnection("first"); err == nil {
+		t.Error("expected error removing connection twice")
+	}
+
+	// the removed connection is closed once its handler has stopped
+	for atomic.LoadInt32(&conns["first"].closed) == 0 {
+		select {
+		case <-timeout:
+			t.Fatal("removed connection not closed")
+		case <-time.After(time.Millisecond):
+		}
+	}
+
+	// ids of remaining connections are not reused
+	add("third")
+	if id := qe.DeviceID(devs["third"]); id != "SDM3.1" {
+		t.Errorf("expected device SDM3.1, got %s", id)
+	}
+
+	cancel()
+	<-done
+
+	for name, conn := range conns {
+		if n := atomic.LoadInt32(&conn.closed); n != 1 {
+			t.Errorf("%s: expected connection closed once, got %d", name, n)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// readingsFilter restricts the measurements and fields returned by the readings endpoints
+type readingsFilter struct {
+	measurements []string        // lower case glob patterns of measurement names
+	phases       map[int]bool    // phases 1-3
+	fields       map[string]bool // lower case field names, all fields if nil
+}
+
+// splitParam splits a comma-separated query parameter into its trimmed, non-empty parts
+func splitParam(r *http.Request, param string) []string {
+	var res []string
+	for _, s := range strings.Split(r.URL.Query().Get(param), ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// parseReadingsFilter parses the measurement, phase and fields query parameters
+func parseReadingsFilter(r *http.Request) (readingsFilter, error) {
+	var f readingsFilter
+
+	for _, pattern := range splitParam(r, "measurement") {
+		f.measurements = append(f.measurements, strings.ToLower(pattern))
+	}
+
+	for _, p := range splitParam(r, "phase") {
+		phase, err := strconv.Atoi(strings.TrimPrefix(strings.ToUpper(p), "L"))
+		if err != nil || phase < 1 || phase > 3 {
+			return f, fmt.Errorf("invalid phase %s", p)
+		}
+
+		if f.phases == nil {
+			f.phases = make(map[int]bool)
+		}
+		f.phases[phase] = true
+	}
+
+	for _, field := range splitParam(r, "fields") {
+		if f.fields == nil {
+			f.fields = make(map[string]bool)
+		}
+		f.fields[strings.ToLower(field)] = true
+	}
+
+	return f, nil
+}
+
+// phase returns the phase of the measurement or 0 if it is not a phase measurement
+func phase(m meters.Measurement) int {
+	name := m.String()
+	if n := len(name); n > 2 && name[n-2] == 'L' && name[n-1] >= '1' && name[n-1] <= '3' {
+		return int(name[n-1] - '0')
+	}
+	return 0
+}
+
+// match returns true if the measurement matches the filter
+func (f readingsFilter) match(m meters.Measurement) bool {
+	if f.phases != nil && !f.phases[phase(m)] {
+		return false
+	}
+
+	if len(f.measurements) == 0 {
+		return true
+	}
+
+	for _, pattern := range f.measurements {
+		if glob(pattern, m.String()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// field returns true if the field is selected
+func (f readingsFilter) field(name string) bool {
+	return f.fields == nil || f.fields[strings.ToLower(name)]
+}
+
+// apply removes the measurements not matching the filter from the readings. The readings
+// must be a copy as returned by the cache.
+func (f readingsFilter) apply(r *Readings) {
+	if f.phases == nil && len(f.measurements) == 0 {
+		return
+	}
+
+	for m := range r.Values {
+		if f.match(m) {
+			continue
+		}
+
+		delete(r.Values, m)
+		delete(r.Timestamps, m)
+		delete(r.Cycles, m)
+		delete(r.Derived, m)
+		delete(r.Ages, m)
+		delete(r.Outdated, m)
+	}
+}
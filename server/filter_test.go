@@ -0,0 +1,92 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestReadingsFilter(t *testing.T) {
+	if _, err := parseReadingsFilter(httptest.NewRequest("GET", "/api/v1/last?phase=4", nil)); err == nil {
+		t.Error("expected error for invalid phase")
+	}
+
+	tc := []struct {
+		query    string
+		expected []meters.Measurement
+	}{
+		{"", []meters.Measurement{meters.Power, meters.PowerL1, meters.PowerL2, meters.VoltageL1}},
+		{"measurement=power*", []meters.Measurement{meters.Power, meters.PowerL1, meters.PowerL2}},
+		{"phase=L1", []meters.Measurement{meters.PowerL1, meters.VoltageL1}},
+		{"measurement=Power*&phase=1,2", []meters.Measurement{meters.PowerL1, meters.PowerL2}},
+	}
+
+	for _, tc := range tc {
+		f, err := parseReadingsFilter(httptest.NewRequest("GET", "/api/v1/last?"+tc.query, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r := &Readings{
+			Values:     make(map[meters.Measurement]float64),
+			Timestamps: make(map[meters.Measurement]time.Time),
+		}
+		for _, m := range []meters.Measurement{meters.Power, meters.PowerL1, meters.PowerL2, meters.VoltageL1} {
+			r.Values[m] = 1
+			r.Timestamps[m] = time.Now()
+		}
+
+		f.apply(r)
+
+		if len(r.Values) != len(tc.expected) || len(r.Timestamps) != len(tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.query, tc.expected, r.Values)
+		}
+		for _, m := range tc.expected {
+			if _, ok := r.Values[m]; !ok {
+				t.Errorf("%s: expected %s", tc.query, m)
+			}
+		}
+	}
+}
+
+func TestReadingsFilterFields(t *testing.T) {
+	f, err := parseReadingsFilter(httptest.NewRequest("GET", "/api/v1/last?fields=value,Timestamp", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &Readings{
+		Timestamp: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		Values: map[meters.Measurement]float64{
+			meters.Power: 100,
+		},
+		Timestamps: map[meters.Measurement]time.Time{
+			meters.Power: time.Date(2020, 1, 1, 11, 59, 59, 500e6, time.UTC),
+		},
+	}
+
+	dev := newV1Device("SDM1.1", Labels{}, r)
+	dev.filter(f)
+
+	b, err := json.Marshal(dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"device":"SDM1.1","timestamp":"2020-01-01T12:00:00.000Z","readings":[{"measurement":"Power","value":100,"timestamp":"2020-01-01T11:59:59.500Z"}]}`
+	if string(b) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, b)
+	}
+
+	if b, err = json.Marshal(apiData{readings: r, filter: f}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected = `{"Timestamp":"2020-01-01T12:00:00.000Z","Power":100.000000}`
+	if string(b) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, b)
+	}
+}
@@ -0,0 +1,248 @@
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/log"
+	"github.com/volkszaehler/mbmd/meters"
+	"github.com/volkszaehler/mbmd/meters/rs485"
+)
+
+// BusForwarder forwards raw Modbus requests to the devices of an adapter
+type BusForwarder interface {
+	Forward(ctx context.Context, adapter string, unit uint8, pdu []byte) ([]byte, error)
+}
+
+// forwardRequest is a pending request forwarded to the handler's bus
+type forwardRequest struct {
+	unit   uint8
+	pdu    []byte
+	result chan []byte
+}
+
+// Forward forwards the request PDU to the unit on the adapter's bus and returns the response PDU.
+// The request is executed by the adapter's connection handler between its device queries.
+func (q *QueryEngine) Forward(ctx context.Context, adapter string, unit uint8, pdu []byte) ([]byte, error) {
+	q.Lock()
+	h, ok := q.handlers[adapter]
+	q.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("adapter %s has no devices", adapter)
+	}
+
+	req := forwardRequest{
+		unit:   unit,
+		pdu:    pdu,
+		result: make(chan []byte, 1),
+	}
+
+	select {
+	case h.forwards <- req:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	select {
+	case res := <-req.result:
+		return res, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// processForwards executes all pending forwarded requests
+func (h *Handler) processForwards() {
+	for {
+		select {
+		case req := <-h.forwards:
+			h.forward(req)
+		default:
+			return
+		}
+	}
+}
+
+// forward executes a forwarded request on the handler's connection. Writes to protected
+// registers of known device types are rejected with an illegal data address exception.
+func (h *Handler) forward(req forwardRequest) {
+	if h.protected(req.unit, req.pdu) {
+		log.Warnf("gateway: unit %d: refusing write to protected registers: % x", req.unit, req.pdu)
+		req.result <- []byte{req.pdu[0] | 0x80, modbus.ExceptionCodeIllegalDataAddress}
+		return
+	}
+
+	h.Manager.Conn.Slave(req.unit)
+	res := forwardPDU(h.Manager.Conn.ModbusClient(), req.pdu)
+	log.Debugf("gateway: unit %d: % x -> % x", req.unit, req.pdu, res)
+	req.result <- res
+}
+
+// protected returns true if the request PDU writes protected registers of the unit's device
+func (h *Handler) protected(unit uint8, pdu []byte) bool {
+	register, length, ok := writtenRegisters(pdu)
+	if !ok {
+		return false
+	}
+
+	return h.Manager.Find(func(id uint8, dev meters.Device) bool {
+		d, ok := dev.(*rs485.RS485)
+		return ok && id == unit && rs485.IsProtected(d.Producer(), register, length)
+	})
+}
+
+// writeFunctions are the function codes writing to the device
+var writeFunctions = map[byte]bool{
+	modbus.FuncCodeWriteSingleCoil:            true,
+	modbus.FuncCodeWriteSingleRegister:        true,
+	modbus.FuncCodeWriteMultipleCoils:         true,
+	modbus.FuncCodeWriteMultipleRegisters:     true,
+	modbus.FuncCodeMaskWriteRegister:          true,
+	modbus.FuncCodeReadWriteMultipleRegisters: true,
+}
+
+// writtenRegisters returns the holding registers written by the request PDU
+func writtenRegisters(pdu []byte) (register, length uint16, ok bool) {
+	u16 := func(i int) uint16 {
+		return binary.BigEndian.Uint16(pdu[1+2*i:])
+	}
+
+	switch {
+	case len(pdu) < 5:
+		return 0, 0, false
+	case pdu[0] == modbus.FuncCodeWriteSingleRegister, pdu[0] == modbus.FuncCodeMaskWriteRegister:
+		return u16(0), 1, true
+	case pdu[0] == modbus.FuncCodeWriteMultipleRegisters:
+		return u16(0), u16(1), true
+	case pdu[0] == modbus.FuncCodeReadWriteMultipleRegisters && len(pdu) >= 9:
+		return u16(2), u16(3), true
+	}
+
+	return 0, 0, false
+}
+
+// errInvalidRequest is returned for malformed request PDUs
+var errInvalidRequest = errors.New("invalid request")
+
+// forwardPDU executes the request PDU using the client and returns the response PDU. Exceptions of the
+// device are returned unchanged, devices failing to respond are reported as gateway exception.
+func forwardPDU(client modbus.Client, pdu []byte) []byte {
+	fc := pdu[0]
+	data := pdu[1:]
+
+	res, err := forwardFunction(client, fc, data)
+	if err == nil {
+		return append([]byte{fc}, res...)
+	}
+
+	var code byte = modbus.ExceptionCodeGatewayTargetDeviceFailedToRespond
+	var me *modbus.Error
+	switch {
+	case errors.As(err, &me):
+		code = me.ExceptionCode
+	case err == errInvalidRequest:
+		code = modbus.ExceptionCodeIllegalDataValue
+	case err == errUnsupportedFunction:
+		code = modbus.ExceptionCodeIllegalFunction
+	}
+
+	return []byte{fc | 0x80, code}
+}
+
+// errUnsupportedFunction is returned for function codes that cannot be forwarded
+var errUnsupportedFunction = errors.New("unsupported function")
+
+// forwardFunction calls the client method of the function code and encodes the response data
+func forwardFunction(client modbus.Client, fc byte, data []byte) ([]byte, error) {
+	u16 := func(i int) uint16 {
+		return binary.BigEndian.Uint16(data[2*i:])
+	}
+
+	// request length of the function codes, odd lengths end with the byte count of the following values
+	lengths := map[byte]int{
+		modbus.FuncCodeReadCoils:                  4,
+		modbus.FuncCodeReadDiscreteInputs:         4,
+		modbus.FuncCodeReadHoldingRegisters:       4,
+		modbus.FuncCodeReadInputRegisters:         4,
+		modbus.FuncCodeWriteSingleCoil:            4,
+		modbus.FuncCodeWriteSingleRegister:        4,
+		modbus.FuncCodeMaskWriteRegister:          6,
+		modbus.FuncCodeReadFIFOQueue:              2,
+		modbus.FuncCodeWriteMultipleCoils:         5,
+		modbus.FuncCodeWriteMultipleRegisters:     5,
+		modbus.FuncCodeReadWriteMultipleRegisters: 9,
+	}
+
+	length, ok := lengths[fc]
+	if !ok {
+		return nil, errUnsupportedFunction
+	}
+	if len(data) < length {
+		return nil, errInvalidRequest
+	}
+
+	var values []byte
+	if length%2 == 1 {
+		values = data[length:]
+		if len(values) != int(data[length-1]) {
+			return nil, errInvalidRequest
+		}
+	} else if len(data) != length {
+		return nil, errInvalidRequest
+	}
+
+	// read responses start with the byte count
+	read := func(b []byte, err error) ([]byte, error) {
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{byte(len(b))}, b...), nil
+	}
+
+	// write responses echo address and value or quantity
+	write := func(_ []byte, err error) ([]byte, error) {
+		if err != nil {
+			return nil, err
+		}
+		return data[:4], nil
+	}
+
+	switch fc {
+	case modbus.FuncCodeReadCoils:
+		return read(client.ReadCoils(u16(0), u16(1)))
+	case modbus.FuncCodeReadDiscreteInputs:
+		return read(client.ReadDiscreteInputs(u16(0), u16(1)))
+	case modbus.FuncCodeReadHoldingRegisters:
+		return read(client.ReadHoldingRegisters(u16(0), u16(1)))
+	case modbus.FuncCodeReadInputRegisters:
+		return read(client.ReadInputRegisters(u16(0), u16(1)))
+	case modbus.FuncCodeReadWriteMultipleRegisters:
+		return read(client.ReadWriteMultipleRegisters(u16(0), u16(1), u16(2), u16(3), values))
+	case modbus.FuncCodeWriteSingleCoil:
+		return write(client.WriteSingleCoil(u16(0), u16(1)))
+	case modbus.FuncCodeWriteSingleRegister:
+		return write(client.WriteSingleRegister(u16(0), u16(1)))
+	case modbus.FuncCodeWriteMultipleCoils:
+		return write(client.WriteMultipleCoils(u16(0), u16(1), values))
+	case modbus.FuncCodeWriteMultipleRegisters:
+		return write(client.WriteMultipleRegisters(u16(0), u16(1), values))
+	case modbus.FuncCodeMaskWriteRegister:
+		if _, err := client.MaskWriteRegister(u16(0), u16(1), u16(2)); err != nil {
+			return nil, err
+		}
+		return data[:6], nil
+	default: // modbus.FuncCodeReadFIFOQueue
+		b, err := client.ReadFIFOQueue(u16(0))
+		if err != nil {
+			return nil, err
+		}
+		res := make([]byte, 4, 4+len(b))
+		binary.BigEndian.PutUint16(res, uint16(len(b)+2))
+		binary.BigEndian.PutUint16(res[2:], uint16(len(b)/2))
+		return append(res, b...), nil
+	}
+}
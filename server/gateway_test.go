@@ -0,0 +1,146 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+	"github.com/volkszaehler/mbmd/meters/rs485"
+)
+
+// gatewayClient returns fixed register values or an error
+type gatewayClient struct {
+	*meters.MockClient
+	registers []byte
+	written   []byte
+	err       error
+}
+
+func (c *gatewayClient) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	return c.registers, c.err
+}
+
+func (c *gatewayClient) WriteMultipleRegisters(address, quantity uint16, value []byte) ([]byte, error) {
+	c.written = value
+	return nil, c.err
+}
+
+func TestForwardPDU(t *testing.T) {
+	tc := []struct {
+		err error
+		req []byte
+		res []byte
+	}{
+		{nil, []byte{0x03, 0x00, 0x10, 0x00, 0x02}, []byte{0x03, 0x04, 0x01, 0x02, 0x03, 0x04}},
+		{nil, []byte{0x10, 0x00, 0x10, 0x00, 0x01, 0x02, 0xab, 0xcd}, []byte{0x10, 0x00, 0x10, 0x00, 0x01}},
+		{&modbus.Error{FunctionCode: 0x83, ExceptionCode: modbus.ExceptionCodeIllegalDataAddress}, []byte{0x03, 0x00, 0x10, 0x00, 0x02}, []byte{0x83, 0x02}},
+		{errors.New("timeout"), []byte{0x03, 0x00, 0x10, 0x00, 0x02}, []byte{0x83, 0x0b}},
+		{nil, []byte{0x03, 0x00, 0x10}, []byte{0x83, 0x03}},
+		{nil, []byte{0x10, 0x00, 0x10, 0x00, 0x01, 0x02, 0xab}, []byte{0x90, 0x03}},
+		{nil, []byte{0x08, 0x00, 0x00, 0x12, 0x34}, []byte{0x88, 0x01}},
+	}
+
+	for _, tc := range tc {
+		client := &gatewayClient{MockClient: meters.NewMockClient(0), registers: []byte{1, 2, 3, 4}, err: tc.err}
+		if res := forwardPDU(client, tc.req); !bytes.Equal(res, tc.res) {
+			t.Errorf("% x: expected % x, got % x", tc.req, tc.res, res)
+		}
+	}
+}
+
+func TestModbusGateway(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+	if err := m.Add(1, &serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}); err != nil {
+		t.Fatal(err)
+	}
+	sdm, err := rs485.NewDevice("SDM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Add(2, sdm); err != nil {
+		t.Fatal(err)
+	}
+
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	control := make(chan ControlSnip)
+	results := make(chan QuerySnip)
+	go func() {
+		for range control {
+		}
+	}()
+	go func() {
+		for range results {
+		}
+	}()
+
+	// requests are forwarded while the handler waits for the next cycle
+	go qe.Run(ctx, time.Hour, control, results)
+
+	s, err := NewModbusServer(deviceNames{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Gateway(qe, "mock", false)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() { _ = s.Serve(ctx, l) }()
+
+	handler := modbus.NewTCPClientHandler(l.Addr().String())
+	handler.Timeout = time.Second
+	handler.SlaveID = 5
+	defer handler.Close()
+
+	client := modbus.NewClient(handler)
+
+	b, err := client.ReadHoldingRegisters(0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) != 4 {
+		t.Errorf("expected 4 bytes, got % x", b)
+	}
+
+	// writes are refused unless enabled
+	if _, err := client.WriteSingleRegister(0x10, 1); !isException(err, modbus.ExceptionCodeIllegalFunction) {
+		t.Errorf("expected illegal function, got %v", err)
+	}
+
+	s.Gateway(qe, "mock", true)
+	if _, err := client.WriteSingleRegister(0x10, 1); err != nil {
+		t.Errorf("expected write forwarded, got %v", err)
+	}
+
+	// protected registers of known device types
+	handler.SlaveID = 2
+	if _, err := client.WriteMultipleRegisters(0x0017, 2, []byte{0, 0, 0, 0}); !isException(err, modbus.ExceptionCodeIllegalDataAddress) {
+		t.Errorf("expected illegal data address for protected register, got %v", err)
+	}
+	if _, err := client.WriteSingleRegister(0x0010, 1); err != nil {
+		t.Errorf("expected unprotected write forwarded, got %v", err)
+	}
+
+	// broadcasts are not forwarded
+	handler.SlaveID = 0
+	if _, err := client.ReadHoldingRegisters(0, 2); !isException(err, modbus.ExceptionCodeGatewayPathUnavailable) {
+		t.Errorf("expected gateway path unavailable for broadcast, got %v", err)
+	}
+	handler.SlaveID = 5
+
+	// adapter without devices
+	s.Gateway(qe, "other", false)
+	if _, err := client.ReadHoldingRegisters(0, 2); !isException(err, modbus.ExceptionCodeGatewayPathUnavailable) {
+		t.Errorf("expected gateway path unavailable, got %v", err)
+	}
+}
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Group is a consistency group of devices. Devices of a group are queried
+// back-to-back and their readings are combined into a single snapshot.
+type Group struct {
+	Name    string
+	Devices []string
+}
+
+// GroupSnapshot is a coherent set of readings of all devices of a
+// consistency group taken within a single bus pass
+type GroupSnapshot struct {
+	Group     string
+	Timestamp time.Time
+	Duration  time.Duration
+	Complete  bool
+	Devices   map[string]*Readings
+}
+
+// SnapshotCache holds the most recent snapshot per consistency group
+type SnapshotCache struct {
+	sync.Mutex
+	snapshots map[string]*GroupSnapshot
+}
+
+// NewSnapshotCache creates a consistency group snapshot cache
+func NewSnapshotCache() *SnapshotCache {
+	return &SnapshotCache{
+		snapshots: make(map[string]*GroupSnapshot),
+	}
+}
+
+// Put stores a group snapshot replacing the group's previous snapshot
+func (sc *SnapshotCache) Put(snapshot *GroupSnapshot) {
+	sc.Lock()
+	defer sc.Unlock()
+	sc.snapshots[snapshot.Group] = snapshot
+}
+
+// SortedNames returns the sorted list of groups with snapshots
+func (sc *SnapshotCache) SortedNames() []string {
+	sc.Lock()
+	defer sc.Unlock()
+
+	keys := make([]string, 0, len(sc.snapshots))
+	for k := range sc.snapshots {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Snapshot returns the latest snapshot of the given group
+func (sc *SnapshotCache) Snapshot(group string) (*GroupSnapshot, error) {
+	sc.Lock()
+	defer sc.Unlock()
+
+	if snapshot, ok := sc.snapshots[group]; ok {
+		return snapshot, nil
+	}
+
+	return nil, fmt.Errorf("group %s does not exist", group)
+}
+
+// MarshalJSON creates group snapshot api json for export
+func (s *GroupSnapshot) MarshalJSON() ([]byte, error) {
+	devices := make([]string, 0, len(s.Devices))
+	for id := range s.Devices {
+		devices = append(devices, id)
+	}
+	sort.Strings(devices)
+
+	values := kvslice{}
+	for _, id := range devices {
+		values = append(values, kv{id, apiData{readings: s.Devices[id]}})
+	}
+
+	return json.Marshal(kvslice{
+		{"Group", s.Group},
+		{"Timestamp", apiTime(s.Timestamp)},
+		{"Unix", s.Timestamp.Unix()},
+		{"Duration", s.Duration.Seconds()},
+		{"Complete", s.Complete},
+		{"Devices", values},
+	})
+}
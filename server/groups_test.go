@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestAddGroup(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+	for id := uint8(1); id <= 4; id++ {
+		dev := &serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}
+		if id == 1 {
+			dev.desc.Serial = "123456"
+		}
+		if err := m.Add(id, dev); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	other := meters.NewManager(meters.NewMock("other"))
+	if err := other.Add(1, &serialDevice{desc: meters.DeviceDescriptor{Type: "ABB"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m, "other": other})
+	qe.SetSerialIDs(true)
+
+	if err := qe.AddGroup(Group{Name: "inverter", Devices: []string{"SDM1.2", "SDM1.3"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	tc := []struct {
+		name    string
+		devices []string
+	}{
+		{"empty", nil},
+		{"missing", []string{"SDM1.4", "SDM1.9"}},
+		{"connections", []string{"SDM1.4", "ABB2.1"}},
+		{"grouped", []string{"SDM1.4", "SDM1.3"}},
+		{"duplicate", []string{"SDM1.4", "SDM1.4"}},
+		{"alias", []string{"SDM.123456", "SDM1.1"}},
+	}
+
+	for _, tc := range tc {
+		if err := qe.AddGroup(Group{Name: tc.name, Devices: tc.devices}); err == nil {
+			t.Errorf("%s: expected error", tc.name)
+		}
+	}
+
+	// rejected groups leave no trace
+	if err := qe.AddGroup(Group{Name: "heat", Devices: []string{"SDM.123456", "SDM1.4"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	h := qe.handlerByDeviceID("SDM1.2")
+	if len(h.groups) != 2 || h.groups[0].Name != "inverter" || h.groups[1].Name != "heat" {
+		t.Errorf("unexpected groups %+v", h.groups)
+	}
+}
+
+func TestGroupSnapshot(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+	ok := &flakyDevice{serialDevice: serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}}
+	failing := &flakyDevice{serialDevice: serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}, failures: 100}
+	for id, dev := range map[uint8]meters.Device{1: ok, 2: failing} {
+		if err := m.Add(id, dev); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m})
+	if err := qe.AddGroup(Group{Name: "pv", Devices: []string{"SDM1.1", "SDM1.2"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	wait := make(chan time.Time)
+	close(wait)
+
+	h := qe.handlerByDeviceID("SDM1.1")
+	h.clock = &fakeClock{step: time.Millisecond, wait: wait}
+
+	control := make(chan ControlSnip, 10)
+	results := make(chan QuerySnip, 10)
+	h.queryGroup(context.Background(), control, results, h.groups[0])
+
+	snapshots := qe.Snapshots()
+	if names := snapshots.SortedNames(); len(names) != 1 || names[0] != "pv" {
+		t.Fatalf("expected snapshot of group pv, got %v", names)
+	}
+
+	snapshot, err := snapshots.Snapshot("pv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// devices failing to respond are included without readings
+	if snapshot.Complete {
+		t.Error("expected incomplete snapshot")
+	}
+	if len(snapshot.Devices) != 2 || snapshot.Devices["SDM1.1"].Values[meters.Power] != 1 || len(snapshot.Devices["SDM1.2"].Values) != 0 {
+		t.Errorf("unexpected snapshot devices %+v", snapshot.Devices)
+	}
+
+	if _, err := snapshots.Snapshot("missing"); err == nil {
+		t.Error("expected error for missing group")
+	}
+
+	b, err := json.Marshal(snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var res struct {
+		Group    string
+		Complete bool
+		Devices  map[string]map[string]interface{}
+	}
+	if err := json.Unmarshal(b, &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Group != "pv" || res.Complete || len(res.Devices) != 2 {
+		t.Errorf("unexpected snapshot json %s", b)
+	}
+
+	// a later pass with all devices responding replaces the snapshot
+	failing.failures = 0
+	h.setRuntimeInfo(failing, &RuntimeInfo{Online: true})
+	h.queryGroup(context.Background(), control, results, h.groups[0])
+
+	if snapshot, err = snapshots.Snapshot("pv"); err != nil || !snapshot.Complete {
+		t.Errorf("expected complete snapshot, got %+v: %v", snapshot, err)
+	}
+}
@@ -0,0 +1,278 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/volkszaehler/mbmd/log"
+	"github.com/volkszaehler/mbmd/meters"
+	"github.com/volkszaehler/mbmd/server/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Maximum number of readings buffered per stream.
+// If exceeded further readings are dropped for that stream only.
+const grpcBufferSize = 256
+
+// GrpcServer serves readings via the rpc.Readings gRPC service
+type GrpcServer struct {
+	qe      DeviceInfo
+	cache   *Cache
+	mu      sync.Mutex
+	streams map[chan QuerySnip]struct{}
+}
+
+// NewGrpcServer creates a gRPC server returning last readings from the cache
+func NewGrpcServer(qe DeviceInfo, cache *Cache) *GrpcServer {
+	return &GrpcServer{
+		qe:      qe,
+		cache:   cache,
+		streams: make(map[chan QuerySnip]struct{}),
+	}
+}
+
+// Run distributes readings to the subscribed streams
+func (s *GrpcServer) Run(in <-chan QuerySnip) {
+	for snip := range in {
+		s.mu.Lock()
+		for stream := range s.streams {
+			select {
+			case stream <- snip:
+			default:
+			}
+		}
+		s.mu.Unlock()
+	}
+
+	// end subscriptions
+	s.mu.Lock()
+	for stream := range s.streams {
+		close(stream)
+		delete(s.streams, stream)
+	}
+	s.mu.Unlock()
+}
+
+// reading converts a measurement result to its protobuf message
+func reading(device string, r meters.MeasurementResult, stale bool) (*rpc.Reading, error) {
+	ts, err := ptypes.TimestampProto(r.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	_, unit := r.Measurement.DescriptionAndUnit()
+
+	return &rpc.Reading{
+		Device:      device,
+		Measurement: r.Measurement.String(),
+		Unit:        unit,
+		Value:       r.Value,
+		Timestamp:   ts,
+		Derived:     r.Derived,
+		Stale:       stale,
+	}, nil
+}
+
+// Subscribe implements rpc.ReadingsServer
+func (s *GrpcServer) Subscribe(req *rpc.SubscribeRequest, stream rpc.Readings_SubscribeServer) error {
+	selector := NewSelector(strings.Join(req.Devices, ","))
+
+	snips := make(chan QuerySnip, grpcBufferSize)
+	s.mu.Lock()
+	s.streams[snips] = struct{}{}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.streams, snips)
+		s.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case snip, ok := <-snips:
+			if !ok {
+				return nil
+			}
+
+			if !selector.Match(snip.Device, s.qe.DeviceLabelsByID(snip.Device)) {
+				continue
+			}
+
+			msg, err := reading(snip.Device, snip.MeasurementResult, snip.Stale)
+			if err != nil {
+				return err
+			}
+
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetLastReadings implements rpc.ReadingsServer
+func (s *GrpcServer) GetLastReadings(ctx context.Context, req *rpc.LastReadingsRequest) (*rpc.LastReadingsResponse, error) {
+	selector := NewSelector(strings.Join(req.Devices, ","))
+	res := &rpc.LastReadingsResponse{}
+
+	for _, id := range s.cache.SortedIDs() {
+		if !selector.Match(id, s.qe.DeviceLabelsByID(id)) {
+			continue
+		}
+
+		readings, err := s.cache.Current(id)
+		if err != nil {
+			continue // device not available
+		}
+
+		measurements := make([]meters.Measurement, 0, len(readings.Values))
+		for m := range readings.Values {
+			measurements = append(measurements, m)
+		}
+
+		sort.Slice(measurements, func(a, b int) bool {
+			return measurements[a].String() < measurements[b].String()
+		})
+
+		for _, m := range measurements {
+			ts, ok := readings.Timestamps[m]
+			if !ok {
+				ts = readings.Timestamp
+			}
+
+			msg, err := reading(id, meters.MeasurementResult{
+				Measurement: m,
+				Value:       readings.Values[m],
+				Timestamp:   ts,
+				Derived:     readings.Derived[m],
+			}, readings.Stale)
+			if err != nil {
+				return nil, err
+			}
+
+			res.Readings = append(res.Readings, msg)
+		}
+	}
+
+	return res, nil
+}
+
+// grpcRequest converts the call's metadata and verified client certificate to a http request
+// for authentication by the REST API's authenticator
+func grpcRequest(ctx context.Context) *http.Request {
+	r := &http.Request{Header: make(http.Header)}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for k, vs := range md {
+			for _, v := range vs {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			r.TLS = &info.State
+		}
+	}
+	return r.WithContext(ctx)
+}
+
+// grpcAuthenticate rejects calls that are not authenticated or of principals not allowed access
+func grpcAuthenticate(ctx context.Context, a Authenticator) error {
+	_, err := a.Authenticate(grpcRequest(ctx))
+	if errors.Is(err, ErrForbidden) {
+		log.Warnf("grpc: forbidden request: %v", err)
+		return status.Error(codes.PermissionDenied, "forbidden")
+	}
+	if err != nil {
+		log.Warnf("grpc: unauthorized request: %v", err)
+		return status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	return nil
+}
+
+// grpcAuthOptions returns interceptors applying the authenticator to unary and streaming calls
+func grpcAuthOptions(a Authenticator) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if err := grpcAuthenticate(ctx, a); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := grpcAuthenticate(ss.Context(), a); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}),
+	}
+}
+
+// ListenAndServe serves the gRPC service at the TCP address until the context is cancelled.
+// If TLS is enabled, the http server's certificate is used. Clients must present a certificate
+// signed by the client CA if configured. If auth is not nil, calls are authenticated like REST
+// API requests, using the call's metadata as headers.
+func (s *GrpcServer) ListenAndServe(ctx context.Context, addr string, conf TLSConfig, auth Authenticator) error {
+	var opts []grpc.ServerOption
+	if auth != nil {
+		opts = append(opts, grpcAuthOptions(auth)...)
+	}
+
+	if conf.Enabled() {
+		cert, err := conf.Certificate()
+		if err != nil {
+			return fmt.Errorf("grpc: failed to load certificate: %v", err)
+		}
+
+		tlsConfig := &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
+
+		if conf.ClientCAFile != "" {
+			if tlsConfig.ClientCAs, err = conf.ClientCAs(); err != nil {
+				return fmt.Errorf("grpc: failed to load client CA: %v", err)
+			}
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	srv := grpc.NewServer(opts...)
+	rpc.RegisterReadingsServer(srv, s)
+
+	go func() {
+		<-ctx.Done()
+		srv.Stop()
+	}()
+
+	log.Printf("grpc: listening at %s", l.Addr())
+
+	if err := srv.Serve(l); err != nil && ctx.Err() == nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+	"github.com/volkszaehler/mbmd/server/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestGrpcSubscribe(t *testing.T) {
+	s := NewGrpcServer(deviceNames{"SDM1.1": "garage"}, nil)
+
+	l := bufconn.Listen(1 << 16)
+	srv := grpc.NewServer()
+	rpc.RegisterReadingsServer(srv, s)
+	go func() { _ = srv.Serve(l) }()
+	defer srv.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return l.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	stream, err := rpc.NewReadingsClient(conn).Subscribe(ctx, &rpc.SubscribeRequest{Devices: []string{"garage"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// wait for the stream to be registered
+	for {
+		s.mu.Lock()
+		n := len(s.streams)
+		s.mu.Unlock()
+		if n > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	ts := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	in := make(chan QuerySnip)
+	go s.Run(in)
+	in <- QuerySnip{Device: "SDM2.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Power, Value: 1, Timestamp: ts}}
+	in <- QuerySnip{Device: "SDM1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Power, Value: 2, Timestamp: ts}}
+	close(in)
+
+	r, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Device != "SDM1.1" || r.Measurement != "Power" || r.Unit != "W" || r.Value != 2 || r.Timestamp.Seconds != ts.Unix() {
+		t.Errorf("unexpected reading %v", r)
+	}
+
+	// closed input ends the stream
+	if _, err := stream.Recv(); err == nil {
+		t.Error("expected end of stream")
+	}
+}
+
+func TestGrpcAuth(t *testing.T) {
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": meters.NewManager(meters.NewMock("mock"))})
+	control := make(chan ControlSnip)
+	defer close(control)
+	s := NewGrpcServer(qe, NewCache(0, NewStatus(qe, control), false))
+
+	l := bufconn.Listen(1 << 16)
+	srv := grpc.NewServer(grpcAuthOptions(Restrict(HeaderAuthenticator{Header: "X-Remote-User"}, "alice"))...)
+	rpc.RegisterReadingsServer(srv, s)
+	go func() { _ = srv.Serve(l) }()
+	defer srv.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return l.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	client := rpc.NewReadingsClient(conn)
+
+	tc := []struct {
+		user string
+		code codes.Code
+	}{
+		{"", codes.Unauthenticated},
+		{"mallory", codes.PermissionDenied},
+		{"alice", codes.OK},
+	}
+
+	for _, tc := range tc {
+		ctx := ctx
+		if tc.user != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "x-remote-user", tc.user)
+		}
+
+		_, err := client.GetLastReadings(ctx, &rpc.LastReadingsRequest{})
+		if code := status.Code(err); code != tc.code {
+			t.Errorf("unary %q: expected %v, got %v", tc.user, tc.code, err)
+		}
+
+		if tc.code == codes.OK {
+			continue
+		}
+
+		// streams report the error on first receive
+		stream, err := client.Subscribe(ctx, &rpc.SubscribeRequest{})
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		if code := status.Code(err); code != tc.code {
+			t.Errorf("stream %q: expected %v, got %v", tc.user, tc.code, err)
+		}
+	}
+}
+
+func TestGrpcRequestClientCert(t *testing.T) {
+	r := withClientCert(httptest.NewRequest("GET", "/", nil), "bob")
+	ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: *r.TLS}})
+
+	if principal, err := (ClientCertAuthenticator{}).Authenticate(grpcRequest(ctx)); err != nil || principal != "bob" {
+		t.Errorf("expected client certificate principal, got %q: %v", principal, err)
+	}
+}
@@ -4,40 +4,114 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"math"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/log"
 	"github.com/volkszaehler/mbmd/meters"
 )
 
 const (
 	maxRetry   = 3
-	retryDelay = 1 * time.Second
+	retryDelay = 100 * time.Millisecond // doubled with every retry
 	initDelay  = 3 * time.Second
 )
 
+// clock provides the time for measuring queries and waiting between retries
+type clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// wallClock is the clock used outside of tests
+type wallClock struct{}
+
+func (wallClock) Now() time.Time { return time.Now() }
+
+func (wallClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// QueryOptions configures device query retries and timeouts. Zero values select the defaults.
+type QueryOptions struct {
+	Retries    int           // query attempts before the device is considered offline, defaults to 3
+	Timeout    time.Duration // response timeout, defaults to the connection's timeout
+	RetryDelay time.Duration // delay before the first retry, doubled with every retry, defaults to 100ms
+	Demand     time.Duration // interval for computing power demand from energy counters, disabled by default
+	Priority   int           // devices with higher priority are queried first within a cycle, defaults to 0
+}
+
+// defaultQueryOptions are used for options not configured otherwise
+var defaultQueryOptions = QueryOptions{
+	Retries:    maxRetry,
+	RetryDelay: retryDelay,
+}
+
+// Merge returns the options with zero values replaced by the defaults' values
+func (o QueryOptions) Merge(defaults QueryOptions) QueryOptions {
+	if o.Retries <= 0 {
+		o.Retries = defaults.Retries
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = defaults.Timeout
+	}
+	if o.RetryDelay <= 0 {
+		o.RetryDelay = defaults.RetryDelay
+	}
+	if o.Demand <= 0 {
+		o.Demand = defaults.Demand
+	}
+	return o
+}
+
 // Handler is responsible for querying a single connection
 type Handler struct {
-	ID      int
-	Manager *meters.Manager
-	status  map[string]*RuntimeInfo
+	ID        int
+	Manager   *meters.Manager
+	mux       sync.Mutex // guard status
+	status    map[meters.Device]*RuntimeInfo
+	derivers  map[meters.Device]*meters.Deriver
+	demands   map[meters.Device]*meters.Demand
+	groups    []Group
+	snapshots *SnapshotCache
+	writes    chan writeRequest
+	forwards  chan forwardRequest
+	options   func(meters.Device) QueryOptions // per device query options
+	serialIDs bool                             // identify devices by serial number
+	clock     clock
+	stop      context.CancelFunc // stops the running handler
+	removed   bool               // connection removed from the query engine
+}
+
+// writeRequest is a pending device setting write
+type writeRequest struct {
+	device  string
+	setting string
+	value   float64
+	result  chan error
 }
 
 // NewHandler creates a connection handler. The handler is responsible
 // for querying all devices attached to the connection.
 func NewHandler(id int, m *meters.Manager) *Handler {
 	handler := &Handler{
-		ID:      id,
-		Manager: m,
-		status:  make(map[string]*RuntimeInfo),
+		ID:       id,
+		Manager:  m,
+		status:   make(map[meters.Device]*RuntimeInfo),
+		derivers: make(map[meters.Device]*meters.Deriver),
+		demands:  make(map[meters.Device]*meters.Demand),
+		writes:   make(chan writeRequest),
+		forwards: make(chan forwardRequest),
+		clock:    wallClock{},
 	}
 
 	return handler
 }
 
-// deviceID creates a unique id per device
-func (h *Handler) deviceID(id uint8, dev meters.Device) string {
+// addressID creates a unique id per device from the connection and slave id
+func (h *Handler) addressID(id uint8, dev meters.Device) string {
 	desc := dev.Descriptor()
 	devID := fmt.Sprintf("%s%d.%d", desc.Type, h.ID, id)
 	if desc.SubDevice > 0 {
@@ -46,43 +120,333 @@ func (h *Handler) deviceID(id uint8, dev meters.Device) string {
 	return devID
 }
 
+// serialID creates a stable id from the device type and serial number, e.g. SDM.123456.
+// It returns an empty string if the serial number is unknown.
+func serialID(desc meters.DeviceDescriptor) string {
+	serial := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' {
+			return r
+		}
+		return -1
+	}, desc.Serial)
+
+	if strings.Trim(serial, "0") == "" {
+		return ""
+	}
+
+	devID := fmt.Sprintf("%s.%s", desc.Type, serial)
+	if desc.SubDevice > 0 {
+		devID = fmt.Sprintf("%s.%d", devID, desc.SubDevice)
+	}
+	return devID
+}
+
+// deviceID creates a unique id per device. If serial ids are enabled, devices with known
+// serial number are identified by serial number instead of connection and slave id.
+func (h *Handler) deviceID(id uint8, dev meters.Device) string {
+	if h.serialIDs {
+		if devID := serialID(dev.Descriptor()); devID != "" {
+			return devID
+		}
+	}
+	return h.addressID(id, dev)
+}
+
+// hasID returns true if the device is identified by deviceID using either its serial or address id
+func (h *Handler) hasID(deviceID string, id uint8, dev meters.Device) bool {
+	return deviceID == h.deviceID(id, dev) || deviceID == h.addressID(id, dev)
+}
+
 // Run initializes and queries every device attached to the handler's connection
 func (h *Handler) Run(
 	ctx context.Context,
 	control chan<- ControlSnip,
 	results chan<- QuerySnip,
 ) {
+	// execute pending writes before querying
+	h.processWrites()
+	h.processForwards()
+
+	for _, u := range h.schedule() {
+		// consistency groups are queried back-to-back
+		if u.group != nil {
+			h.queryGroup(ctx, control, results, *u.group)
+			continue
+		}
+
+		// forwarded gateway requests are interleaved with device queries
+		h.processForwards()
+
+		h.runDevice(ctx, control, results, u.id, u.dev)
+	}
+}
+
+// pollUnit is a consistency group or a single device queried within a cycle
+type pollUnit struct {
+	group    *Group
+	id       uint8
+	dev      meters.Device
+	priority int
+}
+
+// schedule returns the groups and ungrouped devices in query order. Units with higher priority
+// are queried first, groups have the highest priority of their members. Units of equal priority
+// keep their order with groups first.
+func (h *Handler) schedule() []pollUnit {
+	units := make([]pollUnit, len(h.groups))
+	members := make([]bool, len(h.groups))
+	for i := range h.groups {
+		units[i].group = &h.groups[i]
+	}
+
 	h.Manager.All(func(id uint8, dev meters.Device) {
-		// abort if context is cancelled
-		select {
-		case <-ctx.Done():
+		priority := h.queryOptions(dev).Priority
+
+		if i := h.groupIndex(id, dev); i >= 0 {
+			if !members[i] || priority > units[i].priority {
+				units[i].priority = priority
+			}
+			members[i] = true
 			return
+		}
+
+		units = append(units, pollUnit{id: id, dev: dev, priority: priority})
+	})
+
+	sort.SliceStable(units, func(i, j int) bool {
+		return units[i].priority > units[j].priority
+	})
+
+	return units
+}
+
+// processWrites executes all pending device setting writes
+func (h *Handler) processWrites() {
+	for {
+		select {
+		case req := <-h.writes:
+			req.result <- h.writeSetting(req.device, req.setting, req.value)
 		default:
+			return
 		}
+	}
+}
 
-		// select device
-		h.Manager.Conn.Slave(id)
+// writeSetting writes a device setting
+func (h *Handler) writeSetting(deviceID string, setting string, value float64) (err error) {
+	err = fmt.Errorf("device %s does not exist", deviceID)
+
+	h.Manager.Find(func(id uint8, dev meters.Device) bool {
+		if !h.hasID(deviceID, id, dev) {
+			return false
+		}
 
-		// initialize device
-		deviceID := h.deviceID(id, dev)
-		status, ok := h.status[deviceID]
+		cd, ok := dev.(meters.Configurable)
 		if !ok {
-			var err error
-			if status, err = h.initializeDevice(ctx, control, id, dev); err != nil {
-				return
-			}
-			h.status[deviceID] = status
+			err = fmt.Errorf("device %s does not support settings", deviceID)
+			return true
 		}
 
-		if queryable, wakeup := status.IsQueryable(); wakeup {
-			log.Printf("device %s is offline - reactivating", deviceID)
-		} else if !queryable {
-			return
+		h.Manager.Conn.Slave(id)
+		if err = cd.WriteSetting(h.Manager.Conn.ModbusClient(), setting, value); err == nil {
+			log.Printf("device %s: set %s to %v", deviceID, setting, value)
 		}
 
-		// query device
-		h.queryDevice(ctx, control, results, id, dev)
+		return true
+	})
+
+	return err
+}
+
+// runtimeInfo returns the runtime info of an initialized device
+func (h *Handler) runtimeInfo(dev meters.Device) (*RuntimeInfo, bool) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	status, ok := h.status[dev]
+	return status, ok
+}
+
+// setRuntimeInfo sets the runtime info of an initialized device unless it has been removed
+func (h *Handler) setRuntimeInfo(dev meters.Device, status *RuntimeInfo) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	if h.attached(dev) {
+		h.status[dev] = status
+	}
+}
+
+// deriver returns the device's deriver for computing missing measurements
+func (h *Handler) deriver(dev meters.Device) *meters.Deriver {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	d, ok := h.derivers[dev]
+	if !ok {
+		d = meters.NewDeriver()
+		h.derivers[dev] = d
+	}
+	return d
+}
+
+// demand returns the device's demand calculator for the interval
+func (h *Handler) demand(dev meters.Device, interval time.Duration) *meters.Demand {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	d, ok := h.demands[dev]
+	if !ok || d.Interval() != interval {
+		d = meters.NewDemand(interval)
+		h.demands[dev] = d
+	}
+	return d
+}
+
+// attached returns true if the device is attached to the handler's connection
+func (h *Handler) attached(dev meters.Device) bool {
+	return h.Manager.Find(func(_ uint8, d meters.Device) bool {
+		return d == dev
+	})
+}
+
+// queryOptions returns the device's query options
+func (h *Handler) queryOptions(dev meters.Device) QueryOptions {
+	var opts QueryOptions
+	if h.options != nil {
+		opts = h.options(dev)
+	}
+	return opts.Merge(defaultQueryOptions)
+}
+
+// remove removes a device from the handler's connection.
+// The handler stops querying the device with its next query.
+func (h *Handler) remove(dev meters.Device) bool {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	delete(h.status, dev)
+	delete(h.derivers, dev)
+	delete(h.demands, dev)
+	return h.Manager.Remove(dev)
+}
+
+// find returns the slave id and device identified by deviceID
+func (h *Handler) find(deviceID string) (slaveID uint8, device meters.Device, ok bool) {
+	ok = h.Manager.Find(func(id uint8, dev meters.Device) bool {
+		if h.hasID(deviceID, id, dev) {
+			slaveID, device = id, dev
+			return true
+		}
+		return false
 	})
+	return slaveID, device, ok
+}
+
+// grouped returns true if the device is member of a consistency group
+func (h *Handler) grouped(id uint8, dev meters.Device) bool {
+	return h.groupIndex(id, dev) >= 0
+}
+
+// groupIndex returns the index of the device's consistency group or -1 if the device is not grouped
+func (h *Handler) groupIndex(id uint8, dev meters.Device) int {
+	for i, group := range h.groups {
+		for _, member := range group.Devices {
+			if h.hasID(member, id, dev) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// queryGroup queries all devices of a consistency group and stores the combined snapshot
+func (h *Handler) queryGroup(
+	ctx context.Context,
+	control chan<- ControlSnip,
+	results chan<- QuerySnip,
+	group Group,
+) {
+	snapshot := &GroupSnapshot{
+		Group:     group.Name,
+		Timestamp: time.Now(),
+		Complete:  true,
+		Devices:   make(map[string]*Readings),
+	}
+
+	for _, member := range group.Devices {
+		h.Manager.Find(func(id uint8, dev meters.Device) bool {
+			if !h.hasID(member, id, dev) {
+				return false
+			}
+
+			measurements := h.runDevice(ctx, control, results, id, dev)
+			deviceID := h.deviceID(id, dev)
+			if measurements == nil {
+				snapshot.Complete = false
+			}
+
+			readings := &Readings{}
+			for _, r := range measurements {
+				readings.Add(QuerySnip{
+					Device:            deviceID,
+					MeasurementResult: r,
+				})
+			}
+			snapshot.Devices[deviceID] = readings
+
+			return true
+		})
+	}
+
+	snapshot.Duration = time.Since(snapshot.Timestamp)
+	h.snapshots.Put(snapshot)
+}
+
+// runDevice initializes and queries a single device. It returns the published measurements
+// or nil if the device could not be queried.
+func (h *Handler) runDevice(
+	ctx context.Context,
+	control chan<- ControlSnip,
+	results chan<- QuerySnip,
+	id uint8,
+	dev meters.Device,
+) []meters.MeasurementResult {
+	// abort if context is cancelled
+	select {
+	case <-ctx.Done():
+		return nil
+	default:
+	}
+
+	// skip devices removed during the current cycle
+	if !h.attached(dev) {
+		return nil
+	}
+
+	// select device
+	h.Manager.Conn.Slave(id)
+
+	// apply device response timeout
+	opts := h.queryOptions(dev)
+	if opts.Timeout > 0 {
+		timeout := h.Manager.Conn.Timeout(opts.Timeout)
+		defer h.Manager.Conn.Timeout(timeout)
+	}
+
+	// initialize device
+	status, ok := h.runtimeInfo(dev)
+	if !ok {
+		var err error
+		if status, err = h.initializeDevice(ctx, control, id, dev); err != nil {
+			return nil
+		}
+		h.setRuntimeInfo(dev, status)
+	}
+
+	if queryable, wakeup := status.IsQueryable(); wakeup {
+		log.Printf("device %s is offline - reactivating", h.deviceID(id, dev))
+	} else if !queryable {
+		return nil
+	}
+
+	// query device
+	return h.queryDevice(ctx, control, results, id, dev, opts)
 }
 
 func (h *Handler) initializeDevice(
@@ -95,7 +459,7 @@ func (h *Handler) initializeDevice(
 
 	if err := dev.Initialize(h.Manager.Conn.ModbusClient()); err != nil {
 		if !errors.Is(err, meters.ErrPartiallyOpened) {
-			log.Printf("initializing device %s failed: %v", deviceID, err)
+			log.Errorf("initializing device %s failed: %v", deviceID, err)
 
 			// wait for error to settle
 			ctx, cancel := context.WithTimeout(ctx, initDelay)
@@ -104,10 +468,20 @@ func (h *Handler) initializeDevice(
 
 			return nil, err
 		}
-		log.Println(err) // log error but continue
+		log.Warnf("%v", err) // log error but continue
 	}
 
-	log.Printf("initialized device %s: %v", deviceID, dev.Descriptor())
+	// read serial number for identifying the device
+	if identifiable, ok := dev.(meters.Identifiable); ok && h.serialIDs {
+		identifiable.Identify(h.Manager.Conn.ModbusClient())
+	}
+
+	if serialID := h.deviceID(id, dev); serialID != deviceID {
+		log.Printf("initialized device %s as %s: %v", deviceID, serialID, dev.Descriptor())
+		deviceID = serialID
+	} else {
+		log.Printf("initialized device %s: %v", deviceID, dev.Descriptor())
+	}
 
 	// create status
 	status := &RuntimeInfo{Online: true}
@@ -121,63 +495,143 @@ func (h *Handler) initializeDevice(
 	return status, nil
 }
 
+// queryDevice queries the device and publishes its measurements. It returns the published
+// measurements or nil if the device could not be queried.
 func (h *Handler) queryDevice(
 	ctx context.Context,
 	control chan<- ControlSnip,
 	results chan<- QuerySnip,
 	id uint8,
 	dev meters.Device,
-) {
+	opts QueryOptions,
+) []meters.MeasurementResult {
 	deviceID := h.deviceID(id, dev)
-	status := h.status[deviceID]
+	status, _ := h.runtimeInfo(dev)
 
-	for retry := 0; retry < maxRetry; retry++ {
-		status.Requests++
-		measurements, err := dev.Query(h.Manager.Conn.ModbusClient())
+	res, err := h.queryWithRetry(ctx, h.Manager.Conn.ModbusClient(), deviceID, dev, status, opts)
+	if err != nil {
+		// abort without changing the device's status if cancelled while waiting for a retry
+		if err == ctx.Err() {
+			return nil
+		}
 
-		if err == nil {
-			// send ok status
-			status.Available(true)
-			control <- ControlSnip{
-				Device: deviceID,
-				Status: *status,
-			}
+		h.offline(control, deviceID, status)
+		return nil
+	}
 
-			// send measurements
-			for _, r := range measurements {
-				if math.IsNaN(r.Value) {
-					log.Printf("device %s skipping NaN for %s", deviceID, r.Measurement.String())
-					continue
-				}
+	if status.Quarantined {
+		log.Printf("device %s responded - leaving quarantine", deviceID)
+	}
 
-				snip := QuerySnip{
-					Device:            deviceID,
-					MeasurementResult: r,
-				}
-				results <- snip
+	// send ok status
+	status.Available(true)
+	status.Observe(res.duration)
+	control <- ControlSnip{
+		Device: deviceID,
+		Status: *status,
+	}
+
+	published := h.decode(deviceID, dev, res.measurements, opts)
+	cycle := Cycle{Seq: status.Successes, Timestamp: res.start, Size: len(published)}
+	dispatch(results, deviceID, cycle, published)
+
+	return published
+}
+
+// queryResult is the result of a successful device query
+type queryResult struct {
+	measurements []meters.MeasurementResult
+	start        time.Time
+	duration     time.Duration
+}
+
+// queryWithRetry queries the device until it responds or its retries are exhausted, doubling the
+// delay between attempts. It returns the last query error or the context's error if cancelled.
+func (h *Handler) queryWithRetry(
+	ctx context.Context,
+	client modbus.Client,
+	deviceID string,
+	dev meters.Device,
+	status *RuntimeInfo,
+	opts QueryOptions,
+) (queryResult, error) {
+	// quarantined devices don't get a retry budget to avoid slowing down the bus
+	attempts := opts.Retries
+	if status.Quarantined {
+		attempts = 1
+	}
+
+	var err error
+	for retry := 0; retry < attempts; retry++ {
+		if retry > 0 {
+			// wait for device to settle after error
+			select {
+			case <-ctx.Done():
+				return queryResult{}, ctx.Err()
+			case <-h.clock.After(opts.RetryDelay << (retry - 1)):
 			}
+		}
 
-			return
+		status.Requests++
+		start := h.clock.Now()
+		var measurements []meters.MeasurementResult
+		measurements, err = dev.Query(client)
+		duration := h.clock.Now().Sub(start)
+		status.BusTime += duration
+
+		if err == nil {
+			return queryResult{measurements: measurements, start: start, duration: duration}, nil
 		}
 
-		status.Errors++
-		log.Printf("device %s did not respond (%d/%d): %v", deviceID, retry+1, maxRetry, err)
+		status.Fail(err)
+		log.Warnf("device %s query failed with %s (%d/%d): %v", deviceID, errorClass(err), retry+1, attempts, err)
+	}
 
-		// wait for device to settle after error
-		select {
-		case <-ctx.Done():
-			return
-		case <-time.After(retryDelay):
+	return queryResult{}, err
+}
+
+// decode adds the derived measurements to the query results and removes invalid values
+func (h *Handler) decode(deviceID string, dev meters.Device, measurements []meters.MeasurementResult, opts QueryOptions) []meters.MeasurementResult {
+	measurements = h.deriver(dev).Derive(measurements)
+	if opts.Demand > 0 {
+		measurements = h.demand(dev, opts.Demand).Derive(measurements)
+	}
+
+	res := make([]meters.MeasurementResult, 0, len(measurements))
+	for _, r := range measurements {
+		if math.IsNaN(r.Value) {
+			log.Debugf("device %s skipping NaN for %s", deviceID, r.Measurement.String())
+			continue
 		}
+		res = append(res, r)
 	}
 
-	log.Printf("device %s is offline", deviceID)
+	return res
+}
 
+// dispatch sends the measurements of a polling cycle to the results channel
+func dispatch(results chan<- QuerySnip, deviceID string, cycle Cycle, measurements []meters.MeasurementResult) {
+	for _, r := range measurements {
+		results <- QuerySnip{
+			Device:            deviceID,
+			MeasurementResult: r,
+			Cycle:             cycle,
+		}
+	}
+}
+
+// offline closes the connection and sends the device's offline status
+func (h *Handler) offline(control chan<- ControlSnip, deviceID string, status *RuntimeInfo) {
 	// close connection to force modbus client to reopen
 	h.Manager.Conn.Close()
 
 	// send error status
 	status.Available(false)
+	if status.Quarantined {
+		log.Errorf("device %s is offline - quarantined, next attempt in %v", deviceID, status.RetryTimeout())
+	} else {
+		log.Errorf("device %s is offline", deviceID)
+	}
 	control <- ControlSnip{
 		Device: deviceID,
 		Status: *status,
@@ -0,0 +1,442 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// serialDevice is a device with fixed descriptor
+type serialDevice struct {
+	desc meters.DeviceDescriptor
+}
+
+func (d *serialDevice) Initialize(client modbus.Client) error { return nil }
+
+func (d *serialDevice) Descriptor() meters.DeviceDescriptor { return d.desc }
+
+func (d *serialDevice) Probe(client modbus.Client) (meters.MeasurementResult, error) {
+	return meters.MeasurementResult{}, nil
+}
+
+func (d *serialDevice) Query(client modbus.Client) ([]meters.MeasurementResult, error) {
+	return nil, nil
+}
+
+func TestSerialID(t *testing.T) {
+	tc := []struct {
+		desc meters.DeviceDescriptor
+		id   string
+	}{
+		{meters.DeviceDescriptor{Type: "SDM", Serial: "123456"}, "SDM.123456"},
+		{meters.DeviceDescriptor{Type: "SUNS", Serial: "AB-12 34", SubDevice: 1}, "SUNS.AB1234.1"},
+		{meters.DeviceDescriptor{Type: "SDM", Serial: "0"}, ""},
+		{meters.DeviceDescriptor{Type: "SDM"}, ""},
+	}
+
+	for _, tc := range tc {
+		if id := serialID(tc.desc); id != tc.id {
+			t.Errorf("%v: expected %q, got %q", tc.desc, tc.id, id)
+		}
+	}
+}
+
+func TestSerialIDs(t *testing.T) {
+	dev := &serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}
+
+	m := meters.NewManager(meters.NewMock("mock"))
+	if err := m.Add(1, dev); err != nil {
+		t.Fatal(err)
+	}
+
+	h := NewHandler(1, m)
+	h.serialIDs = true
+
+	if id := h.deviceID(1, dev); id != "SDM1.1" {
+		t.Errorf("expected address id without serial number, got %s", id)
+	}
+
+	dev.desc.Serial = "123456"
+	if id := h.deviceID(1, dev); id != "SDM.123456" {
+		t.Errorf("expected serial id, got %s", id)
+	}
+
+	for _, id := range []string{"SDM.123456", "SDM1.1"} {
+		if _, d, ok := h.find(id); !ok || d != dev {
+			t.Errorf("device not found by %s", id)
+		}
+	}
+}
+
+func TestDevices(t *testing.T) {
+	dev := &serialDevice{desc: meters.DeviceDescriptor{Type: "SDM", Model: "SDM630", Serial: "123456", Version: "1.2"}}
+
+	m := meters.NewManager(meters.NewMock("mock"))
+	if err := m.Add(2, dev); err != nil {
+		t.Fatal(err)
+	}
+
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m})
+	qe.SetLabels(dev, Labels{Name: "garage"})
+
+	control := make(chan ControlSnip)
+	close(control)
+
+	s := NewStatus(qe, control)
+	md := deviceMetadata(qe.Devices()[0], s)
+
+	b, err := json.Marshal(md)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"device":"SDM1.2","type":"SDM","id":2,"adapter":"mock","name":"garage","model":"SDM630","serial":"123456","firmware":"1.2","health":"unknown"}`
+	if string(b) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, b)
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+
+	priorities := make(map[meters.Device]int)
+	for id, priority := range []int{0, 0, 10, -1, 5} {
+		if id == 0 {
+			continue
+		}
+		dev := &serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}
+		if err := m.Add(uint8(id), dev); err != nil {
+			t.Fatal(err)
+		}
+		priorities[dev] = priority
+	}
+
+	h := NewHandler(1, m)
+	h.groups = []Group{{Name: "garage", Devices: []string{"SDM1.3", "SDM1.4"}}}
+	h.options = func(dev meters.Device) QueryOptions {
+		return QueryOptions{Priority: priorities[dev]}
+	}
+
+	units := h.schedule()
+	if len(units) != 3 {
+		t.Fatalf("expected 3 units, got %d", len(units))
+	}
+
+	// devices with higher priority first, groups with their highest member priority
+	if u := units[0]; u.group != nil || u.id != 2 {
+		t.Errorf("expected SDM1.2 first, got %+v", u)
+	}
+	if u := units[1]; u.group == nil || u.priority != 5 {
+		t.Errorf("expected group second, got %+v", u)
+	}
+	if u := units[2]; u.group != nil || u.id != 1 {
+		t.Errorf("expected SDM1.1 last, got %+v", u)
+	}
+}
+
+// flakyDevice fails the given number of queries before responding
+type flakyDevice struct {
+	serialDevice
+	failures int
+}
+
+func (d *flakyDevice) Query(client modbus.Client) ([]meters.MeasurementResult, error) {
+	if d.failures > 0 {
+		d.failures--
+		return nil, errors.New("timeout")
+	}
+	return []meters.MeasurementResult{{Measurement: meters.Power, Value: 1}}, nil
+}
+
+// fakeClock advances by a fixed duration per query and records the retry delays
+type fakeClock struct {
+	now    time.Time
+	step   time.Duration
+	delays []time.Duration
+	wait   chan time.Time // delays never elapse if nil
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.now = c.now.Add(c.step)
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.delays = append(c.delays, d)
+	return c.wait
+}
+
+func TestQueryRetry(t *testing.T) {
+	tc := []struct {
+		failures, retries int
+		quarantined       bool
+		published         bool
+		delays            []time.Duration
+	}{
+		{0, 3, false, true, nil},
+		{2, 3, false, true, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond}},
+		{3, 3, false, false, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond}},
+		{1, 3, true, false, nil},
+	}
+
+	for _, tc := range tc {
+		m := meters.NewManager(meters.NewMock("mock"))
+		dev := &flakyDevice{serialDevice: serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}, failures: tc.failures}
+		if err := m.Add(1, dev); err != nil {
+			t.Fatal(err)
+		}
+
+		wait := make(chan time.Time)
+		close(wait)
+		clock := &fakeClock{step: time.Millisecond, wait: wait}
+
+		h := NewHandler(1, m)
+		h.clock = clock
+		h.setRuntimeInfo(dev, &RuntimeInfo{Online: true, Quarantined: tc.quarantined})
+
+		control := make(chan ControlSnip, 1)
+		results := make(chan QuerySnip, 10)
+		opts := QueryOptions{Retries: tc.retries, RetryDelay: 100 * time.Millisecond}
+
+		published := h.queryDevice(context.Background(), control, results, 1, dev, opts)
+		if (published != nil) != tc.published {
+			t.Errorf("%+v: expected published %v, got %v", tc, tc.published, published)
+		}
+
+		if len(clock.delays) != len(tc.delays) {
+			t.Fatalf("%+v: expected delays %v, got %v", tc, tc.delays, clock.delays)
+		}
+		for i, d := range tc.delays {
+			if clock.delays[i] != d {
+				t.Errorf("%+v: expected delays %v, got %v", tc, tc.delays, clock.delays)
+			}
+		}
+
+		status := (<-control).Status
+		if status.Online != tc.published {
+			t.Errorf("%+v: expected online %v", tc, tc.published)
+		}
+
+		if requests := uint64(len(tc.delays) + 1); status.Requests != requests || status.BusTime != time.Duration(requests)*time.Millisecond {
+			t.Errorf("%+v: expected %d requests, got %d with bus time %v", tc, requests, status.Requests, status.BusTime)
+		}
+
+		if tc.published && (len(results) != 1 || (<-results).Cycle.Seq != 1) {
+			t.Errorf("%+v: expected result of first cycle", tc)
+		}
+	}
+}
+
+func TestQueryRetryCancelled(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+	dev := &flakyDevice{serialDevice: serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}, failures: 1}
+	if err := m.Add(1, dev); err != nil {
+		t.Fatal(err)
+	}
+
+	h := NewHandler(1, m)
+	h.clock = &fakeClock{}
+	h.setRuntimeInfo(dev, &RuntimeInfo{Online: true})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	control := make(chan ControlSnip, 1)
+	if published := h.queryDevice(ctx, control, nil, 1, dev, QueryOptions{Retries: 3}); published != nil {
+		t.Errorf("expected no results, got %v", published)
+	}
+
+	// the device's status is unchanged when cancelled while waiting for a retry
+	if len(control) != 0 {
+		t.Error("expected no status update")
+	}
+}
+
+func TestQuarantine(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+	dev := &flakyDevice{serialDevice: serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}, failures: 100}
+	if err := m.Add(1, dev); err != nil {
+		t.Fatal(err)
+	}
+
+	wait := make(chan time.Time)
+	close(wait)
+
+	h := NewHandler(1, m)
+	h.clock = &fakeClock{step: time.Millisecond, wait: wait}
+	h.setRuntimeInfo(dev, &RuntimeInfo{Online: true})
+	status, _ := h.runtimeInfo(dev)
+
+	control := make(chan ControlSnip, 10)
+	results := make(chan QuerySnip, 10)
+
+	// cycle runs a query cycle, optionally after the retry timeout has elapsed, and returns the number of queries
+	cycle := func(elapsed bool) (int, []meters.MeasurementResult) {
+		if elapsed {
+			status.lastFailure = time.Time{}
+		}
+		failures := dev.failures
+		published := h.runDevice(context.Background(), control, results, 1, dev)
+		for len(control) > 0 {
+			<-control
+		}
+		return failures - dev.failures, published
+	}
+
+	// devices are quarantined after repeatedly failing all retries
+	for i := 1; i <= quarantineFailures; i++ {
+		if queries, _ := cycle(true); queries != maxRetry {
+			t.Errorf("cycle %d: expected %d queries, got %d", i, maxRetry, queries)
+		}
+		if status.Online || status.Quarantined != (i == quarantineFailures) {
+			t.Errorf("cycle %d: unexpected status %+v", i, status)
+		}
+	}
+
+	// quarantined devices are not queried until the retry timeout has elapsed
+	if queries, _ := cycle(false); queries != 0 {
+		t.Errorf("expected no queries before retry timeout, got %d", queries)
+	}
+
+	// and get a single attempt only
+	if queries, _ := cycle(true); queries != 1 {
+		t.Errorf("expected single query of quarantined device, got %d", queries)
+	}
+	if !status.Quarantined {
+		t.Error("expected device to remain quarantined")
+	}
+
+	// a response leaves quarantine
+	dev.failures = 0
+	if _, published := cycle(true); published == nil {
+		t.Error("expected results of recovered device")
+	}
+	if !status.Online || status.Quarantined || status.failures != 0 {
+		t.Errorf("expected recovered device, got %+v", status)
+	}
+}
+
+func TestQueryOptionsMerge(t *testing.T) {
+	defaults := QueryOptions{Retries: 5, Timeout: time.Second, RetryDelay: 50 * time.Millisecond, Demand: time.Minute, Priority: 1}
+
+	tc := []struct {
+		opts, res QueryOptions
+	}{
+		{QueryOptions{}, QueryOptions{Retries: 5, Timeout: time.Second, RetryDelay: 50 * time.Millisecond, Demand: time.Minute}},
+		{
+			QueryOptions{Retries: 1, Timeout: 2 * time.Second, RetryDelay: time.Millisecond, Demand: time.Hour, Priority: 2},
+			QueryOptions{Retries: 1, Timeout: 2 * time.Second, RetryDelay: time.Millisecond, Demand: time.Hour, Priority: 2},
+		},
+		{QueryOptions{Retries: 2}, QueryOptions{Retries: 2, Timeout: time.Second, RetryDelay: 50 * time.Millisecond, Demand: time.Minute}},
+		{QueryOptions{Timeout: 3 * time.Second}, QueryOptions{Retries: 5, Timeout: 3 * time.Second, RetryDelay: 50 * time.Millisecond, Demand: time.Minute}},
+		// negative values select the defaults
+		{QueryOptions{Retries: -1, Timeout: -time.Second}, QueryOptions{Retries: 5, Timeout: time.Second, RetryDelay: 50 * time.Millisecond, Demand: time.Minute}},
+	}
+
+	for _, tc := range tc {
+		if res := tc.opts.Merge(defaults); res != tc.res {
+			t.Errorf("%+v: expected %+v, got %+v", tc.opts, tc.res, res)
+		}
+	}
+}
+
+func TestQueryOptionsPrecedence(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+	configured := &serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}
+	other := &serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}
+	for id, dev := range map[uint8]meters.Device{1: configured, 2: other} {
+		if err := m.Add(id, dev); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// handlers without engine use the package defaults
+	if opts := NewHandler(1, m).queryOptions(configured); opts != defaultQueryOptions {
+		t.Errorf("expected default options %+v, got %+v", defaultQueryOptions, opts)
+	}
+
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m})
+	qe.SetDefaultQueryOptions(QueryOptions{Retries: 5, Timeout: 2 * time.Second})
+	qe.SetQueryOptions(configured, QueryOptions{Retries: 1, RetryDelay: time.Second, Priority: 3})
+
+	h := qe.handlerByDeviceID("SDM1.1")
+
+	// device options take precedence over the engine's defaults, which take precedence over the package defaults
+	if expected, opts := (QueryOptions{Retries: 1, Timeout: 2 * time.Second, RetryDelay: time.Second, Priority: 3}), h.queryOptions(configured); opts != expected {
+		t.Errorf("expected device options %+v, got %+v", expected, opts)
+	}
+	if expected, opts := (QueryOptions{Retries: 5, Timeout: 2 * time.Second, RetryDelay: retryDelay}), h.queryOptions(other); opts != expected {
+		t.Errorf("expected engine options %+v, got %+v", expected, opts)
+	}
+}
+
+// timeoutConn records the connection timeout
+type timeoutConn struct {
+	*meters.Mock
+	timeout time.Duration
+}
+
+func (c *timeoutConn) Timeout(timeout time.Duration) time.Duration {
+	prev := c.timeout
+	c.timeout = timeout
+	return prev
+}
+
+// timeoutDevice records the connection timeout it was queried with
+type timeoutDevice struct {
+	serialDevice
+	conn    *timeoutConn
+	queried []time.Duration
+}
+
+func (d *timeoutDevice) Query(client modbus.Client) ([]meters.MeasurementResult, error) {
+	d.queried = append(d.queried, d.conn.timeout)
+	return []meters.MeasurementResult{{Measurement: meters.Power, Value: 1}}, nil
+}
+
+func TestDeviceTimeout(t *testing.T) {
+	conn := &timeoutConn{Mock: meters.NewMock("mock").(*meters.Mock), timeout: time.Second}
+	m := meters.NewManager(conn)
+
+	configured := &timeoutDevice{serialDevice: serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}, conn: conn}
+	other := &timeoutDevice{serialDevice: serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}, conn: conn}
+	for id, dev := range map[uint8]meters.Device{1: configured, 2: other} {
+		if err := m.Add(id, dev); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	h := NewHandler(1, m)
+	h.options = func(dev meters.Device) QueryOptions {
+		if dev == configured {
+			return QueryOptions{Timeout: 3 * time.Second}
+		}
+		return QueryOptions{}
+	}
+
+	control := make(chan ControlSnip, 10)
+	results := make(chan QuerySnip, 10)
+
+	// the device timeout applies to its queries only
+	for id, dev := range map[uint8]*timeoutDevice{1: configured, 2: other} {
+		h.setRuntimeInfo(dev, &RuntimeInfo{Online: true})
+		if published := h.runDevice(context.Background(), control, results, id, dev); published == nil {
+			t.Fatalf("device %d: expected results", id)
+		}
+		if conn.timeout != time.Second {
+			t.Errorf("device %d: expected connection timeout restored, got %v", id, conn.timeout)
+		}
+	}
+
+	if len(configured.queried) != 1 || configured.queried[0] != 3*time.Second {
+		t.Errorf("expected query with device timeout, got %v", configured.queried)
+	}
+	if len(other.queried) != 1 || other.queried[0] != time.Second {
+		t.Errorf("expected query with connection timeout, got %v", other.queried)
+	}
+}
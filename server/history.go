@@ -0,0 +1,152 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// HistoryPoint is the aggregate of a measurement's values within one resolution interval
+type HistoryPoint struct {
+	Time time.Time // start of the interval
+	Aggregate
+}
+
+// historyRing is a fixed size ring buffer of a measurement's history points
+type historyRing struct {
+	points []HistoryPoint
+	next   int // index of the next point to be overwritten once the ring is full
+}
+
+// add adds the value to the point of the interval starting at ts
+func (r *historyRing) add(ts time.Time, v float64, size int) {
+	if n := len(r.points); n > 0 {
+		last := &r.points[(r.next+n-1)%n]
+		if !ts.After(last.Time) {
+			// late values are added to the latest interval
+			last.add(v)
+			return
+		}
+	}
+
+	p := HistoryPoint{Time: ts}
+	p.add(v)
+
+	if len(r.points) < size {
+		r.points = append(r.points, p)
+		return
+	}
+
+	r.points[r.next] = p
+	r.next = (r.next + 1) % size
+}
+
+// since returns the points starting after the given time, oldest first
+func (r *historyRing) since(t time.Time) []HistoryPoint {
+	res := make([]HistoryPoint, 0, len(r.points))
+	for i := range r.points {
+		if p := r.points[(r.next+i)%len(r.points)]; p.Time.After(t) {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// History keeps a bounded in-memory history of all measurements downsampled to a fixed resolution
+type History struct {
+	mux        sync.Mutex
+	retention  time.Duration
+	resolution time.Duration
+	size       int
+	devices    map[string]map[meters.Measurement]*historyRing
+}
+
+// NewHistory creates a history retaining the given duration at the given resolution
+func NewHistory(retention, resolution time.Duration) (*History, error) {
+	if resolution < time.Second {
+		return nil, fmt.Errorf("invalid history resolution %v", resolution)
+	}
+	if retention < resolution {
+		return nil, fmt.Errorf("history retention %v is shorter than resolution %v", retention, resolution)
+	}
+
+	return &History{
+		retention:  retention,
+		resolution: resolution,
+		size:       int(retention / resolution),
+		devices:    make(map[string]map[meters.Measurement]*historyRing),
+	}, nil
+}
+
+// Resolution returns the duration of the history's intervals
+func (h *History) Resolution() time.Duration {
+	return h.resolution
+}
+
+// add adds the snip's value to its device's history
+func (h *History) add(snip QuerySnip) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	rings, ok := h.devices[snip.Device]
+	if !ok {
+		rings = make(map[meters.Measurement]*historyRing)
+		h.devices[snip.Device] = rings
+	}
+
+	r, ok := rings[snip.Measurement]
+	if !ok {
+		r = &historyRing{}
+		rings[snip.Measurement] = r
+	}
+
+	r.add(snip.Timestamp.Truncate(h.resolution), snip.Value, h.size)
+}
+
+// Device returns the device's history since the given time limited to the retention period.
+// If measurements are given, only their history is returned.
+func (h *History) Device(device string, since time.Time, measurements ...meters.Measurement) (map[meters.Measurement][]HistoryPoint, error) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	rings, ok := h.devices[device]
+	if !ok {
+		return nil, fmt.Errorf("no history for device %s", device)
+	}
+
+	if oldest := time.Now().Add(-h.retention); since.Before(oldest) {
+		since = oldest
+	}
+
+	if len(measurements) == 0 {
+		for m := range rings {
+			measurements = append(measurements, m)
+		}
+	}
+
+	res := make(map[meters.Measurement][]HistoryPoint, len(measurements))
+	for _, m := range measurements {
+		if r, ok := rings[m]; ok {
+			if points := r.since(since); len(points) > 0 {
+				res[m] = points
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// Run adds query results to the history. Restored stale values are not added.
+func (h *History) Run(in <-chan QuerySnip) {
+	for snip := range in {
+		// non-finite values can't be aggregated or encoded as json
+		if snip.Stale || snip.Timestamp.IsZero() || math.IsInf(snip.Value, 0) || math.IsNaN(snip.Value) {
+			continue
+		}
+
+		h.add(snip)
+	}
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestHistory(t *testing.T) {
+	if _, err := NewHistory(time.Minute, time.Hour); err == nil {
+		t.Error("expected error for retention shorter than resolution")
+	}
+
+	h, err := NewHistory(3*time.Minute, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now().Truncate(time.Minute).Add(-2 * time.Minute)
+	for i := 0; i < 8; i++ {
+		h.add(QuerySnip{
+			Device: "SDM1.1",
+			MeasurementResult: meters.MeasurementResult{
+				Measurement: meters.Power,
+				Value:       float64(i),
+				Timestamp:   start.Add(time.Duration(i) * 30 * time.Second),
+			},
+		})
+	}
+
+	res, err := h.Device("SDM1.1", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// oldest interval is overwritten
+	points := res[meters.Power]
+	if len(points) != 3 {
+		t.Fatalf("expected 3 points, got %v", points)
+	}
+
+	for i, p := range points {
+		if expected := start.Add(time.Duration(i+1) * time.Minute); !p.Time.Equal(expected) {
+			t.Errorf("point %d: expected %v, got %v", i, expected, p.Time)
+		}
+		if p.Count != 2 || p.Min != float64(2*i+2) || p.Max != float64(2*i+3) || p.Mean != float64(2*i)+2.5 {
+			t.Errorf("point %d: unexpected aggregate %+v", i, p.Aggregate)
+		}
+	}
+
+	if res, _ := h.Device("SDM1.1", start.Add(150*time.Second)); len(res[meters.Power]) != 1 {
+		t.Errorf("expected 1 point since given time, got %v", res[meters.Power])
+	}
+
+	if res, _ := h.Device("SDM1.1", time.Time{}, meters.Frequency); len(res) != 0 {
+		t.Errorf("expected no points for missing measurement, got %v", res)
+	}
+
+	if _, err := h.Device("SDM1.2", time.Time{}); err == nil {
+		t.Error("expected error for unknown device")
+	}
+}
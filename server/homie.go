@@ -2,7 +2,6 @@ package server
 
 import (
 	"fmt"
-	"log"
 	"sort"
 	"strings"
 	"sync"
@@ -10,6 +9,7 @@ import (
 
 	MQTT "github.com/eclipse/paho.mqtt.golang"
 
+	"github.com/volkszaehler/mbmd/log"
 	"github.com/volkszaehler/mbmd/meters"
 )
 
@@ -27,6 +27,7 @@ type HomieRunner struct {
 	rootTopic string
 	qe        DeviceInfo
 	cc        <-chan ControlSnip
+	units     UnitConverter
 	meters    map[string]*homieMeter
 }
 
@@ -34,12 +35,14 @@ type homieMeter struct {
 	*MqttClient
 	rootTopic string
 	meter     string
-	online    bool
+	units     UnitConverter
+	state     string
 	observed  map[meters.Measurement]bool
 }
 
-// NewHomieRunner create new runner for homie IoT spec
-func NewHomieRunner(qe DeviceInfo, cc <-chan ControlSnip, options *MQTT.ClientOptions, qos byte, rootTopic string, verbose bool) *HomieRunner {
+// NewHomieRunner create new runner for homie IoT spec. Units are published
+// according to the unit converter which may be nil.
+func NewHomieRunner(qe DeviceInfo, cc <-chan ControlSnip, options *MQTT.ClientOptions, qos byte, rootTopic string, units UnitConverter, verbose bool) *HomieRunner {
 	hr := &HomieRunner{
 		options:   options,
 		qos:       qos,
@@ -47,6 +50,7 @@ func NewHomieRunner(qe DeviceInfo, cc <-chan ControlSnip, options *MQTT.ClientOp
 		rootTopic: rootTopic,
 		qe:        qe,
 		cc:        cc,
+		units:     units,
 		meters:    make(map[string]*homieMeter),
 	}
 
@@ -62,6 +66,7 @@ func (hr *HomieRunner) cloneOptions() *MQTT.ClientOptions {
 	opt.SetClientID(hr.options.ClientID)
 	opt.SetCleanSession(hr.options.CleanSession)
 	opt.SetAutoReconnect(hr.options.AutoReconnect)
+	opt.SetTLSConfig(hr.options.TLSConfig)
 
 	for _, b := range hr.options.Servers {
 		opt.AddBroker(b.String())
@@ -74,6 +79,7 @@ func (hr *HomieRunner) cloneOptions() *MQTT.ClientOptions {
 func (hr *HomieRunner) Run(in <-chan QuerySnip) {
 	defer hr.unregister() // cleanup topics
 
+	cc := hr.cc
 	for {
 		select {
 		case snip, chanOpen := <-in:
@@ -86,7 +92,11 @@ func (hr *HomieRunner) Run(in <-chan QuerySnip) {
 			}
 			// publish actual message
 			meter.publishMessage(snip)
-		case snip := <-hr.cc:
+		case snip, chanOpen := <-cc:
+			if !chanOpen {
+				cc = nil // control channel closed, continue until input is closed
+				continue
+			}
 			if meter, ok := hr.meters[snip.Device]; ok {
 				meter.status(snip.Status.Online)
 			}
@@ -107,7 +117,7 @@ func (hr *HomieRunner) createMeter(snip QuerySnip) *homieMeter {
 	client := NewMqttClient(options, hr.qos, hr.verbose)
 
 	// add meter and publish
-	meter := newHomieMeter(client, hr.rootTopic, snip.Device)
+	meter := newHomieMeter(client, hr.rootTopic, snip.Device, hr.units)
 	hr.meters[snip.Device] = meter
 
 	d := hr.qe.DeviceDescriptorByID(snip.Device)
@@ -124,11 +134,12 @@ func (hr *HomieRunner) unregister() {
 }
 
 // newHomieMeter creates meter on given mqtt client
-func newHomieMeter(client *MqttClient, rootTopic string, meter string) *homieMeter {
+func newHomieMeter(client *MqttClient, rootTopic string, meter string, units UnitConverter) *homieMeter {
 	hm := &homieMeter{
 		MqttClient: client,
 		rootTopic:  rootTopic,
 		meter:      meter,
+		units:      units,
 		observed:   make(map[meters.Measurement]bool),
 	}
 	return hm
@@ -148,11 +159,12 @@ func (hr *homieMeter) publishMeter(descriptor meters.DeviceDescriptor) {
 	hr.publish(subTopic+"/$homie", specVersion)
 	hr.publish(subTopic+"/$name", hr.meter)
 	hr.publish(subTopic+"/$state", "init")
+	hr.publish(subTopic+"/$extensions", "")
 	hr.publish(subTopic+"/$implementation", "MBMD")
 
 	// node
 	hr.publish(subTopic+"/$nodes", nodeTopic)
-	hr.unpublish(subTopic, nodeTopic, "$homie", "$name", "$state", "$nodes")
+	hr.unpublish(subTopic, nodeTopic, "$homie", "$name", "$state", "$extensions", "$implementation", "$nodes")
 
 	subTopic = fmt.Sprintf("%s/%s", subTopic, nodeTopic)
 	hr.publish(subTopic+"/$name", descriptor.Manufacturer)
@@ -161,14 +173,15 @@ func (hr *homieMeter) publishMeter(descriptor meters.DeviceDescriptor) {
 
 // status updates a meter's $state attibute when its online status changes
 func (hr *homieMeter) status(online bool) {
-	if hr.online != online {
+	state := "alert"
+	if online {
+		state = "ready"
+	}
+
+	if hr.state != state {
 		subTopic := mqttDeviceTopic(hr.meter)
-		msg := "alert"
-		if online {
-			msg = "ready"
-		}
-		hr.publish(subTopic+"/$state", msg)
-		hr.online = online
+		hr.publish(subTopic+"/$state", state)
+		hr.state = state
 	}
 }
 
@@ -187,7 +200,7 @@ func (hr *homieMeter) publishMessage(snip QuerySnip) {
 		strings.ToLower(snip.Measurement.String()),
 	)
 
-	message := fmt.Sprintf("%.3f", snip.Value)
+	message := mqttMessage(snip)
 	go hr.Publish(topic, false, message)
 }
 
@@ -210,18 +223,27 @@ func (hr *homieMeter) publishProperties() {
 		property := strings.ToLower(m.String())
 		properties[i] = property
 
-		description, unit := m.DescriptionAndUnit()
+		description, _ := m.DescriptionAndUnit()
+		unit := hr.units.Unit(m)
 
 		propertySubtopic := fmt.Sprintf("%s/%s", subtopic, property)
 		hr.publish(propertySubtopic+"/$name", description)
 		hr.publish(propertySubtopic+"/$unit", unit)
-		hr.publish(propertySubtopic+"/$datatype", "float")
+
+		datatype := "float"
+		if m.Boolean() {
+			datatype = "boolean"
+		}
+		hr.publish(propertySubtopic+"/$datatype", datatype)
+
+		// values are published as non-retained messages
+		hr.publish(propertySubtopic+"/$retained", "false")
 	}
 
 	hr.publish(subtopic+"/$properties", strings.Join(properties, ","))
 
 	// unpublish remains attributes if any
-	exceptions := []string{"$name", "$unit", "$datatype", "$properties"}
+	exceptions := []string{"$name", "$type", "$properties"}
 	exceptions = append(exceptions, properties...)
 	hr.unpublish(subtopic, exceptions...)
 }
@@ -235,7 +257,7 @@ func (hr *homieMeter) publish(subtopic string, message string) {
 func (hr *homieMeter) unpublish(subtopic string, exceptions ...string) {
 	topic := fmt.Sprintf("%s/%s/#", hr.rootTopic, subtopic)
 	if hr.verbose {
-		log.Printf("mqtt: unpublish %s", topic)
+		log.Debugf("mqtt: unpublish %s", topic)
 	}
 
 	var mux sync.Mutex
@@ -0,0 +1,282 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
+	"github.com/volkszaehler/mbmd/log"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// HookConfig describes a reading hook. Filter, value and rename are expressions
+// evaluated for each matching reading.
+type HookConfig struct {
+	Name        string
+	Device      string // device id or name, all devices if empty
+	Measurement string // measurement, all measurements if empty
+	Filter      string // boolean, readings are dropped if false
+	Value       string // number replacing the reading's value
+	Rename      string // measurement name replacing the reading's measurement, kept if empty
+	Enrich      bool   // publish the result in addition to the original reading
+}
+
+// hook is a compiled reading hook
+type hook struct {
+	name        string
+	device      string
+	measurement *meters.Measurement
+	filter      *vm.Program
+	value       *vm.Program
+	rename      *vm.Program
+	enrich      bool
+}
+
+// hookFuncs are the functions available to hook expressions
+var hookFuncs = map[string]interface{}{
+	"abs":   math.Abs,
+	"min":   math.Min,
+	"max":   math.Max,
+	"round": math.Round,
+	"isNaN": math.IsNaN,
+}
+
+// hookEnv returns the expression environment of the reading
+func hookEnv(snip QuerySnip, name string, last float64) map[string]interface{} {
+	_, unit := snip.Measurement.DescriptionAndUnit()
+	ts := snip.Timestamp.In(time.Local)
+
+	env := map[string]interface{}{
+		"device":      snip.Device,
+		"name":        name,
+		"measurement": snip.Measurement.String(),
+		"unit":        unit,
+		"value":       snip.Value,
+		"last":        last,
+		"time":        float64(ts.UnixNano()) / 1e9,
+		"hour":        ts.Hour(),
+		"minute":      ts.Minute(),
+		"weekday":     int(ts.Weekday()),
+	}
+	for k, f := range hookFuncs {
+		env[k] = f
+	}
+
+	return env
+}
+
+// Hooks transforms, filters and enriches readings using expressions before they reach the sinks
+type Hooks struct {
+	qe    DeviceInfo
+	hooks []hook
+}
+
+// NewHooks compiles the hooks' expressions
+func NewHooks(conf []HookConfig, qe DeviceInfo) (*Hooks, error) {
+	env := hookEnv(QuerySnip{}, "", 0)
+
+	h := &Hooks{qe: qe}
+	for i, c := range conf {
+		name := c.Name
+		if name == "" {
+			name = fmt.Sprintf("hook %d", i+1)
+		}
+
+		hk := hook{name: name, device: c.Device, enrich: c.Enrich}
+
+		if c.Measurement != "" {
+			m, err := meters.MeasurementString(c.Measurement)
+			if err != nil {
+				return nil, fmt.Errorf("hooks: %s: invalid measurement %s", name, c.Measurement)
+			}
+			hk.measurement = &m
+		}
+
+		for _, e := range []struct {
+			code    string
+			program **vm.Program
+			option  expr.Option
+		}{
+			{c.Filter, &hk.filter, expr.AsBool()},
+			{c.Value, &hk.value, expr.AsFloat64()},
+			{c.Rename, &hk.rename, nil},
+		} {
+			if e.code == "" {
+				continue
+			}
+
+			options := []expr.Option{expr.Env(env)}
+			if e.option != nil {
+				options = append(options, e.option)
+			}
+
+			program, err := expr.Compile(e.code, options...)
+			if err != nil {
+				return nil, fmt.Errorf("hooks: %s: %v", name, err)
+			}
+			*e.program = program
+		}
+
+		if hk.filter == nil && hk.value == nil && hk.rename == nil {
+			return nil, fmt.Errorf("hooks: %s: missing filter, value or rename", name)
+		}
+
+		h.hooks = append(h.hooks, hk)
+	}
+
+	return h, nil
+}
+
+// matches returns true if the hook applies to the reading
+func (h *Hooks) matches(hk *hook, snip QuerySnip) bool {
+	if hk.measurement != nil && *hk.measurement != snip.Measurement {
+		return false
+	}
+	return hk.device == "" || hk.device == snip.Device || hk.device == h.qe.DeviceLabelsByID(snip.Device).Name
+}
+
+// apply evaluates the hook. It returns false if the reading is dropped.
+func (hk *hook) apply(snip *QuerySnip, env map[string]interface{}) (bool, error) {
+	if hk.filter != nil {
+		res, err := expr.Run(hk.filter, env)
+		if err != nil {
+			return false, err
+		}
+		if !res.(bool) {
+			return false, nil
+		}
+	}
+
+	if hk.value != nil {
+		res, err := expr.Run(hk.value, env)
+		if err != nil {
+			return false, err
+		}
+		snip.Value = res.(float64)
+	}
+
+	if hk.rename != nil {
+		res, err := expr.Run(hk.rename, env)
+		if err != nil {
+			return false, err
+		}
+
+		name, ok := res.(string)
+		if !ok {
+			return false, fmt.Errorf("rename: expected string, got %v", res)
+		}
+
+		if name != "" {
+			m, err := meters.MeasurementString(name)
+			if err != nil {
+				return false, fmt.Errorf("rename: invalid measurement %s", name)
+			}
+			snip.Measurement = m
+		}
+	}
+
+	return true, nil
+}
+
+// process applies the hooks in order, each hook receiving the previous hook's results
+func (h *Hooks) process(snip QuerySnip, last map[string]float64) []QuerySnip {
+	results := []QuerySnip{snip}
+
+	for i := range h.hooks {
+		hk := &h.hooks[i]
+
+		var next []QuerySnip
+		for _, snip := range results {
+			if !h.matches(hk, snip) {
+				next = append(next, snip)
+				continue
+			}
+
+			prev, ok := last[snip.Device+"."+snip.Measurement.String()]
+			if !ok {
+				prev = snip.Value
+			}
+
+			res := snip
+			keep, err := hk.apply(&res, hookEnv(snip, h.qe.DeviceLabelsByID(snip.Device).Name, prev))
+			if err != nil {
+				log.Errorf("hooks: %s: device %s %s: %v", hk.name, snip.Device, snip.Measurement, err)
+				next = append(next, snip)
+				continue
+			}
+
+			if hk.enrich {
+				next = append(next, snip)
+				if keep {
+					res.Derived = true
+					next = append(next, res)
+				}
+			} else if keep {
+				next = append(next, res)
+			}
+		}
+
+		results = next
+	}
+
+	return results
+}
+
+// Run applies the hooks to all readings from in and sends the results to out.
+// Readings of a polling cycle are processed together for updating the cycle's size.
+// Out is closed when in is closed.
+func (h *Hooks) Run(in <-chan QuerySnip, out chan<- QuerySnip) {
+	defer close(out)
+	h.run(in, out)
+}
+
+// run applies the hooks to all readings from in until in is closed
+func (h *Hooks) run(in <-chan QuerySnip, out chan<- QuerySnip) {
+	last := make(map[string]float64)        // last published values by device and measurement
+	pending := make(map[string][]QuerySnip) // readings of incomplete cycles by device
+
+	flush := func(snips []QuerySnip) {
+		var results []QuerySnip
+		for _, snip := range snips {
+			results = append(results, h.process(snip, last)...)
+		}
+
+		for _, snip := range results {
+			if snip.Cycle.Seq > 0 {
+				snip.Cycle.Size = len(results)
+			}
+			if !snip.Stale {
+				last[snip.Device+"."+snip.Measurement.String()] = snip.Value
+			}
+			out <- snip
+		}
+	}
+
+	for snip := range in {
+		if snip.Cycle.Seq == 0 {
+			flush([]QuerySnip{snip})
+			continue
+		}
+
+		snips := pending[snip.Device]
+		if len(snips) > 0 && snips[0].Cycle.Seq != snip.Cycle.Seq {
+			flush(snips)
+			snips = nil
+		}
+
+		snips = append(snips, snip)
+		if len(snips) < snip.Cycle.Size {
+			pending[snip.Device] = snips
+			continue
+		}
+
+		delete(pending, snip.Device)
+		flush(snips)
+	}
+
+	for _, snips := range pending {
+		flush(snips)
+	}
+}
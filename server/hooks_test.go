@@ -0,0 +1,75 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestHooks(t *testing.T) {
+	hooks, err := NewHooks([]HookConfig{
+		{Name: "spikes", Measurement: "Power", Filter: "abs(value - last) < 10000"},
+		{Name: "watts", Device: "garage", Measurement: "Import", Value: "value * 1000"},
+		{Name: "tariff", Measurement: "Import", Rename: `hour >= 22 || hour < 6 ? "ImportT2" : "ImportT1"`, Enrich: true},
+	}, deviceNames{"SDM1.1": "garage"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts := time.Date(2020, 1, 1, 12, 0, 0, 0, time.Local)
+	snip := func(device string, seq uint64, size int, m meters.Measurement, value float64) QuerySnip {
+		return QuerySnip{
+			Device:            device,
+			MeasurementResult: meters.MeasurementResult{Measurement: m, Value: value, Timestamp: ts},
+			Cycle:             Cycle{Seq: seq, Size: size},
+		}
+	}
+
+	in := make(chan QuerySnip, 8)
+	in <- snip("SDM1.1", 1, 2, meters.Power, 1000)
+	in <- snip("SDM1.1", 1, 2, meters.Import, 12)
+	in <- snip("SDM1.1", 2, 1, meters.Power, 50000) // spike
+	in <- snip("SDM1.2", 1, 1, meters.Import, 3)
+	close(in)
+
+	out := make(chan QuerySnip, 16)
+	hooks.Run(in, out)
+
+	expected := []QuerySnip{
+		snip("SDM1.1", 1, 3, meters.Power, 1000),
+		snip("SDM1.1", 1, 3, meters.Import, 12000),
+		snip("SDM1.1", 1, 3, meters.ImportT1, 12000),
+		snip("SDM1.2", 1, 2, meters.Import, 3),
+		snip("SDM1.2", 1, 2, meters.ImportT1, 3),
+	}
+	expected[2].Derived = true
+	expected[4].Derived = true
+
+	var results []QuerySnip
+	for snip := range out {
+		results = append(results, snip)
+	}
+
+	if len(results) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, results)
+	}
+	for i, e := range expected {
+		if results[i] != e {
+			t.Errorf("expected %v, got %v", e, results[i])
+		}
+	}
+}
+
+func TestHooksInvalid(t *testing.T) {
+	for _, conf := range []HookConfig{
+		{Measurement: "Foo", Filter: "true"},
+		{Filter: "value"},
+		{Value: `"foo"`},
+		{Name: "empty"},
+	} {
+		if _, err := NewHooks([]HookConfig{conf}, nil); err == nil {
+			t.Errorf("expected error for %v", conf)
+		}
+	}
+}
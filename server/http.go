@@ -1,42 +1,72 @@
 package server
 
 import (
+	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
+	"expvar"
 	"fmt"
 	"html/template"
-	"log"
+	golog "log"
 	"net/http"
+	"net/http/pprof"
 	"os"
 	"runtime"
 	"runtime/debug"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
+	"github.com/volkszaehler/mbmd/log"
+	"github.com/volkszaehler/mbmd/meters"
 )
 
-const devAssets = false
+const (
+	devAssets = false
+
+	// maximum time waiting for a device setting write to complete
+	settingsWriteTimeout = 8 * time.Second
+
+	// maximum time waiting for active requests on shutdown
+	httpShutdownTimeout = 5 * time.Second
+
+	// maximum time writing a response, debug endpoints allow cpu profiles and traces of up to 5 minutes
+	httpWriteTimeout  = 10 * time.Second
+	debugWriteTimeout = 5 * time.Minute
+)
 
 //go:generate esc -private -o assets.go -pkg server -modtime 1566640112 -ignore .DS_Store -prefix ../assets ../assets
 
 // Httpd is an http server
 type Httpd struct {
 	mc *Cache
+	sc *SnapshotCache
+	as *AnnotationStore
 	qe DeviceInfo
 }
 
 func (h *Httpd) mkIndexHandler() func(http.ResponseWriter, *http.Request) {
 	mainTemplate, err := _escFSString(devAssets, "/index.html")
 	if err != nil {
-		log.Fatal("httpd: failed to load embedded template: " + err.Error())
+		log.Errorf("httpd: failed to load embedded template: %v", err)
 	}
-	t, err := template.New("mbmd").Parse(mainTemplate)
-	if err != nil {
-		log.Fatal("httpd: failed to create main page template: ", err.Error())
+
+	var t *template.Template
+	if err == nil {
+		if t, err = template.New("mbmd").Parse(mainTemplate); err != nil {
+			log.Errorf("httpd: failed to create main page template: %v", err)
+		}
 	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if t == nil {
+			http.Error(w, "main page not available", http.StatusInternalServerError)
+			return
+		}
+
 		w.Header().Set("Content-Type", "text/html; charset=UTF-8")
 		w.WriteHeader(http.StatusOK)
 		data := struct {
@@ -48,27 +78,59 @@ func (h *Httpd) mkIndexHandler() func(http.ResponseWriter, *http.Request) {
 		}
 		err := t.Execute(w, data)
 		if err != nil {
-			log.Fatal("httpd: failed to render main page: ", err.Error())
+			log.Errorf("httpd: failed to render main page: %v", err)
 		}
 	})
 }
 
+// obisKeys returns true if the key query parameter requests readings keyed by OBIS code
+func obisKeys(r *http.Request) (bool, error) {
+	switch key := r.URL.Query().Get("key"); strings.ToLower(key) {
+	case "", "name":
+		return false, nil
+	case "obis":
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid key %s", key)
+	}
+}
+
 func (h *Httpd) allDevicesHandler(
 	readingsProvider func(id string) (*Readings, error),
 ) func(http.ResponseWriter, *http.Request) {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		obis, err := obisKeys(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		filter, err := parseReadingsFilter(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
 		ids := h.mc.SortedIDs()
 		res := make(map[string]apiData)
 
+		selector := NewSelector(r.URL.Query().Get("device"))
+
 		for _, id := range ids {
+			if !selector.Match(id, h.qe.DeviceLabelsByID(id)) {
+				continue
+			}
+
 			readings, err := readingsProvider(id)
 			if err != nil {
 				// Skip this meter, it will simply not be displayed
 				continue
 			}
 
-			data := apiData{readings: readings}
-			res[id] = data
+			filter.apply(readings)
+			res[id] = apiData{readings: readings, obis: obis, filter: filter}
 		}
 
 		if len(res) == 0 {
@@ -79,7 +141,51 @@ func (h *Httpd) allDevicesHandler(
 
 		w.WriteHeader(http.StatusOK)
 		if err := json.NewEncoder(w).Encode(res); err != nil {
-			log.Printf("httpd: failed to encode JSON: %s", err.Error())
+			log.Errorf("httpd: failed to encode JSON: %s", err.Error())
+		}
+	})
+}
+
+func (h *Httpd) csvHandler(
+	readingsProvider func(id string) (*Readings, error),
+) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		opt, err := NewCSVOptions(q.Get("locale"), q.Get("separator"), q.Get("decimal"), q.Get("timeformat"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		filter, err := parseReadingsFilter(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		selector := NewSelector(q.Get("device"))
+
+		res := make(map[string]*Readings)
+		for _, id := range h.mc.SortedIDs() {
+			if !selector.Match(id, h.qe.DeviceLabelsByID(id)) {
+				continue
+			}
+
+			if readings, err := readingsProvider(id); err == nil {
+				filter.apply(readings)
+				res[id] = readings
+			}
+		}
+
+		w.Header().Set("Content-Type", "text/csv; charset=UTF-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="mbmd.csv"`)
+		w.WriteHeader(http.StatusOK)
+
+		if err := WriteCSV(w, opt, res); err != nil {
+			log.Errorf("httpd: failed to encode CSV: %s", err.Error())
 		}
 	})
 }
@@ -96,6 +202,20 @@ func (h *Httpd) singleDeviceHandler(
 			return
 		}
 
+		obis, err := obisKeys(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		filter, err := parseReadingsFilter(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
 		readings, err := readingsProvider(id)
 		if err != nil {
 			w.WriteHeader(http.StatusBadRequest)
@@ -103,21 +223,498 @@ func (h *Httpd) singleDeviceHandler(
 			return
 		}
 
-		data := apiData{readings: readings}
+		filter.apply(readings)
+		data := apiData{readings: readings, obis: obis, filter: filter}
 
 		w.WriteHeader(http.StatusOK)
 		if err := json.NewEncoder(w).Encode(data); err != nil {
-			log.Printf("httpd: failed to encode JSON %s", err.Error())
+			log.Errorf("httpd: failed to encode JSON %s", err.Error())
+		}
+	})
+}
+
+func (h *Httpd) allGroupsHandler() func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res := make(map[string]*GroupSnapshot)
+		for _, name := range h.sc.SortedNames() {
+			if snapshot, err := h.sc.Snapshot(name); err == nil {
+				res[name] = snapshot
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			log.Errorf("httpd: failed to encode JSON: %s", err.Error())
+		}
+	})
+}
+
+func (h *Httpd) singleGroupHandler() func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		snapshot, err := h.sc.Snapshot(vars["name"])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(snapshot); err != nil {
+			log.Errorf("httpd: failed to encode JSON %s", err.Error())
+		}
+	})
+}
+
+// parseTime parses an optional RFC3339 time parameter
+func parseTime(r *http.Request, param string) (time.Time, error) {
+	if v := r.FormValue(param); v != "" {
+		return time.Parse(time.RFC3339, v)
+	}
+	return time.Time{}, nil
+}
+
+func (h *Httpd) allAnnotationsHandler() func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		from, err := parseTime(r, "from")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid from: %v", err)
+			return
+		}
+
+		to, err := parseTime(r, "to")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid to: %v", err)
+			return
+		}
+
+		res := h.as.Query(from, to, NewSelector(r.FormValue("device")), h.qe)
+
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			log.Errorf("httpd: failed to encode JSON: %s", err.Error())
+		}
+	})
+}
+
+func (h *Httpd) addAnnotationHandler() func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var a Annotation
+		err := json.NewDecoder(r.Body).Decode(&a)
+		if err == nil {
+			a, err = h.as.Add(a)
+		}
+
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid annotation: %v", err)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(a); err != nil {
+			log.Errorf("httpd: failed to encode JSON: %s", err.Error())
+		}
+	})
+}
+
+func (h *Httpd) deleteAnnotationHandler() func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		id, err := strconv.ParseInt(vars["id"], 10, 64)
+		if err == nil {
+			err = h.as.Delete(id)
+		}
+
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (h *Httpd) deviceSettingsHandler(sw SettingsWriter) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		settings, err := sw.DeviceSettings(vars["id"])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(settings); err != nil {
+			log.Errorf("httpd: failed to encode JSON %s", err.Error())
+		}
+	})
+}
+
+func (h *Httpd) writeSettingHandler(sw SettingsWriter, j *Journal) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		value, err := strconv.ParseFloat(r.FormValue("value"), 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid value: %v", err)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), settingsWriteTimeout)
+		defer cancel()
+
+		if err := sw.WriteSetting(ctx, vars["id"], vars["name"], value); err != nil {
+			code := http.StatusBadRequest
+			if errors.Is(err, meters.ErrProtected) {
+				code = http.StatusForbidden
+			}
+			w.WriteHeader(code)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		journalControl(j, r, vars["id"], fmt.Sprintf("set %s to %v", vars["name"], value))
+
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(map[string]float64{vars["name"]: value}); err != nil {
+			log.Errorf("httpd: failed to encode JSON %s", err.Error())
+		}
+	})
+}
+
+// journalControl records a control event including the request's principal
+func journalControl(j *Journal, r *http.Request, device, text string) {
+	if j == nil {
+		return
+	}
+	if principal := Principal(r); principal != "" {
+		text += " by " + principal
+	}
+	j.Add(Event{Type: EventControl, Device: device, Text: text})
+}
+
+// deviceMetadata adds the device's health state to its metadata
+func deviceMetadata(md DeviceMetadata, s *Status) DeviceMetadata {
+	var lastSeen time.Time
+	md.Health, lastSeen = s.Health(md.Device)
+	if !lastSeen.IsZero() {
+		md.LastSeen = apiTime(lastSeen)
+	}
+	return md
+}
+
+func (h *Httpd) allDevicesMetadataHandler(dl DeviceLister, s *Status) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		selector := NewSelector(r.URL.Query().Get("device"))
+		res := make([]DeviceMetadata, 0)
+
+		for _, md := range dl.Devices() {
+			if selector.Match(md.Device, Labels{Name: md.Name, Tags: md.Tags}) {
+				res = append(res, deviceMetadata(md, s))
+			}
+		}
+
+		v1Encode(w, http.StatusOK, res)
+	})
+}
+
+func (h *Httpd) singleDeviceMetadataHandler(dl DeviceLister, s *Status) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := mux.Vars(r)["id"]
+
+		for _, md := range dl.Devices() {
+			if md.Device == id {
+				v1Encode(w, http.StatusOK, deviceMetadata(md, s))
+				return
+			}
+		}
+
+		v1Encode(w, http.StatusNotFound, v1Error{fmt.Sprintf("device %s does not exist", id)})
+	})
+}
+
+func (h *Httpd) addDeviceHandler(dr DeviceRegistry, j *Journal) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var spec DeviceSpec
+		var id string
+		err := json.NewDecoder(r.Body).Decode(&spec)
+		if err == nil {
+			id, err = dr.RegisterDevice(spec)
+		}
+
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid device: %v", err)
+			return
+		}
+
+		journalControl(j, r, id, "device added")
+
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(map[string]string{"device": id}); err != nil {
+			log.Errorf("httpd: failed to encode JSON: %s", err.Error())
+		}
+	})
+}
+
+func (h *Httpd) deleteDeviceHandler(dr DeviceRegistry, s *Status, j *Journal) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := mux.Vars(r)["id"]
+
+		if err := dr.UnregisterDevice(id); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		s.Remove(id)
+		journalControl(j, r, id, "device removed")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (h *Httpd) reloadHandler(reload func() error) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := reload(); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (h *Httpd) eventsHandler(j *Journal) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		from, err := parseTime(r, "from")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid from: %v", err)
+			return
+		}
+
+		res := j.Query(from, r.FormValue("type"), NewSelector(r.FormValue("device")))
+
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			log.Errorf("httpd: failed to encode JSON: %s", err.Error())
+		}
+	})
+}
+
+// aggregateHandler returns the last closed aggregation window of a single or all devices.
+// Using current=true the window in progress is returned instead.
+func (h *Httpd) aggregateHandler(a *Aggregator) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		window, err := time.ParseDuration(vars["window"])
+		if err == nil && !a.Configured(window) {
+			err = fmt.Errorf("%s is not configured", WindowName(window))
+		}
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid window: %v", err)
+			return
+		}
+
+		current, _ := strconv.ParseBool(r.FormValue("current"))
+
+		var res interface{}
+		if id, ok := vars["id"]; ok {
+			aw, err := a.Window(window, id, current)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, err.Error())
+				return
+			}
+			res = aw
+		} else {
+			all := make(map[string]AggregateWindow)
+			selector := NewSelector(r.FormValue("device"))
+
+			for _, id := range h.mc.SortedIDs() {
+				if !selector.Match(id, h.qe.DeviceLabelsByID(id)) {
+					continue
+				}
+
+				if aw, err := a.Window(window, id, current); err == nil {
+					all[id] = aw
+				}
+			}
+			res = all
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			log.Errorf("httpd: failed to encode JSON: %s", err.Error())
+		}
+	})
+}
+
+// historyHandler returns the downsampled history of a single device and the annotations overlapping it.
+// The optional from parameter limits the history to intervals starting after the given time, measurement
+// to the given measurements.
+func (h *Httpd) historyHandler(hist *History) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		from, err := parseTime(r, "from")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid from: %v", err)
+			return
+		}
+
+		var measurements []meters.Measurement
+		if v := r.FormValue("measurement"); v != "" {
+			for _, name := range strings.Split(v, ",") {
+				m, err := meters.MeasurementString(strings.TrimSpace(name))
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprintf(w, "invalid measurement: %s", name)
+					return
+				}
+				measurements = append(measurements, m)
+			}
+		}
+
+		id := mux.Vars(r)["id"]
+		points, err := hist.Device(id, from, measurements...)
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		// range covered by the returned intervals
+		var start, end time.Time
+		values := make(map[string][]HistoryPoint, len(points))
+		for m, p := range points {
+			values[m.String()] = p
+
+			if first := p[0].Time; start.IsZero() || first.Before(start) {
+				start = first
+			}
+			if last := p[len(p)-1].Time.Add(hist.Resolution()); last.After(end) {
+				end = last
+			}
+		}
+
+		annotations := make([]Annotation, 0)
+		if h.as != nil && len(values) > 0 {
+			annotations = h.as.Query(start, end, NewSelector(id), h.qe)
+		}
+
+		res := struct {
+			Device      string
+			Resolution  string
+			Values      map[string][]HistoryPoint
+			Annotations []Annotation
+		}{
+			Device:      id,
+			Resolution:  WindowName(hist.Resolution()),
+			Values:      values,
+			Annotations: annotations,
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			log.Errorf("httpd: failed to encode JSON: %s", err.Error())
+		}
+	})
+}
+
+func (h *Httpd) diagHandler(diag *Diagnostics) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := fmt.Sprintf("mbmd-diag-%s.tar.gz", time.Now().Format("20060102-150405"))
+
+		w.Header().Set("Content-Type", "application/gzip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, name))
+		w.WriteHeader(http.StatusOK)
+
+		if err := diag.WriteArchive(w); err != nil {
+			log.Errorf("httpd: failed to write diagnostics: %s", err.Error())
+		}
+	})
+}
+
+// mkMetricsHandler serves the status in prometheus text exposition format
+func (h *Httpd) mkMetricsHandler(s *Status) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		if err := s.WriteMetrics(w); err != nil {
+			log.Errorf("httpd: failed to write metrics: %s", err.Error())
 		}
 	})
 }
 
-// mkSocketHandler attaches status handler to uri
+// mkStatusHandler attaches status handler to uri
 func (h *Httpd) mkStatusHandler(s *Status) func(http.ResponseWriter, *http.Request) {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		if err := json.NewEncoder(w).Encode(s); err != nil {
-			log.Printf("httpd: failed to encode JSON: %s", err.Error())
+			log.Errorf("httpd: failed to encode JSON: %s", err.Error())
+		}
+	})
+}
+
+// deviceStatusHandler serves a single device's status
+func (h *Httpd) deviceStatusHandler(s *Status) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := mux.Vars(r)["id"]
+		ds, ok := s.Device(id)
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, "no status for device %s", id)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(ds); err != nil {
+			log.Errorf("httpd: failed to encode JSON: %s", err.Error())
+		}
+	})
+}
+
+// healthHandler serves the state of the supervised goroutines. It responds with
+// 503 Service Unavailable if any of them has crashed or stalled.
+func (h *Httpd) healthHandler(sv *Supervisor) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tasks := sv.Status()
+
+		res := struct {
+			Healthy bool
+			Tasks   []TaskStatus
+		}{
+			Healthy: true,
+			Tasks:   tasks,
+		}
+
+		for _, ts := range tasks {
+			if ts.State != TaskRunning {
+				res.Healthy = false
+			}
+		}
+
+		if res.Healthy {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			log.Errorf("httpd: failed to encode JSON: %s", err.Error())
 		}
 	})
 }
@@ -151,21 +748,104 @@ func jsonHandler(h http.Handler) http.Handler {
 }
 
 // NewHttpd creates HTTP daemon
-func NewHttpd(qe DeviceInfo, mc *Cache) *Httpd {
+func NewHttpd(qe DeviceInfo, mc *Cache, sc *SnapshotCache, as *AnnotationStore) *Httpd {
 	return &Httpd{
 		qe: qe,
 		mc: mc,
+		sc: sc,
+		as: as,
 	}
 }
 
-// Run executes the http server
-func (h *Httpd) Run(
-	hub *SocketHub,
-	s *Status,
-	url string,
-) {
-	log.Printf("httpd: starting api at %s", url)
-	router := mux.NewRouter().StrictSlash(true)
+// HttpdConfig describes the http server configuration
+type HttpdConfig struct {
+	URL        string
+	BasePath   string // url path prefix when served behind a reverse proxy
+	TrustProxy bool   // apply X-Forwarded-* headers
+	TLS        TLSConfig
+	Settings   SettingsWriter // enables writing device settings if not nil
+	Reload     func() error   // enables reloading the configuration if not nil
+	Devices    DeviceRegistry // enables adding and removing devices if not nil
+	Metadata   DeviceLister   // enables device metadata api if not nil
+	Diag       *Diagnostics   // enables diagnostics bundle if not nil
+	Auth       Authenticator  // enables authentication if not nil
+	Events     *Journal       // enables event journal if not nil
+	Aggregates *Aggregator    // enables aggregation api if not nil
+	History    *History       // enables history api if not nil
+	OpenHAB    *OpenHABExport // enables openHAB things and items export if not nil
+	Debug      bool           // enables pprof and expvar debug endpoints
+	Supervisor *Supervisor    // enables health endpoint if not nil
+}
+
+// apiRoutes registers the api endpoints shared by the versioned and legacy api
+func (h *Httpd) apiRoutes(api *mux.Router, s *Status, conf HttpdConfig) {
+	api.Use(jsonHandler)
+	api.Use(handlers.CompressHandler)
+
+	api.HandleFunc("/csv/last", h.csvHandler(h.mc.Current))
+	api.HandleFunc("/csv/avg", h.csvHandler(h.mc.Average))
+	api.HandleFunc("/groups", h.allGroupsHandler())
+	api.HandleFunc("/groups/{name:[a-zA-Z0-9._-]+}", h.singleGroupHandler())
+	api.HandleFunc("/status", h.mkStatusHandler(s))
+	api.HandleFunc("/status/{id:[a-zA-Z0-9.]+}", h.deviceStatusHandler(s)).Methods(http.MethodGet)
+	api.HandleFunc("/annotations", h.allAnnotationsHandler()).Methods(http.MethodGet)
+	api.HandleFunc("/annotations", h.addAnnotationHandler()).Methods(http.MethodPost)
+	api.HandleFunc("/annotations/{id:[0-9]+}", h.deleteAnnotationHandler()).Methods(http.MethodDelete)
+
+	if conf.Metadata != nil {
+		api.HandleFunc("/devices", h.allDevicesMetadataHandler(conf.Metadata, s)).Methods(http.MethodGet)
+		api.HandleFunc("/devices/{id:[a-zA-Z0-9.]+}", h.singleDeviceMetadataHandler(conf.Metadata, s)).Methods(http.MethodGet)
+	}
+
+	if conf.Events != nil {
+		api.HandleFunc("/events", h.eventsHandler(conf.Events)).Methods(http.MethodGet)
+	}
+
+	if conf.Aggregates != nil {
+		api.HandleFunc("/aggregate/{window:[0-9hms]+}", h.aggregateHandler(conf.Aggregates)).Methods(http.MethodGet)
+		api.HandleFunc("/aggregate/{window:[0-9hms]+}/{id:[a-zA-Z0-9.]+}", h.aggregateHandler(conf.Aggregates)).Methods(http.MethodGet)
+	}
+
+	if conf.History != nil {
+		api.HandleFunc("/device/{id:[a-zA-Z0-9.]+}/history", h.historyHandler(conf.History)).Methods(http.MethodGet)
+	}
+
+	if conf.OpenHAB != nil {
+		api.HandleFunc("/openhab/things", h.openHABHandler(conf.OpenHAB, writeOpenHABThings)).Methods(http.MethodGet)
+		api.HandleFunc("/openhab/items", h.openHABHandler(conf.OpenHAB, writeOpenHABItems)).Methods(http.MethodGet)
+	}
+
+	if conf.Diag != nil {
+		api.HandleFunc("/diag", h.diagHandler(conf.Diag)).Methods(http.MethodGet)
+	}
+
+	if conf.Settings != nil {
+		api.HandleFunc("/settings/{id:[a-zA-Z0-9.]+}", h.deviceSettingsHandler(conf.Settings)).Methods(http.MethodGet)
+		api.HandleFunc("/settings/{id:[a-zA-Z0-9.]+}/{name:[a-zA-Z0-9_-]+}", h.writeSettingHandler(conf.Settings, conf.Events)).Methods(http.MethodPost, http.MethodPut)
+	}
+
+	if conf.Devices != nil {
+		api.HandleFunc("/devices", h.addDeviceHandler(conf.Devices, conf.Events)).Methods(http.MethodPost)
+		api.HandleFunc("/devices/{id:[a-zA-Z0-9.]+}", h.deleteDeviceHandler(conf.Devices, s, conf.Events)).Methods(http.MethodDelete)
+	}
+
+	if conf.Reload != nil {
+		api.HandleFunc("/reload", h.reloadHandler(conf.Reload)).Methods(http.MethodPost)
+	}
+}
+
+// handler creates the http handler serving ui, api, metrics and websocket. Responses are compressed
+// using gzip or deflate if supported by the client, websocket messages using permessage-deflate.
+func (h *Httpd) handler(hub *SocketHub, s *Status, conf HttpdConfig) http.Handler {
+	root := mux.NewRouter().StrictSlash(true)
+
+	router := root
+	if base := strings.TrimRight(conf.BasePath, "/"); base != "" {
+		log.Printf("httpd: using base path %s", base)
+		root.Handle(base, http.RedirectHandler(base+"/", http.StatusMovedPermanently))
+		router = root.PathPrefix(base).Subrouter()
+		conf.BasePath = base
+	}
 
 	// static
 	static := router.PathPrefix("/").Subrouter()
@@ -175,35 +855,138 @@ func (h *Httpd) Run(
 	static.HandleFunc("/", h.mkIndexHandler())
 	for _, folder := range []string{"js", "css"} {
 		prefix := fmt.Sprintf("/%s/", folder)
-		static.PathPrefix(prefix).Handler(http.StripPrefix(prefix, http.FileServer(_escDir(devAssets, prefix))))
+		static.PathPrefix(prefix).Handler(http.StripPrefix(conf.BasePath+prefix, http.FileServer(_escDir(devAssets, prefix))))
 	}
 
-	// api
-	api := router.PathPrefix("/api").Subrouter()
-	api.Use(jsonHandler)
-	api.Use(handlers.CompressHandler)
+	if conf.Settings != nil {
+		log.Println("httpd: device settings api enabled")
+	}
+
+	// versioned api with stable readings schema
+	v1 := router.PathPrefix("/api/v1").Subrouter()
+	v1.HandleFunc("/last", h.v1AllDevicesHandler(h.mc.Current))
+	v1.HandleFunc("/last/{id:[a-zA-Z0-9.]+}", h.v1SingleDeviceHandler(h.mc.Current))
+	v1.HandleFunc("/avg", h.v1AllDevicesHandler(h.mc.Average))
+	v1.HandleFunc("/avg/{id:[a-zA-Z0-9.]+}", h.v1SingleDeviceHandler(h.mc.Average))
+	h.apiRoutes(v1, s, conf)
 
+	// legacy api
+	api := router.PathPrefix("/api").Subrouter()
 	api.HandleFunc("/last", h.allDevicesHandler(h.mc.Current))
 	api.HandleFunc("/last/{id:[a-zA-Z0-9.]+}", h.singleDeviceHandler(h.mc.Current))
 	api.HandleFunc("/avg", h.allDevicesHandler(h.mc.Average))
 	api.HandleFunc("/avg/{id:[a-zA-Z0-9.]+}", h.singleDeviceHandler(h.mc.Average))
-	api.HandleFunc("/status", h.mkStatusHandler(s))
+	h.apiRoutes(api, s, conf)
+
+	// prometheus
+	router.Handle("/metrics", handlers.CompressHandler(http.HandlerFunc(h.mkMetricsHandler(s))))
 
 	// websocket
 	router.HandleFunc("/ws", h.mkSocketHandler(hub))
 
+	// goroutine health for liveness probes
+	if conf.Supervisor != nil {
+		router.Handle("/healthz", jsonHandler(http.HandlerFunc(h.healthHandler(conf.Supervisor)))).Methods(http.MethodGet)
+	}
+
+	// runtime profiling and variables for diagnosing long-running installations
+	if conf.Debug {
+		log.Println("httpd: debug endpoints enabled")
+		debug := router.PathPrefix("/debug").Subrouter()
+		debug.HandleFunc("/pprof/cmdline", pprof.Cmdline)
+		debug.HandleFunc("/pprof/profile", pprof.Profile)
+		debug.HandleFunc("/pprof/symbol", pprof.Symbol)
+		debug.HandleFunc("/pprof/trace", pprof.Trace)
+		debug.PathPrefix("/pprof/").Handler(http.StripPrefix(conf.BasePath, http.HandlerFunc(pprof.Index)))
+		debug.Handle("/vars", expvar.Handler())
+	}
+
+	var handler http.Handler = root
+	if conf.Auth != nil {
+		handler = authHandler(conf.Auth, handler)
+	}
+	if conf.TrustProxy {
+		handler = proxyHandler(handler)
+	}
+
+	return handler
+}
+
+// writeTimeout returns the server's write timeout. Since net/http/pprof rejects profiles
+// exceeding the write timeout, it is raised if debug endpoints are enabled.
+func writeTimeout(conf HttpdConfig) time.Duration {
+	if conf.Debug {
+		return debugWriteTimeout
+	}
+	return httpWriteTimeout
+}
+
+// Run executes the http server until the context is cancelled
+func (h *Httpd) Run(
+	ctx context.Context,
+	hub *SocketHub,
+	s *Status,
+	conf HttpdConfig,
+) error {
+	log.Printf("httpd: starting api at %s", conf.URL)
+	handler := h.handler(hub, s, conf)
+
 	// debug logger
-	_ = log.New(debugLogger{"superfluous"}, "", 0)
+	_ = golog.New(debugLogger{"superfluous"}, "", 0)
 
 	srv := http.Server{
-		Addr:         url,
-		Handler:      router,
+		Addr:         conf.URL,
+		Handler:      handler,
 		ReadTimeout:  5 * time.Second,
-		WriteTimeout: 10 * time.Second,
+		WriteTimeout: writeTimeout(conf),
 		IdleTimeout:  120 * time.Second,
 		// ErrorLog: debug,
 	}
 
 	srv.SetKeepAlivesEnabled(true)
-	log.Fatal(srv.ListenAndServe())
+
+	if conf.TLS.Enabled() {
+		cert, err := conf.TLS.Certificate()
+		if err != nil {
+			return fmt.Errorf("httpd: failed to load certificate: %v", err)
+		}
+
+		srv.TLSConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
+
+		if conf.TLS.ClientCAFile != "" {
+			if srv.TLSConfig.ClientCAs, err = conf.TLS.ClientCAs(); err != nil {
+				return fmt.Errorf("httpd: failed to load client CA: %v", err)
+			}
+			srv.TLSConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		ctx, cancel := context.WithTimeout(context.Background(), httpShutdownTimeout)
+		defer cancel()
+
+		if err := srv.Shutdown(ctx); err != nil {
+			log.Errorf("httpd: shutdown: %v", err)
+		}
+	}()
+
+	var err error
+	if conf.TLS.Enabled() {
+		log.Println("httpd: serving https")
+		err = srv.ListenAndServeTLS("", "")
+	} else {
+		err = srv.ListenAndServe()
+	}
+
+	if err == http.ErrServerClosed {
+		return nil
+	}
+
+	return fmt.Errorf("httpd: %v", err)
 }
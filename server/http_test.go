@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestCompression(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m})
+
+	control := make(chan ControlSnip)
+	defer close(control)
+	status := NewStatus(qe, control)
+
+	hub := NewSocketHub(status)
+	in := make(chan QuerySnip)
+	defer close(in)
+	go hub.Run(in)
+
+	h := NewHttpd(qe, NewCache(0, status, false), nil, nil)
+	srv := httptest.NewServer(h.handler(hub, status, HttpdConfig{}))
+	defer srv.Close()
+
+	for _, path := range []string{"/api/v1/last", "/api/status", "/metrics"} {
+		req, err := http.NewRequest("GET", srv.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Accept-Encoding", "gzip")
+
+		resp, err := http.DefaultTransport.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		if enc := resp.Header.Get("Content-Encoding"); enc != "gzip" {
+			t.Errorf("%s: expected gzip encoding, got %q", path, enc)
+		}
+	}
+
+	dialer := websocket.Dialer{EnableCompression: true}
+	conn, resp, err := dialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/ws", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if ext := resp.Header.Get("Sec-Websocket-Extensions"); !strings.Contains(ext, "permessage-deflate") {
+		t.Errorf("expected permessage-deflate, got %q", ext)
+	}
+}
+
+func TestDebugEndpoints(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m})
+
+	control := make(chan ControlSnip)
+	defer close(control)
+	status := NewStatus(qe, control)
+
+	h := NewHttpd(qe, NewCache(0, status, false), nil, nil)
+
+	get := func(handler http.Handler, path string) int {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		return w.Code
+	}
+
+	if code := get(h.handler(NewSocketHub(status), status, HttpdConfig{}), "/debug/vars"); code != http.StatusNotFound {
+		t.Errorf("expected debug endpoints disabled by default, got %d", code)
+	}
+
+	handler := h.handler(NewSocketHub(status), status, HttpdConfig{Debug: true, BasePath: "/mbmd"})
+	for _, path := range []string{"/mbmd/debug/vars", "/mbmd/debug/pprof/", "/mbmd/debug/pprof/goroutine?debug=1"} {
+		if code := get(handler, path); code != http.StatusOK {
+			t.Errorf("%s: expected %d, got %d", path, http.StatusOK, code)
+		}
+	}
+}
+
+func TestProfileEndpoint(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m})
+
+	control := make(chan ControlSnip)
+	defer close(control)
+	status := NewStatus(qe, control)
+
+	conf := HttpdConfig{Debug: true}
+	h := NewHttpd(qe, NewCache(0, status, false), nil, nil)
+
+	srv := httptest.NewUnstartedServer(h.handler(NewSocketHub(status), status, conf))
+	srv.Config.WriteTimeout = writeTimeout(conf)
+	srv.Start()
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/debug/pprof/profile?seconds=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || len(body) == 0 {
+		t.Errorf("expected profile, got %d: %s", resp.StatusCode, body)
+	}
+
+	// the default duration of 30s must not be rejected for exceeding the write timeout
+	client := http.Client{Timeout: 500 * time.Millisecond}
+	if resp, err := client.Get(srv.URL + "/debug/pprof/profile"); err == nil {
+		resp.Body.Close()
+		t.Errorf("expected profile running until cancelled, got %d", resp.StatusCode)
+	}
+}
+
+func TestHealthEndpoint(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m})
+
+	control := make(chan ControlSnip)
+	defer close(control)
+	status := NewStatus(qe, control)
+
+	sv := NewSupervisor()
+	h := NewHttpd(qe, NewCache(0, status, false), nil, nil)
+	handler := h.handler(NewSocketHub(status), status, HttpdConfig{Supervisor: sv})
+
+	get := func() int {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", "/healthz", nil))
+		return w.Code
+	}
+
+	if code := get(); code != http.StatusOK {
+		t.Errorf("expected %d, got %d", http.StatusOK, code)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	crashed := make(chan struct{})
+	go sv.Run(ctx, "task", 0, func(func()) {
+		close(crashed)
+		panic("boom")
+	})
+	<-crashed
+	time.Sleep(10 * time.Millisecond)
+
+	if code := get(); code != http.StatusServiceUnavailable {
+		t.Errorf("expected %d for crashed task, got %d", http.StatusServiceUnavailable, code)
+	}
+}
@@ -1,19 +1,53 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"log"
+	"strings"
+	"sync"
 	"time"
 
 	influxdb "github.com/influxdata/influxdb-client-go"
 	api "github.com/influxdata/influxdb-client-go/api"
+	"github.com/influxdata/influxdb-client-go/api/write"
+	"github.com/volkszaehler/mbmd/log"
 )
 
-// Influx is an InfluxDB v2 publisher
+const (
+	influxBatchSize     = 500
+	influxFlushInterval = 1 * time.Second
+	influxRetryInterval = 10 * time.Second
+	influxWriteTimeout  = 10 * time.Second
+)
+
+// InfluxPolicy determines how points are handled while the database is unavailable
+type InfluxPolicy string
+
+const (
+	// InfluxQueue queues points up to the queue size, dropping the oldest points if exceeded
+	InfluxQueue InfluxPolicy = "queue"
+	// InfluxDrop drops points while the database is unavailable
+	InfluxDrop InfluxPolicy = "drop"
+)
+
+// Influx is an InfluxDB v2 publisher. Points are written from an in-memory
+// queue so an unavailable database does not block polling.
 type Influx struct {
 	client      influxdb.Client
-	writer      api.WriteAPI
+	writer      api.WriteAPIBlocking
 	measurement string
+
+	policy    InfluxPolicy
+	queueSize int
+	status    *Status
+
+	mux      sync.Mutex
+	queue    []*write.Point
+	trimmed  int // oldest points dropped while a batch is written
+	notify   chan struct{}
+	sink     SinkStatus
+	lastWarn time.Time
 }
 
 // NewInfluxClient creates new publisher for influx
@@ -25,7 +59,14 @@ func NewInfluxClient(
 	token string,
 	user string,
 	password string,
-) *Influx {
+) (*Influx, error) {
+	if database == "" {
+		return nil, errors.New("influx: missing database")
+	}
+	if measurement == "" {
+		return nil, errors.New("influx: missing measurement")
+	}
+
 	// InfluxDB v1 compatibility
 	if token == "" && user != "" {
 		token = fmt.Sprintf("%s:%s", user, password)
@@ -33,43 +74,247 @@ func NewInfluxClient(
 
 	client := influxdb.NewClient(url, token)
 
-	if database == "" {
-		log.Fatal("influx: missing database")
-	}
-	if measurement == "" {
-		log.Fatal("influx: missing measurement")
-	}
-
 	return &Influx{
 		client:      client,
 		measurement: measurement,
-		writer:      client.WriteApi(org, database),
+		writer:      client.WriteAPIBlocking(org, database),
+		policy:      InfluxQueue,
+		queueSize:   10 * influxBatchSize,
+		queue:       make([]*write.Point, 0),
+		notify:      make(chan struct{}, 1),
+		sink:        SinkStatus{Since: time.Now()},
+	}, nil
+}
+
+// Degradation configures handling of points while the database is unavailable
+// and the status used for reporting the database state.
+func (m *Influx) Degradation(policy InfluxPolicy, queueSize int, status *Status) error {
+	if policy != InfluxQueue && policy != InfluxDrop {
+		return fmt.Errorf("invalid policy %s", policy)
+	}
+	if queueSize < 1 {
+		return fmt.Errorf("invalid queue size %d", queueSize)
+	}
+
+	m.policy = policy
+	m.queueSize = queueSize
+	m.status = status
+
+	return nil
+}
+
+// report publishes the sink status. Caller must hold the lock.
+func (m *Influx) report() {
+	m.sink.Queued = len(m.queue)
+	if m.status != nil {
+		m.status.UpdateSink("influx", m.sink)
+	}
+}
+
+// available updates the database state. Caller must hold the lock.
+func (m *Influx) available(err error) {
+	if degraded := err != nil; degraded != m.sink.Degraded {
+		m.sink.Degraded = degraded
+		m.sink.Since = time.Now()
+
+		if degraded {
+			action := "queueing"
+			if m.policy == InfluxDrop {
+				action = "dropping"
+			}
+			log.Errorf("influx: database unavailable, %s readings: %v", action, err)
+		} else {
+			log.Printf("influx: database available again")
+			m.sink.Error = ""
+		}
+	}
+
+	if err != nil {
+		m.sink.Error = err.Error()
+	}
+
+	m.report()
+}
+
+// enqueue adds a point to the write queue without blocking
+func (m *Influx) enqueue(p *write.Point) {
+	m.mux.Lock()
+
+	if m.sink.Degraded && m.policy == InfluxDrop {
+		m.sink.Dropped++
+	} else {
+		if len(m.queue) >= m.queueSize {
+			m.queue = m.queue[1:]
+			m.trimmed++
+			m.sink.Dropped++
+
+			if time.Since(m.lastWarn) > time.Minute {
+				log.Warnf("influx: queue full, dropping oldest points")
+				m.lastWarn = time.Now()
+			}
+		}
+		m.queue = append(m.queue, p)
+	}
+
+	full := len(m.queue) >= influxBatchSize
+	if m.sink.Degraded {
+		m.report()
+	}
+	m.mux.Unlock()
+
+	if full {
+		select {
+		case m.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// flush writes queued points in batches until the queue is empty or writing fails
+func (m *Influx) flush() error {
+	for {
+		m.mux.Lock()
+		n := len(m.queue)
+		if n > influxBatchSize {
+			n = influxBatchSize
+		}
+		batch := m.queue[:n]
+		m.trimmed = 0
+		m.mux.Unlock()
+
+		if n == 0 {
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), influxWriteTimeout)
+		err := m.writer.WritePoint(ctx, batch...)
+		cancel()
+
+		m.mux.Lock()
+		if err == nil || m.policy == InfluxDrop {
+			// batch points dropped from the full queue meanwhile are no longer queued
+			trimmed := m.trimmed
+			if trimmed > n {
+				trimmed = n
+			}
+			n -= trimmed
+			if err != nil {
+				m.sink.Dropped += uint64(n)
+			} else {
+				// dropped points have been written nonetheless
+				m.sink.Dropped -= uint64(trimmed)
+			}
+			m.queue = m.queue[n:]
+		}
+		m.available(err)
+		m.mux.Unlock()
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// writeProc periodically writes queued points until done is closed
+func (m *Influx) writeProc(done <-chan struct{}, stopped chan<- struct{}) {
+	defer close(stopped)
+
+	ticker := time.NewTicker(influxFlushInterval)
+	defer ticker.Stop()
+
+	var retry time.Time
+	for {
+		select {
+		case <-done:
+			if err := m.flush(); err != nil {
+				m.mux.Lock()
+				log.Errorf("influx: discarding %d queued readings on shutdown: %v", len(m.queue), err)
+				m.mux.Unlock()
+			}
+			return
+		case <-ticker.C:
+		case <-m.notify:
+		}
+
+		if time.Now().Before(retry) {
+			continue
+		}
+
+		if err := m.flush(); err != nil {
+			retry = time.Now().Add(influxRetryInterval)
+		}
 	}
 }
 
+// Aggregate writes the closed window's aggregated measurements as points at the window's start to the aggregates measurement
+func (m *Influx) Aggregate(w AggregateWindow) {
+	for measurement, a := range w.Values {
+		tags := map[string]string{
+			"device": w.Device,
+			"type":   measurement.String(),
+			"window": WindowName(w.Window),
+		}
+
+		fields := map[string]interface{}{
+			"min":   a.Min,
+			"max":   a.Max,
+			"mean":  a.Mean,
+			"count": a.Count,
+		}
+
+		m.enqueue(influxdb.NewPoint(m.measurement+"_aggregates", tags, fields, w.Start))
+	}
+}
+
+// Annotate writes an annotation as point at its start time to the annotations measurement
+func (m *Influx) Annotate(a Annotation) {
+	tags := make(map[string]string)
+	if len(a.Devices) > 0 {
+		tags["devices"] = strings.Join(a.Devices, ",")
+	}
+
+	fields := map[string]interface{}{
+		"id":       a.ID,
+		"text":     a.Text,
+		"duration": a.End.Sub(a.Start).Seconds(),
+	}
+
+	m.enqueue(influxdb.NewPoint(m.measurement+"_annotations", tags, fields, a.Start))
+}
+
 // Run Influx publisher
 func (m *Influx) Run(in <-chan QuerySnip) {
-	// log errors
-	go func() {
-		for err := range m.writer.Errors() {
-			log.Printf("influxdb error: %v", err)
-		}
-	}()
+	m.mux.Lock()
+	m.report()
+	m.mux.Unlock()
+
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go m.writeProc(done, stopped)
 
 	for snip := range in {
+		// restored values have already been written
+		if snip.Stale {
+			continue
+		}
+
 		tags := map[string]string{
 			"device": snip.Device,
 			"type":   snip.Measurement.String(),
 		}
+		if snip.Derived {
+			tags["derived"] = "true"
+		}
 
 		fields := map[string]interface{}{
 			"value": snip.Value,
 		}
 
-		// write asynchronously
-		p := influxdb.NewPoint(m.measurement, tags, fields, time.Now())
-		m.writer.WritePoint(p)
+		m.enqueue(influxdb.NewPoint(m.measurement, tags, fields, time.Now()))
 	}
 
+	close(done)
+	<-stopped
+
 	m.client.Close()
 }
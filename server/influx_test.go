@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	influxdb "github.com/influxdata/influxdb-client-go"
+	"github.com/influxdata/influxdb-client-go/api/write"
+)
+
+// blockingWriter records written batches, the first write blocks until released
+type blockingWriter struct {
+	started chan struct{}
+	release chan struct{}
+	batches [][]*write.Point
+}
+
+func (w *blockingWriter) WriteRecord(ctx context.Context, line ...string) error {
+	return nil
+}
+
+func (w *blockingWriter) WritePoint(ctx context.Context, point ...*write.Point) error {
+	if len(w.batches) == 0 {
+		close(w.started)
+		<-w.release
+	}
+	w.batches = append(w.batches, point)
+	return nil
+}
+
+func TestInfluxFlushDropped(t *testing.T) {
+	m, err := NewInfluxClient("http://localhost:8086", "db", "readings", "", "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Degradation(InfluxQueue, 4, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	w := &blockingWriter{started: make(chan struct{}), release: make(chan struct{})}
+	m.writer = w
+
+	points := make([]*write.Point, 6)
+	for i := range points {
+		points[i] = influxdb.NewPoint("readings", nil, map[string]interface{}{"value": i}, time.Now())
+	}
+
+	for _, p := range points[:3] {
+		m.enqueue(p)
+	}
+
+	done := make(chan error)
+	go func() { done <- m.flush() }()
+
+	// the full queue drops the two oldest points of the batch being written
+	<-w.started
+	for _, p := range points[3:] {
+		m.enqueue(p)
+	}
+	close(w.release)
+
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	if len(w.batches) != 2 {
+		t.Fatalf("expected 2 batches, got %d", len(w.batches))
+	}
+
+	// points queued during the write are written with the next batch
+	if batch := w.batches[1]; len(batch) != 3 || batch[0] != points[3] || batch[1] != points[4] || batch[2] != points[5] {
+		t.Errorf("expected points queued during write in second batch, got %d points", len(batch))
+	}
+
+	// the dropped points were part of the written batch
+	if m.sink.Dropped != 0 {
+		t.Errorf("expected no dropped points, got %d", m.sink.Dropped)
+	}
+}
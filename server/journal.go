@@ -0,0 +1,230 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/volkszaehler/mbmd/log"
+)
+
+// event types
+const (
+	EventAvailability = "availability"
+	EventControl      = "control"
+	EventAlert        = "alert"
+)
+
+// Event is a journal entry
+type Event struct {
+	Time   time.Time `json:"time"`
+	Type   string    `json:"type"`
+	Device string    `json:"device,omitempty"`
+	Text   string    `json:"text"`
+}
+
+// Journal holds the most recent events and optionally persists them to a json lines file
+type Journal struct {
+	mux      sync.Mutex
+	file     string
+	size     int
+	appended int // events appended to file since last compaction
+	events   []Event
+}
+
+// NewJournal creates an event journal keeping up to size events. If file is not empty,
+// existing events are loaded from and new events are appended to file. Invalid lines of
+// the file are skipped and removed.
+func NewJournal(file string, size int) (*Journal, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("invalid journal size %d", size)
+	}
+
+	j := &Journal{
+		file:   file,
+		size:   size,
+		events: make([]Event, 0),
+	}
+
+	if file == "" {
+		return j, nil
+	}
+
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return j, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	// undecodable lines, e.g. the last line truncated by a crash, are skipped
+	var invalid int
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		var e Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			log.Warnf("journal: skipping invalid line %d of %s: %v", line, file, err)
+			invalid++
+			continue
+		}
+
+		j.events = append(j.events, e)
+		j.appended++
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	j.truncate()
+
+	// rewrite the file so that new events are not appended to a truncated line
+	if invalid > 0 {
+		f.Close()
+		if err := j.compact(); err != nil {
+			return nil, err
+		}
+	}
+
+	return j, nil
+}
+
+// truncate discards the oldest events exceeding the journal size. Caller must hold the lock.
+func (j *Journal) truncate() {
+	if len(j.events) > j.size {
+		j.events = append([]Event(nil), j.events[len(j.events)-j.size:]...)
+	}
+}
+
+// compact rewrites the journal file with the retained events. Caller must hold the lock.
+func (j *Journal) compact() error {
+	tmp := j.file + ".tmp"
+
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(f)
+	for _, e := range j.events {
+		if err := enc.Encode(e); err != nil {
+			f.Close()
+			return err
+		}
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	j.appended = len(j.events)
+
+	return os.Rename(tmp, j.file)
+}
+
+// save appends a single event to file. The file is compacted once it
+// contains twice the journal size. Caller must hold the lock.
+func (j *Journal) save(e Event) error {
+	if j.file == "" {
+		return nil
+	}
+
+	if j.appended >= 2*j.size {
+		return j.compact()
+	}
+
+	f, err := os.OpenFile(j.file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	err = json.NewEncoder(f).Encode(e)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+
+	j.appended++
+
+	return err
+}
+
+// Add adds an event to the journal. Missing event time is set to now.
+func (j *Journal) Add(e Event) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	j.mux.Lock()
+	defer j.mux.Unlock()
+
+	j.events = append(j.events, e)
+	j.truncate()
+
+	if err := j.save(e); err != nil {
+		log.Errorf("journal: failed to save: %v", err)
+	}
+}
+
+// Query returns events since from of the given type for the selected devices.
+// Zero from and empty type are unbounded.
+func (j *Journal) Query(from time.Time, typ string, selector Selector) []Event {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+
+	res := make([]Event, 0)
+	for _, e := range j.events {
+		if !from.IsZero() && e.Time.Before(from) {
+			continue
+		}
+		if typ != "" && e.Type != typ {
+			continue
+		}
+		if len(selector) > 0 && (e.Device == "" || !selector.Match(e.Device, Labels{})) {
+			continue
+		}
+
+		res = append(res, e)
+	}
+
+	return res
+}
+
+// SinkChanged records alerts when a sink becomes degraded or recovers.
+// It can be registered using Status.SubscribeSinks.
+func (j *Journal) SinkChanged(name string, ss SinkStatus) {
+	text := fmt.Sprintf("%s recovered", name)
+	if ss.Degraded {
+		text = fmt.Sprintf("%s degraded: %s", name, ss.Error)
+	}
+
+	j.Add(Event{Type: EventAlert, Text: text})
+}
+
+// Run records device availability changes from the control channel
+func (j *Journal) Run(in <-chan ControlSnip) {
+	state := make(map[string]string)
+
+	for snip := range in {
+		current := "offline"
+		if snip.Status.Online {
+			current = "online"
+		} else if snip.Status.Quarantined {
+			current = "quarantined"
+		}
+
+		if state[snip.Device] != current {
+			state[snip.Device] = current
+
+			j.Add(Event{
+				Type:   EventAvailability,
+				Device: snip.Device,
+				Text:   "device " + current,
+			})
+		}
+	}
+}
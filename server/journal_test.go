@@ -0,0 +1,104 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJournal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "journal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "events.jsonl")
+	j, err := NewJournal(file, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		j.Add(Event{Time: start.Add(time.Duration(i) * time.Minute), Type: EventControl, Device: "SDM1.1", Text: string(rune('a' + i))})
+	}
+	j.Add(Event{Type: EventAlert, Text: "f"})
+
+	texts := func(events []Event) (res string) {
+		for _, e := range events {
+			res += e.Text
+		}
+		return res
+	}
+
+	// retains the most recent events
+	if s := texts(j.Query(time.Time{}, "", nil)); s != "def" {
+		t.Errorf("expected events def, got %s", s)
+	}
+	if s := texts(j.Query(start.Add(4*time.Minute), EventControl, NewSelector("SDM1.1"))); s != "e" {
+		t.Errorf("expected event e, got %s", s)
+	}
+	if s := texts(j.Query(time.Time{}, "", NewSelector("SDM1.2"))); s != "" {
+		t.Errorf("expected no events, got %s", s)
+	}
+
+	// file is compacted at twice the journal size
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(string(b), "\n"); n > 6 {
+		t.Errorf("expected compacted file, got %d lines", n)
+	}
+
+	// events are restored from file
+	j, err = NewJournal(file, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := texts(j.Query(time.Time{}, "", nil)); s != "def" {
+		t.Errorf("expected restored events def, got %s", s)
+	}
+	if e := j.Query(time.Time{}, "", nil)[0]; !e.Time.Equal(start.Add(3*time.Minute)) || e.Device != "SDM1.1" {
+		t.Errorf("unexpected restored event %+v", e)
+	}
+}
+
+func TestJournalTruncated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "journal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "events.jsonl")
+	data := `{"time":"2020-01-01T00:00:00Z","type":"alert","text":"a"}` + "\n" +
+		`{"time":"2020-01-01T00:01:00Z","type":"alert","te`
+	if err := ioutil.WriteFile(file, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// truncated last line is skipped
+	j, err := NewJournal(file, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if events := j.Query(time.Time{}, "", nil); len(events) != 1 || events[0].Text != "a" {
+		t.Errorf("expected valid event, got %+v", events)
+	}
+
+	// new events are not appended to the truncated line
+	j.Add(Event{Type: EventAlert, Text: "b"})
+
+	j, err = NewJournal(file, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if events := j.Query(time.Time{}, "", nil); len(events) != 2 || events[1].Text != "b" {
+		t.Errorf("expected events a and b, got %+v", events)
+	}
+}
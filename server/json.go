@@ -5,37 +5,145 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+
+	"github.com/volkszaehler/mbmd/meters"
 )
 
-// apiData combines readings with timestamps and uses
+// apiData combines readings with RFC3339 timestamps and uses
 // kvslice to ensure ordered export of the readings map
 type apiData struct {
 	readings *Readings
+	obis     bool           // key readings by OBIS code where available
+	filter   readingsFilter // selects the metadata fields, values are always included
+}
+
+// key returns the measurement's name or OBIS code
+func (d apiData) key(m meters.Measurement) string {
+	if d.obis {
+		if code := m.OBIS(); code != "" {
+			return code
+		}
+	}
+	return m.String()
 }
 
 // MarshalJSON creates device api json for export
 func (d apiData) MarshalJSON() ([]byte, error) {
 	res := kvslice{
-		{"Timestamp", d.readings.Timestamp},
+		{"Timestamp", apiTime(d.readings.Timestamp)},
 		{"Unix", d.readings.Timestamp.Unix()},
 	}
 
+	if d.readings.Stale {
+		res = append(res, kv{"Stale", true})
+	}
+
+	if d.readings.Cycle.Seq > 0 {
+		res = append(res, kv{"Cycle", d.readings.Cycle})
+	}
+
+	if len(d.readings.Derived) > 0 {
+		derived := make([]string, 0, len(d.readings.Derived))
+		for m := range d.readings.Derived {
+			derived = append(derived, d.key(m))
+		}
+		sort.Strings(derived)
+		res = append(res, kv{"Derived", derived})
+	}
+
 	if d.readings.Values == nil {
-		return json.Marshal(res)
+		return json.Marshal(d.selected(res))
+	}
+
+	if len(d.readings.Timestamps) > 0 {
+		timestamps := make(kvslice, 0, len(d.readings.Timestamps))
+		for m, ts := range d.readings.Timestamps {
+			timestamps = append(timestamps, kv{d.key(m), apiTime(ts)})
+		}
+		sort.Slice(timestamps, func(a, b int) bool {
+			return timestamps[a].key < timestamps[b].key
+		})
+		res = append(res, kv{"Timestamps", timestamps})
+	}
+
+	if len(d.readings.Ages) > 0 {
+		ages := make(kvslice, 0, len(d.readings.Ages))
+		for m, age := range d.readings.Ages {
+			ages = append(ages, kv{d.key(m), apiAge(age)})
+		}
+		sort.Slice(ages, func(a, b int) bool {
+			return ages[a].key < ages[b].key
+		})
+		res = append(res, kv{"Ages", ages})
 	}
 
+	if len(d.readings.Outdated) > 0 {
+		outdated := make([]string, 0, len(d.readings.Outdated))
+		for m := range d.readings.Outdated {
+			outdated = append(outdated, d.key(m))
+		}
+		sort.Strings(outdated)
+		res = append(res, kv{"Outdated", outdated})
+	}
+
+	if len(d.readings.Cycles) > 0 {
+		cycles := make(kvslice, 0, len(d.readings.Cycles))
+		for m, seq := range d.readings.Cycles {
+			cycles = append(cycles, kv{d.key(m), seq})
+		}
+		sort.Slice(cycles, func(a, b int) bool {
+			return cycles[a].key < cycles[b].key
+		})
+		res = append(res, kv{"Cycles", cycles})
+	}
+
+	res = d.selected(res)
+
 	values := kvslice{}
+	diagnostics := kvslice{}
 	for m, v := range d.readings.Values {
-		values = append(values, kv{m.String(), v})
+		var value interface{} = v
+		if m.Boolean() {
+			// averaged status values are true if set in any reading
+			value = v != 0
+		}
+
+		values = append(values, kv{d.key(m), value})
+		if m.Diagnostic() {
+			diagnostics = append(diagnostics, kv{d.key(m), value})
+		}
 	}
 
 	sort.Slice(values, func(a, b int) bool {
 		return values[a].key < values[b].key
 	})
 
+	// diagnostics are contained in the values as well for compatibility
+	if len(diagnostics) > 0 && d.filter.field("Diagnostics") {
+		sort.Slice(diagnostics, func(a, b int) bool {
+			return diagnostics[a].key < diagnostics[b].key
+		})
+		res = append(res, kv{"Diagnostics", diagnostics})
+	}
+
 	return json.Marshal(append(res, values...))
 }
 
+// selected returns the metadata fields selected by the filter
+func (d apiData) selected(res kvslice) kvslice {
+	if d.filter.fields == nil {
+		return res
+	}
+
+	sel := make(kvslice, 0, len(res))
+	for _, kv := range res {
+		if d.filter.field(kv.key) {
+			sel = append(sel, kv)
+		}
+	}
+	return sel
+}
+
 type kvslice []kv
 
 func (s kvslice) MarshalJSON() ([]byte, error) {
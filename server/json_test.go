@@ -0,0 +1,40 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestAPIDataOBIS(t *testing.T) {
+	r := &Readings{
+		Timestamp: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		Values: map[meters.Measurement]float64{
+			meters.Import: 1.5,
+			meters.Relay1: 1,
+		},
+	}
+
+	b, err := json.Marshal(apiData{readings: r, obis: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"Timestamp":"2020-01-01T12:00:00.000Z","Unix":1577880000,"Diagnostics":{"Relay1":true},"1-0:1.8.0":1.500000,"Relay1":true}`
+	if string(b) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, b)
+	}
+
+	for key, obis := range map[string]bool{"": false, "name": false, "OBIS": true} {
+		if res, err := obisKeys(httptest.NewRequest("GET", "/api/last?key="+key, nil)); err != nil || res != obis {
+			t.Errorf("%s: expected %v, got %v %v", key, obis, res, err)
+		}
+	}
+
+	if _, err := obisKeys(httptest.NewRequest("GET", "/api/last?key=iec", nil)); err == nil {
+		t.Error("expected error for invalid key")
+	}
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// metric describes a prometheus metric family
+type metric struct {
+	name, typ, help string
+	value           func(ds DeviceStatus) float64
+}
+
+var deviceMetrics = []metric{
+	{"mbmd_device_online", "gauge", "Device online status", func(ds DeviceStatus) float64 { return boolMetric(ds.Online) }},
+	{"mbmd_device_quarantined", "gauge", "Device quarantine status", func(ds DeviceStatus) float64 { return boolMetric(ds.Quarantined) }},
+	{"mbmd_device_requests_total", "counter", "Device queries", func(ds DeviceStatus) float64 { return float64(ds.Requests) }},
+	{"mbmd_device_successes_total", "counter", "Successful device queries", func(ds DeviceStatus) float64 { return float64(ds.Successes) }},
+	{"mbmd_device_errors_total", "counter", "Failed device queries", func(ds DeviceStatus) float64 { return float64(ds.Errors) }},
+	{"mbmd_device_timeouts_total", "counter", "Device queries failed due to timeouts", func(ds DeviceStatus) float64 { return float64(ds.Timeouts) }},
+	{"mbmd_device_crc_errors_total", "counter", "Device queries failed due to checksum errors", func(ds DeviceStatus) float64 { return float64(ds.CRCErrors) }},
+	{"mbmd_device_exceptions_total", "counter", "Device queries failed due to exception responses", func(ds DeviceStatus) float64 { return float64(ds.Exceptions) }},
+	{"mbmd_device_connection_errors_total", "counter", "Device queries failed due to connection errors", func(ds DeviceStatus) float64 { return float64(ds.ConnErrors) }},
+	{"mbmd_device_bus_seconds_total", "counter", "Bus time spent querying the device", func(ds DeviceStatus) float64 { return ds.BusTime }},
+	{"mbmd_device_last_seen_timestamp_seconds", "gauge", "Time of last successful device query", func(ds DeviceStatus) float64 {
+		if ds.LastSeen.IsZero() {
+			return 0
+		}
+		return float64(ds.LastSeen.UnixNano()) / 1e9
+	}},
+}
+
+// adapterMetric describes a prometheus metric family of limited adapters
+type adapterMetric struct {
+	name, typ, help string
+	value           func(as meters.LimiterStatus) float64
+}
+
+var adapterMetrics = []adapterMetric{
+	{"mbmd_adapter_transaction_limit", "gauge", "Maximum adapter transactions per second", func(as meters.LimiterStatus) float64 { return as.Limit }},
+	{"mbmd_adapter_transactions_per_second", "gauge", "Adapter transactions per second during the last 10 seconds", func(as meters.LimiterStatus) float64 { return as.Rate }},
+	{"mbmd_adapter_throttled_seconds_total", "counter", "Time adapter transactions have been delayed by the limit", func(as meters.LimiterStatus) float64 { return as.Throttled }},
+}
+
+func boolMetric(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// escapeLabel escapes a prometheus label value
+func escapeLabel(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
+
+// deviceLabels returns the device's prometheus labels. The name label is only added for named devices.
+func deviceLabels(ds DeviceStatus) string {
+	labels := fmt.Sprintf("device=\"%s\",type=\"%s\"", escapeLabel(ds.Device), escapeLabel(ds.Type))
+	if ds.Name != "" {
+		labels += fmt.Sprintf(",name=\"%s\"", escapeLabel(ds.Name))
+	}
+	return labels
+}
+
+// WriteMetrics writes the daemon and device status in prometheus text exposition format
+func (s *Status) WriteMetrics(w io.Writer) error {
+	snapshot := s.Snapshot()
+	uptime := snapshot.UpTime
+	devices := snapshot.Meters
+	adapters := snapshot.Adapters
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# HELP mbmd_uptime_seconds Daemon uptime\n# TYPE mbmd_uptime_seconds gauge\nmbmd_uptime_seconds %g\n", uptime)
+
+	for _, m := range deviceMetrics {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", m.name, m.help, m.name, m.typ)
+		for _, ds := range devices {
+			fmt.Fprintf(&b, "%s{%s} %g\n", m.name, deviceLabels(ds), m.value(ds))
+		}
+	}
+
+	if len(adapters) > 0 {
+		names := make([]string, 0, len(adapters))
+		for adapter := range adapters {
+			names = append(names, adapter)
+		}
+		sort.Strings(names)
+
+		for _, m := range adapterMetrics {
+			fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", m.name, m.help, m.name, m.typ)
+			for _, adapter := range names {
+				fmt.Fprintf(&b, "%s{adapter=\"%s\"} %g\n", m.name, escapeLabel(adapter), m.value(adapters[adapter]))
+			}
+		}
+	}
+
+	name := "mbmd_device_query_latency_seconds"
+	fmt.Fprintf(&b, "# HELP %s Successful device query latency\n# TYPE %s summary\n", name, name)
+	for _, ds := range devices {
+		labels := deviceLabels(ds)
+		for _, q := range []struct {
+			quantile string
+			ms       float64
+		}{{"0.5", ds.Latency.P50}, {"0.95", ds.Latency.P95}, {"0.99", ds.Latency.P99}} {
+			fmt.Fprintf(&b, "%s{%s,quantile=\"%s\"} %g\n", name, labels, q.quantile, q.ms/1e3)
+		}
+		fmt.Fprintf(&b, "%s_sum{%s} %g\n", name, labels, ds.Latency.Avg*float64(ds.Successes)/1e3)
+		fmt.Fprintf(&b, "%s_count{%s} %d\n", name, labels, ds.Successes)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
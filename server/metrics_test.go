@@ -0,0 +1,129 @@
+package server
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestWriteMetrics(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+	garage := &serialDevice{desc: meters.DeviceDescriptor{Type: "SDM", Manufacturer: "Eastron"}}
+	if err := m.Add(1, garage); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Add(2, &serialDevice{desc: meters.DeviceDescriptor{Type: "ABB", Manufacturer: "ABB"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m})
+	qe.SetLabels(garage, Labels{Name: `garage "north"`})
+
+	control := make(chan ControlSnip)
+	defer close(control)
+	s := NewStatus(qe, control)
+	s.AddLimiter("/dev/ttyUSB0", meters.NewLimiter(meters.NewMock("mock"), 5))
+
+	online := RuntimeInfo{
+		Online:    true,
+		Requests:  12,
+		Successes: 10,
+		Errors:    2,
+		Timeouts:  1,
+		CRCErrors: 1,
+		LastSeen:  time.Unix(1577880000, 500000000),
+		BusTime:   1500 * time.Millisecond,
+		Latency:   LatencyStatus{Avg: 120, P50: 100, P95: 250, P99: 400},
+	}
+	offline := RuntimeInfo{Quarantined: true, Requests: 3, Errors: 3, ConnErrors: 3}
+
+	// the repeated snip makes sure the previous ones have been processed
+	for _, snip := range []ControlSnip{{Device: "SDM1.1", Status: online}, {Device: "ABB1.2", Status: offline}, {Device: "ABB1.2", Status: offline}} {
+		control <- snip
+	}
+
+	var b bytes.Buffer
+	if err := s.WriteMetrics(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	// uptime depends on the test's runtime
+	res := regexp.MustCompile(`(?m)^mbmd_uptime_seconds .*$`).ReplaceAllString(b.String(), "mbmd_uptime_seconds 0")
+
+	if res != metricsGolden {
+		t.Errorf("expected\n%s\ngot\n%s", metricsGolden, res)
+	}
+}
+
+const metricsGolden = `# HELP mbmd_uptime_seconds Daemon uptime
+# TYPE mbmd_uptime_seconds gauge
+mbmd_uptime_seconds 0
+# HELP mbmd_device_online Device online status
+# TYPE mbmd_device_online gauge
+mbmd_device_online{device="ABB1.2",type="ABB"} 0
+mbmd_device_online{device="SDM1.1",type="Eastron",name="garage \"north\""} 1
+# HELP mbmd_device_quarantined Device quarantine status
+# TYPE mbmd_device_quarantined gauge
+mbmd_device_quarantined{device="ABB1.2",type="ABB"} 1
+mbmd_device_quarantined{device="SDM1.1",type="Eastron",name="garage \"north\""} 0
+# HELP mbmd_device_requests_total Device queries
+# TYPE mbmd_device_requests_total counter
+mbmd_device_requests_total{device="ABB1.2",type="ABB"} 3
+mbmd_device_requests_total{device="SDM1.1",type="Eastron",name="garage \"north\""} 12
+# HELP mbmd_device_successes_total Successful device queries
+# TYPE mbmd_device_successes_total counter
+mbmd_device_successes_total{device="ABB1.2",type="ABB"} 0
+mbmd_device_successes_total{device="SDM1.1",type="Eastron",name="garage \"north\""} 10
+# HELP mbmd_device_errors_total Failed device queries
+# TYPE mbmd_device_errors_total counter
+mbmd_device_errors_total{device="ABB1.2",type="ABB"} 3
+mbmd_device_errors_total{device="SDM1.1",type="Eastron",name="garage \"north\""} 2
+# HELP mbmd_device_timeouts_total Device queries failed due to timeouts
+# TYPE mbmd_device_timeouts_total counter
+mbmd_device_timeouts_total{device="ABB1.2",type="ABB"} 0
+mbmd_device_timeouts_total{device="SDM1.1",type="Eastron",name="garage \"north\""} 1
+# HELP mbmd_device_crc_errors_total Device queries failed due to checksum errors
+# TYPE mbmd_device_crc_errors_total counter
+mbmd_device_crc_errors_total{device="ABB1.2",type="ABB"} 0
+mbmd_device_crc_errors_total{device="SDM1.1",type="Eastron",name="garage \"north\""} 1
+# HELP mbmd_device_exceptions_total Device queries failed due to exception responses
+# TYPE mbmd_device_exceptions_total counter
+mbmd_device_exceptions_total{device="ABB1.2",type="ABB"} 0
+mbmd_device_exceptions_total{device="SDM1.1",type="Eastron",name="garage \"north\""} 0
+# HELP mbmd_device_connection_errors_total Device queries failed due to connection errors
+# TYPE mbmd_device_connection_errors_total counter
+mbmd_device_connection_errors_total{device="ABB1.2",type="ABB"} 3
+mbmd_device_connection_errors_total{device="SDM1.1",type="Eastron",name="garage \"north\""} 0
+# HELP mbmd_device_bus_seconds_total Bus time spent querying the device
+# TYPE mbmd_device_bus_seconds_total counter
+mbmd_device_bus_seconds_total{device="ABB1.2",type="ABB"} 0
+mbmd_device_bus_seconds_total{device="SDM1.1",type="Eastron",name="garage \"north\""} 1.5
+# HELP mbmd_device_last_seen_timestamp_seconds Time of last successful device query
+# TYPE mbmd_device_last_seen_timestamp_seconds gauge
+mbmd_device_last_seen_timestamp_seconds{device="ABB1.2",type="ABB"} 0
+mbmd_device_last_seen_timestamp_seconds{device="SDM1.1",type="Eastron",name="garage \"north\""} 1.5778800005e+09
+# HELP mbmd_adapter_transaction_limit Maximum adapter transactions per second
+# TYPE mbmd_adapter_transaction_limit gauge
+mbmd_adapter_transaction_limit{adapter="/dev/ttyUSB0"} 5
+# HELP mbmd_adapter_transactions_per_second Adapter transactions per second during the last 10 seconds
+# TYPE mbmd_adapter_transactions_per_second gauge
+mbmd_adapter_transactions_per_second{adapter="/dev/ttyUSB0"} 0
+# HELP mbmd_adapter_throttled_seconds_total Time adapter transactions have been delayed by the limit
+# TYPE mbmd_adapter_throttled_seconds_total counter
+mbmd_adapter_throttled_seconds_total{adapter="/dev/ttyUSB0"} 0
+# HELP mbmd_device_query_latency_seconds Successful device query latency
+# TYPE mbmd_device_query_latency_seconds summary
+mbmd_device_query_latency_seconds{device="ABB1.2",type="ABB",quantile="0.5"} 0
+mbmd_device_query_latency_seconds{device="ABB1.2",type="ABB",quantile="0.95"} 0
+mbmd_device_query_latency_seconds{device="ABB1.2",type="ABB",quantile="0.99"} 0
+mbmd_device_query_latency_seconds_sum{device="ABB1.2",type="ABB"} 0
+mbmd_device_query_latency_seconds_count{device="ABB1.2",type="ABB"} 0
+mbmd_device_query_latency_seconds{device="SDM1.1",type="Eastron",name="garage \"north\"",quantile="0.5"} 0.1
+mbmd_device_query_latency_seconds{device="SDM1.1",type="Eastron",name="garage \"north\"",quantile="0.95"} 0.25
+mbmd_device_query_latency_seconds{device="SDM1.1",type="Eastron",name="garage \"north\"",quantile="0.99"} 0.4
+mbmd_device_query_latency_seconds_sum{device="SDM1.1",type="Eastron",name="garage \"north\""} 1.2
+mbmd_device_query_latency_seconds_count{device="SDM1.1",type="Eastron",name="garage \"north\""} 10
+`
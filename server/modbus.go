@@ -0,0 +1,343 @@
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/log"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+const (
+	modbusHeaderLength = 7   // MBAP header including unit id
+	modbusMaxLength    = 260 // maximum ADU length
+	modbusMaxQuantity  = 125 // maximum number of registers per read request
+
+	// modbusGatewayTimeout limits waiting for forwarded requests which are queued behind the current device query
+	modbusGatewayTimeout = 10 * time.Second
+)
+
+// modbusEncodings are the supported register data types, values are scaled before encoding
+// and limited to the range of integer types
+var modbusEncodings = map[string]struct {
+	registers int
+	encode    func(b []byte, f float64)
+}{
+	"int16": {1, func(b []byte, f float64) {
+		binary.BigEndian.PutUint16(b, uint16(int16(clamp(f, math.MinInt16, math.MaxInt16))))
+	}},
+	"uint16": {1, func(b []byte, f float64) { binary.BigEndian.PutUint16(b, uint16(clamp(f, 0, math.MaxUint16))) }},
+	"int32": {2, func(b []byte, f float64) {
+		binary.BigEndian.PutUint32(b, uint32(int32(clamp(f, math.MinInt32, math.MaxInt32))))
+	}},
+	"uint32":  {2, func(b []byte, f float64) { binary.BigEndian.PutUint32(b, uint32(clamp(f, 0, math.MaxUint32))) }},
+	"float32": {2, func(b []byte, f float64) { binary.BigEndian.PutUint32(b, math.Float32bits(float32(f))) }},
+	"float64": {4, func(b []byte, f float64) { binary.BigEndian.PutUint64(b, math.Float64bits(f)) }},
+}
+
+// clamp rounds the value and limits it to the given range, NaN is returned as zero
+func clamp(f, min, max float64) float64 {
+	if math.IsNaN(f) {
+		return 0
+	}
+	return math.Max(min, math.Min(max, math.Round(f)))
+}
+
+// ModbusRegister maps a device's measurement to registers of the Modbus server
+type ModbusRegister struct {
+	Unit        uint8  // unit id the register is served under
+	Address     uint16 // address of the first register
+	Device      string // device id or name
+	Measurement meters.Measurement
+	Type        string  // int16, uint16, int32, uint32, float32 (default) or float64
+	Scale       float64 // factor applied before encoding, defaults to 1
+}
+
+// modbusValueKey identifies a device's measurement
+type modbusValueKey struct {
+	device      string
+	measurement meters.Measurement
+}
+
+// modbusWord is a single register of a mapped measurement
+type modbusWord struct {
+	register *ModbusRegister
+	offset   int // offset from the register's first address
+}
+
+// ModbusServer is a Modbus TCP server serving the last readings of devices
+// as holding and input registers. Requests for unmapped units may be forwarded to a gateway adapter.
+type ModbusServer struct {
+	qe      DeviceInfo
+	units   map[uint8]map[uint16]modbusWord
+	mu      sync.Mutex
+	values  map[modbusValueKey]float64
+	bus     BusForwarder
+	adapter string // gateway adapter
+	writes  bool   // forward write requests
+}
+
+// NewModbusServer creates a Modbus TCP server for the given register map
+func NewModbusServer(qe DeviceInfo, registers []ModbusRegister) (*ModbusServer, error) {
+	s := &ModbusServer{
+		qe:     qe,
+		units:  make(map[uint8]map[uint16]modbusWord),
+		values: make(map[modbusValueKey]float64),
+	}
+
+	for i := range registers {
+		r := registers[i]
+		if r.Type == "" {
+			r.Type = "float32"
+		}
+		if r.Scale == 0 {
+			r.Scale = 1
+		}
+
+		enc, ok := modbusEncodings[r.Type]
+		if !ok {
+			return nil, fmt.Errorf("invalid register type %s", r.Type)
+		}
+		if int(r.Address)+enc.registers > math.MaxUint16+1 {
+			return nil, fmt.Errorf("register %d exceeds address range", r.Address)
+		}
+
+		words, ok := s.units[r.Unit]
+		if !ok {
+			words = make(map[uint16]modbusWord)
+			s.units[r.Unit] = words
+		}
+
+		for offset := 0; offset < enc.registers; offset++ {
+			addr := r.Address + uint16(offset)
+			if w, ok := words[addr]; ok {
+				return nil, fmt.Errorf("register %d of unit %d used by %s and %s", addr, r.Unit, w.register.Measurement, r.Measurement)
+			}
+			words[addr] = modbusWord{register: &r, offset: offset}
+		}
+	}
+
+	return s, nil
+}
+
+// Gateway forwards requests for units without mapped registers to the devices of the adapter.
+// Write requests are only forwarded if writes is true. It must be called before serving requests.
+func (s *ModbusServer) Gateway(bus BusForwarder, adapter string, writes bool) {
+	s.bus = bus
+	s.adapter = adapter
+	s.writes = writes
+}
+
+// Run stores the devices' last values
+func (s *ModbusServer) Run(in <-chan QuerySnip) {
+	for snip := range in {
+		if snip.Stale {
+			continue
+		}
+
+		s.mu.Lock()
+		s.values[modbusValueKey{snip.Device, snip.Measurement}] = snip.Value
+		s.mu.Unlock()
+	}
+}
+
+// value returns the register's current value. Registers may refer to devices by id or name.
+func (s *ModbusServer) value(r *ModbusRegister) (float64, bool) {
+	if v, ok := s.values[modbusValueKey{r.Device, r.Measurement}]; ok {
+		return v, true
+	}
+
+	for key, v := range s.values {
+		if key.measurement == r.Measurement && s.qe.DeviceLabelsByID(key.device).Name == r.Device {
+			return v, true
+		}
+	}
+
+	return 0, false
+}
+
+// readRegisters returns the encoded registers or a Modbus exception code. Unmapped registers
+// within the requested range are returned as zero.
+func (s *ModbusServer) readRegisters(unit uint8, start, quantity uint16) ([]byte, byte) {
+	words, ok := s.units[unit]
+	if !ok {
+		return nil, modbus.ExceptionCodeGatewayPathUnavailable
+	}
+
+	if quantity == 0 || quantity > modbusMaxQuantity {
+		return nil, modbus.ExceptionCodeIllegalDataValue
+	}
+	if int(start)+int(quantity) > math.MaxUint16+1 {
+		return nil, modbus.ExceptionCodeIllegalDataAddress
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := make([]byte, 2*int(quantity))
+	encoded := make(map[*ModbusRegister][]byte)
+	mapped := false
+
+	for i := 0; i < int(quantity); i++ {
+		w, ok := words[start+uint16(i)]
+		if !ok {
+			continue
+		}
+		mapped = true
+
+		b, ok := encoded[w.register]
+		if !ok {
+			v, ok := s.value(w.register)
+			if !ok {
+				return nil, modbus.ExceptionCodeGatewayTargetDeviceFailedToRespond
+			}
+
+			enc := modbusEncodings[w.register.Type]
+			b = make([]byte, 2*enc.registers)
+			enc.encode(b, v*w.register.Scale)
+			encoded[w.register] = b
+		}
+
+		copy(res[2*i:], b[2*w.offset:2*w.offset+2])
+	}
+
+	if !mapped {
+		return nil, modbus.ExceptionCodeIllegalDataAddress
+	}
+
+	return res, 0
+}
+
+// handle executes the request PDU and returns the response PDU
+func (s *ModbusServer) handle(ctx context.Context, unit uint8, pdu []byte) []byte {
+	fc := pdu[0]
+
+	if _, ok := s.units[unit]; !ok && s.bus != nil {
+		return s.forward(ctx, unit, pdu)
+	}
+
+	var res []byte
+	var code byte
+
+	switch fc {
+	case modbus.FuncCodeReadHoldingRegisters, modbus.FuncCodeReadInputRegisters:
+		if len(pdu) != 5 {
+			code = modbus.ExceptionCodeIllegalDataValue
+			break
+		}
+
+		res, code = s.readRegisters(unit, binary.BigEndian.Uint16(pdu[1:]), binary.BigEndian.Uint16(pdu[3:]))
+	default:
+		code = modbus.ExceptionCodeIllegalFunction
+	}
+
+	if code != 0 {
+		return []byte{fc | 0x80, code}
+	}
+
+	return append([]byte{fc, byte(len(res))}, res...)
+}
+
+// forward forwards the request PDU to the gateway adapter. Broadcasts are rejected since
+// the bus doesn't respond to them.
+func (s *ModbusServer) forward(ctx context.Context, unit uint8, pdu []byte) []byte {
+	if unit == 0 {
+		log.Debugf("modbus: gateway: broadcast not supported")
+		return []byte{pdu[0] | 0x80, modbus.ExceptionCodeGatewayPathUnavailable}
+	}
+
+	if writeFunctions[pdu[0]] && !s.writes {
+		log.Debugf("modbus: gateway: writes not enabled")
+		return []byte{pdu[0] | 0x80, modbus.ExceptionCodeIllegalFunction}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, modbusGatewayTimeout)
+	defer cancel()
+
+	res, err := s.bus.Forward(ctx, s.adapter, unit, pdu)
+	if err != nil {
+		log.Debugf("modbus: gateway: %v", err)
+		return []byte{pdu[0] | 0x80, modbus.ExceptionCodeGatewayPathUnavailable}
+	}
+
+	return res
+}
+
+// serveConn handles the connection's requests until the connection is closed
+func (s *ModbusServer) serveConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	header := make([]byte, modbusHeaderLength)
+	for {
+		if _, err := io.ReadFull(conn, header); err != nil {
+			if err != io.EOF {
+				log.Debugf("modbus: %s: %v", conn.RemoteAddr(), err)
+			}
+			return
+		}
+
+		// length includes the unit id
+		length := int(binary.BigEndian.Uint16(header[4:]))
+		if binary.BigEndian.Uint16(header[2:]) != 0 || length < 2 || modbusHeaderLength-1+length > modbusMaxLength {
+			log.Debugf("modbus: %s: invalid header % x", conn.RemoteAddr(), header)
+			return
+		}
+
+		pdu := make([]byte, length-1)
+		if _, err := io.ReadFull(conn, pdu); err != nil {
+			log.Debugf("modbus: %s: %v", conn.RemoteAddr(), err)
+			return
+		}
+
+		res := s.handle(ctx, header[6], pdu)
+
+		adu := make([]byte, modbusHeaderLength, modbusHeaderLength+len(res))
+		copy(adu, header[:4])
+		binary.BigEndian.PutUint16(adu[4:], uint16(len(res)+1))
+		adu[6] = header[6]
+
+		if _, err := conn.Write(append(adu, res...)); err != nil {
+			log.Debugf("modbus: %s: %v", conn.RemoteAddr(), err)
+			return
+		}
+	}
+}
+
+// Serve accepts Modbus TCP connections on the listener until the context is cancelled
+func (s *ModbusServer) Serve(ctx context.Context, l net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		go s.serveConn(ctx, conn)
+	}
+}
+
+// ListenAndServe listens on the TCP address and serves Modbus TCP requests until the context is cancelled
+func (s *ModbusServer) ListenAndServe(ctx context.Context, addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("modbus: listening at %s", l.Addr())
+
+	return s.Serve(ctx, l)
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// deviceNames provides device names for tests
+type deviceNames map[string]string
+
+func (d deviceNames) DeviceDescriptorByID(id string) meters.DeviceDescriptor {
+	return meters.DeviceDescriptor{}
+}
+
+func (d deviceNames) DeviceLabelsByID(id string) Labels {
+	return Labels{Name: d[id]}
+}
+
+func TestModbusServer(t *testing.T) {
+	s, err := NewModbusServer(deviceNames{"SDM1.1": "garage"}, []ModbusRegister{
+		{Unit: 1, Address: 0, Device: "SDM1.1", Measurement: meters.Power},
+		{Unit: 1, Address: 2, Device: "garage", Measurement: meters.Import, Type: "uint32", Scale: 1000},
+		{Unit: 1, Address: 10, Device: "SDM1.1", Measurement: meters.Frequency},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in := make(chan QuerySnip)
+	done := make(chan struct{})
+	go func() {
+		s.Run(in)
+		close(done)
+	}()
+	for _, r := range []meters.MeasurementResult{
+		{Measurement: meters.Power, Value: 1500.5},
+		{Measurement: meters.Import, Value: 123.456},
+	} {
+		in <- QuerySnip{Device: "SDM1.1", MeasurementResult: r}
+	}
+	close(in)
+	<-done
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = s.Serve(ctx, l) }()
+
+	handler := modbus.NewTCPClientHandler(l.Addr().String())
+	handler.Timeout = time.Second
+	handler.SlaveID = 1
+	defer handler.Close()
+
+	client := modbus.NewClient(handler)
+
+	b, err := client.ReadHoldingRegisters(0, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if f := math.Float32frombits(binary.BigEndian.Uint32(b)); f != 1500.5 {
+		t.Errorf("expected power 1500.5, got %v", f)
+	}
+	if u := binary.BigEndian.Uint32(b[4:]); u != 123456 {
+		t.Errorf("expected import 123456, got %v", u)
+	}
+	if u := binary.BigEndian.Uint16(b[8:]); u != 0 {
+		t.Errorf("expected unmapped register 0, got %v", u)
+	}
+
+	// no value yet
+	if _, err := client.ReadInputRegisters(10, 2); !isException(err, modbus.ExceptionCodeGatewayTargetDeviceFailedToRespond) {
+		t.Errorf("expected target device failure, got %v", err)
+	}
+
+	// unmapped
+	if _, err := client.ReadInputRegisters(20, 2); !isException(err, modbus.ExceptionCodeIllegalDataAddress) {
+		t.Errorf("expected illegal data address, got %v", err)
+	}
+
+	// unknown unit
+	handler.SlaveID = 2
+	if _, err := client.ReadInputRegisters(0, 2); !isException(err, modbus.ExceptionCodeGatewayPathUnavailable) {
+		t.Errorf("expected gateway path unavailable, got %v", err)
+	}
+}
+
+func TestModbusServerOverlap(t *testing.T) {
+	if _, err := NewModbusServer(deviceNames{}, []ModbusRegister{
+		{Unit: 1, Address: 0, Device: "SDM1.1", Measurement: meters.Power},
+		{Unit: 1, Address: 1, Device: "SDM1.1", Measurement: meters.Import},
+	}); err == nil {
+		t.Error("expected error for overlapping registers")
+	}
+}
+
+func isException(err error, code byte) bool {
+	me, ok := err.(*modbus.Error)
+	return ok && me.ExceptionCode == code
+}
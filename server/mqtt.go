@@ -1,18 +1,27 @@
 package server
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
+	"io/ioutil"
+	"math"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	MQTT "github.com/eclipse/paho.mqtt.golang"
+	"github.com/volkszaehler/mbmd/log"
 	"github.com/volkszaehler/mbmd/meters"
 )
 
 const (
-	publishTimeout = 2000 * time.Millisecond
+	publishTimeout    = 2000 * time.Millisecond
+	reconnectInterval = 10 * time.Second
 )
 
 var (
@@ -26,12 +35,56 @@ type MqttClient struct {
 	verbose bool
 }
 
+// MqttTLSConfig describes the certificates for connecting to a broker via ssl:// or tls://
+type MqttTLSConfig struct {
+	CAFile   string // CA for verifying the broker certificate, system roots if empty
+	CertFile string // client certificate (optional)
+	KeyFile  string // client certificate key (optional)
+	Insecure bool   // skip verifying the broker certificate
+}
+
+// Config creates the client TLS configuration. It returns nil if no
+// TLS options are configured.
+func (c MqttTLSConfig) Config() (*tls.Config, error) {
+	if c == (MqttTLSConfig{}) {
+		return nil, nil
+	}
+
+	config := &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	if c.CAFile != "" {
+		b, err := ioutil.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(b) {
+			return nil, errors.New("no certificates found in " + c.CAFile)
+		}
+	}
+
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
 // NewMqttOptions creates MQTT client options
 func NewMqttOptions(
 	broker string,
 	user string,
 	password string,
 	clientID string,
+	tlsConfig *tls.Config,
 ) *MQTT.ClientOptions {
 	opt := MQTT.NewClientOptions()
 	opt.AddBroker(broker)
@@ -39,6 +92,9 @@ func NewMqttOptions(
 	opt.SetPassword(password)
 	opt.SetClientID(clientID)
 	opt.SetAutoReconnect(true)
+	if tlsConfig != nil {
+		opt.SetTLSConfig(tlsConfig)
+	}
 	return opt
 }
 
@@ -52,10 +108,10 @@ func NewMqttClient(
 
 	client := MQTT.NewClient(options)
 	if token := client.Connect(); token.Wait() && token.Error() != nil {
-		log.Fatalf("mqtt: error connecting: %s", token.Error())
-	}
-	if verbose {
-		log.Println("mqtt: connected")
+		log.Errorf("mqtt: error connecting: %s", token.Error())
+		go reconnect(client)
+	} else if verbose {
+		log.Debugf("mqtt: connected")
 	}
 
 	return &MqttClient{
@@ -65,11 +121,26 @@ func NewMqttClient(
 	}
 }
 
+// reconnect retries connecting until successful. Afterwards, the client reconnects automatically.
+func reconnect(client MQTT.Client) {
+	for {
+		time.Sleep(reconnectInterval)
+
+		if token := client.Connect(); token.Wait() && token.Error() != nil {
+			log.Debugf("mqtt: error connecting: %s", token.Error())
+			continue
+		}
+
+		log.Printf("mqtt: connected")
+		return
+	}
+}
+
 // Publish MQTT message with error handling
 func (m *MqttClient) Publish(topic string, retained bool, message interface{}) {
 	token := m.Client.Publish(topic, m.qos, retained, message)
 	if m.verbose {
-		log.Printf("mqtt: publish %s, message: %s", topic, message)
+		log.Debugf("mqtt: publish %s, message: %s", topic, message)
 	}
 	go m.WaitForToken(token)
 }
@@ -78,10 +149,18 @@ func (m *MqttClient) Publish(topic string, retained bool, message interface{}) {
 func (m *MqttClient) WaitForToken(token MQTT.Token) {
 	if token.WaitTimeout(publishTimeout) {
 		if token.Error() != nil {
-			log.Printf("mqtt: error: %s", token.Error())
+			log.Errorf("mqtt: error: %s", token.Error())
 		}
 	} else if m.verbose {
-		log.Println("mqtt: timeout")
+		log.Debugf("mqtt: timeout")
+	}
+}
+
+// Disconnect waits for pending work to complete and disconnects the client
+func (m *MqttClient) Disconnect() {
+	m.Client.Disconnect(uint(publishTimeout / time.Millisecond))
+	if m.verbose {
+		log.Debugf("mqtt: disconnected")
 	}
 }
 
@@ -91,10 +170,33 @@ func mqttDeviceTopic(deviceID string) string {
 	return strings.Replace(topic, ".", "-", -1)
 }
 
+// mqttNameRE matches characters not used in device name topics
+var mqttNameRE = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// mqttNameTopic converts a device name to topic string
+func mqttNameTopic(name string) string {
+	return strings.Trim(mqttNameRE.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// mqttTopic returns the device's topic. If names is not nil, devices with configured name
+// are published using their name.
+func mqttTopic(deviceID string, names DeviceInfo) string {
+	if names != nil {
+		if topic := mqttNameTopic(names.DeviceLabelsByID(deviceID).Name); topic != "" {
+			return topic
+		}
+	}
+	return mqttDeviceTopic(deviceID)
+}
+
 // MqttRunner allows to attach an MqttClient as broadcast receiver
 type MqttRunner struct {
 	*MqttClient
 	topic string
+	names DeviceInfo    // publish devices by name if not nil
+	units UnitConverter // units of converted values
+	json  bool          // publish values as json including unit and description
+	obis  bool          // publish readings by OBIS code where available
 }
 
 // NewMqttRunner create a new runer for plain MQTT
@@ -111,6 +213,46 @@ func NewMqttRunner(options *MQTT.ClientOptions, qos byte, topic string, verbose
 	}
 }
 
+// Names publishes devices with configured name at <topic>/<name> instead of <topic>/<device>
+func (m *MqttRunner) Names(qe DeviceInfo) {
+	m.names = qe
+}
+
+// Units sets the unit conversions applied to published values such that json messages contain the target units
+func (m *MqttRunner) Units(c UnitConverter) {
+	m.units = c
+}
+
+// JSON publishes values as json including unit, description and timestamp instead of plain numbers
+func (m *MqttRunner) JSON() {
+	m.json = true
+}
+
+// OBIS publishes readings with OBIS code at <topic>/<device>/<obis code> instead of their measurement name
+func (m *MqttRunner) OBIS() {
+	m.obis = true
+}
+
+// measurementKey returns the measurement's OBIS code if enabled and available or its name
+func (m *MqttRunner) measurementKey(measurement meters.Measurement) string {
+	if m.obis {
+		if code := measurement.OBIS(); code != "" {
+			return code
+		}
+	}
+	return measurement.String()
+}
+
+// measurementTopic returns the measurement's OBIS code if enabled and available or its hierarchical topic
+func (m *MqttRunner) measurementTopic(measurement meters.Measurement) string {
+	if m.obis {
+		if code := measurement.OBIS(); code != "" {
+			return code
+		}
+	}
+	return topicFromMeasurement(measurement)
+}
+
 // topicFromMeasurement converts measurements of type MeasureLx/MeasureSx/MeasureTx to hierarchical Measure/Lx topics
 func topicFromMeasurement(measurement meters.Measurement) string {
 	name := measurement.String()
@@ -124,15 +266,184 @@ func topicFromMeasurement(measurement meters.Measurement) string {
 	return topic
 }
 
+// mqttMessage formats the snip's value, boolean measurements are published as true or false
+func mqttMessage(snip QuerySnip) string {
+	if snip.Measurement.Boolean() {
+		return strconv.FormatBool(snip.Value != 0)
+	}
+	return fmt.Sprintf("%.3f", snip.Value)
+}
+
+// mqttValue is a json value message
+type mqttValue struct {
+	Value       interface{} // number, boolean for status measurements or null if not finite
+	Unit        string
+	Description string
+	Timestamp   string
+}
+
+// mqttJSONMessage formats the snip's value as json including unit and description
+func mqttJSONMessage(snip QuerySnip, unit string) string {
+	var value interface{}
+	switch {
+	case snip.Measurement.Boolean():
+		value = snip.Value != 0
+	case !math.IsNaN(snip.Value) && !math.IsInf(snip.Value, 0):
+		value = snip.Value
+	}
+
+	description, _ := snip.Measurement.DescriptionAndUnit()
+	b, _ := json.Marshal(mqttValue{
+		Value:       value,
+		Unit:        unit,
+		Description: description,
+		Timestamp:   apiTime(snip.Timestamp),
+	})
+
+	return string(b)
+}
+
+// Aggregate publishes the closed window's aggregated measurements as json at <topic>/<device>/aggregate/<window>/<measurement>
+func (m *MqttRunner) Aggregate(w AggregateWindow) {
+	for measurement, a := range w.Values {
+		description, _ := measurement.DescriptionAndUnit()
+		message, err := json.Marshal(struct {
+			*Aggregate
+			Unit        string
+			Description string
+		}{
+			Aggregate:   a,
+			Unit:        m.units.Unit(measurement),
+			Description: description,
+		})
+		if err != nil {
+			log.Errorf("mqtt: failed to encode aggregate: %v", err)
+			continue
+		}
+
+		topic := fmt.Sprintf("%s/%s/aggregate/%s/%s", m.topic, mqttTopic(w.Device, m.names), WindowName(w.Window), m.measurementTopic(measurement))
+		m.Publish(topic, false, message)
+	}
+}
+
+// mqttSnapshot are the query results of a device's polling cycle
+type mqttSnapshot struct {
+	cycle        Cycle
+	received     int
+	values       map[string]interface{}
+	units        map[string]string
+	descriptions map[string]string
+}
+
+// MarshalJSON encodes the snapshot with values ordered by measurement
+func (s *mqttSnapshot) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Seq          uint64
+		Timestamp    string
+		Values       map[string]interface{}
+		Units        map[string]string `json:",omitempty"`
+		Descriptions map[string]string `json:",omitempty"`
+	}{
+		Seq:          s.cycle.Seq,
+		Timestamp:    apiTime(s.cycle.Timestamp),
+		Values:       s.values,
+		Units:        s.units,
+		Descriptions: s.descriptions,
+	})
+}
+
+// Snapshots publishes the query results of each polling cycle as a single json message at
+// <topic>/<device>/snapshot once all results of the cycle have been received.
+// Incomplete snapshots are published when the device's next cycle starts.
+func (m *MqttRunner) Snapshots(in <-chan QuerySnip) {
+	snapshots := make(map[string]*mqttSnapshot)
+
+	publish := func(device string, s *mqttSnapshot) {
+		message, err := json.Marshal(s)
+		if err != nil {
+			log.Errorf("mqtt: failed to encode snapshot: %v", err)
+			return
+		}
+		m.Publish(fmt.Sprintf("%s/%s/snapshot", m.topic, mqttTopic(device, m.names)), false, message)
+	}
+
+	for snip := range in {
+		if snip.Cycle.Seq == 0 {
+			continue
+		}
+
+		s, ok := snapshots[snip.Device]
+		if ok && s.cycle.Seq != snip.Cycle.Seq {
+			publish(snip.Device, s)
+			ok = false
+		}
+		if !ok {
+			s = &mqttSnapshot{
+				cycle:        snip.Cycle,
+				values:       make(map[string]interface{}),
+				units:        make(map[string]string),
+				descriptions: make(map[string]string),
+			}
+			snapshots[snip.Device] = s
+		}
+
+		// json can't encode infinite values
+		s.received++
+		if !math.IsInf(snip.Value, 0) {
+			var value interface{} = snip.Value
+			if snip.Measurement.Boolean() {
+				value = snip.Value != 0
+			}
+			key := m.measurementKey(snip.Measurement)
+			s.values[key] = value
+
+			description, _ := snip.Measurement.DescriptionAndUnit()
+			s.descriptions[key] = description
+			if unit := m.units.Unit(snip.Measurement); unit != "" {
+				s.units[key] = unit
+			}
+		}
+
+		if s.received >= s.cycle.Size {
+			publish(snip.Device, s)
+			delete(snapshots, snip.Device)
+		}
+	}
+}
+
 // Run MqttClient publisher
 func (m *MqttRunner) Run(in <-chan QuerySnip) {
 	// notify connection and override will
 	m.MqttClient.Publish(fmt.Sprintf("%s/status", m.topic), true, "connected")
 
+	// devices with restored values, flagged as stale until the device's first query result
+	stale := make(map[string]bool)
+
+	var wg sync.WaitGroup
 	for snip := range in {
-		subtopic := topicFromMeasurement(snip.Measurement)
-		topic := fmt.Sprintf("%s/%s/%s", m.topic, mqttDeviceTopic(snip.Device), subtopic)
-		message := fmt.Sprintf("%.3f", snip.Value)
-		go m.Publish(topic, false, message)
+		if snip.Stale != stale[snip.Device] {
+			stale[snip.Device] = snip.Stale
+			m.Publish(fmt.Sprintf("%s/%s/stale", m.topic, mqttTopic(snip.Device, m.names)), true, strconv.FormatBool(snip.Stale))
+		}
+
+		subtopic := m.measurementTopic(snip.Measurement)
+		topic := fmt.Sprintf("%s/%s/%s", m.topic, mqttTopic(snip.Device, m.names), subtopic)
+		message := mqttMessage(snip)
+		if m.json {
+			message = mqttJSONMessage(snip, m.units.Unit(snip.Measurement))
+		}
+
+		wg.Add(1)
+		go func() {
+			m.Publish(topic, false, message)
+			wg.Done()
+		}()
 	}
+
+	// drain pending messages and replace will on graceful shutdown
+	wg.Wait()
+	token := m.Client.Publish(fmt.Sprintf("%s/status", m.topic), m.qos, true, "disconnected")
+	m.WaitForToken(token)
+
+	m.Disconnect()
 }
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestMqttTLSConfig(t *testing.T) {
+	if c, err := (MqttTLSConfig{}).Config(); c != nil || err != nil {
+		t.Errorf("expected no tls config, got %v %v", c, err)
+	}
+
+	if c, err := (MqttTLSConfig{Insecure: true}).Config(); err != nil || !c.InsecureSkipVerify {
+		t.Errorf("expected insecure tls config, got %v %v", c, err)
+	}
+
+	if _, err := (MqttTLSConfig{CAFile: "does-not-exist.pem"}).Config(); err == nil {
+		t.Error("expected error for missing ca file")
+	}
+
+	if _, err := (MqttTLSConfig{CertFile: "does-not-exist.pem"}).Config(); err == nil {
+		t.Error("expected error for missing client certificate")
+	}
+}
+
+func TestMqttSnapshot(t *testing.T) {
+	s := &mqttSnapshot{
+		cycle:  Cycle{Seq: 3, Timestamp: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)},
+		values: map[string]interface{}{"PowerL2": 2.5, "PowerL1": 0.0001234, "Relay1": true},
+	}
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"Seq":3,"Timestamp":"2020-01-01T12:00:00.000Z","Values":{"PowerL1":0.0001234,"PowerL2":2.5,"Relay1":true}}`
+	if string(b) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, b)
+	}
+}
+
+func TestMqttTopic(t *testing.T) {
+	names := deviceNames{"SDM1.1": "Heat Pump/Garage", "SDM1.2": "#"}
+
+	for _, tc := range []struct {
+		device string
+		names  DeviceInfo
+		topic  string
+	}{
+		{"SDM1.1", nil, "sdm1-1"},
+		{"SDM1.1", names, "heat-pump-garage"},
+		{"SDM1.2", names, "sdm1-2"}, // no valid characters
+		{"SDM1.3", names, "sdm1-3"},
+	} {
+		if topic := mqttTopic(tc.device, tc.names); topic != tc.topic {
+			t.Errorf("%s: expected %s, got %s", tc.device, tc.topic, topic)
+		}
+	}
+}
+
+func TestMqttJSONMessage(t *testing.T) {
+	units, err := NewUnitConverter("W=kW")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snip := QuerySnip{MeasurementResult: meters.MeasurementResult{
+		Measurement: meters.PowerL1,
+		Value:       2.5,
+		Timestamp:   time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+	}}
+
+	expected := `{"Value":2.5,"Unit":"kW","Description":"L1 Power","Timestamp":"2020-01-01T12:00:00.000Z"}`
+	if msg := mqttJSONMessage(snip, units.Unit(snip.Measurement)); msg != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, msg)
+	}
+
+	snip.Measurement, snip.Value = meters.Relay1, 1
+	expected = `{"Value":true,"Unit":"","Description":"Relay 1 State","Timestamp":"2020-01-01T12:00:00.000Z"}`
+	if msg := mqttJSONMessage(snip, units.Unit(snip.Measurement)); msg != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, msg)
+	}
+}
+
+func TestMqttMeasurementTopic(t *testing.T) {
+	m := &MqttRunner{}
+	if topic := m.measurementTopic(meters.PowerL1); topic != "Power/L1" {
+		t.Errorf("expected Power/L1, got %s", topic)
+	}
+
+	m.OBIS()
+	for measurement, topic := range map[meters.Measurement]string{
+		meters.PowerL1: "1-0:36.7.0",
+		meters.Import:  "1-0:1.8.0",
+		meters.Relay1:  "Relay1",
+	} {
+		if res := m.measurementTopic(measurement); res != topic {
+			t.Errorf("%s: expected %s, got %s", measurement, topic, res)
+		}
+	}
+}
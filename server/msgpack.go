@@ -0,0 +1,115 @@
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// jsonToMsgpack converts a json document to MessagePack. Integral numbers are
+// encoded as integers, all other numbers as float64.
+func jsonToMsgpack(b []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := msgpackEncode(&buf, v); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// msgpackLength writes the header of a variable length type using its fix, 8 (optional), 16 and 32 bit variants
+func msgpackLength(buf *bytes.Buffer, n int, fix byte, fixMax int, b8, b16, b32 byte) {
+	switch {
+	case n <= fixMax:
+		buf.WriteByte(fix | byte(n))
+	case b8 != 0 && n <= math.MaxUint8:
+		buf.Write([]byte{b8, byte(n)})
+	case n <= math.MaxUint16:
+		buf.WriteByte(b16)
+		_ = binary.Write(buf, binary.BigEndian, uint16(n))
+	default:
+		buf.WriteByte(b32)
+		_ = binary.Write(buf, binary.BigEndian, uint32(n))
+	}
+}
+
+// msgpackEncode encodes values decoded from json
+func msgpackEncode(buf *bytes.Buffer, v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		buf.WriteByte(0xc0)
+
+	case bool:
+		if v {
+			buf.WriteByte(0xc3)
+		} else {
+			buf.WriteByte(0xc2)
+		}
+
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			switch {
+			case i >= 0 && i <= 0x7f:
+				buf.WriteByte(byte(i))
+			case i < 0 && i >= -32:
+				buf.WriteByte(byte(int8(i)))
+			default:
+				buf.WriteByte(0xd3)
+				_ = binary.Write(buf, binary.BigEndian, i)
+			}
+			return nil
+		}
+
+		f, err := v.Float64()
+		if err != nil {
+			return err
+		}
+		buf.WriteByte(0xcb)
+		_ = binary.Write(buf, binary.BigEndian, math.Float64bits(f))
+
+	case string:
+		msgpackLength(buf, len(v), 0xa0, 31, 0xd9, 0xda, 0xdb)
+		buf.WriteString(v)
+
+	case []interface{}:
+		msgpackLength(buf, len(v), 0x90, 15, 0, 0xdc, 0xdd)
+		for _, e := range v {
+			if err := msgpackEncode(buf, e); err != nil {
+				return err
+			}
+		}
+
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		msgpackLength(buf, len(v), 0x80, 15, 0, 0xde, 0xdf)
+		for _, k := range keys {
+			if err := msgpackEncode(buf, k); err != nil {
+				return err
+			}
+			if err := msgpackEncode(buf, v[k]); err != nil {
+				return err
+			}
+		}
+
+	default:
+		return fmt.Errorf("msgpack: unsupported type %T", v)
+	}
+
+	return nil
+}
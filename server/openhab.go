@@ -0,0 +1,206 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+
+	"github.com/volkszaehler/mbmd/log"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// OpenHABExport describes the MQTT sink the openHAB definitions are generated for
+type OpenHABExport struct {
+	Topic    string
+	Selector Selector
+	Units    UnitConverter
+	Names    bool // devices with configured name are published by name
+}
+
+// openHABDimensions maps base units to openHAB quantity types
+var openHABDimensions = map[string]string{
+	"W":    "Power",
+	"VA":   "Power",
+	"var":  "Power",
+	"Wh":   "Energy",
+	"VAh":  "Energy",
+	"varh": "Energy",
+	"V":    "ElectricPotential",
+	"A":    "ElectricCurrent",
+	"Hz":   "Frequency",
+	"°C":   "Temperature",
+	"K":    "Temperature",
+	"°F":   "Temperature",
+	"s":    "Time",
+	"°":    "Angle",
+	"%":    "Dimensionless",
+}
+
+// openHABInvalidRE matches characters not allowed in openHAB item names
+var openHABInvalidRE = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// openHABBridgeRE matches valid bridge ids
+var openHABBridgeRE = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// openHABThing is a device exported as MQTT generic thing
+type openHABThing struct {
+	id           string // thing id
+	label        string
+	topic        string
+	measurements []meters.Measurement
+}
+
+// openHABItemType returns the item type including quantity type if the unit is known
+func openHABItemType(m meters.Measurement, unit string) string {
+	if m.Boolean() {
+		return "Switch"
+	}
+
+	if _, base, ok := splitUnit(unit); ok {
+		unit = base
+	}
+	if dimension, ok := openHABDimensions[unit]; ok {
+		return "Number:" + dimension
+	}
+
+	return "Number"
+}
+
+// openHABItemName converts the id to a valid item name
+func openHABItemName(parts ...string) string {
+	var name string
+	for i, p := range parts {
+		if i > 0 {
+			name += "_"
+		}
+		name += openHABInvalidRE.ReplaceAllString(p, "_")
+	}
+	return name
+}
+
+// writeOpenHABThings writes MQTT generic things with a channel per measurement
+func writeOpenHABThings(w io.Writer, export *OpenHABExport, bridge string, things []openHABThing) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "// generated by mbmd, requires the MQTT broker thing mqtt:broker:%s\n", bridge)
+	for _, t := range things {
+		fmt.Fprintf(bw, "\nThing mqtt:topic:%s:%s %q (mqtt:broker:%s) {\n", bridge, t.id, t.label, bridge)
+		fmt.Fprintln(bw, "    Channels:")
+
+		for _, m := range t.measurements {
+			description, _ := m.DescriptionAndUnit()
+			stateTopic := fmt.Sprintf("%s/%s/%s", export.Topic, t.topic, topicFromMeasurement(m))
+
+			if m.Boolean() {
+				fmt.Fprintf(bw, "        Type switch : %s %q [ stateTopic=%q, on=\"true\", off=\"false\" ]\n", m, description, stateTopic)
+				continue
+			}
+
+			if unit := export.Units.Unit(m); unit != "" {
+				fmt.Fprintf(bw, "        Type number : %s %q [ stateTopic=%q, unit=%q ]\n", m, description, stateTopic, unit)
+			} else {
+				fmt.Fprintf(bw, "        Type number : %s %q [ stateTopic=%q ]\n", m, description, stateTopic)
+			}
+		}
+
+		fmt.Fprintln(bw, "}")
+	}
+
+	return bw.Flush()
+}
+
+// writeOpenHABItems writes a group per device and items linked to the things' channels
+func writeOpenHABItems(w io.Writer, export *OpenHABExport, bridge string, things []openHABThing) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "// generated by mbmd, requires the things of the MQTT broker thing mqtt:broker:%s\n", bridge)
+	for _, t := range things {
+		group := openHABItemName(bridge, t.id)
+		fmt.Fprintf(bw, "\nGroup %s %q\n", group, t.label)
+
+		for _, m := range t.measurements {
+			description, _ := m.DescriptionAndUnit()
+			typ := openHABItemType(m, export.Units.Unit(m))
+
+			label := description
+			switch {
+			case m.Boolean():
+			case typ == "Number":
+				label += " [%.2f]"
+			default:
+				label += " [%.1f %unit%]"
+			}
+
+			fmt.Fprintf(bw, "%s %s %q (%s) { channel=\"mqtt:topic:%s:%s:%s\" }\n",
+				typ, openHABItemName(group, m.String()), label, group, bridge, t.id, m)
+		}
+	}
+
+	return bw.Flush()
+}
+
+// openHABHandler generates openHAB definitions for the devices published via MQTT.
+// The bridge query parameter sets the id of the MQTT broker thing, defaulting to mbmd.
+func (h *Httpd) openHABHandler(
+	export *OpenHABExport,
+	write func(io.Writer, *OpenHABExport, string, []openHABThing) error,
+) func(http.ResponseWriter, *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bridge := r.URL.Query().Get("bridge")
+		if bridge == "" {
+			bridge = "mbmd"
+		}
+		if !openHABBridgeRE.MatchString(bridge) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid bridge %s", bridge)
+			return
+		}
+
+		var things []openHABThing
+		for _, id := range h.mc.SortedIDs() {
+			labels := h.qe.DeviceLabelsByID(id)
+			if !export.Selector.Match(id, labels) {
+				continue
+			}
+
+			readings, err := h.mc.Current(id)
+			if err != nil {
+				continue // device not available
+			}
+
+			label := labels.Name
+			if label == "" {
+				label = id
+			}
+
+			topic := mqttDeviceTopic(id)
+			if export.Names {
+				topic = mqttTopic(id, h.qe)
+			}
+			t := openHABThing{
+				id:    openHABInvalidRE.ReplaceAllString(topic, "-"),
+				label: label,
+				topic: topic,
+			}
+
+			for m := range readings.Values {
+				t.measurements = append(t.measurements, m)
+			}
+			sort.Slice(t.measurements, func(i, j int) bool {
+				return t.measurements[i].String() < t.measurements[j].String()
+			})
+
+			things = append(things, t)
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=UTF-8")
+		w.WriteHeader(http.StatusOK)
+
+		if err := write(w, export, bridge, things); err != nil {
+			log.Errorf("httpd: failed to write openHAB definitions: %s", err.Error())
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestOpenHAB(t *testing.T) {
+	units, err := NewUnitConverter("W=kW")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	export := &OpenHABExport{Topic: "mbmd", Units: units}
+	things := []openHABThing{{
+		id:           "sdm1-1",
+		label:        "garage",
+		topic:        "sdm1-1",
+		measurements: []meters.Measurement{meters.Cosphi, meters.PowerL1, meters.Relay1},
+	}}
+
+	var b bytes.Buffer
+	if err := writeOpenHABThings(&b, export, "broker", things); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `// generated by mbmd, requires the MQTT broker thing mqtt:broker:broker
+
+Thing mqtt:topic:broker:sdm1-1 "garage" (mqtt:broker:broker) {
+    Channels:
+        Type number : Cosphi "Cosphi" [ stateTopic="mbmd/sdm1-1/Cosphi" ]
+        Type number : PowerL1 "L1 Power" [ stateTopic="mbmd/sdm1-1/Power/L1", unit="kW" ]
+        Type switch : Relay1 "Relay 1 State" [ stateTopic="mbmd/sdm1-1/Relay1", on="true", off="false" ]
+}
+`
+	if b.String() != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, b.String())
+	}
+
+	b.Reset()
+	if err := writeOpenHABItems(&b, export, "broker", things); err != nil {
+		t.Fatal(err)
+	}
+
+	expected = `// generated by mbmd, requires the things of the MQTT broker thing mqtt:broker:broker
+
+Group broker_sdm1_1 "garage"
+Number broker_sdm1_1_Cosphi "Cosphi [%.2f]" (broker_sdm1_1) { channel="mqtt:topic:broker:sdm1-1:Cosphi" }
+Number:Power broker_sdm1_1_PowerL1 "L1 Power [%.1f %unit%]" (broker_sdm1_1) { channel="mqtt:topic:broker:sdm1-1:PowerL1" }
+Switch broker_sdm1_1_Relay1 "Relay 1 State" (broker_sdm1_1) { channel="mqtt:topic:broker:sdm1-1:Relay1" }
+`
+	if b.String() != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, b.String())
+	}
+}
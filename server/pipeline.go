@@ -0,0 +1,83 @@
+package server
+
+import "sync"
+
+// pipelineBuffer is the number of snips a bus can publish before waiting for delivery
+const pipelineBuffer = 256
+
+// pipelineSnip is either a control or a measurement snip queued for delivery
+type pipelineSnip struct {
+	control *ControlSnip
+	result  *QuerySnip
+}
+
+// pipeline is the bus' own path for publishing control and measurement snips. Snips of the
+// bus are buffered and delivered by a separate goroutine so that a bus publishing many results
+// or slow receivers never delay polling of other buses.
+//
+// Control and results share a single ordered buffer. Publishing is unbuffered, so snips sent
+// by the bus' goroutine are queued and delivered in the order they were published; receivers
+// can rely on a device's status snip not overtaking the results it refers to and vice versa.
+type pipeline struct {
+	control chan ControlSnip
+	results chan QuerySnip
+	queue   chan pipelineSnip
+	wg      sync.WaitGroup
+}
+
+// newPipeline creates a pipeline delivering to the shared control and results channels
+func newPipeline(control chan<- ControlSnip, results chan<- QuerySnip) *pipeline {
+	p := &pipeline{
+		control: make(chan ControlSnip),
+		results: make(chan QuerySnip),
+		queue:   make(chan pipelineSnip, pipelineBuffer),
+	}
+
+	p.wg.Add(2)
+	go func() {
+		p.enqueue()
+		p.wg.Done()
+	}()
+	go func() {
+		for snip := range p.queue {
+			if snip.control != nil {
+				control <- *snip.control
+			} else {
+				results <- *snip.result
+			}
+		}
+		p.wg.Done()
+	}()
+
+	return p
+}
+
+// enqueue moves published snips into the ordered buffer until both inputs are closed
+func (p *pipeline) enqueue() {
+	defer close(p.queue)
+
+	control, results := p.control, p.results
+	for control != nil || results != nil {
+		select {
+		case snip, ok := <-control:
+			if !ok {
+				control = nil
+				continue
+			}
+			p.queue <- pipelineSnip{control: &snip}
+		case snip, ok := <-results:
+			if !ok {
+				results = nil
+				continue
+			}
+			p.queue <- pipelineSnip{result: &snip}
+		}
+	}
+}
+
+// close closes the pipeline and waits until buffered snips are delivered
+func (p *pipeline) close() {
+	close(p.control)
+	close(p.results)
+	p.wg.Wait()
+}
@@ -0,0 +1,211 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// countingDevice counts its queries, each query takes the given delay
+type countingDevice struct {
+	serialDevice
+	delay   time.Duration
+	queries int32
+}
+
+func (d *countingDevice) Query(client modbus.Client) ([]meters.MeasurementResult, error) {
+	atomic.AddInt32(&d.queries, 1)
+	time.Sleep(d.delay)
+	return []meters.MeasurementResult{{Measurement: meters.Power, Value: 1}}, nil
+}
+
+func TestPipeline(t *testing.T) {
+	control := make(chan ControlSnip)
+	results := make(chan QuerySnip)
+
+	p := newPipeline(control, results)
+
+	// publishing doesn't wait for the receiver
+	for i := 0; i < 10; i++ {
+		p.results <- QuerySnip{MeasurementResult: meters.MeasurementResult{Value: float64(i)}}
+	}
+
+	done := make(chan []float64)
+	go func() {
+		var res []float64
+		for snip := range results {
+			res = append(res, snip.Value)
+		}
+		done <- res
+	}()
+
+	p.close()
+	close(results)
+
+	res := <-done
+	if len(res) != 10 {
+		t.Fatalf("expected 10 results, got %v", res)
+	}
+	for i, v := range res {
+		if v != float64(i) {
+			t.Errorf("expected result %d, got %v", i, v)
+		}
+	}
+}
+
+func TestPipelineOrder(t *testing.T) {
+	control := make(chan ControlSnip)
+	results := make(chan QuerySnip)
+
+	p := newPipeline(control, results)
+
+	// control and results published alternately keep their order
+	go func() {
+		for i := 0; i < 10; i++ {
+			p.control <- ControlSnip{Device: strconv.Itoa(2 * i)}
+			p.results <- QuerySnip{Device: strconv.Itoa(2*i + 1)}
+		}
+		p.close()
+	}()
+
+	var res []string
+	for len(res) < 20 {
+		select {
+		case snip := <-control:
+			res = append(res, snip.Device)
+		case snip := <-results:
+			res = append(res, snip.Device)
+		}
+	}
+
+	for i, v := range res {
+		if v != strconv.Itoa(i) {
+			t.Fatalf("expected snips in publish order, got %v", res)
+		}
+	}
+}
+
+func TestPipelineBlockedReceiver(t *testing.T) {
+	// receiver of the first bus never reads
+	a := newPipeline(make(chan ControlSnip), make(chan QuerySnip))
+
+	control := make(chan ControlSnip)
+	results := make(chan QuerySnip)
+	b := newPipeline(control, results)
+
+	published := make(chan struct{})
+	go func() {
+		for i := 0; i < pipelineBuffer; i++ {
+			a.results <- QuerySnip{}
+		}
+		close(published)
+	}()
+
+	select {
+	case <-published:
+	case <-time.After(5 * time.Second):
+		t.Fatal("publishing blocked by receiver")
+	}
+
+	// second bus is delivered while the first bus' receiver is blocked
+	go func() { b.results <- QuerySnip{Device: "b"} }()
+
+	select {
+	case snip := <-results:
+		if snip.Device != "b" {
+			t.Errorf("unexpected snip %+v", snip)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("delivery blocked by other bus")
+	}
+
+	go func() {
+		for range control {
+		}
+	}()
+	b.close()
+}
+
+// gatedDevice signals each query and blocks until released
+type gatedDevice struct {
+	serialDevice
+	started chan struct{}
+	release chan struct{}
+}
+
+func (d *gatedDevice) Query(client modbus.Client) ([]meters.MeasurementResult, error) {
+	d.started <- struct{}{}
+	<-d.release
+	return []meters.MeasurementResult{{Measurement: meters.Power, Value: 1}}, nil
+}
+
+func TestParallelBuses(t *testing.T) {
+	fast := &countingDevice{serialDevice: serialDevice{desc: meters.DeviceDescriptor{Type: "FAST"}}}
+	slow := &gatedDevice{
+		serialDevice: serialDevice{desc: meters.DeviceDescriptor{Type: "SLOW"}},
+		started:      make(chan struct{}, 1),
+		release:      make(chan struct{}),
+	}
+
+	managers := make(map[string]*meters.Manager)
+	for conn, dev := range map[string]meters.Device{"fast": fast, "slow": slow} {
+		m := meters.NewManager(meters.NewMock(conn))
+		if err := m.Add(1, dev); err != nil {
+			t.Fatal(err)
+		}
+		managers[conn] = m
+	}
+
+	qe := NewQueryEngine(managers)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	control := make(chan ControlSnip)
+	results := make(chan QuerySnip)
+	go func() {
+		for range control {
+		}
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		qe.Run(ctx, time.Millisecond, control, results)
+		close(done)
+	}()
+
+	// slow bus is blocked inside its query
+	select {
+	case <-slow.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("slow bus not queried")
+	}
+
+	// fast bus keeps delivering results
+	var n int
+	timeout := time.After(5 * time.Second)
+	for n < 5 {
+		select {
+		case snip := <-results:
+			if strings.HasPrefix(snip.Device, "FAST") {
+				n++
+			}
+		case <-timeout:
+			t.Fatalf("fast bus stalled by blocked bus, got %d results", n)
+		}
+	}
+
+	close(slow.release)
+	cancel()
+
+	go func() {
+		for range results {
+		}
+	}()
+	<-done
+}
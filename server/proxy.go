@@ -0,0 +1,45 @@
+package server
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/handlers"
+)
+
+// proxyHandler is a middleware that applies X-Forwarded-* headers set by a trusted reverse proxy.
+// X-Forwarded-For, X-Forwarded-Proto and X-Forwarded-Host are applied to the request.
+// X-Forwarded-Prefix is prepended to absolute redirect locations.
+func proxyHandler(h http.Handler) http.Handler {
+	return handlers.ProxyHeaders(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if prefix := strings.TrimRight(r.Header.Get("X-Forwarded-Prefix"), "/"); prefix != "" {
+			w = &prefixWriter{ResponseWriter: w, prefix: prefix}
+		}
+		h.ServeHTTP(w, r)
+	}))
+}
+
+// prefixWriter rewrites absolute redirect locations
+type prefixWriter struct {
+	http.ResponseWriter
+	prefix string
+}
+
+// WriteHeader implements http.ResponseWriter
+func (w *prefixWriter) WriteHeader(code int) {
+	if loc := w.Header().Get("Location"); strings.HasPrefix(loc, "/") && !strings.HasPrefix(loc, w.prefix+"/") {
+		w.Header().Set("Location", w.prefix+loc)
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Hijack implements http.Hijacker as required by websocket connections
+func (w *prefixWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := w.ResponseWriter.(http.Hijacker); ok {
+		return h.Hijack()
+	}
+	return nil, nil, errors.New("hijacking not supported")
+}
@@ -0,0 +1,221 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/volkszaehler/mbmd/log"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+const (
+	pvoutputURL          = "https://pvoutput.org/service/r2/addstatus.jsp"
+	pvoutputTimeout      = 30 * time.Second
+	pvoutputTickInterval = time.Second
+)
+
+// PVOutputConfig describes the PVOutput.org system and the devices metering generation and consumption
+type PVOutputConfig struct {
+	APIKey      string
+	SystemID    string
+	Generation  string        // device id or name, optional
+	Consumption string        // device id or name, optional
+	Interval    time.Duration // status interval of the system, 5, 10 or 15 minutes
+}
+
+// pvoutputValues are a device's mean power and last lifetime energy within a status interval
+type pvoutputValues struct {
+	power  Aggregate
+	energy float64 // Wh
+}
+
+// params adds the power and energy parameters if available
+func (v *pvoutputValues) params(params url.Values, energy, power string) {
+	if v.energy > 0 {
+		params.Set(energy, strconv.FormatFloat(math.Round(v.energy), 'f', -1, 64))
+	}
+	if v.power.Count > 0 {
+		params.Set(power, strconv.FormatFloat(math.Round(v.power.Mean), 'f', -1, 64))
+	}
+}
+
+// PVOutput uploads generation and consumption status to PVOutput.org
+type PVOutput struct {
+	PVOutputConfig
+	url    string
+	client *http.Client
+	qe     DeviceInfo
+
+	start       time.Time
+	generation  pvoutputValues
+	consumption pvoutputValues
+}
+
+// NewPVOutput creates a PVOutput.org uploader
+func NewPVOutput(conf PVOutputConfig, qe DeviceInfo) (*PVOutput, error) {
+	if conf.APIKey == "" || conf.SystemID == "" {
+		return nil, errors.New("pvoutput: missing api key or system id")
+	}
+	if conf.Generation == "" && conf.Consumption == "" {
+		return nil, errors.New("pvoutput: missing generation or consumption device")
+	}
+
+	switch conf.Interval {
+	case 5 * time.Minute, 10 * time.Minute, 15 * time.Minute:
+	default:
+		return nil, fmt.Errorf("pvoutput: invalid interval %v", conf.Interval)
+	}
+
+	return &PVOutput{
+		PVOutputConfig: conf,
+		url:            pvoutputURL,
+		client:         &http.Client{Timeout: pvoutputTimeout},
+		qe:             qe,
+	}, nil
+}
+
+// matches returns true if the device is referenced by id or name
+func (m *PVOutput) matches(device, id string) bool {
+	return device != "" && (device == id || device == m.qe.DeviceLabelsByID(id).Name)
+}
+
+// add adds a reading of the generation or consumption device. Generated energy is the
+// device's Export, consumed energy its Import counter.
+func (m *PVOutput) add(snip QuerySnip) {
+	var v *pvoutputValues
+	energy := meters.Import
+
+	switch {
+	case m.matches(m.Generation, snip.Device):
+		v = &m.generation
+		energy = meters.Export
+	case m.matches(m.Consumption, snip.Device):
+		v = &m.consumption
+	default:
+		return
+	}
+
+	switch snip.Measurement {
+	case meters.Power:
+		v.power.add(snip.Value)
+	case energy:
+		v.energy = 1e3 * snip.Value
+	}
+}
+
+// status returns the closed interval's status parameters and resets the interval
+func (m *PVOutput) status(end time.Time) url.Values {
+	params := url.Values{}
+	m.generation.params(params, "v1", "v2")
+	m.consumption.params(params, "v3", "v4")
+
+	m.generation.power = Aggregate{}
+	m.consumption.power = Aggregate{}
+
+	if len(params) == 0 {
+		return nil
+	}
+
+	// energy values are lifetime counters
+	if params.Get("v1") != "" || params.Get("v3") != "" {
+		params.Set("c1", "1")
+	}
+
+	// status is reported in the system's local time
+	params.Set("d", end.Format("20060102"))
+	params.Set("t", end.Format("15:04"))
+
+	return params
+}
+
+// upload posts the status
+func (m *PVOutput) upload(params url.Values) error {
+	req, err := http.NewRequest(http.MethodPost, m.url, strings.NewReader(params.Encode()))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-Pvoutput-Apikey", m.APIKey)
+	req.Header.Set("X-Pvoutput-SystemId", m.SystemID)
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// uploadProc uploads statuses until the channel is closed
+func (m *PVOutput) uploadProc(statuses <-chan url.Values, stopped chan<- struct{}) {
+	defer close(stopped)
+	for params := range statuses {
+		if err := m.upload(params); err != nil {
+			log.Errorf("pvoutput: failed to upload status: %v", err)
+		}
+	}
+}
+
+// publish uploads the status once the current interval has ended
+func (m *PVOutput) publish(now time.Time, statuses chan<- url.Values) {
+	start := now.Truncate(m.Interval)
+	if m.start.IsZero() {
+		m.start = start
+	}
+	if !start.After(m.start) {
+		return
+	}
+
+	if params := m.status(start); params != nil {
+		select {
+		case statuses <- params:
+		default:
+			log.Warnf("pvoutput: upload pending, dropping status")
+		}
+	}
+	m.start = start
+}
+
+// Run PVOutput uploader. Power is averaged over the status interval, energy is
+// uploaded as lifetime counters. Restored stale values are ignored.
+func (m *PVOutput) Run(in <-chan QuerySnip) {
+	statuses := make(chan url.Values, 1)
+	stopped := make(chan struct{})
+	go m.uploadProc(statuses, stopped)
+
+	ticker := time.NewTicker(pvoutputTickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case snip, ok := <-in:
+			if !ok {
+				close(statuses)
+				<-stopped
+				return
+			}
+
+			if snip.Stale || math.IsNaN(snip.Value) || math.IsInf(snip.Value, 0) {
+				continue
+			}
+
+			m.publish(time.Now(), statuses)
+			m.add(snip)
+
+		case now := <-ticker.C:
+			m.publish(now, statuses)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestPVOutput(t *testing.T) {
+	var form, apiKey string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		form = r.PostForm.Encode()
+		apiKey = r.Header.Get("X-Pvoutput-Apikey")
+	}))
+	defer srv.Close()
+
+	pv, err := NewPVOutput(PVOutputConfig{
+		APIKey:      "key",
+		SystemID:    "1",
+		Generation:  "inverter",
+		Consumption: "SDM1.1",
+		Interval:    5 * time.Minute,
+	}, deviceNames{"SUNS1.1": "inverter"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pv.url = srv.URL
+
+	for _, snip := range []QuerySnip{
+		{Device: "SUNS1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Power, Value: 1000}},
+		{Device: "SUNS1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Power, Value: 2000}},
+		{Device: "SUNS1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Export, Value: 1234.5678}},
+		{Device: "SDM1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Power, Value: 500.4}},
+		{Device: "SDM1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Export, Value: 10}},
+	} {
+		pv.add(snip)
+	}
+
+	params := pv.status(time.Date(2020, 1, 1, 12, 5, 0, 0, time.Local))
+	if err := pv.upload(params); err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := "c1=1&d=20200101&t=12%3A05&v1=1234568&v2=1500&v4=500"; form != expected {
+		t.Errorf("expected %s, got %s", expected, form)
+	}
+	if apiKey != "key" {
+		t.Errorf("expected api key, got %s", apiKey)
+	}
+
+	// power is reset for the next interval
+	if params := pv.status(time.Date(2020, 1, 1, 12, 10, 0, 0, time.Local)); params.Get("v2") != "" || params.Get("v1") == "" {
+		t.Errorf("unexpected status %v", params)
+	}
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"sync"
 	"time"
@@ -9,15 +10,31 @@ import (
 	"github.com/volkszaehler/mbmd/meters"
 )
 
-// DeviceInfo returns device descriptor by device id
+// DeviceInfo returns device descriptor and labels by device id
 type DeviceInfo interface {
 	DeviceDescriptorByID(id string) meters.DeviceDescriptor
+	DeviceLabelsByID(id string) Labels
+}
+
+// SettingsWriter reads and writes device settings
+type SettingsWriter interface {
+	DeviceSettings(id string) ([]string, error)
+	WriteSetting(ctx context.Context, id string, setting string, value float64) error
 }
 
 // QueryEngine executes queries on connections and attached devices
 type QueryEngine struct {
+	sync.Mutex  // guard handlers, device cache, labels and query options
 	handlers    map[string]*Handler
 	deviceCache map[string]meters.Device
+	labels      map[meters.Device]Labels
+	defaults    QueryOptions
+	options     map[meters.Device]QueryOptions
+	snapshots   *SnapshotCache
+	serialIDs   bool           // identify devices by serial number
+	rate        time.Duration  // polling interval while running
+	start       func(*Handler) // starts handlers added while running
+	supervisor  *Supervisor    // restarts crashed handlers
 }
 
 // NewQueryEngine creates new query engine
@@ -31,6 +48,15 @@ func NewQueryEngine(managers map[string]*meters.Manager) *QueryEngine {
 	}
 	sort.Strings(keys)
 
+	qe := &QueryEngine{
+		handlers:    handlers,
+		deviceCache: make(map[string]meters.Device),
+		labels:      make(map[meters.Device]Labels),
+		options:     make(map[meters.Device]QueryOptions),
+		snapshots:   NewSnapshotCache(),
+		supervisor:  NewSupervisor(),
+	}
+
 	for _, conn := range keys {
 		m := managers[conn]
 		if m.Count() == 0 {
@@ -38,40 +64,377 @@ func NewQueryEngine(managers map[string]*meters.Manager) *QueryEngine {
 			continue
 		}
 
-		handlers[conn] = NewHandler(len(handlers)+1, m)
+		handlers[conn] = qe.newHandler(len(handlers)+1, m)
 	}
 
-	qe := &QueryEngine{
-		handlers:    handlers,
-		deviceCache: make(map[string]meters.Device),
-	}
 	return qe
 }
 
-// DeviceDescriptorByID implements DeviceInfo interface
-func (q *QueryEngine) DeviceDescriptorByID(id string) (res meters.DeviceDescriptor) {
+// newHandler creates a connection handler using the query engine's query options
+func (q *QueryEngine) newHandler(id int, m *meters.Manager) *Handler {
+	h := NewHandler(id, m)
+	h.options = q.queryOptions
+	h.serialIDs = q.serialIDs
+	return h
+}
+
+// SetSerialIDs identifies devices with known serial number by device type and serial number
+// instead of connection and slave id. It must be called before Run.
+func (q *QueryEngine) SetSerialIDs(enabled bool) {
+	q.Lock()
+	defer q.Unlock()
+
+	q.serialIDs = enabled
+	for _, h := range q.handlers {
+		h.serialIDs = enabled
+	}
+}
+
+// SetSupervisor sets the supervisor running the connection handlers. It must be called before Run.
+func (q *QueryEngine) SetSupervisor(s *Supervisor) {
+	q.Lock()
+	defer q.Unlock()
+	q.supervisor = s
+}
+
+// Snapshots returns the consistency group snapshot cache
+func (q *QueryEngine) Snapshots() *SnapshotCache {
+	return q.snapshots
+}
+
+// AddGroup adds a consistency group. All group devices must be attached to the same connection.
+func (q *QueryEngine) AddGroup(group Group) error {
+	if len(group.Devices) == 0 {
+		return fmt.Errorf("group %s has no devices", group.Name)
+	}
+
+	var handler *Handler
+	members := make(map[meters.Device]bool)
+	for _, id := range group.Devices {
+		h := q.handlerByDeviceID(id)
+		if h == nil {
+			return fmt.Errorf("group %s: device %s does not exist", group.Name, id)
+		}
+		if handler != nil && h != handler {
+			return fmt.Errorf("group %s spans multiple connections", group.Name)
+		}
+
+		// devices may be given by address and serial id
+		slaveID, dev, _ := h.find(id)
+		if members[dev] {
+			return fmt.Errorf("group %s: device %s is listed twice", group.Name, id)
+		}
+		if h.grouped(slaveID, dev) {
+			return fmt.Errorf("group %s: device %s is already grouped", group.Name, id)
+		}
+
+		members[dev] = true
+		handler = h
+	}
+
+	handler.groups = append(handler.groups, group)
+	handler.snapshots = q.snapshots
+
+	return nil
+}
+
+// DeviceSettings returns the names of the device's writable settings
+func (q *QueryEngine) DeviceSettings(id string) ([]string, error) {
+	dev := q.deviceByID(id)
+	if dev == nil {
+		return nil, fmt.Errorf("device %s does not exist", id)
+	}
+
+	if cd, ok := dev.(meters.Configurable); ok {
+		return cd.Settings(), nil
+	}
+
+	return []string{}, nil
+}
+
+// WriteSetting writes a device setting. The write is executed by the device's
+// connection handler before its next query cycle.
+func (q *QueryEngine) WriteSetting(ctx context.Context, id string, setting string, value float64) error {
+	h := q.handlerByDeviceID(id)
+	if h == nil {
+		return fmt.Errorf("device %s does not exist", id)
+	}
+
+	req := writeRequest{
+		device:  id,
+		setting: setting,
+		value:   value,
+		result:  make(chan error, 1),
+	}
+
+	select {
+	case h.writes <- req:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-req.result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// AddDevice attaches a device to the connection managed by m. If the query engine
+// is running, the device is queried from the connection's next cycle on.
+func (q *QueryEngine) AddDevice(conn string, m *meters.Manager, id uint8, dev meters.Device) error {
+	q.Lock()
+	defer q.Unlock()
+
+	h, ok := q.handlers[conn]
+	if ok && h.Manager != m {
+		return fmt.Errorf("connection %s already exists", conn)
+	}
+
+	if err := m.Add(id, dev); err != nil {
+		return err
+	}
+
+	if !ok {
+		h = q.newHandler(q.nextHandlerID(), m)
+		q.handlers[conn] = h
+
+		if q.start != nil {
+			q.start(h)
+		}
+	}
+
+	return nil
+}
+
+// nextHandlerID returns an unused handler id. Ids of removed connections are not reused
+// while higher ids exist. Caller must hold the lock.
+func (q *QueryEngine) nextHandlerID() int {
+	id := 0
+	for _, h := range q.handlers {
+		if h.ID > id {
+			id = h.ID
+		}
+	}
+	return id + 1
+}
+
+// removeConnection removes the connection's handler. It returns false if the connection has no
+// handler. The connection of a running handler is closed once the handler has stopped.
+func (q *QueryEngine) removeConnection(conn string) bool {
+	q.Lock()
+	defer q.Unlock()
+
+	h, ok := q.handlers[conn]
+	if !ok {
+		return false
+	}
+
+	delete(q.handlers, conn)
+	h.removed = true
+
+	if h.stop != nil {
+		h.stop()
+	} else {
+		h.Manager.Conn.Close()
+	}
+
+	return true
+}
+
+// DeviceID returns the device id of an attached device or empty string if not found
+func (q *QueryEngine) DeviceID(dev meters.Device) string {
+	for _, h := range q.handlerList() {
+		var res string
+		if h.Manager.Find(func(slaveID uint8, d meters.Device) bool {
+			if d == dev {
+				res = h.deviceID(slaveID, d)
+				return true
+			}
+			return false
+		}) {
+			return res
+		}
+	}
+	return ""
+}
+
+// RemoveDevice removes the device identified by device id. Grouped devices cannot be removed.
+// If the query engine is running, the device is no longer queried from the connection's next cycle on.
+func (q *QueryEngine) RemoveDevice(id string) error {
+	h := q.handlerByDeviceID(id)
+	dev := q.deviceByID(id)
+	if h == nil || dev == nil {
+		return fmt.Errorf("device %s does not exist", id)
+	}
+
+	if slaveID, dev, _ := h.find(id); h.grouped(slaveID, dev) {
+		return fmt.Errorf("device %s is grouped", id)
+	}
+
+	if !h.remove(dev) {
+		return fmt.Errorf("device %s does not exist", id)
+	}
+
+	q.Lock()
+	for cached, d := range q.deviceCache {
+		if d == dev {
+			delete(q.deviceCache, cached)
+		}
+	}
+	delete(q.labels, dev)
+	delete(q.options, dev)
+	q.Unlock()
+
+	return nil
+}
+
+// handlerList returns a copy of the handlers
+func (q *QueryEngine) handlerList() []*Handler {
+	q.Lock()
+	defer q.Unlock()
+
+	res := make([]*Handler, 0, len(q.handlers))
+	for _, h := range q.handlers {
+		res = append(res, h)
+	}
+	return res
+}
+
+// handlerByDeviceID returns the handler the device is attached to
+func (q *QueryEngine) handlerByDeviceID(id string) *Handler {
+	for _, h := range q.handlerList() {
+		if h.Manager.Find(func(slaveID uint8, dev meters.Device) bool {
+			return h.hasID(id, slaveID, dev)
+		}) {
+			return h
+		}
+	}
+	return nil
+}
+
+// SetLabels assigns name and tags to a device
+func (q *QueryEngine) SetLabels(dev meters.Device, labels Labels) {
+	q.Lock()
+	defer q.Unlock()
+	q.labels[dev] = labels
+}
+
+// SetQueryOptions assigns query retries and timeouts to a device
+func (q *QueryEngine) SetQueryOptions(dev meters.Device, opts QueryOptions) {
+	q.Lock()
+	defer q.Unlock()
+	q.options[dev] = opts
+}
+
+// SetDefaultQueryOptions sets query retries and timeouts of devices without own options
+func (q *QueryEngine) SetDefaultQueryOptions(opts QueryOptions) {
+	q.Lock()
+	defer q.Unlock()
+	q.defaults = opts
+}
+
+// queryOptions returns the device's query options merged with the defaults
+func (q *QueryEngine) queryOptions(dev meters.Device) QueryOptions {
+	q.Lock()
+	defer q.Unlock()
+	return q.options[dev].Merge(q.defaults)
+}
+
+// deviceByID returns the device identified by device id or nil if not found
+func (q *QueryEngine) deviceByID(id string) meters.Device {
+	q.Lock()
+	defer q.Unlock()
+
 	// already cached?
 	if dev, ok := q.deviceCache[id]; ok {
-		return dev.Descriptor()
+		return dev
 	}
 
 	for _, h := range q.handlers {
-		h.Manager.Find(func(slaveID uint8, dev meters.Device) (found bool) {
-			devID := h.deviceID(slaveID, dev)
-			if id == devID {
-				q.deviceCache[id] = dev
-				res = dev.Descriptor()
-				found = true
+		var res meters.Device
+		if h.Manager.Find(func(slaveID uint8, dev meters.Device) bool {
+			if h.hasID(id, slaveID, dev) {
+				res = dev
+				return true
 			}
+			return false
+		}) {
+			q.deviceCache[id] = res
+			return res
+		}
+	}
+
+	return nil
+}
+
+// DeviceDescriptorByID implements DeviceInfo interface
+func (q *QueryEngine) DeviceDescriptorByID(id string) (res meters.DeviceDescriptor) {
+	if dev := q.deviceByID(id); dev != nil {
+		res = dev.Descriptor()
+	}
+	return res
+}
+
+// DeviceLabelsByID implements DeviceInfo interface
+func (q *QueryEngine) DeviceLabelsByID(id string) (res Labels) {
+	if dev := q.deviceByID(id); dev != nil {
+		q.Lock()
+		res = q.labels[dev]
+		q.Unlock()
+	}
+	return res
+}
+
+// Devices implements DeviceLister interface
+func (q *QueryEngine) Devices() []DeviceMetadata {
+	q.Lock()
+	handlers := make(map[string]*Handler, len(q.handlers))
+	for conn, h := range q.handlers {
+		handlers[conn] = h
+	}
+	rate := q.rate
+	q.Unlock()
+
+	res := make([]DeviceMetadata, 0)
+	for conn, h := range handlers {
+		h.Manager.All(func(id uint8, dev meters.Device) {
+			desc := dev.Descriptor()
 
-			return
+			q.Lock()
+			labels := q.labels[dev]
+			q.Unlock()
+
+			res = append(res, DeviceMetadata{
+				Device: h.deviceID(id, dev),
+				DeviceSpec: DeviceSpec{
+					Type:      desc.Type,
+					ID:        id,
+					SubDevice: desc.SubDevice,
+					Adapter:   conn,
+					Name:      labels.Name,
+					Tags:      labels.Tags,
+				},
+				Manufacturer: desc.Manufacturer,
+				Model:        desc.Model,
+				Serial:       desc.Serial,
+				Firmware:     desc.Version,
+				Interval:     rate.Seconds(),
+			})
 		})
 	}
 
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Device < res[j].Device
+	})
+
 	return res
 }
 
-// Run executes the query engine to produce measurement results
+// Run executes the query engine to produce measurement results. When the context
+// is cancelled, in-flight queries are completed, connections are closed and the
+// control and results channels are closed to signal shutdown to their receivers.
 func (q *QueryEngine) Run(
 	ctx context.Context,
 	rate time.Duration,
@@ -81,30 +444,83 @@ func (q *QueryEngine) Run(
 	defer close(control)
 	defer close(results)
 
-	// run each connection manager inside separate goroutine
+	// run each connection manager inside separate goroutine, publishing through its own pipeline
 	var wg sync.WaitGroup
-	for _, h := range q.handlers {
+	start := func(h *Handler) {
 		wg.Add(1)
 
-		go func(h *Handler) {
+		hctx, stop := context.WithCancel(ctx)
+		h.stop = stop
+
+		go func(ctx context.Context, h *Handler) {
+			defer wg.Done()
+			defer stop()
+
 			ticker := time.NewTicker(rate)
 			defer ticker.Stop()
 
-			for {
-				// run handlers
-				h.Run(ctx, control, results)
-
-				// wait for rate limit
-				select {
-				case <-ctx.Done():
-					// abort if context is cancelled
-					wg.Done()
-					return
-				case <-ticker.C:
+			pipe := newPipeline(control, results)
+			defer pipe.close()
+
+			// a cycle not completing within 10 cycles or a minute is reported as stalled
+			stall := 10 * rate
+			if stall < time.Minute {
+				stall = time.Minute
+			}
+
+			name := fmt.Sprintf("poll %s", h.Manager.Conn)
+			q.supervisor.Run(ctx, name, stall, func(beat func()) {
+				for {
+					beat()
+
+					// run handlers
+					h.Run(ctx, pipe.control, pipe.results)
+
+					// wait for rate limit, forwarded gateway requests are executed while waiting
+				wait:
+					for {
+						select {
+						case <-ctx.Done():
+							// abort if context is cancelled after delivering pending results
+							return
+						case req := <-h.forwards:
+							h.forward(req)
+						case <-ticker.C:
+							break wait
+						}
+					}
 				}
+			})
+
+			// release the connection of a removed handler
+			q.Lock()
+			removed := h.removed
+			q.Unlock()
+			if removed {
+				h.Manager.Conn.Close()
 			}
-		}(h)
+		}(hctx, h)
+	}
+
+	q.Lock()
+	q.rate = rate
+	q.start = start
+	for _, h := range q.handlers {
+		start(h)
 	}
+	q.Unlock()
+
+	<-ctx.Done()
+
+	// don't start handlers added during shutdown
+	q.Lock()
+	q.start = nil
+	q.Unlock()
 
 	wg.Wait()
+
+	// release connections, e.g. serial ports
+	for _, h := range q.handlerList() {
+		h.Manager.Conn.Close()
+	}
 }
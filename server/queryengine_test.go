@@ -0,0 +1,219 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestQueryEngineAddRemoveRunning(t *testing.T) {
+	newDevice := func(typ string) *countingDevice {
+		return &countingDevice{serialDevice: serialDevice{desc: meters.DeviceDescriptor{Type: typ}}}
+	}
+
+	first := newDevice("FIRST")
+	m := meters.NewManager(meters.NewMock("mock"))
+	if err := m.Add(1, first); err != nil {
+		t.Fatal(err)
+	}
+
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	control := make(chan ControlSnip)
+	results := make(chan QuerySnip)
+	go func() {
+		for range control {
+		}
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		qe.Run(ctx, 10*time.Millisecond, control, results)
+		close(done)
+	}()
+
+	// await waits until the device has published a result
+	await := func(id string) {
+		t.Helper()
+		timeout := time.After(5 * time.Second)
+		for {
+			select {
+			case snip := <-results:
+				if snip.Device == id {
+					return
+				}
+			case <-timeout:
+				t.Fatalf("no result from %s", id)
+			}
+		}
+	}
+
+	await("FIRST1.1")
+
+	// add to the running connection and to a new connection
+	second, other := newDevice("SECOND"), newDevice("OTHER")
+	if err := qe.AddDevice("mock", m, 2, second); err != nil {
+		t.Fatal(err)
+	}
+	if err := qe.AddDevice("other", meters.NewManager(meters.NewMock("other")), 1, other); err != nil {
+		t.Fatal(err)
+	}
+
+	await("SECOND1.2")
+	await("OTHER2.1")
+
+	if err := qe.RemoveDevice("FIRST1.1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// a removed device may complete its current cycle but is not queried again
+	for i := 0; i < 3; i++ {
+		await("SECOND1.2")
+	}
+	queries := atomic.LoadInt32(&first.queries)
+	await("SECOND1.2")
+	await("SECOND1.2")
+
+	if n := atomic.LoadInt32(&first.queries); n != queries {
+		t.Errorf("expected removed device not queried, got %d queries after %d", n, queries)
+	}
+
+	cancel()
+	go func() {
+		for range results {
+		}
+	}()
+	<-done
+}
+
+// configurableDevice is a device with a writable setting and a write-protected setting
+type configurableDevice struct {
+	serialDevice
+	mu     sync.Mutex
+	values map[string]float64
+}
+
+func (d *configurableDevice) Settings() []string { return []string{"address", "reset"} }
+
+func (d *configurableDevice) WriteSetting(client modbus.Client, setting string, value float64) error {
+	switch setting {
+	case "address":
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		d.values[setting] = value
+		return nil
+	case "reset":
+		return fmt.Errorf("setting %s is %w", setting, meters.ErrProtected)
+	default:
+		return fmt.Errorf("setting %s not supported", setting)
+	}
+}
+
+func TestQueryEngineWriteSetting(t *testing.T) {
+	dev := &configurableDevice{serialDevice: serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}, values: make(map[string]float64)}
+	m := meters.NewManager(meters.NewMock("mock"))
+	if err := m.Add(1, dev); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Add(2, &serialDevice{desc: meters.DeviceDescriptor{Type: "ABB"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	control := make(chan ControlSnip)
+	results := make(chan QuerySnip)
+	go func() {
+		for range control {
+		}
+	}()
+	go func() {
+		for range results {
+		}
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		qe.Run(ctx, 10*time.Millisecond, control, results)
+		close(done)
+	}()
+
+	if settings, err := qe.DeviceSettings("SDM1.1"); err != nil || len(settings) != 2 {
+		t.Errorf("unexpected settings %v: %v", settings, err)
+	}
+	if settings, err := qe.DeviceSettings("ABB1.2"); err != nil || len(settings) != 0 {
+		t.Errorf("expected no settings, got %v: %v", settings, err)
+	}
+
+	// writes are executed by the connection handler
+	if err := qe.WriteSetting(ctx, "SDM1.1", "address", 2); err != nil {
+		t.Fatal(err)
+	}
+
+	dev.mu.Lock()
+	if v := dev.values["address"]; v != 2 {
+		t.Errorf("expected address 2, got %v", v)
+	}
+	dev.mu.Unlock()
+
+	if err := qe.WriteSetting(ctx, "SDM1.1", "reset", 0); !errors.Is(err, meters.ErrProtected) {
+		t.Errorf("expected protected error, got %v", err)
+	}
+	if err := qe.WriteSetting(ctx, "ABB1.2", "address", 2); err == nil {
+		t.Error("expected error for device without settings")
+	}
+	if err := qe.WriteSetting(ctx, "SDM1.9", "address", 2); err == nil {
+		t.Error("expected error for missing device")
+	}
+
+	// api
+	status := NewStatus(qe, control)
+	h := NewHttpd(qe, NewCache(0, status, false), nil, nil)
+	handler := h.handler(NewSocketHub(status), status, HttpdConfig{Settings: qe})
+
+	tc := []struct {
+		path, value string
+		code        int
+	}{
+		{"/api/settings/SDM1.1/address", "3", http.StatusOK},
+		{"/api/v1/settings/SDM1.1/address", "4", http.StatusOK},
+		{"/api/settings/SDM1.1/address", "x", http.StatusBadRequest},
+		{"/api/settings/SDM1.1/reset", "0", http.StatusForbidden},
+		{"/api/settings/SDM1.1/unknown", "0", http.StatusBadRequest},
+		{"/api/settings/SDM1.9/address", "2", http.StatusBadRequest},
+	}
+
+	for _, tc := range tc {
+		r := httptest.NewRequest(http.MethodPost, tc.path, strings.NewReader(url.Values{"value": {tc.value}}.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		if w.Code != tc.code {
+			t.Errorf("%s=%s: expected %d, got %d: %s", tc.path, tc.value, tc.code, w.Code, w.Body.String())
+		}
+	}
+
+	dev.mu.Lock()
+	if v := dev.values["address"]; v != 4 {
+		t.Errorf("expected address 4, got %v", v)
+	}
+	dev.mu.Unlock()
+
+	cancel()
+	<-done
+}
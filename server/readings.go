@@ -11,8 +11,15 @@ import (
 // Readings combines readings of all measurements into one data structure
 type Readings struct {
 	sync.Mutex
-	Timestamp time.Time
-	Values    map[meters.Measurement]float64
+	Timestamp  time.Time
+	Values     map[meters.Measurement]float64
+	Timestamps map[meters.Measurement]time.Time     // read time per measurement
+	Cycle      Cycle                                // latest polling cycle
+	Cycles     map[meters.Measurement]uint64        // polling cycle sequence number per measurement
+	Stale      bool                                 // contains restored values only
+	Derived    map[meters.Measurement]bool          // values computed from other measurements
+	Ages       map[meters.Measurement]time.Duration // age per measurement, set by the cache
+	Outdated   map[meters.Measurement]bool          // values exceeding the staleness threshold
 }
 
 func (r *Readings) f2s(key meters.Measurement, digits int) string {
@@ -53,12 +60,36 @@ func (r *Readings) Add(q QuerySnip) {
 	defer r.Unlock()
 
 	r.Timestamp = q.Timestamp
+	r.Stale = q.Stale
 
 	if r.Values == nil {
 		r.Values = make(map[meters.Measurement]float64)
 	}
+	if r.Timestamps == nil {
+		r.Timestamps = make(map[meters.Measurement]time.Time)
+	}
 
 	r.Values[q.Measurement] = q.Value
+	r.Timestamps[q.Measurement] = q.Timestamp
+
+	if q.Cycle.Seq > 0 {
+		if r.Cycles == nil {
+			r.Cycles = make(map[meters.Measurement]uint64)
+		}
+		r.Cycle = q.Cycle
+		r.Cycles[q.Measurement] = q.Cycle.Seq
+	} else if r.Cycles != nil {
+		delete(r.Cycles, q.Measurement)
+	}
+
+	if q.Derived {
+		if r.Derived == nil {
+			r.Derived = make(map[meters.Measurement]bool)
+		}
+		r.Derived[q.Measurement] = true
+	} else if r.Derived != nil {
+		delete(r.Derived, q.Measurement)
+	}
 }
 
 // Clone clones a Readings including its values map
@@ -67,17 +98,65 @@ func (r *Readings) Clone() *Readings {
 	defer r.Unlock()
 
 	res := Readings{
-		Timestamp: r.Timestamp,
-		Values:    make(map[meters.Measurement]float64, len(r.Values)),
+		Timestamp:  r.Timestamp,
+		Values:     make(map[meters.Measurement]float64, len(r.Values)),
+		Timestamps: make(map[meters.Measurement]time.Time, len(r.Timestamps)),
+		Cycle:      r.Cycle,
+		Stale:      r.Stale,
 	}
 
 	for k, v := range r.Values {
 		res.Values[k] = v
 	}
 
+	for k, v := range r.Timestamps {
+		res.Timestamps[k] = v
+	}
+
+	if r.Cycles != nil {
+		res.Cycles = make(map[meters.Measurement]uint64, len(r.Cycles))
+		for k, v := range r.Cycles {
+			res.Cycles[k] = v
+		}
+	}
+
+	if r.Derived != nil {
+		res.Derived = make(map[meters.Measurement]bool, len(r.Derived))
+		for k := range r.Derived {
+			res.Derived[k] = true
+		}
+	}
+
 	return &res
 }
 
+// age sets the age of the values at the given time. Values older than staleAfter are
+// flagged as outdated or removed if omit is set. A zero staleAfter disables the check.
+func (r *Readings) age(now time.Time, staleAfter time.Duration, omit bool) {
+	r.Ages = make(map[meters.Measurement]time.Duration, len(r.Timestamps))
+
+	for m, ts := range r.Timestamps {
+		age := now.Sub(ts)
+
+		if staleAfter > 0 && age > staleAfter {
+			if omit {
+				delete(r.Values, m)
+				delete(r.Timestamps, m)
+				delete(r.Cycles, m)
+				delete(r.Derived, m)
+				continue
+			}
+
+			if r.Outdated == nil {
+				r.Outdated = make(map[meters.Measurement]bool)
+			}
+			r.Outdated[m] = true
+		}
+
+		r.Ages[m] = age
+	}
+}
+
 // MeterReadings holds entire sets of current and recent meter readings for a single device
 type MeterReadings struct {
 	sync.Mutex
@@ -109,7 +188,11 @@ func (mr *MeterReadings) Add(snip QuerySnip) {
 	defer mr.Unlock()
 
 	mr.Current.Add(snip)
-	mr.Historic = append(mr.Historic, mr.Current.Clone())
+
+	// restored values are not averaged
+	if !snip.Stale {
+		mr.Historic = append(mr.Historic, mr.Current.Clone())
+	}
 }
 
 // Average averages historic readings after given timestamp
@@ -143,9 +226,12 @@ func (mr *MeterReadings) Average(timestamp time.Time) *Readings {
 		}
 	}
 
+	current := mr.Current.Clone()
 	res := Readings{
-		Timestamp: mr.Current.Timestamp,
-		Values:    make(map[meters.Measurement]float64, len(mcv)),
+		Timestamp:  current.Timestamp,
+		Values:     make(map[meters.Measurement]float64, len(mcv)),
+		Timestamps: current.Timestamps,
+		Derived:    current.Derived,
 	}
 	for m, cv := range mcv {
 		res.Values[m] = cv.sum / float64(cv.count)
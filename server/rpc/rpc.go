@@ -0,0 +1,4 @@
+// Package rpc contains the gRPC service definitions of the mbmd readings API
+package rpc
+
+//go:generate protoc --go_out=plugins=grpc,paths=source_relative:. mbmd.proto
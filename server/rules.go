@@ -0,0 +1,309 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/volkszaehler/mbmd/log"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+const (
+	ruleActionTimeout = 10 * time.Second
+	ruleQueueSize     = 100 // pending alerts while actions are slow
+)
+
+// rule alert states
+const (
+	RuleTriggered = "triggered"
+	RuleCleared   = "cleared"
+)
+
+// ruleConditionRE matches conditions like CurrentL1 > 16A for 30s
+var ruleConditionRE = regexp.MustCompile(`^\s*(\w+)\s*(>=|<=|==|!=|>|<)\s*([-+]?(?:[0-9]+\.?[0-9]*|\.[0-9]+)(?:[eE][-+]?[0-9]+)?)\s*(\S*?)(?:\s+for\s+(\S+))?\s*$`)
+
+// Rule supervises a measurement's limit. Alerts are sent when the condition has been true
+// for the configured duration and when it is no longer true.
+type Rule struct {
+	Name        string
+	Device      string // device id or name, all devices if empty
+	Measurement meters.Measurement
+	Operator    string
+	Threshold   float64
+	For         time.Duration
+	Topic       string // mqtt topic (optional)
+	Webhook     string // url (optional)
+	Exec        string // command (optional)
+}
+
+// ParseRuleCondition parses a condition like CurrentL1 > 16A for 30s into the rule.
+// The unit is optional and must match the measurement's unit.
+func ParseRuleCondition(condition string, rule *Rule) error {
+	match := ruleConditionRE.FindStringSubmatch(condition)
+	if match == nil {
+		return fmt.Errorf("invalid condition %s", condition)
+	}
+
+	measurement, err := meters.MeasurementString(match[1])
+	if err != nil {
+		return fmt.Errorf("invalid measurement %s", match[1])
+	}
+
+	threshold, err := strconv.ParseFloat(match[3], 64)
+	if err != nil {
+		return fmt.Errorf("invalid threshold %s", match[3])
+	}
+
+	if unit := match[4]; unit != "" {
+		if _, expected := measurement.DescriptionAndUnit(); unit != expected {
+			return fmt.Errorf("invalid unit %s for %s, expected %s", unit, measurement, expected)
+		}
+	}
+
+	var duration time.Duration
+	if match[5] != "" {
+		if duration, err = time.ParseDuration(match[5]); err != nil || duration < 0 {
+			return fmt.Errorf("invalid duration %s", match[5])
+		}
+	}
+
+	rule.Measurement = measurement
+	rule.Operator = match[2]
+	rule.Threshold = threshold
+	rule.For = duration
+
+	return nil
+}
+
+// exceeded returns true if the value violates the rule's limit
+func (r *Rule) exceeded(value float64) bool {
+	switch r.Operator {
+	case ">":
+		return value > r.Threshold
+	case ">=":
+		return value >= r.Threshold
+	case "<":
+		return value < r.Threshold
+	case "<=":
+		return value <= r.Threshold
+	case "==":
+		return value == r.Threshold
+	default:
+		return value != r.Threshold
+	}
+}
+
+// condition formats the rule's condition
+func (r *Rule) condition() string {
+	_, unit := r.Measurement.DescriptionAndUnit()
+	s := fmt.Sprintf("%s %s %g%s", r.Measurement, r.Operator, r.Threshold, unit)
+	if r.For > 0 {
+		s += fmt.Sprintf(" for %v", r.For)
+	}
+	return s
+}
+
+// RuleAlert is the JSON payload of rule alerts
+type RuleAlert struct {
+	Time        time.Time `json:"time"`
+	Rule        string    `json:"rule"`
+	State       string    `json:"state"`
+	Device      string    `json:"device"`
+	Name        string    `json:"name,omitempty"`
+	Measurement string    `json:"measurement"`
+	Value       float64   `json:"value"`
+	Text        string    `json:"text"`
+}
+
+// ruleState is a rule's state for a device
+type ruleState struct {
+	since     time.Time // condition true since
+	triggered bool
+}
+
+// ruleAlert is a pending alert of a rule
+type ruleAlert struct {
+	rule  *Rule
+	alert RuleAlert
+}
+
+// Rules evaluates limit rules against readings and sends alerts to the rules' MQTT topic,
+// webhook and command. Alerts are also recorded in the journal.
+type Rules struct {
+	rules   []Rule
+	qe      DeviceInfo
+	journal *Journal    // optional
+	mqtt    *MqttClient // required for rules with topic
+	client  *http.Client
+}
+
+// NewRules creates the rules supervisor
+func NewRules(rules []Rule, qe DeviceInfo, journal *Journal) (*Rules, error) {
+	for _, r := range rules {
+		if r.Webhook != "" && !validWebhookURL(r.Webhook) {
+			return nil, fmt.Errorf("rules: %s: invalid webhook %s", r.Name, r.Webhook)
+		}
+		if r.Exec != "" && len(strings.Fields(r.Exec)) == 0 {
+			return nil, fmt.Errorf("rules: %s: invalid command", r.Name)
+		}
+	}
+
+	return &Rules{
+		rules:   rules,
+		qe:      qe,
+		journal: journal,
+		client:  &http.Client{Timeout: ruleActionTimeout},
+	}, nil
+}
+
+// MQTT sets the client for publishing alerts of rules with topic
+func (m *Rules) MQTT(client *MqttClient) {
+	m.mqtt = client
+}
+
+// matches returns true if the rule applies to the device
+func (m *Rules) matches(r *Rule, device string) bool {
+	return r.Device == "" || r.Device == device || r.Device == m.qe.DeviceLabelsByID(device).Name
+}
+
+// execute runs the rule's command with the alert as JSON on stdin and as MBMD_* environment variables
+func (m *Rules) execute(command string, alert RuleAlert, body []byte) error {
+	args := strings.Fields(command)
+
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Env = append(os.Environ(),
+		"MBMD_RULE="+alert.Rule,
+		"MBMD_STATE="+alert.State,
+		"MBMD_DEVICE="+alert.Device,
+		"MBMD_MEASUREMENT="+alert.Measurement,
+		"MBMD_VALUE="+strconv.FormatFloat(alert.Value, 'f', -1, 64),
+		"MBMD_TEXT="+alert.Text,
+	)
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	timer := time.AfterFunc(ruleActionTimeout, func() { _ = cmd.Process.Kill() })
+	defer timer.Stop()
+
+	return cmd.Wait()
+}
+
+// alertProc sends alerts until the channel is closed
+func (m *Rules) alertProc(alerts <-chan ruleAlert, stopped chan<- struct{}) {
+	defer close(stopped)
+
+	for a := range alerts {
+		log.Printf("rules: %s", a.alert.Text)
+
+		if m.journal != nil {
+			m.journal.Add(Event{Time: a.alert.Time, Type: EventAlert, Device: a.alert.Device, Text: a.alert.Text})
+		}
+
+		body, err := json.Marshal(a.alert)
+		if err != nil {
+			log.Errorf("rules: %v", err)
+			continue
+		}
+
+		if a.rule.Topic != "" && m.mqtt != nil {
+			m.mqtt.Publish(a.rule.Topic, false, body)
+		}
+
+		if a.rule.Webhook != "" {
+			if err := postJSON(m.client, a.rule.Webhook, body); err != nil {
+				log.Errorf("rules: %s: webhook failed: %v", a.rule.Name, err)
+			}
+		}
+
+		if a.rule.Exec != "" {
+			if err := m.execute(a.rule.Exec, a.alert, body); err != nil {
+				log.Errorf("rules: %s: exec failed: %v", a.rule.Name, err)
+			}
+		}
+	}
+}
+
+// Run rules supervisor. Rules are evaluated per device using the readings' timestamps.
+func (m *Rules) Run(in <-chan QuerySnip) {
+	alerts := make(chan ruleAlert, ruleQueueSize)
+	stopped := make(chan struct{})
+	go m.alertProc(alerts, stopped)
+
+	states := make(map[string]*ruleState) // by rule index and device
+
+	for snip := range in {
+		if snip.Stale || math.IsNaN(snip.Value) {
+			continue
+		}
+
+		for i := range m.rules {
+			r := &m.rules[i]
+			if r.Measurement != snip.Measurement || !m.matches(r, snip.Device) {
+				continue
+			}
+
+			key := fmt.Sprintf("%d/%s", i, snip.Device)
+			state, ok := states[key]
+			if !ok {
+				state = &ruleState{}
+				states[key] = state
+			}
+
+			var alert string
+			if r.exceeded(snip.Value) {
+				if state.since.IsZero() {
+					state.since = snip.Timestamp
+				}
+				if !state.triggered && snip.Timestamp.Sub(state.since) >= r.For {
+					state.triggered = true
+					alert = RuleTriggered
+				}
+			} else {
+				state.since = time.Time{}
+				if state.triggered {
+					state.triggered = false
+					alert = RuleCleared
+				}
+			}
+
+			if alert == "" {
+				continue
+			}
+
+			a := ruleAlert{
+				rule: r,
+				alert: RuleAlert{
+					Time:        snip.Timestamp,
+					Rule:        r.Name,
+					State:       alert,
+					Device:      snip.Device,
+					Name:        m.qe.DeviceLabelsByID(snip.Device).Name,
+					Measurement: snip.Measurement.String(),
+					Value:       snip.Value,
+					Text:        fmt.Sprintf("%s %s: device %s %s at %g", r.Name, alert, snip.Device, r.condition(), snip.Value),
+				},
+			}
+
+			select {
+			case alerts <- a:
+			default:
+				log.Warnf("rules: queue full, dropping alerts")
+			}
+		}
+	}
+
+	close(alerts)
+	<-stopped
+}
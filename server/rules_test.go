@@ -0,0 +1,113 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestParseRuleCondition(t *testing.T) {
+	tc := []struct {
+		condition string
+		rule      Rule
+		err       bool
+	}{
+		{"CurrentL1 > 16A for 30s", Rule{Measurement: meters.CurrentL1, Operator: ">", Threshold: 16, For: 30 * time.Second}, false},
+		{"VoltageL2<=207", Rule{Measurement: meters.VoltageL2, Operator: "<=", Threshold: 207}, false},
+		{"Frequency != 50 Hz", Rule{Measurement: meters.Frequency, Operator: "!=", Threshold: 50}, false},
+		{"Power < -1.5e3W for 1m", Rule{Measurement: meters.Power, Operator: "<", Threshold: -1500, For: time.Minute}, false},
+		{"CurrentL1 > 16V", Rule{}, true},
+		{"Foo > 1", Rule{}, true},
+		{"CurrentL1 16", Rule{}, true},
+		{"CurrentL1 > 16 for 1 minute", Rule{}, true},
+	}
+
+	for _, tc := range tc {
+		var rule Rule
+		err := ParseRuleCondition(tc.condition, &rule)
+		if tc.err {
+			if err == nil {
+				t.Errorf("%s: expected error", tc.condition)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tc.condition, err)
+		} else if rule != tc.rule {
+			t.Errorf("%s: expected %v, got %v", tc.condition, tc.rule, rule)
+		}
+	}
+}
+
+func TestRules(t *testing.T) {
+	var alerts []RuleAlert
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert RuleAlert
+		if err := json.NewDecoder(r.Body).Decode(&alert); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		alerts = append(alerts, alert)
+	}))
+	defer srv.Close()
+
+	rule := Rule{Name: "overload", Device: "garage", Webhook: srv.URL}
+	if err := ParseRuleCondition("CurrentL1 > 16A for 30s", &rule); err != nil {
+		t.Fatal(err)
+	}
+
+	journal, _ := NewJournal("", 10)
+	rules, err := NewRules([]Rule{rule}, deviceNames{"SDM1.1": "garage"}, journal)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts := time.Unix(1577880000, 0)
+	in := make(chan QuerySnip, 6)
+	for _, s := range []struct {
+		device string
+		offset time.Duration
+		value  float64
+	}{
+		{"SDM1.1", 0, 17},
+		{"SDM1.2", 0, 20}, // not matched
+		{"SDM1.1", 20 * time.Second, 18},
+		{"SDM1.1", 30 * time.Second, 17},
+		{"SDM1.1", 40 * time.Second, 19},
+		{"SDM1.1", 50 * time.Second, 10},
+	} {
+		in <- QuerySnip{Device: s.device, MeasurementResult: meters.MeasurementResult{Measurement: meters.CurrentL1, Value: s.value, Timestamp: ts.Add(s.offset)}}
+	}
+	close(in)
+
+	rules.Run(in)
+
+	expected := []RuleAlert{
+		{Rule: "overload", State: RuleTriggered, Device: "SDM1.1", Name: "garage", Measurement: "CurrentL1", Value: 17},
+		{Rule: "overload", State: RuleCleared, Device: "SDM1.1", Name: "garage", Measurement: "CurrentL1", Value: 10},
+	}
+
+	if len(alerts) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, alerts)
+	}
+	for i, e := range expected {
+		a := alerts[i]
+		if a.Rule != e.Rule || a.State != e.State || a.Device != e.Device || a.Name != e.Name || a.Measurement != e.Measurement || a.Value != e.Value {
+			t.Errorf("expected %v, got %v", e, a)
+		}
+	}
+
+	if events := journal.Query(time.Time{}, "", NewSelector("")); len(events) != 2 || events[0].Type != EventAlert {
+		t.Errorf("expected 2 alert events, got %v", events)
+	}
+}
+
+func TestRulesInvalidWebhook(t *testing.T) {
+	if _, err := NewRules([]Rule{{Name: "overload", Webhook: "localhost"}}, nil, nil); err == nil {
+		t.Error("expected error")
+	}
+}
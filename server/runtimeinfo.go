@@ -1,34 +1,212 @@
 package server
 
 import (
+	"errors"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"syscall"
 	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
 )
 
 const (
-	retryTimeout = 1 * time.Second
+	retryTimeout       = 1 * time.Second
+	maxRetryTimeout    = 5 * time.Minute
+	quarantineFailures = 3
+	latencySamples     = 100 // samples used for latency percentiles
 )
 
+// LatencyStatus represents successful device query latency in milliseconds.
+// Percentiles are calculated from the most recent queries.
+type LatencyStatus struct {
+	Last float64
+	Min  float64
+	Max  float64
+	Avg  float64
+	P50  float64
+	P95  float64
+	P99  float64
+}
+
 // RuntimeInfo represents a single modbus device status
 type RuntimeInfo struct {
 	lastFailure time.Time
+	failures    uint      // consecutive failed query cycles
+	samples     []float64 // recent latencies
 	Online      bool
+	Quarantined bool
 	Requests    uint64
+	Successes   uint64
 	Errors      uint64
+	Timeouts    uint64
+	CRCErrors   uint64
+	Exceptions  uint64 // exception responses of the device
+	ConnErrors  uint64 // lost or refused connections
+	LastSeen    time.Time
+	BusTime     time.Duration // total duration of queries including failed queries
+	Latency     LatencyStatus
 }
 
-// Available sets the device online status
+// Observe records a successful query and adds its duration to the latency statistics
+func (r *RuntimeInfo) Observe(d time.Duration) {
+	ms := float64(d) / float64(time.Millisecond)
+
+	r.Successes++
+	r.LastSeen = time.Now()
+
+	if r.Successes == 1 || ms < r.Latency.Min {
+		r.Latency.Min = ms
+	}
+	if ms > r.Latency.Max {
+		r.Latency.Max = ms
+	}
+
+	r.Latency.Last = ms
+	r.Latency.Avg += (ms - r.Latency.Avg) / float64(r.Successes)
+
+	// copy samples as runtime info is passed by value
+	samples := append(make([]float64, 0, latencySamples), r.samples...)
+	if len(samples) == latencySamples {
+		samples = samples[1:]
+	}
+	r.samples = append(samples, ms)
+
+	sorted := append([]float64(nil), r.samples...)
+	sort.Float64s(sorted)
+
+	r.Latency.P50 = percentile(sorted, 0.5)
+	r.Latency.P95 = percentile(sorted, 0.95)
+	r.Latency.P99 = percentile(sorted, 0.99)
+}
+
+// percentile returns the nearest-rank percentile of sorted values or 0 without values
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	i := int(float64(len(sorted))*p+0.5) - 1
+	if i < 0 {
+		i = 0
+	}
+	if i >= len(sorted) {
+		i = len(sorted) - 1
+	}
+	return sorted[i]
+}
+
+// Fail records a failed query and classifies the error
+func (r *RuntimeInfo) Fail(err error) {
+	r.Errors++
+
+	switch errorClass(err) {
+	case "timeout":
+		r.Timeouts++
+	case "crc error":
+		r.CRCErrors++
+	case "exception":
+		r.Exceptions++
+	case "connection error":
+		r.ConnErrors++
+	}
+}
+
+// errorClass classifies query errors as timeout, crc error, exception or connection error.
+// A timeout usually means the device is absent, a crc error indicates a noisy bus.
+func errorClass(err error) string {
+	switch {
+	case isTimeout(err):
+		return "timeout"
+	case isCRCError(err):
+		return "crc error"
+	case isExceptionResponse(err):
+		return "exception"
+	case isConnectionError(err):
+		return "connection error"
+	default:
+		return "error"
+	}
+}
+
+// isTimeout returns true if the error is caused by a timeout
+func isTimeout(err error) bool {
+	var ne net.Error
+	if errors.As(err, &ne) && ne.Timeout() || errors.Is(err, os.ErrDeadlineExceeded) {
+		return true
+	}
+
+	// errors are not always wrapped
+	s := strings.ToLower(err.Error())
+	return strings.Contains(s, "timeout") || strings.Contains(s, "timed out")
+}
+
+// isCRCError returns true if the error is caused by a checksum mismatch
+func isCRCError(err error) bool {
+	s := err.Error()
+	return strings.Contains(s, "response crc") || strings.Contains(s, "response lrc")
+}
+
+// isExceptionResponse returns true if the device answered with a modbus exception response
+func isExceptionResponse(err error) bool {
+	var me *modbus.Error
+	return errors.As(err, &me) || strings.Contains(err.Error(), "modbus: exception")
+}
+
+// isConnectionError returns true if the connection to the device or gateway failed or was lost,
+// including disconnected serial adapters
+func isConnectionError(err error) bool {
+	if errors.Is(err, io.EOF) || errors.Is(err, meters.ErrDisconnected) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var oe *net.OpError
+	if errors.As(err, &oe) {
+		return true
+	}
+
+	s := strings.ToLower(err.Error())
+	return strings.Contains(s, "connection refused") || strings.Contains(s, "connection reset") ||
+		strings.Contains(s, "broken pipe") || strings.Contains(s, "use of closed network connection")
+}
+
+// Available sets the device online status.
+// Devices failing repeatedly are quarantined until they respond again.
 func (r *RuntimeInfo) Available(online bool) {
-	if !online {
+	if online {
+		r.failures = 0
+		r.Quarantined = false
+	} else {
 		r.lastFailure = time.Now()
+		r.failures++
+		r.Quarantined = r.failures >= quarantineFailures
 	}
 	r.Online = online
 }
 
+// RetryTimeout returns the time to wait before querying an offline device.
+// The timeout doubles with every failed query cycle up to maxRetryTimeout.
+func (r *RuntimeInfo) RetryTimeout() time.Duration {
+	timeout := retryTimeout
+	for i := uint(1); i < r.failures && timeout < maxRetryTimeout; i++ {
+		timeout *= 2
+	}
+	if timeout > maxRetryTimeout {
+		timeout = maxRetryTimeout
+	}
+	return timeout
+}
+
 // IsQueryable determines if a device can be queries.
 // This is the case if either the device is online or
-// the device is offline and the retryTimeout has elapsed.
+// the device is offline and the retry timeout has elapsed.
 // Returns queryable status and if the offline timeout has elapsed.
 func (r *RuntimeInfo) IsQueryable() (queryable bool, elapsed bool) {
-	retry := r.lastFailure.Add(retryTimeout).Before(time.Now())
+	retry := r.lastFailure.Add(r.RetryTimeout()).Before(time.Now())
 	return r.Online || retry, !r.Online && retry
 }
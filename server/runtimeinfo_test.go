@@ -0,0 +1,146 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+func TestRuntimeInfoFail(t *testing.T) {
+	tc := []struct {
+		err   error
+		class string
+	}{
+		{&net.OpError{Op: "read", Net: "tcp", Err: timeoutError{}}, "timeout"},
+		{errors.New("serial: timeout"), "timeout"},
+		{errors.New("modbus: response crc '1234' does not match expected '4321'"), "crc error"},
+		{fmt.Errorf("read failed: %w", &modbus.Error{FunctionCode: 0x83, ExceptionCode: modbus.ExceptionCodeIllegalDataAddress}), "exception"},
+		{&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}, "connection error"},
+		{io.EOF, "connection error"},
+		{errors.New("write tcp 127.0.0.1:502: broken pipe"), "connection error"},
+		{errors.New("invalid response length"), "error"},
+	}
+
+	for _, tc := range tc {
+		if class := errorClass(tc.err); class != tc.class {
+			t.Errorf("%v: expected %s, got %s", tc.err, tc.class, class)
+		}
+	}
+
+	var r RuntimeInfo
+	for _, tc := range tc {
+		r.Fail(tc.err)
+	}
+
+	if r.Errors != 8 || r.Timeouts != 2 || r.CRCErrors != 1 || r.Exceptions != 1 || r.ConnErrors != 3 {
+		t.Errorf("unexpected counters %+v", r)
+	}
+}
+
+// timeoutError is a net.Error timing out
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestRuntimeInfoBackoff(t *testing.T) {
+	r := &RuntimeInfo{Online: true}
+
+	tc := []struct {
+		timeout     time.Duration
+		quarantined bool
+	}{
+		{1 * time.Second, false},
+		{2 * time.Second, false},
+		{4 * time.Second, true},
+		{8 * time.Second, true},
+		{16 * time.Second, true},
+		{32 * time.Second, true},
+		{64 * time.Second, true},
+		{128 * time.Second, true},
+		{256 * time.Second, true},
+		{maxRetryTimeout, true},
+		{maxRetryTimeout, true},
+	}
+
+	for i, tc := range tc {
+		r.Available(false)
+
+		if r.Online || r.Quarantined != tc.quarantined {
+			t.Errorf("failure %d: expected offline with quarantine %v, got %+v", i+1, tc.quarantined, r)
+		}
+		if timeout := r.RetryTimeout(); timeout != tc.timeout {
+			t.Errorf("failure %d: expected retry timeout %v, got %v", i+1, tc.timeout, timeout)
+		}
+	}
+
+	// offline devices are retried once the timeout has elapsed
+	if queryable, wakeup := r.IsQueryable(); queryable || wakeup {
+		t.Errorf("expected device not queryable before retry timeout, got %v %v", queryable, wakeup)
+	}
+
+	r.lastFailure = time.Now().Add(-maxRetryTimeout - time.Second)
+	if queryable, wakeup := r.IsQueryable(); !queryable || !wakeup {
+		t.Errorf("expected device woken up after retry timeout, got %v %v", queryable, wakeup)
+	}
+
+	// recovery resets the backoff
+	r.Available(true)
+	if !r.Online || r.Quarantined || r.RetryTimeout() != retryTimeout {
+		t.Errorf("expected recovered device, got %+v with retry timeout %v", r, r.RetryTimeout())
+	}
+	if queryable, wakeup := r.IsQueryable(); !queryable || wakeup {
+		t.Errorf("expected online device queryable, got %v %v", queryable, wakeup)
+	}
+
+	r.Available(false)
+	if r.Quarantined || r.RetryTimeout() != retryTimeout {
+		t.Errorf("expected backoff restarted after recovery, got %+v", r)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	hundred := make([]float64, 100)
+	for i := range hundred {
+		hundred[i] = float64(i + 1)
+	}
+
+	tc := []struct {
+		sorted        []float64
+		p50, p95, p99 float64
+	}{
+		{nil, 0, 0, 0},
+		{[]float64{7}, 7, 7, 7},
+		{[]float64{1, 2}, 1, 2, 2},
+		{[]float64{1, 2, 3, 4}, 2, 4, 4},
+		{hundred, 50, 95, 99},
+	}
+
+	for _, tc := range tc {
+		if p := percentile(tc.sorted, 0.5); p != tc.p50 {
+			t.Errorf("%v: expected p50 %v, got %v", tc.sorted, tc.p50, p)
+		}
+		if p := percentile(tc.sorted, 0.95); p != tc.p95 {
+			t.Errorf("%v: expected p95 %v, got %v", tc.sorted, tc.p95, p)
+		}
+		if p := percentile(tc.sorted, 0.99); p != tc.p99 {
+			t.Errorf("%v: expected p99 %v, got %v", tc.sorted, tc.p99, p)
+		}
+	}
+
+	// percentiles are calculated from the most recent samples
+	var r RuntimeInfo
+	for i := 1; i <= latencySamples+50; i++ {
+		r.Observe(time.Duration(i) * time.Millisecond)
+	}
+	if r.Latency.Min != 1 || r.Latency.Max != latencySamples+50 || r.Latency.P50 != 100 || r.Latency.P99 != 149 {
+		t.Errorf("unexpected latency %+v", r.Latency)
+	}
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"path"
+	"strings"
+)
+
+const tagPrefix = "tag:"
+
+// Labels are user-defined device name and tags
+type Labels struct {
+	Name string
+	Tags []string
+}
+
+// Selector selects devices by glob patterns. Patterns are matched against the device id
+// and name. Patterns prefixed with "tag:" are matched against the device tags.
+// An empty selector matches all devices.
+type Selector []string
+
+// NewSelector creates a selector from a comma-separated list of patterns
+func NewSelector(patterns string) Selector {
+	res := make(Selector, 0)
+	for _, p := range strings.Split(patterns, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			res = append(res, strings.ToLower(p))
+		}
+	}
+	return res
+}
+
+// Match returns true if any of the selector's patterns matches the device
+func (s Selector) Match(id string, labels Labels) bool {
+	if len(s) == 0 {
+		return true
+	}
+
+	for _, pattern := range s {
+		if strings.HasPrefix(pattern, tagPrefix) {
+			pattern = strings.TrimPrefix(pattern, tagPrefix)
+			for _, tag := range labels.Tags {
+				if glob(pattern, tag) {
+					return true
+				}
+			}
+			continue
+		}
+
+		if glob(pattern, id) || labels.Name != "" && glob(pattern, labels.Name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// glob matches case-insensitive. Invalid patterns never match.
+func glob(pattern, s string) bool {
+	match, err := path.Match(pattern, strings.ToLower(s))
+	return err == nil && match
+}
+
+// NewSelectorRunner decorates a QuerySnip runner such that it only receives snips
+// of devices matching the selector
+func NewSelectorRunner(s Selector, qe DeviceInfo, run func(<-chan QuerySnip)) func(<-chan QuerySnip) {
+	if len(s) == 0 {
+		return run
+	}
+
+	return func(in <-chan QuerySnip) {
+		out := make(chan QuerySnip)
+		done := make(chan struct{})
+
+		go func() {
+			run(out)
+			close(done)
+		}()
+
+		for snip := range in {
+			if s.Match(snip.Device, qe.DeviceLabelsByID(snip.Device)) {
+				out <- snip
+			}
+		}
+
+		close(out)
+		<-done
+	}
+}
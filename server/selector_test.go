@@ -0,0 +1,99 @@
+package server
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestSelectorMatch(t *testing.T) {
+	labels := Labels{Name: "garage", Tags: []string{"billing"}}
+
+	tc := []struct {
+		patterns string
+		match    bool
+	}{
+		{"", true},
+		{"SDM1.*", true},
+		{"sdm2.*", false},
+		{"gar*", true},
+		{"tag:bill*", true},
+		{"tag:garage", false},
+		{"foo,tag:billing", true},
+		{"[", false},
+	}
+
+	for _, c := range tc {
+		if m := NewSelector(c.patterns).Match("SDM1.1", labels); m != c.match {
+			t.Errorf("%s: expected %v, got %v", c.patterns, c.match, m)
+		}
+	}
+}
+
+func TestAnnotationApplies(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+	dev := &serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}
+	if err := m.Add(1, dev); err != nil {
+		t.Fatal(err)
+	}
+
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m})
+	qe.SetLabels(dev, Labels{Name: "garage", Tags: []string{"billing"}})
+
+	a := Annotation{Text: "replaced meter", Devices: []string{"SDM1.1"}}
+
+	tc := []struct {
+		patterns string
+		info     DeviceInfo
+		applies  bool
+	}{
+		{"", nil, true},
+		{"SDM1.*", nil, true},
+		{"garage", nil, false},
+		{"garage", qe, true},
+		{"tag:billing", qe, true},
+		{"tag:heating", qe, false},
+	}
+
+	for _, c := range tc {
+		if applies := a.Applies(NewSelector(c.patterns), c.info); applies != c.applies {
+			t.Errorf("%s: expected %v, got %v", c.patterns, c.applies, applies)
+		}
+	}
+
+	// annotations without devices apply to all devices
+	if !(Annotation{Text: "outage"}).Applies(NewSelector("tag:heating"), qe) {
+		t.Error("expected annotation without devices to apply")
+	}
+}
+
+func TestDeviceLabelsConcurrent(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+	dev := &serialDevice{desc: meters.DeviceDescriptor{Type: "SDM"}}
+	if err := m.Add(1, dev); err != nil {
+		t.Fatal(err)
+	}
+
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m})
+	selector := NewSelector("tag:billing")
+
+	// labels are updated on reload while sinks select devices
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			qe.SetLabels(dev, Labels{Tags: []string{"billing"}})
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		selector.Match("SDM1.1", qe.DeviceLabelsByID("SDM1.1"))
+	}
+	wg.Wait()
+
+	if !selector.Match("SDM1.1", qe.DeviceLabelsByID("SDM1.1")) {
+		t.Error("expected tag match")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/volkszaehler/mbmd/meters"
 )
@@ -14,10 +15,30 @@ type ControlSnip struct {
 	Status RuntimeInfo
 }
 
+// Cycle identifies the device's polling cycle a query result was read in
+type Cycle struct {
+	Seq       uint64    // sequence number of the device's successful queries, 0 if not read from the device
+	Timestamp time.Time // start of the query
+	Size      int       // number of query results of the cycle
+}
+
+// MarshalJSON converts Cycle to json with RFC3339 timestamp
+func (c Cycle) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Seq       uint64
+		Timestamp string
+	}{
+		Seq:       c.Seq,
+		Timestamp: apiTime(c.Timestamp),
+	})
+}
+
 // QuerySnip wraps query results
 type QuerySnip struct {
 	Device string
 	meters.MeasurementResult
+	Cycle Cycle
+	Stale bool // restored last known value, not read from the device
 }
 
 // String representation
@@ -25,20 +46,31 @@ func (q *QuerySnip) String() string {
 	return fmt.Sprintf("Dev: %s, IEC: %s, Value: %.3f", q.Device, q.Measurement.String(), q.Value)
 }
 
-// MarshalJSON converts QuerySnip to json, replacing Timestamp with unix time representation
+// MarshalJSON converts QuerySnip to json, replacing Timestamp with RFC3339 representation
 func (q *QuerySnip) MarshalJSON() ([]byte, error) {
+	_, unit := q.Measurement.DescriptionAndUnit()
 	return json.Marshal(struct {
 		Device      string
 		Value       float64
 		IEC61850    string
+		OBIS        string `json:",omitempty"`
 		Description string
-		Timestamp   int64
+		Unit        string
+		Timestamp   string
+		Cycle       uint64 `json:",omitempty"`
+		Stale       bool   `json:",omitempty"`
+		Derived     bool   `json:",omitempty"`
 	}{
 		Device:      q.Device,
 		Value:       q.Value,
 		IEC61850:    q.Measurement.String(),
+		OBIS:        q.Measurement.OBIS(),
 		Description: q.Measurement.Description(),
-		Timestamp:   q.Timestamp.UnixNano() / 1e6,
+		Unit:        unit,
+		Timestamp:   apiTime(q.Timestamp),
+		Cycle:       q.Cycle.Seq,
+		Stale:       q.Stale,
+		Derived:     q.Derived,
 	})
 }
 
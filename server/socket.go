@@ -1,12 +1,13 @@
 package server
 
 import (
-	"encoding/json"
-	"log"
+	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/volkszaehler/mbmd/log"
 )
 
 const (
@@ -15,12 +16,18 @@ const (
 
 	// Frequency at which status updates are sent
 	statusFrequency = 1 * time.Second
+
+	// Maximum number of messages buffered per client.
+	// If exceeded the oldest messages are dropped for that client only.
+	socketBufferSize = 256
 )
 
+// upgrader negotiates permessage-deflate compression with clients supporting it
 var upgrader = websocket.Upgrader{
-	ReadBufferSize:  1024,
-	WriteBufferSize: 1024,
-	CheckOrigin:     func(r *http.Request) bool { return true },
+	ReadBufferSize:    1024,
+	WriteBufferSize:   1024,
+	EnableCompression: true,
+	CheckOrigin:       func(r *http.Request) bool { return true },
 }
 
 // SocketClient is a middleman between the websocket connection and the hub.
@@ -30,38 +37,129 @@ type SocketClient struct {
 	// The websocket connection.
 	conn *websocket.Conn
 
-	// Buffered channel of outbound messages.
-	send chan []byte
+	// The negotiated message format.
+	format socketFormat
+
+	// Bounded buffer of outbound messages.
+	mux    sync.Mutex
+	buffer [][]byte
+
+	// signals buffered messages
+	notify chan struct{}
+
+	// closed when the client is unregistered
+	done chan struct{}
+}
+
+func newSocketClient(hub *SocketHub, conn *websocket.Conn, format socketFormat) *SocketClient {
+	return &SocketClient{
+		hub:    hub,
+		conn:   conn,
+		format: format,
+		buffer: make([][]byte, 0, socketBufferSize),
+		notify: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+}
+
+// enqueue adds a message to the client's buffer without blocking.
+// If the buffer is full the client's oldest message is dropped.
+func (c *SocketClient) enqueue(msg []byte) {
+	c.mux.Lock()
+	if len(c.buffer) >= socketBufferSize {
+		c.buffer = c.buffer[1:]
+	}
+	c.buffer = append(c.buffer, msg)
+	c.mux.Unlock()
+
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+// dequeue removes and returns all buffered messages
+func (c *SocketClient) dequeue() [][]byte {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	res := c.buffer
+	c.buffer = make([][]byte, 0, socketBufferSize)
+	return res
 }
 
-// writePump pumps messages from the hub to the websocket connection.
+// writePump pumps messages from the client's buffer to the websocket connection.
 func (c *SocketClient) writePump() {
 	defer func() {
 		c.conn.Close()
 	}()
 	for {
-		msg := <-c.send
-		if err := c.conn.SetWriteDeadline(time.Now().Add(socketWriteWait)); err != nil {
+		select {
+		case <-c.done:
+			return
+		case <-c.hub.done:
 			return
+		case <-c.notify:
+		}
+
+		for _, msg := range c.dequeue() {
+			if err := c.conn.SetWriteDeadline(time.Now().Add(socketWriteWait)); err != nil {
+				c.close()
+				return
+			}
+			if err := c.conn.WriteMessage(c.format.messageType(), msg); err != nil {
+				c.close()
+				return
+			}
 		}
-		if err := c.conn.WriteMessage(websocket.TextMessage, msg); err != nil {
+	}
+}
+
+// readPump discards incoming messages and detects closed connections.
+func (c *SocketClient) readPump() {
+	for {
+		if _, _, err := c.conn.NextReader(); err != nil {
+			c.close()
 			return
 		}
 	}
 }
 
+// close requests unregistering the client from the hub unless the hub has stopped
+func (c *SocketClient) close() {
+	select {
+	case c.hub.unregister <- c:
+	case <-c.done:
+	case <-c.hub.done:
+	}
+}
+
 // ServeWebsocket handles websocket requests from the peer.
+// The message format is negotiated using the format query parameter or Accept header.
 func ServeWebsocket(hub *SocketHub, w http.ResponseWriter, r *http.Request) {
+	format, err := negotiateSocketFormat(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
-		log.Println(err)
+		log.Warnf("websocket: %v", err)
+		return
+	}
+	client := newSocketClient(hub, conn, format)
+
+	select {
+	case client.hub.register <- client:
+	case <-client.hub.done:
+		conn.Close()
 		return
 	}
-	client := &SocketClient{hub: hub, conn: conn, send: make(chan []byte, 256)}
-	client.hub.register <- client
 
 	// run writing to client in goroutine
 	go client.writePump()
+	go client.readPump()
 }
 
 // SocketHub maintains the set of active clients and broadcasts messages to the
@@ -78,6 +176,12 @@ type SocketHub struct {
 
 	// status channel
 	status *Status
+
+	// message sequence number
+	seq uint64
+
+	// closed when the hub has stopped
+	done chan struct{}
 }
 
 // NewSocketHub creates a web socket hub that distributes meter status and
@@ -85,38 +189,58 @@ type SocketHub struct {
 func NewSocketHub(status *Status) *SocketHub {
 	return &SocketHub{
 		register:   make(chan *SocketClient),
-		unregister: make(chan *SocketClient),
+		unregister: make(chan *SocketClient, 1),
 		clients:    make(map[*SocketClient]bool),
 		status:     status,
+		done:       make(chan struct{}),
+	}
+}
+
+// sequenced adds the message sequence number to a marshaled json object.
+// Clients can use the sequence number to detect dropped messages.
+func sequenced(message []byte, seq uint64) []byte {
+	res := []byte(fmt.Sprintf(`{"Seq":%d`, seq))
+	if len(message) > 2 {
+		res = append(res, ',')
 	}
+	return append(res, message[1:]...)
 }
 
 func (h *SocketHub) broadcast(i interface{}) {
-	if len(h.clients) > 0 {
-		message, err := json.Marshal(i)
-		if err != nil {
-			log.Fatal(err)
-		}
+	h.seq++
 
-		for client := range h.clients {
-			select {
-			case client.send <- message:
-			default:
-				close(client.send)
-				delete(h.clients, client)
+	// encode once per format used by the clients
+	messages := make(map[socketFormat][]byte)
+
+	for client := range h.clients {
+		message, ok := messages[client.format]
+		if !ok {
+			var err error
+			if message, err = encodeSocketMessage(client.format, i, h.seq); err != nil {
+				log.Errorf("websocket: failed to encode %s: %v", client.format, err)
+				return
 			}
+			messages[client.format] = message
 		}
+
+		client.enqueue(message)
 	}
 }
 
 // Run starts data and status distribution
 func (h *SocketHub) Run(in <-chan QuerySnip) {
+	defer close(h.done)
+
 	// Periodically push meter status information
 	statusChannel := make(chan *Status)
 	go func() {
 		for {
 			time.Sleep(statusFrequency)
-			statusChannel <- h.status
+			select {
+			case statusChannel <- h.status:
+			case <-h.done:
+				return
+			}
 		}
 	}()
 
@@ -127,7 +251,7 @@ func (h *SocketHub) Run(in <-chan QuerySnip) {
 		case client := <-h.unregister:
 			if _, ok := h.clients[client]; ok {
 				delete(h.clients, client)
-				close(client.send)
+				close(client.done)
 			}
 		case obj, ok := <-in:
 			if !ok {
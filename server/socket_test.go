@@ -0,0 +1,110 @@
+package server
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/volkszaehler/mbmd/meters"
+	"github.com/volkszaehler/mbmd/server/rpc"
+)
+
+func TestSequenced(t *testing.T) {
+	if s := string(sequenced([]byte(`{"Device":"SDM1.1"}`), 7)); s != `{"Seq":7,"Device":"SDM1.1"}` {
+		t.Errorf("unexpected message %s", s)
+	}
+	if s := string(sequenced([]byte(`{}`), 1)); s != `{"Seq":1}` {
+		t.Errorf("unexpected message %s", s)
+	}
+}
+
+func TestSocketClientBuffer(t *testing.T) {
+	c := newSocketClient(nil, nil, socketJSON)
+	for i := 0; i < socketBufferSize+2; i++ {
+		c.enqueue([]byte{byte(i)})
+	}
+
+	msgs := c.dequeue()
+	if len(msgs) != socketBufferSize {
+		t.Fatalf("expected %d buffered messages, got %d", socketBufferSize, len(msgs))
+	}
+	if msgs[0][0] != 2 {
+		t.Errorf("expected oldest messages to be dropped, got %d", msgs[0][0])
+	}
+}
+
+func TestNegotiateSocketFormat(t *testing.T) {
+	tc := []struct {
+		url, accept string
+		format      socketFormat
+	}{
+		{"/ws", "", socketJSON},
+		{"/ws", "*/*", socketJSON},
+		{"/ws", "application/x-msgpack", socketMsgpack},
+		{"/ws", "text/html, application/protobuf;q=0.9", socketProtobuf},
+		{"/ws?format=msgpack", "application/protobuf", socketMsgpack},
+	}
+
+	for _, c := range tc {
+		r := httptest.NewRequest("GET", c.url, nil)
+		r.Header.Set("Accept", c.accept)
+		if f, err := negotiateSocketFormat(r); err != nil || f != c.format {
+			t.Errorf("%s %s: expected %s, got %s %v", c.url, c.accept, c.format, f, err)
+		}
+	}
+
+	if _, err := negotiateSocketFormat(httptest.NewRequest("GET", "/ws?format=xml", nil)); err == nil {
+		t.Error("expected error for invalid format")
+	}
+}
+
+func TestEncodeSocketMessage(t *testing.T) {
+	snip := &QuerySnip{Device: "D", MeasurementResult: meters.MeasurementResult{Measurement: meters.Power, Value: 1.5}}
+
+	b, err := encodeSocketMessage(socketMsgpack, snip, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// map of Seq, Device, Value, IEC61850, OBIS, Description, Unit and Timestamp
+	if b[0] != 0x88 || !bytes.Contains(b, []byte{0xa3, 'S', 'e', 'q', 0x07}) || !bytes.Contains(b, []byte{0xa1, 'D'}) {
+		t.Errorf("unexpected msgpack % x", b)
+	}
+
+	if b, err = encodeSocketMessage(socketProtobuf, snip, 7); err != nil {
+		t.Fatal(err)
+	}
+
+	var msg rpc.SocketMessage
+	if err := proto.Unmarshal(b, &msg); err != nil {
+		t.Fatal(err)
+	}
+	if r := msg.GetReading(); msg.Seq != 7 || r.GetDevice() != "D" || r.GetValue() != 1.5 {
+		t.Errorf("unexpected message %v", &msg)
+	}
+}
+
+func TestSocketClientCloseStoppedHub(t *testing.T) {
+	hub := NewSocketHub(nil)
+
+	in := make(chan QuerySnip)
+	close(in)
+	hub.Run(in)
+
+	// closing must not block once the hub has stopped
+	c := newSocketClient(hub, nil, socketJSON)
+	done := make(chan struct{})
+	go func() {
+		c.close()
+		c.close()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("close blocked")
+	}
+}
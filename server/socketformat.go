@@ -0,0 +1,126 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/gorilla/websocket"
+	"github.com/volkszaehler/mbmd/server/rpc"
+)
+
+// socketFormat is the payload format of websocket messages
+type socketFormat string
+
+const (
+	socketJSON     socketFormat = "json"
+	socketMsgpack  socketFormat = "msgpack"
+	socketProtobuf socketFormat = "protobuf" // rpc.SocketMessage
+)
+
+// socketMediaTypes maps Accept header media types to formats
+var socketMediaTypes = map[string]socketFormat{
+	"application/json":       socketJSON,
+	"application/msgpack":    socketMsgpack,
+	"application/x-msgpack":  socketMsgpack,
+	"application/protobuf":   socketProtobuf,
+	"application/x-protobuf": socketProtobuf,
+}
+
+// negotiateSocketFormat selects the format from the format query parameter or
+// the first supported media type of the Accept header. It defaults to json.
+func negotiateSocketFormat(r *http.Request) (socketFormat, error) {
+	if format := r.URL.Query().Get("format"); format != "" {
+		switch f := socketFormat(strings.ToLower(format)); f {
+		case socketJSON, socketMsgpack, socketProtobuf:
+			return f, nil
+		default:
+			return "", fmt.Errorf("invalid format %s", format)
+		}
+	}
+
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		if mediaType, _, err := mime.ParseMediaType(accept); err == nil {
+			if f, ok := socketMediaTypes[mediaType]; ok {
+				return f, nil
+			}
+		}
+	}
+
+	return socketJSON, nil
+}
+
+// messageType returns the websocket message type of the format
+func (f socketFormat) messageType() int {
+	if f == socketJSON {
+		return websocket.TextMessage
+	}
+	return websocket.BinaryMessage
+}
+
+// encodeSocketMessage encodes a query snip or status message including the sequence number
+func encodeSocketMessage(format socketFormat, i interface{}, seq uint64) ([]byte, error) {
+	if format == socketProtobuf {
+		msg, err := protoSocketMessage(i, seq)
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(msg)
+	}
+
+	message, err := json.Marshal(i)
+	if err != nil {
+		return nil, err
+	}
+	message = sequenced(message, seq)
+
+	if format == socketMsgpack {
+		return jsonToMsgpack(message)
+	}
+
+	return message, nil
+}
+
+// protoSocketMessage converts a query snip or status message to protobuf
+func protoSocketMessage(i interface{}, seq uint64) (*rpc.SocketMessage, error) {
+	msg := &rpc.SocketMessage{Seq: seq}
+
+	switch v := i.(type) {
+	case *QuerySnip:
+		r, err := reading(v.Device, v.MeasurementResult, v.Stale)
+		if err != nil {
+			return nil, err
+		}
+		msg.Payload = &rpc.SocketMessage_Reading{Reading: r}
+
+	case *Status:
+		snapshot := v.Snapshot()
+		status := &rpc.Status{Uptime: snapshot.UpTime}
+		for _, ds := range snapshot.Meters {
+			lastSeen, err := ptypes.TimestampProto(ds.LastSeen)
+			if err != nil {
+				return nil, err
+			}
+
+			status.Devices = append(status.Devices, &rpc.DeviceStatus{
+				Device:      ds.Device,
+				Type:        ds.Type,
+				Online:      ds.Online,
+				Quarantined: ds.Quarantined,
+				Requests:    ds.Requests,
+				Errors:      ds.Errors,
+				LastSeen:    lastSeen,
+			})
+		}
+		msg.Payload = &rpc.SocketMessage_Status{Status: status}
+
+	default:
+		return nil, fmt.Errorf("unsupported message %T", i)
+	}
+
+	return msg, nil
+}
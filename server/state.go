@@ -0,0 +1,178 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/volkszaehler/mbmd/log"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// stateSaveInterval is the interval for saving changed values, values are always saved on shutdown
+const stateSaveInterval = time.Minute
+
+// stateValue is a persisted last known value
+type stateValue struct {
+	Value     float64   `json:"value"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// StateStore persists the last known values of all devices to a json file
+// such that they can be restored after restart
+type StateStore struct {
+	mux     sync.Mutex
+	file    string
+	all     bool
+	changed bool
+	values  map[string]map[string]stateValue // device id, measurement
+}
+
+// NewStateStore creates a state store loading existing values from file.
+// Only energy readings are persisted unless all is true.
+func NewStateStore(file string, all bool) (*StateStore, error) {
+	s := &StateStore{
+		file:   file,
+		all:    all,
+		values: make(map[string]map[string]stateValue),
+	}
+
+	b, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &s.values); err != nil {
+		return nil, fmt.Errorf("invalid state file %s: %v", file, err)
+	}
+
+	return s, nil
+}
+
+// persistable returns true if the measurement is persisted
+func (s *StateStore) persistable(m meters.Measurement) bool {
+	if s.all {
+		return true
+	}
+
+	_, unit := m.DescriptionAndUnit()
+	return unit == "kWh" || unit == "kvarh"
+}
+
+// Snips returns the stored values as stale query results sorted by device and measurement.
+// Values of unknown measurements, e.g. from a different mbmd version, are skipped.
+func (s *StateStore) Snips() []QuerySnip {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	res := make([]QuerySnip, 0)
+	for device, values := range s.values {
+		for name, v := range values {
+			m, err := meters.MeasurementString(name)
+			if err != nil || !s.persistable(m) {
+				continue
+			}
+
+			res = append(res, QuerySnip{
+				Device: device,
+				MeasurementResult: meters.MeasurementResult{
+					Measurement: m,
+					Value:       v.Value,
+					Timestamp:   v.Timestamp,
+				},
+				Stale: true,
+			})
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Device != res[j].Device {
+			return res[i].Device < res[j].Device
+		}
+		return res[i].Measurement < res[j].Measurement
+	})
+
+	return res
+}
+
+// save writes all values to file if changed. Caller must hold the lock.
+func (s *StateStore) save() error {
+	if !s.changed {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(s.values, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// write atomically to not lose the state when interrupted
+	tmp := s.file + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+
+	s.changed = false
+
+	return os.Rename(tmp, s.file)
+}
+
+// add stores the snip's value. Caller must hold the lock.
+func (s *StateStore) add(snip QuerySnip) {
+	if snip.Stale || !s.persistable(snip.Measurement) {
+		return
+	}
+
+	values, ok := s.values[snip.Device]
+	if !ok {
+		values = make(map[string]stateValue)
+		s.values[snip.Device] = values
+	}
+
+	values[snip.Measurement.String()] = stateValue{
+		Value:     snip.Value,
+		Timestamp: snip.Timestamp,
+	}
+
+	s.changed = true
+}
+
+// persist saves the values and logs errors
+func (s *StateStore) persist() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if err := s.save(); err != nil {
+		log.Errorf("state: failed to save: %v", err)
+	}
+}
+
+// Run stores query results and saves them periodically and when the channel is closed
+func (s *StateStore) Run(in <-chan QuerySnip) {
+	ticker := time.NewTicker(stateSaveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case snip, ok := <-in:
+			if !ok {
+				s.persist()
+				return
+			}
+
+			s.mux.Lock()
+			s.add(snip)
+			s.mux.Unlock()
+
+		case <-ticker.C:
+			s.persist()
+		}
+	}
+}
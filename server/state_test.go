@@ -0,0 +1,60 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestStateStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mbmd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "state.json")
+
+	s, err := NewStateStore(file, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts := time.Now().Truncate(time.Second)
+	snip := func(m meters.Measurement, v float64) QuerySnip {
+		return QuerySnip{
+			Device:            "SDM1.1",
+			MeasurementResult: meters.MeasurementResult{Measurement: m, Value: v, Timestamp: ts},
+		}
+	}
+
+	in := make(chan QuerySnip)
+	done := make(chan struct{})
+	go func() {
+		s.Run(in)
+		close(done)
+	}()
+
+	in <- snip(meters.Import, 1234.5)
+	in <- snip(meters.Power, 100) // not an energy reading
+	close(in)
+	<-done
+
+	s, err = NewStateStore(file, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snips := s.Snips()
+	if len(snips) != 1 {
+		t.Fatalf("expected 1 restored value, got %d", len(snips))
+	}
+
+	if r := snips[0]; r.Measurement != meters.Import || r.Value != 1234.5 || !r.Timestamp.Equal(ts) || !r.Stale {
+		t.Errorf("unexpected restored value %+v", r)
+	}
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// StatsD publishes readings as StatsD gauges and device errors as counters
+type StatsD struct {
+	conn   net.Conn
+	prefix string
+	tags   bool                   // use DogStatsD tags instead of device names in metric names
+	status map[string]RuntimeInfo // last device status for counting errors
+}
+
+// NewStatsD creates a StatsD sink sending to the UDP address
+func NewStatsD(addr string, prefix string, tags bool) (*StatsD, error) {
+	if prefix == "" {
+		return nil, errors.New("statsd: missing prefix")
+	}
+
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StatsD{
+		conn:   conn,
+		prefix: prefix,
+		tags:   tags,
+		status: make(map[string]RuntimeInfo),
+	}, nil
+}
+
+// metric formats a metric of the device as <prefix>.<device>.<name> or <prefix>.<name>|#device:<device>
+func (m *StatsD) metric(device, name string, value string, typ string) string {
+	if m.tags {
+		return fmt.Sprintf("%s.%s:%s|%s|#device:%s\n", m.prefix, name, value, typ, device)
+	}
+	return fmt.Sprintf("%s.%s.%s:%s|%s\n", m.prefix, mqttDeviceTopic(device), name, value, typ)
+}
+
+// gauge formats a gauge. Since signed values modify instead of set StatsD gauges,
+// negative gauges are reset to zero first.
+func (m *StatsD) gauge(device, name string, value float64) string {
+	v := strconv.FormatFloat(value, 'f', -1, 64)
+	if value < 0 {
+		return m.metric(device, name, "0", "g") + m.metric(device, name, v, "g")
+	}
+	return m.metric(device, name, v, "g")
+}
+
+// Run StatsD gauge publisher
+func (m *StatsD) Run(in <-chan QuerySnip) {
+	d := &datagrams{conn: m.conn}
+	receive(in, d, func(snip QuerySnip) {
+		if snip.Stale || math.IsNaN(snip.Value) || math.IsInf(snip.Value, 0) {
+			return
+		}
+
+		d.add(m.gauge(snip.Device, strings.ToLower(snip.Measurement.String()), snip.Value))
+	})
+}
+
+// Control publishes the devices' online state as gauge and increments error counters
+func (m *StatsD) Control(in <-chan ControlSnip) {
+	d := &datagrams{conn: m.conn}
+
+	for snip := range in {
+		online := 0.0
+		if snip.Status.Online {
+			online = 1
+		}
+		d.add(m.gauge(snip.Device, "online", online))
+
+		// counters are only incremented by changes after the first status
+		if last, ok := m.status[snip.Device]; ok {
+			for name, delta := range map[string]uint64{
+				"errors":            snip.Status.Errors - last.Errors,
+				"timeouts":          snip.Status.Timeouts - last.Timeouts,
+				"crc_errors":        snip.Status.CRCErrors - last.CRCErrors,
+				"exceptions":        snip.Status.Exceptions - last.Exceptions,
+				"connection_errors": snip.Status.ConnErrors - last.ConnErrors,
+			} {
+				// skip wrapped around deltas of reset counters
+				if delta > 0 && delta < math.MaxUint32 {
+					d.add(m.metric(snip.Device, name, strconv.FormatUint(delta, 10), "c"))
+				}
+			}
+		}
+		m.status[snip.Device] = snip.Status
+
+		d.flush()
+	}
+}
+
+// Close closes the connection after the runners have finished
+func (m *StatsD) Close() error {
+	return m.conn.Close()
+}
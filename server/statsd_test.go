@@ -0,0 +1,63 @@
+package server
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func readDatagram(t *testing.T, pc net.PacketConn) string {
+	_ = pc.SetReadDeadline(time.Now().Add(time.Second))
+	b := make([]byte, udpMaxDatagram)
+	n, _, err := pc.ReadFrom(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b[:n])
+}
+
+func TestStatsD(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pc.Close()
+
+	statsd, err := NewStatsD(pc.LocalAddr().String(), "mbmd", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer statsd.Close()
+
+	in := make(chan QuerySnip, 3)
+	in <- QuerySnip{Device: "SDM1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Power, Value: -1500.5}}
+	in <- QuerySnip{Device: "SDM1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Import, Value: 12}, Stale: true}
+	in <- QuerySnip{Device: "SDM1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Cosphi, Value: 0.5}}
+	close(in)
+
+	statsd.Run(in)
+
+	expected := "mbmd.sdm1-1.power:0|g\nmbmd.sdm1-1.power:-1500.5|g\nmbmd.sdm1-1.cosphi:0.5|g\n"
+	if s := readDatagram(t, pc); s != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, s)
+	}
+
+	ctrl := make(chan ControlSnip, 2)
+	ctrl <- ControlSnip{Device: "SDM1.1", Status: RuntimeInfo{Online: true, Errors: 2}}
+	ctrl <- ControlSnip{Device: "SDM1.1", Status: RuntimeInfo{Online: false, Errors: 5}}
+	close(ctrl)
+
+	statsd.tags = true
+	statsd.Control(ctrl)
+
+	for _, expected := range []string{
+		"mbmd.online:1|g|#device:SDM1.1\n",
+		"mbmd.online:0|g|#device:SDM1.1\nmbmd.errors:3|c|#device:SDM1.1\n",
+	} {
+		if s := readDatagram(t, pc); s != expected {
+			t.Errorf("expected\n%s\ngot\n%s", expected, s)
+		}
+	}
+}
@@ -3,8 +3,11 @@ package server
 import (
 	"encoding/json"
 	"runtime"
+	"sort"
 	"sync"
 	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
 )
 
 // MemoryStatus represents daemon memory allocation
@@ -17,15 +20,38 @@ type MemoryStatus struct {
 type ModbusStatus struct {
 	Requests          uint64
 	RequestsPerMinute float64
+	Successes         uint64
 	Errors            uint64
 	ErrorsPerMinute   float64
+	Timeouts          uint64
+	CRCErrors         uint64
+	Exceptions        uint64
+	ConnErrors        uint64
+	LastSeen          time.Time
+	BusTime           float64 // seconds
+	BusUtilization    float64 // percent of uptime
+	Latency           LatencyStatus
+}
+
+// SinkStatus represents the status of a persistence sink like InfluxDB
+type SinkStatus struct {
+	Degraded bool
+	Error    string `json:",omitempty"`
+	Since    time.Time
+	Queued   int
+	Dropped  uint64
 }
 
 // DeviceStatus represents a devices runtime status
 type DeviceStatus struct {
-	Device string
-	Type   string
-	Online bool
+	Device      string
+	Name        string `json:",omitempty"`
+	Type        string
+	Model       string `json:",omitempty"`
+	Version     string `json:",omitempty"`
+	Serial      string `json:",omitempty"`
+	Online      bool
+	Quarantined bool
 	ModbusStatus
 }
 
@@ -38,92 +64,239 @@ func memoryStatus() MemoryStatus {
 	}
 }
 
-// Status represents the daemon and device status.
-// It is updated when marshaled to JSON
-type Status struct {
-	sync.Mutex
-	qe         DeviceInfo
+// StatusSnapshot is a consistent copy of the daemon and device status
+type StatusSnapshot struct {
 	StartTime  time.Time
 	UpTime     float64
 	Goroutines int
 	Memory     MemoryStatus
-	Meters     []DeviceStatus
-	meterMap   map[string]DeviceStatus
+	Meters     []DeviceStatus                  // sorted by device
+	Sinks      map[string]SinkStatus           `json:",omitempty"`
+	Adapters   map[string]meters.LimiterStatus `json:",omitempty"` // transaction rate of limited adapters
 }
 
-// NewStatus creates status cache that collects device status from control channel.
-// It needs to be Update()d in order to refresh its data for consumption
+// Status collects the daemon and device status. It is safe for concurrent use,
+// consumers obtain consistent copies using Snapshot or Device.
+type Status struct {
+	mux       sync.Mutex
+	qe        DeviceInfo
+	startTime time.Time
+	devices   map[string]DeviceStatus // per-device counters, rates are computed on read
+	sinks     map[string]SinkStatus
+	limiters  map[string]*meters.Limiter
+	sinkSubs  []func(string, SinkStatus)
+}
+
+// NewStatus creates status cache that collects device status from control channel
 func NewStatus(qe DeviceInfo, control <-chan ControlSnip) *Status {
 	s := &Status{
-		qe:         qe,
-		Memory:     memoryStatus(),
-		Goroutines: runtime.NumGoroutine(),
-		StartTime:  time.Now(),
-		UpTime:     1,
-		meterMap:   make(map[string]DeviceStatus),
+		qe:        qe,
+		startTime: time.Now(),
+		devices:   make(map[string]DeviceStatus),
 	}
 
 	go func() {
 		for c := range control {
-			s.Lock()
-
-			minutes := s.UpTime / 60
-			mbs := ModbusStatus{
-				Requests:          c.Status.Requests,
-				Errors:            c.Status.Errors,
-				ErrorsPerMinute:   float64(c.Status.Errors) / minutes,
-				RequestsPerMinute: float64(c.Status.Requests) / minutes,
-			}
-
 			desc := s.qe.DeviceDescriptorByID(c.Device)
 
 			ds := DeviceStatus{
-				Device:       c.Device,
-				Type:         desc.Manufacturer,
-				Online:       c.Status.Online,
-				ModbusStatus: mbs,
+				Device:      c.Device,
+				Name:        s.qe.DeviceLabelsByID(c.Device).Name,
+				Type:        desc.Manufacturer,
+				Model:       desc.Model,
+				Version:     desc.Version,
+				Serial:      desc.Serial,
+				Online:      c.Status.Online,
+				Quarantined: c.Status.Quarantined,
+				ModbusStatus: ModbusStatus{
+					Requests:   c.Status.Requests,
+					Successes:  c.Status.Successes,
+					Errors:     c.Status.Errors,
+					Timeouts:   c.Status.Timeouts,
+					CRCErrors:  c.Status.CRCErrors,
+					Exceptions: c.Status.Exceptions,
+					ConnErrors: c.Status.ConnErrors,
+					LastSeen:   c.Status.LastSeen,
+					BusTime:    c.Status.BusTime.Seconds(),
+					Latency:    c.Status.Latency,
+				},
 			}
-			s.meterMap[c.Device] = ds
 
-			s.Unlock()
+			s.mux.Lock()
+			s.devices[c.Device] = ds
+			s.mux.Unlock()
 		}
 	}()
 
 	return s
 }
 
+// SubscribeSinks registers a function that is called when a sink becomes degraded or recovers
+func (s *Status) SubscribeSinks(f func(string, SinkStatus)) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.sinkSubs = append(s.sinkSubs, f)
+}
+
+// UpdateSink updates a persistence sink's status
+func (s *Status) UpdateSink(name string, ss SinkStatus) {
+	s.mux.Lock()
+
+	if s.sinks == nil {
+		s.sinks = make(map[string]SinkStatus)
+	}
+	changed := s.sinks[name].Degraded != ss.Degraded
+	s.sinks[name] = ss
+	subs := s.sinkSubs
+
+	s.mux.Unlock()
+
+	if changed {
+		for _, f := range subs {
+			f(name, ss)
+		}
+	}
+}
+
+// AddLimiter adds the transaction limiter of the named adapter
+func (s *Status) AddLimiter(adapter string, l *meters.Limiter) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.limiters == nil {
+		s.limiters = make(map[string]*meters.Limiter)
+	}
+	s.limiters[adapter] = l
+}
+
+// RemoveLimiter removes the transaction limiter of the named adapter
+func (s *Status) RemoveLimiter(adapter string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	delete(s.limiters, adapter)
+}
+
+// RemoveSink removes a persistence sink's status
+func (s *Status) RemoveSink(name string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	delete(s.sinks, name)
+}
+
+// Remove removes a device's status, e.g. after the device has been removed
+func (s *Status) Remove(device string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	delete(s.devices, device)
+}
+
 // Online returns device's online status or false if the device does not exist
 func (s *Status) Online(device string) bool {
-	s.Lock()
-	defer s.Unlock()
+	s.mux.Lock()
+	defer s.mux.Unlock()
 
-	if ds, ok := s.meterMap[device]; ok {
+	if ds, ok := s.devices[device]; ok {
 		return ds.Online
 	}
 
 	return false
 }
 
-// Update status
-func (s *Status) update() {
-	s.Memory = memoryStatus()
-	s.Goroutines = runtime.NumGoroutine()
-	s.UpTime = time.Since(s.StartTime).Seconds()
+// Health returns the device's health state and the time it was last seen
+func (s *Status) Health(device string) (string, time.Time) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
 
-	s.Meters = make([]DeviceStatus, 0)
-	for _, ms := range s.meterMap {
-		s.Meters = append(s.Meters, ms)
+	ds, ok := s.devices[device]
+	switch {
+	case !ok:
+		return HealthUnknown, time.Time{}
+	case ds.Quarantined:
+		return HealthQuarantined, ds.LastSeen
+	case ds.Online:
+		return HealthOnline, ds.LastSeen
+	default:
+		return HealthOffline, ds.LastSeen
 	}
 }
 
-// MarshalJSON will syncronize access to the status object
-// see http://choly.ca/post/go-json-marshalling/ for avoiding infinite loop
-func (s *Status) MarshalJSON() ([]byte, error) {
-	s.Lock()
-	defer s.Unlock()
+// DeviceIDs returns the sorted ids of all devices with status
+func (s *Status) DeviceIDs() []string {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	ids := make([]string, 0, len(s.devices))
+	for id := range s.devices {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// Device returns the device's status or false if the device does not exist
+func (s *Status) Device(device string) (DeviceStatus, bool) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	ds, ok := s.devices[device]
+	if !ok {
+		return DeviceStatus{}, false
+	}
+
+	return withRates(ds, time.Since(s.startTime)), true
+}
+
+// withRates adds the request and error rates and bus utilization for the given uptime
+func withRates(ds DeviceStatus, uptime time.Duration) DeviceStatus {
+	if minutes := uptime.Minutes(); minutes > 0 {
+		ds.RequestsPerMinute = float64(ds.Requests) / minutes
+		ds.ErrorsPerMinute = float64(ds.Errors) / minutes
+		ds.BusUtilization = 100 * ds.BusTime / uptime.Seconds()
+	}
+	return ds
+}
+
+// Snapshot returns a consistent copy of the current status
+func (s *Status) Snapshot() StatusSnapshot {
+	s.mux.Lock()
+	defer s.mux.Unlock()
 
-	s.update()
+	uptime := time.Since(s.startTime)
 
-	type Alias Status
-	return json.Marshal(&struct{ *Alias }{Alias: (*Alias)(s)})
+	res := StatusSnapshot{
+		StartTime:  s.startTime,
+		UpTime:     uptime.Seconds(),
+		Goroutines: runtime.NumGoroutine(),
+		Memory:     memoryStatus(),
+		Meters:     make([]DeviceStatus, 0, len(s.devices)),
+	}
+
+	for _, ds := range s.devices {
+		res.Meters = append(res.Meters, withRates(ds, uptime))
+	}
+	sort.Slice(res.Meters, func(i, j int) bool {
+		return res.Meters[i].Device < res.Meters[j].Device
+	})
+
+	if len(s.sinks) > 0 {
+		res.Sinks = make(map[string]SinkStatus, len(s.sinks))
+		for name, ss := range s.sinks {
+			res.Sinks[name] = ss
+		}
+	}
+
+	if len(s.limiters) > 0 {
+		res.Adapters = make(map[string]meters.LimiterStatus, len(s.limiters))
+		for adapter, l := range s.limiters {
+			res.Adapters[adapter] = l.Status()
+		}
+	}
+
+	return res
+}
+
+// MarshalJSON marshals a snapshot of the status
+func (s *Status) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Snapshot())
 }
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestStatus(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m})
+
+	control := make(chan ControlSnip)
+	defer close(control)
+	s := NewStatus(qe, control)
+
+	// the repeated snip makes sure the previous ones have been processed
+	for _, id := range []string{"SDM1.2", "SDM1.1", "SDM1.1"} {
+		control <- ControlSnip{Device: id, Status: RuntimeInfo{Online: true, Requests: 60, Errors: 6}}
+	}
+
+	snapshot := s.Snapshot()
+	if len(snapshot.Meters) != 2 || snapshot.Meters[0].Device != "SDM1.1" || snapshot.Meters[1].Device != "SDM1.2" {
+		t.Fatalf("expected sorted devices, got %+v", snapshot.Meters)
+	}
+
+	// rates are relative to the current uptime
+	uptime := time.Since(snapshot.StartTime)
+	if rate := snapshot.Meters[0].RequestsPerMinute; rate < 60/uptime.Minutes() {
+		t.Errorf("expected request rate of at least %f, got %f", 60/uptime.Minutes(), rate)
+	}
+
+	ds, ok := s.Device("SDM1.2")
+	if !ok || !ds.Online || ds.Errors != 6 {
+		t.Errorf("unexpected device status %+v", ds)
+	}
+	if later := s.Snapshot(); later.Meters[1].ErrorsPerMinute > snapshot.Meters[1].ErrorsPerMinute {
+		t.Error("expected error rate to decrease with uptime")
+	}
+
+	s.Remove("SDM1.2")
+	if _, ok := s.Device("SDM1.2"); ok {
+		t.Error("expected removed device to have no status")
+	}
+
+	if ids := s.DeviceIDs(); len(ids) != 1 || ids[0] != "SDM1.1" {
+		t.Errorf("unexpected device ids %v", ids)
+	}
+}
+
+func TestStatusConcurrency(t *testing.T) {
+	m := meters.NewManager(meters.NewMock("mock"))
+	qe := NewQueryEngine(map[string]*meters.Manager{"mock": m})
+
+	control := make(chan ControlSnip)
+	s := NewStatus(qe, control)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if _, err := json.Marshal(s); err != nil {
+					t.Error(err)
+				}
+				s.UpdateSink("influx", SinkStatus{Queued: j})
+			}
+		}()
+	}
+
+	for i := uint64(0); i < 100; i++ {
+		control <- ControlSnip{Device: "SDM1.1", Status: RuntimeInfo{Requests: i}}
+	}
+	close(control)
+	wg.Wait()
+}
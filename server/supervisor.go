@@ -0,0 +1,182 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/volkszaehler/mbmd/log"
+)
+
+const (
+	restartDelay    = time.Second // doubled with every consecutive restart
+	maxRestartDelay = 10 * time.Second
+)
+
+// Task states
+const (
+	TaskRunning    = "running"
+	TaskRestarting = "restarting" // waiting for restart after a panic
+	TaskStalled    = "stalled"    // no heartbeat within the stall timeout
+)
+
+// TaskStatus is the state of a supervised goroutine
+type TaskStatus struct {
+	Name      string
+	State     string
+	Restarts  int
+	LastPanic string    `json:",omitempty"`
+	Since     time.Time // start of the current run
+}
+
+// task is a supervised goroutine
+type task struct {
+	TaskStatus
+	stall time.Duration
+	beat  time.Time
+}
+
+// Supervisor runs the polling, hook and sink goroutines, restarts them after panics and
+// reports goroutines that have stopped making progress
+type Supervisor struct {
+	mux   sync.Mutex
+	tasks map[string]*task
+}
+
+// NewSupervisor creates a supervisor
+func NewSupervisor() *Supervisor {
+	return &Supervisor{
+		tasks: make(map[string]*task),
+	}
+}
+
+// register adds a task, names of concurrently running tasks are made unique
+func (s *Supervisor) register(name string, stall time.Duration) string {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	key := name
+	for i := 2; s.tasks[key] != nil; i++ {
+		key = fmt.Sprintf("%s#%d", name, i)
+	}
+
+	now := time.Now()
+	s.tasks[key] = &task{
+		TaskStatus: TaskStatus{Name: key, State: TaskRunning, Since: now},
+		stall:      stall,
+		beat:       now,
+	}
+
+	return key
+}
+
+// update applies f to the named task
+func (s *Supervisor) update(name string, f func(t *task)) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if t, ok := s.tasks[name]; ok {
+		f(t)
+	}
+}
+
+// call runs the task and returns the recovered panic and its stack trace, if any
+func call(run func(beat func()), beat func()) (stack []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			stack = debug.Stack()
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	run(beat)
+	return nil, nil
+}
+
+// Run runs the task until it returns, restarting it with increasing delay after panics.
+// Tasks with a stall timeout must call beat at least once per timeout or are reported as stalled.
+// Restarts are abandoned when the context is cancelled.
+func (s *Supervisor) Run(ctx context.Context, name string, stall time.Duration, run func(beat func())) {
+	name = s.register(name, stall)
+	defer func() {
+		s.mux.Lock()
+		delete(s.tasks, name)
+		s.mux.Unlock()
+	}()
+
+	beat := func() {
+		s.update(name, func(t *task) { t.beat = time.Now() })
+	}
+
+	delay := restartDelay
+	for {
+		stack, err := call(run, beat)
+		if err == nil {
+			return
+		}
+
+		log.Errorf("supervisor: %s crashed - restarting in %v: %v\n%s", name, delay, err, stack)
+		s.update(name, func(t *task) {
+			t.State = TaskRestarting
+			t.LastPanic = err.Error()
+		})
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		if delay *= 2; delay > maxRestartDelay {
+			delay = maxRestartDelay
+		}
+
+		s.update(name, func(t *task) {
+			now := time.Now()
+			t.State = TaskRunning
+			t.Restarts++
+			t.Since = now
+			t.beat = now
+		})
+	}
+}
+
+// Status returns the state of all running tasks sorted by name
+func (s *Supervisor) Status() []TaskStatus {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	now := time.Now()
+	res := make([]TaskStatus, 0, len(s.tasks))
+	for _, t := range s.tasks {
+		ts := t.TaskStatus
+		if ts.State == TaskRunning && t.stall > 0 && now.Sub(t.beat) > t.stall {
+			ts.State = TaskStalled
+		}
+		res = append(res, ts)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+
+	return res
+}
+
+// runnerName returns a task name for the runner function, e.g. (*Cache).Run
+func runnerName(run interface{}) string {
+	name := runtime.FuncForPC(reflect.ValueOf(run).Pointer()).Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	if i := strings.Index(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.TrimSuffix(name, "-fm")
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSupervisorRestart(t *testing.T) {
+	s := NewSupervisor()
+
+	runs := 0
+	crashed := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		s.Run(context.Background(), "task", 0, func(func()) {
+			if runs++; runs == 1 {
+				close(crashed)
+				panic("boom")
+			}
+		})
+		close(done)
+	}()
+
+	<-crashed
+	time.Sleep(10 * time.Millisecond)
+
+	if st := s.Status(); len(st) != 1 || st[0].State != TaskRestarting || st[0].LastPanic != "boom" {
+		t.Errorf("expected restarting task, got %+v", st)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(2 * restartDelay):
+		t.Fatal("task not restarted")
+	}
+
+	if runs != 2 {
+		t.Errorf("expected 2 runs, got %d", runs)
+	}
+
+	// finished tasks are removed
+	if st := s.Status(); len(st) != 0 {
+		t.Errorf("expected no tasks, got %+v", st)
+	}
+}
+
+func TestSupervisorCancel(t *testing.T) {
+	s := NewSupervisor()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	runs := 0
+	s.Run(ctx, "task", 0, func(func()) {
+		runs++
+		panic("boom")
+	})
+
+	if runs != 1 {
+		t.Errorf("expected no restart after cancel, got %d runs", runs)
+	}
+}
+
+func TestSupervisorStall(t *testing.T) {
+	s := NewSupervisor()
+
+	beats := make(chan struct{})
+	done := make(chan struct{})
+	defer close(beats)
+
+	go s.Run(context.Background(), "task", 10*time.Millisecond, func(beat func()) {
+		for range beats {
+			beat()
+			done <- struct{}{}
+		}
+	})
+
+	time.Sleep(20 * time.Millisecond)
+	if st := s.Status(); len(st) != 1 || st[0].State != TaskStalled {
+		t.Errorf("expected stalled task, got %+v", st)
+	}
+
+	beats <- struct{}{}
+	<-done
+	if st := s.Status(); st[0].State != TaskRunning {
+		t.Errorf("expected running task, got %+v", st)
+	}
+}
+
+func TestSupervisorNames(t *testing.T) {
+	s := NewSupervisor()
+
+	if name := s.register("task", 0); name != "task" {
+		t.Errorf("unexpected name %s", name)
+	}
+	if name := s.register("task", 0); name != "task#2" {
+		t.Errorf("expected unique name, got %s", name)
+	}
+
+	if name := runnerName((&Cache{}).Run); name != "(*Cache).Run" {
+		t.Errorf("unexpected runner name %s", name)
+	}
+}
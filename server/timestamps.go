@@ -0,0 +1,27 @@
+package server
+
+import (
+	"math"
+	"time"
+)
+
+// apiTimeFormat is RFC3339 with millisecond precision
+const apiTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// apiLocation is the time zone of timestamps in api payloads
+var apiLocation = time.UTC
+
+// SetTimezone sets the time zone of timestamps in api payloads. Timestamps are UTC by default.
+func SetTimezone(loc *time.Location) {
+	apiLocation = loc
+}
+
+// apiTime formats the timestamp as RFC3339 in the api time zone
+func apiTime(t time.Time) string {
+	return t.In(apiLocation).Format(apiTimeFormat)
+}
+
+// apiAge returns the age in seconds with millisecond precision
+func apiAge(d time.Duration) float64 {
+	return math.Round(d.Seconds()*1e3) / 1e3
+}
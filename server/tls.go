@@ -0,0 +1,135 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"time"
+
+	"github.com/volkszaehler/mbmd/log"
+)
+
+const (
+	selfSignedValidity = 10 * 365 * 24 * time.Hour
+)
+
+// TLSConfig describes the http server's certificate configuration
+type TLSConfig struct {
+	CertFile     string
+	KeyFile      string
+	SelfSigned   bool
+	ClientCAFile string // CA for verifying client certificates
+}
+
+// ClientCAs loads the certificate pool for verifying client certificates
+func (c TLSConfig) ClientCAs() (*x509.CertPool, error) {
+	b, err := ioutil.ReadFile(c.ClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, errors.New("no certificates found in " + c.ClientCAFile)
+	}
+
+	return pool, nil
+}
+
+// Enabled returns true if the server should be served via https
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != "" || c.SelfSigned
+}
+
+// Certificate loads the configured certificate. If self-signed certificates are
+// enabled and the certificate files do not exist, a new certificate is generated
+// and- if file names are given- saved for subsequent runs. If only one of the files
+// exists, an error is returned instead of overwriting it.
+func (c TLSConfig) Certificate() (tls.Certificate, error) {
+	if c.SelfSigned && fileExists(c.CertFile) != fileExists(c.KeyFile) {
+		return tls.Certificate{}, fmt.Errorf("certificate %s and key %s must either both exist or both not exist", c.CertFile, c.KeyFile)
+	}
+
+	if c.SelfSigned && !(fileExists(c.CertFile) && fileExists(c.KeyFile)) {
+		certPEM, keyPEM, err := selfSignedCertificate()
+		if err != nil {
+			return tls.Certificate{}, err
+		}
+
+		if c.CertFile != "" && c.KeyFile != "" {
+			log.Printf("httpd: saving self-signed certificate to %s", c.CertFile)
+			if err := ioutil.WriteFile(c.CertFile, certPEM, 0644); err != nil {
+				return tls.Certificate{}, err
+			}
+			if err := ioutil.WriteFile(c.KeyFile, keyPEM, 0600); err != nil {
+				return tls.Certificate{}, err
+			}
+		}
+
+		return tls.X509KeyPair(certPEM, keyPEM)
+	}
+
+	return tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+}
+
+func fileExists(name string) bool {
+	if name == "" {
+		return false
+	}
+	_, err := os.Stat(name)
+	return err == nil
+}
+
+// selfSignedCertificate creates PEM encoded certificate and key for the local host
+func selfSignedCertificate() ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	host, _ := os.Hostname()
+	template := x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"mbmd"}, CommonName: host},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(selfSignedValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+	if host != "" {
+		template.DNSNames = append(template.DNSNames, host)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+
+	return certPEM, keyPEM, nil
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSelfSignedCertificate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	conf := TLSConfig{
+		CertFile:   filepath.Join(dir, "cert.pem"),
+		KeyFile:    filepath.Join(dir, "key.pem"),
+		SelfSigned: true,
+	}
+
+	// generated certificate is saved and loaded on subsequent runs
+	if _, err := conf.Certificate(); err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := ioutil.ReadFile(conf.CertFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := conf.Certificate(); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := ioutil.ReadFile(conf.CertFile); !bytes.Equal(b, cert) {
+		t.Error("expected certificate to be reused")
+	}
+
+	// a missing key does not replace the existing certificate
+	if err := os.Remove(conf.KeyFile); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conf.Certificate(); err == nil {
+		t.Error("expected error for missing key")
+	}
+	if b, _ := ioutil.ReadFile(conf.CertFile); !bytes.Equal(b, cert) {
+		t.Error("expected certificate unchanged")
+	}
+	if _, err := os.Stat(conf.KeyFile); !os.IsNotExist(err) {
+		t.Error("expected no key written")
+	}
+
+	// neither is a missing certificate replaced
+	if err := os.Rename(conf.CertFile, conf.KeyFile); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conf.Certificate(); err == nil {
+		t.Error("expected error for missing certificate")
+	}
+
+	// certificate without files is not saved
+	if _, err := (TLSConfig{SelfSigned: true}).Certificate(); err != nil {
+		t.Error(err)
+	}
+}
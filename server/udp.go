@@ -0,0 +1,117 @@
+package server
+
+import (
+	"errors"
+	"math"
+	"net"
+	"time"
+
+	influxdb "github.com/influxdata/influxdb-client-go"
+	"github.com/influxdata/influxdb-client-go/api/write"
+	"github.com/volkszaehler/mbmd/log"
+)
+
+// udpMaxDatagram is the maximum datagram size, small enough to avoid fragmentation on common networks
+const udpMaxDatagram = 1400
+
+// LineProtocolUDP writes readings as InfluxDB line protocol datagrams, e.g. to Telegraf's socket_listener.
+// Readings are sent in the same layout as written by the InfluxDB sink.
+type LineProtocolUDP struct {
+	conn        net.Conn
+	measurement string
+}
+
+// NewLineProtocolUDP creates a line protocol sink sending to the UDP address
+func NewLineProtocolUDP(addr string, measurement string) (*LineProtocolUDP, error) {
+	if measurement == "" {
+		return nil, errors.New("udp: missing measurement")
+	}
+
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LineProtocolUDP{
+		conn:        conn,
+		measurement: measurement,
+	}, nil
+}
+
+// line encodes the snip as line protocol. Values that cannot be represented are skipped.
+func (m *LineProtocolUDP) line(snip QuerySnip) (string, bool) {
+	if math.IsNaN(snip.Value) || math.IsInf(snip.Value, 0) {
+		return "", false
+	}
+
+	tags := map[string]string{
+		"device": snip.Device,
+		"type":   snip.Measurement.String(),
+	}
+	if snip.Derived {
+		tags["derived"] = "true"
+	}
+
+	fields := map[string]interface{}{
+		"value": snip.Value,
+	}
+
+	return write.PointToLineProtocol(influxdb.NewPoint(m.measurement, tags, fields, snip.Timestamp), time.Nanosecond), true
+}
+
+// Run LineProtocolUDP publisher. Lines of readings received at once are combined into
+// datagrams up to the maximum datagram size.
+func (m *LineProtocolUDP) Run(in <-chan QuerySnip) {
+	defer m.conn.Close()
+
+	d := &datagrams{conn: m.conn}
+	receive(in, d, func(snip QuerySnip) {
+		// restored values have already been written
+		if line, ok := m.line(snip); ok && !snip.Stale {
+			d.add(line)
+		}
+	})
+}
+
+// datagrams combines lines into datagrams up to the maximum datagram size
+type datagrams struct {
+	conn net.Conn
+	buf  []byte
+}
+
+// add adds a line, sending pending lines first if the datagram would exceed the maximum size
+func (d *datagrams) add(line string) {
+	if len(d.buf) > 0 && len(d.buf)+len(line) > udpMaxDatagram {
+		d.flush()
+	}
+	d.buf = append(d.buf, line...)
+}
+
+// flush sends pending lines. Errors are logged only since datagrams are not retried.
+func (d *datagrams) flush() {
+	if len(d.buf) == 0 {
+		return
+	}
+
+	if _, err := d.conn.Write(d.buf); err != nil {
+		log.Debugf("udp: %v", err)
+	}
+	d.buf = d.buf[:0]
+}
+
+// receive handles snips until the channel is closed. Datagrams are flushed once no further snips are pending.
+func receive(in <-chan QuerySnip, d *datagrams, handle func(QuerySnip)) {
+	for snip := range in {
+		for pending := true; pending; {
+			handle(snip)
+
+			select {
+			case snip, pending = <-in:
+			default:
+				pending = false
+			}
+		}
+
+		d.flush()
+	}
+}
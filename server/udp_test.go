@@ -0,0 +1,48 @@
+package server
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestLineProtocolUDP(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pc.Close()
+
+	udp, err := NewLineProtocolUDP(pc.LocalAddr().String(), "data")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts := time.Unix(1577880000, 0)
+	in := make(chan QuerySnip, 3)
+	in <- QuerySnip{Device: "SDM1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Power, Value: 1500.5, Timestamp: ts}}
+	in <- QuerySnip{Device: "SDM1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Import, Value: 12, Timestamp: ts}, Stale: true}
+	in <- QuerySnip{Device: "SDM1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Cosphi, Value: 0.5, Timestamp: ts, Derived: true}}
+	close(in)
+
+	udp.Run(in)
+
+	_ = pc.SetReadDeadline(time.Now().Add(time.Second))
+	b := make([]byte, udpMaxDatagram)
+	n, _, err := pc.ReadFrom(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"data,device=SDM1.1,type=Power value=1500.5 1577880000000000000",
+		"data,derived=true,device=SDM1.1,type=Cosphi value=0.5 1577880000000000000",
+	}
+
+	if lines := strings.Split(strings.TrimSpace(string(b[:n])), "\n"); strings.Join(lines, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected\n%s\ngot\n%s", strings.Join(expected, "\n"), b[:n])
+	}
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// unitBases are the units supporting metric prefixes
+var unitBases = []string{"varh", "var", "VAh", "VA", "Wh", "W", "Hz", "A", "V"}
+
+// unitPrefixes are the supported metric prefixes and their exponents
+var unitPrefixes = map[string]int{"m": -3, "": 0, "k": 3, "M": 6}
+
+// temperatures convert to and from °C
+var temperatures = map[string]struct{ to, from func(float64) float64 }{
+	"°C": {func(f float64) float64 { return f }, func(f float64) float64 { return f }},
+	"K":  {func(f float64) float64 { return f - 273.15 }, func(f float64) float64 { return f + 273.15 }},
+	"°F": {func(f float64) float64 { return (f - 32) * 5 / 9 }, func(f float64) float64 { return f*9/5 + 32 }},
+}
+
+// splitUnit splits a unit into metric prefix exponent and base unit
+func splitUnit(unit string) (int, string, bool) {
+	for _, base := range unitBases {
+		if strings.HasSuffix(unit, base) {
+			if exp, ok := unitPrefixes[strings.TrimSuffix(unit, base)]; ok {
+				return exp, base, true
+			}
+		}
+	}
+	return 0, "", false
+}
+
+type unitConversion struct {
+	unit    string
+	convert func(float64) float64
+}
+
+// conversion creates the conversion between two units
+func conversion(from, to string) (func(float64) float64, error) {
+	if t1, ok := temperatures[from]; ok {
+		if t2, ok := temperatures[to]; ok {
+			return func(f float64) float64 { return t2.from(t1.to(f)) }, nil
+		}
+	}
+
+	exp1, base1, ok1 := splitUnit(from)
+	exp2, base2, ok2 := splitUnit(to)
+	if ok1 && ok2 && base1 == base2 {
+		factor := math.Pow10(exp1 - exp2)
+		return func(f float64) float64 { return f * factor }, nil
+	}
+
+	return nil, fmt.Errorf("cannot convert %s to %s", from, to)
+}
+
+// UnitConverter converts measurement values from their native unit to target units
+type UnitConverter map[string]unitConversion
+
+// NewUnitConverter creates a unit converter from comma-separated conversions, e.g. W=kW,°C=K
+func NewUnitConverter(spec string) (UnitConverter, error) {
+	c := make(UnitConverter)
+
+	for _, s := range strings.Split(spec, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+
+		units := strings.SplitN(s, "=", 2)
+		if len(units) != 2 {
+			return nil, fmt.Errorf("invalid unit conversion %s", s)
+		}
+
+		from, to := strings.TrimSpace(units[0]), strings.TrimSpace(units[1])
+		f, err := conversion(from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		c[from] = unitConversion{unit: to, convert: f}
+	}
+
+	return c, nil
+}
+
+// Unit returns the measurement's unit after conversion
+func (c UnitConverter) Unit(m meters.Measurement) string {
+	_, unit := m.DescriptionAndUnit()
+	if conv, ok := c[unit]; ok {
+		return conv.unit
+	}
+	return unit
+}
+
+// Convert converts the measurement's value to the target unit
+func (c UnitConverter) Convert(m meters.Measurement, value float64) float64 {
+	_, unit := m.DescriptionAndUnit()
+	if conv, ok := c[unit]; ok {
+		return conv.convert(value)
+	}
+	return value
+}
+
+// NewUnitRunner decorates a QuerySnip runner such that it receives converted values
+func NewUnitRunner(c UnitConverter, run func(<-chan QuerySnip)) func(<-chan QuerySnip) {
+	if len(c) == 0 {
+		return run
+	}
+
+	return func(in <-chan QuerySnip) {
+		out := make(chan QuerySnip)
+		done := make(chan struct{})
+
+		go func() {
+			run(out)
+			close(done)
+		}()
+
+		for snip := range in {
+			snip.Value = c.Convert(snip.Measurement, snip.Value)
+			out <- snip
+		}
+
+		close(out)
+		<-done
+	}
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"math"
+	"testing"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestUnitConverter(t *testing.T) {
+	uc, err := NewUnitConverter("W=kW, kWh=Wh,A=mA,°C=K")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tc := []struct {
+		m     meters.Measurement
+		value float64
+		unit  string
+		res   float64
+	}{
+		{meters.Power, 1500, "kW", 1.5},
+		{meters.Import, 1.5, "Wh", 1500},
+		{meters.Current, 0.2, "mA", 200},
+		{meters.HeatSinkTemp, 20, "K", 293.15},
+		{meters.ReactivePower, 10, "var", 10},
+	}
+
+	for _, c := range tc {
+		if u := uc.Unit(c.m); u != c.unit {
+			t.Errorf("%s: expected unit %s, got %s", c.m, c.unit, u)
+		}
+		if v := uc.Convert(c.m, c.value); math.Abs(v-c.res) > 1e-9 {
+			t.Errorf("%s: expected %v, got %v", c.m, c.res, v)
+		}
+	}
+
+	for _, spec := range []string{"W=Wh", "W", "°C=kW"} {
+		if _, err := NewUnitConverter(spec); err == nil {
+			t.Errorf("%s: expected error", spec)
+		}
+	}
+}
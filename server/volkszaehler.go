@@ -0,0 +1,174 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/volkszaehler/mbmd/log"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+const (
+	vzWriteTimeout = 10 * time.Second
+	vzQueueSize    = 100 // pending batches while the middleware is slow or unavailable
+)
+
+var vzUUIDRE = regexp.MustCompile(`^[0-9a-fA-F-]+$`)
+
+// VolkszaehlerChannel maps a device's measurement to a middleware channel
+type VolkszaehlerChannel struct {
+	Device      string // device id or name
+	Measurement meters.Measurement
+	UUID        string
+}
+
+// Volkszaehler pushes readings to a volkszaehler.org middleware
+type Volkszaehler struct {
+	url      string
+	client   *http.Client
+	qe       DeviceInfo
+	channels []VolkszaehlerChannel
+	failed   bool
+}
+
+// vzBatch are the tuples of timestamp in milliseconds and value per channel uuid
+type vzBatch map[string][][2]float64
+
+// NewVolkszaehler creates a middleware publisher for the given channels
+func NewVolkszaehler(url string, qe DeviceInfo, channels []VolkszaehlerChannel) (*Volkszaehler, error) {
+	if url == "" {
+		return nil, errors.New("volkszaehler: missing middleware url")
+	}
+	if len(channels) == 0 {
+		return nil, errors.New("volkszaehler: missing channels")
+	}
+
+	for _, c := range channels {
+		if c.Device == "" {
+			return nil, fmt.Errorf("volkszaehler: missing device for channel %s", c.UUID)
+		}
+		if !vzUUIDRE.MatchString(c.UUID) {
+			return nil, fmt.Errorf("volkszaehler: invalid uuid %s", c.UUID)
+		}
+	}
+
+	return &Volkszaehler{
+		url:      strings.TrimRight(url, "/"),
+		client:   &http.Client{Timeout: vzWriteTimeout},
+		qe:       qe,
+		channels: channels,
+	}, nil
+}
+
+// uuids returns the channels of the snip's device and measurement
+func (m *Volkszaehler) uuids(snip QuerySnip) []string {
+	var res []string
+	for _, c := range m.channels {
+		if c.Measurement == snip.Measurement &&
+			(c.Device == snip.Device || c.Device == m.qe.DeviceLabelsByID(snip.Device).Name) {
+			res = append(res, c.UUID)
+		}
+	}
+	return res
+}
+
+// post writes the channel's tuples
+func (m *Volkszaehler) post(uuid string, tuples [][2]float64) error {
+	body, err := json.Marshal(tuples)
+	if err != nil {
+		return err
+	}
+
+	resp, err := m.client.Post(fmt.Sprintf("%s/data/%s.json", m.url, uuid), "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("channel %s: unexpected status %s", uuid, resp.Status)
+	}
+
+	return nil
+}
+
+// write posts the batch. Failed batches are dropped, errors are logged when the middleware state changes.
+func (m *Volkszaehler) write(batch vzBatch) {
+	uuids := make([]string, 0, len(batch))
+	for uuid := range batch {
+		uuids = append(uuids, uuid)
+	}
+	sort.Strings(uuids)
+
+	var err error
+	for _, uuid := range uuids {
+		if err = m.post(uuid, batch[uuid]); err != nil {
+			break
+		}
+	}
+
+	if failed := err != nil; failed != m.failed {
+		m.failed = failed
+		if failed {
+			log.Errorf("volkszaehler: middleware unavailable, dropping readings: %v", err)
+		} else {
+			log.Printf("volkszaehler: middleware available again")
+		}
+	}
+}
+
+// writeProc writes batches until the channel is closed
+func (m *Volkszaehler) writeProc(batches <-chan vzBatch, stopped chan<- struct{}) {
+	defer close(stopped)
+	for batch := range batches {
+		m.write(batch)
+	}
+}
+
+// Run Volkszaehler publisher. Readings received at once are combined into a single batch.
+func (m *Volkszaehler) Run(in <-chan QuerySnip) {
+	batches := make(chan vzBatch, vzQueueSize)
+	stopped := make(chan struct{})
+	go m.writeProc(batches, stopped)
+
+	batch := make(vzBatch)
+	for snip := range in {
+		for pending := true; pending; {
+			// restored values have already been written
+			if !snip.Stale && !math.IsNaN(snip.Value) && !math.IsInf(snip.Value, 0) {
+				ts := float64(snip.Timestamp.UnixNano() / int64(time.Millisecond))
+				for _, uuid := range m.uuids(snip) {
+					batch[uuid] = append(batch[uuid], [2]float64{ts, snip.Value})
+				}
+			}
+
+			select {
+			case snip, pending = <-in:
+			default:
+				pending = false
+			}
+		}
+
+		if len(batch) == 0 {
+			continue
+		}
+
+		select {
+		case batches <- batch:
+		default:
+			log.Warnf("volkszaehler: queue full, dropping readings")
+		}
+		batch = make(vzBatch)
+	}
+
+	close(batches)
+	<-stopped
+}
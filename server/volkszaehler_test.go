@@ -0,0 +1,57 @@
+package server
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+func TestVolkszaehler(t *testing.T) {
+	requests := make(map[string]string)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		requests[r.URL.Path] = string(b)
+	}))
+	defer srv.Close()
+
+	vz, err := NewVolkszaehler(srv.URL+"/middleware.php/", deviceNames{"SDM1.1": "garage"}, []VolkszaehlerChannel{
+		{Device: "garage", Measurement: meters.Power, UUID: "a0"},
+		{Device: "SDM1.1", Measurement: meters.Import, UUID: "b1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts := time.Unix(1577880000, 0)
+	in := make(chan QuerySnip, 4)
+	in <- QuerySnip{Device: "SDM1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Power, Value: 1500.5, Timestamp: ts}}
+	in <- QuerySnip{Device: "SDM1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Power, Value: 1400, Timestamp: ts.Add(time.Second)}}
+	in <- QuerySnip{Device: "SDM1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Import, Value: 12, Timestamp: ts}, Stale: true}
+	in <- QuerySnip{Device: "SDM1.1", MeasurementResult: meters.MeasurementResult{Measurement: meters.Cosphi, Value: 0.5, Timestamp: ts}}
+	close(in)
+
+	vz.Run(in)
+
+	expected := map[string]string{
+		"/middleware.php/data/a0.json": "[[1577880000000,1500.5],[1577880001000,1400]]",
+	}
+
+	if len(requests) != len(expected) {
+		t.Errorf("expected %v, got %v", expected, requests)
+	}
+	for path, body := range expected {
+		if requests[path] != body {
+			t.Errorf("expected %s %s, got %s", path, body, requests[path])
+		}
+	}
+}
+
+func TestVolkszaehlerInvalidUUID(t *testing.T) {
+	if _, err := NewVolkszaehler("http://localhost", nil, []VolkszaehlerChannel{{Device: "SDM1.1", UUID: "../x"}}); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,228 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/volkszaehler/mbmd/log"
+)
+
+const (
+	webhookTimeout     = 10 * time.Second
+	webhookQueueSize   = 100 // pending events while endpoints are slow or unavailable
+	webhookMinRequests = 10  // requests within window required for evaluating the error rate
+)
+
+// webhook event types
+const (
+	WebhookFailed          = "failed"
+	WebhookRecovered       = "recovered"
+	WebhookErrorRate       = "error-rate"
+	WebhookErrorRateNormal = "error-rate-normal"
+)
+
+// WebhookEvent is the JSON payload posted to webhooks
+type WebhookEvent struct {
+	Time      time.Time `json:"time"`
+	Event     string    `json:"event"`
+	Device    string    `json:"device"`
+	Name      string    `json:"name,omitempty"`
+	Text      string    `json:"text"`
+	ErrorRate float64   `json:"errorRate"` // percent of failed requests within window
+	Requests  uint64    `json:"requests"`
+	Errors    uint64    `json:"errors"`
+}
+
+// webhookSample are a device's request counters at a time
+type webhookSample struct {
+	time     time.Time
+	requests uint64
+	errors   uint64
+}
+
+// webhookDevice is a device's alerting state
+type webhookDevice struct {
+	online   bool
+	alerting bool // error rate exceeded
+	samples  []webhookSample
+}
+
+// errorRate adds the sample and returns the error rate in percent within window.
+// Ok is false if not enough requests have been sent within window.
+func (d *webhookDevice) errorRate(s webhookSample, window time.Duration) (rate float64, ok bool) {
+	// counters are reset when devices are initialized again
+	if n := len(d.samples); n > 0 && s.requests < d.samples[n-1].requests {
+		d.samples = nil
+	}
+
+	d.samples = append(d.samples, s)
+	for len(d.samples) > 1 && s.time.Sub(d.samples[0].time) > window {
+		d.samples = d.samples[1:]
+	}
+
+	first := d.samples[0]
+	requests := s.requests - first.requests
+	if requests < webhookMinRequests {
+		return 0, false
+	}
+
+	return 100 * float64(s.errors-first.errors) / float64(requests), true
+}
+
+// Webhook posts device failure, recovery and error rate alerts as JSON to HTTP endpoints
+type Webhook struct {
+	urls      []string
+	threshold float64 // error rate in percent, 0 disables error rate alerts
+	window    time.Duration
+	qe        DeviceInfo
+	client    *http.Client
+	failed    bool
+}
+
+// validWebhookURL returns true for absolute http and https urls
+func validWebhookURL(u string) bool {
+	pu, err := url.Parse(u)
+	return err == nil && (pu.Scheme == "http" || pu.Scheme == "https")
+}
+
+// NewWebhook creates a webhook publisher. Error rate alerts are sent when more than threshold percent
+// of a device's requests within window fail.
+func NewWebhook(urls []string, threshold float64, window time.Duration, qe DeviceInfo) (*Webhook, error) {
+	if len(urls) == 0 {
+		return nil, errors.New("webhook: missing url")
+	}
+	for _, u := range urls {
+		if !validWebhookURL(u) {
+			return nil, fmt.Errorf("webhook: invalid url %s", u)
+		}
+	}
+	if threshold < 0 || threshold > 100 {
+		return nil, fmt.Errorf("webhook: invalid error rate %g", threshold)
+	}
+	if threshold > 0 && window <= 0 {
+		return nil, fmt.Errorf("webhook: invalid window %v", window)
+	}
+
+	return &Webhook{
+		urls:      urls,
+		threshold: threshold,
+		window:    window,
+		qe:        qe,
+		client:    &http.Client{Timeout: webhookTimeout},
+	}, nil
+}
+
+// postJSON posts the JSON body to the endpoint
+func postJSON(client *http.Client, endpoint string, body []byte) error {
+	resp, err := client.Post(endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s: unexpected status %s", endpoint, resp.Status)
+	}
+
+	return nil
+}
+
+// writeProc posts events until the channel is closed. Failed events are dropped,
+// errors are logged when the endpoints' state changes.
+func (m *Webhook) writeProc(events <-chan WebhookEvent, stopped chan<- struct{}) {
+	defer close(stopped)
+
+	for event := range events {
+		body, err := json.Marshal(event)
+		if err != nil {
+			log.Errorf("webhook: %v", err)
+			continue
+		}
+
+		for _, endpoint := range m.urls {
+			if perr := postJSON(m.client, endpoint, body); perr != nil {
+				err = perr
+			}
+		}
+
+		if failed := err != nil; failed != m.failed {
+			m.failed = failed
+			if failed {
+				log.Errorf("webhook: endpoint unavailable, dropping events: %v", err)
+			} else {
+				log.Printf("webhook: endpoints available again")
+			}
+		}
+	}
+}
+
+// Run webhook publisher. Events are sent when a device goes offline or comes back online,
+// and when its error rate exceeds or falls below the threshold.
+func (m *Webhook) Run(in <-chan ControlSnip) {
+	events := make(chan WebhookEvent, webhookQueueSize)
+	stopped := make(chan struct{})
+	go m.writeProc(events, stopped)
+
+	devices := make(map[string]*webhookDevice)
+
+	for snip := range in {
+		now := time.Now()
+
+		event := WebhookEvent{
+			Time:     now,
+			Device:   snip.Device,
+			Name:     m.qe.DeviceLabelsByID(snip.Device).Name,
+			Requests: snip.Status.Requests,
+			Errors:   snip.Status.Errors,
+		}
+
+		send := func(typ, text string) {
+			event.Event = typ
+			event.Text = fmt.Sprintf("device %s %s", snip.Device, text)
+
+			select {
+			case events <- event:
+			default:
+				log.Warnf("webhook: queue full, dropping events")
+			}
+		}
+
+		d, ok := devices[snip.Device]
+		if !ok {
+			// initialized devices are online, there's nothing to recover from
+			d = &webhookDevice{online: true}
+			devices[snip.Device] = d
+		}
+
+		rate, valid := d.errorRate(webhookSample{now, snip.Status.Requests, snip.Status.Errors}, m.window)
+		event.ErrorRate = rate
+
+		if snip.Status.Online != d.online {
+			d.online = snip.Status.Online
+			if d.online {
+				send(WebhookRecovered, "recovered")
+			} else {
+				send(WebhookFailed, "failed")
+			}
+		}
+
+		if m.threshold > 0 && valid {
+			if exceeded := rate > m.threshold; exceeded != d.alerting {
+				d.alerting = exceeded
+				if exceeded {
+					send(WebhookErrorRate, fmt.Sprintf("error rate %.1f%% exceeds %g%%", rate, m.threshold))
+				} else {
+					send(WebhookErrorRateNormal, fmt.Sprintf("error rate %.1f%% below %g%%", rate, m.threshold))
+				}
+			}
+		}
+	}
+
+	close(events)
+	<-stopped
+}
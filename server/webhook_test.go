@@ -0,0 +1,64 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWebhook(t *testing.T) {
+	var events []WebhookEvent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event WebhookEvent
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		events = append(events, event)
+	}))
+	defer srv.Close()
+
+	webhook, err := NewWebhook([]string{srv.URL}, 10, time.Hour, deviceNames{"SDM1.1": "garage"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in := make(chan ControlSnip, 5)
+	in <- ControlSnip{Device: "SDM1.1", Status: RuntimeInfo{Online: true}}
+	in <- ControlSnip{Device: "SDM1.1", Status: RuntimeInfo{Online: false, Requests: 3, Errors: 3}}
+	in <- ControlSnip{Device: "SDM1.1", Status: RuntimeInfo{Online: true, Requests: 20, Errors: 5}}
+	in <- ControlSnip{Device: "SDM1.1", Status: RuntimeInfo{Online: true, Requests: 40, Errors: 5}}
+	in <- ControlSnip{Device: "SDM1.1", Status: RuntimeInfo{Online: true, Requests: 100, Errors: 5}}
+	close(in)
+
+	webhook.Run(in)
+
+	expected := []string{WebhookFailed, WebhookRecovered, WebhookErrorRate, WebhookErrorRateNormal}
+
+	if len(events) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, events)
+	}
+	for i, typ := range expected {
+		if events[i].Event != typ || events[i].Device != "SDM1.1" || events[i].Name != "garage" {
+			t.Errorf("expected %s, got %v", typ, events[i])
+		}
+	}
+
+	if rate := events[2].ErrorRate; rate != 25 {
+		t.Errorf("expected error rate 25, got %g", rate)
+	}
+}
+
+func TestWebhookInvalid(t *testing.T) {
+	for _, urls := range [][]string{nil, {"localhost:8080"}} {
+		if _, err := NewWebhook(urls, 0, 0, nil); err == nil {
+			t.Errorf("expected error for %v", urls)
+		}
+	}
+
+	if _, err := NewWebhook([]string{"http://localhost"}, 10, 0, nil); err == nil {
+		t.Error("expected error for missing window")
+	}
+}